@@ -20,6 +20,8 @@ import (
 	"cuelang.org/go/cue"
 	"cuelang.org/go/cue/cuecontext"
 	"cuelang.org/go/cue/load"
+
+	"github.com/matthewbaird/ontology/internal/cueparse"
 )
 
 // ToolDef represents an Anthropic function-calling tool definition.
@@ -203,7 +205,7 @@ func generateStateMachinesDoc(val cue.Value, outDir string) {
 			var targets []string
 			tIter, _ := sIter.Value().List()
 			for tIter.Next() {
-				t, _ := tIter.Value().String()
+				t, _ := cueparse.TransitionTarget(tIter.Value()).String()
 				targets = append(targets, t)
 			}
 			stateMap[state] = targets
@@ -327,6 +329,14 @@ func buildInputSchema(opType, entity string, op cue.Value) json.RawMessage {
 		}
 		required = []string{"data"}
 
+	case "bulk":
+		properties["items"] = map[string]interface{}{
+			"type":        "array",
+			"items":       map[string]string{"type": "object"},
+			"description": fmt.Sprintf("The %s records to create in one transaction", entity),
+		}
+		required = []string{"items"}
+
 	case "update":
 		properties["id"] = map[string]interface{}{
 			"type":        "string",
@@ -366,6 +376,13 @@ func buildInputSchema(opType, entity string, op cue.Value) json.RawMessage {
 			"description": fmt.Sprintf("The UUID of the %s to delete", entity),
 		}
 		required = []string{"id"}
+
+	case "exists":
+		properties["id"] = map[string]interface{}{
+			"type":        "string",
+			"description": fmt.Sprintf("The UUID of the %s to look for", entity),
+		}
+		required = []string{"id"}
 	}
 
 	schema["properties"] = properties
@@ -563,3 +580,4 @@ func findProjectRoot() string {
 		dir = parent
 	}
 }
+
@@ -18,6 +18,8 @@ import (
 	"cuelang.org/go/cue"
 	"cuelang.org/go/cue/cuecontext"
 	"cuelang.org/go/cue/load"
+
+	"github.com/matthewbaird/ontology/internal/cueparse"
 )
 
 type serviceDef struct {
@@ -146,23 +148,7 @@ func parseServices(val cue.Value) []serviceDef {
 func parseEntityMessages(val cue.Value) map[string][]entityField {
 	entities := make(map[string][]entityField)
 
-	iter, _ := val.Fields(cue.Definitions(true))
-	for iter.Next() {
-		label := iter.Selector().String()
-		defVal := iter.Value()
-
-		// Check if entity (has id + audit)
-		idField := defVal.LookupPath(cue.ParsePath("id"))
-		auditField := defVal.LookupPath(cue.ParsePath("audit"))
-		if idField.Err() != nil || auditField.Err() != nil {
-			continue
-		}
-
-		name := strings.TrimPrefix(label, "#")
-		// Skip base entity types — not domain entities
-		if name == "BaseEntity" || name == "StatefulEntity" || name == "ImmutableEntity" {
-			continue
-		}
+	for _, e := range cueparse.Entities(val) {
 		var fields []entityField
 		fieldNum := 1
 
@@ -175,20 +161,14 @@ func parseEntityMessages(val cue.Value) map[string][]entityField {
 		fieldNum++
 
 		// Parse remaining fields
-		fIter, _ := defVal.Fields(cue.Optional(true))
-		for fIter.Next() {
-			fname := strings.TrimSuffix(fIter.Selector().String(), "?")
-			if fname == "id" || fname == "audit" || strings.HasPrefix(fname, "_") {
-				continue
-			}
-
+		for _, cf := range e.Fields {
 			ef := entityField{
-				Name:     fname,
+				Name:     cf.Name,
 				Number:   fieldNum,
-				Optional: fIter.IsOptional(),
+				Optional: cf.Optional,
 			}
-			ef.ProtoType = cueToProtoType(fIter.Value())
-			if a := fIter.Value().Attribute("deprecated"); a.Err() == nil {
+			ef.ProtoType = cueToProtoType(cf.Value)
+			if a := cf.Value.Attribute("deprecated"); a.Err() == nil {
 				ef.Deprecated = true
 				reason, _, _ := a.Lookup(0, "reason")
 				if reason != "" {
@@ -209,7 +189,7 @@ func parseEntityMessages(val cue.Value) map[string][]entityField {
 			fieldNum++
 		}
 
-		entities[name] = fields
+		entities[e.Name] = fields
 	}
 
 	return entities
@@ -217,7 +197,7 @@ func parseEntityMessages(val cue.Value) map[string][]entityField {
 
 func cueToProtoType(val cue.Value) string {
 	// Check for reference to known types
-	ref := findReference(val)
+	ref := cueparse.FindReference(val)
 	switch ref {
 	case "#Money", "#NonNegativeMoney", "#PositiveMoney":
 		return "Money"
@@ -231,12 +211,7 @@ func cueToProtoType(val cue.Value) string {
 		return "google.protobuf.Timestamp"
 	}
 
-	kind := val.IncompleteKind()
-	if kind == cue.BottomKind {
-		kind = inferKindFromExpr(val)
-	}
-
-	switch kind {
+	switch cueparse.Kind(val) {
 	case cue.StringKind:
 		return "string"
 	case cue.IntKind:
@@ -261,55 +236,6 @@ func protoTypeForAuditField(name string) string {
 	}
 }
 
-// findReference recursively searches CUE value for type references.
-func findReference(val cue.Value) string {
-	_, path := val.ReferencePath()
-	if path.String() != "" {
-		selectors := path.Selectors()
-		if len(selectors) > 0 {
-			return selectors[len(selectors)-1].String()
-		}
-	}
-	op, args := val.Expr()
-	if op == cue.AndOp || op == cue.OrOp {
-		for _, arg := range args {
-			if ref := findReference(arg); ref != "" {
-				return ref
-			}
-		}
-	}
-	if op == cue.SelectorOp && len(args) >= 2 {
-		if s, err := args[1].String(); err == nil && s == "Time" {
-			return "time.Time"
-		}
-	}
-	return ""
-}
-
-func inferKindFromExpr(val cue.Value) cue.Kind {
-	op, args := val.Expr()
-	if op == cue.AndOp {
-		for _, arg := range args {
-			k := arg.IncompleteKind()
-			if k != cue.BottomKind {
-				return k
-			}
-			if inferred := inferKindFromExpr(arg); inferred != cue.BottomKind {
-				return inferred
-			}
-		}
-	}
-	if op == cue.OrOp && len(args) > 0 {
-		for _, arg := range args {
-			k := arg.IncompleteKind()
-			if k != cue.BottomKind {
-				return k
-			}
-		}
-	}
-	return cue.BottomKind
-}
-
 func generateProto(projectRoot string, svc serviceDef, entities map[string][]entityField) error {
 	var buf bytes.Buffer
 	tmpl, err := template.New("proto").Funcs(template.FuncMap{
@@ -495,6 +421,14 @@ message {{.Name}}Request {
 message {{.Name}}Response {
   {{.Entity}} {{toSnake .Entity}} = 1;
 }
+{{- else if eq .Type "bulk"}}
+message {{.Name}}Request {
+  repeated {{.Entity}} {{toSnake .Entity}}s = 1;
+}
+
+message {{.Name}}Response {
+  repeated {{.Entity}} {{toSnake .Entity}}s = 1;
+}
 {{- else if eq .Type "get"}}
 message {{.Name}}Request {
   string id = 1;
@@ -504,6 +438,14 @@ message {{.Name}}Request {
 message {{.Name}}Response {
   {{.Entity}} {{toSnake .Entity}} = 1;
 }
+{{- else if eq .Type "exists"}}
+message {{.Name}}Request {
+  string id = 1;
+}
+
+message {{.Name}}Response {
+  bool exists = 1;
+}
 {{- else if eq .Type "list"}}
 message {{.Name}}Request {
   int32 page_size = 1;
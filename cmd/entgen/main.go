@@ -8,7 +8,12 @@
 //	CUE Type                     Ent Field
 //	string & !=""                field.String(name).NotEmpty()
 //	int & >= 0                   field.Int(name).NonNegative()
+//	int & > 0                    field.Int(name).Positive()
+//	float & >=-90 & <=90         field.Float(name).Min(-90).Max(90)
+//	float & > 0                  field.Float(name).Positive()
 //	bool | *false                field.Bool(name).Default(false)
+//	string | *"USD"              field.String(name).Default("USD")
+//	int | *0                     field.Int(name).Default(0)
 //	time.Time                    field.Time(name)
 //	"a" | "b" | "c"             field.Enum(name).Values("a","b","c")
 //	=~"^pattern$"                field.String(name).Match(regexp)
@@ -19,32 +24,47 @@ package main
 
 import (
 	"bytes"
+	"encoding/json"
 	"fmt"
 	"go/format"
 	"log"
 	"os"
 	"path/filepath"
 	"regexp"
+	"slices"
 	"sort"
+	"strconv"
 	"strings"
 	"text/template"
 
 	"cuelang.org/go/cue"
+	"cuelang.org/go/cue/ast"
 	"cuelang.org/go/cue/cuecontext"
 	"cuelang.org/go/cue/load"
+	"cuelang.org/go/cue/parser"
+
+	"github.com/matthewbaird/ontology/internal/cueparse"
 )
 
 // entityDef holds the parsed definition of a domain entity from CUE.
 type entityDef struct {
 	Name               string
+	Table              string // @table("name") override; empty keeps Ent's default table name
+	SoftDelete         bool   // @soft_delete(): SoftDeleteMixin instead of hard deletes
+	Versioned          bool   // @versioned(): version column incremented by a hook on every update
 	Fields             []fieldDef
 	Edges              []edgeDef
 	Immutable          bool // LedgerEntry, JournalEntry
 	HasMachine         bool
-	Machine            map[string][]string // status -> valid next statuses
-	EdgeField          map[string]string   // edge name -> field name (for .Field() binding)
-	HasConstraints     bool                // true if entity has cross-field constraints
-	ConstraintHookCode string              // pre-rendered Go code for Hooks() + validation function
+	Machine            map[string][]string                   // status -> valid next statuses
+	Guards             map[string]map[string]transitionGuard // status -> next status -> guard
+	EdgeField          map[string]string                     // edge name -> field name (for .Field() binding)
+	FKEdge             map[string]string                     // removed FK field name -> edge that owns its column
+	Indexes            []indexDef
+	HasConstraints     bool   // true if entity has cross-field constraints
+	ConstraintHookCode string // pre-rendered Go code for the constraint validation hook
+	VersionHookCode    string // pre-rendered Go code for the version stamping hook
+	HookCode           string // Hooks() plus the hook functions it registers
 }
 
 // fieldDef holds the parsed definition of an entity field.
@@ -56,16 +76,39 @@ type fieldDef struct {
 	NotEmpty     bool
 	Immutable    bool
 	Sensitive    bool
+	StorageKey   string   // @column("name") override for the column name
+	Unique       bool     // @unique()
+	UniqueScope  string   // @unique(field): unique together with field
+	Index        bool     // @index(): non-unique lookup index
+	Text         bool     // @text(): unbounded Postgres text instead of varchar
+	Decimal      string   // @decimal(p,s): Postgres column type, e.g. "numeric(10,4)"
+	Currencies   []string // @currencies(USD,CAD): allowed codes of a Money field's currency
 	Default      string   // Go expression for default value
 	EnumValues   []string // For Enum fields
 	MatchPattern string   // For String fields with regex constraint
 	Validators   []string // Additional validator expressions
 	Comment      string
+	Description  string // CUE doc comment, shared with openapigen via schema.FieldDocs
 	JSONType     string // For JSON fields, the Go type expression
 	NonNegative  bool
 	Positive     bool
 	Min          string // numeric min
 	Max          string // numeric max
+	MinExclusive bool   // Min came from > rather than >=
+	MaxExclusive bool   // Max came from < rather than <=
+
+	// From @deprecated(reason="...", since="..."); recorded for openapigen.
+	Deprecated       bool
+	DeprecatedReason string
+	DeprecatedSince  string
+}
+
+// indexDef is an index emitted in the schema's Indexes(). Edges names edges
+// whose FK column participates in place of a field.
+type indexDef struct {
+	Fields []string
+	Edges  []string
+	Unique bool
 }
 
 // edgeDef holds the parsed definition of a relationship edge.
@@ -78,42 +121,8 @@ type edgeDef struct {
 	RefName      string // For "From" edges, the inverse edge name
 	Comment      string
 	FieldBinding string // If set, edge uses this field column via .Field()
-}
-
-// knownValueTypes maps CUE definition names to their Go type expressions.
-var knownValueTypes = map[string]string{
-	"#Money":             "types.Money",
-	"#NonNegativeMoney":  "types.Money",
-	"#PositiveMoney":     "types.Money",
-	"#Address":           "types.Address",
-	"#ContactMethod":     "types.ContactMethod",
-	"#DateRange":         "types.DateRange",
-	"#EntityRef":         "types.EntityRef",
-	"#RentScheduleEntry": "types.RentScheduleEntry",
-	"#RecurringCharge":   "types.RecurringCharge",
-	"#LateFeePolicy":     "types.LateFeePolicy",
-	"#CAMTerms":          "types.CAMTerms",
-	"#TenantImprovement": "types.TenantImprovement",
-	"#RenewalOption":     "types.RenewalOption",
-	"#SubsidyTerms":      "types.SubsidyTerms",
-	"#AccountDimensions": "types.AccountDimensions",
-	"#JournalLine":       "types.JournalLine",
-	"#RoleAttributes":    "json.RawMessage",
-	"#TenantAttributes":    "types.TenantAttributes",
-	"#OwnerAttributes":     "types.OwnerAttributes",
-	"#ManagerAttributes":   "types.ManagerAttributes",
-	"#GuarantorAttributes": "types.GuarantorAttributes",
-	"#UsageBasedCharge":    "types.UsageBasedCharge",
-	"#PercentageRent":      "types.PercentageRent",
-	"#RentAdjustment":      "types.RentAdjustment",
-	"#ExpansionRight":      "types.ExpansionRight",
-	"#ContractionRight":    "types.ContractionRight",
-	"#CAMCategoryTerms":    "types.CAMCategoryTerms",
-}
-
-// moneyFieldNames are field names recognized as #Money that get flattened.
-var moneyFieldNames = map[string]bool{
-	"#Money": true, "#NonNegativeMoney": true, "#PositiveMoney": true,
+	Cascade      bool   // on_delete: "cascade" — deleting this entity deletes the edge's targets
+	OneToOne     bool   // O2O relationship; its FK column is already unique
 }
 
 // ── CUE attribute extraction ─────────────────────────────────────────────────
@@ -126,12 +135,25 @@ type fieldAttrs struct {
 	computed  bool
 	sensitive bool
 	pii       bool
+	unique    bool
+	index     bool
+	column    string // @column("name"): storage key override
+	// decimal holds the precision and scale of @decimal(p,s); zero when absent.
+	decimal [2]int64
+	// currencies lists the ISO 4217 codes named by @currencies(USD,CAD).
+	currencies []string
+	// uniqueScope is the sibling field named by @unique(field); empty for a
+	// globally unique @unique().
+	uniqueScope string
 }
 
+// currencyCode matches the ISO 4217 codes #Money's currency accepts.
+var currencyCode = regexp.MustCompile(`^[A-Z]{3}$`)
+
 // extractAttributes reads CUE field-level attributes from a value.
 func extractAttributes(v cue.Value) fieldAttrs {
 	var fa fieldAttrs
-	for _, name := range []string{"display", "text", "immutable", "computed", "sensitive", "pii"} {
+	for _, name := range []string{"display", "text", "immutable", "computed", "sensitive", "pii", "unique", "index", "column", "decimal", "currencies"} {
 		a := v.Attribute(name)
 		if a.Err() != nil {
 			continue
@@ -149,6 +171,30 @@ func extractAttributes(v cue.Value) fieldAttrs {
 			fa.sensitive = true
 		case "pii":
 			fa.pii = true
+		case "unique":
+			fa.unique = true
+			fa.uniqueScope = strings.TrimSpace(a.Contents())
+		case "index":
+			fa.index = true
+		case "column":
+			fa.column, _ = a.String(0)
+		case "decimal":
+			p, perr := a.Int(0)
+			sc, serr := a.Int(1)
+			if perr != nil || serr != nil || p <= 0 || sc < 0 || sc > p {
+				log.Printf("warning: @decimal(%s): want @decimal(precision,scale) with 0 <= scale <= precision", a.Contents())
+				continue
+			}
+			fa.decimal = [2]int64{p, sc}
+		case "currencies":
+			for _, c := range strings.Split(a.Contents(), ",") {
+				c = strings.ToUpper(strings.TrimSpace(c))
+				if !currencyCode.MatchString(c) {
+					log.Printf("warning: @currencies: %q is not an ISO 4217 code", c)
+					continue
+				}
+				fa.currencies = append(fa.currencies, c)
+			}
 		}
 	}
 	return fa
@@ -208,14 +254,21 @@ func main() {
 
 	// Parse state machines
 	parseStateMachines(val, entities)
+	if err := validateStateMachines(entities); err != nil {
+		log.Fatalf("validating state machines: %v", err)
+	}
 
 	// Remove fields that would conflict with edges (FK fields like property_id, unit_id)
 	for _, ent := range entities {
 		removeFKFields(ent)
+		resolveUniqueIndexes(ent)
+		resolveLookupIndexes(ent)
 	}
 
 	// Add cross-field constraint hooks
 	assignConstraints(entities)
+	assignVersionHooks(entities)
+	assignHooks(entities)
 
 	// Generate Ent schema files
 	for _, ent := range entities {
@@ -225,41 +278,53 @@ func main() {
 		fmt.Printf("Generated ent/schema/%s.go\n", toSnake(ent.Name))
 	}
 
+	if err := generateConstraintError(projectRoot, entities); err != nil {
+		log.Fatalf("generating constraint error: %v", err)
+	}
+
+	if err := generateSoftDeleteMixin(projectRoot, entities); err != nil {
+		log.Fatalf("generating soft-delete mixin: %v", err)
+	}
+
+	if err := generateTransitionGuard(projectRoot, entities); err != nil {
+		log.Fatalf("generating transition guard: %v", err)
+	}
+
+	if err := generateFieldDocs(projectRoot, entities); err != nil {
+		log.Fatalf("generating field docs: %v", err)
+	}
+	fmt.Println("Generated ent/schema/field_docs.go")
+
+	if err := generateMetaSidecars(projectRoot, entities); err != nil {
+		log.Fatalf("generating metadata sidecars: %v", err)
+	}
+	fmt.Printf("Generated gen/meta/*.json (%d entities)\n", len(entities))
+
 	fmt.Printf("entgen: generated %d entity schemas\n", len(entities))
 }
 
-// parseEntities identifies domain entities in the CUE value.
-// An entity is a CUE definition that has both "id" and "audit" fields.
+// parseEntities builds an entityDef for every domain entity cueparse finds
+// in the ontology.
 func parseEntities(val cue.Value) map[string]*entityDef {
 	entities := make(map[string]*entityDef)
-
-	// Iterate over all definitions in the CUE package
-	iter, _ := val.Fields(cue.Definitions(true))
-	for iter.Next() {
-		label := iter.Selector().String()
-		defVal := iter.Value()
-
-		// Check if this is an entity (has "id" and "audit" fields)
-		// Note: Some entities with conditional constraints have IncompleteKind() == BottomKind,
-		// so we check for id/audit fields directly instead of filtering by struct kind.
-		idField := defVal.LookupPath(cue.ParsePath("id"))
-		auditField := defVal.LookupPath(cue.ParsePath("audit"))
-		if idField.Err() != nil || auditField.Err() != nil {
-			continue
+	for _, e := range cueparse.Entities(val) {
+		ent := &entityDef{
+			Name: e.Name,
 		}
-
-		name := strings.TrimPrefix(label, "#")
-		// Skip base entity types — they provide shared structure via embedding,
-		// but are not domain entities themselves.
-		if name == "BaseEntity" || name == "StatefulEntity" || name == "ImmutableEntity" {
-			continue
+		// @table("name") renames the table, e.g. to dodge a reserved word.
+		if a := e.Value.Attribute("table"); a.Err() == nil {
+			ent.Table, _ = a.String(0)
 		}
-		ent := &entityDef{
-			Name: name,
+		if a := e.Value.Attribute("soft_delete"); a.Err() == nil {
+			ent.SoftDelete = true
+		}
+		// @versioned() adds a version column for optimistic concurrency.
+		if a := e.Value.Attribute("versioned"); a.Err() == nil {
+			ent.Versioned = true
 		}
 
 		// Parse fields
-		ent.Fields = parseFields(name, defVal)
+		ent.Fields = parseFields(e.Name, e.Fields)
 
 		// Derive entity-level immutability: if all non-status fields have @immutable()
 		if len(ent.Fields) > 0 {
@@ -276,36 +341,20 @@ func parseEntities(val cue.Value) map[string]*entityDef {
 			ent.Immutable = allImmutable
 		}
 
-		entities[name] = ent
+		entities[e.Name] = ent
 	}
 
 	return entities
 }
 
-// parseFields extracts field definitions from a CUE struct value.
-func parseFields(entityName string, structVal cue.Value) []fieldDef {
+// parseFields converts classified CUE fields to field definitions.
+func parseFields(entityName string, cueFields []cueparse.Field) []fieldDef {
 	var fields []fieldDef
 
-	iter, _ := structVal.Fields(cue.Optional(true))
-	for iter.Next() {
-		label := iter.Selector().String()
-		fieldVal := iter.Value()
+	for _, cf := range cueFields {
+		label, fieldVal := cf.Name, cf.Value
 
-		// Strip optional marker from label if present
-		label = strings.TrimSuffix(label, "?")
-
-		// Skip "id" (handled as UUID PK), "audit" (handled by mixin), and constraint blocks
-		if label == "id" || label == "audit" {
-			continue
-		}
-
-		// Skip hidden fields (start with _)
-		if strings.HasPrefix(label, "_") {
-			continue
-		}
-
-		// Detect the CUE type reference
-		fd := classifyField(label, fieldVal, iter.IsOptional())
+		fd := classifyField(cf)
 		if fd != nil {
 			// Apply CUE attribute metadata
 			attrs := extractAttributes(fieldVal)
@@ -313,7 +362,45 @@ func parseFields(entityName string, structVal cue.Value) []fieldDef {
 				fd.Immutable = true
 			}
 			if attrs.sensitive || attrs.pii {
-				fd.Sensitive = true
+				// Ent only redacts string, bytes and JSON fields.
+				switch fd.EntType {
+				case "String", "JSON":
+					fd.Sensitive = true
+				default:
+					log.Printf("warning: %s.%s: Ent cannot mark %s fields sensitive; value is not redacted", entityName, label, fd.EntType)
+				}
+			}
+			fd.StorageKey = attrs.column
+			if attrs.unique {
+				if attrs.uniqueScope == "" {
+					fd.Unique = true
+				} else {
+					fd.UniqueScope = attrs.uniqueScope
+				}
+			}
+			fd.Index = attrs.index
+			if attrs.text {
+				fd.Text = fd.EntType == "String"
+			}
+			if len(attrs.currencies) > 0 {
+				if fd.EntType == "Money" {
+					fd.Currencies = attrs.currencies
+				} else {
+					log.Printf("warning: %s.%s: @currencies applies to Money fields, not %s; ignoring", entityName, label, fd.EntType)
+				}
+			}
+			if attrs.decimal[0] > 0 {
+				if fd.EntType == "Float64" {
+					fd.Decimal = fmt.Sprintf("numeric(%d,%d)", attrs.decimal[0], attrs.decimal[1])
+				} else {
+					log.Printf("warning: %s.%s: @decimal applies to float fields, not %s; ignoring", entityName, label, fd.EntType)
+				}
+			}
+			fd.Description = fieldDoc(fieldVal)
+			if a := fieldVal.Attribute("deprecated"); a.Err() == nil {
+				fd.Deprecated = true
+				fd.DeprecatedReason, _, _ = a.Lookup(0, "reason")
+				fd.DeprecatedSince, _, _ = a.Lookup(0, "since")
 			}
 			fields = append(fields, *fd)
 		}
@@ -322,105 +409,119 @@ func parseFields(entityName string, structVal cue.Value) []fieldDef {
 	return fields
 }
 
-// findReference recursively searches a CUE value expression tree for references
-// to known types (like #Money, #Address, time.Time).
-func findReference(val cue.Value) string {
-	// Direct reference
-	_, path := val.ReferencePath()
-	if path.String() != "" {
-		selectors := path.Selectors()
-		if len(selectors) > 0 {
-			return selectors[len(selectors)-1].String()
+// fieldDoc returns the CUE doc comment attached to a field as a single line,
+// or "" when the field is undocumented. Only a comment directly above a lone
+// field documents it: one heading a run of fields ("// Physical" above
+// year_built, total_square_footage, ...) is a section header, and one
+// separated from the field by a blank line is free-floating. A title of
+// three words or fewer ("// Liability", "// Rent schedule") heads a
+// one-field section and is skipped too.
+func fieldDoc(v cue.Value) string {
+	var parts []string
+	for _, cg := range v.Doc() {
+		if !fieldDocComments(cg.Pos().Filename())[cg.Pos().Line()] {
+			continue
+		}
+		if words := strings.Fields(cg.Text()); len(words) > 3 {
+			parts = append(parts, strings.Join(words, " "))
 		}
 	}
+	return strings.Join(parts, " ")
+}
 
-	// Check expression tree for references within unifications
-	op, args := val.Expr()
-	if op == cue.AndOp || op == cue.OrOp {
-		for _, arg := range args {
-			if ref := findReference(arg); ref != "" {
-				return ref
+// docCommentLines caches fieldDocComments per CUE file.
+var docCommentLines = map[string]map[int]bool{}
+
+// fieldDocComments returns the starting lines of the comment groups in file
+// that document a single field: the group ends on the line above the field,
+// and the next element of the struct does not start on the line after it.
+func fieldDocComments(file string) map[int]bool {
+	if lines, ok := docCommentLines[file]; ok {
+		return lines
+	}
+	lines := map[int]bool{}
+	docCommentLines[file] = lines
+	f, err := parser.ParseFile(file, nil, parser.ParseComments)
+	if err != nil {
+		return lines
+	}
+	mark := func(elts []ast.Decl) {
+		for i, d := range elts {
+			field, ok := d.(*ast.Field)
+			if !ok {
+				continue
+			}
+			if i+1 < len(elts) {
+				if _, next := elts[i+1].(*ast.Field); next && elts[i+1].Pos().Line() == field.End().Line()+1 {
+					continue
+				}
+			}
+			for _, cg := range ast.Comments(field) {
+				if cg.Doc && cg.End().Line() == field.Pos().Line()-1 {
+					lines[cg.Pos().Line()] = true
+				}
 			}
 		}
 	}
-	// Check for time.Time specifically: it shows as a selector on time package
-	if op == cue.SelectorOp && len(args) >= 2 {
-		if s, err := args[1].String(); err == nil && s == "Time" {
-			return "time.Time"
+	ast.Walk(f, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.File:
+			mark(n.Decls)
+		case *ast.StructLit:
+			mark(n.Elts)
 		}
-	}
-	return ""
-}
-
-// isTimeField checks if a CUE value represents a time.Time field.
-func isTimeField(val cue.Value) bool {
-	ref := findReference(val)
-	return ref == "time.Time" || ref == "Time"
+		return true
+	}, nil)
+	return lines
 }
 
-// classifyField determines the Ent field type for a CUE value.
-func classifyField(name string, val cue.Value, optional bool) *fieldDef {
+// classifyField determines the Ent field type for a classified CUE field.
+// It returns nil for fields cueparse could not classify.
+func classifyField(cf cueparse.Field) *fieldDef {
+	name, val, optional := cf.Name, cf.Value, cf.Optional
 	fd := &fieldDef{
 		Name:     name,
 		Optional: optional,
 		Nillable: optional,
 	}
 
-	// Check for time.Time first (from CUE time import)
-	if isTimeField(val) {
+	switch cf.Kind {
+	case cueparse.Time:
 		fd.EntType = "Time"
-		return fd
-	}
 
-	// Check for references to known types
-	refName := findReference(val)
-	if refName != "" {
+	case cueparse.Money:
 		// Money fields at top level get flattened
-		if moneyFieldNames[refName] {
-			return flattenMoney(name, optional, refName)
-		}
+		return flattenMoney(name, optional, cf.Ref)
 
+	case cueparse.ValueType:
 		// Known value types become JSON fields
-		if goType, ok := knownValueTypes[refName]; ok {
-			fd.EntType = "JSON"
-			if isList(val) {
-				fd.JSONType = "[]" + goType + "{}"
-			} else {
-				fd.JSONType = "&" + goType + "{}"
-			}
-			return fd
+		goType := cueparse.ValueTypes[cf.Ref]
+		fd.EntType = "JSON"
+		if cf.List {
+			fd.JSONType = "[]" + goType + "{}"
+		} else {
+			fd.JSONType = "&" + goType + "{}"
 		}
-	}
 
-	// Check for list types
-	if isList(val) {
-		return classifyListField(name, val, optional)
-	}
+	case cueparse.List:
+		fd.EntType = "JSON"
+		if cf.ElemKind == cueparse.String {
+			fd.JSONType = "[]string{}"
+		} else {
+			fd.JSONType = "json.RawMessage{}"
+		}
 
-	// Check for disjunction (enum) — must check before string since enums are string-like
-	if isEnum(val) {
+	case cueparse.Enum:
 		fd.EntType = "Enum"
-		fd.EnumValues = extractEnumValues(val)
+		fd.EnumValues = cf.EnumValues
 		// Check for default value on enum
 		if d, ok := val.Default(); ok {
 			if s, err := d.String(); err == nil {
 				fd.Default = s
 			}
 		}
-		return fd
-	}
-
-	// Classify by incomplete kind
-	kind := val.IncompleteKind()
-
-	// If kind is bottom (_|_), the field has conflicting constraints from
-	// conditional blocks. Try to infer the actual type from the expression tree.
-	if kind == cue.BottomKind {
-		kind = inferKindFromExpr(val)
-	}
 
-	switch kind {
-	case cue.StringKind:
+	case cueparse.String:
 		fd.EntType = "String"
 		// Check for regex constraint
 		if pattern := extractPattern(val); pattern != "" {
@@ -430,32 +531,58 @@ func classifyField(name string, val cue.Value, optional bool) *fieldDef {
 		if hasNonEmpty(val) {
 			fd.NotEmpty = true
 		}
+		// Check for default value (enums with defaults were handled above)
+		if d, ok := val.Default(); ok {
+			if s, err := d.String(); err == nil {
+				fd.Default = strconv.Quote(s)
+			}
+		}
 
-	case cue.IntKind:
+	case cueparse.Int:
 		fd.EntType = "Int"
-		// Check for constraints
+		// Check for constraints. Integer bounds are exact, so > n becomes
+		// Min(n+1) and < n becomes Max(n-1).
 		lo, hi := extractNumericBounds(val)
-		if lo != "" {
-			fd.Min = lo
+		if lo != nil {
+			fd.Min = lo.Value
+			if lo.Exclusive {
+				fd.Min = shiftInt(lo.Value, 1)
+			}
 		}
-		if hi != "" {
-			fd.Max = hi
+		if hi != nil {
+			fd.Max = hi.Value
+			if hi.Exclusive {
+				fd.Max = shiftInt(hi.Value, -1)
+			}
 		}
-		if fd.Min == "0" {
+		switch fd.Min {
+		case "0":
 			fd.NonNegative = true
+		case "1":
+			fd.Positive = lo.Exclusive
+		}
+		if d, ok := val.Default(); ok {
+			if n, err := d.Int64(); err == nil {
+				fd.Default = strconv.FormatInt(n, 10)
+			}
 		}
 
-	case cue.FloatKind, cue.NumberKind:
+	case cueparse.Float:
 		fd.EntType = "Float64"
 		lo, hi := extractNumericBounds(val)
-		if lo != "" {
-			fd.Min = lo
+		if lo != nil {
+			fd.Min, fd.MinExclusive = lo.Value, lo.Exclusive
 		}
-		if hi != "" {
-			fd.Max = hi
+		if hi != nil {
+			fd.Max, fd.MaxExclusive = hi.Value, hi.Exclusive
+		}
+		if d, ok := val.Default(); ok {
+			if f, err := d.Float64(); err == nil {
+				fd.Default = strconv.FormatFloat(f, 'g', -1, 64)
+			}
 		}
 
-	case cue.BoolKind:
+	case cueparse.Bool:
 		fd.EntType = "Bool"
 		// Check for default value
 		if d, ok := val.Default(); ok {
@@ -467,21 +594,12 @@ func classifyField(name string, val cue.Value, optional bool) *fieldDef {
 			}
 		}
 
-	case cue.ListKind:
-		return classifyListField(name, val, optional)
-
-	case cue.StructKind:
-		// Struct without a known reference → JSON
+	case cueparse.Struct, cueparse.Any:
+		// Struct without a known reference, top type (_) or mixed kind → JSON
 		fd.EntType = "JSON"
 		fd.JSONType = "json.RawMessage{}"
 
 	default:
-		// Top type (_) or mixed kind → JSON
-		if kind != 0 && kind != cue.BottomKind {
-			fd.EntType = "JSON"
-			fd.JSONType = "json.RawMessage{}"
-			return fd
-		}
 		// Skip fields we can't classify
 		return nil
 	}
@@ -489,45 +607,6 @@ func classifyField(name string, val cue.Value, optional bool) *fieldDef {
 	return fd
 }
 
-// inferKindFromExpr walks the expression tree to find the underlying type
-// of a field that CUE reports as BottomKind due to conditional constraints.
-func inferKindFromExpr(val cue.Value) cue.Kind {
-	op, args := val.Expr()
-
-	if op == cue.AndOp {
-		for _, arg := range args {
-			k := arg.IncompleteKind()
-			if k != cue.BottomKind {
-				return k
-			}
-			// Recurse
-			if inferred := inferKindFromExpr(arg); inferred != cue.BottomKind {
-				return inferred
-			}
-		}
-	}
-
-	if op == cue.OrOp {
-		// For a disjunction, check the first arg
-		if len(args) > 0 {
-			for _, arg := range args {
-				k := arg.IncompleteKind()
-				if k != cue.BottomKind {
-					return k
-				}
-			}
-		}
-	}
-
-	// Check for NoOp with a single value
-	if op == cue.NoOp && len(args) == 0 {
-		// Try evaluating with Allow
-		return val.IncompleteKind()
-	}
-
-	return cue.BottomKind
-}
-
 // flattenMoney converts a #Money field into two columns: _amount_cents and _currency.
 func flattenMoney(name string, optional bool, moneyType string) *fieldDef {
 	// We return nil here and the caller should handle the expansion.
@@ -541,145 +620,6 @@ func flattenMoney(name string, optional bool, moneyType string) *fieldDef {
 	}
 }
 
-// classifyListField handles CUE list types.
-func classifyListField(name string, val cue.Value, optional bool) *fieldDef {
-	fd := &fieldDef{
-		Name:     name,
-		EntType:  "JSON",
-		Optional: optional,
-		Nillable: optional,
-	}
-
-	// Try to determine the element type.
-	// First try direct lookup, then walk expression tree for BottomKind values.
-	elemFound := false
-	elemVal := val.LookupPath(cue.MakePath(cue.AnyIndex))
-	if elemVal.Err() == nil {
-		elemFound = true
-	} else {
-		// For BottomKind list values (from conditional constraints), try to find
-		// the list in the expression tree
-		op, args := val.Expr()
-		if op == cue.AndOp {
-			for _, arg := range args {
-				if arg.IncompleteKind() == cue.ListKind || inferKindFromExpr(arg) == cue.ListKind {
-					ev := arg.LookupPath(cue.MakePath(cue.AnyIndex))
-					if ev.Err() == nil {
-						elemVal = ev
-						elemFound = true
-						break
-					}
-				}
-			}
-		}
-	}
-
-	if elemFound {
-		// Check for reference to a known type
-		ref := findReference(elemVal)
-		if ref != "" {
-			if goType, ok := knownValueTypes[ref]; ok {
-				fd.JSONType = "[]" + goType + "{}"
-				return fd
-			}
-		}
-
-		// Check if it's a simple string list (including enum strings)
-		kind := elemVal.IncompleteKind()
-		if kind == cue.BottomKind {
-			kind = inferKindFromExpr(elemVal)
-		}
-		if kind == cue.StringKind {
-			fd.JSONType = "[]string{}"
-			return fd
-		}
-	}
-
-	// Fallback to raw JSON
-	fd.JSONType = "json.RawMessage{}"
-	return fd
-}
-
-// isEnum checks if a CUE value is a disjunction of string literals.
-func isEnum(val cue.Value) bool {
-	return isEnumDirect(findEnumDisjunction(val))
-}
-
-// findEnumDisjunction extracts the OrOp disjunction from a value that may be
-// wrapped in an AndOp (e.g., when a `status: string` from an embedded base type
-// is unified with `status: "a" | "b" | "c"` in the entity).
-func findEnumDisjunction(val cue.Value) (cue.Op, []cue.Value) {
-	op, args := val.Expr()
-	if op == cue.OrOp {
-		return op, args
-	}
-	// When embedding introduces `string & ("a" | "b" | "c")`, the expression
-	// tree has AndOp at top level. Look through it for the OrOp branch.
-	if op == cue.AndOp {
-		for _, arg := range args {
-			argOp, argArgs := arg.Expr()
-			if argOp == cue.OrOp && isEnumDirect(argOp, argArgs) {
-				return argOp, argArgs
-			}
-		}
-	}
-	return op, args
-}
-
-func isEnumDirect(op cue.Op, args []cue.Value) bool {
-	if op != cue.OrOp {
-		return false
-	}
-	for _, arg := range args {
-		// Each arg should be a string literal, possibly with a default marker
-		aOp, aArgs := arg.Expr()
-		check := arg
-		if aOp == cue.SelectorOp && len(aArgs) > 0 {
-			check = aArgs[0]
-		}
-		if check.IncompleteKind() != cue.StringKind {
-			return false
-		}
-		// Must be a concrete string
-		if _, err := check.String(); err != nil {
-			// Could be a default expression
-			d, ok := check.Default()
-			if ok {
-				if _, err := d.String(); err != nil {
-					return false
-				}
-			} else {
-				return false
-			}
-		}
-	}
-	return len(args) >= 2
-}
-
-// extractEnumValues pulls string literal values from a disjunction.
-func extractEnumValues(val cue.Value) []string {
-	_, args := findEnumDisjunction(val)
-	var values []string
-	for _, arg := range args {
-		// Handle default markers
-		if s, err := arg.String(); err == nil {
-			values = append(values, s)
-			continue
-		}
-		if d, ok := arg.Default(); ok {
-			if s, err := d.String(); err == nil {
-				values = append(values, s)
-			}
-		}
-	}
-	return values
-}
-
-// isList checks if a CUE value represents a list type.
-func isList(val cue.Value) bool {
-	return val.IncompleteKind() == cue.ListKind
-}
-
 // extractPattern extracts a regex pattern from a CUE string constraint.
 func extractPattern(val cue.Value) string {
 	op, args := val.Expr()
@@ -727,42 +667,107 @@ func hasNonEmpty(val cue.Value) bool {
 	return false
 }
 
-// extractNumericBounds extracts min/max from numeric CUE constraints.
-func extractNumericBounds(val cue.Value) (lo, hi string) {
+// numericBound is one side of a numeric CUE constraint such as >=0 or <365.
+type numericBound struct {
+	Value     string // the literal as written in CUE, e.g. "0" or "-90"
+	Exclusive bool   // > or < rather than >= or <=
+}
+
+// extractNumericBounds extracts min/max from numeric CUE constraints, or nil
+// for a side that is unbounded.
+func extractNumericBounds(val cue.Value) (lo, hi *numericBound) {
 	op, args := val.Expr()
 	if op == cue.AndOp {
 		for _, arg := range args {
 			l, h := extractNumericBounds(arg)
-			if l != "" {
+			if l != nil {
 				lo = l
 			}
-			if h != "" {
+			if h != nil {
 				hi = h
 			}
 		}
 		return
 	}
-	switch op {
-	case cue.GreaterThanEqualOp:
-		if len(args) >= 2 {
-			lo = fmt.Sprint(args[1])
+	// A field with a default (*30 | int & >=0) wraps the constraint in a NoOp.
+	if op == cue.NoOp && len(args) == 1 {
+		if inner, _ := args[0].Expr(); inner != cue.NoOp {
+			return extractNumericBounds(args[0])
 		}
+		return
+	}
+	if len(args) == 0 {
+		return
+	}
+	b := &numericBound{Value: fmt.Sprint(args[len(args)-1])}
+	switch op {
 	case cue.GreaterThanOp:
-		if len(args) >= 2 {
-			lo = fmt.Sprint(args[1])
-		}
-	case cue.LessThanEqualOp:
-		if len(args) >= 2 {
-			hi = fmt.Sprint(args[1])
-		}
+		b.Exclusive = true
+		lo = b
+	case cue.GreaterThanEqualOp:
+		lo = b
 	case cue.LessThanOp:
-		if len(args) >= 2 {
-			hi = fmt.Sprint(args[1])
-		}
+		b.Exclusive = true
+		hi = b
+	case cue.LessThanEqualOp:
+		hi = b
 	}
 	return
 }
 
+// shiftInt offsets an integer bound literal by d, turning CUE's exclusive
+// > n / < n into the inclusive bound Ent's Min/Max expect.
+func shiftInt(lit string, d int64) string {
+	n, err := strconv.ParseInt(lit, 10, 64)
+	if err != nil {
+		return lit
+	}
+	return strconv.FormatInt(n+d, 10)
+}
+
+// floatBounds renders the range validators for a Float64 field. Inclusive
+// bounds map to Min/Max; Ent has no exclusive equivalent, so > 0 and < 0 use
+// Positive/Negative and any other exclusive bound gets an explicit Validate.
+func floatBounds(f fieldDef) string {
+	var b strings.Builder
+	switch {
+	case f.Min == "":
+	case !f.MinExclusive:
+		fmt.Fprintf(&b, ".Min(%s)", f.Min)
+	case f.Min == "0":
+		b.WriteString(".Positive()")
+	default:
+		fmt.Fprintf(&b, `.Validate(func(v float64) error {
+			if v <= %s {
+				return fmt.Errorf("%s %%v must be greater than %s", v)
+			}
+			return nil
+		})`, f.Min, f.Name, f.Min)
+	}
+	switch {
+	case f.Max == "":
+	case !f.MaxExclusive:
+		fmt.Fprintf(&b, ".Max(%s)", f.Max)
+	case f.Max == "0":
+		b.WriteString(".Negative()")
+	default:
+		fmt.Fprintf(&b, `.Validate(func(v float64) error {
+			if v >= %s {
+				return fmt.Errorf("%s %%v must be less than %s", v)
+			}
+			return nil
+		})`, f.Max, f.Name, f.Max)
+	}
+	return b.String()
+}
+
+// needsFloatValidate reports whether floatBounds emits a Validate closure,
+// which needs fmt in the generated schema.
+func needsFloatValidate(f fieldDef) bool {
+	return f.EntType == "Float64" &&
+		(f.MinExclusive && f.Min != "0" || f.MaxExclusive && f.Max != "0")
+}
+
 // parseRelationships reads the relationships list from CUE and assigns edges to entities.
 func parseRelationships(val cue.Value, entities map[string]*entityDef) {
 	relList := val.LookupPath(cue.ParsePath("relationships"))
@@ -782,6 +787,7 @@ func parseRelationships(val cue.Value, entities map[string]*entityDef) {
 		required, _ := rel.LookupPath(cue.ParsePath("required")).Bool()
 		semantic, _ := rel.LookupPath(cue.ParsePath("semantic")).String()
 		inverseName, _ := rel.LookupPath(cue.ParsePath("inverse_name")).String()
+		onDelete, _ := rel.LookupPath(cue.ParsePath("on_delete")).String()
 
 		if from == "" || to == "" || edgeName == "" {
 			continue
@@ -794,10 +800,12 @@ func parseRelationships(val cue.Value, entities map[string]*entityDef) {
 				Target:  to,
 				Type:    "To",
 				Comment: semantic,
+				Cascade: onDelete == "cascade",
 			}
 			switch cardinality {
 			case "O2O":
 				edge.Unique = true
+				edge.OneToOne = true
 			case "M2O":
 				// M2O from the "from" perspective means this is actually a "From" edge
 				// The "from" entity has many, the "to" entity has one
@@ -830,6 +838,7 @@ func parseRelationships(val cue.Value, entities map[string]*entityDef) {
 				invEdge.Type = "From"
 				invEdge.Unique = true
 				invEdge.RefName = edgeName
+				invEdge.OneToOne = true
 			case "O2M":
 				invEdge.Type = "From"
 				invEdge.Unique = true
@@ -846,6 +855,48 @@ func parseRelationships(val cue.Value, entities map[string]*entityDef) {
 	}
 }
 
+// transitionGuard is a parsed #TransitionGuard. Value is the Go literal of the
+// constant: a quoted string, true/false, or a float64 conversion.
+type transitionGuard struct {
+	Field string
+	Op    string
+	Value string
+	Kind  string // "string", "bool" or "number"
+}
+
+// parseTransitionGuard reads a #TransitionGuard value.
+func parseTransitionGuard(v cue.Value) (transitionGuard, error) {
+	var g transitionGuard
+	var err error
+	if g.Field, err = v.LookupPath(cue.ParsePath("field")).String(); err != nil {
+		return g, fmt.Errorf("guard field: %w", err)
+	}
+	if g.Op, err = v.LookupPath(cue.ParsePath("op")).String(); err != nil {
+		return g, fmt.Errorf("guard op: %w", err)
+	}
+	value := v.LookupPath(cue.ParsePath("value"))
+	switch value.IncompleteKind() {
+	case cue.StringKind:
+		s, _ := value.String()
+		g.Value, g.Kind = strconv.Quote(s), "string"
+	case cue.BoolKind:
+		b, _ := value.Bool()
+		g.Value, g.Kind = strconv.FormatBool(b), "bool"
+	case cue.IntKind, cue.FloatKind, cue.NumberKind:
+		f, err := value.Float64()
+		if err != nil {
+			return g, fmt.Errorf("guard value: %w", err)
+		}
+		g.Value, g.Kind = "float64("+strconv.FormatFloat(f, 'g', -1, 64)+")", "number"
+	default:
+		return g, fmt.Errorf("guard value must be a string, number or bool")
+	}
+	if g.Kind != "number" && g.Op != "==" && g.Op != "!=" {
+		return g, fmt.Errorf("guard op %s needs a numeric value", g.Op)
+	}
+	return g, nil
+}
+
 // parseStateMachines reads state machine definitions from the unified #StateMachines map.
 func parseStateMachines(val cue.Value, entities map[string]*entityDef) {
 	for entName := range entities {
@@ -856,6 +907,7 @@ func parseStateMachines(val cue.Value, entities map[string]*entityDef) {
 		}
 
 		machine := make(map[string][]string)
+		guards := make(map[string]map[string]transitionGuard)
 		iter, _ := smVal.Fields()
 		for iter.Next() {
 			state := iter.Selector().String()
@@ -864,9 +916,24 @@ func parseStateMachines(val cue.Value, entities map[string]*entityDef) {
 			var targets []string
 			tIter, _ := transitions.List()
 			for tIter.Next() {
-				if s, err := tIter.Value().String(); err == nil {
+				t := tIter.Value()
+				if s, err := t.String(); err == nil {
 					targets = append(targets, s)
+					continue
 				}
+				to, err := t.LookupPath(cue.ParsePath("to")).String()
+				if err != nil {
+					continue
+				}
+				targets = append(targets, to)
+				g, err := parseTransitionGuard(t.LookupPath(cue.ParsePath("guard")))
+				if err != nil {
+					log.Fatalf("%s: %s → %s: %v", entName, state, to, err)
+				}
+				if guards[state] == nil {
+					guards[state] = make(map[string]transitionGuard)
+				}
+				guards[state][to] = g
 			}
 			machine[state] = targets
 		}
@@ -874,8 +941,129 @@ func parseStateMachines(val cue.Value, entities map[string]*entityDef) {
 		if len(machine) > 0 {
 			ent.HasMachine = true
 			ent.Machine = machine
+			ent.Guards = guards
+		}
+	}
+}
+
+// validateStateMachines cross-references each entity's state machine against
+// the values of its status enum. Every source and target state must be an
+// enum value, and every enum value must appear as a source state, so typos in
+// either the ontology or state_machines.cue fail generation instead of
+// producing transitions that can never fire.
+func validateStateMachines(entities map[string]*entityDef) error {
+	var problems []string
+	names := make([]string, 0, len(entities))
+	for name := range entities {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		ent := entities[name]
+		if !ent.HasMachine {
+			continue
+		}
+		var status *fieldDef
+		for i := range ent.Fields {
+			if ent.Fields[i].Name == "status" {
+				status = &ent.Fields[i]
+				break
+			}
+		}
+		if status == nil || len(status.EnumValues) == 0 {
+			problems = append(problems, fmt.Sprintf("%s: has a state machine but no status enum", name))
+			continue
+		}
+
+		enum := make(map[string]bool, len(status.EnumValues))
+		for _, v := range status.EnumValues {
+			enum[v] = true
 		}
+		seen := make(map[string]bool)
+		var unknown []string
+		for state, targets := range ent.Machine {
+			for _, s := range append([]string{state}, targets...) {
+				if !enum[s] && !seen[s] {
+					seen[s] = true
+					unknown = append(unknown, s)
+				}
+			}
+		}
+		sort.Strings(unknown)
+		for _, state := range unknown {
+			problems = append(problems, fmt.Sprintf("%s: state %q is in the state machine but not in the status enum", name, state))
+		}
+		for _, v := range status.EnumValues {
+			if _, ok := ent.Machine[v]; !ok {
+				problems = append(problems, fmt.Sprintf("%s: status %q is in the status enum but not in the state machine", name, v))
+			}
+		}
+		kinds := guardFieldKinds(ent)
+		for _, from := range sortedKeys(ent.Guards) {
+			for _, to := range sortedKeys(ent.Guards[from]) {
+				g := ent.Guards[from][to]
+				kind, ok := kinds[g.Field]
+				switch {
+				case !ok:
+					problems = append(problems, fmt.Sprintf("%s: guard on %s → %s names %q, which is not a string, enum, bool or numeric field", name, from, to, g.Field))
+				case kind != g.Kind:
+					problems = append(problems, fmt.Sprintf("%s: guard on %s → %s compares %s field %q with a %s", name, from, to, kind, g.Field, g.Kind))
+				}
+			}
+		}
+	}
+
+	if len(problems) > 0 {
+		return fmt.Errorf("%d problem(s):\n  %s", len(problems), strings.Join(problems, "\n  "))
 	}
+	return nil
+}
+
+// guardFieldKinds maps each field a transition guard can name to the kind of
+// constant it compares with. Money fields are guarded through their flattened
+// _amount_cents and _currency columns.
+func guardFieldKinds(ent *entityDef) map[string]string {
+	kinds := make(map[string]string)
+	for _, f := range ent.Fields {
+		switch f.EntType {
+		case "String", "Enum":
+			kinds[f.Name] = "string"
+		case "Bool":
+			kinds[f.Name] = "bool"
+		case "Int", "Float64":
+			kinds[f.Name] = "number"
+		case "Money":
+			kinds[f.Name+"_amount_cents"] = "number"
+			kinds[f.Name+"_currency"] = "string"
+		}
+	}
+	return kinds
+}
+
+// sortedKeys returns the keys of m in order.
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// renderTransitionGuards renders the body of a {{Entity}}TransitionGuards map
+// literal, sorted by source then target state.
+func renderTransitionGuards(guards map[string]map[string]transitionGuard) string {
+	var b strings.Builder
+	for _, from := range sortedKeys(guards) {
+		fmt.Fprintf(&b, "\t%q: {\n", from)
+		for _, to := range sortedKeys(guards[from]) {
+			g := guards[from][to]
+			fmt.Fprintf(&b, "\t\t%q: {Field: %q, Op: %q, Value: %s},\n", to, g.Field, g.Op, g.Value)
+		}
+		b.WriteString("\t},\n")
+	}
+	return b.String()
 }
 
 // removeFKFields handles the relationship between entity fields and edge foreign keys.
@@ -888,6 +1076,9 @@ func removeFKFields(ent *entityDef) {
 	if ent.EdgeField == nil {
 		ent.EdgeField = make(map[string]string)
 	}
+	if ent.FKEdge == nil {
+		ent.FKEdge = make(map[string]string)
+	}
 
 	type fkMatch struct {
 		fieldName string
@@ -944,6 +1135,7 @@ func removeFKFields(ent *entityDef) {
 		} else {
 			// Remove the field, Ent creates the FK column from the edge
 			removeFields[m.fieldName] = true
+			ent.FKEdge[m.fieldName] = ent.Edges[m.edgeIdx].Name
 			// Propagate required-ness: if the CUE field was NOT optional,
 			// mark the edge as Required so the FK column is NOT NULL.
 			for _, f := range ent.Fields {
@@ -970,6 +1162,64 @@ func removeFKFields(ent *entityDef) {
 	ent.Fields = filtered
 }
 
+// resolveUniqueIndexes turns @unique(scope) fields into composite unique
+// indexes. The scope is either a field kept on the schema or an FK that
+// removeFKFields replaced with an edge, in which case the index uses the edge.
+func resolveUniqueIndexes(ent *entityDef) {
+	for _, f := range ent.Fields {
+		if f.UniqueScope == "" {
+			continue
+		}
+		if edgeName, ok := ent.FKEdge[f.UniqueScope]; ok {
+			ent.Indexes = append(ent.Indexes, indexDef{Fields: []string{f.Name}, Edges: []string{edgeName}, Unique: true})
+			continue
+		}
+		if !hasFieldDef(ent.Fields, f.UniqueScope) {
+			log.Printf("warning: %s.%s: @unique(%s) names no field or FK; skipping index", ent.Name, f.Name, f.UniqueScope)
+			continue
+		}
+		ent.Indexes = append(ent.Indexes, indexDef{Fields: []string{f.UniqueScope, f.Name}, Unique: true})
+	}
+}
+
+// resolveLookupIndexes adds non-unique indexes for the columns queries filter
+// on: every FK column this entity holds (whether Ent derives it from the edge
+// or the edge is bound to a kept field), an enum "status" column, and any
+// field marked @index(). Fields already unique on their own are skipped.
+func resolveLookupIndexes(ent *entityDef) {
+	ownsFK := make(map[string]bool)
+	for _, edgeName := range ent.FKEdge {
+		ownsFK[edgeName] = true
+	}
+	for _, e := range ent.Edges {
+		switch {
+		case e.OneToOne:
+			// Ent already makes an O2O FK column unique.
+		case e.FieldBinding != "":
+			ent.Indexes = append(ent.Indexes, indexDef{Fields: []string{e.FieldBinding}})
+		case ownsFK[e.Name]:
+			ent.Indexes = append(ent.Indexes, indexDef{Edges: []string{e.Name}})
+		}
+	}
+	for _, f := range ent.Fields {
+		if f.Unique {
+			continue
+		}
+		if f.Index || (f.Name == "status" && f.EntType == "Enum") {
+			ent.Indexes = append(ent.Indexes, indexDef{Fields: []string{f.Name}})
+		}
+	}
+}
+
+func hasFieldDef(fields []fieldDef, name string) bool {
+	for _, f := range fields {
+		if f.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
 // assignConstraints attaches cross-field constraint hook code to entities that have them.
 // Constraints are hardcoded from CUE ontology conditional blocks — they change rarely,
 // and CUE vet catches any drift between the ontology and this map.
@@ -983,6 +1233,64 @@ func assignConstraints(entities map[string]*entityDef) {
 	}
 }
 
+// assignVersionHooks attaches the version stamping hook to @versioned() entities.
+func assignVersionHooks(entities map[string]*entityDef) {
+	for name, ent := range entities {
+		if ent.Versioned {
+			ent.VersionHookCode = buildVersionHook(name)
+		}
+	}
+}
+
+// buildVersionHook returns pre-rendered Go source for the hook that increments
+// the version column on every update, so the column is a monotonic token for
+// optimistic concurrency independent of updated_at's resolution.
+func buildVersionHook(entityName string) string {
+	return fmt.Sprintf(`
+
+func stamp%sVersion() ent.Hook {
+	return func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if m.Op().Is(ent.OpUpdate | ent.OpUpdateOne) {
+				if err := m.AddField("version", 1); err != nil {
+					return nil, err
+				}
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+}`, entityName)
+}
+
+// assignHooks renders the Hooks() method of every entity with a constraint or
+// version hook, followed by the hook functions it registers.
+func assignHooks(entities map[string]*entityDef) {
+	for name, ent := range entities {
+		var hooks []string
+		var doc string
+		switch {
+		case ent.HasConstraints && ent.Versioned:
+			hooks = []string{"validate" + name + "Constraints()", "stamp" + name + "Version()"}
+			doc = "// Hooks returns cross-field constraint validation hooks, generated from\n// CUE ontology conditional blocks, and the version stamping hook."
+		case ent.HasConstraints:
+			hooks = []string{"validate" + name + "Constraints()"}
+			doc = "// Hooks returns cross-field constraint validation hooks.\n// Generated from CUE ontology conditional blocks."
+		case ent.Versioned:
+			hooks = []string{"stamp" + name + "Version()"}
+			doc = "// Hooks returns the version stamping hook."
+		default:
+			continue
+		}
+		var b strings.Builder
+		fmt.Fprintf(&b, "\n\n%s\nfunc (%s) Hooks() []ent.Hook {\n\treturn []ent.Hook{\n", doc, name)
+		for _, h := range hooks {
+			fmt.Fprintf(&b, "\t\t%s,\n", h)
+		}
+		b.WriteString("\t}\n}")
+		ent.HookCode = b.String() + ent.ConstraintHookCode + ent.VersionHookCode
+	}
+}
+
 // buildConstraintCode returns pre-rendered Go source for cross-field constraint hooks,
 // or empty string if the entity has no constraints.
 func buildConstraintCode(entityName string) string {
@@ -1072,14 +1380,6 @@ func buildConstraintCode(entityName string) string {
 	wrap := func(name, helpers, checks string) string {
 		return fmt.Sprintf(`
 
-// Hooks returns cross-field constraint validation hooks.
-// Generated from CUE ontology conditional blocks.
-func (%s) Hooks() []ent.Hook {
-	return []ent.Hook{
-		validate%sConstraints(),
-	}
-}
-
 func validate%sConstraints() ent.Hook {
 	return func(next ent.Mutator) ent.Mutator {
 		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {%s
@@ -1087,7 +1387,7 @@ func validate%sConstraints() ent.Hook {
 			return next.Mutate(ctx, m)
 		})
 	}
-}`, name, name, name, helpers, checks)
+}`, name, helpers, checks)
 	}
 
 	switch entityName {
@@ -1102,7 +1402,7 @@ func validate%sConstraints() ent.Hook {
 			if v, ok := getField("property_type"); ok && fmt.Sprint(v) == "single_family" {
 				if tu, ok := getField("total_spaces"); ok {
 					if tuInt, isInt := tu.(int); isInt && tuInt != 1 {
-						return nil, fmt.Errorf("single_family property must have total_spaces=1, got %d", tuInt)
+						return nil, constraintErrorf("total_spaces", "single_family property must have total_spaces=1, got %d", tuInt)
 					}
 				}
 			}
@@ -1110,11 +1410,11 @@ func validate%sConstraints() ent.Hook {
 			if v, ok := getField("property_type"); ok && fmt.Sprint(v) == "affordable_housing" {
 				cp, cpOk := getField("compliance_programs")
 				if !cpOk && m.Op().Is(ent.OpCreate) {
-					return nil, fmt.Errorf("affordable_housing property must have at least one compliance_programs entry")
+					return nil, constraintErrorf("compliance_programs", "affordable_housing property must have at least one compliance_programs entry")
 				}
 				if cpOk {
 					if list, isList := cp.([]string); isList && len(list) == 0 {
-						return nil, fmt.Errorf("affordable_housing property must have at least one compliance_programs entry")
+						return nil, constraintErrorf("compliance_programs", "affordable_housing property must have at least one compliance_programs entry")
 					}
 				}
 			}
@@ -1122,7 +1422,7 @@ func validate%sConstraints() ent.Hook {
 			if v, ok := getField("rent_controlled"); ok && fmt.Sprint(v) == "true" {
 				jid, jidOk := getField("jurisdiction_id")
 				if !jidOk || toString(jid) == "" {
-					return nil, fmt.Errorf("rent-controlled property must have jurisdiction_id set")
+					return nil, constraintErrorf("jurisdiction_id", "rent-controlled property must have jurisdiction_id set")
 				}
 			}
 			// year_built < 1978 → requires_lead_disclosure must be true
@@ -1130,7 +1430,7 @@ func validate%sConstraints() ent.Hook {
 				if yb, isInt := v.(int); isInt && yb < 1978 {
 					if rld, ok := getField("requires_lead_disclosure"); ok {
 						if fmt.Sprint(rld) != "true" {
-							return nil, fmt.Errorf("property built before 1978 must have requires_lead_disclosure=true")
+							return nil, constraintErrorf("requires_lead_disclosure", "property built before 1978 must have requires_lead_disclosure=true")
 						}
 					}
 				}
@@ -1143,10 +1443,10 @@ func validate%sConstraints() ent.Hook {
 			// residential_unit → bedrooms and bathrooms must be set
 			if v, ok := getField("space_type"); ok && fmt.Sprint(v) == "residential_unit" {
 				if _, ok := getField("bedrooms"); !ok && m.Op().Is(ent.OpCreate) {
-					return nil, fmt.Errorf("residential_unit space must have bedrooms set")
+					return nil, constraintErrorf("bedrooms", "residential_unit space must have bedrooms set")
 				}
 				if _, ok := getField("bathrooms"); !ok && m.Op().Is(ent.OpCreate) {
-					return nil, fmt.Errorf("residential_unit space must have bathrooms set")
+					return nil, constraintErrorf("bathrooms", "residential_unit space must have bathrooms set")
 				}
 			}
 			// parking or storage → bedrooms == 0 and bathrooms == 0
@@ -1155,12 +1455,12 @@ func validate%sConstraints() ent.Hook {
 				if st == "parking" || st == "storage" {
 					if bd, ok := getField("bedrooms"); ok {
 						if bdInt, ok := toInt(bd); ok && bdInt != 0 {
-							return nil, fmt.Errorf("%s space must have bedrooms=0, got %d", st, bdInt)
+							return nil, constraintErrorf("bedrooms", "%s space must have bedrooms=0, got %d", st, bdInt)
 						}
 					}
 					if bt, ok := getField("bathrooms"); ok {
 						if btFloat, ok := toFloat(bt); ok && btFloat != 0 {
-							return nil, fmt.Errorf("%s space must have bathrooms=0, got %v", st, btFloat)
+							return nil, constraintErrorf("bathrooms", "%s space must have bathrooms=0, got %v", st, btFloat)
 						}
 					}
 				}
@@ -1168,7 +1468,7 @@ func validate%sConstraints() ent.Hook {
 			// common_area → leasable must be false
 			if v, ok := getField("space_type"); ok && fmt.Sprint(v) == "common_area" {
 				if lv, ok := getField("leasable"); ok && fmt.Sprint(lv) == "true" {
-					return nil, fmt.Errorf("common_area space must have leasable=false")
+					return nil, constraintErrorf("leasable", "common_area space must have leasable=false")
 				}
 			}
 			// occupied → active_lease_id must be set
@@ -1176,7 +1476,7 @@ func validate%sConstraints() ent.Hook {
 				alid, alidOk := getField("active_lease_id")
 				if !alidOk || toString(alid) == "" {
 					if m.Op().Is(ent.OpCreate) {
-						return nil, fmt.Errorf("occupied space must have active_lease_id set")
+						return nil, constraintErrorf("active_lease_id", "occupied space must have active_lease_id set")
 					}
 				}
 			}`)
@@ -1197,14 +1497,14 @@ func validate%sConstraints() ent.Hook {
 			// Active leases must have a move-in date
 			if status == "active" {
 				if _, ok := getField("move_in_date"); !ok && m.Op().Is(ent.OpCreate) {
-					return nil, fmt.Errorf("active lease must have move_in_date set")
+					return nil, constraintErrorf("move_in_date", "active lease must have move_in_date set")
 				}
 			}
 
 			// Active/expired/renewed leases must be signed
 			if status == "active" || status == "expired" || status == "renewed" {
 				if _, ok := getField("signed_at"); !ok && m.Op().Is(ent.OpCreate) {
-					return nil, fmt.Errorf("%s lease must have signed_at set", status)
+					return nil, constraintErrorf("signed_at", "%s lease must have signed_at set", status)
 				}
 			}
 
@@ -1213,7 +1513,7 @@ func validate%sConstraints() ent.Hook {
 				if b, isBool := toBool(v); isBool && b {
 					// parent_lease_id is managed via the parent_lease edge
 					if len(m.AddedIDs("parent_lease")) == 0 && m.Op().Is(ent.OpCreate) {
-						return nil, fmt.Errorf("sublease must have parent_lease set")
+						return nil, constraintErrorf("parent_lease_id", "sublease must have parent_lease set")
 					}
 				}
 			}
@@ -1225,7 +1525,7 @@ func validate%sConstraints() ent.Hook {
 			}
 			if commercialTypes[leaseType] && m.Op().Is(ent.OpCreate) {
 				if v, ok := getField("cam_terms"); !ok || v == nil {
-					return nil, fmt.Errorf("commercial lease type %s requires cam_terms", leaseType)
+					return nil, constraintErrorf("cam_terms", "commercial lease type %s requires cam_terms", leaseType)
 				}
 			}
 
@@ -1234,7 +1534,7 @@ func validate%sConstraints() ent.Hook {
 				if v, ok := getField("cam_terms"); ok && v != nil {
 					if ct, isCAM := v.(*types.CAMTerms); isCAM && ct != nil {
 						if !ct.IncludesPropertyTax || !ct.IncludesInsurance || !ct.IncludesUtilities {
-							return nil, fmt.Errorf("NNN lease cam_terms must include property_tax, insurance, and utilities")
+							return nil, constraintErrorf("cam_terms", "NNN lease cam_terms must include property_tax, insurance, and utilities")
 						}
 					}
 				}
@@ -1245,7 +1545,7 @@ func validate%sConstraints() ent.Hook {
 				if v, ok := getField("cam_terms"); ok && v != nil {
 					if ct, isCAM := v.(*types.CAMTerms); isCAM && ct != nil {
 						if !ct.IncludesPropertyTax || !ct.IncludesInsurance {
-							return nil, fmt.Errorf("NN lease cam_terms must include property_tax and insurance")
+							return nil, constraintErrorf("cam_terms", "NN lease cam_terms must include property_tax and insurance")
 						}
 					}
 				}
@@ -1256,7 +1556,7 @@ func validate%sConstraints() ent.Hook {
 				if v, ok := getField("cam_terms"); ok && v != nil {
 					if ct, isCAM := v.(*types.CAMTerms); isCAM && ct != nil {
 						if !ct.IncludesPropertyTax {
-							return nil, fmt.Errorf("N lease cam_terms must include property_tax")
+							return nil, constraintErrorf("cam_terms", "N lease cam_terms must include property_tax")
 						}
 					}
 				}
@@ -1265,7 +1565,7 @@ func validate%sConstraints() ent.Hook {
 			// Section 8 leases require subsidy terms
 			if leaseType == "section_8" && m.Op().Is(ent.OpCreate) {
 				if v, ok := getField("subsidy"); !ok || v == nil {
-					return nil, fmt.Errorf("section_8 lease requires subsidy terms")
+					return nil, constraintErrorf("subsidy", "section_8 lease requires subsidy terms")
 				}
 			}
 
@@ -1274,7 +1574,7 @@ func validate%sConstraints() ent.Hook {
 				if v, ok := getField("term"); ok && v != nil {
 					if dr, isDR := v.(*types.DateRange); isDR && dr != nil {
 						if dr.End == nil {
-							return nil, fmt.Errorf("%s lease must have term.end set", leaseType)
+							return nil, constraintErrorf("term", "%s lease must have term.end set", leaseType)
 						}
 					}
 				}
@@ -1294,10 +1594,10 @@ func validate%sConstraints() ent.Hook {
 				if m.Op().Is(ent.OpCreate) {
 					db, dbOk := getField("decision_by")
 					if !dbOk || toString(db) == "" {
-						return nil, fmt.Errorf("%s application must have decision_by set", status)
+						return nil, constraintErrorf("decision_by", "%s application must have decision_by set", status)
 					}
 					if _, ok := getField("decision_at"); !ok {
-						return nil, fmt.Errorf("%s application must have decision_at set", status)
+						return nil, constraintErrorf("decision_at", "%s application must have decision_at set", status)
 					}
 				}
 			}
@@ -1307,7 +1607,7 @@ func validate%sConstraints() ent.Hook {
 				dr, drOk := getField("decision_reason")
 				if !drOk || toString(dr) == "" {
 					if m.Op().Is(ent.OpCreate) {
-						return nil, fmt.Errorf("denied application must have decision_reason set (fair housing compliance)")
+						return nil, constraintErrorf("decision_reason", "denied application must have decision_reason set (fair housing compliance)")
 					}
 				}
 			}`)
@@ -1321,13 +1621,13 @@ func validate%sConstraints() ent.Hook {
 				at := fmt.Sprint(v)
 				if at == "asset" || at == "expense" {
 					if nb, ok := getField("normal_balance"); ok && toString(nb) != "debit" {
-						return nil, fmt.Errorf("%s account must have normal_balance=debit", at)
+						return nil, constraintErrorf("normal_balance", "%s account must have normal_balance=debit", at)
 					}
 				}
 				// Liability, equity, and revenue accounts must have credit normal balance
 				if at == "liability" || at == "equity" || at == "revenue" {
 					if nb, ok := getField("normal_balance"); ok && toString(nb) != "credit" {
-						return nil, fmt.Errorf("%s account must have normal_balance=credit", at)
+						return nil, constraintErrorf("normal_balance", "%s account must have normal_balance=credit", at)
 					}
 				}
 			}
@@ -1335,7 +1635,7 @@ func validate%sConstraints() ent.Hook {
 			// Header accounts cannot accept direct postings
 			if v, ok := getField("is_header"); ok && fmt.Sprint(v) == "true" {
 				if adp, ok := getField("allows_direct_posting"); ok && fmt.Sprint(adp) == "true" {
-					return nil, fmt.Errorf("header account must have allows_direct_posting=false")
+					return nil, constraintErrorf("allows_direct_posting", "header account must have allows_direct_posting=false")
 				}
 			}
 
@@ -1343,7 +1643,7 @@ func validate%sConstraints() ent.Hook {
 			if v, ok := getField("is_trust_account"); ok && fmt.Sprint(v) == "true" {
 				tt, ttOk := getField("trust_type")
 				if !ttOk || toString(tt) == "" {
-					return nil, fmt.Errorf("trust account must have trust_type set")
+					return nil, constraintErrorf("trust_type", "trust account must have trust_type set")
 				}
 			}`)
 
@@ -1359,14 +1659,14 @@ func validate%sConstraints() ent.Hook {
 			// Payment/refund/nsf entries require a person (person edge)
 			if entryType == "payment" || entryType == "refund" || entryType == "nsf" {
 				if len(m.AddedIDs("person")) == 0 && m.Op().Is(ent.OpCreate) {
-					return nil, fmt.Errorf("%s ledger entry must have person set", entryType)
+					return nil, constraintErrorf("person_id", "%s ledger entry must have person set", entryType)
 				}
 			}
 
 			// Charge/late_fee entries require a lease (lease edge)
 			if entryType == "charge" || entryType == "late_fee" {
 				if len(m.AddedIDs("lease")) == 0 && m.Op().Is(ent.OpCreate) {
-					return nil, fmt.Errorf("%s ledger entry must have lease set", entryType)
+					return nil, constraintErrorf("lease_id", "%s ledger entry must have lease set", entryType)
 				}
 			}
 
@@ -1375,7 +1675,7 @@ func validate%sConstraints() ent.Hook {
 				aeid, aeidOk := getField("adjusts_entry_id")
 				if !aeidOk || toString(aeid) == "" {
 					if m.Op().Is(ent.OpCreate) {
-						return nil, fmt.Errorf("adjustment ledger entry must have adjusts_entry_id set")
+						return nil, constraintErrorf("adjusts_entry_id", "adjustment ledger entry must have adjusts_entry_id set")
 					}
 				}
 			}
@@ -1385,10 +1685,10 @@ func validate%sConstraints() ent.Hook {
 				if b, isBool := toBool(v); isBool && b {
 					rid, ridOk := getField("reconciliation_id")
 					if !ridOk || toString(rid) == "" {
-						return nil, fmt.Errorf("reconciled ledger entry must have reconciliation_id set")
+						return nil, constraintErrorf("reconciliation_id", "reconciled ledger entry must have reconciliation_id set")
 					}
 					if _, ok := getField("reconciled_at"); !ok && m.Op().Is(ent.OpCreate) {
-						return nil, fmt.Errorf("reconciled ledger entry must have reconciled_at set")
+						return nil, constraintErrorf("reconciled_at", "reconciled ledger entry must have reconciled_at set")
 					}
 				}
 			}`)
@@ -1411,11 +1711,11 @@ func validate%sConstraints() ent.Hook {
 				ab, abOk := getField("approved_by")
 				if !abOk || toString(ab) == "" {
 					if m.Op().Is(ent.OpCreate) {
-						return nil, fmt.Errorf("posted manual journal entry must have approved_by set")
+						return nil, constraintErrorf("approved_by", "posted manual journal entry must have approved_by set")
 					}
 				}
 				if _, ok := getField("approved_at"); !ok && m.Op().Is(ent.OpCreate) {
-					return nil, fmt.Errorf("posted manual journal entry must have approved_at set")
+					return nil, constraintErrorf("approved_at", "posted manual journal entry must have approved_at set")
 				}
 			}
 
@@ -1424,7 +1724,7 @@ func validate%sConstraints() ent.Hook {
 				rbj, rbjOk := getField("reversed_by_journal_id")
 				if !rbjOk || toString(rbj) == "" {
 					if m.Op().Is(ent.OpCreate) {
-						return nil, fmt.Errorf("voided journal entry must have reversed_by_journal_id set")
+						return nil, constraintErrorf("reversed_by_journal_id", "voided journal entry must have reversed_by_journal_id set")
 					}
 				}
 			}`)
@@ -1445,13 +1745,40 @@ func generateSchema(projectRoot string, ent *entityDef) error {
 	var buf bytes.Buffer
 
 	tmpl, err := template.New("schema").Funcs(template.FuncMap{
-		"toSnake":   toSnake,
-		"toPascal":  toPascal,
-		"toCamel":   toCamel,
+		"toSnake":           toSnake,
+		"toPascal":          toPascal,
+		"toCamel":           toCamel,
+		"doc":               fieldDocAnnotation,
+		"comment":           fieldComment,
+		"currencyDefault":   currencyDefault,
+		"currencyValidator": currencyValidator,
+		"floatBounds":       floatBounds,
+		"needsFmt": func(ent *entityDef) bool {
+			if ent.HasConstraints {
+				return true
+			}
+			for _, f := range ent.Fields {
+				if len(f.Currencies) > 0 || needsFloatValidate(f) {
+					return true
+				}
+			}
+			return false
+		},
 		"hasJSON":    func(fields []fieldDef) bool { return fieldsHaveType(fields, "JSON") },
 		"hasMoney":   func(fields []fieldDef) bool { return fieldsHaveType(fields, "Money") },
 		"hasEnum":    func(fields []fieldDef) bool { return fieldsHaveType(fields, "Enum") },
 		"hasTime":    func(fields []fieldDef) bool { return fieldsHaveType(fields, "Time") },
+		"needsEntSQL": func(ent *entityDef) bool {
+			if ent.Table != "" {
+				return true
+			}
+			for _, e := range ent.Edges {
+				if e.Cascade {
+					return true
+				}
+			}
+			return false
+		},
 		"needsRegexp": func(fields []fieldDef) bool {
 			for _, f := range fields {
 				if f.EntType == "Money" || f.MatchPattern != "" {
@@ -1476,6 +1803,7 @@ func generateSchema(projectRoot string, ent *entityDef) error {
 			}
 			return false
 		},
+		"transitionGuards": renderTransitionGuards,
 		"sortedStates": func(m map[string][]string) []string {
 			keys := make([]string, 0, len(m))
 			for k := range m {
@@ -1510,6 +1838,454 @@ func generateSchema(projectRoot string, ent *entityDef) error {
 	return nil
 }
 
+// currencyDefault is the default currency of a Money field: USD unless an
+// @currencies set excludes it, in which case the first listed code.
+func currencyDefault(f fieldDef) string {
+	if len(f.Currencies) == 0 || slices.Contains(f.Currencies, "USD") {
+		return "USD"
+	}
+	return f.Currencies[0]
+}
+
+// currencyValidator renders a .Validate restricting a Money field's currency
+// to its @currencies set. Without a set the ISO 4217 pattern alone applies.
+func currencyValidator(f fieldDef) string {
+	if len(f.Currencies) == 0 {
+		return ""
+	}
+	quoted := make([]string, len(f.Currencies))
+	for i, c := range f.Currencies {
+		quoted[i] = strconv.Quote(c)
+	}
+	return fmt.Sprintf(`.Validate(func(s string) error {
+			switch s {
+			case %s:
+				return nil
+			}
+			return fmt.Errorf("%s_currency %%q is not one of %s", s)
+		})`, strings.Join(quoted, ", "), f.Name, strings.Join(f.Currencies, ", "))
+}
+
+// fieldComment renders .Comment for a described field so Ent carries the CUE
+// doc comment onto the generated struct field. Money fields keep their fixed
+// per-column comments.
+func fieldComment(f fieldDef) string {
+	if f.Description == "" {
+		return ""
+	}
+	return fmt.Sprintf(".Comment(%q)", f.Description)
+}
+
+// openAPIFormats maps Ent field types to the OpenAPI format of their values.
+var openAPIFormats = map[string]string{
+	"Int":     "int32",
+	"Float64": "double",
+	"Time":    "date-time",
+	"UUID":    "uuid",
+}
+
+// fieldDocLiteral renders the FieldDoc composite literal for f, or "" when
+// the field has nothing to record.
+func fieldDocLiteral(f fieldDef) string {
+	var parts []string
+	if f.Description != "" && f.EntType == "Money" {
+		parts = append(parts, fmt.Sprintf("Description: %q", f.Description))
+	}
+	if format := openAPIFormats[f.EntType]; format != "" {
+		parts = append(parts, fmt.Sprintf("Format: %q", format))
+	}
+	if f.EntType == "Enum" && len(f.EnumValues) > 0 {
+		parts = append(parts, fmt.Sprintf("EnumValues: %#v", f.EnumValues))
+	}
+	if f.Deprecated {
+		parts = append(parts, "Deprecated: true")
+		if f.DeprecatedReason != "" {
+			parts = append(parts, fmt.Sprintf("DeprecatedReason: %q", f.DeprecatedReason))
+		}
+		if f.DeprecatedSince != "" {
+			parts = append(parts, fmt.Sprintf("DeprecatedSince: %q", f.DeprecatedSince))
+		}
+	}
+	if len(parts) == 0 {
+		return ""
+	}
+	return "FieldDoc{" + strings.Join(parts, ", ") + "}"
+}
+
+// fieldDocAnnotation renders the FieldDoc annotation for a field that has
+// a description or OpenAPI hints.
+func fieldDocAnnotation(f fieldDef) string {
+	lit := fieldDocLiteral(f)
+	if lit == "" {
+		return ""
+	}
+	return ".Annotations(" + lit + ")"
+}
+
+// generateFieldDocs writes ent/schema/field_docs.go: the FieldDoc annotation
+// type, the FieldDocs map and the Schemas map. Descriptions, formats, enum
+// values and deprecations are captured from CUE once, here, and openapigen
+// reads FieldDocs and the fields' .Comment through Schemas instead of
+// classifying fields itself.
+func generateFieldDocs(projectRoot string, entities map[string]*entityDef) error {
+	var buf bytes.Buffer
+	buf.WriteString(`// Code generated by cmd/entgen from CUE ontology. DO NOT EDIT.
+package schema
+
+import "entgo.io/ent"
+
+// FieldDoc is an Ent field annotation carrying the OpenAPI hints derived from
+// a field's CUE type and attributes. A field's CUE doc comment is its
+// .Comment, except on Money fields, whose columns keep fixed comments and
+// whose doc comment is recorded as Description.
+type FieldDoc struct {
+	Description      string // Money fields only
+	Format           string // OpenAPI format, e.g. "date-time"
+	EnumValues       []string
+	Deprecated       bool
+	DeprecatedReason string
+	DeprecatedSince  string
+}
+
+// Name implements the schema.Annotation interface.
+func (FieldDoc) Name() string { return "FieldDoc" }
+
+// FieldDocs maps entity name to CUE field name to the field's FieldDoc. It
+// holds the same values as the FieldDoc annotations and is the source of
+// formats, enum values and deprecations in the OpenAPI spec.
+var FieldDocs = map[string]map[string]FieldDoc{
+`)
+	names := make([]string, 0, len(entities))
+	for name := range entities {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		var docs []string
+		for _, f := range entities[name].Fields {
+			if lit := fieldDocLiteral(f); lit != "" {
+				docs = append(docs, fmt.Sprintf("\t\t%q: %s,\n", f.Name, lit))
+			}
+		}
+		if len(docs) == 0 {
+			continue
+		}
+		fmt.Fprintf(&buf, "\t%q: {\n", name)
+		for _, d := range docs {
+			buf.WriteString(d)
+		}
+		buf.WriteString("\t},\n")
+	}
+	buf.WriteString("}\n")
+
+	buf.WriteString(`
+// Schemas maps entity name to its Ent schema, so tools can read field
+// descriptors such as the .Comment holding a CUE doc comment.
+var Schemas = map[string]ent.Interface{
+`)
+	for _, name := range names {
+		fmt.Fprintf(&buf, "\t%q: %s{},\n", name, name)
+	}
+	buf.WriteString("}\n")
+
+	formatted, err := format.Source(buf.Bytes())
+	if err != nil {
+		return fmt.Errorf("formatting field docs: %w", err)
+	}
+	outPath := filepath.Join(projectRoot, "ent", "schema", "field_docs.go")
+	return os.WriteFile(outPath, formatted, 0644)
+}
+
+// constraintErrorSource is written to ent/schema/constraint_error.go when at
+// least one entity has cross-field constraint hooks.
+const constraintErrorSource = `// Code generated by cmd/entgen from CUE ontology. DO NOT EDIT.
+package schema
+
+import "fmt"
+
+// ConstraintError is returned by the cross-field constraint hooks. Field is
+// the request member the client must change, e.g. move_in_date for "active
+// lease must have move_in_date set".
+type ConstraintError struct {
+	Field string
+	Msg   string
+}
+
+func (e *ConstraintError) Error() string { return e.Msg }
+
+// constraintErrorf builds a ConstraintError for field with a formatted message.
+func constraintErrorf(field, format string, args ...any) error {
+	return &ConstraintError{Field: field, Msg: fmt.Sprintf(format, args...)}
+}
+`
+
+// generateConstraintError writes ent/schema/constraint_error.go, the
+// ConstraintError type every constraint hook returns.
+func generateConstraintError(projectRoot string, entities map[string]*entityDef) error {
+	outPath := filepath.Join(projectRoot, "ent", "schema", "constraint_error.go")
+	for _, ent := range entities {
+		if ent.HasConstraints {
+			return os.WriteFile(outPath, []byte(constraintErrorSource), 0644)
+		}
+	}
+	if err := os.Remove(outPath); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+// entityMeta is the gen/meta/{entity}.json sidecar: the field classification
+// entgen derived from CUE, for tools that would otherwise re-parse it.
+type entityMeta struct {
+	Entity    string      `json:"entity"`
+	Immutable bool        `json:"immutable,omitempty"`
+	Fields    []fieldMeta `json:"fields"`
+}
+
+// fieldMeta describes one field of an entityMeta sidecar.
+type fieldMeta struct {
+	Name             string   `json:"name"`
+	Type             string   `json:"type"` // Ent field type, e.g. "String", "Money"
+	Format           string   `json:"format,omitempty"`
+	JSONType         string   `json:"json_type,omitempty"`
+	Optional         bool     `json:"optional,omitempty"`
+	Immutable        bool     `json:"immutable,omitempty"`
+	Sensitive        bool     `json:"sensitive,omitempty"`
+	EnumValues       []string `json:"enum_values,omitempty"`
+	Description      string   `json:"description,omitempty"`
+	Deprecated       bool     `json:"deprecated,omitempty"`
+	DeprecatedReason string   `json:"deprecated_reason,omitempty"`
+	DeprecatedSince  string   `json:"deprecated_since,omitempty"`
+}
+
+// generateMetaSidecars writes gen/meta/{entity}.json for every entity, with
+// the same field metadata as the FieldDoc annotations.
+func generateMetaSidecars(projectRoot string, entities map[string]*entityDef) error {
+	dir := filepath.Join(projectRoot, "gen", "meta")
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+	for _, ent := range entities {
+		meta := entityMeta{Entity: ent.Name, Immutable: ent.Immutable, Fields: []fieldMeta{}}
+		for _, f := range ent.Fields {
+			fm := fieldMeta{
+				Name:             f.Name,
+				Type:             f.EntType,
+				Format:           openAPIFormats[f.EntType],
+				JSONType:         f.JSONType,
+				Optional:         f.Optional,
+				Immutable:        f.Immutable,
+				Sensitive:        f.Sensitive,
+				Description:      f.Description,
+				Deprecated:       f.Deprecated,
+				DeprecatedReason: f.DeprecatedReason,
+				DeprecatedSince:  f.DeprecatedSince,
+			}
+			if f.EntType == "Enum" {
+				fm.EnumValues = f.EnumValues
+			}
+			meta.Fields = append(meta.Fields, fm)
+		}
+		data, err := json.MarshalIndent(meta, "", "  ")
+		if err != nil {
+			return fmt.Errorf("encoding %s metadata: %w", ent.Name, err)
+		}
+		outPath := filepath.Join(dir, toSnake(ent.Name)+".json")
+		if err := os.WriteFile(outPath, append(data, '\n'), 0644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// softDeleteMixinSource is written to ent/schema/soft_delete_mixin.go when at
+// least one entity is marked @soft_delete().
+const softDeleteMixinSource = `// Code generated by cmd/entgen from CUE ontology. DO NOT EDIT.
+package schema
+
+import (
+	"context"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/mixin"
+	"github.com/matthewbaird/ontology/ent/intercept"
+)
+
+// SoftDeleteMixin marks rows deleted with a deleted_at timestamp instead of
+// removing them, and hides deleted rows from every query on the entity.
+type SoftDeleteMixin struct {
+	mixin.Schema
+}
+
+// Fields of the SoftDeleteMixin.
+func (SoftDeleteMixin) Fields() []ent.Field {
+	return []ent.Field{
+		field.Time("deleted_at").
+			Optional().
+			Nillable().
+			Comment("When the entity was soft-deleted; nil while it is live"),
+	}
+}
+
+type skipSoftDeleteKey struct{}
+
+// SkipSoftDelete returns a context whose queries also see soft-deleted rows.
+func SkipSoftDelete(parent context.Context) context.Context {
+	return context.WithValue(parent, skipSoftDeleteKey{}, true)
+}
+
+// Interceptors of the SoftDeleteMixin.
+func (SoftDeleteMixin) Interceptors() []ent.Interceptor {
+	return []ent.Interceptor{
+		intercept.TraverseFunc(func(ctx context.Context, q intercept.Query) error {
+			if skip, _ := ctx.Value(skipSoftDeleteKey{}).(bool); skip {
+				return nil
+			}
+			q.WhereP(sql.FieldIsNull("deleted_at"))
+			return nil
+		}),
+	}
+}
+`
+
+// generateSoftDeleteMixin writes the SoftDeleteMixin when any entity uses
+// @soft_delete(), and removes a stale copy when none does.
+func generateSoftDeleteMixin(projectRoot string, entities map[string]*entityDef) error {
+	outPath := filepath.Join(projectRoot, "ent", "schema", "soft_delete_mixin.go")
+	for _, ent := range entities {
+		if ent.SoftDelete {
+			return os.WriteFile(outPath, []byte(softDeleteMixinSource), 0644)
+		}
+	}
+	if err := os.Remove(outPath); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+// transitionGuardSource is written to ent/schema/transition_guard.go when at
+// least one entity has a state machine.
+const transitionGuardSource = `// Code generated by cmd/entgen from CUE ontology. DO NOT EDIT.
+package schema
+
+import (
+	"cmp"
+	"fmt"
+	"reflect"
+	"slices"
+)
+
+// TransitionGuard is a condition a state machine transition requires: the
+// entity's Field, as it will be after the transition, compared with Value by
+// Op. Value is a string, bool or float64.
+type TransitionGuard struct {
+	Field string
+	Op    string
+	Value any
+}
+
+// String renders the guard as "field op value".
+func (g TransitionGuard) String() string {
+	return fmt.Sprintf("%s %s %v", g.Field, g.Op, g.Value)
+}
+
+// ValidateTransitionWithGuard checks that current → target is in transitions
+// and, when guards has a guard for it, that the guard holds. value looks up a
+// field of the entity; ok is false when the field is unset.
+func ValidateTransitionWithGuard(transitions map[string][]string, guards map[string]map[string]TransitionGuard, current, target string, value func(field string) (v any, ok bool)) error {
+	allowed, ok := transitions[current]
+	if !ok {
+		return fmt.Errorf("unknown current state: %s", current)
+	}
+	if !slices.Contains(allowed, target) {
+		return fmt.Errorf("transition from %q to %q is not allowed", current, target)
+	}
+	g, ok := guards[current][target]
+	if !ok {
+		return nil
+	}
+	if v, ok := value(g.Field); !ok || !g.holds(v) {
+		return fmt.Errorf("transition from %q to %q requires %s", current, target, g)
+	}
+	return nil
+}
+
+// holds reports whether v satisfies the guard. An unset field only satisfies
+// "!=", and a value of the wrong kind satisfies nothing.
+func (g TransitionGuard) holds(v any) bool {
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Pointer && !rv.IsNil() {
+		rv = rv.Elem()
+	}
+	if !rv.IsValid() || rv.Kind() == reflect.Pointer {
+		return g.Op == "!="
+	}
+	var c int
+	switch want := g.Value.(type) {
+	case string:
+		if rv.Kind() != reflect.String {
+			return false
+		}
+		c = cmp.Compare(rv.String(), want)
+	case bool:
+		if rv.Kind() != reflect.Bool {
+			return false
+		}
+		if rv.Bool() != want {
+			c = 1
+		}
+	case float64:
+		var got float64
+		switch {
+		case rv.CanInt():
+			got = float64(rv.Int())
+		case rv.CanUint():
+			got = float64(rv.Uint())
+		case rv.CanFloat():
+			got = rv.Float()
+		default:
+			return false
+		}
+		c = cmp.Compare(got, want)
+	default:
+		return false
+	}
+	switch g.Op {
+	case "==":
+		return c == 0
+	case "!=":
+		return c != 0
+	case "<":
+		return c < 0
+	case "<=":
+		return c <= 0
+	case ">":
+		return c > 0
+	case ">=":
+		return c >= 0
+	}
+	return false
+}
+`
+
+// generateTransitionGuard writes ent/schema/transition_guard.go, the
+// TransitionGuard type and ValidateTransitionWithGuard shared by every
+// entity's {{Entity}}TransitionGuards.
+func generateTransitionGuard(projectRoot string, entities map[string]*entityDef) error {
+	outPath := filepath.Join(projectRoot, "ent", "schema", "transition_guard.go")
+	for _, ent := range entities {
+		if ent.HasMachine {
+			return os.WriteFile(outPath, []byte(transitionGuardSource), 0644)
+		}
+	}
+	if err := os.Remove(outPath); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
 func fieldsHaveType(fields []fieldDef, t string) bool {
 	for _, f := range fields {
 		if f.EntType == t {
@@ -1564,8 +2340,10 @@ const schemaTemplate = `// Code generated by cmd/entgen from CUE ontology. DO NO
 package schema
 
 import (
-	{{- if .HasConstraints}}
+	{{- if .HookCode}}
 	"context"
+	{{- end}}
+	{{- if needsFmt .}}
 	"fmt"
 	{{- end}}
 	{{- if needsJSON .Fields}}
@@ -1576,10 +2354,19 @@ import (
 	{{- end}}
 
 	"entgo.io/ent"
+	{{- if needsEntSQL .}}
+	"entgo.io/ent/dialect/entsql"
+	{{- end}}
+	{{- if .Table}}
+	"entgo.io/ent/schema"
+	{{- end}}
 	"entgo.io/ent/schema/field"
 	{{- if .Edges}}
 	"entgo.io/ent/schema/edge"
 	{{- end}}
+	{{- if .Indexes}}
+	"entgo.io/ent/schema/index"
+	{{- end}}
 	"entgo.io/ent/schema/mixin"
 	"github.com/google/uuid"
 	{{- if needsTypes .Fields}}
@@ -1594,11 +2381,23 @@ var _ mixin.Schema
 type {{.Name}} struct {
 	ent.Schema
 }
+{{- if .Table}}
+
+// Annotations of the {{.Name}}.
+func ({{.Name}}) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "{{.Table}}"},
+	}
+}
+{{- end}}
 
 // Mixin of the {{.Name}}.
 func ({{.Name}}) Mixin() []ent.Mixin {
 	return []ent.Mixin{
 		AuditMixin{},
+		{{- if .SoftDelete}}
+		SoftDeleteMixin{},
+		{{- end}}
 	}
 }
 
@@ -1608,27 +2407,30 @@ func ({{.Name}}) Fields() []ent.Field {
 		field.UUID("id", uuid.UUID{}).Default(uuid.New).Immutable().Comment("Primary key"),
 {{- range .Fields}}
 {{- if eq .EntType "Money"}}
-		field.Int64("{{.Name}}_amount_cents"){{if .Optional}}.Optional().Nillable(){{end}}{{if .Immutable}}.Immutable(){{end}}.Comment("{{.Name}} — amount in cents"),
-		field.String("{{.Name}}_currency"){{if .Optional}}.Optional().Nillable(){{end}}{{if .Immutable}}.Immutable(){{end}}.Default("USD").Match(regexp.MustCompile(` + "`" + `^[A-Z]{3}$` + "`" + `)).Comment("{{.Name}} — ISO 4217 currency code"),
+		field.Int64("{{.Name}}_amount_cents"){{if .Optional}}.Optional().Nillable(){{end}}{{if .Immutable}}.Immutable(){{end}}.Comment("{{.Name}} — amount in cents"){{doc .}},
+		field.String("{{.Name}}_currency"){{if .Optional}}.Optional().Nillable(){{end}}{{if .Immutable}}.Immutable(){{end}}.Default("{{currencyDefault .}}").Match(regexp.MustCompile(` + "`" + `^[A-Z]{3}$` + "`" + `)){{currencyValidator .}}.Comment("{{.Name}} — ISO 4217 currency code"){{doc .}},
 {{- else if eq .EntType "String"}}
-		field.String("{{.Name}}"){{if .Optional}}.Optional().Nillable(){{end}}{{if .NotEmpty}}.NotEmpty(){{end}}{{if .Sensitive}}.Sensitive(){{end}}{{if .Immutable}}.Immutable(){{end}}{{if .MatchPattern}}.Match(regexp.MustCompile(` + "`" + `{{.MatchPattern}}` + "`" + `)){{end}}.SchemaType(map[string]string{"postgres": "varchar"}),
+		field.String("{{.Name}}"){{if .Optional}}.Optional().Nillable(){{end}}{{if .NotEmpty}}.NotEmpty(){{end}}{{if .Unique}}.Unique(){{end}}{{if .Sensitive}}.Sensitive(){{end}}{{if .Immutable}}.Immutable(){{end}}{{if .MatchPattern}}.Match(regexp.MustCompile(` + "`" + `{{.MatchPattern}}` + "`" + `)){{end}}{{if .Default}}.Default({{.Default}}){{end}}.SchemaType(map[string]string{"postgres": "{{if .Text}}text{{else}}varchar{{end}}"}){{if .StorageKey}}.StorageKey("{{.StorageKey}}"){{end}}{{comment .}}{{doc .}},
 {{- else if eq .EntType "Int"}}
-		field.Int("{{.Name}}"){{if .Optional}}.Optional().Nillable(){{end}}{{if .Immutable}}.Immutable(){{end}}{{if .NonNegative}}.NonNegative(){{end}}{{if .Min}}.Min({{.Min}}){{end}}{{if .Max}}.Max({{.Max}}){{end}},
+		field.Int("{{.Name}}"){{if .Optional}}.Optional().Nillable(){{end}}{{if .Unique}}.Unique(){{end}}{{if .Immutable}}.Immutable(){{end}}{{if .Positive}}.Positive(){{else if .NonNegative}}.NonNegative(){{else if .Min}}.Min({{.Min}}){{end}}{{if .Max}}.Max({{.Max}}){{end}}{{if .Default}}.Default({{.Default}}){{end}}{{if .StorageKey}}.StorageKey("{{.StorageKey}}"){{end}}{{comment .}}{{doc .}},
 {{- else if eq .EntType "Int64"}}
-		field.Int64("{{.Name}}"){{if .Optional}}.Optional().Nillable(){{end}}{{if .Immutable}}.Immutable(){{end}},
+		field.Int64("{{.Name}}"){{if .Optional}}.Optional().Nillable(){{end}}{{if .Unique}}.Unique(){{end}}{{if .Immutable}}.Immutable(){{end}}{{if .StorageKey}}.StorageKey("{{.StorageKey}}"){{end}}{{comment .}}{{doc .}},
 {{- else if eq .EntType "Float64"}}
-		field.Float("{{.Name}}"){{if .Optional}}.Optional().Nillable(){{end}}{{if .Immutable}}.Immutable(){{end}},
+		field.Float("{{.Name}}"){{if .Optional}}.Optional().Nillable(){{end}}{{if .Immutable}}.Immutable(){{end}}{{floatBounds .}}{{if .Default}}.Default({{.Default}}){{end}}{{if .Decimal}}.SchemaType(map[string]string{"postgres": "{{.Decimal}}"}){{end}}{{if .StorageKey}}.StorageKey("{{.StorageKey}}"){{end}}{{comment .}}{{doc .}},
 {{- else if eq .EntType "Bool"}}
-		field.Bool("{{.Name}}"){{if .Optional}}.Optional().Nillable(){{end}}{{if .Immutable}}.Immutable(){{end}}{{if .Default}}.Default({{.Default}}){{end}},
+		field.Bool("{{.Name}}"){{if .Optional}}.Optional().Nillable(){{end}}{{if .Immutable}}.Immutable(){{end}}{{if .Default}}.Default({{.Default}}){{end}}{{if .StorageKey}}.StorageKey("{{.StorageKey}}"){{end}}{{comment .}}{{doc .}},
 {{- else if eq .EntType "Time"}}
-		field.Time("{{.Name}}"){{if .Optional}}.Optional().Nillable(){{end}}{{if .Immutable}}.Immutable(){{end}},
+		field.Time("{{.Name}}"){{if .Optional}}.Optional().Nillable(){{end}}{{if .Immutable}}.Immutable(){{end}}{{if .StorageKey}}.StorageKey("{{.StorageKey}}"){{end}}{{comment .}}{{doc .}},
 {{- else if eq .EntType "Enum"}}
-		field.Enum("{{.Name}}").Values({{range $i, $v := .EnumValues}}{{if $i}}, {{end}}"{{$v}}"{{end}}){{if .Optional}}.Optional().Nillable(){{end}}{{if .Immutable}}.Immutable(){{end}}{{if .Default}}.Default("{{.Default}}"){{end}},
+		field.Enum("{{.Name}}").Values({{range $i, $v := .EnumValues}}{{if $i}}, {{end}}"{{$v}}"{{end}}){{if .Optional}}.Optional().Nillable(){{end}}{{if .Immutable}}.Immutable(){{end}}{{if .Default}}.Default("{{.Default}}"){{end}}{{if .StorageKey}}.StorageKey("{{.StorageKey}}"){{end}}{{comment .}}{{doc .}},
 {{- else if eq .EntType "JSON"}}
-		field.JSON("{{.Name}}", {{.JSONType}}){{if .Optional}}.Optional(){{end}}{{if .Immutable}}.Immutable(){{end}},
+		field.JSON("{{.Name}}", {{.JSONType}}){{if .Optional}}.Optional(){{end}}{{if .Sensitive}}.Sensitive(){{end}}{{if .Immutable}}.Immutable(){{end}}{{if .StorageKey}}.StorageKey("{{.StorageKey}}"){{end}}{{comment .}}{{doc .}},
 {{- else if eq .EntType "UUID"}}
-		field.UUID("{{.Name}}", uuid.UUID{}){{if .Optional}}.Optional().Nillable(){{end}}{{if .Immutable}}.Immutable(){{end}},
+		field.UUID("{{.Name}}", uuid.UUID{}){{if .Optional}}.Optional().Nillable(){{end}}{{if .Immutable}}.Immutable(){{end}}{{if .StorageKey}}.StorageKey("{{.StorageKey}}"){{end}}{{comment .}}{{doc .}},
+{{- end}}
 {{- end}}
+{{- if .Versioned}}
+		field.Int("version").Default(1).NonNegative().Comment("Optimistic concurrency token; incremented on every update"),
 {{- end}}
 	}
 }
@@ -1639,7 +2441,7 @@ func ({{.Name}}) Edges() []ent.Edge {
 	return []ent.Edge{
 {{- range .Edges}}
 {{- if eq .Type "To"}}
-		edge.To("{{.Name}}", {{.Target}}.Type){{if .Unique}}.Unique(){{end}}{{if .Required}}.Required(){{end}}{{if .FieldBinding}}.Field("{{.FieldBinding}}"){{end}}.Comment("{{.Comment}}"),
+		edge.To("{{.Name}}", {{.Target}}.Type){{if .Unique}}.Unique(){{end}}{{if .Required}}.Required(){{end}}{{if .FieldBinding}}.Field("{{.FieldBinding}}"){{end}}{{if .Cascade}}.Annotations(entsql.OnDelete(entsql.Cascade)){{end}}.Comment("{{.Comment}}"),
 {{- else}}
 		edge.From("{{.Name}}", {{.Target}}.Type).Ref("{{.RefName}}"){{if .Unique}}.Unique(){{end}}{{if .Required}}.Required(){{end}}.Comment("{{.Comment}}"),
 {{- end}}
@@ -1649,6 +2451,21 @@ func ({{.Name}}) Edges() []ent.Edge {
 	return nil
 {{- end}}
 }
+{{- if .Indexes}}
+
+// Indexes of the {{.Name}}.
+func ({{.Name}}) Indexes() []ent.Index {
+	return []ent.Index{
+{{- range .Indexes}}
+{{- if .Fields}}
+		index.Fields({{range $i, $f := .Fields}}{{if $i}}, {{end}}"{{$f}}"{{end}}){{if .Edges}}.Edges({{range $i, $e := .Edges}}{{if $i}}, {{end}}"{{$e}}"{{end}}){{end}}{{if .Unique}}.Unique(){{end}},
+{{- else}}
+		index.Edges({{range $i, $e := .Edges}}{{if $i}}, {{end}}"{{$e}}"{{end}}){{if .Unique}}.Unique(){{end}},
+{{- end}}
+{{- end}}
+	}
+}
+{{- end}}
 {{- if .HasMachine}}
 
 // Valid{{.Name}}Transitions defines the allowed state machine transitions.
@@ -1658,9 +2475,14 @@ var Valid{{.Name}}Transitions = map[string][]string{
 	"{{$state}}": { {{- range $i, $target := index $.Machine $state}}{{if $i}}, {{end}}"{{$target}}"{{end}} },
 {{- end}}
 }
+
+// {{.Name}}TransitionGuards holds the conditions of guarded transitions, keyed
+// by source then target state. Transitions not listed here are unconditional.
+var {{.Name}}TransitionGuards = map[string]map[string]TransitionGuard{
+{{transitionGuards .Guards}}}
 {{- end}}
-{{- if .HasConstraints}}
-{{.ConstraintHookCode}}
+{{- if .HookCode}}
+{{.HookCode}}
 {{- end}}
 {{- if .Immutable}}
 
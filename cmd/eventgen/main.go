@@ -18,6 +18,8 @@ import (
 	"cuelang.org/go/cue"
 	"cuelang.org/go/cue/cuecontext"
 	"cuelang.org/go/cue/load"
+
+	"github.com/matthewbaird/ontology/internal/cueparse"
 )
 
 // DomainEvent is the canonical event structure emitted by Ent hooks.
@@ -82,7 +84,7 @@ func main() {
 
 			tIter, _ := toList.List()
 			for tIter.Next() {
-				toState, _ := tIter.Value().String()
+				toState, _ := cueparse.TransitionTarget(tIter.Value()).String()
 				if toState == "" {
 					continue
 				}
@@ -194,3 +196,4 @@ func findProjectRoot() string {
 		dir = parent
 	}
 }
+
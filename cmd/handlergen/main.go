@@ -5,6 +5,7 @@ package main
 
 import (
 	"bytes"
+	"flag"
 	"fmt"
 	"go/format"
 	"log"
@@ -16,6 +17,8 @@ import (
 	"cuelang.org/go/cue"
 	"cuelang.org/go/cue/cuecontext"
 	"cuelang.org/go/cue/load"
+
+	"github.com/matthewbaird/ontology/internal/cueparse"
 )
 
 // ─── Data types ──────────────────────────────────────────────────────────────
@@ -28,6 +31,11 @@ type fieldDef struct {
 	Default    string
 	Computed   bool   // @computed() — exclude from create and update
 	Immutable  bool   // @immutable() — exclude from update
+	Filterable bool   // @filterable() — list endpoint accepts ?<name>= equality filter
+	EnumValues []string
+	Min, Max   *cueparse.Bound // numeric bounds; for Money, of the amount in cents
+	Pattern    string          // =~ constraint of a string field
+	NotEmpty   bool            // !="" or strings.MinRunes(1) on a string field
 }
 
 type edgeDef struct {
@@ -40,15 +48,21 @@ type edgeDef struct {
 type edgeFK struct {
 	FieldName string // e.g. "unit_id"
 	EdgeName  string // e.g. "unit"
-	Target    string // e.g. "Unit"
-	Optional  bool
+	Target     string // e.g. "Unit"
+	Optional   bool
+	Filterable bool // @filterable() on the FK field
 }
 
 type entityInfo struct {
 	Name       string
 	Fields     []fieldDef
 	EdgeFKs    []edgeFK
+	Edges      []edgeDef // every Ent edge, in relationship order; drives ?include=
 	HasMachine bool
+	SoftDelete bool   // @soft_delete(): delete sets deleted_at instead of removing the row
+	Versioned  bool   // @versioned(): the version column, not updated_at, is the ETag
+	Immutable  bool   // #ImmutableEntity: never updated, so it carries no ETag
+	Search     string // display field matched by the list ?q= search; "" when it is not a string
 }
 
 type serviceDef struct {
@@ -66,28 +80,7 @@ type operationDef struct {
 	ToStatus    string
 	ExtraFields []string
 	Custom      bool
-}
-
-// ─── Known types ─────────────────────────────────────────────────────────────
-
-var moneyFieldNames = map[string]bool{
-	"#Money": true, "#NonNegativeMoney": true, "#PositiveMoney": true,
-}
-
-var knownValueTypes = map[string]string{
-	"#Money": "types.Money", "#NonNegativeMoney": "types.Money", "#PositiveMoney": "types.Money",
-	"#Address": "types.Address", "#ContactMethod": "types.ContactMethod",
-	"#DateRange": "types.DateRange", "#EntityRef": "types.EntityRef",
-	"#RentScheduleEntry": "types.RentScheduleEntry", "#RecurringCharge": "types.RecurringCharge",
-	"#LateFeePolicy": "types.LateFeePolicy", "#CAMTerms": "types.CAMTerms",
-	"#TenantImprovement": "types.TenantImprovement", "#RenewalOption": "types.RenewalOption",
-	"#SubsidyTerms": "types.SubsidyTerms", "#AccountDimensions": "types.AccountDimensions",
-	"#JournalLine": "types.JournalLine", "#RoleAttributes": "json.RawMessage",
-	"#TenantAttributes": "types.TenantAttributes", "#OwnerAttributes": "types.OwnerAttributes",
-	"#ManagerAttributes": "types.ManagerAttributes", "#GuarantorAttributes": "types.GuarantorAttributes",
-	"#UsageBasedCharge": "types.UsageBasedCharge", "#PercentageRent": "types.PercentageRent",
-	"#RentAdjustment": "types.RentAdjustment", "#ExpansionRight": "types.ExpansionRight",
-	"#ContractionRight": "types.ContractionRight", "#CAMCategoryTerms": "types.CAMCategoryTerms",
+	Paginated   bool // list only; false returns a bare array
 }
 
 // State machines are read from the unified #StateMachines map in CUE.
@@ -108,8 +101,10 @@ var goInitialisms = map[string]bool{
 
 // fieldAttrs holds cross-cutting metadata read from CUE @attr() annotations.
 type fieldAttrs struct {
-	computed  bool
-	immutable bool
+	computed   bool
+	immutable  bool
+	filterable bool
+	display    bool
 }
 
 // extractAttributes reads CUE field-level attributes from a value.
@@ -121,6 +116,12 @@ func extractAttributes(v cue.Value) fieldAttrs {
 	if a := v.Attribute("immutable"); a.Err() == nil {
 		fa.immutable = true
 	}
+	if a := v.Attribute("filterable"); a.Err() == nil {
+		fa.filterable = true
+	}
+	if a := v.Attribute("display"); a.Err() == nil {
+		fa.display = true
+	}
 	return fa
 }
 
@@ -140,238 +141,113 @@ func findProjectRoot() string {
 	}
 }
 
-func findReference(val cue.Value) string {
-	_, path := val.ReferencePath()
-	if path.String() != "" {
-		sels := path.Selectors()
-		if len(sels) > 0 {
-			return sels[len(sels)-1].String()
-		}
-	}
-	op, args := val.Expr()
-	if op == cue.AndOp || op == cue.OrOp {
-		for _, a := range args {
-			if r := findReference(a); r != "" {
-				return r
-			}
-		}
-	}
-	if op == cue.SelectorOp && len(args) >= 2 {
-		if s, err := args[1].String(); err == nil && s == "Time" {
-			return "time.Time"
-		}
-	}
-	return ""
-}
-
-func isTimeField(val cue.Value) bool {
-	r := findReference(val)
-	return r == "time.Time" || r == "Time"
-}
-
-func isEnum(val cue.Value) bool {
-	op, args := findEnumDisjunction(val)
-	if op != cue.OrOp || len(args) < 2 {
-		return false
-	}
-	for _, a := range args {
-		check := a
-		aOp, aArgs := a.Expr()
-		if aOp == cue.SelectorOp && len(aArgs) > 0 {
-			check = aArgs[0]
-		}
-		if check.IncompleteKind() != cue.StringKind {
-			return false
-		}
-		if _, err := check.String(); err != nil {
-			if d, ok := check.Default(); ok {
-				if _, err := d.String(); err != nil {
-					return false
-				}
-			} else {
-				return false
-			}
-		}
-	}
-	return true
-}
-
-// findEnumDisjunction extracts the OrOp disjunction from a value that may be
-// wrapped in an AndOp (e.g., when a field from an embedded base type is
-// unified with enum values in the entity).
-func findEnumDisjunction(val cue.Value) (cue.Op, []cue.Value) {
-	op, args := val.Expr()
-	if op == cue.OrOp {
-		return op, args
-	}
-	if op == cue.AndOp {
-		for _, arg := range args {
-			argOp, argArgs := arg.Expr()
-			if argOp == cue.OrOp {
-				return argOp, argArgs
-			}
-		}
-	}
-	return op, args
-}
-
-func inferKindFromExpr(val cue.Value) cue.Kind {
-	op, args := val.Expr()
-	if op == cue.AndOp || op == cue.OrOp {
-		for _, a := range args {
-			if k := a.IncompleteKind(); k != cue.BottomKind {
-				return k
-			}
-			if k := inferKindFromExpr(a); k != cue.BottomKind {
-				return k
-			}
-		}
-	}
-	return cue.BottomKind
-}
-
-// inferListElementKind attempts to determine the element kind of a CUE list
-// by walking its expression tree. For lists like [...("A" | "B")], the element
-// constraint may not be reachable via LookupPath when the value is an
-// intersection (AndOp) of list constraints from conditional blocks.
-func inferListElementKind(val cue.Value) cue.Kind {
-	// Walk expression arguments looking for resolvable list elements
-	op, args := val.Expr()
-	if op == cue.AndOp || op == cue.OrOp {
-		for _, a := range args {
-			// Try element lookup on each arg
-			elem := a.LookupPath(cue.MakePath(cue.AnyIndex))
-			if elem.Err() == nil {
-				k := elem.IncompleteKind()
-				if k != cue.BottomKind {
-					return k
-				}
-			}
-			// Recurse
-			if k := inferListElementKind(a); k != cue.BottomKind {
-				return k
-			}
-		}
-	}
-	return cue.BottomKind
-}
-
-func classifyField(name string, val cue.Value, optional bool) *fieldDef {
-	fd := &fieldDef{Name: name, Optional: optional}
+// classifyField maps a classified CUE field to its Ent type. It returns nil
+// for fields the handlers do not read or write.
+func classifyField(cf cueparse.Field) *fieldDef {
+	fd := &fieldDef{Name: cf.Name, Optional: cf.Optional}
 
-	if isTimeField(val) {
+	switch cf.Kind {
+	case cueparse.Time:
 		fd.EntType = "Time"
 		return fd
-	}
-
-	ref := findReference(val)
-	if ref != "" {
-		if moneyFieldNames[ref] {
-			return &fieldDef{Name: name, EntType: "Money", Optional: optional}
-		}
-		if goType, ok := knownValueTypes[ref]; ok {
-			fd.EntType = "JSON"
-			if val.IncompleteKind() == cue.ListKind {
-				fd.JSONType = "[]" + goType
-			} else {
-				fd.JSONType = goType
-			}
-			return fd
-		}
-	}
-
-	if val.IncompleteKind() == cue.ListKind || inferKindFromExpr(val) == cue.ListKind {
+	case cueparse.Money:
+		fd.EntType = "Money"
+		fd.Min, fd.Max = cueparse.NumericBounds(cf.Value.LookupPath(cue.ParsePath("amount_cents")))
+		return fd
+	case cueparse.ValueType:
+		goType := cueparse.ValueTypes[cf.Ref]
 		fd.EntType = "JSON"
-		elem := val.LookupPath(cue.MakePath(cue.AnyIndex))
-		if elem.Err() == nil {
-			eRef := findReference(elem)
-			if eRef != "" {
-				if goType, ok := knownValueTypes[eRef]; ok {
-					fd.JSONType = "[]" + goType
-					return fd
-				}
-			}
-			if elem.IncompleteKind() == cue.StringKind || isEnum(elem) {
-				fd.JSONType = "[]string"
-				return fd
-			}
+		if cf.List {
+			fd.JSONType = "[]" + goType
+		} else {
+			fd.JSONType = goType
 		}
-		// Fallback: walk the expression tree to find element type
-		if elemKind := inferListElementKind(val); elemKind == cue.StringKind {
+		return fd
+	case cueparse.List:
+		fd.EntType = "JSON"
+		if cf.ElemKind == cueparse.String {
 			fd.JSONType = "[]string"
-			return fd
+		} else {
+			fd.JSONType = "json.RawMessage"
 		}
-		fd.JSONType = "json.RawMessage"
 		return fd
-	}
-
-	if isEnum(val) {
+	case cueparse.Enum:
 		fd.EntType = "Enum"
-		if d, ok := val.Default(); ok {
+		fd.EnumValues = cf.EnumValues
+		if d, ok := cf.Value.Default(); ok {
 			if s, err := d.String(); err == nil {
 				fd.Default = s
 			}
 		}
 		return fd
-	}
-
-	kind := val.IncompleteKind()
-	if kind == cue.BottomKind {
-		kind = inferKindFromExpr(val)
-	}
-
-	switch kind {
-	case cue.StringKind:
+	case cueparse.String:
 		fd.EntType = "String"
-	case cue.IntKind:
+		fd.Pattern = cueparse.Pattern(cf.Value)
+		fd.NotEmpty = cueparse.NonEmpty(cf.Value)
+	case cueparse.Int:
 		fd.EntType = "Int"
-	case cue.FloatKind, cue.NumberKind:
+		fd.Min, fd.Max = cueparse.NumericBounds(cf.Value)
+	case cueparse.Float:
 		fd.EntType = "Float64"
-	case cue.BoolKind:
+		fd.Min, fd.Max = cueparse.NumericBounds(cf.Value)
+	case cueparse.Bool:
 		fd.EntType = "Bool"
 	default:
 		return nil
 	}
+	// A CUE default becomes an Ent default, so create may omit the field.
+	if fd.EntType != "Bool" {
+		if d, ok := cf.Value.Default(); ok {
+			fd.Default = fmt.Sprint(d)
+		}
+	}
 	return fd
 }
 
 func parseEntities(val cue.Value) map[string]*entityInfo {
 	entities := make(map[string]*entityInfo)
-	iter, _ := val.Fields(cue.Definitions(true))
-	for iter.Next() {
-		label := iter.Selector().String()
-		defVal := iter.Value()
-		if defVal.LookupPath(cue.ParsePath("id")).Err() != nil {
-			continue
-		}
-		if defVal.LookupPath(cue.ParsePath("audit")).Err() != nil {
-			continue
-		}
-		name := strings.TrimPrefix(label, "#")
-		// Skip base entity types — not domain entities
-		if name == "BaseEntity" || name == "StatefulEntity" || name == "ImmutableEntity" {
-			continue
-		}
-		ent := &entityInfo{Name: name}
-		fIter, _ := defVal.Fields(cue.Optional(true))
-		for fIter.Next() {
-			fLabel := strings.TrimSuffix(fIter.Selector().String(), "?")
-			if fLabel == "id" || fLabel == "audit" || strings.HasPrefix(fLabel, "_") {
-				continue
-			}
-			fd := classifyField(fLabel, fIter.Value(), fIter.IsOptional())
+	for _, e := range cueparse.Entities(val) {
+		ent := &entityInfo{Name: e.Name}
+		if a := e.Value.Attribute("soft_delete"); a.Err() == nil {
+			ent.SoftDelete = true
+		}
+		if a := e.Value.Attribute("versioned"); a.Err() == nil {
+			ent.Versioned = true
+		}
+		ent.Immutable = hasHiddenField(e.Value, "_immutable")
+		display := ""
+		for _, cf := range e.Fields {
+			fd := classifyField(cf)
 			if fd != nil {
-				attrs := extractAttributes(fIter.Value())
+				attrs := extractAttributes(cf.Value)
 				fd.Computed = attrs.computed
 				fd.Immutable = attrs.immutable
+				fd.Filterable = attrs.filterable
+				if attrs.display && display == "" {
+					display = cf.Name
+				}
 				ent.Fields = append(ent.Fields, *fd)
 			}
 		}
-		entities[name] = ent
+		ent.Search = searchField(ent, display)
+		entities[e.Name] = ent
 	}
 	return entities
 }
 
+// hasHiddenField reports whether v declares the hidden field name, such as the
+// _immutable marker #ImmutableEntity embeds. cue.ParsePath cannot address
+// hidden fields without their package, so the fields are scanned instead.
+func hasHiddenField(v cue.Value, name string) bool {
+	iter, _ := v.Fields(cue.Hidden(true))
+	for iter.Next() {
+		if iter.Selector().String() == name {
+			return true
+		}
+	}
+	return false
+}
+
 func parseRelationships(val cue.Value, entities map[string]*entityInfo) {
 	relList := val.LookupPath(cue.ParsePath("relationships"))
 	if relList.Err() != nil {
@@ -393,6 +269,7 @@ func parseRelationships(val cue.Value, entities map[string]*entityInfo) {
 			if cardinality == "O2O" || cardinality == "M2O" {
 				e.Unique = true
 			}
+			ent.Edges = append(ent.Edges, e)
 			ent.EdgeFKs = appendEdge(ent.EdgeFKs, ent.Fields, e)
 		}
 		if ent, ok := entities[to]; ok {
@@ -406,6 +283,9 @@ func parseRelationships(val cue.Value, entities map[string]*entityInfo) {
 			case "M2M":
 				e.Type = "From"
 			}
+			if e.Name != "" {
+				ent.Edges = append(ent.Edges, e)
+			}
 			ent.EdgeFKs = appendEdge(ent.EdgeFKs, ent.Fields, e)
 		}
 	}
@@ -448,10 +328,11 @@ func appendEdge(fks []edgeFK, fields []fieldDef, e edgeDef) []edgeFK {
 					}
 				}
 				fks = append(fks, edgeFK{
-					FieldName: f.Name,
-					EdgeName:  e.Name,
-					Target:    e.Target,
-					Optional:  f.Optional,
+					FieldName:  f.Name,
+					EdgeName:   e.Name,
+					Target:     e.Target,
+					Optional:   f.Optional,
+					Filterable: f.Filterable,
 				})
 				return fks
 			}
@@ -511,6 +392,10 @@ func parseServices(ctx *cue.Context, projectRoot string) []serviceDef {
 			op.Action, _ = o.LookupPath(cue.ParsePath("action")).String()
 			op.ToStatus, _ = o.LookupPath(cue.ParsePath("to_status")).String()
 			op.Custom, _ = o.LookupPath(cue.ParsePath("custom")).Bool()
+			op.Paginated = true
+			if p, err := o.LookupPath(cue.ParsePath("paginated")).Bool(); err == nil {
+				op.Paginated = p
+			}
 			efList := o.LookupPath(cue.ParsePath("extra_fields"))
 			if efList.Err() == nil {
 				efIter, _ := efList.List()
@@ -579,6 +464,7 @@ func generateHandlerFile(projectRoot string, svc serviceDef, entities map[string
 	needTypes := false
 	needSchema := false
 	needJSON := false
+	needRegexp := false
 
 	// Pre-scan what we need
 	for _, entName := range svc.Entities {
@@ -590,7 +476,26 @@ func generateHandlerFile(projectRoot string, svc serviceDef, entities map[string
 		if len(ent.EdgeFKs) > 0 {
 			needUUID = true
 		}
+		if ent.SoftDelete {
+			needTime = true // time.Now() in the soft-delete handler
+		}
+		for _, efk := range ent.EdgeFKs {
+			if efk.Filterable {
+				entPkgs[entPkg(efk.Target)] = true // target.ID predicate in list filters
+			}
+		}
+		if hasGeneratedOp(svc.Operations, entName, "get") {
+			for _, e := range collectionEdges(ent) {
+				entPkgs[entPkg(e.Target)] = true // target.FieldCreatedAt in sub-resource lists
+			}
+		}
+		validated := hasGeneratedOp(svc.Operations, entName, "create") ||
+			hasGeneratedOp(svc.Operations, entName, "bulk") ||
+			hasGeneratedOp(svc.Operations, entName, "update")
 		for _, f := range ent.Fields {
+			if validated && f.Pattern != "" && !f.Computed {
+				needRegexp = true // compiled patterns checked by validate{Entity}Create/Update
+			}
 			if f.EntType == "Time" {
 				needTime = true
 			}
@@ -620,10 +525,14 @@ func generateHandlerFile(projectRoot string, svc serviceDef, entities map[string
 	buf.line("package handler")
 	buf.line("")
 	buf.line("import (")
+	buf.line("\t\"context\"")
 	if needJSON {
 		buf.line("\t\"encoding/json\"")
 	}
 	buf.line("\t\"net/http\"")
+	if needRegexp {
+		buf.line("\t\"regexp\"")
+	}
 	if needTime {
 		buf.line("\t\"time\"")
 	}
@@ -680,11 +589,13 @@ func generateHandlerFile(projectRoot string, svc serviceDef, entities map[string
 	buf.line("// %s implements HTTP handlers for %s entities.", handlerType, svc.Name)
 	buf.line("type %s struct {", handlerType)
 	buf.line("\tclient *ent.Client")
+	buf.line("\taudit  AuditRecorder")
 	buf.line("}")
 	buf.line("")
-	buf.line("// New%s creates a new %s.", handlerType, handlerType)
-	buf.line("func New%s(client *ent.Client) *%s {", handlerType, handlerType)
-	buf.line("\treturn &%s{client: client}", handlerType)
+	buf.line("// New%s creates a new %s. Mutations append to audit when it is", handlerType, handlerType)
+	buf.line("// non-nil; a nil recorder keeps no audit trail.")
+	buf.line("func New%s(client *ent.Client, audit AuditRecorder) *%s {", handlerType, handlerType)
+	buf.line("\treturn &%s{client: client, audit: audit}", handlerType)
 	buf.line("}")
 	buf.line("")
 
@@ -729,7 +640,8 @@ func writeEntitySection(buf *cw, handlerType string, ent *entityInfo, pkg string
 	buf.line("")
 
 	// Find operation names
-	var createOp, getOp, listOp, updateOp string
+	var createOp, bulkOp, getOp, existsOp, listOp, updateOp, deleteOp string
+	var listPaginated bool
 	var transitions []operationDef
 	for _, op := range ops {
 		if op.Custom {
@@ -741,35 +653,72 @@ func writeEntitySection(buf *cw, handlerType string, ent *entityInfo, pkg string
 		switch op.Type {
 		case "create":
 			createOp = op.Name
+		case "bulk":
+			bulkOp = op.Name
 		case "get":
 			getOp = op.Name
+		case "exists":
+			existsOp = op.Name
 		case "list":
 			listOp = op.Name
+			listPaginated = op.Paginated
 		case "update":
 			updateOp = op.Name
+		case "delete":
+			deleteOp = op.Name
 		case "transition":
 			transitions = append(transitions, op)
 		}
 	}
 
-	if createOp != "" {
+	if createOp != "" || bulkOp != "" || updateOp != "" || len(transitions) > 0 {
+		writeEnumValues(buf, ent)
+	}
+	if createOp != "" || bulkOp != "" || updateOp != "" {
+		writePatterns(buf, ent)
+	}
+	if createOp != "" || bulkOp != "" {
 		writeCreateStruct(buf, ent, pkg)
+		writeValidate(buf, ent, false)
+		writeCreateApply(buf, ent, pkg)
+	}
+	if createOp != "" {
 		writeCreateHandler(buf, handlerType, ent, pkg, createOp)
 	}
+	if bulkOp != "" {
+		writeBulkCreateHandler(buf, handlerType, ent, pkg, bulkOp)
+	}
+
+	includesVar := ""
+	if (getOp != "" || listOp != "") && len(ent.Edges) > 0 {
+		includesVar = writeIncludes(buf, ent)
+	}
 
 	if getOp != "" {
-		writeGetHandler(buf, handlerType, ent, getOp)
+		writeGetHandler(buf, handlerType, ent, pkg, getOp, includesVar)
+		for _, e := range collectionEdges(ent) {
+			writeSubResourceHandler(buf, handlerType, ent, e)
+		}
+	}
+
+	if existsOp != "" {
+		writeExistsHandler(buf, handlerType, ent, pkg, existsOp)
 	}
 
 	if listOp != "" {
-		writeListHandler(buf, handlerType, ent, pkg, listOp)
+		writeListHandler(buf, handlerType, ent, pkg, listOp, includesVar, listPaginated)
 	}
 
 	if updateOp != "" {
 		writeUpdateStruct(buf, ent, pkg)
+		writeValidate(buf, ent, true)
 		writeUpdateHandler(buf, handlerType, ent, pkg, updateOp)
 	}
 
+	if deleteOp != "" {
+		writeDeleteHandler(buf, handlerType, ent, pkg, deleteOp)
+	}
+
 	if len(transitions) > 0 {
 		writeTransitionHelper(buf, handlerType, ent, pkg)
 		for _, tr := range transitions {
@@ -799,199 +748,952 @@ func writeCreateStruct(buf *cw, ent *entityInfo, pkg string) {
 	buf.line("")
 }
 
-func writeCreateHandler(buf *cw, handlerType string, ent *entityInfo, pkg, opName string) {
-	buf.line("func (h *%s) %s(w http.ResponseWriter, r *http.Request) {", handlerType, opName)
-	buf.line("\taudit, ok := parseAuditContext(w, r)")
-	buf.line("\tif !ok { return }")
-	buf.line("\tvar req create%sRequest", ent.Name)
-	buf.line("\tif err := decodeJSON(r, &req); err != nil {")
-	buf.line("\t\twriteError(w, http.StatusBadRequest, \"INVALID_JSON\", err.Error())")
-	buf.line("\t\treturn")
-	buf.line("\t}")
-	buf.line("\tbuilder := h.client.%s.Create()", ent.Name)
-
-	// Set fields
+// writeCreateApply emits the apply method that copies a validated create
+// request onto an Ent builder. It is shared by the single and bulk create
+// handlers; currencies and edge IDs have already passed validate, so it
+// cannot fail.
+func writeCreateApply(buf *cw, ent *entityInfo, pkg string) {
+	buf.line("func (req *create%sRequest) apply(builder *ent.%sCreate) {", ent.Name, ent.Name)
 	for _, f := range ent.Fields {
 		if f.Computed {
 			continue // @computed() fields are server-managed
 		}
 		writeCreateSetter(buf, f, pkg)
 	}
-	// Set edge FK fields
 	for _, efk := range ent.EdgeFKs {
 		writeEdgeFKSetter(buf, efk, false)
 	}
-
-	// Audit fields
-	buf.line("\tbuilder.SetCreatedBy(audit.Actor).SetUpdatedBy(audit.Actor).SetSource(%s.Source(audit.Source))", pkg)
-	buf.line("\tif audit.CorrelationID != nil {")
-	buf.line("\t\tbuilder.SetCorrelationID(*audit.CorrelationID)")
-	buf.line("\t}")
-	buf.line("\tresult, err := builder.Save(r.Context())")
-	buf.line("\tif err != nil {")
-	buf.line("\t\tentErrorToHTTP(w, err)")
-	buf.line("\t\treturn")
-	buf.line("\t}")
-	buf.line("\twriteJSON(w, http.StatusCreated, result)")
 	buf.line("}")
 	buf.line("")
 }
 
-// ─── Get ─────────────────────────────────────────────────────────────────────
+// writeCreateAudit emits the audit column setters for a create builder.
+func writeCreateAudit(buf *cw, indent, pkg string) {
+	buf.line("%sbuilder.SetCreatedBy(audit.Actor).SetUpdatedBy(audit.Actor).SetSource(%s.Source(audit.Source))", indent, pkg)
+	buf.line("%sif audit.CorrelationID != nil {", indent)
+	buf.line("%s\tbuilder.SetCorrelationID(*audit.CorrelationID)", indent)
+	buf.line("%s}", indent)
+}
 
-func writeGetHandler(buf *cw, handlerType string, ent *entityInfo, opName string) {
+func writeCreateHandler(buf *cw, handlerType string, ent *entityInfo, pkg, opName string) {
 	buf.line("func (h *%s) %s(w http.ResponseWriter, r *http.Request) {", handlerType, opName)
-	buf.line("\tid, ok := parseUUID(w, r, \"id\")")
+	writeHandlerContext(buf)
+	buf.line("\taudit, ok := parseAuditContext(w, r)")
 	buf.line("\tif !ok { return }")
-	buf.line("\tresult, err := h.client.%s.Get(r.Context(), id)", ent.Name)
+	buf.line("\tvar req create%sRequest", ent.Name)
+	buf.line("\tif err := decodeJSON(r, &req); err != nil {")
+	buf.line("\t\twriteError(w, http.StatusBadRequest, \"INVALID_JSON\", err.Error())")
+	buf.line("\t\treturn")
+	buf.line("\t}")
+	buf.line("\tif errs := validate%sCreate(&req); len(errs) > 0 {", ent.Name)
+	buf.line("\t\twriteFieldErrors(w, errs)")
+	buf.line("\t\treturn")
+	buf.line("\t}")
+	buf.line("\tbuilder := h.client.%s.Create()", ent.Name)
+	buf.line("\treq.apply(builder)")
+	writeCreateAudit(buf, "\t", pkg)
+	buf.line("\tresult, err := saveAudited[*ent.%s](ctx, h.client, h.audit, AuditOpCreate, audit, builder.Mutation())", ent.Name)
 	buf.line("\tif err != nil {")
 	buf.line("\t\tentErrorToHTTP(w, err)")
 	buf.line("\t\treturn")
 	buf.line("\t}")
-	buf.line("\twriteJSON(w, http.StatusOK, result)")
+	buf.line("\twriteJSON(w, http.StatusCreated, result)")
 	buf.line("}")
 	buf.line("")
 }
 
-// ─── List ────────────────────────────────────────────────────────────────────
-
-func writeListHandler(buf *cw, handlerType string, ent *entityInfo, pkg, opName string) {
+// writeBulkCreateHandler emits POST /{path}/bulk. Every item is validated
+// before the transaction opens so the client gets all malformed items at
+// once; inserts then run in one transaction that rolls back on any error.
+func writeBulkCreateHandler(buf *cw, handlerType string, ent *entityInfo, pkg, opName string) {
 	buf.line("func (h *%s) %s(w http.ResponseWriter, r *http.Request) {", handlerType, opName)
-	buf.line("\tpg := parsePagination(r)")
-	buf.line("\titems, err := h.client.%s.Query().", ent.Name)
-	buf.line("\t\tLimit(pg.Limit).Offset(pg.Offset).")
-	buf.line("\t\tOrder(ent.Desc(%s.FieldCreatedAt)).", pkg)
-	buf.line("\t\tAll(r.Context())")
+	writeHandlerContext(buf)
+	buf.line("\taudit, ok := parseAuditContext(w, r)")
+	buf.line("\tif !ok { return }")
+	buf.line("\tvar reqs []create%sRequest", ent.Name)
+	buf.line("\tif err := decodeJSON(r, &reqs); err != nil {")
+	buf.line("\t\twriteError(w, http.StatusBadRequest, \"INVALID_JSON\", err.Error())")
+	buf.line("\t\treturn")
+	buf.line("\t}")
+	buf.line("\tif !checkBulkSize(w, len(reqs)) { return }")
+	buf.line("\terrs := map[int]fieldErrors{}")
+	buf.line("\tfor i := range reqs {")
+	buf.line("\t\tif itemErrs := validate%sCreate(&reqs[i]); len(itemErrs) > 0 {", ent.Name)
+	buf.line("\t\t\terrs[i] = itemErrs")
+	buf.line("\t\t}")
+	buf.line("\t}")
+	buf.line("\tif len(errs) > 0 {")
+	buf.line("\t\twriteBulkErrors(w, errs)")
+	buf.line("\t\treturn")
+	buf.line("\t}")
+	buf.line("\ttx, err := h.client.Tx(ctx)")
 	buf.line("\tif err != nil {")
 	buf.line("\t\tentErrorToHTTP(w, err)")
 	buf.line("\t\treturn")
 	buf.line("\t}")
-	buf.line("\twriteJSON(w, http.StatusOK, items)")
+	buf.line("\tcreated := make([]*ent.%s, 0, len(reqs))", ent.Name)
+	buf.line("\tfor i := range reqs {")
+	buf.line("\t\tbuilder := tx.%s.Create()", ent.Name)
+	buf.line("\t\treqs[i].apply(builder)")
+	writeCreateAudit(buf, "\t\t", pkg)
+	buf.line("\t\tresult, err := builder.Save(ctx)")
+	buf.line("\t\tif err != nil {")
+	buf.line("\t\t\ttx.Rollback()")
+	buf.line("\t\t\tentErrorToHTTP(w, err)")
+	buf.line("\t\t\treturn")
+	buf.line("\t\t}")
+	buf.line("\t\tif h.audit != nil {")
+	buf.line("\t\t\tchanges, _ := auditChanges(ctx, builder.Mutation()) // create reads no old values")
+	buf.line("\t\t\tif err := appendAudit(ctx, tx, h.audit, AuditOpCreate, audit, builder.Mutation(), changes); err != nil {")
+	buf.line("\t\t\t\ttx.Rollback()")
+	buf.line("\t\t\t\tentErrorToHTTP(w, err)")
+	buf.line("\t\t\t\treturn")
+	buf.line("\t\t\t}")
+	buf.line("\t\t}")
+	buf.line("\t\tcreated = append(created, result)")
+	buf.line("\t}")
+	buf.line("\tif err := tx.Commit(); err != nil {")
+	buf.line("\t\twriteCommitError(w, err)")
+	buf.line("\t\treturn")
+	buf.line("\t}")
+	buf.line("\twriteJSON(w, http.StatusCreated, created)")
 	buf.line("}")
 	buf.line("")
 }
 
-// ─── Update ──────────────────────────────────────────────────────────────────
+// ─── Get ─────────────────────────────────────────────────────────────────────
 
-func writeUpdateStruct(buf *cw, ent *entityInfo, pkg string) {
-	buf.line("type update%sRequest struct {", ent.Name)
-	for _, f := range ent.Fields {
-		if f.Computed || f.Immutable {
-			continue // @computed() and @immutable() fields cannot be updated
+// writeIncludes emits the ?include= allowlist mapping each edge name to its
+// eager loader, and returns the name of the generated variable.
+func writeIncludes(buf *cw, ent *entityInfo) string {
+	varName := strings.ToLower(ent.Name[:1]) + ent.Name[1:] + "Includes"
+	buf.line("// %s maps ?include= edge names to eager loaders on a %s query.", varName, ent.Name)
+	buf.line("var %s = map[string]func(*ent.%sQuery){", varName, ent.Name)
+	seen := map[string]bool{}
+	for _, e := range ent.Edges {
+		if seen[e.Name] {
+			continue
 		}
-		writeStructField(buf, f, true)
-	}
-	for _, efk := range ent.EdgeFKs {
-		buf.line("\t%s *string `json:\"%s,omitempty\"`", entPascal(efk.FieldName), efk.FieldName)
+		seen[e.Name] = true
+		buf.line("\t%q: func(q *ent.%sQuery) { q.With%s() },", e.Name, ent.Name, entPascal(e.Name))
 	}
 	buf.line("}")
 	buf.line("")
+	return varName
 }
 
-func writeUpdateHandler(buf *cw, handlerType string, ent *entityInfo, pkg, opName string) {
+func writeGetHandler(buf *cw, handlerType string, ent *entityInfo, pkg, opName, includesVar string) {
 	buf.line("func (h *%s) %s(w http.ResponseWriter, r *http.Request) {", handlerType, opName)
+	writeHandlerContext(buf)
 	buf.line("\tid, ok := parseUUID(w, r, \"id\")")
 	buf.line("\tif !ok { return }")
-	buf.line("\taudit, ok := parseAuditContext(w, r)")
-	buf.line("\tif !ok { return }")
-	buf.line("\tvar req update%sRequest", ent.Name)
-	buf.line("\tif err := decodeJSON(r, &req); err != nil {")
-	buf.line("\t\twriteError(w, http.StatusBadRequest, \"INVALID_JSON\", err.Error())")
-	buf.line("\t\treturn")
-	buf.line("\t}")
-	buf.line("\tbuilder := h.client.%s.UpdateOneID(id)", ent.Name)
-
-	// Set fields
-	for _, f := range ent.Fields {
-		if f.Computed || f.Immutable {
-			continue // @computed() and @immutable() fields cannot be updated
-		}
-		writeUpdateSetter(buf, f, pkg)
-	}
-	// Set edge FK fields
-	for _, efk := range ent.EdgeFKs {
-		writeEdgeFKSetter(buf, efk, true)
+	if includesVar != "" {
+		buf.line("\tquery := h.client.%s.Query().Where(%s.ID(id))", ent.Name, pkg)
+		buf.line("\twithIncludes(r, query, %s)", includesVar)
+		buf.line("\tresult, err := query.Only(ctx)")
+	} else {
+		buf.line("\tresult, err := h.client.%s.Get(ctx, id)", ent.Name)
 	}
-
-	// Audit fields
-	buf.line("\tbuilder.SetUpdatedBy(audit.Actor).SetSource(%s.Source(audit.Source))", pkg)
-	buf.line("\tif audit.CorrelationID != nil {")
-	buf.line("\t\tbuilder.SetCorrelationID(*audit.CorrelationID)")
-	buf.line("\t}")
-	buf.line("\tresult, err := builder.Save(r.Context())")
 	buf.line("\tif err != nil {")
 	buf.line("\t\tentErrorToHTTP(w, err)")
 	buf.line("\t\treturn")
 	buf.line("\t}")
+	if !ent.Immutable {
+		buf.line("\tsetETag(w, %s)", etagExpr(ent, "result"))
+	}
 	buf.line("\twriteJSON(w, http.StatusOK, result)")
 	buf.line("}")
 	buf.line("")
 }
 
-// ─── Transitions ─────────────────────────────────────────────────────────────
+// writeHandlerContext opens a handler body with a request context bounded by
+// handlerTimeout; every database call in the handler uses ctx.
+func writeHandlerContext(buf *cw) {
+	buf.line("\tctx, cancel := context.WithTimeout(r.Context(), handlerTimeout)")
+	buf.line("\tdefer cancel()")
+}
 
-func writeTransitionHelper(buf *cw, handlerType string, ent *entityInfo, pkg string) {
-	buf.line("func (h *%s) transition%s(w http.ResponseWriter, r *http.Request, targetStatus string, applyExtra func(*ent.%sUpdateOne)) {", handlerType, ent.Name, ent.Name)
+// ─── Exists ──────────────────────────────────────────────────────────────────
+
+// writeExistsHandler emits HEAD /{path}/{id}: 200 when the row exists and 404
+// when it does not, with no body, so a client can check an ID without
+// loading the row.
+func writeExistsHandler(buf *cw, handlerType string, ent *entityInfo, pkg, opName string) {
+	buf.line("func (h *%s) %s(w http.ResponseWriter, r *http.Request) {", handlerType, opName)
+	writeHandlerContext(buf)
 	buf.line("\tid, ok := parseUUID(w, r, \"id\")")
 	buf.line("\tif !ok { return }")
-	buf.line("\taudit, ok := parseAuditContext(w, r)")
-	buf.line("\tif !ok { return }")
-	buf.line("\tcurrent, err := h.client.%s.Get(r.Context(), id)", ent.Name)
+	buf.line("\texists, err := h.client.%s.Query().Where(%s.ID(id)).Exist(ctx)", ent.Name, pkg)
 	buf.line("\tif err != nil {")
 	buf.line("\t\tentErrorToHTTP(w, err)")
 	buf.line("\t\treturn")
 	buf.line("\t}")
-	buf.line("\tif err := ValidateTransition(schema.Valid%sTransitions, string(current.Status), targetStatus); err != nil {", ent.Name)
-	buf.line("\t\twriteError(w, http.StatusConflict, \"INVALID_TRANSITION\", err.Error())")
+	buf.line("\tif !exists {")
+	buf.line("\t\tw.WriteHeader(http.StatusNotFound)")
 	buf.line("\t\treturn")
 	buf.line("\t}")
-	buf.line("\tbuilder := h.client.%s.UpdateOneID(id).", ent.Name)
-	buf.line("\t\tSetStatus(%s.Status(targetStatus)).", pkg)
-	buf.line("\t\tSetUpdatedBy(audit.Actor).")
-	buf.line("\t\tSetSource(%s.Source(audit.Source))", pkg)
-	buf.line("\tif audit.CorrelationID != nil {")
-	buf.line("\t\tbuilder.SetCorrelationID(*audit.CorrelationID)")
-	buf.line("\t}")
-	buf.line("\tif applyExtra != nil {")
-	buf.line("\t\tapplyExtra(builder)")
-	buf.line("\t}")
-	buf.line("\tupdated, err := builder.Save(r.Context())")
+	buf.line("\tw.WriteHeader(http.StatusOK)")
+	buf.line("}")
+	buf.line("")
+}
+
+// ─── List ────────────────────────────────────────────────────────────────────
+
+func writeListHandler(buf *cw, handlerType string, ent *entityInfo, pkg, opName, includesVar string, paginated bool) {
+	sortVar := writeSortColumns(buf, ent, pkg, opName)
+	buf.line("func (h *%s) %s(w http.ResponseWriter, r *http.Request) {", handlerType, opName)
+	writeHandlerContext(buf)
+	buf.line("\tpg := parsePagination(r)")
+	buf.line("\tquery := h.client.%s.Query()", ent.Name)
+	if hasListFilters(ent) {
+		writeListFilters(buf, ent, pkg)
+	}
+	// Conditional GET: polling clients that send If-Modified-Since get a 304
+	// when no matching row changed, before paying for the count and page.
+	// Only append-only entities qualify: a mutable entity's rows can be
+	// deleted, or updated out of the filter, without advancing the newest
+	// updated_at the list still matches.
+	if ent.Immutable {
+		buf.line("\tif r.Header.Get(\"If-Modified-Since\") != \"\" {")
+		buf.line("\t\tlastModified, err := latestUpdatedAt(ctx, query.Clone().Order(ent.Desc(%s.FieldUpdatedAt)).Limit(1).Select(%s.FieldUpdatedAt))", pkg, pkg)
+		buf.line("\t\tif err != nil {")
+		buf.line("\t\t\tentErrorToHTTP(w, err)")
+		buf.line("\t\t\treturn")
+		buf.line("\t\t}")
+		buf.line("\t\tif !checkIfModifiedSince(w, r, lastModified) {")
+		buf.line("\t\t\treturn")
+		buf.line("\t\t}")
+		buf.line("\t}")
+	}
+	if paginated {
+		// Count before paging so total reflects the same filter predicates.
+		buf.line("\ttotal, err := query.Clone().Count(ctx)")
+		buf.line("\tif err != nil {")
+		buf.line("\t\tentErrorToHTTP(w, err)")
+		buf.line("\t\treturn")
+		buf.line("\t}")
+	}
+	if includesVar != "" {
+		buf.line("\twithIncludes(r, query, %s)", includesVar)
+	}
+	buf.line("\titems, err := query.")
+	buf.line("\t\tLimit(pg.Limit).Offset(pg.Offset).")
+	buf.line("\t\tOrder(listOrder(r, %s, %s.FieldCreatedAt)).", sortVar, pkg)
+	buf.line("\t\tAll(ctx)")
 	buf.line("\tif err != nil {")
 	buf.line("\t\tentErrorToHTTP(w, err)")
 	buf.line("\t\treturn")
 	buf.line("\t}")
-	buf.line("\twriteJSON(w, http.StatusOK, updated)")
+	if paginated {
+		buf.line("\twriteJSON(w, http.StatusOK, listResponse{Data: items, Total: total, Offset: pg.Offset, Limit: pg.Limit})")
+	} else {
+		buf.line("\twriteJSON(w, http.StatusOK, items)")
+	}
 	buf.line("}")
 	buf.line("")
 }
 
-func writeTransitionMethod(buf *cw, handlerType string, ent *entityInfo, pkg string, op operationDef) {
-	buf.line("func (h *%s) %s(w http.ResponseWriter, r *http.Request) {", handlerType, op.Name)
-	if len(op.ExtraFields) > 0 {
-		// Define an inline struct for extra fields
-		buf.line("\ttype extraFields struct {")
-		for _, ef := range op.ExtraFields {
-			goType := "string"
-			if strings.Contains(ef, "date") {
-				goType = "*time.Time"
-			}
-			buf.line("\t\t%s %s `json:\"%s,omitempty\"`", entPascal(ef), goType, ef)
+// writeSortColumns emits the ?sort= allowlist for a list handler: every scalar
+// column plus the audit timestamps. Only these names ever reach ORDER BY.
+// It returns the name of the generated variable.
+func writeSortColumns(buf *cw, ent *entityInfo, pkg, opName string) string {
+	varName := strings.ToLower(ent.Name[:1]) + ent.Name[1:] + "SortColumns"
+	buf.line("// %s allowlists the ?sort= values accepted by %s.", varName, opName)
+	buf.line("var %s = map[string]string{", varName)
+	buf.line("\t\"created_at\": %s.FieldCreatedAt,", pkg)
+	buf.line("\t\"updated_at\": %s.FieldUpdatedAt,", pkg)
+	for _, f := range ent.Fields {
+		switch f.EntType {
+		case "String", "Int", "Int64", "Float64", "Bool", "Time", "Enum":
+			buf.line("\t%q: %s.Field%s,", f.Name, pkg, entPascal(f.Name))
+		}
+	}
+	buf.line("}")
+	buf.line("")
+	return varName
+}
+
+// searchField picks the field the list ?q= search matches: the first
+// @display() field, else name, as uigen labels records. Search needs a
+// substring match, so a display field that is not a string disables it.
+func searchField(ent *entityInfo, display string) string {
+	if display == "" {
+		display = "name"
+	}
+	for _, f := range ent.Fields {
+		if f.Name == display && f.EntType == "String" {
+			return display
+		}
+	}
+	return ""
+}
+
+// hasListFilters reports whether ent's list accepts ?q= or any field or FK of
+// ent is @filterable().
+func hasListFilters(ent *entityInfo) bool {
+	if ent.Search != "" {
+		return true
+	}
+	for _, f := range ent.Fields {
+		if f.Filterable {
+			return true
+		}
+	}
+	for _, efk := range ent.EdgeFKs {
+		if efk.Filterable {
+			return true
+		}
+	}
+	return false
+}
+
+// writeListFilters emits equality filters for @filterable() fields: enum and
+// string fields match the column, FK fields match the edge's target ID.
+// Malformed values are rejected with 400 rather than silently matching nothing.
+// ?q= is a case-insensitive substring search on the display field, which the
+// generated list UI's search box sends.
+func writeListFilters(buf *cw, ent *entityInfo, pkg string) {
+	if ent.Search != "" {
+		buf.line("\tif q := r.URL.Query().Get(\"q\"); q != \"\" {")
+		buf.line("\t\tquery.Where(%s.%sContainsFold(q))", pkg, entPascal(ent.Search))
+		buf.line("\t}")
+	}
+	for _, f := range ent.Fields {
+		if !f.Filterable {
+			continue
+		}
+		name := entPascal(f.Name)
+		switch f.EntType {
+		case "Enum":
+			buf.line("\tif v := r.URL.Query().Get(%q); v != \"\" {", f.Name)
+			buf.line("\t\tif err := %s.%sValidator(%s.%s(v)); err != nil {", pkg, name, pkg, name)
+			buf.line("\t\t\twriteError(w, http.StatusBadRequest, \"INVALID_FILTER\", err.Error())")
+			buf.line("\t\t\treturn")
+			buf.line("\t\t}")
+			buf.line("\t\tquery.Where(%s.%sEQ(%s.%s(v)))", pkg, name, pkg, name)
+			buf.line("\t}")
+		case "String":
+			buf.line("\tif v := r.URL.Query().Get(%q); v != \"\" {", f.Name)
+			buf.line("\t\tquery.Where(%s.%sEQ(v))", pkg, name)
+			buf.line("\t}")
+		default:
+			log.Printf("warning: %s.%s: @filterable() is only supported on enum, string and FK fields", ent.Name, f.Name)
+		}
+	}
+	for _, efk := range ent.EdgeFKs {
+		if !efk.Filterable {
+			continue
+		}
+		buf.line("\tif v := r.URL.Query().Get(%q); v != \"\" {", efk.FieldName)
+		buf.line("\t\tuid, err := uuid.Parse(v)")
+		buf.line("\t\tif err != nil {")
+		buf.line("\t\t\twriteError(w, http.StatusBadRequest, \"INVALID_FILTER\", \"invalid %s\")", efk.FieldName)
+		buf.line("\t\t\treturn")
+		buf.line("\t\t}")
+		buf.line("\t\tquery.Where(%s.Has%sWith(%s.ID(uid)))", pkg, entPascal(efk.EdgeName), entPkg(efk.Target))
+		buf.line("\t}")
+	}
+}
+
+// ─── Sub-resources ───────────────────────────────────────────────────────────
+
+// collectionEdges returns ent's O2M and M2M edges, the ones that lead to many
+// records, once per edge name.
+func collectionEdges(ent *entityInfo) []edgeDef {
+	var out []edgeDef
+	seen := map[string]bool{}
+	for _, e := range ent.Edges {
+		if e.Unique || seen[e.Name] {
+			continue
+		}
+		seen[e.Name] = true
+		out = append(out, e)
+	}
+	return out
+}
+
+// subResourceHandler names the handler that lists the records behind edge e
+// of ent, e.g. ListSpacesOfProperty. The "Of" keeps it apart from list
+// operations such as ListPersonRoles, which lists every PersonRole.
+func subResourceHandler(ent *entityInfo, e edgeDef) string {
+	return "List" + entPascal(e.Name) + "Of" + ent.Name
+}
+
+// subResourcePath returns the route segment of edge e: the edge name with
+// hyphens, as entity paths are spelled.
+func subResourcePath(e edgeDef) string {
+	return strings.ReplaceAll(e.Name, "_", "-")
+}
+
+// writeSubResourceHandler emits GET /{path}/{id}/{edge}: a paginated list of
+// the records reached through one O2M or M2M edge, newest first. It answers
+// 404 when the parent does not exist rather than an empty list.
+func writeSubResourceHandler(buf *cw, handlerType string, ent *entityInfo, e edgeDef) {
+	buf.line("func (h *%s) %s(w http.ResponseWriter, r *http.Request) {", handlerType, subResourceHandler(ent, e))
+	writeHandlerContext(buf)
+	buf.line("\tid, ok := parseUUID(w, r, \"id\")")
+	buf.line("\tif !ok { return }")
+	buf.line("\tparent, err := h.client.%s.Get(ctx, id)", ent.Name)
+	buf.line("\tif err != nil {")
+	buf.line("\t\tentErrorToHTTP(w, err)")
+	buf.line("\t\treturn")
+	buf.line("\t}")
+	buf.line("\tpg := parsePagination(r)")
+	buf.line("\tquery := h.client.%s.Query%s(parent)", ent.Name, entPascal(e.Name))
+	buf.line("\ttotal, err := query.Clone().Count(ctx)")
+	buf.line("\tif err != nil {")
+	buf.line("\t\tentErrorToHTTP(w, err)")
+	buf.line("\t\treturn")
+	buf.line("\t}")
+	buf.line("\titems, err := query.")
+	buf.line("\t\tLimit(pg.Limit).Offset(pg.Offset).")
+	buf.line("\t\tOrder(listOrder(r, nil, %s.FieldCreatedAt)).", entPkg(e.Target))
+	buf.line("\t\tAll(ctx)")
+	buf.line("\tif err != nil {")
+	buf.line("\t\tentErrorToHTTP(w, err)")
+	buf.line("\t\treturn")
+	buf.line("\t}")
+	buf.line("\twriteJSON(w, http.StatusOK, listResponse{Data: items, Total: total, Offset: pg.Offset, Limit: pg.Limit})")
+	buf.line("}")
+	buf.line("")
+}
+
+// hasGeneratedOp reports whether ops holds a generated (non-custom) operation
+// of the given type on entity.
+func hasGeneratedOp(ops []operationDef, entity, opType string) bool {
+	for _, op := range ops {
+		if op.Entity == entity && op.Type == opType && !op.Custom {
+			return true
+		}
+	}
+	return false
+}
+
+// ─── Update ──────────────────────────────────────────────────────────────────
+
+func writeUpdateStruct(buf *cw, ent *entityInfo, pkg string) {
+	buf.line("type update%sRequest struct {", ent.Name)
+	for _, f := range ent.Fields {
+		if f.Computed || f.Immutable {
+			continue // @computed() and @immutable() fields cannot be updated
+		}
+		writeStructField(buf, f, true)
+	}
+	for _, efk := range ent.EdgeFKs {
+		buf.line("\t%s *string `json:\"%s,omitempty\"`", entPascal(efk.FieldName), efk.FieldName)
+	}
+	buf.line("}")
+	buf.line("")
+}
+
+func writeUpdateHandler(buf *cw, handlerType string, ent *entityInfo, pkg, opName string) {
+	buf.line("func (h *%s) %s(w http.ResponseWriter, r *http.Request) {", handlerType, opName)
+	writeHandlerContext(buf)
+	buf.line("\tid, ok := parseUUID(w, r, \"id\")")
+	buf.line("\tif !ok { return }")
+	buf.line("\taudit, ok := parseAuditContext(w, r)")
+	buf.line("\tif !ok { return }")
+	buf.line("\tvar req update%sRequest", ent.Name)
+	buf.line("\tpatch, err := decodeUpdate(r, &req)")
+	buf.line("\tif err != nil {")
+	buf.line("\t\twriteError(w, http.StatusBadRequest, \"INVALID_JSON\", err.Error())")
+	buf.line("\t\treturn")
+	buf.line("\t}")
+
+	// Merge patch: null on a required member is an error, null on an optional
+	// member clears it. Plain JSON bodies yield a nil patch and skip both.
+	clearable, required := mergePatchMembers(ent)
+	if len(required) > 0 {
+		quoted := make([]string, len(required))
+		for i, name := range required {
+			quoted[i] = fmt.Sprintf("%q", name)
+		}
+		buf.line("\tif field := patch.firstNull(%s); field != \"\" {", strings.Join(quoted, ", "))
+		buf.line("\t\twriteError(w, http.StatusBadRequest, \"INVALID_PATCH\", field+\" cannot be null\")")
+		buf.line("\t\treturn")
+		buf.line("\t}")
+	}
+	// An object member of a merge patch is merged into the stored value type
+	// rather than replacing it, so it is resolved before validation.
+	if objects := mergePatchObjects(ent); len(objects) > 0 {
+		quoted := make([]string, len(objects))
+		for i, f := range objects {
+			quoted[i] = fmt.Sprintf("%q", f.Name)
+		}
+		buf.line("\tif patch.hasObject(%s) {", strings.Join(quoted, ", "))
+		buf.line("\t\tstored, err := h.client.%s.Get(ctx, id)", ent.Name)
+		buf.line("\t\tif err != nil {")
+		buf.line("\t\t\tentErrorToHTTP(w, err)")
+		buf.line("\t\t\treturn")
+		buf.line("\t\t}")
+		for _, f := range objects {
+			goName := entPascal(f.Name)
+			buf.line("\t\tif req.%s, err = mergeObject(patch, %q, stored.%s, req.%s); err != nil {", goName, f.Name, goName, goName)
+			buf.line("\t\t\twriteError(w, http.StatusBadRequest, \"INVALID_JSON\", err.Error())")
+			buf.line("\t\t\treturn")
+			buf.line("\t\t}")
+		}
+		buf.line("\t}")
+	}
+	buf.line("\tif errs := validate%sUpdate(&req); len(errs) > 0 {", ent.Name)
+	buf.line("\t\twriteFieldErrors(w, errs)")
+	buf.line("\t\treturn")
+	buf.line("\t}")
+	buf.line("\tbuilder := h.client.%s.UpdateOneID(id)", ent.Name)
+	writeIfMatchCheck(buf, ent, pkg)
+
+	// Set fields
+	for _, f := range ent.Fields {
+		if f.Computed || f.Immutable {
+			continue // @computed() and @immutable() fields cannot be updated
+		}
+		writeUpdateSetter(buf, f, pkg)
+	}
+	// Set edge FK fields
+	for _, efk := range ent.EdgeFKs {
+		writeEdgeFKSetter(buf, efk, true)
+	}
+	for _, c := range clearable {
+		buf.line("\tif patch.isNull(%q) { builder.%s() }", c.member, c.clearer)
+	}
+
+	// Audit fields
+	buf.line("\tbuilder.SetUpdatedBy(audit.Actor).SetSource(%s.Source(audit.Source))", pkg)
+	buf.line("\tif audit.CorrelationID != nil {")
+	buf.line("\t\tbuilder.SetCorrelationID(*audit.CorrelationID)")
+	buf.line("\t}")
+	buf.line("\tresult, err := saveAudited[*ent.%s](ctx, h.client, h.audit, AuditOpUpdate, audit, builder.Mutation())", ent.Name)
+	buf.line("\tif err != nil {")
+	buf.line("\t\twriteGuardedSaveError(w, r, err)")
+	buf.line("\t\treturn")
+	buf.line("\t}")
+	buf.line("\tsetETag(w, %s)", etagExpr(ent, "result"))
+	buf.line("\twriteJSON(w, http.StatusOK, result)")
+	buf.line("}")
+	buf.line("")
+}
+
+// hasEnumValues reports whether f is an enum with a known value set to check.
+func hasEnumValues(f fieldDef) bool {
+	return f.EntType == "Enum" && len(f.EnumValues) > 0
+}
+
+// enumValuesVar names the package variable holding an enum field's values.
+func enumValuesVar(ent *entityInfo, f fieldDef) string {
+	return lowerFirst(ent.Name) + entPascal(f.Name) + "Values"
+}
+
+// writeEnumValues emits the accepted values of each enum field, which request
+// handlers check incoming strings against before setting them.
+func writeEnumValues(buf *cw, ent *entityInfo) {
+	var fields []fieldDef
+	for _, f := range ent.Fields {
+		if !f.Computed && hasEnumValues(f) {
+			fields = append(fields, f)
+		}
+	}
+	if len(fields) == 0 {
+		return
+	}
+	buf.line("// Accepted values of the %s enum fields.", ent.Name)
+	buf.line("var (")
+	for _, f := range fields {
+		quoted := make([]string, len(f.EnumValues))
+		for i, v := range f.EnumValues {
+			quoted[i] = fmt.Sprintf("%q", v)
+		}
+		buf.line("\t%s = []string{%s}", enumValuesVar(ent, f), strings.Join(quoted, ", "))
+	}
+	buf.line(")")
+	buf.line("")
+}
+
+// patternVar names the package variable holding a string field's compiled pattern.
+func patternVar(ent *entityInfo, f fieldDef) string {
+	return lowerFirst(ent.Name) + entPascal(f.Name) + "Pattern"
+}
+
+// writePatterns emits the compiled =~ constraints of the string fields.
+func writePatterns(buf *cw, ent *entityInfo) {
+	var fields []fieldDef
+	for _, f := range ent.Fields {
+		if !f.Computed && f.Pattern != "" {
+			fields = append(fields, f)
+		}
+	}
+	if len(fields) == 0 {
+		return
+	}
+	buf.line("// Patterns the %s string fields must match.", ent.Name)
+	buf.line("var (")
+	for _, f := range fields {
+		buf.line("\t%s = regexp.MustCompile(%s)", patternVar(ent, f), "`"+f.Pattern+"`")
+	}
+	buf.line(")")
+	buf.line("")
+}
+
+// writeValidate emits validate{Entity}Create or validate{Entity}Update, which
+// check a request against the ontology's constraints (required members, enum
+// membership, numeric bounds, string patterns, currency codes and edge IDs)
+// and return every failure keyed by field, so the handler answers once with
+// all of them. Update members are all optional pointers and are only checked
+// when present.
+func writeValidate(buf *cw, ent *entityInfo, isUpdate bool) {
+	if isUpdate {
+		buf.line("func validate%sUpdate(req *update%sRequest) fieldErrors {", ent.Name, ent.Name)
+	} else {
+		buf.line("func validate%sCreate(req *create%sRequest) fieldErrors {", ent.Name, ent.Name)
+	}
+	buf.line("\terrs := fieldErrors{}")
+	for _, f := range ent.Fields {
+		if f.Computed || (isUpdate && f.Immutable) {
+			continue
+		}
+		writeFieldValidation(buf, ent, f, isUpdate)
+	}
+	for _, efk := range ent.EdgeFKs {
+		writeEdgeFKValidation(buf, efk, isUpdate)
+	}
+	buf.line("\treturn errs")
+	buf.line("}")
+	buf.line("")
+}
+
+// writeEdgeFKValidation emits the checks of an edge FK member. A required FK
+// in a create request is reported as REQUIRED when empty, and as INVALID_ID
+// otherwise, since add keeps the first failure of a field.
+func writeEdgeFKValidation(buf *cw, efk edgeFK, isUpdate bool) {
+	goName := entPascal(efk.FieldName)
+	if efk.Optional || isUpdate {
+		buf.line("\tif req.%s != nil { errs.add(checkID(%q, *req.%s)) }", goName, efk.FieldName, goName)
+		return
+	}
+	buf.line("\terrs.add(checkRequired(%q, req.%s == \"\"))", efk.FieldName, goName)
+	buf.line("\terrs.add(checkID(%q, req.%s))", efk.FieldName, goName)
+}
+
+// writeFieldValidation emits the checks of one field. The member's shape
+// follows writeStructField: pointers are checked only when set.
+func writeFieldValidation(buf *cw, ent *entityInfo, f fieldDef, isUpdate bool) {
+	goName := entPascal(f.Name)
+	var checks []string
+	member, pointer := "req."+goName, isUpdate || f.Optional
+
+	switch f.EntType {
+	case "String", "Enum":
+		if f.EntType == "String" && f.Default != "" {
+			pointer = true
+		}
+		value := member
+		if pointer {
+			value = "*" + member
+		}
+		switch {
+		case !pointer && f.Default == "":
+			checks = append(checks, fmt.Sprintf("checkRequired(%q, %s == \"\")", f.Name, value))
+		case f.NotEmpty:
+			checks = append(checks, fmt.Sprintf("checkRequired(%q, %s == \"\")", f.Name, value))
+		}
+		if hasEnumValues(f) {
+			check := fmt.Sprintf("checkEnum(%q, %s, %s)", f.Name, value, enumValuesVar(ent, f))
+			if !pointer && f.Default != "" {
+				// An omitted enum with a default is left to Ent.
+				buf.line("\tif %s != \"\" { errs.add(%s) }", value, check)
+			} else {
+				checks = append(checks, check)
+			}
+		}
+		if f.Pattern != "" {
+			checks = append(checks, fmt.Sprintf("checkPattern(%q, %s, %s)", f.Name, value, patternVar(ent, f)))
+		}
+	case "Int", "Float64":
+		if f.Default != "" {
+			pointer = true
+		}
+		checks = boundChecks(f.Name, member, pointer, f.Min, f.Max)
+	case "Money":
+		amount := f.Name + "_amount_cents"
+		member = "req." + entPascal(amount)
+		checks = boundChecks(amount, member, pointer, f.Min, f.Max)
+		// The currency member is a pointer exactly when the amount is, and
+		// a create request leaves an empty one to the column default.
+		currency := f.Name + "_currency"
+		if pointer {
+			buf.line("\tif req.%s != nil { errs.add(checkCurrency(%q, *req.%s)) }", entPascal(currency), currency, entPascal(currency))
+		} else {
+			buf.line("\tif req.%s != \"\" { errs.add(checkCurrency(%q, req.%s)) }", entPascal(currency), currency, entPascal(currency))
+		}
+	case "Time":
+		if !pointer {
+			checks = append(checks, fmt.Sprintf("checkRequired(%q, %s.IsZero())", f.Name, member))
+		}
+	case "JSON":
+		slice := f.JSONType == "" || f.JSONType == "json.RawMessage" || strings.HasPrefix(f.JSONType, "[]")
+		if !pointer && slice {
+			checks = append(checks, fmt.Sprintf("checkRequired(%q, %s == nil)", f.Name, member))
+		}
+	}
+	if len(checks) == 0 {
+		return
+	}
+	if !pointer {
+		for _, c := range checks {
+			buf.line("\terrs.add(%s)", c)
+		}
+		return
+	}
+	buf.line("\tif %s != nil {", member)
+	for _, c := range checks {
+		buf.line("\t\terrs.add(%s)", c)
+	}
+	buf.line("\t}")
+}
+
+// boundChecks returns the checkMin and checkMax calls of a numeric member.
+func boundChecks(name, member string, pointer bool, lo, hi *cueparse.Bound) []string {
+	value := member
+	if pointer {
+		value = "*" + member
+	}
+	var checks []string
+	if lo != nil {
+		checks = append(checks, fmt.Sprintf("checkMin(%q, float64(%s), %s, %t)", name, value, lo.Value, lo.Exclusive))
+	}
+	if hi != nil {
+		checks = append(checks, fmt.Sprintf("checkMax(%q, float64(%s), %s, %t)", name, value, hi.Value, hi.Exclusive))
+	}
+	return checks
+}
+
+// writeIfMatchCheck emits the If-Match precondition for an update: when the
+// header is present the current row is fetched and its ETag (version or
+// updated_at, see etagExpr) compared before anything is saved, answering 412
+// on a stale tag. The builder is then guarded on that column, so a write that
+// lands between the read and the save makes the update match no row rather
+// than overwrite it.
+func writeIfMatchCheck(buf *cw, ent *entityInfo, pkg string) {
+	buf.line("\tif hasIfMatch(r) {")
+	buf.line("\t\tcurrent, err := h.client.%s.Get(ctx, id)", ent.Name)
+	buf.line("\t\tif err != nil {")
+	buf.line("\t\t\tentErrorToHTTP(w, err)")
+	buf.line("\t\t\treturn")
+	buf.line("\t\t}")
+	buf.line("\t\tif !checkIfMatch(w, r, %s) { return }", etagExpr(ent, "current"))
+	buf.line("\t\tbuilder.Where(%s)", ifMatchGuard(ent, pkg))
+	buf.line("\t}")
+}
+
+// etagExpr returns the ETag expression for the row held in varName: its
+// version for @versioned() entities, whose hook bumps it on every update,
+// otherwise its updated_at.
+func etagExpr(ent *entityInfo, varName string) string {
+	if ent.Versioned {
+		return "versionETag(" + varName + ".Version)"
+	}
+	return "entityETag(" + varName + ".UpdatedAt)"
+}
+
+// ifMatchGuard returns the predicate that pins a guarded update to the row
+// whose ETag was checked, so a concurrent write makes it match nothing.
+func ifMatchGuard(ent *entityInfo, pkg string) string {
+	if ent.Versioned {
+		return pkg + ".VersionEQ(current.Version)"
+	}
+	return pkg + ".UpdatedAtEQ(current.UpdatedAt)"
+}
+
+// mergePatchClear pairs a JSON member with the Ent builder method that clears it.
+type mergePatchClear struct {
+	member  string
+	clearer string
+}
+
+// mergePatchMembers splits the updatable JSON members of an entity into those
+// an RFC 7386 null may clear (optional fields and edges) and those it may not.
+func mergePatchMembers(ent *entityInfo) (clearable []mergePatchClear, required []string) {
+	for _, f := range ent.Fields {
+		if f.Computed || f.Immutable {
+			continue
+		}
+		members := []string{f.Name}
+		if f.EntType == "Money" {
+			members = []string{f.Name + "_amount_cents", f.Name + "_currency"}
+		}
+		for _, m := range members {
+			if f.Optional {
+				clearable = append(clearable, mergePatchClear{member: m, clearer: "Clear" + entPascal(m)})
+			} else {
+				required = append(required, m)
+			}
+		}
+	}
+	for _, efk := range ent.EdgeFKs {
+		if efk.Optional {
+			clearable = append(clearable, mergePatchClear{member: efk.FieldName, clearer: "Clear" + entPascal(efk.EdgeName)})
+		} else {
+			required = append(required, efk.FieldName)
+		}
+	}
+	return clearable, required
+}
+
+// mergePatchObjects returns the updatable value-type fields, the members a
+// merge patch may update partially with a nested object.
+func mergePatchObjects(ent *entityInfo) []fieldDef {
+	var objects []fieldDef
+	for _, f := range ent.Fields {
+		if f.Computed || f.Immutable || f.EntType != "JSON" {
+			continue
+		}
+		if f.JSONType == "" || f.JSONType == "json.RawMessage" || strings.HasPrefix(f.JSONType, "[]") {
+			continue
 		}
+		objects = append(objects, f)
+	}
+	return objects
+}
+
+// ─── Delete ──────────────────────────────────────────────────────────────────
+
+// writeDeleteHandler emits DELETE /{path}/{id}. @soft_delete() entities are
+// stamped with deleted_at (their queries already hide such rows); deleting an
+// already-deleted row is a 404. The stamp is an update, so it honors If-Match
+// like one. Other entities are removed outright. Either way the AuditOpDelete
+// event is appended in the delete's transaction.
+func writeDeleteHandler(buf *cw, handlerType string, ent *entityInfo, pkg, opName string) {
+	buf.line("func (h *%s) %s(w http.ResponseWriter, r *http.Request) {", handlerType, opName)
+	writeHandlerContext(buf)
+	buf.line("\tid, ok := parseUUID(w, r, \"id\")")
+	buf.line("\tif !ok { return }")
+	buf.line("\taudit, ok := parseAuditContext(w, r)")
+	buf.line("\tif !ok { return }")
+	if ent.SoftDelete {
+		buf.line("\tbuilder := h.client.%s.UpdateOneID(id).", ent.Name)
+		buf.line("\t\tWhere(%s.DeletedAtIsNil()).", pkg)
+		buf.line("\t\tSetDeletedAt(time.Now()).")
+		buf.line("\t\tSetUpdatedBy(audit.Actor)")
+		writeIfMatchCheck(buf, ent, pkg)
+		buf.line("\t_, err := saveAudited[*ent.%s](ctx, h.client, h.audit, AuditOpDelete, audit, builder.Mutation())", ent.Name)
+		buf.line("\tif err != nil {")
+		buf.line("\t\twriteGuardedSaveError(w, r, err)")
+		buf.line("\t\treturn")
 		buf.line("\t}")
-		buf.line("\tvar extra extraFields")
-		buf.line("\t_ = decodeJSON(r, &extra)")
-		buf.line("\th.transition%s(w, r, \"%s\", func(b *ent.%sUpdateOne) {", ent.Name, op.ToStatus, ent.Name)
+	} else {
+		buf.line("\terr := deleteAudited(ctx, h.client, h.audit, audit, ent.Type%s, id, func(c *ent.Client) error {", ent.Name)
+		buf.line("\t\treturn c.%s.DeleteOneID(id).Exec(ctx)", ent.Name)
+		buf.line("\t})")
+		buf.line("\tif err != nil {")
+		buf.line("\t\tentErrorToHTTP(w, err)")
+		buf.line("\t\treturn")
+		buf.line("\t}")
+	}
+	buf.line("\tw.WriteHeader(http.StatusNoContent)")
+	buf.line("}")
+	buf.line("")
+}
+
+// ─── Transitions ─────────────────────────────────────────────────────────────
+
+func writeTransitionHelper(buf *cw, handlerType string, ent *entityInfo, pkg string) {
+	buf.line("func (h *%s) transition%s(w http.ResponseWriter, r *http.Request, targetStatus string, applyExtra func(*ent.%sUpdateOne)) {", handlerType, ent.Name, ent.Name)
+	writeHandlerContext(buf)
+	buf.line("\tid, ok := parseUUID(w, r, \"id\")")
+	buf.line("\tif !ok { return }")
+	buf.line("\taudit, ok := parseAuditContext(w, r)")
+	buf.line("\tif !ok { return }")
+	buf.line("\tcurrent, err := h.client.%s.Get(ctx, id)", ent.Name)
+	buf.line("\tif err != nil {")
+	buf.line("\t\tentErrorToHTTP(w, err)")
+	buf.line("\t\treturn")
+	buf.line("\t}")
+	buf.line("\tif !checkIfMatch(w, r, %s) { return }", etagExpr(ent, "current"))
+	buf.line("\tbuilder := h.client.%s.UpdateOneID(id).", ent.Name)
+	buf.line("\t\tSetStatus(%s.Status(targetStatus)).", pkg)
+	buf.line("\t\tSetUpdatedBy(audit.Actor).")
+	buf.line("\t\tSetSource(%s.Source(audit.Source))", pkg)
+	buf.line("\tif audit.CorrelationID != nil {")
+	buf.line("\t\tbuilder.SetCorrelationID(*audit.CorrelationID)")
+	buf.line("\t}")
+	buf.line("\tif hasIfMatch(r) {")
+	buf.line("\t\tbuilder.Where(%s)", ifMatchGuard(ent, pkg))
+	buf.line("\t}")
+	buf.line("\tif applyExtra != nil {")
+	buf.line("\t\tapplyExtra(builder)")
+	buf.line("\t}")
+	// Guards see the entity as the transition would leave it, so they are
+	// checked once the extra fields are on the mutation.
+	buf.line("\tif err := schema.ValidateTransitionWithGuard(schema.Valid%sTransitions, schema.%sTransitionGuards, string(current.Status), targetStatus, guardValues(ctx, builder.Mutation())); err != nil {", ent.Name, ent.Name)
+	buf.line("\t\twriteError(w, http.StatusConflict, \"INVALID_TRANSITION\", err.Error())")
+	buf.line("\t\treturn")
+	buf.line("\t}")
+	buf.line("\tupdated, err := saveAudited[*ent.%s](ctx, h.client, h.audit, AuditOpTransition, audit, builder.Mutation())", ent.Name)
+	buf.line("\tif err != nil {")
+	buf.line("\t\twriteGuardedSaveError(w, r, err)")
+	buf.line("\t\treturn")
+	buf.line("\t}")
+	buf.line("\tsetETag(w, %s)", etagExpr(ent, "updated"))
+	buf.line("\twriteJSON(w, http.StatusOK, updated)")
+	buf.line("}")
+	buf.line("")
+}
+
+func writeTransitionMethod(buf *cw, handlerType string, ent *entityInfo, pkg string, op operationDef) {
+	buf.line("func (h *%s) %s(w http.ResponseWriter, r *http.Request) {", handlerType, op.Name)
+	if len(op.ExtraFields) > 0 {
+		// Extra fields that name a column (or FK) on the entity are persisted
+		// with the same setters as update; anything else is accepted but ignored.
+		var fields []fieldDef
+		var fks []edgeFK
+		buf.line("\ttype extraFields struct {")
 		for _, ef := range op.ExtraFields {
-			goName := entPascal(ef)
-			entName := entPascal(ef)
+			if f, ok := transitionField(ent, ef); ok {
+				fields = append(fields, f)
+				writeStructField(buf, f, true)
+				continue
+			}
+			if efk, ok := transitionEdgeFK(ent, ef); ok {
+				fks = append(fks, efk)
+				buf.line("\t\t%s *string `json:\"%s,omitempty\"`", entPascal(ef), ef)
+				continue
+			}
+			goType := "string"
 			if strings.Contains(ef, "date") {
-				// Time field — use SetNillable
-				buf.line("\t\tif extra.%s != nil { b.SetNillable%s(extra.%s) }", goName, entName, goName)
+				goType = "*time.Time"
 			}
-			// Non-date extra fields (like "reason") are accepted but not persisted
-			// unless the entity has a matching field
+			buf.line("\t\t%s %s `json:\"%s,omitempty\"`", entPascal(ef), goType, ef)
+		}
+		buf.line("\t}")
+		buf.line("\tvar req extraFields")
+		buf.line("\tif err := decodeOptionalJSON(r, &req); err != nil {")
+		buf.line("\t\twriteError(w, http.StatusBadRequest, \"INVALID_JSON\", err.Error())")
+		buf.line("\t\treturn")
+		buf.line("\t}")
+		if len(fields) > 0 || len(fks) > 0 {
+			buf.line("\terrs := fieldErrors{}")
+			for _, f := range fields {
+				writeFieldValidation(buf, ent, f, true)
+			}
+			for _, efk := range fks {
+				writeEdgeFKValidation(buf, efk, true)
+			}
+			buf.line("\tif len(errs) > 0 {")
+			buf.line("\t\twriteFieldErrors(w, errs)")
+			buf.line("\t\treturn")
+			buf.line("\t}")
+		}
+		if len(fields) == 0 && len(fks) == 0 {
+			buf.line("\th.transition%s(w, r, \"%s\", nil)", ent.Name, op.ToStatus)
+		} else {
+			buf.line("\th.transition%s(w, r, \"%s\", func(builder *ent.%sUpdateOne) {", ent.Name, op.ToStatus, ent.Name)
+			for _, f := range fields {
+				writeUpdateSetter(buf, f, pkg)
+			}
+			for _, efk := range fks {
+				buf.line("\t\tif req.%s != nil { builder.Set%sID(uuid.MustParse(*req.%s)) }", entPascal(efk.FieldName), entPascal(efk.EdgeName), entPascal(efk.FieldName))
+			}
+			buf.line("\t})")
 		}
-		buf.line("\t})")
 	} else {
 		buf.line("\th.transition%s(w, r, \"%s\", nil)", ent.Name, op.ToStatus)
 	}
@@ -999,6 +1701,38 @@ func writeTransitionMethod(buf *cw, handlerType string, ent *entityInfo, pkg str
 	buf.line("")
 }
 
+// transitionField returns the entity field an extra_fields entry names, if
+// it is a plain column the update setters can write from a transition.
+func transitionField(ent *entityInfo, name string) (fieldDef, bool) {
+	for _, f := range ent.Fields {
+		if f.Name != name || f.Computed {
+			continue
+		}
+		switch f.EntType {
+		case "String", "Int", "Int64", "Float64", "Bool", "Time", "Enum":
+			return f, true
+		}
+	}
+	return fieldDef{}, false
+}
+
+// transitionEdgeFK returns the edge FK an extra_fields entry names, if any.
+func transitionEdgeFK(ent *entityInfo, name string) (edgeFK, bool) {
+	for _, efk := range ent.EdgeFKs {
+		if efk.FieldName == name {
+			return efk, true
+		}
+	}
+	return edgeFK{}, false
+}
+
+func lowerFirst(s string) string {
+	if s == "" {
+		return s
+	}
+	return strings.ToLower(s[:1]) + s[1:]
+}
+
 // ─── Struct field helpers ────────────────────────────────────────────────────
 
 func writeStructField(buf *cw, f fieldDef, isUpdate bool) {
@@ -1022,7 +1756,7 @@ func writeStructField(buf *cw, f fieldDef, isUpdate bool) {
 	case "String":
 		if isUpdate {
 			buf.line("\t%s *string `json:\"%s,omitempty\"`", goName, f.Name)
-		} else if f.Optional {
+		} else if f.Optional || f.Default != "" {
 			buf.line("\t%s *string `json:\"%s,omitempty\"`", goName, f.Name)
 		} else {
 			buf.line("\t%s string `json:\"%s\"`", goName, f.Name)
@@ -1031,7 +1765,7 @@ func writeStructField(buf *cw, f fieldDef, isUpdate bool) {
 	case "Int":
 		if isUpdate {
 			buf.line("\t%s *int `json:\"%s,omitempty\"`", goName, f.Name)
-		} else if f.Optional {
+		} else if f.Optional || f.Default != "" {
 			buf.line("\t%s *int `json:\"%s,omitempty\"`", goName, f.Name)
 		} else {
 			buf.line("\t%s int `json:\"%s\"`", goName, f.Name)
@@ -1049,7 +1783,7 @@ func writeStructField(buf *cw, f fieldDef, isUpdate bool) {
 	case "Float64":
 		if isUpdate {
 			buf.line("\t%s *float64 `json:\"%s,omitempty\"`", goName, f.Name)
-		} else if f.Optional {
+		} else if f.Optional || f.Default != "" {
 			buf.line("\t%s *float64 `json:\"%s,omitempty\"`", goName, f.Name)
 		} else {
 			buf.line("\t%s float64 `json:\"%s\"`", goName, f.Name)
@@ -1124,28 +1858,28 @@ func writeCreateSetter(buf *cw, f fieldDef, pkg string) {
 		curGoName := entPascal(f.Name + "_currency")
 		if f.Optional {
 			buf.line("\tif req.%s != nil { builder.SetNillable%s(req.%s) }", amtGoName, amtEntName, amtGoName)
-			buf.line("\tif req.%s != nil { builder.SetNillable%s(req.%s) }", curGoName, curEntName, curGoName)
+			buf.line("\tif req.%s != nil { builder.Set%s(*req.%s) }", curGoName, curEntName, curGoName)
 		} else {
 			buf.line("\tbuilder.Set%s(req.%s)", amtEntName, amtGoName)
 			buf.line("\tif req.%s != \"\" { builder.Set%s(req.%s) }", curGoName, curEntName, curGoName)
 		}
 
 	case "String":
-		if f.Optional {
+		if f.Optional || f.Default != "" {
 			buf.line("\tif req.%s != nil { builder.SetNillable%s(req.%s) }", goName, entName, goName)
 		} else {
 			buf.line("\tbuilder.Set%s(req.%s)", entName, goName)
 		}
 
 	case "Int", "Int64":
-		if f.Optional {
+		if f.Optional || f.Default != "" {
 			buf.line("\tif req.%s != nil { builder.SetNillable%s(req.%s) }", goName, entName, goName)
 		} else {
 			buf.line("\tbuilder.Set%s(req.%s)", entName, goName)
 		}
 
 	case "Float64":
-		if f.Optional {
+		if f.Optional || f.Default != "" {
 			buf.line("\tif req.%s != nil { builder.SetNillable%s(req.%s) }", goName, entName, goName)
 		} else {
 			buf.line("\tbuilder.Set%s(req.%s)", entName, goName)
@@ -1252,34 +1986,21 @@ func writeUpdateSetter(buf *cw, f fieldDef, pkg string) {
 
 // ─── Edge FK setter helpers ──────────────────────────────────────────────────
 
+// writeEdgeFKSetter sets an edge from its FK member. The ID has already
+// passed checkID in validate, so it is parsed with MustParse.
 func writeEdgeFKSetter(buf *cw, efk edgeFK, isUpdate bool) {
 	goName := entPascal(efk.FieldName)
 	edgeSetter := "Set" + entPascal(efk.EdgeName) + "ID"
-
 	if efk.Optional || isUpdate {
-		buf.line("\tif req.%s != nil {", goName)
-		buf.line("\t\tuid, err := uuid.Parse(*req.%s)", goName)
-		buf.line("\t\tif err != nil {")
-		buf.line("\t\t\twriteError(w, http.StatusBadRequest, \"INVALID_ID\", \"invalid %s\")", efk.FieldName)
-		buf.line("\t\t\treturn")
-		buf.line("\t\t}")
-		buf.line("\t\tbuilder.%s(uid)", edgeSetter)
-		buf.line("\t}")
-	} else {
-		buf.line("\t{")
-		buf.line("\t\tuid, err := uuid.Parse(req.%s)", goName)
-		buf.line("\t\tif err != nil {")
-		buf.line("\t\t\twriteError(w, http.StatusBadRequest, \"INVALID_ID\", \"invalid %s\")", efk.FieldName)
-		buf.line("\t\t\treturn")
-		buf.line("\t\t}")
-		buf.line("\t\tbuilder.%s(uid)", edgeSetter)
-		buf.line("\t}")
+		buf.line("\tif req.%s != nil { builder.%s(uuid.MustParse(*req.%s)) }", goName, edgeSetter, goName)
+		return
 	}
+	buf.line("\tbuilder.%s(uuid.MustParse(req.%s))", edgeSetter, goName)
 }
 
 // ─── Routes generation ───────────────────────────────────────────────────────
 
-func generateRoutesFile(projectRoot string, services []serviceDef, handlerTypes map[string]string) error {
+func generateRoutesFile(projectRoot string, services []serviceDef, handlerTypes map[string]string, entities map[string]*entityInfo) error {
 	var buf cw
 	buf.line("// Code generated by cmd/handlergen from CUE ontology. DO NOT EDIT.")
 	buf.line("package server")
@@ -1293,7 +2014,8 @@ func generateRoutesFile(projectRoot string, services []serviceDef, handlerTypes
 	buf.line(")")
 	buf.line("")
 	buf.line("// RegisterRoutes registers all generated HTTP routes on the given router.")
-	buf.line("func RegisterRoutes(r chi.Router, client *ent.Client) {")
+	buf.line("// Mutations append to audit when it is non-nil.")
+	buf.line("func RegisterRoutes(r chi.Router, client *ent.Client, audit handler.AuditRecorder) {")
 	buf.line("\t// Health check")
 	buf.line("\tr.Get(\"/healthz\", func(w http.ResponseWriter, r *http.Request) {")
 	buf.line("\t\tw.Header().Set(\"Content-Type\", \"application/json\")")
@@ -1318,7 +2040,7 @@ func generateRoutesFile(projectRoot string, services []serviceDef, handlerTypes
 		}
 		v := handlerVars[svc.Name]
 		ht := handlerTypes[svc.Name]
-		buf.line("\t%s := handler.New%s(client)", v, ht)
+		buf.line("\t%s := handler.New%s(client, audit)", v, ht)
 	}
 	buf.line("")
 
@@ -1339,8 +2061,12 @@ func generateRoutesFile(projectRoot string, services []serviceDef, handlerTypes
 			switch op.Type {
 			case "create":
 				chiMethod, path = "Post", basePath
+			case "bulk":
+				chiMethod, path = "Post", basePath+"/bulk"
 			case "get":
 				chiMethod, path = "Get", basePath+"/{id}"
+			case "exists":
+				chiMethod, path = "Head", basePath+"/{id}"
 			case "list":
 				chiMethod, path = "Get", basePath
 			case "update":
@@ -1351,6 +2077,13 @@ func generateRoutesFile(projectRoot string, services []serviceDef, handlerTypes
 				chiMethod, path = "Post", basePath+"/{id}/"+op.Action
 			}
 			buf.line("\tr.%s(\"%s\", %s.%s)", chiMethod, path, v, op.Name)
+			// Every readable entity also lists the records behind its
+			// collection edges.
+			if ent, ok := entities[op.Entity]; ok && op.Type == "get" && !op.Custom {
+				for _, e := range collectionEdges(ent) {
+					buf.line("\tr.Get(\"%s/%s\", %s.%s)", path, subResourcePath(e), v, subResourceHandler(ent, e))
+				}
+			}
 		}
 	}
 
@@ -1378,9 +2111,310 @@ func hasGeneratedRoutes(svc serviceDef) bool {
 	return false
 }
 
+// ─── Benchmarks ──────────────────────────────────────────────────────────────
+
+// benchDatasetSize is the number of rows the generated benchmarks seed per
+// entity before timing starts.
+const benchDatasetSize = 200
+
+// benchTarget describes one entity covered by the generated benchmarks.
+type benchTarget struct {
+	Entity     *entityInfo
+	HandlerVar string
+	Handler    string
+	Path       string
+	Create     string
+	Get        string
+	List       string
+}
+
+// collectBenchTargets returns the entities whose create, get and list
+// operations are all generated and whose create payload has no required edge
+// FK. Entities that need a parent row are skipped so every fixture can be
+// created in isolation; they are returned in skipped with the reason.
+func collectBenchTargets(services []serviceDef, entities map[string]*entityInfo, handlerTypes map[string]string) (out []benchTarget, skipped []string) {
+	for _, svc := range services {
+		if !hasGeneratedRoutes(svc) {
+			continue
+		}
+		for _, entName := range svc.Entities {
+			ent, ok := entities[entName]
+			if !ok {
+				continue
+			}
+			t := benchTarget{Entity: ent, Handler: handlerTypes[svc.Name]}
+			for _, op := range opsForEntity(svc.Operations, entName) {
+				if op.Custom {
+					continue
+				}
+				switch op.Type {
+				case "create":
+					t.Create, t.Path = op.Name, "/v1/"+op.EntityPath
+				case "get":
+					t.Get = op.Name
+				case "list":
+					t.List = op.Name
+				}
+			}
+			if t.Create == "" || t.Get == "" || t.List == "" {
+				continue
+			}
+			if reason := unbenchable(ent); reason != "" {
+				skipped = append(skipped, ent.Name+": "+reason)
+				continue
+			}
+			out = append(out, t)
+		}
+	}
+	return out, skipped
+}
+
+// unbenchable returns why a fixture for the entity cannot be built from its
+// own fields alone, or "" when it can.
+func unbenchable(ent *entityInfo) string {
+	var required []string
+	for _, efk := range ent.EdgeFKs {
+		if !efk.Optional {
+			required = append(required, efk.FieldName)
+		}
+	}
+	if len(required) > 0 {
+		return "requires " + strings.Join(required, ", ")
+	}
+	for _, f := range ent.Fields {
+		if f.Optional || f.Computed {
+			continue
+		}
+		if f.EntType == "Enum" && f.Default == "" && len(f.EnumValues) == 0 {
+			return "enum " + f.Name + " has no known values"
+		}
+	}
+	return ""
+}
+
+// writeFixtureValue emits the map entry for a required field. Only
+// required fields are populated: strings are suffixed with the row index so
+// rows stay distinct, strings and enums with a CUE default are omitted so the
+// default (which satisfies any pattern) applies, enums otherwise use their
+// first value, money uses a fixed positive amount in the default currency,
+// and date ranges are closed so term constraints are satisfied.
+func writeFixtureValue(buf *cw, f fieldDef) {
+	switch f.EntType {
+	case "String":
+		if f.Default == "" {
+			buf.line("\t\t%q: fmt.Sprintf(\"%s-%%d\", i),", f.Name, f.Name)
+		}
+	case "Int", "Int64":
+		buf.line("\t\t%q: 1,", f.Name)
+	case "Float64":
+		buf.line("\t\t%q: 1.0,", f.Name)
+	case "Time":
+		buf.line("\t\t%q: fixtureTime,", f.Name)
+	case "Enum":
+		if f.Default == "" {
+			buf.line("\t\t%q: %q,", f.Name, f.EnumValues[0])
+		}
+	case "Money":
+		buf.line("\t\t%q: 100000,", f.Name+"_amount_cents")
+	case "JSON":
+		if strings.HasPrefix(f.JSONType, "[]") {
+			buf.line("\t\t%q: []any{},", f.Name)
+		} else if f.JSONType == "types.DateRange" {
+			// Closed range so end-date constraints on fixed terms hold.
+			buf.line("\t\t%q: map[string]any{\"start\": fixtureTime, \"end\": fixtureTime.AddDate(1, 0, 0)},", f.Name)
+		} else {
+			buf.line("\t\t%q: map[string]any{},", f.Name)
+		}
+	}
+}
+
+func generateBenchFile(projectRoot string, targets []benchTarget, skipped []string) error {
+	var buf cw
+	buf.line("// Code generated by cmd/handlergen from CUE ontology. DO NOT EDIT.")
+	buf.line("package handler")
+	buf.line("")
+	buf.line("import (")
+	buf.line("\t\"bytes\"")
+	buf.line("\t\"context\"")
+	buf.line("\t\"database/sql\"")
+	buf.line("\t\"encoding/json\"")
+	buf.line("\t\"net/http\"")
+	buf.line("\t\"net/http/httptest\"")
+	buf.line("\t\"testing\"")
+	buf.line("")
+	buf.line("\t\"entgo.io/ent/dialect\"")
+	buf.line("\tentsql \"entgo.io/ent/dialect/sql\"")
+	buf.line("\t\"github.com/go-chi/chi/v5\"")
+	buf.line("\t\"github.com/matthewbaird/ontology/ent\"")
+	buf.line("")
+	buf.line("\t_ \"github.com/matthewbaird/ontology/ent/runtime\"")
+	buf.line("\t_ \"modernc.org/sqlite\"")
+	buf.line(")")
+	buf.line("")
+	buf.line("// benchDatasetSize is the number of rows seeded per entity before timing.")
+	buf.line("// Get benchmarks cycle through the seeded IDs; list benchmarks read the")
+	buf.line("// first page of the seeded rows.")
+	buf.line("const benchDatasetSize = %d", benchDatasetSize)
+	buf.line("")
+	buf.line("// newBenchClient opens a private in-memory SQLite database with the schema applied.")
+	buf.line("func newBenchClient(b *testing.B) *ent.Client {")
+	buf.line("\tb.Helper()")
+	buf.line("\tdb, err := sql.Open(\"sqlite\", \"file::memory:?_pragma=foreign_keys(1)&_time_format=sqlite\")")
+	buf.line("\tif err != nil {")
+	buf.line("\t\tb.Fatalf(\"opening database: %%v\", err)")
+	buf.line("\t}")
+	buf.line("\tdb.SetMaxOpenConns(1)")
+	buf.line("\tclient := ent.NewClient(ent.Driver(entsql.OpenDB(dialect.SQLite, db)))")
+	buf.line("\tb.Cleanup(func() { client.Close() })")
+	buf.line("\tif err := client.Schema.Create(context.Background()); err != nil {")
+	buf.line("\t\tb.Fatalf(\"creating schema: %%v\", err)")
+	buf.line("\t}")
+	buf.line("\treturn client")
+	buf.line("}")
+	buf.line("")
+	buf.line("// benchServe invokes a handler directly, bypassing the router, and fails the")
+	buf.line("// benchmark on an unexpected status.")
+	buf.line("func benchServe(b *testing.B, h http.HandlerFunc, method, target, id string, body []byte, want int) *httptest.ResponseRecorder {")
+	buf.line("\treq := httptest.NewRequest(method, target, bytes.NewReader(body))")
+	buf.line("\treq.Header.Set(\"Content-Type\", \"application/json\")")
+	buf.line("\treq.Header.Set(\"X-Actor\", \"bench\")")
+	buf.line("\tif id != \"\" {")
+	buf.line("\t\trctx := chi.NewRouteContext()")
+	buf.line("\t\trctx.URLParams.Add(\"id\", id)")
+	buf.line("\t\treq = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rctx))")
+	buf.line("\t}")
+	buf.line("\trec := httptest.NewRecorder()")
+	buf.line("\th(rec, req)")
+	buf.line("\tif rec.Code != want {")
+	buf.line("\t\tb.Fatalf(\"%%s %%s: status %%d, want %%d: %%s\", method, target, rec.Code, want, rec.Body.String())")
+	buf.line("\t}")
+	buf.line("\treturn rec")
+	buf.line("}")
+	buf.line("")
+	buf.line("// benchCreate posts the i-th fixture and returns the new row's ID.")
+	buf.line("func benchCreate(b *testing.B, create http.HandlerFunc, target string, fixture func(int) map[string]any, i int) string {")
+	buf.line("\tbody, err := json.Marshal(fixture(i))")
+	buf.line("\tif err != nil {")
+	buf.line("\t\tb.Fatalf(\"encoding fixture: %%v\", err)")
+	buf.line("\t}")
+	buf.line("\trec := benchServe(b, create, http.MethodPost, target, \"\", body, http.StatusCreated)")
+	buf.line("\tvar created struct {")
+	buf.line("\t\tID string `json:\"id\"`")
+	buf.line("\t}")
+	buf.line("\tif err := json.Unmarshal(rec.Body.Bytes(), &created); err != nil {")
+	buf.line("\t\tb.Fatalf(\"decoding created row: %%v\", err)")
+	buf.line("\t}")
+	buf.line("\treturn created.ID")
+	buf.line("}")
+	buf.line("")
+	buf.line("// benchHandlers seeds benchDatasetSize rows and then benchmarks get, list")
+	buf.line("// and create, in that order so reads always see the fixed dataset.")
+	buf.line("func benchHandlers(b *testing.B, target string, create, get, list http.HandlerFunc, fixture func(int) map[string]any) {")
+	buf.line("\tids := make([]string, benchDatasetSize)")
+	buf.line("\tfor i := range ids {")
+	buf.line("\t\tids[i] = benchCreate(b, create, target, fixture, i)")
+	buf.line("\t}")
+	buf.line("\tb.Run(\"get\", func(b *testing.B) {")
+	buf.line("\t\tb.ReportAllocs()")
+	buf.line("\t\tfor i := 0; i < b.N; i++ {")
+	buf.line("\t\t\tid := ids[i%%len(ids)]")
+	buf.line("\t\t\tbenchServe(b, get, http.MethodGet, target+\"/\"+id, id, nil, http.StatusOK)")
+	buf.line("\t\t}")
+	buf.line("\t})")
+	buf.line("\tb.Run(\"list\", func(b *testing.B) {")
+	buf.line("\t\tb.ReportAllocs()")
+	buf.line("\t\tfor i := 0; i < b.N; i++ {")
+	buf.line("\t\t\tbenchServe(b, list, http.MethodGet, target, \"\", nil, http.StatusOK)")
+	buf.line("\t\t}")
+	buf.line("\t})")
+	buf.line("\tnext := benchDatasetSize")
+	buf.line("\tb.Run(\"create\", func(b *testing.B) {")
+	buf.line("\t\tb.ReportAllocs()")
+	buf.line("\t\tfor i := 0; i < b.N; i++ {")
+	buf.line("\t\t\tbenchCreate(b, create, target, fixture, next)")
+	buf.line("\t\t\tnext++")
+	buf.line("\t\t}")
+	buf.line("\t})")
+	buf.line("}")
+	buf.line("")
+
+	if len(skipped) > 0 {
+		buf.line("// Not benchmarked, because their fixtures need a parent row or a value the")
+		buf.line("// ontology does not pin down:")
+		for _, s := range skipped {
+			buf.line("//   - %s", s)
+		}
+		buf.line("")
+	}
+	for _, t := range targets {
+		buf.line("func Benchmark%sHandlers(b *testing.B) {", t.Entity.Name)
+		buf.line("\th := New%s(newBenchClient(b), nil)", t.Handler)
+		buf.line("\tbenchHandlers(b, %q, h.%s, h.%s, h.%s, %s)", t.Path, t.Create, t.Get, t.List, fixtureFunc(t.Entity))
+		buf.line("}")
+		buf.line("")
+	}
+
+	formatted, err := format.Source(buf.Bytes())
+	if err != nil {
+		outPath := filepath.Join(projectRoot, "internal", "handler", "gen_bench_test.go")
+		os.WriteFile(outPath, buf.Bytes(), 0644)
+		return fmt.Errorf("formatting benchmarks: %w", err)
+	}
+
+	outPath := filepath.Join(projectRoot, "internal", "handler", "gen_bench_test.go")
+	return os.WriteFile(outPath, formatted, 0644)
+}
+
+// fixtureFunc names the generated create-payload fixture of an entity.
+func fixtureFunc(ent *entityInfo) string {
+	return strings.ToLower(ent.Name[:1]) + ent.Name[1:] + "Fixture"
+}
+
+// generateFixturesFile emits a create-payload fixture per bench target. The
+// benchmarks seed rows from them and handler tests start from them.
+func generateFixturesFile(projectRoot string, targets []benchTarget) error {
+	var buf cw
+	buf.line("// Code generated by cmd/handlergen from CUE ontology. DO NOT EDIT.")
+	buf.line("package handler")
+	buf.line("")
+	buf.line("import (")
+	buf.line("\t\"fmt\"")
+	buf.line("\t\"time\"")
+	buf.line(")")
+	buf.line("")
+	buf.line("// fixtureTime is the fixed timestamp used for required time fields.")
+	buf.line("var fixtureTime = time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)")
+	buf.line("")
+	for _, t := range targets {
+		buf.line("// %s returns a create payload for the i-th %s row.", fixtureFunc(t.Entity), t.Entity.Name)
+		buf.line("func %s(i int) map[string]any {", fixtureFunc(t.Entity))
+		buf.line("\treturn map[string]any{")
+		for _, f := range t.Entity.Fields {
+			if f.Optional || f.Computed {
+				continue
+			}
+			writeFixtureValue(&buf, f)
+		}
+		buf.line("\t}")
+		buf.line("}")
+		buf.line("")
+	}
+
+	outPath := filepath.Join(projectRoot, "internal", "handler", "gen_fixtures_test.go")
+	formatted, err := format.Source(buf.Bytes())
+	if err != nil {
+		os.WriteFile(outPath, buf.Bytes(), 0644)
+		return fmt.Errorf("formatting fixtures: %w", err)
+	}
+	return os.WriteFile(outPath, formatted, 0644)
+}
+
 // ─── Main ────────────────────────────────────────────────────────────────────
 
 func main() {
+	bench := flag.Bool("bench", false, "also emit internal/handler/gen_bench_test.go")
+	flag.Parse()
+
 	log.SetFlags(0)
 	log.SetPrefix("handlergen: ")
 
@@ -1424,10 +2458,25 @@ func main() {
 	}
 
 	// Generate routes
-	if err := generateRoutesFile(projectRoot, services, handlerTypes); err != nil {
+	if err := generateRoutesFile(projectRoot, services, handlerTypes, entities); err != nil {
 		log.Fatalf("generating routes: %v", err)
 	}
 	fmt.Println("Generated internal/server/gen_routes.go")
 
+	// Fixtures are always emitted: handler tests build on them even when the
+	// benchmarks are not generated.
+	targets, skipped := collectBenchTargets(services, entities, handlerTypes)
+	if err := generateFixturesFile(projectRoot, targets); err != nil {
+		log.Fatalf("generating fixtures: %v", err)
+	}
+	fmt.Printf("Generated internal/handler/gen_fixtures_test.go (%d entities)\n", len(targets))
+
+	if *bench {
+		if err := generateBenchFile(projectRoot, targets, skipped); err != nil {
+			log.Fatalf("generating benchmarks: %v", err)
+		}
+		fmt.Printf("Generated internal/handler/gen_bench_test.go (%d entities, %d skipped)\n", len(targets), len(skipped))
+	}
+
 	fmt.Printf("handlergen: generated %d handler files + routes\n", len(services))
 }
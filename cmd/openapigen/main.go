@@ -2,33 +2,48 @@
 //
 // Output: gen/openapi/openapi.json
 //
+// With -split, openapi.json only stitches the spec together: every path item
+// and component schema is written to its own file under gen/openapi/components/
+// and referenced with a relative $ref.
+//
 // This follows the same architecture as the other generators — load CUE,
 // iterate entities + services, emit a single derived artifact.
 package main
 
 import (
 	"encoding/json"
+	"flag"
 	"fmt"
 	"log"
 	"os"
 	"path/filepath"
+	"regexp"
 	"sort"
 	"strings"
 
 	"cuelang.org/go/cue"
 	"cuelang.org/go/cue/cuecontext"
 	"cuelang.org/go/cue/load"
+
+	"github.com/matthewbaird/ontology/ent/schema"
+	"github.com/matthewbaird/ontology/internal/cueparse"
 )
 
 // ─── Data types ──────────────────────────────────────────────────────────────
 
 type fieldDef struct {
-	Name       string
-	FieldType  string // "string","int","float","bool","time","enum","json","money","uuid"
-	Optional   bool
-	EnumValues []string
-	JSONType   string // Go type for JSON fields (e.g. "[]string", "types.Money")
-	Deprecated bool   // @deprecated() — mark in OpenAPI output
+	Name        string
+	FieldType   string // "string","int","float","bool","time","enum","json","money","uuid"
+	Optional    bool
+	EnumValues  []string
+	JSONType    string // Go type for JSON fields (e.g. "[]string", "types.Money")
+	Format      string // OpenAPI format from schema.FieldDocs, captured by entgen
+	Deprecated  bool   // @deprecated() — mark in OpenAPI output
+	Description string // from schema.FieldDocs, captured by entgen
+
+	// From @deprecated(reason="...", since="..."); appended to the description.
+	DeprecatedReason string
+	DeprecatedSince  string
 }
 
 type entityInfo struct {
@@ -37,37 +52,45 @@ type entityInfo struct {
 }
 
 type serviceDef struct {
-	Name       string
-	Operations []operationDef
+	Name        string
+	Description string
+	Entities    []string
+	Operations  []operationDef
 }
 
 type operationDef struct {
 	Name        string
+	Service     string // owning service; the operation's tag
 	Entity      string
 	Type        string // create, get, list, update, delete, transition
 	EntityPath  string
 	Action      string
 	ToStatus    string
+	ExtraFields []string
+	Paginated   bool // list only; false returns a bare array
+	Public      bool // exempt from the global bearer-token requirement
 	Description string
 }
 
 // ─── Known type maps ─────────────────────────────────────────────────────────
 
-var moneyFieldNames = map[string]bool{
-	"#Money": true, "#NonNegativeMoney": true, "#PositiveMoney": true,
+// valueTypeExamples are realistic sample values for known value types, used
+// as schema examples in docs and mock servers.
+var valueTypeExamples = map[string]map[string]interface{}{
+	"#Money": {"amount_cents": 125000, "currency": "USD"},
+	"#Address": {
+		"line1":       "742 Evergreen Terrace",
+		"line2":       "Apt 2B",
+		"city":        "Springfield",
+		"state":       "OR",
+		"postal_code": "97477",
+		"country":     "US",
+	},
+	"#ContactMethod": {"type": "email", "value": "tenant@example.com", "primary": true, "verified": true, "opt_out": false},
+	"#DateRange":     {"start": "2025-01-01T00:00:00Z", "end": "2025-12-31T00:00:00Z"},
 }
 
-var knownValueTypes = map[string]bool{
-	"#Money": true, "#NonNegativeMoney": true, "#PositiveMoney": true,
-	"#Address": true, "#ContactMethod": true, "#DateRange": true,
-	"#EntityRef": true, "#RentScheduleEntry": true, "#RecurringCharge": true,
-	"#LateFeePolicy": true, "#CAMTerms": true, "#TenantImprovement": true,
-	"#RenewalOption": true, "#SubsidyTerms": true, "#AccountDimensions": true,
-	"#JournalLine": true, "#RoleAttributes": true, "#TenantAttributes": true,
-	"#OwnerAttributes": true, "#ManagerAttributes": true, "#GuarantorAttributes": true,
-}
-
-// ─── CUE parsing (simplified from entgen/handlergen) ─────────────────────────
+// ─── CUE parsing ─────────────────────────────────────────────────────────────
 
 func findProjectRoot() string {
 	dir, _ := os.Getwd()
@@ -83,200 +106,42 @@ func findProjectRoot() string {
 	}
 }
 
-func findReference(val cue.Value) string {
-	_, path := val.ReferencePath()
-	if path.String() != "" {
-		sels := path.Selectors()
-		if len(sels) > 0 {
-			return sels[len(sels)-1].String()
-		}
-	}
-	op, args := val.Expr()
-	if op == cue.AndOp || op == cue.OrOp {
-		for _, a := range args {
-			if r := findReference(a); r != "" {
-				return r
-			}
-		}
-	}
-	if op == cue.SelectorOp && len(args) >= 2 {
-		if s, err := args[1].String(); err == nil && s == "Time" {
-			return "time.Time"
-		}
-	}
-	return ""
-}
-
-func isTimeField(val cue.Value) bool {
-	r := findReference(val)
-	return r == "time.Time" || r == "Time"
-}
+// classifyField maps a classified CUE field to its OpenAPI field type. It
+// returns nil for fields cueparse could not classify.
+func classifyField(cf cueparse.Field) *fieldDef {
+	fd := &fieldDef{Name: cf.Name, Optional: cf.Optional}
 
-func isEnum(val cue.Value) bool {
-	op, args := findEnumDisjunction(val)
-	if op != cue.OrOp || len(args) < 2 {
-		return false
-	}
-	for _, a := range args {
-		check := a
-		aOp, aArgs := a.Expr()
-		if aOp == cue.SelectorOp && len(aArgs) > 0 {
-			check = aArgs[0]
-		}
-		if check.IncompleteKind() != cue.StringKind {
-			return false
-		}
-		if _, err := check.String(); err != nil {
-			if d, ok := check.Default(); ok {
-				if _, err := d.String(); err != nil {
-					return false
-				}
-			} else {
-				return false
-			}
-		}
-	}
-	return true
-}
-
-func extractEnumValues(val cue.Value) []string {
-	_, args := findEnumDisjunction(val)
-	var values []string
-	for _, arg := range args {
-		if s, err := arg.String(); err == nil {
-			values = append(values, s)
-			continue
-		}
-		if d, ok := arg.Default(); ok {
-			if s, err := d.String(); err == nil {
-				values = append(values, s)
-			}
-		}
-	}
-	return values
-}
-
-// findEnumDisjunction extracts the OrOp disjunction from a value that may be
-// wrapped in an AndOp (e.g., when a field from an embedded base type is
-// unified with enum values in the entity).
-func findEnumDisjunction(val cue.Value) (cue.Op, []cue.Value) {
-	op, args := val.Expr()
-	if op == cue.OrOp {
-		return op, args
-	}
-	if op == cue.AndOp {
-		for _, arg := range args {
-			argOp, argArgs := arg.Expr()
-			if argOp == cue.OrOp {
-				return argOp, argArgs
-			}
-		}
-	}
-	return op, args
-}
-
-func inferKindFromExpr(val cue.Value) cue.Kind {
-	op, args := val.Expr()
-	if op == cue.AndOp || op == cue.OrOp {
-		for _, a := range args {
-			if k := a.IncompleteKind(); k != cue.BottomKind {
-				return k
-			}
-			if k := inferKindFromExpr(a); k != cue.BottomKind {
-				return k
-			}
-		}
-	}
-	return cue.BottomKind
-}
-
-func inferListElementKind(val cue.Value) cue.Kind {
-	op, args := val.Expr()
-	if op == cue.AndOp || op == cue.OrOp {
-		for _, a := range args {
-			elem := a.LookupPath(cue.MakePath(cue.AnyIndex))
-			if elem.Err() == nil {
-				k := elem.IncompleteKind()
-				if k != cue.BottomKind {
-					return k
-				}
-			}
-			if k := inferListElementKind(a); k != cue.BottomKind {
-				return k
-			}
-		}
-	}
-	return cue.BottomKind
-}
-
-func classifyField(name string, val cue.Value, optional bool) *fieldDef {
-	fd := &fieldDef{Name: name, Optional: optional}
-
-	if isTimeField(val) {
+	switch cf.Kind {
+	case cueparse.Time:
 		fd.FieldType = "time"
-		return fd
-	}
-
-	ref := findReference(val)
-	if ref != "" {
-		if moneyFieldNames[ref] {
-			return &fieldDef{Name: name, FieldType: "money", Optional: optional}
-		}
-		if knownValueTypes[ref] {
-			fd.FieldType = "json"
-			fd.JSONType = ref
-			return fd
-		}
-	}
-
-	if val.IncompleteKind() == cue.ListKind || inferKindFromExpr(val) == cue.ListKind {
+	case cueparse.Money:
+		fd.FieldType = "money"
+	case cueparse.ValueType:
 		fd.FieldType = "json"
-		elem := val.LookupPath(cue.MakePath(cue.AnyIndex))
-		if elem.Err() == nil {
-			if elem.IncompleteKind() == cue.StringKind || isEnum(elem) {
-				fd.JSONType = "[]string"
-				return fd
-			}
-			eRef := findReference(elem)
-			if eRef != "" && knownValueTypes[eRef] {
-				fd.JSONType = "[]" + eRef
-				return fd
-			}
+		fd.JSONType = cf.Ref
+		if cf.List {
+			fd.JSONType = "[]" + cf.Ref
 		}
-		if inferListElementKind(val) == cue.StringKind {
+	case cueparse.List:
+		fd.FieldType = "json"
+		fd.JSONType = "object"
+		if cf.ElemKind == cueparse.String {
 			fd.JSONType = "[]string"
-			return fd
 		}
-		fd.JSONType = "object"
-		return fd
-	}
-
-	if isEnum(val) {
+	case cueparse.Enum:
 		fd.FieldType = "enum"
-		fd.EnumValues = extractEnumValues(val)
-		return fd
-	}
-
-	kind := val.IncompleteKind()
-	if kind == cue.BottomKind {
-		kind = inferKindFromExpr(val)
-	}
-
-	switch kind {
-	case cue.StringKind:
+		fd.EnumValues = cf.EnumValues
+	case cueparse.String:
 		fd.FieldType = "string"
-	case cue.IntKind:
+	case cueparse.Int:
 		fd.FieldType = "int"
-	case cue.FloatKind, cue.NumberKind:
+	case cueparse.Float:
 		fd.FieldType = "float"
-	case cue.BoolKind:
+	case cueparse.Bool:
 		fd.FieldType = "bool"
+	case cueparse.Struct, cueparse.Any:
+		fd.FieldType = "object"
 	default:
-		// Top type (_) or mixed kind → object (JSON)
-		if kind != 0 && kind != cue.BottomKind {
-			fd.FieldType = "object"
-			return fd
-		}
 		return nil
 	}
 	return fd
@@ -284,39 +149,49 @@ func classifyField(name string, val cue.Value, optional bool) *fieldDef {
 
 // ─── Entity and service parsing ──────────────────────────────────────────────
 
+// fieldComments returns the .Comment of each field of an entity's Ent
+// schema, which entgen sets from the field's CUE doc comment.
+func fieldComments(entity string) map[string]string {
+	comments := make(map[string]string)
+	s, ok := schema.Schemas[entity]
+	if !ok {
+		return comments
+	}
+	for _, f := range s.Fields() {
+		if d := f.Descriptor(); d.Comment != "" {
+			comments[d.Name] = d.Comment
+		}
+	}
+	return comments
+}
+
 func parseEntities(val cue.Value) map[string]*entityInfo {
 	entities := make(map[string]*entityInfo)
-	iter, _ := val.Fields(cue.Definitions(true))
-	for iter.Next() {
-		label := iter.Selector().String()
-		defVal := iter.Value()
-		if defVal.LookupPath(cue.ParsePath("id")).Err() != nil {
-			continue
-		}
-		if defVal.LookupPath(cue.ParsePath("audit")).Err() != nil {
-			continue
-		}
-		name := strings.TrimPrefix(label, "#")
-		// Skip base entity types — not domain entities
-		if name == "BaseEntity" || name == "StatefulEntity" || name == "ImmutableEntity" {
-			continue
-		}
-		ent := &entityInfo{Name: name}
-		fIter, _ := defVal.Fields(cue.Optional(true))
-		for fIter.Next() {
-			fLabel := strings.TrimSuffix(fIter.Selector().String(), "?")
-			if fLabel == "id" || fLabel == "audit" || strings.HasPrefix(fLabel, "_") {
-				continue
-			}
-			fd := classifyField(fLabel, fIter.Value(), fIter.IsOptional())
+	for _, e := range cueparse.Entities(val) {
+		ent := &entityInfo{Name: e.Name}
+		comments := fieldComments(e.Name)
+		for _, cf := range e.Fields {
+			fd := classifyField(cf)
 			if fd != nil {
-				if a := fIter.Value().Attribute("deprecated"); a.Err() == nil {
-					fd.Deprecated = true
+				// Descriptions, formats, enum values and deprecations come from
+				// entgen's capture, not from re-reading CUE, so the spec and
+				// Ent schema cannot diverge.
+				doc := schema.FieldDocs[e.Name][cf.Name]
+				fd.Description = doc.Description
+				if c := comments[cf.Name]; c != "" {
+					fd.Description = c
+				}
+				fd.Format = doc.Format
+				if len(doc.EnumValues) > 0 {
+					fd.EnumValues = doc.EnumValues
 				}
+				fd.Deprecated = doc.Deprecated
+				fd.DeprecatedReason = doc.DeprecatedReason
+				fd.DeprecatedSince = doc.DeprecatedSince
 				ent.Fields = append(ent.Fields, *fd)
 			}
 		}
-		entities[name] = ent
+		entities[e.Name] = ent
 	}
 	return entities
 }
@@ -340,17 +215,35 @@ func parseServices(ctx *cue.Context, projectRoot string) []serviceDef {
 		s := iter.Value()
 		svc := serviceDef{}
 		svc.Name, _ = s.LookupPath(cue.ParsePath("name")).String()
+		svc.Description, _ = s.LookupPath(cue.ParsePath("description")).String()
+		eIter, _ := s.LookupPath(cue.ParsePath("entities")).List()
+		for eIter.Next() {
+			if e, err := eIter.Value().String(); err == nil {
+				svc.Entities = append(svc.Entities, e)
+			}
+		}
 		opList := s.LookupPath(cue.ParsePath("operations"))
 		oIter, _ := opList.List()
 		for oIter.Next() {
 			o := oIter.Value()
-			op := operationDef{}
+			op := operationDef{Service: svc.Name}
 			op.Name, _ = o.LookupPath(cue.ParsePath("name")).String()
 			op.Entity, _ = o.LookupPath(cue.ParsePath("entity")).String()
 			op.Type, _ = o.LookupPath(cue.ParsePath("type")).String()
 			op.EntityPath, _ = o.LookupPath(cue.ParsePath("entity_path")).String()
 			op.Action, _ = o.LookupPath(cue.ParsePath("action")).String()
 			op.ToStatus, _ = o.LookupPath(cue.ParsePath("to_status")).String()
+			op.Public, _ = o.LookupPath(cue.ParsePath("public")).Bool()
+			op.Paginated = true
+			if p, err := o.LookupPath(cue.ParsePath("paginated")).Bool(); err == nil {
+				op.Paginated = p
+			}
+			efIter, _ := o.LookupPath(cue.ParsePath("extra_fields")).List()
+			for efIter.Next() {
+				if ef, err := efIter.Value().String(); err == nil {
+					op.ExtraFields = append(op.ExtraFields, ef)
+				}
+			}
 			op.Description, _ = o.LookupPath(cue.ParsePath("description")).String()
 			svc.Operations = append(svc.Operations, op)
 		}
@@ -398,6 +291,59 @@ func (om *orderedMap) MarshalJSON() ([]byte, error) {
 }
 
 func fieldToSchema(f fieldDef) map[string]interface{} {
+	s := fieldTypeSchema(f)
+	if s == nil {
+		return nil
+	}
+	if f.Format != "" {
+		s["format"] = f.Format
+	}
+	var parts []string
+	if f.Description != "" {
+		parts = append(parts, f.Description)
+	}
+	if f.Deprecated {
+		s["deprecated"] = true
+		if note := deprecationNote(f); note != "" {
+			parts = append(parts, note)
+		}
+	}
+	if table, ok := s["description"].(string); ok {
+		parts = append(parts, table)
+	}
+	if len(parts) > 0 {
+		s["description"] = strings.Join(parts, "\n\n")
+	}
+	return s
+}
+
+// deprecationNote renders @deprecated's arguments as migration guidance,
+// e.g. "Deprecated since v2: use new_field instead". It is empty when the
+// attribute carries neither a reason nor a version.
+func deprecationNote(f fieldDef) string {
+	if f.DeprecatedReason == "" && f.DeprecatedSince == "" {
+		return ""
+	}
+	note := "Deprecated"
+	if f.DeprecatedSince != "" {
+		note += " since " + f.DeprecatedSince
+	}
+	if f.DeprecatedReason != "" {
+		note += ": " + f.DeprecatedReason
+	}
+	return note
+}
+
+// moneySchemas returns the schemas for a flattened money field's
+// _amount_cents and _currency properties, with the #Money example values.
+func moneySchemas() (amount, currency map[string]interface{}) {
+	ex := valueTypeExamples["#Money"]
+	amount = map[string]interface{}{"type": "integer", "format": "int64", "example": ex["amount_cents"]}
+	currency = map[string]interface{}{"type": "string", "pattern": "^[A-Z]{3}$", "example": ex["currency"]}
+	return amount, currency
+}
+
+func fieldTypeSchema(f fieldDef) map[string]interface{} {
 	switch f.FieldType {
 	case "string":
 		return map[string]interface{}{"type": "string"}
@@ -412,7 +358,7 @@ func fieldToSchema(f fieldDef) map[string]interface{} {
 	case "uuid":
 		return map[string]interface{}{"type": "string", "format": "uuid"}
 	case "enum":
-		return map[string]interface{}{"type": "string", "enum": f.EnumValues}
+		return enumSchema(f.EnumValues)
 	case "money":
 		// Money is handled specially — expanded to two fields
 		return nil
@@ -424,16 +370,112 @@ func fieldToSchema(f fieldDef) map[string]interface{} {
 			}
 		}
 		if strings.HasPrefix(f.JSONType, "[]") {
-			return map[string]interface{}{
+			s := map[string]interface{}{
 				"type":  "array",
 				"items": map[string]interface{}{"type": "object"},
 			}
+			if ex, ok := valueTypeExamples[strings.TrimPrefix(f.JSONType, "[]")]; ok {
+				s["example"] = []interface{}{ex}
+			}
+			return s
 		}
-		return map[string]interface{}{"type": "object"}
+		s := map[string]interface{}{"type": "object"}
+		if ex, ok := valueTypeExamples[f.JSONType]; ok {
+			s["example"] = ex
+		}
+		return s
 	}
 	return map[string]interface{}{"type": "string"}
 }
 
+// enumSchema describes a string enum with friendly names: x-enum-varnames
+// gives client generators an identifier per value, and the description is a
+// markdown table of each value's display label.
+func enumSchema(values []string) map[string]interface{} {
+	varNames := make([]string, len(values))
+	var table strings.Builder
+	table.WriteString("| Value | Label |\n| --- | --- |\n")
+	for i, v := range values {
+		varNames[i] = enumVarName(v)
+		fmt.Fprintf(&table, "| `%s` | %s |\n", v, enumLabel(v))
+	}
+	return map[string]interface{}{
+		"type":            "string",
+		"enum":            values,
+		"x-enum-varnames": varNames,
+		"description":     strings.TrimSuffix(table.String(), "\n"),
+	}
+}
+
+// Abbreviations kept upper-case in enum labels and variable names.
+// Kept in sync with cmd/uigen.
+var knownAbbreviations = map[string]string{
+	"nnn": "NNN", "nn": "NN", "cam": "CAM", "ach": "ACH",
+	"cpi": "CPI", "nsf": "NSF", "hud": "HUD", "lihtc": "LIHTC",
+	"ami": "AMI", "id": "ID", "uuid": "UUID", "url": "URL",
+	"ssn": "SSN", "dba": "DBA", "ein": "EIN", "itin": "ITIN",
+	"hoa": "HOA", "ada": "ADA", "hvac": "HVAC", "pbv": "PBV",
+	"vash": "VASH", "gl": "GL",
+}
+
+// Known phrases for enum labels. Kept in sync with cmd/uigen.
+var knownPhrases = map[string]string{
+	"section_8":               "Section 8",
+	"month_to_month":          "Month to Month",
+	"month_to_month_holdover": "Month-to-Month",
+	"joint_and_several":       "Joint and Several",
+	"by_the_bed":              "By the Bed",
+	"not_started":             "Not Started",
+	"in_progress":             "In Progress",
+	"single_family":           "Single Family",
+	"multi_family":            "Multi-Family",
+	"mixed_use":               "Mixed Use",
+	"notice_given":            "Notice Given",
+	"make_ready":              "Make Ready",
+	"owner_occupied":          "Owner Occupied",
+	"common_area":             "Common Area",
+	"lot_pad":                 "Lot / Pad",
+	"bed_space":               "Bed Space",
+	"desk_space":              "Desk Space",
+	"per_day":                 "Per Day",
+	"full_service":            "Full Service",
+}
+
+// enumWords splits a snake_case enum value into capitalized words,
+// upper-casing known abbreviations.
+func enumWords(value string) []string {
+	var words []string
+	for _, p := range strings.Split(value, "_") {
+		if p == "" {
+			continue
+		}
+		if abbr, ok := knownAbbreviations[strings.ToLower(p)]; ok {
+			words = append(words, abbr)
+		} else {
+			words = append(words, strings.ToUpper(p[:1])+p[1:])
+		}
+	}
+	return words
+}
+
+// enumLabel returns the display label uigen shows for an enum value.
+func enumLabel(value string) string {
+	if label, ok := knownPhrases[value]; ok {
+		return label
+	}
+	return strings.Join(enumWords(value), " ")
+}
+
+// enumVarName returns a PascalCase identifier for an enum value. Values that
+// start with a digit (e.g. "1099") are prefixed so the name stays valid.
+func enumVarName(value string) string {
+	name := strings.Join(enumWords(value), "")
+	if name == "" || (name[0] >= '0' && name[0] <= '9') {
+		name = "Value" + name
+	}
+	return name
+}
+
 func buildEntitySchema(ent *entityInfo) *orderedMap {
 	schema := newOrderedMap()
 	schema.Set("type", "object")
@@ -450,8 +492,11 @@ func buildEntitySchema(ent *entityInfo) *orderedMap {
 			// Expand Money fields to two properties
 			amtName := f.Name + "_amount_cents"
 			curName := f.Name + "_currency"
-			props.Set(amtName, map[string]interface{}{"type": "integer", "format": "int64", "description": f.Name + " amount in cents"})
-			props.Set(curName, map[string]interface{}{"type": "string", "pattern": "^[A-Z]{3}$", "description": f.Name + " ISO 4217 currency code"})
+			amt, cur := moneySchemas()
+			amt["description"] = f.Name + " amount in cents"
+			cur["description"] = f.Name + " ISO 4217 currency code"
+			props.Set(amtName, amt)
+			props.Set(curName, cur)
 			if !f.Optional {
 				required = append(required, amtName)
 			}
@@ -460,9 +505,6 @@ func buildEntitySchema(ent *entityInfo) *orderedMap {
 
 		s := fieldToSchema(f)
 		if s != nil {
-			if f.Deprecated {
-				s["deprecated"] = true
-			}
 			props.Set(f.Name, s)
 			if !f.Optional {
 				required = append(required, f.Name)
@@ -485,10 +527,12 @@ func buildEntitySchema(ent *entityInfo) *orderedMap {
 
 func httpMethod(opType string) string {
 	switch opType {
-	case "create":
+	case "create", "bulk":
 		return "post"
 	case "get":
 		return "get"
+	case "exists":
+		return "head"
 	case "list":
 		return "get"
 	case "update":
@@ -505,7 +549,11 @@ func buildPathItem(op operationDef, entities map[string]*entityInfo) map[string]
 	item := map[string]interface{}{
 		"operationId": op.Name,
 		"summary":     op.Description,
-		"tags":        []string{op.Entity},
+		"tags":        []string{op.Service},
+	}
+	if op.Public {
+		// An empty requirement list overrides the global bearerAuth.
+		item["security"] = []map[string][]string{}
 	}
 
 	switch op.Type {
@@ -529,7 +577,36 @@ func buildPathItem(op operationDef, entities map[string]*entityInfo) map[string]
 					},
 				},
 			},
-			"400": map[string]interface{}{"description": "Bad Request"},
+			"400": errorResponse("Bad Request"),
+			"422": errorResponse("Constraint Violation"),
+		}
+
+	case "bulk":
+		item["requestBody"] = map[string]interface{}{
+			"required": true,
+			"content": map[string]interface{}{
+				"application/json": map[string]interface{}{
+					"schema": map[string]interface{}{
+						"type":  "array",
+						"items": map[string]interface{}{"$ref": "#/components/schemas/" + op.Entity + "Create"},
+					},
+				},
+			},
+		}
+		item["responses"] = map[string]interface{}{
+			"201": map[string]interface{}{
+				"description": "Created",
+				"content": map[string]interface{}{
+					"application/json": map[string]interface{}{
+						"schema": map[string]interface{}{
+							"type":  "array",
+							"items": map[string]interface{}{"$ref": "#/components/schemas/" + op.Entity},
+						},
+					},
+				},
+			},
+			"400": errorResponse("Bad Request; per-item errors are listed by index"),
+			"422": errorResponse("Constraint Violation"),
 		}
 
 	case "get":
@@ -545,24 +622,40 @@ func buildPathItem(op operationDef, entities map[string]*entityInfo) map[string]
 					},
 				},
 			},
+			"404": errorResponse("Not Found"),
+		}
+
+	case "exists":
+		item["parameters"] = []map[string]interface{}{
+			{"name": "id", "in": "path", "required": true, "schema": map[string]interface{}{"type": "string", "format": "uuid"}},
+		}
+		item["responses"] = map[string]interface{}{
+			"200": map[string]interface{}{"description": "Exists"},
 			"404": map[string]interface{}{"description": "Not Found"},
 		}
 
 	case "list":
 		item["parameters"] = []map[string]interface{}{
-			{"name": "limit", "in": "query", "schema": map[string]interface{}{"type": "integer", "default": 50}},
-			{"name": "offset", "in": "query", "schema": map[string]interface{}{"type": "integer", "default": 0}},
+			{"name": "limit", "in": "query", "schema": map[string]interface{}{"type": "integer", "default": 20, "minimum": 1, "maximum": 100}},
+			{"name": "offset", "in": "query", "schema": map[string]interface{}{"type": "integer", "default": 0, "minimum": 0}},
+			{"name": "sort", "in": "query", "description": "Column to sort by; unknown columns fall back to created_at",
+				"schema": map[string]interface{}{"type": "string", "default": "created_at"}},
+			{"name": "order", "in": "query", "schema": map[string]interface{}{"type": "string", "enum": []string{"asc", "desc"}, "default": "desc"}},
+		}
+		var listSchema map[string]interface{}
+		if op.Paginated {
+			listSchema = map[string]interface{}{"$ref": "#/components/schemas/" + op.Entity + "List"}
+		} else {
+			listSchema = map[string]interface{}{
+				"type":  "array",
+				"items": map[string]interface{}{"$ref": "#/components/schemas/" + op.Entity},
+			}
 		}
 		item["responses"] = map[string]interface{}{
 			"200": map[string]interface{}{
 				"description": "OK",
 				"content": map[string]interface{}{
-					"application/json": map[string]interface{}{
-						"schema": map[string]interface{}{
-							"type":  "array",
-							"items": map[string]interface{}{"$ref": "#/components/schemas/" + op.Entity},
-						},
-					},
+					"application/json": map[string]interface{}{"schema": listSchema},
 				},
 			},
 		}
@@ -571,14 +664,18 @@ func buildPathItem(op operationDef, entities map[string]*entityInfo) map[string]
 		item["parameters"] = []map[string]interface{}{
 			{"name": "id", "in": "path", "required": true, "schema": map[string]interface{}{"type": "string", "format": "uuid"}},
 		}
+		updateRef := map[string]interface{}{
+			"$ref": "#/components/schemas/" + op.Entity + "Update",
+		}
 		item["requestBody"] = map[string]interface{}{
 			"required": true,
+			"description": "application/json: absent and null members are left unchanged. " +
+				"application/merge-patch+json (RFC 7386): absent members are left unchanged, " +
+				"null clears an optional member and is rejected for a required one; " +
+				"an object member is merged into the stored value.",
 			"content": map[string]interface{}{
-				"application/json": map[string]interface{}{
-					"schema": map[string]interface{}{
-						"$ref": "#/components/schemas/" + op.Entity + "Update",
-					},
-				},
+				"application/json":             map[string]interface{}{"schema": updateRef},
+				"application/merge-patch+json": map[string]interface{}{"schema": updateRef},
 			},
 		}
 		item["responses"] = map[string]interface{}{
@@ -590,8 +687,9 @@ func buildPathItem(op operationDef, entities map[string]*entityInfo) map[string]
 					},
 				},
 			},
-			"400": map[string]interface{}{"description": "Bad Request"},
-			"404": map[string]interface{}{"description": "Not Found"},
+			"400": errorResponse("Bad Request"),
+			"404": errorResponse("Not Found"),
+			"422": errorResponse("Constraint Violation"),
 		}
 
 	case "transition":
@@ -603,6 +701,15 @@ func buildPathItem(op operationDef, entities map[string]*entityInfo) map[string]
 			desc += fmt.Sprintf(" (transitions to %q)", op.ToStatus)
 		}
 		item["summary"] = desc
+		if len(op.ExtraFields) > 0 {
+			item["requestBody"] = map[string]interface{}{
+				"content": map[string]interface{}{
+					"application/json": map[string]interface{}{
+						"schema": buildTransitionSchema(op, entities[op.Entity]),
+					},
+				},
+			}
+		}
 		item["responses"] = map[string]interface{}{
 			"200": map[string]interface{}{
 				"description": "OK",
@@ -612,13 +719,98 @@ func buildPathItem(op operationDef, entities map[string]*entityInfo) map[string]
 					},
 				},
 			},
-			"409": map[string]interface{}{"description": "Invalid State Transition"},
+			"404": errorResponse("Not Found"),
+			"409": errorResponse("Invalid State Transition"),
+			"422": errorResponse("Constraint Violation"),
 		}
 	}
 
+	responses, _ := item["responses"].(map[string]interface{})
+	if responses == nil {
+		responses = map[string]interface{}{}
+		item["responses"] = responses
+	}
+	responses["500"] = errorResponse("Internal Server Error")
+
 	return item
 }
 
+// errorResponse is a response whose body is the shared Error schema.
+// buildTags returns one tag per service, in apigen.cue order, described by
+// the service's description and the entities it owns.
+func buildTags(services []serviceDef) []map[string]interface{} {
+	tags := make([]map[string]interface{}, 0, len(services))
+	for _, svc := range services {
+		description := svc.Description + "."
+		if len(svc.Entities) > 0 {
+			description += " Entities: " + strings.Join(svc.Entities, ", ") + "."
+		}
+		tags = append(tags, map[string]interface{}{
+			"name":        svc.Name,
+			"description": description,
+		})
+	}
+	return tags
+}
+
+func errorResponse(description string) map[string]interface{} {
+	return map[string]interface{}{
+		"description": description,
+		"content": map[string]interface{}{
+			"application/json": map[string]interface{}{
+				"schema": map[string]interface{}{"$ref": "#/components/schemas/Error"},
+			},
+		},
+	}
+}
+
+// buildTransitionSchema describes a transition's extra_fields. A field that
+// names an entity column reuses that column's schema; otherwise date-named
+// fields are date-times and everything else is a string.
+func buildTransitionSchema(op operationDef, ent *entityInfo) *orderedMap {
+	schema := newOrderedMap()
+	schema.Set("type", "object")
+	props := newOrderedMap()
+	for _, ef := range op.ExtraFields {
+		var s map[string]interface{}
+		if ent != nil {
+			for _, f := range ent.Fields {
+				if f.Name == ef {
+					s = fieldToSchema(f)
+					break
+				}
+			}
+		}
+		if s == nil {
+			s = map[string]interface{}{"type": "string"}
+			if strings.Contains(ef, "date") {
+				s["format"] = "date-time"
+			}
+		}
+		props.Set(ef, s)
+	}
+	schema.Set("properties", props)
+	return schema
+}
+
+// buildListSchema is the {data, total, offset, limit} envelope returned by a
+// paginated list operation.
+func buildListSchema(entity string) *orderedMap {
+	schema := newOrderedMap()
+	schema.Set("type", "object")
+	props := newOrderedMap()
+	props.Set("data", map[string]interface{}{
+		"type":  "array",
+		"items": map[string]interface{}{"$ref": "#/components/schemas/" + entity},
+	})
+	props.Set("total", map[string]interface{}{"type": "integer", "description": "Matching rows before offset/limit"})
+	props.Set("offset", map[string]interface{}{"type": "integer"})
+	props.Set("limit", map[string]interface{}{"type": "integer"})
+	schema.Set("properties", props)
+	schema.Set("required", []string{"data", "total", "offset", "limit"})
+	return schema
+}
+
 func buildCreateSchema(ent *entityInfo) *orderedMap {
 	schema := newOrderedMap()
 	schema.Set("type", "object")
@@ -629,8 +821,9 @@ func buildCreateSchema(ent *entityInfo) *orderedMap {
 		if f.FieldType == "money" {
 			amtName := f.Name + "_amount_cents"
 			curName := f.Name + "_currency"
-			props.Set(amtName, map[string]interface{}{"type": "integer", "format": "int64"})
-			props.Set(curName, map[string]interface{}{"type": "string", "pattern": "^[A-Z]{3}$"})
+			amt, cur := moneySchemas()
+			props.Set(amtName, amt)
+			props.Set(curName, cur)
 			if !f.Optional {
 				required = append(required, amtName)
 			}
@@ -662,8 +855,9 @@ func buildUpdateSchema(ent *entityInfo) *orderedMap {
 		if f.FieldType == "money" {
 			amtName := f.Name + "_amount_cents"
 			curName := f.Name + "_currency"
-			props.Set(amtName, map[string]interface{}{"type": "integer", "format": "int64"})
-			props.Set(curName, map[string]interface{}{"type": "string", "pattern": "^[A-Z]{3}$"})
+			amt, cur := moneySchemas()
+			props.Set(amtName, amt)
+			props.Set(curName, cur)
 			continue
 		}
 		s := fieldToSchema(f)
@@ -692,6 +886,8 @@ func toSnake(s string) string {
 func main() {
 	log.SetFlags(0)
 	log.SetPrefix("openapigen: ")
+	split := flag.Bool("split", false, "write paths and schemas to separate files under gen/openapi/components/")
+	flag.Parse()
 
 	ctx := cuecontext.New()
 	projectRoot := findProjectRoot()
@@ -720,6 +916,7 @@ func main() {
 	spec.Set("servers", []map[string]interface{}{
 		{"url": "http://localhost:8080", "description": "Local development"},
 	})
+	spec.Set("tags", buildTags(services))
 
 	// Build paths from operations
 	paths := newOrderedMap()
@@ -730,7 +927,9 @@ func main() {
 			switch op.Type {
 			case "create":
 				path = basePath
-			case "get":
+			case "bulk":
+				path = basePath + "/bulk"
+			case "get", "exists":
 				path = basePath + "/{id}"
 			case "list":
 				path = basePath
@@ -756,6 +955,7 @@ func main() {
 			entry.Set(method, pathItem)
 		}
 	}
+	spec.Set("security", []map[string][]string{{"bearerAuth": {}}})
 	spec.Set("paths", paths)
 
 	// Build component schemas
@@ -768,16 +968,21 @@ func main() {
 	}
 	sort.Strings(entityNames)
 
-	// Track which entities need Create/Update schemas
+	// Track which entities need Create/Update/List schemas
 	needsCreate := map[string]bool{}
 	needsUpdate := map[string]bool{}
+	needsList := map[string]bool{}
 	for _, svc := range services {
 		for _, op := range svc.Operations {
 			switch op.Type {
-			case "create":
+			case "create", "bulk":
 				needsCreate[op.Entity] = true
 			case "update":
 				needsUpdate[op.Entity] = true
+			case "list":
+				if op.Paginated {
+					needsList[op.Entity] = true
+				}
 			}
 		}
 	}
@@ -791,34 +996,157 @@ func main() {
 		if needsUpdate[name] {
 			schemas.Set(name+"Update", buildUpdateSchema(ent))
 		}
+		if needsList[name] {
+			schemas.Set(name+"List", buildListSchema(name))
+		}
 	}
 
 	// Error response schema
+	// Error mirrors writeError in internal/handler: {"error": message, "code": code}.
+	// Constraint violations also name the offending field, and VALIDATION_FAILED
+	// responses list every invalid field, per item by index for bulk requests.
+	fieldErrors := func(description string) map[string]interface{} {
+		return map[string]interface{}{
+			"type":        "object",
+			"description": description,
+			"additionalProperties": map[string]interface{}{
+				"type": "object",
+				"properties": map[string]interface{}{
+					"code":    map[string]interface{}{"type": "string"},
+					"field":   map[string]interface{}{"type": "string"},
+					"error":   map[string]interface{}{"type": "string"},
+					"allowed": map[string]interface{}{"type": "array", "items": map[string]interface{}{"type": "string"}},
+				},
+			},
+		}
+	}
 	schemas.Set("Error", map[string]interface{}{
 		"type": "object",
 		"properties": map[string]interface{}{
-			"code":    map[string]interface{}{"type": "string"},
-			"message": map[string]interface{}{"type": "string"},
+			"code":   map[string]interface{}{"type": "string"},
+			"error":  map[string]interface{}{"type": "string"},
+			"field":  map[string]interface{}{"type": "string"},
+			"fields": fieldErrors("VALIDATION_FAILED: every invalid field of the request body, keyed by field"),
+			"items": map[string]interface{}{
+				"type":                 "object",
+				"description":          "VALIDATION_FAILED on a bulk request: the invalid fields of each rejected item, keyed by its index",
+				"additionalProperties": fieldErrors("the invalid fields of one item, keyed by field"),
+			},
 		},
-		"required": []string{"code", "message"},
+		"required": []string{"code", "error"},
 	})
 
 	components := newOrderedMap()
 	components.Set("schemas", schemas)
+	components.Set("securitySchemes", map[string]interface{}{
+		"bearerAuth": map[string]interface{}{
+			"type":         "http",
+			"scheme":       "bearer",
+			"bearerFormat": "JWT",
+		},
+	})
 	spec.Set("components", components)
 
-	// Write output
-	outPath := filepath.Join(projectRoot, "gen", "openapi", "openapi.json")
-	data, err := json.MarshalIndent(spec, "", "    ")
-	if err != nil {
-		log.Fatalf("marshaling OpenAPI spec: %v", err)
+	// Write output. Split files from an earlier -split run are removed so
+	// they never sit next to a spec that doesn't reference them.
+	outDir := filepath.Join(projectRoot, "gen", "openapi")
+	if err := os.RemoveAll(filepath.Join(outDir, componentsDir)); err != nil {
+		log.Fatalf("removing old split output: %v", err)
 	}
-	// Add trailing newline
-	data = append(data, '\n')
-	if err := os.WriteFile(outPath, data, 0644); err != nil {
+	outPath := filepath.Join(outDir, "openapi.json")
+	if *split {
+		files, err := writeSplitSpec(outDir, spec, paths, schemas)
+		if err != nil {
+			log.Fatalf("writing split spec: %v", err)
+		}
+		fmt.Printf("openapigen: generated %s and %d component files (%d paths, %d schemas)\n",
+			outPath, files, len(paths.keys), len(schemas.keys))
+		return
+	}
+	data, err := writeJSON(outPath, spec)
+	if err != nil {
 		log.Fatalf("writing %s: %v", outPath, err)
 	}
 
 	fmt.Printf("openapigen: generated %s (%d bytes, %d paths, %d schemas)\n",
 		outPath, len(data), len(paths.keys), len(schemas.keys))
 }
+
+// ─── Split output ────────────────────────────────────────────────────────────
+
+// componentsDir holds the per-path and per-schema files of a -split spec,
+// relative to gen/openapi.
+const componentsDir = "components"
+
+// schemaRef matches the internal $ref targets buildPathItem and the schema
+// builders emit, capturing the schema name.
+var schemaRef = regexp.MustCompile(`"#/components/schemas/([A-Za-z0-9_]+)"`)
+
+// pathFileName turns an API path into a file name: /v1/leases/{id} becomes
+// v1_leases_id.json.
+func pathFileName(path string) string {
+	return strings.NewReplacer("/", "_", "{", "", "}", "").Replace(strings.TrimPrefix(path, "/")) + ".json"
+}
+
+// writeSplitSpec writes each path item to components/paths/ and each schema to
+// components/schemas/, rewriting internal $refs into relative file refs, then
+// writes a root openapi.json that $refs every file. It returns the number of
+// component files written.
+func writeSplitSpec(outDir string, spec, paths, schemas *orderedMap) (int, error) {
+	for _, dir := range []string{"paths", "schemas"} {
+		if err := os.MkdirAll(filepath.Join(outDir, componentsDir, dir), 0755); err != nil {
+			return 0, err
+		}
+	}
+	files := 0
+	write := func(rel, refPrefix string, v interface{}) error {
+		data, err := json.MarshalIndent(v, "", "    ")
+		if err != nil {
+			return fmt.Errorf("marshaling %s: %w", rel, err)
+		}
+		data = schemaRef.ReplaceAll(data, []byte(`"`+refPrefix+`$1.json"`))
+		files++
+		return os.WriteFile(filepath.Join(outDir, rel), append(data, '\n'), 0644)
+	}
+
+	rootPaths := newOrderedMap()
+	for _, path := range paths.keys {
+		rel := filepath.ToSlash(filepath.Join(componentsDir, "paths", pathFileName(path)))
+		if err := write(rel, "../schemas/", paths.values[path]); err != nil {
+			return files, err
+		}
+		rootPaths.Set(path, map[string]interface{}{"$ref": rel})
+	}
+	rootSchemas := newOrderedMap()
+	for _, name := range schemas.keys {
+		rel := filepath.ToSlash(filepath.Join(componentsDir, "schemas", name+".json"))
+		if err := write(rel, "", schemas.values[name]); err != nil {
+			return files, err
+		}
+		rootSchemas.Set(name, map[string]interface{}{"$ref": rel})
+	}
+
+	root := newOrderedMap()
+	for _, key := range spec.keys {
+		root.Set(key, spec.values[key])
+	}
+	root.Set("paths", rootPaths)
+	components := newOrderedMap()
+	for _, key := range spec.values["components"].(*orderedMap).keys {
+		components.Set(key, spec.values["components"].(*orderedMap).values[key])
+	}
+	components.Set("schemas", rootSchemas)
+	root.Set("components", components)
+	_, err := writeJSON(filepath.Join(outDir, "openapi.json"), root)
+	return files, err
+}
+
+// writeJSON writes v as indented JSON with a trailing newline.
+func writeJSON(path string, v interface{}) ([]byte, error) {
+	data, err := json.MarshalIndent(v, "", "    ")
+	if err != nil {
+		return nil, err
+	}
+	data = append(data, '\n')
+	return data, os.WriteFile(path, data, 0644)
+}
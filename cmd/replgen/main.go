@@ -13,6 +13,7 @@ import (
 	"log"
 	"os"
 	"path/filepath"
+	"slices"
 	"sort"
 	"strings"
 	"text/template"
@@ -21,6 +22,8 @@ import (
 	"cuelang.org/go/cue"
 	"cuelang.org/go/cue/cuecontext"
 	"cuelang.org/go/cue/load"
+
+	"github.com/matthewbaird/ontology/internal/cueparse"
 )
 
 // ── Data structures ─────────────────────────────────────────────────────────
@@ -43,6 +46,9 @@ type fieldInfo struct {
 	Optional   bool
 	Sensitive  bool
 	EnumValues []string
+	Formatter  string // display formatter: "plain", "money_pair", "timestamp", "enum", "uuid", "json"
+	MoneyGroup string // money field name shared by the _amount_cents/_currency halves
+	EdgeFK     bool   // FK that entgen replaces with an edge, so it has no Ent column of its own
 }
 
 type edgeInfo struct {
@@ -53,24 +59,6 @@ type edgeInfo struct {
 	Unique      bool
 }
 
-// Known value types that map to Money (flattened in Ent).
-var moneyTypes = map[string]bool{
-	"#Money": true, "#NonNegativeMoney": true, "#PositiveMoney": true,
-}
-
-// Known value types that map to JSON in Ent.
-var jsonTypes = map[string]bool{
-	"#Money": true, "#NonNegativeMoney": true, "#PositiveMoney": true,
-	"#Address": true, "#ContactMethod": true, "#DateRange": true,
-	"#EntityRef": true, "#RentScheduleEntry": true, "#RecurringCharge": true,
-	"#LateFeePolicy": true, "#CAMTerms": true, "#TenantImprovement": true,
-	"#RenewalOption": true, "#SubsidyTerms": true, "#AccountDimensions": true,
-	"#JournalLine": true, "#RoleAttributes": true, "#TenantAttributes": true,
-	"#OwnerAttributes": true, "#ManagerAttributes": true, "#GuarantorAttributes": true,
-	"#UsageBasedCharge": true, "#PercentageRent": true, "#RentAdjustment": true,
-	"#ExpansionRight": true, "#ContractionRight": true, "#CAMCategoryTerms": true,
-}
-
 // ── CUE field-level attributes ──────────────────────────────────────────────
 
 type fieldAttrs struct {
@@ -116,6 +104,7 @@ func main() {
 
 	// Parse relationships
 	parseRelationships(val, entities)
+	markEdgeFKs(entities)
 
 	// Parse state machines
 	parseStateMachines(val, entities)
@@ -149,31 +138,15 @@ func main() {
 func parseEntities(val cue.Value) map[string]*entityInfo {
 	entities := make(map[string]*entityInfo)
 
-	iter, _ := val.Fields(cue.Definitions(true))
-	for iter.Next() {
-		label := iter.Selector().String()
-		defVal := iter.Value()
-
-		// Entity detection: has "id" and "audit" fields
-		idField := defVal.LookupPath(cue.ParsePath("id"))
-		auditField := defVal.LookupPath(cue.ParsePath("audit"))
-		if idField.Err() != nil || auditField.Err() != nil {
-			continue
-		}
-
-		name := strings.TrimPrefix(label, "#")
-		if name == "BaseEntity" || name == "StatefulEntity" || name == "ImmutableEntity" {
-			continue
-		}
-
+	for _, e := range cueparse.Entities(val) {
 		ent := &entityInfo{
-			Name:       name,
-			PQLName:    toSnake(name),
+			Name:       e.Name,
+			PQLName:    toSnake(e.Name),
 			EnumFields: make(map[string][]string),
 		}
 
 		// Parse fields
-		ent.Fields = parseFields(defVal)
+		ent.Fields = parseFields(e.Fields)
 
 		// Build enum field map
 		for _, f := range ent.Fields {
@@ -183,58 +156,53 @@ func parseEntities(val cue.Value) map[string]*entityInfo {
 		}
 
 		// Check immutability via hidden _immutable field
-		immField := defVal.LookupPath(cue.ParsePath("_immutable"))
+		immField := e.Value.LookupPath(cue.ParsePath("_immutable"))
 		if immField.Err() == nil {
 			ent.Immutable = true
 		}
 
-		entities[name] = ent
+		entities[e.Name] = ent
 	}
 
 	return entities
 }
 
-func parseFields(structVal cue.Value) []fieldInfo {
+func parseFields(cueFields []cueparse.Field) []fieldInfo {
 	var fields []fieldInfo
 
-	iter, _ := structVal.Fields(cue.Optional(true))
-	for iter.Next() {
-		label := iter.Selector().String()
-		fieldVal := iter.Value()
-
-		label = strings.TrimSuffix(label, "?")
-
-		// Skip id, audit, hidden fields
-		if label == "id" || label == "audit" || strings.HasPrefix(label, "_") {
-			continue
-		}
+	for _, cf := range cueFields {
+		label, fieldVal := cf.Name, cf.Value
 
 		// Check for money fields — these get flattened to two fields
-		refName := findReference(fieldVal)
-		if moneyTypes[refName] {
+		if cf.Kind == cueparse.Money {
 			attrs := extractAttributes(fieldVal)
-			optional := iter.IsOptional()
+			optional := cf.Optional
 			fields = append(fields, fieldInfo{
 				Name:      label + "_amount_cents",
 				EntColumn: label + "_amount_cents",
-				Type:      "Int64",
-				Optional:  optional,
-				Sensitive: attrs.sensitive,
+				Type:       "Int64",
+				Optional:   optional,
+				Sensitive:  attrs.sensitive,
+				Formatter:  "money_pair",
+				MoneyGroup: label,
 			})
 			fields = append(fields, fieldInfo{
 				Name:      label + "_currency",
 				EntColumn: label + "_currency",
-				Type:      "String",
-				Optional:  optional,
-				Sensitive: attrs.sensitive,
+				Type:       "String",
+				Optional:   optional,
+				Sensitive:  attrs.sensitive,
+				Formatter:  "money_pair",
+				MoneyGroup: label,
 			})
 			continue
 		}
 
-		fi := classifyField(label, fieldVal, iter.IsOptional())
+		fi := classifyField(cf)
 		if fi != nil {
 			attrs := extractAttributes(fieldVal)
 			fi.Sensitive = attrs.sensitive
+			fi.Formatter = typeFormatter(fi.Type)
 			fields = append(fields, *fi)
 		}
 	}
@@ -242,192 +210,64 @@ func parseFields(structVal cue.Value) []fieldInfo {
 	return fields
 }
 
-// classifyField returns nil for money fields (handled separately in parseFields).
-func classifyField(name string, val cue.Value, optional bool) *fieldInfo {
-	fi := &fieldInfo{
-		Name:      name,
-		EntColumn: name,
-		Optional:  optional,
-	}
-
-	// Check for time.Time
-	if isTimeField(val) {
-		fi.Type = "Time"
-		return fi
+// aggregatable reports whether sum and avg apply to fields of the given type.
+// Money amounts are Int64 and qualify; their currency halves do not.
+func aggregatable(t string) bool {
+	switch t {
+	case "Int", "Int64", "Float":
+		return true
 	}
+	return false
+}
 
-	// Check for references to known types
-	refName := findReference(val)
-	if refName != "" {
-		// Money fields are flattened; return nil to signal special handling
-		if moneyTypes[refName] {
-			return nil
-		}
-		// Other known types → JSON
-		if jsonTypes[refName] {
-			fi.Type = "JSON"
-			return fi
-		}
+// typeFormatter picks the display formatter for a non-money field type.
+func typeFormatter(t string) string {
+	switch t {
+	case "Time":
+		return "timestamp"
+	case "Enum":
+		return "enum"
+	case "UUID":
+		return "uuid"
+	case "JSON":
+		return "json"
+	default:
+		return "plain"
 	}
+}
 
-	// Check for list types
-	if isList(val) {
-		fi.Type = "JSON"
-		return fi
+// classifyField maps a classified CUE field to its Ent type. It returns nil
+// for money fields (handled separately in parseFields) and for fields
+// cueparse could not classify.
+func classifyField(cf cueparse.Field) *fieldInfo {
+	fi := &fieldInfo{
+		Name:      cf.Name,
+		EntColumn: cf.Name,
+		Optional:  cf.Optional,
 	}
 
-	// Check for enums
-	if isEnum(val) {
+	switch cf.Kind {
+	case cueparse.Time:
+		fi.Type = "Time"
+	case cueparse.Enum:
 		fi.Type = "Enum"
-		fi.EnumValues = extractEnumValues(val)
-		return fi
-	}
-
-	kind := val.IncompleteKind()
-	if kind == cue.BottomKind {
-		kind = inferKindFromExpr(val)
-	}
-
-	switch kind {
-	case cue.StringKind:
+		fi.EnumValues = cf.EnumValues
+	case cueparse.String:
 		fi.Type = "String"
-	case cue.IntKind:
+	case cueparse.Int:
 		fi.Type = "Int"
-	case cue.FloatKind, cue.NumberKind:
+	case cueparse.Float:
 		fi.Type = "Float"
-	case cue.BoolKind:
+	case cueparse.Bool:
 		fi.Type = "Bool"
-	case cue.ListKind:
-		fi.Type = "JSON"
-	case cue.StructKind:
+	case cueparse.ValueType, cueparse.List, cueparse.Struct, cueparse.Any:
 		fi.Type = "JSON"
 	default:
-		if kind != 0 && kind != cue.BottomKind {
-			fi.Type = "JSON"
-			return fi
-		}
 		return nil
 	}
-
 	return fi
 }
 
-// ── CUE type detection helpers (mirrored from cmd/entgen) ───────────────────
-
-func findReference(val cue.Value) string {
-	_, path := val.ReferencePath()
-	if path.String() != "" {
-		selectors := path.Selectors()
-		if len(selectors) > 0 {
-			return selectors[len(selectors)-1].String()
-		}
-	}
-	op, args := val.Expr()
-	if op == cue.AndOp || op == cue.OrOp {
-		for _, arg := range args {
-			if ref := findReference(arg); ref != "" {
-				return ref
-			}
-		}
-	}
-	if op == cue.SelectorOp && len(args) >= 2 {
-		if s, err := args[1].String(); err == nil && s == "Time" {
-			return "time.Time"
-		}
-	}
-	return ""
-}
-
-func isTimeField(val cue.Value) bool {
-	ref := findReference(val)
-	return ref == "time.Time" || ref == "Time"
-}
-
-func isList(val cue.Value) bool {
-	if val.IncompleteKind() == cue.ListKind {
-		return true
-	}
-	op, args := val.Expr()
-	if op == cue.AndOp {
-		for _, arg := range args {
-			if arg.IncompleteKind() == cue.ListKind {
-				return true
-			}
-		}
-	}
-	_ = args
-	return false
-}
-
-func isEnum(val cue.Value) bool {
-	op, args := val.Expr()
-	if op == cue.OrOp && len(args) >= 2 {
-		for _, arg := range args {
-			if arg.IncompleteKind() == cue.StringKind {
-				if _, err := arg.String(); err == nil {
-					return true
-				}
-			}
-		}
-	}
-	// Check through AndOp wrapping (from embedded types)
-	if op == cue.AndOp {
-		for _, arg := range args {
-			if isEnum(arg) {
-				return true
-			}
-		}
-	}
-	return false
-}
-
-func extractEnumValues(val cue.Value) []string {
-	return findEnumDisjunction(val)
-}
-
-func findEnumDisjunction(val cue.Value) []string {
-	op, args := val.Expr()
-	if op == cue.OrOp {
-		var values []string
-		for _, arg := range args {
-			// Skip default markers
-			if dop, _ := arg.Expr(); dop == cue.OrOp {
-				continue
-			}
-			if s, err := arg.String(); err == nil {
-				values = append(values, s)
-			}
-		}
-		if len(values) > 0 {
-			return values
-		}
-	}
-	if op == cue.AndOp {
-		for _, arg := range args {
-			if vals := findEnumDisjunction(arg); len(vals) > 0 {
-				return vals
-			}
-		}
-	}
-	return nil
-}
-
-func inferKindFromExpr(val cue.Value) cue.Kind {
-	op, args := val.Expr()
-	if op == cue.AndOp {
-		for _, arg := range args {
-			k := arg.IncompleteKind()
-			if k != cue.BottomKind && k != 0 {
-				return k
-			}
-		}
-	}
-	if op == cue.OrOp && len(args) > 0 {
-		return args[0].IncompleteKind()
-	}
-	return cue.BottomKind
-}
-
 // ── Relationship parsing ────────────────────────────────────────────────────
 
 func parseRelationships(val cue.Value, entities map[string]*entityInfo) {
@@ -474,6 +314,25 @@ func parseRelationships(val cue.Value, entities map[string]*entityInfo) {
 	}
 }
 
+// markEdgeFKs flags the FK fields entgen removes in favour of an edge: a
+// unique edge's {edge}_id or {target}_id field. Composite names such as
+// applicant_person_id stay real columns bound to their edge.
+func markEdgeFKs(entities map[string]*entityInfo) {
+	for _, ent := range entities {
+		for _, e := range ent.Edges {
+			if !e.Unique {
+				continue
+			}
+			for _, cand := range []string{e.Name + "_id", e.Target + "_id"} {
+				if i := slices.IndexFunc(ent.Fields, func(f fieldInfo) bool { return f.Name == cand && !f.EdgeFK }); i >= 0 {
+					ent.Fields[i].EdgeFK = true
+					break
+				}
+			}
+		}
+	}
+}
+
 func invertCardinality(card string) string {
 	switch card {
 	case "O2M":
@@ -503,7 +362,7 @@ func parseStateMachines(val cue.Value, entities map[string]*entityInfo) {
 			var targets []string
 			targetIter, _ := smIter.Value().List()
 			for targetIter.Next() {
-				if s, err := targetIter.Value().String(); err == nil {
+				if s, err := cueparse.TransitionTarget(targetIter.Value()).String(); err == nil {
 					targets = append(targets, s)
 				}
 			}
@@ -518,6 +377,8 @@ func generateRegistryFile(dir string, entities []*entityInfo) error {
 	tmpl := template.Must(template.New("registry").Funcs(template.FuncMap{
 		"quote":    func(s string) string { return fmt.Sprintf("%q", s) },
 		"fieldType": mapFieldType,
+		"formatter": mapFormatter,
+		"aggregatable": aggregatable,
 	}).Parse(registryTemplate))
 
 	var buf bytes.Buffer
@@ -541,6 +402,16 @@ func generateDispatchFile(dir string, entities []*entityInfo) error {
 		"pascal":   toPascal,
 		"toPascal": toPascal,
 		"quote":    func(s string) string { return fmt.Sprintf("%q", s) },
+		"entPascal": entPascal,
+		"aggregatable": aggregatable,
+		"hasSensitive": func(fields []fieldInfo) bool {
+			for _, f := range fields {
+				if f.Sensitive {
+					return true
+				}
+			}
+			return false
+		},
 	}).Parse(dispatchTemplate))
 
 	var buf bytes.Buffer
@@ -557,6 +428,23 @@ func generateDispatchFile(dir string, entities []*entityInfo) error {
 	return os.WriteFile(filepath.Join(dir, "gen_dispatch.go"), formatted, 0o644)
 }
 
+func mapFormatter(f string) string {
+	switch f {
+	case "money_pair":
+		return "FormatMoneyPair"
+	case "timestamp":
+		return "FormatTimestamp"
+	case "enum":
+		return "FormatEnum"
+	case "uuid":
+		return "FormatUUID"
+	case "json":
+		return "FormatJSON"
+	default:
+		return "FormatPlain"
+	}
+}
+
 func mapFieldType(t string) string {
 	switch t {
 	case "String":
@@ -609,6 +497,32 @@ func toPascal(s string) string {
 	return strings.Join(parts, "")
 }
 
+// goInitialisms matches Ent's PascalCase behavior (only standard Go initialisms).
+var goInitialisms = map[string]bool{
+	"acl": true, "api": true, "ascii": true, "cpu": true, "css": true,
+	"dns": true, "eof": true, "guid": true, "html": true, "http": true,
+	"https": true, "id": true, "ip": true, "json": true, "lhs": true,
+	"qps": true, "ram": true, "rhs": true, "rpc": true, "sla": true,
+	"smtp": true, "sql": true, "ssh": true, "tcp": true, "tls": true,
+	"ttl": true, "udp": true, "ui": true, "uid": true, "uuid": true,
+	"uri": true, "url": true, "utf8": true, "vm": true, "xml": true,
+	"xmpp": true, "xsrf": true, "xss": true,
+}
+
+// entPascal converts snake_case to the PascalCase Ent uses for generated
+// identifiers such as enum types and predicates (tax_id_type → TaxIDType).
+func entPascal(s string) string {
+	parts := strings.Split(s, "_")
+	for i, p := range parts {
+		if goInitialisms[strings.ToLower(p)] {
+			parts[i] = strings.ToUpper(p)
+		} else if len(p) > 0 {
+			parts[i] = strings.ToUpper(p[:1]) + p[1:]
+		}
+	}
+	return strings.Join(parts, "")
+}
+
 func findProjectRoot() string {
 	dir, err := os.Getwd()
 	if err != nil {
@@ -648,6 +562,13 @@ func InitRegistry() *Registry {
 				Sensitive: {{.Sensitive}},
 {{- if .EnumValues}}
 				EnumValues: []string{ {{- range $i, $v := .EnumValues}}{{if $i}}, {{end}}{{quote $v}}{{end -}} },
+{{- end}}
+				Formatter: {{formatter .Formatter}},
+{{- if .MoneyGroup}}
+				MoneyGroup: {{quote .MoneyGroup}},
+{{- end}}
+{{- if .EdgeFK}}
+				EdgeFK: true,
 {{- end}}
 			},
 {{- end}}
@@ -670,6 +591,13 @@ func InitRegistry() *Registry {
 		EdgeOrder: []string{
 {{- range .Edges}}
 			{{quote .Name}},
+{{- end}}
+		},
+		Aggregatable: []string{
+{{- range .Fields}}
+{{- if aggregatable .Type}}
+			{{quote .Name}},
+{{- end}}
 {{- end}}
 		},
 		HasStateMachine: {{.HasMachine}},
@@ -681,6 +609,31 @@ func InitRegistry() *Registry {
 {{- end}}
 		},
 {{- end}}
+		description: Description{
+			Entity:    {{quote .PQLName}},
+			EntName:   {{quote .Name}},
+			Immutable: {{.Immutable}},
+			Fields: []FieldDescription{
+{{- range .Fields}}
+				{Name: {{quote .Name}}, Type: {{fieldType .Type}}, Optional: {{.Optional}}, Sensitive: {{.Sensitive}}
+					{{- if .EnumValues}}, EnumValues: []string{ {{- range $i, $v := .EnumValues}}{{if $i}}, {{end}}{{quote $v}}{{end -}} }{{end}}},
+{{- end}}
+			},
+{{- if .Edges}}
+			Edges: []EdgeDescription{
+{{- range .Edges}}
+				{Name: {{quote .Name}}, Target: {{quote .Target}}, Cardinality: {{quote .Cardinality}}},
+{{- end}}
+			},
+{{- end}}
+{{- if .HasMachine}}
+			Transitions: []TransitionDescription{
+{{- range $from, $targets := .Machine}}
+				{From: {{quote $from}}{{if $targets}}, To: []string{ {{- range $i, $t := $targets}}{{if $i}}, {{end}}{{quote $t}}{{end -}} }{{end}}},
+{{- end}}
+			},
+{{- end}}
+		},
 	})
 {{end}}
 	return r
@@ -692,6 +645,7 @@ package executor
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 
 	"entgo.io/ent/dialect/sql"
@@ -718,6 +672,50 @@ func InitDispatchers() *DispatchRegistry {
 {{end}}	return dr
 }
 
+// sensitiveFields lists each entity's @sensitive()/@pii() columns. Their
+// values are masked in REPL output unless sensitive output is requested.
+var sensitiveFields = map[string]map[string]bool{
+{{- range .}}
+{{- if hasSensitive .Fields}}
+	{{quote .PQLName}}: {
+{{- range .Fields}}
+{{- if .Sensitive}}
+		{{quote .EntColumn}}: true,
+{{- end}}
+{{- end}}
+	},
+{{- end}}
+{{- end}}
+}
+
+// redactedValue replaces sensitive values in REPL output.
+const redactedValue = "***"
+
+// redactSensitive returns row with the entity's sensitive columns masked.
+// Rows of entities without sensitive columns are returned unchanged;
+// others are re-encoded as a JSON object so only present values are masked.
+func redactSensitive(entity string, row any) (any, error) {
+	fields := sensitiveFields[entity]
+	if len(fields) == 0 {
+		return row, nil
+	}
+	data, err := json.Marshal(row)
+	if err != nil {
+		return nil, err
+	}
+	var obj map[string]json.RawMessage
+	if err := json.Unmarshal(data, &obj); err != nil {
+		return nil, err
+	}
+	masked, _ := json.Marshal(redactedValue)
+	for name := range fields {
+		if v, ok := obj[name]; ok && string(v) != "null" {
+			obj[name] = masked
+		}
+	}
+	return obj, nil
+}
+
 // buildSQLPredicate converts a PredicateSpec to a raw SQL selector predicate.
 func buildSQLPredicate(spec planner.PredicateSpec) func(*sql.Selector) {
 	switch spec.Op {
@@ -762,17 +760,63 @@ func (d *{{lower .Name}}Dispatcher) Get(ctx context.Context, client *ent.Client,
 }
 
 type {{lower .Name}}QueryHandle struct {
-	q *ent.{{.Name}}Query
+	q             *ent.{{.Name}}Query
+	showSensitive bool
 }
 
 func (h *{{lower .Name}}QueryHandle) Where(specs ...planner.PredicateSpec) QueryHandle {
 	for _, spec := range specs {
+{{- if .EnumFields}}
+		if p, ok := {{lower .Name}}EnumPredicate(spec); ok {
+			h.q = h.q.Where(p)
+			continue
+		}
+{{- end}}
 		p := buildSQLPredicate(spec)
 		h.q = h.q.Where(predicate.{{.Name}}(p))
 	}
 	return h
 }
 
+{{- if .EnumFields}}
+{{- $pkg := lower .Name}}
+
+// {{$pkg}}EnumPredicate builds typed Ent predicates for enum fields. It
+// reports false for other fields, other operators and non-string values,
+// which fall back to buildSQLPredicate.
+func {{$pkg}}EnumPredicate(spec planner.PredicateSpec) (predicate.{{.Name}}, bool) {
+	switch spec.Field {
+{{- range .Fields}}
+{{- if .EnumValues}}
+{{- $t := entPascal .Name}}
+	case {{quote .EntColumn}}:
+		switch spec.Op {
+		case planner.OpEQ:
+			if v, ok := spec.Value.(string); ok {
+				return {{$pkg}}.{{$t}}EQ({{$pkg}}.{{$t}}(v)), true
+			}
+		case planner.OpNEQ:
+			if v, ok := spec.Value.(string); ok {
+				return {{$pkg}}.{{$t}}NEQ({{$pkg}}.{{$t}}(v)), true
+			}
+		case planner.OpIn:
+			vs := make([]{{$pkg}}.{{$t}}, 0, len(spec.Values))
+			for _, v := range spec.Values {
+				s, ok := v.(string)
+				if !ok {
+					return nil, false
+				}
+				vs = append(vs, {{$pkg}}.{{$t}}(s))
+			}
+			return {{$pkg}}.{{$t}}In(vs...), true
+		}
+{{- end}}
+{{- end}}
+	}
+	return nil, false
+}
+{{- end}}
+
 func (h *{{lower .Name}}QueryHandle) WithEdge(name string) QueryHandle {
 	switch name {
 {{- range .Edges}}
@@ -783,12 +827,56 @@ func (h *{{lower .Name}}QueryHandle) WithEdge(name string) QueryHandle {
 	return h
 }
 
+{{- $name := .Name}}
+
+// {{lower .Name}}Edges maps each {{.Name}} edge to a traversal returning the
+// related entities' query handle.
+var {{lower .Name}}Edges = map[string]EdgeTraversal{
+{{- range .Edges}}
+	{{quote .Name}}: {
+		Target: {{quote .Target}},
+		Unique: {{.Unique}},
+		Query: func(client *ent.Client, id uuid.UUID) QueryHandle {
+			return &{{lower (pascal .Target)}}QueryHandle{q: client.{{$name}}.Query().Where({{lower $name}}.ID(id)).Query{{.WithMethod}}(){{if .Unique}}.Limit(1){{end}}}
+		},
+	},
+{{- end}}
+}
+
+// Edges returns the {{.Name}} edge traversals.
+func (d *{{lower .Name}}Dispatcher) Edges() map[string]EdgeTraversal {
+	return {{lower .Name}}Edges
+}
+
+// {{lower .Name}}OrderColumns maps each orderable {{.Name}} field to its Ent
+// column. JSON fields have no meaningful order and edge FKs no column of
+// their own, so both are left out.
+var {{lower .Name}}OrderColumns = map[string]string{
+	"id": {{lower .Name}}.FieldID,
+{{- range .Fields}}
+{{- if and (ne .Type "JSON") (not .EdgeFK)}}
+	{{quote .EntColumn}}: {{lower $name}}.Field{{entPascal .EntColumn}},
+{{- end}}
+{{- end}}
+}
+
+// OrderBy sorts by an orderable column. The planner has already validated the
+// field; anything else is passed through so Ent rejects it when the query runs.
 func (h *{{lower .Name}}QueryHandle) OrderBy(field string, desc bool) QueryHandle {
-	var opts []sql.OrderTermOption
+	column, ok := {{lower .Name}}OrderColumns[field]
+	if !ok {
+		column = field
+	}
+	order := ent.Asc
 	if desc {
-		opts = append(opts, sql.OrderDesc())
+		order = ent.Desc
 	}
-	h.q = h.q.Order({{lower .Name}}.OrderOption(sql.OrderByField(field, opts...).ToFunc()))
+	h.q = h.q.Order(order(column))
+	return h
+}
+
+func (h *{{lower .Name}}QueryHandle) ShowSensitive() QueryHandle {
+	h.showSensitive = true
 	return h
 }
 
@@ -809,7 +897,13 @@ func (h *{{lower .Name}}QueryHandle) All(ctx context.Context) ([]any, error) {
 	}
 	out := make([]any, len(results))
 	for i, r := range results {
-		out[i] = r
+		if h.showSensitive {
+			out[i] = r
+			continue
+		}
+		if out[i], err = redactSensitive({{quote .PQLName}}, r); err != nil {
+			return nil, err
+		}
 	}
 	return out, nil
 }
@@ -817,6 +911,31 @@ func (h *{{lower .Name}}QueryHandle) All(ctx context.Context) ([]any, error) {
 func (h *{{lower .Name}}QueryHandle) Count(ctx context.Context) (int, error) {
 	return h.q.Count(ctx)
 }
+
+// {{lower .Name}}Aggregatable lists the {{.Name}} columns valid for sum and avg.
+var {{lower .Name}}Aggregatable = map[string]bool{
+{{- range .Fields}}
+{{- if aggregatable .Type}}
+	{{quote .EntColumn}}: true,
+{{- end}}
+{{- end}}
+}
+
+func (d *{{lower .Name}}Dispatcher) Aggregate(ctx context.Context, client *ent.Client, fn AggregateFunc, column string, specs ...planner.PredicateSpec) (float64, error) {
+	h := d.Query(client).Where(specs...).(*{{lower .Name}}QueryHandle)
+	if fn == AggregateCount {
+		n, err := h.q.Count(ctx)
+		return float64(n), err
+	}
+	if !{{lower .Name}}Aggregatable[column] {
+		return 0, fmt.Errorf("cannot %s '%s': not a numeric field of {{.PQLName}}", fn, column)
+	}
+	agg, err := aggregateColumn(fn, column)
+	if err != nil {
+		return 0, err
+	}
+	return h.q.Aggregate(agg).Float64(ctx)
+}
 {{- if not .Immutable}}
 
 func (d *{{lower .Name}}Dispatcher) Create(ctx context.Context, client *ent.Client, fields map[string]any) (any, error) {
@@ -846,3 +965,4 @@ func (d *{{lower .Name}}Dispatcher) Delete(ctx context.Context, client *ent.Clie
 }
 {{- end}}
 {{end}}`
+
@@ -33,9 +33,11 @@ func main() {
 	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
 	defer stop()
 
+	// _time_format=sqlite stores times in a fixed layout that round-trips, so
+	// the If-Match guard on updated_at can compare a read value for equality.
 	dsn := os.Getenv("DATABASE_URL")
 	if dsn == "" {
-		dsn = "file:ontology.db?_pragma=foreign_keys(1)"
+		dsn = "file:ontology.db?_pragma=foreign_keys(1)&_time_format=sqlite"
 	}
 
 	db, err := sql.Open("sqlite", dsn)
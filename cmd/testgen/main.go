@@ -21,6 +21,8 @@ import (
 	"cuelang.org/go/cue"
 	"cuelang.org/go/cue/cuecontext"
 	"cuelang.org/go/cue/load"
+
+	"github.com/matthewbaird/ontology/internal/cueparse"
 )
 
 type transitionTest struct {
@@ -72,7 +74,7 @@ func main() {
 			var targets []string
 			tIter, _ := sIter.Value().List()
 			for tIter.Next() {
-				if s, err := tIter.Value().String(); err == nil {
+				if s, err := cueparse.TransitionTarget(tIter.Value()).String(); err == nil {
 					targets = append(targets, s)
 				}
 			}
@@ -198,3 +200,4 @@ func findProjectRoot() string {
 		dir = parent
 	}
 }
+
@@ -9,9 +9,14 @@
 //
 //	CUE Type                     UI Field Type
 //	string (short)               "string"
+//	string (email name/pattern)  "email"
+//	string (phone name/pattern)  "phone"
+//	string (url name/pattern)    "url"
 //	string (description/notes)   "text"
 //	int                          "int"
 //	float                        "float"
+//	float @percent()             "percent"
+//	int @duration(unit)          "duration" (unit: "days", "months", ...)
 //	bool                         "bool"
 //	time.Time (date context)     "date"
 //	time.Time                    "datetime"
@@ -27,20 +32,30 @@
 //	#Struct                      "embedded_object"
 //	name_id + relationship       "entity_ref"
 //	name_ids                     "entity_ref_list"
+//
+// Entity references can be scoped by a sibling field with @ref_filter(field):
+// space_id tagged @ref_filter(property_id) only offers spaces whose
+// property_id matches the form's property_id.
 package main
 
 import (
 	"encoding/json"
+	"flag"
 	"fmt"
 	"log"
 	"os"
 	"path/filepath"
+	"regexp"
+	"slices"
 	"sort"
+	"strconv"
 	"strings"
 
 	"cuelang.org/go/cue"
 	"cuelang.org/go/cue/cuecontext"
 	"cuelang.org/go/cue/load"
+
+	"github.com/matthewbaird/ontology/internal/cueparse"
 )
 
 // ── Data structures ──────────────────────────────────────────────────────────
@@ -73,9 +88,14 @@ type UIFieldDef struct {
 	RefDisplay            string      `json:"ref_display,omitempty"`
 	RefFilter             any         `json:"ref_filter,omitempty"`
 	MoneyVariant          string      `json:"money_variant,omitempty"`
+	Currencies            []string    `json:"currencies,omitempty"`
+	DurationUnit          string      `json:"duration_unit,omitempty"`
 	Required              bool        `json:"required"`
 	Default               any         `json:"default"`
 	Immutable             bool        `json:"immutable,omitempty"`
+	ReadonlyAfterCreate   bool        `json:"readonly_after_create,omitempty"` // @immutable_after_create(): editable on create, locked on update
+	RelativeTime          bool        `json:"relative_time,omitempty"`         // @relative_time(): detail view adds "in 12 days" / "3 days ago"
+	IsExpiry              bool        `json:"is_expiry,omitempty"`             // @relative_time(expiry): hint warns as the date nears and flags it once past
 	ConditionallyRequired bool        `json:"conditionally_required,omitempty"`
 	Label                 string      `json:"label"`
 	HelpText              string      `json:"help_text,omitempty"`
@@ -87,6 +107,7 @@ type UIFieldDef struct {
 	Sortable              bool        `json:"sortable"`
 	Filterable            bool        `json:"filterable"`
 	FilterType            string      `json:"filter_type,omitempty"`
+	FilterRange           []float64   `json:"-"` // @filter_range(min, max), copied onto the list filter
 	EndRequired           *bool       `json:"end_required,omitempty"`
 	EndConditional        *bool       `json:"end_conditionally_required,omitempty"`
 	ListDisplayField      string      `json:"list_display_field,omitempty"`
@@ -98,6 +119,12 @@ type UIFieldDef struct {
 	IsDisplayName         bool        `json:"is_display_name,omitempty"`
 	IsSensitive           bool        `json:"is_sensitive,omitempty"`
 	IsPII                 bool        `json:"is_pii,omitempty"`
+	// IsSelfRef marks an entity_ref pointing back at its own entity
+	// (parent_account_id, parent_space_id), making the records a hierarchy.
+	IsSelfRef             bool        `json:"is_self_ref,omitempty"`
+	// IsComputed marks server-managed @computed() fields. They are read-only
+	// and, unless also tagged @sortable(), neither sortable nor filterable,
+	// since their values are usually derived rather than stored columns.
 	IsComputed            bool        `json:"is_computed,omitempty"`
 	IsDeprecated          bool        `json:"is_deprecated,omitempty"`
 	DeprecatedReason      string      `json:"deprecated_reason,omitempty"`
@@ -109,9 +136,17 @@ type UIEnum struct {
 	Groups []UIEnumGroup `json:"groups,omitempty"`
 }
 
+// UIEmbeddedType describes a value type (CAMTerms, LateFeePolicy, ...) whose
+// fields render as a nested section inside entity forms.
+type UIEmbeddedType struct {
+	Name   string       `json:"name"`
+	Fields []UIFieldDef `json:"fields"`
+}
+
 type UIEnumValue struct {
-	Value string `json:"value"`
-	Label string `json:"label"`
+	Value      string `json:"value"`
+	Label      string `json:"label"`
+	Deprecated bool   `json:"deprecated,omitempty"`
 }
 
 type UIEnumGroup struct {
@@ -122,6 +157,14 @@ type UIEnumGroup struct {
 type UIForm struct {
 	Sections       []UIFormSection `json:"sections"`
 	FieldOrderRule string          `json:"field_order_rule"`
+	Steps          []UIFormStep    `json:"steps,omitempty"` // set when the form renders as a wizard
+}
+
+// UIFormStep is one page of a wizard form: the sections shown together and
+// validated before the user may move on.
+type UIFormStep struct {
+	Title    string   `json:"title"`
+	Sections []string `json:"sections"`
 }
 
 type UIFormSection struct {
@@ -163,6 +206,12 @@ type UIDetailSection struct {
 	EmbeddedObject string          `json:"embedded_object,omitempty"`
 	DisplayMode    string          `json:"display_mode,omitempty"`
 	VisibleWhen    *VisibilityRule `json:"visible_when,omitempty"`
+	// FieldDisplayModes overrides how single fields render, independent of
+	// the section's DisplayMode: "masked" hides a value until revealed.
+	FieldDisplayModes map[string]string `json:"field_display_modes,omitempty"`
+	// FieldLabels labels the sub-fields of an embedded_object section, whose
+	// names are relative to the embedded object.
+	FieldLabels map[string]string `json:"field_labels,omitempty"`
 }
 
 type UIRelatedSection struct {
@@ -179,7 +228,28 @@ type UIList struct {
 	Filters           []UIListFilter `json:"filters"`
 	DefaultSort       UISort         `json:"default_sort"`
 	RowClickAction    string         `json:"row_click_action"`
-	BulkActions       bool           `json:"bulk_actions"`
+	BulkActions       []UIBulkAction `json:"bulk_actions"`
+	Hierarchy         *UIHierarchy   `json:"hierarchy,omitempty"`
+	SearchField       string         `json:"search_field,omitempty"` // display field matched by the list endpoint's ?q= search
+	GroupBy           string         `json:"group_by,omitempty"`     // @list_group_by(field): enum field rows are grouped under
+}
+
+// UIBulkAction is a state transition the list can apply to every selected
+// row. APIEndpoint is the per-record transition route; the renderer calls it
+// once per row.
+type UIBulkAction struct {
+	Target      string `json:"target"`
+	Label       string `json:"label"`
+	APIEndpoint string `json:"api_endpoint"`
+	Variant     string `json:"variant"`
+	Confirm     bool   `json:"confirm"`
+}
+
+// UIHierarchy hints that list rows form a tree through a self-referencing
+// field, so the renderer can offer a tree or indented view.
+type UIHierarchy struct {
+	ParentField string `json:"parent_field"`
+	DepthField  string `json:"depth_field,omitempty"` // precomputed nesting level, if the entity stores one
 }
 
 type UIListColumn struct {
@@ -197,6 +267,10 @@ type UIListFilter struct {
 	Label    string `json:"label,omitempty"`
 	EnumRef  string `json:"enum_ref,omitempty"`
 	RefEntity string `json:"ref_entity,omitempty"`
+	// Min and Max bound a money_range filter in whole currency units, from
+	// @filter_range(min, max). Without them the range is open-ended.
+	Min *float64 `json:"min,omitempty"`
+	Max *float64 `json:"max,omitempty"`
 }
 
 type UISort struct {
@@ -227,7 +301,7 @@ type UIRelationship struct {
 	Name          string `json:"name"`
 	TargetEntity  string `json:"target_entity"`
 	Cardinality   string `json:"cardinality"`
-	APIEndpoint   string `json:"api_endpoint,omitempty"`
+	APIEndpoint   string `json:"api_endpoint,omitempty"` // list path returning the related records; {id} is this entity's ID
 	DisplayInDetail bool `json:"display_in_detail"`
 	DisplayMode   string `json:"display_mode,omitempty"`
 }
@@ -261,15 +335,19 @@ type UIAPI struct {
 type UIAPIEndpoint struct {
 	Method string `json:"method"`
 	Path   string `json:"path"`
+	// RequestFields are the optional body members a transition accepts
+	// (its extra_fields in apigen.cue).
+	RequestFields []string `json:"request_fields,omitempty"`
 }
 
 // ── Internal parse structures ────────────────────────────────────────────────
 
 type entityInfo struct {
-	name       string
-	fields     []fieldInfo
-	hasMachine bool
-	machine    map[string][]string // status -> []target_status
+	name        string
+	fields      []fieldInfo
+	hasMachine  bool
+	machine     map[string][]string // status -> []target_status
+	listGroupBy string              // @list_group_by(field): enum field the list groups rows under
 }
 
 type fieldInfo struct {
@@ -290,6 +368,7 @@ type fieldInfo struct {
 	isList        bool
 	listElemRef   string
 	isDisplayName bool
+	durationUnit  string // set when uiType is "duration"
 	attrs         fieldAttrs
 }
 
@@ -325,41 +404,21 @@ type uiOverride struct {
 	displayName        string
 	displayNamePlural  string
 	primaryDisplay     string
+	formSteps          []UIFormStep
+}
+
+// uiListOverride replaces the heuristic list columns and/or default sort for
+// one entity. A nil defaultSort or empty columns keeps the generated value.
+type uiListOverride struct {
+	columns     []UIListColumn
+	defaultSort *UISort
 }
 
 // ── Known constants ──────────────────────────────────────────────────────────
 
-var knownValueTypes = map[string]string{
-	"#Money":              "Money",
-	"#NonNegativeMoney":   "NonNegativeMoney",
-	"#PositiveMoney":      "PositiveMoney",
-	"#Address":            "Address",
-	"#ContactMethod":      "ContactMethod",
-	"#DateRange":          "DateRange",
-	"#EntityRef":          "EntityRef",
-	"#RentScheduleEntry":  "RentScheduleEntry",
-	"#RecurringCharge":    "RecurringCharge",
-	"#LateFeePolicy":      "LateFeePolicy",
-	"#CAMTerms":           "CAMTerms",
-	"#TenantImprovement":  "TenantImprovement",
-	"#RenewalOption":      "RenewalOption",
-	"#SubsidyTerms":       "SubsidyTerms",
-	"#AccountDimensions":  "AccountDimensions",
-	"#JournalLine":        "JournalLine",
-	"#RoleAttributes":     "RoleAttributes",
-	"#TenantAttributes":   "TenantAttributes",
-	"#OwnerAttributes":    "OwnerAttributes",
-	"#ManagerAttributes":  "ManagerAttributes",
-	"#GuarantorAttributes":"GuarantorAttributes",
-	"#UsageBasedCharge":   "UsageBasedCharge",
-	"#PercentageRent":     "PercentageRent",
-	"#RentAdjustment":     "RentAdjustment",
-	"#ExpansionRight":     "ExpansionRight",
-	"#ContractionRight":   "ContractionRight",
-	"#CAMCategoryTerms":   "CAMCategoryTerms",
-}
-
-var moneyTypes = map[string]string{
+// moneyVariants maps each money definition to the sign constraint the money
+// input enforces.
+var moneyVariants = map[string]string{
 	"#Money":            "any",
 	"#NonNegativeMoney": "non_negative",
 	"#PositiveMoney":    "positive",
@@ -380,6 +439,16 @@ var edgeToEntity = map[string]string{}
 // Populated in main() after parsing entities. Used to set ref_display on entity_ref fields.
 var entityDisplayField = map[string]string{}
 
+// embeddedTypes maps value type names (e.g., "Address") to their parsed fields.
+// Populated in main(). Used to resolve nested display template tokens.
+var embeddedTypes = map[string]*embeddedTypeDef{}
+
+// currencyCode matches an ISO 4217 currency code, as #Money's currency does.
+var currencyCode = regexp.MustCompile(`^[A-Z]{3}$`)
+
+// displayTemplateToken matches {field} and {field.nested} template tokens.
+var displayTemplateToken = regexp.MustCompile(`\{([A-Za-z0-9_.]+)\}`)
+
 // textFieldIndicators is no longer used — text fields are now identified by
 // the @text() attribute in CUE rather than type references or name heuristics.
 
@@ -461,18 +530,31 @@ type fieldAttrs struct {
 	display          bool
 	text             bool
 	immutable        bool
+	immutableAfter   bool // @immutable_after_create(): set on create, locked in the update form
+	relativeTime     bool // @relative_time(): show a relative hint beside the date in the detail view
+	expiry           bool // @relative_time(expiry): the date is a deadline; warn as it approaches
 	computed         bool
 	sensitive        bool
 	pii              bool
+	percent          bool
+	sortable         bool
+	refFilter        string          // @ref_filter(field): scope entity_ref options by a sibling field
+	durationUnit     string          // @duration(unit): int counts a span of time in unit
+	currencies       []string        // @currencies(USD,CAD): ISO 4217 codes a money field accepts
+	filterRange      []float64       // @filter_range(0, 50000): money_range filter bounds in whole currency units
+	detailVisible    *VisibilityRule // @detail_visible_when(status in [a, b]): gates the field's detail section
 	deprecated       bool
 	deprecatedReason string
 	deprecatedSince  string
 }
 
+// detailVisibleWhen parses the contents of @detail_visible_when(status in [a, b]).
+var detailVisibleWhen = regexp.MustCompile(`^\s*(\w+)\s+in\s+\[(.*)\]\s*$`)
+
 // extractAttributes reads CUE field-level attributes from a value.
 func extractAttributes(v cue.Value) fieldAttrs {
 	var fa fieldAttrs
-	for _, name := range []string{"display", "text", "immutable", "computed", "sensitive", "pii"} {
+	for _, name := range []string{"display", "text", "immutable", "immutable_after_create", "computed", "sensitive", "pii", "percent", "sortable"} {
 		a := v.Attribute(name)
 		if a.Err() != nil {
 			continue
@@ -484,12 +566,60 @@ func extractAttributes(v cue.Value) fieldAttrs {
 			fa.text = true
 		case "immutable":
 			fa.immutable = true
+		case "immutable_after_create":
+			fa.immutableAfter = true
 		case "computed":
 			fa.computed = true
 		case "sensitive":
 			fa.sensitive = true
 		case "pii":
 			fa.pii = true
+		case "percent":
+			fa.percent = true
+		case "sortable":
+			fa.sortable = true
+		}
+	}
+	if a := v.Attribute("relative_time"); a.Err() == nil {
+		fa.relativeTime = true
+		switch c := strings.TrimSpace(a.Contents()); c {
+		case "":
+		case "expiry":
+			fa.expiry = true
+		default:
+			log.Printf("warning: @relative_time(%s): want @relative_time() or @relative_time(expiry)", c)
+		}
+	}
+	if a := v.Attribute("duration"); a.Err() == nil {
+		fa.durationUnit = strings.TrimSpace(a.Contents())
+	}
+	if a := v.Attribute("ref_filter"); a.Err() == nil {
+		fa.refFilter = strings.TrimSpace(a.Contents())
+	}
+	if a := v.Attribute("currencies"); a.Err() == nil {
+		for _, c := range strings.Split(a.Contents(), ",") {
+			c = strings.ToUpper(strings.TrimSpace(c))
+			if !currencyCode.MatchString(c) {
+				log.Printf("warning: @currencies: %q is not an ISO 4217 code", c)
+				continue
+			}
+			fa.currencies = append(fa.currencies, c)
+		}
+	}
+	if a := v.Attribute("filter_range"); a.Err() == nil {
+		fa.filterRange = parseFilterRange(a.Contents())
+	}
+	if a := v.Attribute("detail_visible_when"); a.Err() == nil {
+		if m := detailVisibleWhen.FindStringSubmatch(a.Contents()); m != nil {
+			rule := &VisibilityRule{Field: m[1], Operator: "in"}
+			for _, val := range strings.Split(m[2], ",") {
+				if val = strings.Trim(strings.TrimSpace(val), `"'`); val != "" {
+					rule.Values = append(rule.Values, val)
+				}
+			}
+			fa.detailVisible = rule
+		} else {
+			log.Printf("warning: @detail_visible_when(%s): want @detail_visible_when(field in [a, b])", a.Contents())
 		}
 	}
 	if a := v.Attribute("deprecated"); a.Err() == nil {
@@ -500,6 +630,21 @@ func extractAttributes(v cue.Value) fieldAttrs {
 	return fa
 }
 
+// parseFilterRange parses the contents of @filter_range(min, max), returning
+// nil with a warning unless it holds two numbers with min below max.
+func parseFilterRange(contents string) []float64 {
+	parts := strings.Split(contents, ",")
+	if len(parts) == 2 {
+		lo, errLo := strconv.ParseFloat(strings.TrimSpace(parts[0]), 64)
+		hi, errHi := strconv.ParseFloat(strings.TrimSpace(parts[1]), 64)
+		if errLo == nil && errHi == nil && lo < hi {
+			return []float64{lo, hi}
+		}
+	}
+	log.Printf("warning: @filter_range(%s): want @filter_range(min, max) with min < max", contents)
+	return nil
+}
+
 // ── Name conversion utilities ────────────────────────────────────────────────
 
 func toSnake(s string) string {
@@ -598,172 +743,6 @@ func findProjectRoot() string {
 	}
 }
 
-func findReference(val cue.Value) string {
-	_, path := val.ReferencePath()
-	if path.String() != "" {
-		selectors := path.Selectors()
-		if len(selectors) > 0 {
-			return selectors[len(selectors)-1].String()
-		}
-	}
-	op, args := val.Expr()
-	if op == cue.AndOp || op == cue.OrOp {
-		for _, arg := range args {
-			if ref := findReference(arg); ref != "" {
-				return ref
-			}
-		}
-	}
-	if op == cue.SelectorOp && len(args) >= 2 {
-		if s, err := args[1].String(); err == nil && s == "Time" {
-			return "time.Time"
-		}
-	}
-	return ""
-}
-
-func isTimeField(val cue.Value) bool {
-	ref := findReference(val)
-	return ref == "time.Time" || ref == "Time"
-}
-
-func isEnum(val cue.Value) bool {
-	op, args := findEnumDisjunction(val)
-	if op != cue.OrOp || len(args) < 2 {
-		return false
-	}
-	for _, arg := range args {
-		aOp, aArgs := arg.Expr()
-		check := arg
-		if aOp == cue.SelectorOp && len(aArgs) > 0 {
-			check = aArgs[0]
-		}
-		if check.IncompleteKind() != cue.StringKind {
-			return false
-		}
-		if _, err := check.String(); err != nil {
-			d, ok := check.Default()
-			if ok {
-				if _, err := d.String(); err != nil {
-					return false
-				}
-			} else {
-				return false
-			}
-		}
-	}
-	return true
-}
-
-// findEnumDisjunction extracts the OrOp disjunction from a value that may be
-// wrapped in an AndOp (e.g., when a field from an embedded base type is
-// unified with enum values in the entity).
-func findEnumDisjunction(val cue.Value) (cue.Op, []cue.Value) {
-	op, args := val.Expr()
-	if op == cue.OrOp {
-		return op, args
-	}
-	// Resolve definition references (e.g., #USState)
-	if op != cue.OrOp {
-		deref := cue.Dereference(val)
-		dOp, dArgs := deref.Expr()
-		if dOp == cue.OrOp {
-			return dOp, dArgs
-		}
-	}
-	// When embedding introduces `string & ("a" | "b" | "c")`, look through AndOp
-	if op == cue.AndOp {
-		for _, arg := range args {
-			argOp, argArgs := arg.Expr()
-			if argOp == cue.OrOp {
-				return argOp, argArgs
-			}
-		}
-	}
-	return op, args
-}
-
-func extractEnumValues(val cue.Value) []string {
-	op, args := findEnumDisjunction(val)
-	if op != cue.OrOp {
-		return nil
-	}
-	var values []string
-	for _, arg := range args {
-		if s, err := arg.String(); err == nil {
-			values = append(values, s)
-			continue
-		}
-		if d, ok := arg.Default(); ok {
-			if s, err := d.String(); err == nil {
-				values = append(values, s)
-			}
-		}
-	}
-	return values
-}
-
-func isList(val cue.Value) bool {
-	if val.IncompleteKind() == cue.ListKind {
-		return true
-	}
-	op, args := val.Expr()
-	if op == cue.AndOp {
-		for _, arg := range args {
-			if arg.IncompleteKind() == cue.ListKind {
-				return true
-			}
-		}
-	}
-	_ = args
-	return false
-}
-
-func inferKindFromExpr(val cue.Value) cue.Kind {
-	op, args := val.Expr()
-	if op == cue.AndOp {
-		for _, arg := range args {
-			k := arg.IncompleteKind()
-			if k != cue.BottomKind {
-				return k
-			}
-			if inferred := inferKindFromExpr(arg); inferred != cue.BottomKind {
-				return inferred
-			}
-		}
-	}
-	if op == cue.OrOp {
-		if len(args) > 0 {
-			for _, arg := range args {
-				k := arg.IncompleteKind()
-				if k != cue.BottomKind {
-					return k
-				}
-			}
-		}
-	}
-	return cue.BottomKind
-}
-
-func inferListElementKind(val cue.Value) (cue.Value, bool) {
-	elemVal := val.LookupPath(cue.MakePath(cue.AnyIndex))
-	if elemVal.Err() == nil {
-		return elemVal, true
-	}
-	op, args := val.Expr()
-	if op == cue.AndOp {
-		for _, arg := range args {
-			if arg.IncompleteKind() == cue.ListKind || inferKindFromExpr(arg) == cue.ListKind {
-				ev := arg.LookupPath(cue.MakePath(cue.AnyIndex))
-				if ev.Err() == nil {
-					return ev, true
-				}
-			}
-		}
-	}
-	return cue.Value{}, false
-}
-
 func extractNumericBounds(val cue.Value) (string, string) {
 	op, args := val.Expr()
 	lo, hi := "", ""
@@ -779,15 +758,21 @@ func extractNumericBounds(val cue.Value) (string, string) {
 		}
 		return lo, hi
 	}
+	// Defaulted fields (*30 | int & >=0) expose the constraint as the sole
+	// argument of a NoOp expression; unwrap it to reach the bounds.
+	if _, hasDefault := val.Default(); op == cue.NoOp && hasDefault && len(args) == 1 {
+		return extractNumericBounds(args[0])
+	}
+	if len(args) == 0 {
+		return lo, hi
+	}
+	// Unary bounds (>=0) carry the limit as their only argument.
+	bound := fmt.Sprint(args[len(args)-1])
 	switch op {
 	case cue.GreaterThanEqualOp, cue.GreaterThanOp:
-		if len(args) >= 2 {
-			lo = fmt.Sprint(args[1])
-		}
+		lo = bound
 	case cue.LessThanEqualOp, cue.LessThanOp:
-		if len(args) >= 2 {
-			hi = fmt.Sprint(args[1])
-		}
+		hi = bound
 	}
 	return lo, hi
 }
@@ -809,6 +794,22 @@ func extractPattern(val cue.Value) string {
 	return ""
 }
 
+// stringInputType picks the specialized input type of a string field from its
+// name or its regex pattern, falling back to plain "string".
+func stringInputType(name, pattern string) string {
+	lower := strings.ToLower(name)
+	switch {
+	case lower == "email" || strings.HasSuffix(lower, "_email") || strings.Contains(pattern, "@"):
+		return "email"
+	case lower == "phone" || strings.HasSuffix(lower, "_phone") || strings.HasSuffix(lower, "phone_number") ||
+		(strings.Contains(pattern, `\+`) && strings.Contains(pattern, "0-9")):
+		return "phone"
+	case lower == "url" || lower == "website" || strings.HasSuffix(lower, "_url") || strings.Contains(pattern, "http"):
+		return "url"
+	}
+	return "string"
+}
+
 func hasNonEmpty(val cue.Value) bool {
 	op, args := val.Expr()
 	if op == cue.NotEqualOp && len(args) > 1 {
@@ -836,73 +837,83 @@ func hasNonEmpty(val cue.Value) bool {
 
 func parseEntities(val cue.Value) map[string]*entityInfo {
 	entities := make(map[string]*entityInfo)
-
-	iter, _ := val.Fields(cue.Definitions(true))
-	for iter.Next() {
-		label := iter.Selector().String()
-		defVal := iter.Value()
-
-		idField := defVal.LookupPath(cue.ParsePath("id"))
-		auditField := defVal.LookupPath(cue.ParsePath("audit"))
-		if idField.Err() != nil || auditField.Err() != nil {
-			continue
-		}
-
-		name := strings.TrimPrefix(label, "#")
-		// Skip base entity types — not domain entities
-		if name == "BaseEntity" || name == "StatefulEntity" || name == "ImmutableEntity" {
-			continue
-		}
+	for _, e := range cueparse.Entities(val) {
 		ent := &entityInfo{
-			name: name,
+			name: e.Name,
+		}
+		ent.fields = parseEntityFields(e.Fields)
+		if a := e.Value.Attribute("list_group_by"); a.Err() == nil {
+			ent.listGroupBy = parseListGroupBy(ent, strings.TrimSpace(a.Contents()))
 		}
-		ent.fields = parseEntityFields(name, defVal)
-		entities[name] = ent
+		entities[e.Name] = ent
 	}
 	return entities
 }
 
-func parseEntityFields(entityName string, structVal cue.Value) []fieldInfo {
-	var fields []fieldInfo
-	iter, _ := structVal.Fields(cue.Optional(true))
-	for iter.Next() {
-		label := iter.Selector().String()
-		fieldVal := iter.Value()
-		label = strings.TrimSuffix(label, "?")
-
-		if label == "id" || label == "audit" {
+// parseListGroupBy validates the field named by an entity's @list_group_by().
+// Grouping is only meaningful over a small fixed set of values, so the field
+// must exist and be an enum; anything else is dropped with a warning.
+func parseListGroupBy(ent *entityInfo, name string) string {
+	for _, f := range ent.fields {
+		if f.name != name {
 			continue
 		}
-		if strings.HasPrefix(label, "_") {
-			continue
+		if f.uiType != "enum" {
+			log.Printf("warning: %s: @list_group_by(%s): %s is a %s field, only enum fields can group a list", ent.name, name, name, f.uiType)
+			return ""
 		}
+		return name
+	}
+	log.Printf("warning: %s: @list_group_by(%s): no such field", ent.name, name)
+	return ""
+}
 
-		fi := classifyUIField(label, fieldVal, iter.IsOptional())
+func parseEntityFields(cueFields []cueparse.Field) []fieldInfo {
+	var fields []fieldInfo
+	for _, cf := range cueFields {
+		label, fieldVal := cf.Name, cf.Value
+
+		fi := classifyUIField(cf)
 		if fi != nil {
 			fi.attrs = extractAttributes(fieldVal)
 			// Override type classification from attributes
 			if fi.attrs.text && fi.uiType == "string" {
 				fi.uiType = "text"
 			}
+			if fi.attrs.percent && fi.uiType == "float" {
+				fi.uiType = "percent"
+			}
+			if fi.attrs.durationUnit != "" && fi.uiType == "int" {
+				fi.uiType = "duration"
+				fi.durationUnit = fi.attrs.durationUnit
+			}
 			if fi.attrs.display {
 				fi.isDisplayName = true
 			}
+			if fi.attrs.filterRange != nil && fi.uiType != "money" {
+				log.Printf("warning: %s: @filter_range() only applies to money fields", label)
+			}
+			if fi.attrs.relativeTime && fi.uiType != "date" && fi.uiType != "datetime" {
+				log.Printf("warning: %s: @relative_time() only applies to date and datetime fields", label)
+				fi.attrs.relativeTime, fi.attrs.expiry = false, false
+			}
 			fields = append(fields, *fi)
 		}
 	}
 	return fields
 }
 
-// classifyUIField maps a CUE field to one of the 16 UI field types.
-func classifyUIField(name string, val cue.Value, optional bool) *fieldInfo {
+// classifyUIField maps a classified CUE field to one of the 16 UI field types.
+func classifyUIField(cf cueparse.Field) *fieldInfo {
+	name, val := cf.Name, cf.Value
 	fi := &fieldInfo{
 		name:     name,
 		cueVal:   val,
-		optional: optional,
+		optional: cf.Optional,
 	}
 
-	// Check for time.Time
-	if isTimeField(val) {
+	switch cf.Kind {
+	case cueparse.Time:
 		// Distinguish date vs datetime by name heuristic
 		lower := strings.ToLower(name)
 		if strings.Contains(lower, "date") || strings.HasSuffix(lower, "_date") {
@@ -911,89 +922,42 @@ func classifyUIField(name string, val cue.Value, optional bool) *fieldInfo {
 			fi.uiType = "datetime"
 		}
 		return fi
-	}
 
-	// Check for references to known types
-	refName := findReference(val)
-	if refName != "" {
-		// Money types
-		if variant, ok := moneyTypes[refName]; ok {
-			fi.uiType = "money"
-			fi.moneyVariant = variant
-			return fi
-		}
+	case cueparse.Money:
+		fi.uiType = "money"
+		fi.moneyVariant = moneyVariants[cf.Ref]
+		return fi
 
-		// Other known value types
-		if typeName, ok := knownValueTypes[refName]; ok {
-			if isList(val) {
-				fi.uiType = "embedded_array"
-				fi.objectRef = typeName
-				fi.isList = true
-				fi.listElemRef = typeName
-			} else {
-				switch typeName {
-				case "Address":
-					fi.uiType = "address"
-				case "DateRange":
-					fi.uiType = "date_range"
-				case "ContactMethod":
-					// Contact methods are usually lists, check
-					if isList(val) {
-						fi.uiType = "embedded_array"
-						fi.objectRef = typeName
-						fi.isList = true
-					} else {
-						fi.uiType = "contact_method"
-					}
-				default:
-					fi.uiType = "embedded_object"
-					fi.objectRef = typeName
-				}
-			}
+	case cueparse.ValueType:
+		typeName := strings.TrimPrefix(cf.Ref, "#")
+		if cf.List {
+			fi.uiType = "embedded_array"
+			fi.objectRef = typeName
+			fi.isList = true
+			fi.listElemRef = typeName
 			return fi
 		}
-	}
-
-	// Check for list types
-	if isList(val) {
-		elemVal, found := inferListElementKind(val)
-		if found {
-			elemRef := findReference(elemVal)
-			if elemRef != "" {
-				if typeName, ok := knownValueTypes[elemRef]; ok {
-					fi.uiType = "embedded_array"
-					fi.objectRef = typeName
-					fi.isList = true
-					fi.listElemRef = typeName
-					return fi
-				}
-			}
-			// Check for contact methods list
-			if elemRef == "#ContactMethod" {
-				fi.uiType = "embedded_array"
-				fi.objectRef = "ContactMethod"
-				fi.isList = true
-				return fi
-			}
-			// String list
-			kind := elemVal.IncompleteKind()
-			if kind == cue.BottomKind {
-				kind = inferKindFromExpr(elemVal)
-			}
-			if kind == cue.StringKind {
-				fi.uiType = "string_list"
-				return fi
-			}
+		switch typeName {
+		case "Address":
+			fi.uiType = "address"
+		case "DateRange":
+			fi.uiType = "date_range"
+		case "ContactMethod":
+			fi.uiType = "contact_method"
+		default:
+			fi.uiType = "embedded_object"
+			fi.objectRef = typeName
 		}
-		// Fallback for list
+		return fi
+
+	case cueparse.List:
+		// String and enum lists, and lists of anything unrecognized
 		fi.uiType = "string_list"
 		return fi
-	}
 
-	// Check for enum
-	if isEnum(val) {
+	case cueparse.Enum:
 		fi.uiType = "enum"
-		fi.enumValues = extractEnumValues(val)
+		fi.enumValues = cf.EnumValues
 		fi.enumRef = toPascal(name)
 		// Check for default
 		if d, ok := val.Default(); ok {
@@ -1003,16 +967,8 @@ func classifyUIField(name string, val cue.Value, optional bool) *fieldInfo {
 			}
 		}
 		return fi
-	}
-
-	// Classify by kind
-	kind := val.IncompleteKind()
-	if kind == cue.BottomKind {
-		kind = inferKindFromExpr(val)
-	}
 
-	switch kind {
-	case cue.StringKind:
+	case cueparse.String:
 		// Check if _id or _ids → entity_ref (only if the referenced entity exists)
 		if strings.HasSuffix(name, "_ids") {
 			ref := strings.TrimSuffix(name, "_ids")
@@ -1043,21 +999,21 @@ func classifyUIField(name string, val cue.Value, optional bool) *fieldInfo {
 			}
 		}
 
-		fi.uiType = "string"
 		fi.pattern = extractPattern(val)
+		fi.uiType = stringInputType(name, fi.pattern)
 		return fi
 
-	case cue.IntKind:
+	case cueparse.Int:
 		fi.uiType = "int"
 		fi.min, fi.max = extractNumericBounds(val)
 		return fi
 
-	case cue.FloatKind, cue.NumberKind:
+	case cueparse.Float:
 		fi.uiType = "float"
 		fi.min, fi.max = extractNumericBounds(val)
 		return fi
 
-	case cue.BoolKind:
+	case cueparse.Bool:
 		fi.uiType = "bool"
 		if d, ok := val.Default(); ok {
 			b, _ := d.Bool()
@@ -1070,18 +1026,16 @@ func classifyUIField(name string, val cue.Value, optional bool) *fieldInfo {
 		}
 		return fi
 
-	case cue.StructKind:
+	case cueparse.Struct:
 		fi.uiType = "embedded_object"
 		fi.objectRef = "Unknown"
 		return fi
 
-	default:
+	case cueparse.Any:
 		// Top type (_) or mixed kind → embedded_object (JSON)
-		if kind != 0 && kind != cue.BottomKind {
-			fi.uiType = "embedded_object"
-			fi.objectRef = "JSON"
-			return fi
-		}
+		fi.uiType = "embedded_object"
+		fi.objectRef = "JSON"
+		return fi
 	}
 
 	return nil
@@ -1149,7 +1103,7 @@ func parseStateMachines(val cue.Value, entities map[string]*entityInfo) {
 
 			listIter, _ := iter.Value().List()
 			for listIter.Next() {
-				if s, err := listIter.Value().String(); err == nil {
+				if s, err := cueparse.TransitionTarget(listIter.Value()).String(); err == nil {
 					targets = append(targets, s)
 				}
 			}
@@ -1257,6 +1211,20 @@ func parseUIOverrides(codegenVal cue.Value) map[string]uiOverride {
 		if pt := v.LookupPath(cue.ParsePath("primary_display_template")); pt.Err() == nil {
 			o.primaryDisplay, _ = pt.String()
 		}
+		if fs := v.LookupPath(cue.ParsePath("form_steps")); fs.Err() == nil {
+			steps, _ := fs.List()
+			for steps.Next() {
+				sv := steps.Value()
+				step := UIFormStep{}
+				step.Title, _ = sv.LookupPath(cue.ParsePath("title")).String()
+				secs, _ := sv.LookupPath(cue.ParsePath("sections")).List()
+				for secs.Next() {
+					id, _ := secs.Value().String()
+					step.Sections = append(step.Sections, id)
+				}
+				o.formSteps = append(o.formSteps, step)
+			}
+		}
 		overrides[name] = o
 	}
 	return overrides
@@ -1287,6 +1255,9 @@ func parseEnumGroupings(codegenVal cue.Value) map[string]UIEnum {
 			if lv := valIter.Value().LookupPath(cue.ParsePath("label")); lv.Err() == nil {
 				ev.Label, _ = lv.String()
 			}
+			if dv := valIter.Value().LookupPath(cue.ParsePath("deprecated")); dv.Err() == nil {
+				ev.Deprecated, _ = dv.Bool()
+			}
 			e.Values = append(e.Values, ev)
 		}
 
@@ -1310,6 +1281,114 @@ func parseEnumGroupings(codegenVal cue.Value) map[string]UIEnum {
 	return groupings
 }
 
+// parseListOverrides reads per-entity list column and sort overrides from uigen.cue.
+func parseListOverrides(codegenVal cue.Value) map[string]uiListOverride {
+	overrides := make(map[string]uiListOverride)
+
+	lo := codegenVal.LookupPath(cue.ParsePath("ui_list_overrides"))
+	if lo.Err() != nil {
+		return overrides
+	}
+
+	iter, _ := lo.Fields()
+	for iter.Next() {
+		name := iter.Selector().String()
+		v := iter.Value()
+
+		o := uiListOverride{}
+		colIter, _ := v.LookupPath(cue.ParsePath("columns")).List()
+		for colIter.Next() {
+			c := UIListColumn{}
+			cv := colIter.Value()
+			if fv := cv.LookupPath(cue.ParsePath("field")); fv.Err() == nil {
+				c.Field, _ = fv.String()
+			}
+			if lv := cv.LookupPath(cue.ParsePath("label")); lv.Err() == nil {
+				c.Label, _ = lv.String()
+			}
+			if wv := cv.LookupPath(cue.ParsePath("width")); wv.Err() == nil {
+				c.Width, _ = wv.String()
+			}
+			if cmp := cv.LookupPath(cue.ParsePath("component")); cmp.Err() == nil {
+				c.Component, _ = cmp.String()
+			}
+			o.columns = append(o.columns, c)
+		}
+		if ds := v.LookupPath(cue.ParsePath("default_sort")); ds.Err() == nil {
+			order := UISort{Direction: "asc"}
+			order.Field, _ = ds.LookupPath(cue.ParsePath("field")).String()
+			if dir := ds.LookupPath(cue.ParsePath("direction")); dir.Err() == nil {
+				order.Direction, _ = dir.String()
+			}
+			o.defaultSort = &order
+		}
+		overrides[name] = o
+	}
+	return overrides
+}
+
+// primaryDisplayField returns the field that labels an entity's records: its
+// @display() field, else name, else id (see entityDisplayField).
+func primaryDisplayField(snake string) string {
+	if df, ok := entityDisplayField[snake]; ok {
+		return df
+	}
+	return "id"
+}
+
+// validateDisplayTemplate returns the {field} tokens in a display template
+// that do not resolve against the entity's fields. Dotted tokens such as
+// {address.city} are resolved through embedded value types.
+func validateDisplayTemplate(ent *entityInfo, template string) []string {
+	var unknown []string
+	for _, m := range displayTemplateToken.FindAllStringSubmatch(template, -1) {
+		if !resolveTemplatePath(ent.fields, strings.Split(m[1], ".")) {
+			unknown = append(unknown, m[1])
+		}
+	}
+	return unknown
+}
+
+// resolveTemplatePath walks a dotted token path through fields and embedded types.
+func resolveTemplatePath(fields []fieldInfo, path []string) bool {
+	if len(path) == 1 && path[0] == "id" {
+		return true
+	}
+	for _, f := range fields {
+		if f.name != path[0] {
+			continue
+		}
+		if len(path) == 1 {
+			return true
+		}
+		typeName := f.objectRef
+		switch f.uiType {
+		case "address":
+			typeName = "Address"
+		case "date_range":
+			typeName = "DateRange"
+		case "contact_method":
+			typeName = "ContactMethod"
+		}
+		td, ok := embeddedTypes[typeName]
+		if !ok || f.isList {
+			return false
+		}
+		return resolveTemplatePath(td.fields, path[1:])
+	}
+	return false
+}
+
+// hasField reports whether fields contains a top-level field with the given name.
+func hasField(fields []fieldInfo, name string) bool {
+	for _, f := range fields {
+		if f.name == name {
+			return true
+		}
+	}
+	return false
+}
+
 // ── Schema building ──────────────────────────────────────────────────────────
 
 func buildUISchema(
@@ -1318,7 +1397,9 @@ func buildUISchema(
 	services []serviceInfo,
 	overrides map[string]uiOverride,
 	enumGroupings map[string]UIEnum,
+	listOverrides map[string]uiListOverride,
 	allEnums map[string]UIEnum,
+	listColumns *listColumnCache,
 ) UISchema {
 	snake := toSnake(ent.name)
 
@@ -1326,7 +1407,7 @@ func buildUISchema(
 		Entity:            snake,
 		DisplayName:       ent.name,
 		DisplayNamePlural: ent.name + "s",
-		PrimaryDisplayField: "id",
+		PrimaryDisplayField: primaryDisplayField(snake),
 		Enums:             make(map[string]UIEnum),
 		Relationships:     []UIRelationship{},
 	}
@@ -1337,6 +1418,9 @@ func buildUISchema(
 		schema.DisplayNamePlural = o.displayNamePlural
 		if o.primaryDisplay != "" {
 			schema.PrimaryDisplay = o.primaryDisplay
+			for _, tok := range validateDisplayTemplate(ent, o.primaryDisplay) {
+				log.Printf("warning: %s primary_display_template %q references unknown field {%s}", ent.name, o.primaryDisplay, tok)
+			}
 		}
 	}
 
@@ -1399,12 +1483,23 @@ func buildUISchema(
 
 	// Build form
 	schema.Form = buildFormSchema(ent, schema.Fields)
+	if o, ok := overrides[ent.name]; ok && len(o.formSteps) > 0 {
+		schema.Form.Steps = buildFormSteps(ent.name, schema.Form.Sections, o.formSteps)
+	}
 
 	// Build detail
-	schema.Detail = buildDetailSchema(ent, schema.Fields, relationships)
+	schema.Detail = buildDetailSchema(ent, schema.Fields, relationships, listColumns, embeddedTypes)
 
 	// Build list
 	schema.List = buildListSchema(ent, schema.Fields)
+	if o, ok := listOverrides[ent.name]; ok {
+		if len(o.columns) > 0 {
+			schema.List.DefaultColumns = o.columns
+		}
+		if o.defaultSort != nil {
+			schema.List.DefaultSort = *o.defaultSort
+		}
+	}
 
 	// Build status
 	if ent.hasMachine {
@@ -1416,6 +1511,8 @@ func buildUISchema(
 		schema.StateMachine = buildStateMachineSchema(ent, services)
 	}
 
+	schema.List.BulkActions = buildBulkActions(ent, schema.StateMachine, services)
+
 	// Build relationships
 	schema.Relationships = buildRelationships(ent, relationships, services)
 
@@ -1466,9 +1563,11 @@ func buildFieldDefs(ent *entityInfo, relationships []relationshipInfo) []UIField
 
 		case "money":
 			fd.MoneyVariant = f.moneyVariant
+			fd.Currencies = f.attrs.currencies
 			fd.Sortable = true
 			fd.Filterable = true
 			fd.FilterType = "money_range"
+			fd.FilterRange = f.attrs.filterRange
 			fd.ShowInList = true
 
 		case "entity_ref":
@@ -1483,6 +1582,16 @@ func buildFieldDefs(ent *entityInfo, relationships []relationshipInfo) []UIField
 			fd.FilterType = "entity_ref"
 			fd.ShowInList = true
 
+			// @ref_filter(parent_id) scopes the options to records whose
+			// parent_id matches this entity's own parent_id value.
+			if dep := f.attrs.refFilter; dep != "" {
+				if hasField(ent.fields, dep) {
+					fd.RefFilter = dep
+				} else {
+					log.Printf("warning: %s.%s @ref_filter references unknown field %q", ent.name, f.name, dep)
+				}
+			}
+
 			// Resolve display field from relationship
 			for _, rel := range relationships {
 				refSnake := strings.TrimSuffix(f.name, "_id")
@@ -1495,6 +1604,8 @@ func buildFieldDefs(ent *entityInfo, relationships []relationshipInfo) []UIField
 				}
 			}
 
+			fd.IsSelfRef = fd.RefEntity == toSnake(ent.name)
+
 		case "entity_ref_list":
 			fd.RefEntity = f.refEntity
 			if df, ok := entityDisplayField[f.refEntity]; ok {
@@ -1537,7 +1648,7 @@ func buildFieldDefs(ent *entityInfo, relationships []relationshipInfo) []UIField
 		case "string_list":
 			fd.ShowInList = false
 
-		case "string":
+		case "string", "email", "phone", "url":
 			fd.Sortable = true
 			if f.pattern != "" {
 				fd.Pattern = f.pattern
@@ -1546,8 +1657,9 @@ func buildFieldDefs(ent *entityInfo, relationships []relationshipInfo) []UIField
 		case "text":
 			fd.ShowInList = false
 
-		case "int":
+		case "int", "duration":
 			fd.Sortable = true
+			fd.DurationUnit = f.durationUnit
 			if f.min != "" {
 				fd.Min = f.min
 			}
@@ -1555,7 +1667,7 @@ func buildFieldDefs(ent *entityInfo, relationships []relationshipInfo) []UIField
 				fd.Max = f.max
 			}
 
-		case "float":
+		case "float", "percent":
 			fd.Sortable = true
 			if f.min != "" {
 				fd.Min = f.min
@@ -1567,6 +1679,8 @@ func buildFieldDefs(ent *entityInfo, relationships []relationshipInfo) []UIField
 		case "bool":
 			fd.Sortable = true
 			fd.Filterable = true
+			fd.FilterType = "boolean"
+			fd.ShowInList = true
 		}
 
 		// Status field special handling
@@ -1584,11 +1698,26 @@ func buildFieldDefs(ent *entityInfo, relationships []relationshipInfo) []UIField
 			fd.IsComputed = true
 			fd.ShowInCreate = false
 			fd.ShowInUpdate = false
+			// @sortable() opts a computed field back into server-side sort/filter.
+			if !f.attrs.sortable {
+				fd.Sortable = false
+				fd.Filterable = false
+				fd.FilterType = ""
+			}
 		}
 		if f.attrs.immutable {
 			fd.Immutable = true
 			fd.ShowInUpdate = false
 		}
+		if f.attrs.immutableAfter {
+			fd.ReadonlyAfterCreate = true
+			fd.ShowInCreate = true
+			fd.ShowInUpdate = true
+		}
+		if f.attrs.relativeTime {
+			fd.RelativeTime = true
+			fd.IsExpiry = f.attrs.expiry
+		}
 		if f.attrs.sensitive {
 			fd.IsSensitive = true
 		}
@@ -1604,9 +1733,35 @@ func buildFieldDefs(ent *entityInfo, relationships []relationshipInfo) []UIField
 		fields = append(fields, fd)
 	}
 
+	markConditionallyRequired(fields, getEntityConstraints(ent.name))
 	return fields
 }
 
+// markConditionallyRequired flags optional fields that a constraint requires
+// once another field takes a given value (e.g. move_in_date on an active
+// lease), so forms can mark them "required if". A term.end requirement sets
+// EndConditional on the date range; other nested paths mark their top-level
+// field.
+func markConditionallyRequired(fields []UIFieldDef, constraints []constraintDef) {
+	for _, c := range constraints {
+		for _, req := range c.requires {
+			name, sub, _ := strings.Cut(req, ".")
+			for i := range fields {
+				fd := &fields[i]
+				if fd.Name != name {
+					continue
+				}
+				if fd.Type == "date_range" && sub == "end" {
+					conditional := true
+					fd.EndConditional = &conditional
+				} else if !fd.Required {
+					fd.ConditionallyRequired = true
+				}
+			}
+		}
+	}
+}
+
 // ── Form schema building ─────────────────────────────────────────────────────
 
 // Hardcoded constraint map — same approach as entgen's assignConstraints.
@@ -1696,6 +1851,41 @@ func buildFormSchema(ent *entityInfo, fields []UIFieldDef) UIForm {
 	return form
 }
 
+// buildFormSteps resolves the form_steps override against the generated
+// sections. Unknown section IDs are dropped with a warning, and sections no
+// step claims are appended to the last step so new sections always appear.
+func buildFormSteps(entName string, sections []UIFormSection, override []UIFormStep) []UIFormStep {
+	known := make(map[string]bool, len(sections))
+	for _, sec := range sections {
+		known[sec.ID] = true
+	}
+	claimed := make(map[string]bool)
+	var steps []UIFormStep
+	for _, o := range override {
+		step := UIFormStep{Title: o.Title, Sections: []string{}}
+		for _, id := range o.Sections {
+			if !known[id] {
+				log.Printf("warning: %s form step %q references unknown section %q", entName, o.Title, id)
+				continue
+			}
+			if claimed[id] {
+				log.Printf("warning: %s form section %q is listed in more than one step", entName, id)
+				continue
+			}
+			claimed[id] = true
+			step.Sections = append(step.Sections, id)
+		}
+		steps = append(steps, step)
+	}
+	last := &steps[len(steps)-1]
+	for _, sec := range sections {
+		if !claimed[sec.ID] {
+			last.Sections = append(last.Sections, sec.ID)
+		}
+	}
+	return steps
+}
+
 func buildEntityFormSections(ent *entityInfo, fields []UIFieldDef, constraints []constraintDef) []UIFormSection {
 	var sections []UIFormSection
 	switch ent.name {
@@ -1937,7 +2127,7 @@ func buildGenericFormSections(ent *entityInfo, fields []UIFieldDef, constraints
 
 // ── Detail schema building ───────────────────────────────────────────────────
 
-func buildDetailSchema(ent *entityInfo, fields []UIFieldDef, relationships []relationshipInfo) UIDetail {
+func buildDetailSchema(ent *entityInfo, fields []UIFieldDef, relationships []relationshipInfo, listColumns *listColumnCache, types map[string]*embeddedTypeDef) UIDetail {
 	detail := UIDetail{
 		Header: UIDetailHeader{
 			TitleTemplate: ent.name,
@@ -1953,28 +2143,54 @@ func buildDetailSchema(ent *entityInfo, fields []UIFieldDef, relationships []rel
 		}
 	}
 
-	// Overview section — key fields
-	var overviewFields []string
+	// Overview section — key fields. @sensitive()/@pii() values such as
+	// account numbers are masked until the user reveals them. @relative_time()
+	// dates exist for their detail-view hint, so they bypass the field cap.
+	overview := UIDetailSection{ID: "overview", Title: "Overview", Layout: "grid_2col"}
 	for _, f := range fields {
-		if f.ShowInDetail && f.Type != "embedded_object" && f.Type != "embedded_array" && f.Type != "text" && len(overviewFields) < 8 {
-			overviewFields = append(overviewFields, f.Name)
+		if f.ShowInDetail && f.Type != "embedded_object" && f.Type != "embedded_array" && f.Type != "text" && (len(overview.Fields) < 8 || f.RelativeTime) {
+			overview.Fields = append(overview.Fields, f.Name)
+			if f.IsSensitive || f.IsPII {
+				if overview.FieldDisplayModes == nil {
+					overview.FieldDisplayModes = make(map[string]string)
+				}
+				overview.FieldDisplayModes[f.Name] = "masked"
+			}
 		}
 	}
-	detail.Sections = append(detail.Sections, UIDetailSection{
-		ID: "overview", Title: "Overview", Layout: "grid_2col", Fields: overviewFields,
-	})
+	detail.Sections = append(detail.Sections, overview)
 
-	// Add embedded object sections
+	// Add embedded object sections. They appear once the object is set, or
+	// in the statuses named by the field's @detail_visible_when. Known value
+	// types list their scalar sub-fields so the section renders as a grid;
+	// nested objects and lists are left out.
 	for _, f := range fields {
 		if f.Type == "embedded_object" && f.ShowInDetail {
-			detail.Sections = append(detail.Sections, UIDetailSection{
+			visible := &VisibilityRule{Field: f.Name, Operator: "truthy"}
+			if rule := detailVisibilityRule(ent, f.Name); rule != nil {
+				visible = rule
+			}
+			section := UIDetailSection{
 				ID:             toSnake(f.Name),
 				Title:          f.Label,
 				Layout:         "grid_2col",
 				EmbeddedObject: f.ObjectRef,
 				DisplayMode:    "readonly",
-				VisibleWhen:    &VisibilityRule{Field: f.Name, Operator: "truthy"},
-			})
+				VisibleWhen:    visible,
+			}
+			if td, ok := types[f.ObjectRef]; ok {
+				for _, sub := range td.fields {
+					if sub.uiType == "embedded_object" || sub.uiType == "embedded_array" || sub.isList {
+						continue
+					}
+					if section.FieldLabels == nil {
+						section.FieldLabels = make(map[string]string)
+					}
+					section.Fields = append(section.Fields, sub.name)
+					section.FieldLabels[sub.name] = generateEnumLabel(sub.name)
+				}
+			}
+			detail.Sections = append(detail.Sections, section)
 		}
 	}
 
@@ -1987,17 +2203,91 @@ func buildDetailSchema(ent *entityInfo, fields []UIFieldDef, relationships []rel
 		if rel.edgeType == "o2m" {
 			displayMode = "table"
 		}
+		include := listColumns.get(rel.to)
+		if len(include) > maxRelatedFields {
+			include = include[:maxRelatedFields]
+		}
 		detail.RelatedSections = append(detail.RelatedSections, UIRelatedSection{
-			Title:        generateEnumLabel(rel.name),
-			Relationship: rel.name,
-			Entity:       toSnake(rel.to),
-			Display:      displayMode,
+			Title:         generateEnumLabel(rel.name),
+			Relationship:  rel.name,
+			Entity:        toSnake(rel.to),
+			Display:       displayMode,
+			IncludeFields: include,
 		})
 	}
 
 	return detail
 }
 
+// maxRelatedFields caps the columns a related section shows per record.
+const maxRelatedFields = 4
+
+// listColumnCache computes each entity's default list columns at most once,
+// so related sections on other entities' detail views can show the same
+// fields the target's own list does.
+type listColumnCache struct {
+	entities      map[string]*entityInfo
+	relationships []relationshipInfo
+	listOverrides map[string]uiListOverride
+	columns       map[string][]string
+}
+
+func newListColumnCache(entities map[string]*entityInfo, relationships []relationshipInfo, listOverrides map[string]uiListOverride) *listColumnCache {
+	return &listColumnCache{
+		entities:      entities,
+		relationships: relationships,
+		listOverrides: listOverrides,
+		columns:       make(map[string][]string),
+	}
+}
+
+// get returns the field names of the named entity's default list columns,
+// honoring list overrides; nil for an unknown entity.
+func (c *listColumnCache) get(name string) []string {
+	if cols, ok := c.columns[name]; ok {
+		return cols
+	}
+	var cols []string
+	if ent, ok := c.entities[name]; ok {
+		columns := buildListSchema(ent, buildFieldDefs(ent, c.relationships)).DefaultColumns
+		if o, ok := c.listOverrides[name]; ok && len(o.columns) > 0 {
+			columns = o.columns
+		}
+		for _, col := range columns {
+			cols = append(cols, col.Field)
+		}
+	}
+	c.columns[name] = cols
+	return cols
+}
+
+// detailVisibilityRule returns the @detail_visible_when rule of the named
+// field, warning about values its controlling enum field doesn't have.
+func detailVisibilityRule(ent *entityInfo, name string) *VisibilityRule {
+	var rule *VisibilityRule
+	for _, f := range ent.fields {
+		if f.name == name {
+			rule = f.attrs.detailVisible
+		}
+	}
+	if rule == nil {
+		return nil
+	}
+	for _, f := range ent.fields {
+		if f.name != rule.Field {
+			continue
+		}
+		for _, v := range rule.Values {
+			if !slices.Contains(f.enumValues, v) {
+				log.Printf("warning: %s.%s: @detail_visible_when value %q is not a %s value", ent.name, name, v, rule.Field)
+			}
+		}
+		return rule
+	}
+	log.Printf("warning: %s.%s: @detail_visible_when names unknown field %q; ignoring", ent.name, name, rule.Field)
+	return nil
+}
+
 // ── List schema building ─────────────────────────────────────────────────────
 
 func buildListSchema(ent *entityInfo, fields []UIFieldDef) UIList {
@@ -2005,7 +2295,28 @@ func buildListSchema(ent *entityInfo, fields []UIFieldDef) UIList {
 		MaxDefaultColumns: 7,
 		DefaultSort:       UISort{Field: "updated_at", Direction: "desc"},
 		RowClickAction:    "navigate_to_detail",
-		BulkActions:       false,
+		BulkActions:       []UIBulkAction{},
+	}
+
+	// Entities without a status machine default to alphabetical order on their
+	// @display() string field; everything else sorts by most recently updated.
+	displayField := entityDisplayField[toSnake(ent.name)]
+	if !ent.hasMachine {
+		for _, f := range fields {
+			if f.Name == displayField && f.IsDisplayName && f.Type == "string" {
+				list.DefaultSort = UISort{Field: displayField, Direction: "asc"}
+				break
+			}
+		}
+	}
+
+	// handlergen answers ?q= with a case-insensitive match on the same display
+	// field, so search is only offered when that field is plain text.
+	for _, f := range fields {
+		if f.Name == displayField && f.Type == "string" {
+			list.SearchField = displayField
+			break
+		}
 	}
 
 	// Select columns by priority
@@ -2013,7 +2324,8 @@ func buildListSchema(ent *entityInfo, fields []UIFieldDef) UIList {
 	var filters []UIListFilter
 	addedFields := map[string]bool{}
 
-	// Priority 0: display name — always include the entity's @display() field
+	// Priority 0: display name — always include the entity's @display() field,
+	// even when it is @computed() and therefore not sortable.
 	for _, f := range fields {
 		if f.IsDisplayName && len(columns) < 7 {
 			columns = append(columns, UIListColumn{
@@ -2047,9 +2359,11 @@ func buildListSchema(ent *entityInfo, fields []UIFieldDef) UIList {
 			columns = append(columns, UIListColumn{
 				Field: f.Name, Label: f.Label, Width: "140px", Component: "enum_badge",
 			})
-			filters = append(filters, UIListFilter{
-				Field: f.Name, Type: "multi_enum", EnumRef: f.EnumRef, Label: f.Label,
-			})
+			if f.Filterable {
+				filters = append(filters, UIListFilter{
+					Field: f.Name, Type: "multi_enum", EnumRef: f.EnumRef, Label: f.Label,
+				})
+			}
 			addedFields[f.Name] = true
 			break // Only one type column
 		}
@@ -2066,9 +2380,11 @@ func buildListSchema(ent *entityInfo, fields []UIFieldDef) UIList {
 				Field: f.Name, Label: f.Label, Width: "180px",
 				DisplayAs: displayAs,
 			})
-			filters = append(filters, UIListFilter{
-				Field: f.Name, Type: "entity_ref", RefEntity: f.RefEntity, Label: f.Label,
-			})
+			if f.Filterable {
+				filters = append(filters, UIListFilter{
+					Field: f.Name, Type: "entity_ref", RefEntity: f.RefEntity, Label: f.Label,
+				})
+			}
 			addedFields[f.Name] = true
 		}
 	}
@@ -2079,9 +2395,13 @@ func buildListSchema(ent *entityInfo, fields []UIFieldDef) UIList {
 			columns = append(columns, UIListColumn{
 				Field: f.Name, Label: f.Label, Width: "120px", Align: "right", Component: "money",
 			})
-			filters = append(filters, UIListFilter{
-				Field: f.Name, Type: "money_range", Label: f.Label,
-			})
+			if f.Filterable {
+				filter := UIListFilter{Field: f.Name, Type: "money_range", Label: f.Label}
+				if r := f.FilterRange; r != nil {
+					filter.Min, filter.Max = &r[0], &r[1]
+				}
+				filters = append(filters, filter)
+			}
 			addedFields[f.Name] = true
 		}
 	}
@@ -2101,6 +2421,16 @@ func buildListSchema(ent *entityInfo, fields []UIFieldDef) UIList {
 		}
 	}
 
+	// Boolean filters: tri-state (any/true/false). Computed bools are not
+	// filterable and are skipped here.
+	for _, f := range fields {
+		if f.Type == "bool" && f.ShowInList && f.Filterable {
+			filters = append(filters, UIListFilter{
+				Field: f.Name, Type: "boolean", Label: f.Label,
+			})
+		}
+	}
+
 	// Always add updated_at if room
 	if len(columns) < 7 {
 		columns = append(columns, UIListColumn{
@@ -2110,9 +2440,36 @@ func buildListSchema(ent *entityInfo, fields []UIFieldDef) UIList {
 
 	list.DefaultColumns = columns
 	list.Filters = filters
+	list.Hierarchy = buildHierarchyHint(fields)
+	list.GroupBy = ent.listGroupBy
 	return list
 }
 
+// buildHierarchyHint returns the tree hint for entities with a self-referencing
+// parent_ field, or nil. Other self-references such as superseded_by_id form
+// chains rather than trees and get no hint. An int "depth" or "level" field
+// alongside the parent is passed through so the renderer can indent without
+// walking the parent chain.
+func buildHierarchyHint(fields []UIFieldDef) *UIHierarchy {
+	var h *UIHierarchy
+	for _, f := range fields {
+		if f.IsSelfRef && strings.HasPrefix(f.Name, "parent_") {
+			h = &UIHierarchy{ParentField: f.Name}
+			break
+		}
+	}
+	if h == nil {
+		return nil
+	}
+	for _, f := range fields {
+		if (f.Name == "depth" || f.Name == "level") && f.Type == "int" {
+			h.DepthField = f.Name
+			break
+		}
+	}
+	return h
+}
+
 // ── Status schema building ───────────────────────────────────────────────────
 
 func buildStatusSchema(ent *entityInfo) *UIStatus {
@@ -2262,6 +2619,54 @@ func buildStateMachineSchema(ent *entityInfo, services []serviceInfo) *UIStateMa
 	return sm
 }
 
+// buildBulkActions returns the transitions of sm that are safe to apply to
+// many rows at once: those served by a generated transition operation that
+// needs no input beyond the record. Custom transitions are left out because
+// their hand-written handlers have side effects beyond the status change,
+// such as posting charges, and danger transitions because an eviction or
+// termination deserves a look at each record. A bulk action always asks for
+// confirmation.
+func buildBulkActions(ent *entityInfo, sm *UIStateMachine, services []serviceInfo) []UIBulkAction {
+	actions := []UIBulkAction{}
+	if sm == nil {
+		return actions
+	}
+	ops := make(map[string]operationInfo) // toStatus -> operation
+	for _, svc := range services {
+		for _, op := range svc.operations {
+			if op.entity == ent.name && op.opType == "transition" {
+				ops[op.toStatus] = op
+			}
+		}
+	}
+	safe := make(map[string]UITransition) // target -> any transition to it
+	unsafe := make(map[string]bool)
+	for _, state := range sortedKeys(sm.Transitions) {
+		for _, t := range sm.Transitions[state] {
+			op, ok := ops[t.Target]
+			if !ok || op.custom || len(t.RequiresFields) > 0 || t.Variant == "danger" {
+				unsafe[t.Target] = true
+				continue
+			}
+			safe[t.Target] = t
+		}
+	}
+	for _, target := range sortedKeys(safe) {
+		if unsafe[target] {
+			continue
+		}
+		t := safe[target]
+		actions = append(actions, UIBulkAction{
+			Target:      target,
+			Label:       generateTransitionLabel("", target, ent.name),
+			APIEndpoint: t.APIEndpoint,
+			Variant:     t.Variant,
+			Confirm:     true,
+		})
+	}
+	return actions
+}
+
 func classifyTransitionVariant(target string) string {
 	if dangerTargets[target] {
 		return "danger"
@@ -2394,6 +2799,7 @@ func buildRelationships(ent *entityInfo, relationships []relationshipInfo, servi
 			Cardinality:     r.edgeType,
 			DisplayInDetail: true,
 		}
+		rel.APIEndpoint = relatedEndpoint(r, services)
 
 		switch r.edgeType {
 		case "o2m":
@@ -2409,6 +2815,24 @@ func buildRelationships(ent *entityInfo, relationships []relationshipInfo, servi
 	return rels
 }
 
+// relatedEndpoint returns the sub-resource route handlergen serves for an
+// O2M or M2M relationship, e.g. /v1/properties/{id}/spaces. It returns ""
+// for other cardinalities and when the source has no generated get
+// operation, since handlergen then serves no such route.
+func relatedEndpoint(r relationshipInfo, services []serviceInfo) string {
+	if r.edgeType != "o2m" && r.edgeType != "m2m" {
+		return ""
+	}
+	for _, svc := range services {
+		for _, op := range svc.operations {
+			if op.entity == r.from && op.opType == "get" && !op.custom {
+				return fmt.Sprintf("%s/%s/{id}/%s", svc.basePath, op.entityPath, strings.ReplaceAll(r.name, "_", "-"))
+			}
+		}
+	}
+	return ""
+}
+
 // ── Validation building ──────────────────────────────────────────────────────
 
 func buildValidation(ent *entityInfo, fields []UIFieldDef) UIValidation {
@@ -2435,6 +2859,10 @@ func buildValidation(ent *entityInfo, fields []UIFieldDef) UIValidation {
 			v.FieldRules = append(v.FieldRules, UIFieldRule{
 				Field: f.Name, Rule: "pattern", Value: f.Pattern,
 			})
+		} else if f.Type == "email" || f.Type == "phone" || f.Type == "url" {
+			v.FieldRules = append(v.FieldRules, UIFieldRule{
+				Field: f.Name, Rule: "format", Value: f.Type,
+			})
 		}
 		if f.MinItems != nil {
 			v.FieldRules = append(v.FieldRules, UIFieldRule{
@@ -2526,8 +2954,9 @@ func buildAPISchema(ent *entityInfo, services []serviceInfo) UIAPI {
 			case "transition":
 				if op.action != "" {
 					api.Transitions[op.action] = UIAPIEndpoint{
-						Method: "POST",
-						Path:   path + "/{id}/" + op.action,
+						Method:        "POST",
+						Path:          path + "/{id}/" + op.action,
+						RequestFields: op.extraFields,
 					}
 				}
 			}
@@ -2539,6 +2968,39 @@ func buildAPISchema(ent *entityInfo, services []serviceInfo) UIAPI {
 
 // ── Embedded type parsing ────────────────────────────────────────────────────
 
+// buildEmbeddedTypeSchemas builds field definitions for every embedded value
+// type so uirender can generate a form section component per type. Enum
+// fields are named {Type}{Field} and added to allEnums.
+func buildEmbeddedTypeSchemas(enumGroupings map[string]UIEnum, allEnums map[string]UIEnum) map[string]UIEmbeddedType {
+	types := make(map[string]UIEmbeddedType, len(embeddedTypes))
+	for _, name := range sortedKeys(embeddedTypes) {
+		td := embeddedTypes[name]
+		fields := buildFieldDefs(&entityInfo{name: name, fields: td.fields}, nil)
+		// buildFieldDefs emits one definition per field, in order.
+		for i, f := range td.fields {
+			if f.uiType != "enum" || len(f.enumValues) == 0 {
+				continue
+			}
+			enumName := name + toPascal(f.name)
+			fields[i].EnumRef = enumName
+			if _, ok := allEnums[enumName]; ok {
+				continue
+			}
+			if ge, ok := enumGroupings[enumName]; ok {
+				allEnums[enumName] = ge
+				continue
+			}
+			e := UIEnum{}
+			for _, v := range f.enumValues {
+				e.Values = append(e.Values, UIEnumValue{Value: v, Label: generateEnumLabel(v)})
+			}
+			allEnums[enumName] = e
+		}
+		types[name] = UIEmbeddedType{Name: name, Fields: fields}
+	}
+	return types
+}
+
 type embeddedTypeDef struct {
 	name   string
 	fields []fieldInfo
@@ -2562,7 +3024,7 @@ func parseEmbeddedTypes(val cue.Value) map[string]*embeddedTypeDef {
 		}
 
 		// Only include known value types that are structs
-		if _, ok := knownValueTypes[label]; !ok {
+		if _, ok := cueparse.ValueTypes[label]; !ok {
 			continue
 		}
 
@@ -2580,7 +3042,7 @@ func parseEmbeddedTypes(val cue.Value) map[string]*embeddedTypeDef {
 				continue
 			}
 
-			fi := classifyUIField(fname, fIter.Value(), fIter.IsOptional())
+			fi := classifyUIField(cueparse.Classify(fname, fIter.Value(), fIter.IsOptional()))
 			if fi != nil {
 				td.fields = append(td.fields, *fi)
 			}
@@ -2633,6 +3095,9 @@ func main() {
 	log.SetFlags(0)
 	log.SetPrefix("uigen: ")
 
+	strict := flag.Bool("strict", false, "fail instead of warn when an entity has no @display() or name field")
+	flag.Parse()
+
 	ctx := cuecontext.New()
 	projectRoot := findProjectRoot()
 
@@ -2669,7 +3134,8 @@ func main() {
 	services := parseOperations(cgVal)
 	overrides := parseUIOverrides(cgVal)
 	enumGroupings := parseEnumGroupings(cgVal)
-	_ = parseEmbeddedTypes(ontVal) // Collected but schemas capture them via field types
+	listOverrides := parseListOverrides(cgVal)
+	embeddedTypes = parseEmbeddedTypes(ontVal)
 
 	// Populate knownEntityNames for field classifier to validate _id references
 	for name := range entities {
@@ -2683,7 +3149,9 @@ func main() {
 	}
 
 	// Populate entityDisplayField — uses the first @display()-annotated field per entity.
-	// Falls back to "name" or "id" if no field is marked with @display().
+	// Falls back to "name" or "id" if no field is marked with @display();
+	// entities that end up on "id" are reported so they can be annotated.
+	var undisplayed []string
 	for eName, ent := range entities {
 		snake := toSnake(eName)
 		best := "id"
@@ -2703,11 +3171,25 @@ func main() {
 			}
 		}
 		entityDisplayField[snake] = best
+		if best == "id" {
+			undisplayed = append(undisplayed, eName)
+		}
+	}
+	if len(undisplayed) > 0 {
+		sort.Strings(undisplayed)
+		msg := fmt.Sprintf("%d entities have no @display() or name field and will be labeled by id: %s; mark one field with @display()",
+			len(undisplayed), strings.Join(undisplayed, ", "))
+		if *strict {
+			log.Fatal(msg)
+		}
+		log.Printf("warning: %s", msg)
 	}
 
 	// Reclassify _id/_ids fields now that knownEntityNames and edgeToEntity are populated.
 	// parseEntities runs classifyUIField before these maps exist, so entity_ref detection
 	// for edge-named fields (e.g., owner_id → organization) needs a second pass.
+	// Hierarchy fields name their own entity behind a parent_ prefix
+	// (parent_account_id → account).
 	for _, ent := range entities {
 		for i, f := range ent.fields {
 			if f.uiType != "string" {
@@ -2730,6 +3212,9 @@ func main() {
 				} else if target, ok := edgeToEntity[ref]; ok {
 					ent.fields[i].uiType = "entity_ref"
 					ent.fields[i].refEntity = target
+				} else if parent := strings.TrimPrefix(ref, "parent_"); parent != ref && knownEntityNames[parent] {
+					ent.fields[i].uiType = "entity_ref"
+					ent.fields[i].refEntity = parent
 				}
 			}
 		}
@@ -2745,10 +3230,11 @@ func main() {
 	allEnums := make(map[string]UIEnum)
 
 	// Generate schema for each entity
+	listColumns := newListColumnCache(entities, relationships, listOverrides)
 	entityNames := sortedKeys(entities)
 	for _, name := range entityNames {
 		ent := entities[name]
-		schema := buildUISchema(ent, relationships, services, overrides, enumGroupings, allEnums)
+		schema := buildUISchema(ent, relationships, services, overrides, enumGroupings, listOverrides, allEnums, listColumns)
 
 		outPath := filepath.Join(outDir, toSnake(name)+".schema.json")
 		if err := writeJSON(outPath, schema); err != nil {
@@ -2757,6 +3243,14 @@ func main() {
 		fmt.Printf("Generated gen/ui/schema/%s.schema.json\n", toSnake(name))
 	}
 
+	// Write embedded value types; their enum fields join the combined enums.
+	types := buildEmbeddedTypeSchemas(enumGroupings, allEnums)
+	typesPath := filepath.Join(outDir, "_types.schema.json")
+	if err := writeJSON(typesPath, types); err != nil {
+		log.Fatalf("writing embedded types schema: %v", err)
+	}
+	fmt.Printf("Generated gen/ui/schema/_types.schema.json\n")
+
 	// Write combined enums file
 	enumPath := filepath.Join(outDir, "_enums.schema.json")
 	if err := writeJSON(enumPath, allEnums); err != nil {
@@ -2764,5 +3258,6 @@ func main() {
 	}
 	fmt.Printf("Generated gen/ui/schema/_enums.schema.json\n")
 
-	fmt.Printf("uigen: generated %d entity schemas + 1 enums schema\n", len(entities))
+	fmt.Printf("uigen: generated %d entity schemas + %d embedded types + 1 enums schema\n", len(entities), len(types))
 }
+
@@ -9,12 +9,14 @@ import (
 	"embed"
 	"encoding/json"
 	"fmt"
+	"html"
 	"log"
 	"os"
 	"path/filepath"
 	"sort"
 	"strings"
 	"text/template"
+	"unicode"
 )
 
 //go:embed templates/*
@@ -23,55 +25,67 @@ var templateFS embed.FS
 // entityBasePaths maps entity names to their API base paths, populated during schema loading.
 var entityBasePaths = map[string]string{}
 
+// embeddedSections records the value types that have a generated section
+// component under components/sections. Populated in main() before forms render.
+var embeddedSections = map[string]bool{}
+
 // ── Schema types (mirrors uigen output) ──────────────────────────────────────
 
 type UISchema struct {
-	Entity            string                      `json:"entity"`
-	DisplayName       string                      `json:"display_name"`
-	DisplayNamePlural string                      `json:"display_name_plural"`
-	PrimaryDisplay    string                      `json:"primary_display_template"`
-	Fields            []UIFieldDef                `json:"fields"`
-	Enums             map[string]UIEnum           `json:"enums"`
-	Form              UIForm                      `json:"form"`
-	Detail            UIDetail                    `json:"detail"`
-	List              UIList                      `json:"list"`
-	Status            *UIStatus                   `json:"status"`
-	StateMachine      *UIStateMachine             `json:"state_machine"`
-	Relationships     []UIRelationship            `json:"relationships"`
-	Validation        UIValidation                `json:"validation"`
-	API               UIAPI                       `json:"api"`
+	Entity              string            `json:"entity"`
+	DisplayName         string            `json:"display_name"`
+	DisplayNamePlural   string            `json:"display_name_plural"`
+	PrimaryDisplay      string            `json:"primary_display_template"`
+	PrimaryDisplayField string            `json:"primary_display_field"`
+	Fields              []UIFieldDef      `json:"fields"`
+	Enums               map[string]UIEnum `json:"enums"`
+	Form                UIForm            `json:"form"`
+	Detail              UIDetail          `json:"detail"`
+	List                UIList            `json:"list"`
+	Status              *UIStatus         `json:"status"`
+	StateMachine        *UIStateMachine   `json:"state_machine"`
+	Relationships       []UIRelationship  `json:"relationships"`
+	Validation          UIValidation      `json:"validation"`
+	API                 UIAPI             `json:"api"`
 }
 
 type UIFieldDef struct {
-	Name             string `json:"name"`
-	Type             string `json:"type"`
-	EnumRef          string `json:"enum_ref,omitempty"`
-	ObjectRef        string `json:"object_ref,omitempty"`
-	RefEntity        string `json:"ref_entity,omitempty"`
-	RefDisplay       string `json:"ref_display,omitempty"`
-	MoneyVariant     string `json:"money_variant,omitempty"`
-	Required         bool   `json:"required"`
-	Default          any    `json:"default"`
-	Immutable        bool   `json:"immutable,omitempty"`
-	Label            string `json:"label"`
-	HelpText         string `json:"help_text,omitempty"`
-	ShowInCreate     bool   `json:"show_in_create"`
-	ShowInUpdate     bool   `json:"show_in_update"`
-	ShowInList       bool   `json:"show_in_list"`
-	ShowInDetail     bool   `json:"show_in_detail"`
-	Sortable         bool   `json:"sortable"`
-	Filterable       bool   `json:"filterable"`
-	FilterType       string `json:"filter_type,omitempty"`
-	Pattern          string `json:"pattern,omitempty"`
-	Min              any    `json:"min,omitempty"`
-	Max              any    `json:"max,omitempty"`
-	MinItems         *int   `json:"min_items,omitempty"`
-	IsSensitive      bool   `json:"is_sensitive,omitempty"`
-	IsPII            bool   `json:"is_pii,omitempty"`
-	IsComputed       bool   `json:"is_computed,omitempty"`
-	IsDeprecated     bool   `json:"is_deprecated,omitempty"`
-	DeprecatedReason string `json:"deprecated_reason,omitempty"`
-	DeprecatedSince  string `json:"deprecated_since,omitempty"`
+	Name                  string   `json:"name"`
+	Type                  string   `json:"type"`
+	EnumRef               string   `json:"enum_ref,omitempty"`
+	ObjectRef             string   `json:"object_ref,omitempty"`
+	RefEntity             string   `json:"ref_entity,omitempty"`
+	RefDisplay            string   `json:"ref_display,omitempty"`
+	RefFilter             string   `json:"ref_filter,omitempty"`
+	MoneyVariant          string   `json:"money_variant,omitempty"`
+	Currencies            []string `json:"currencies,omitempty"`
+	DurationUnit          string   `json:"duration_unit,omitempty"`
+	Required              bool     `json:"required"`
+	ConditionallyRequired bool     `json:"conditionally_required,omitempty"` // required by a cross-field rule
+	Default               any      `json:"default"`
+	Immutable             bool     `json:"immutable,omitempty"`
+	ReadonlyAfterCreate   bool     `json:"readonly_after_create,omitempty"`
+	RelativeTime          bool     `json:"relative_time,omitempty"` // detail view shows a relative hint beside the date
+	IsExpiry              bool     `json:"is_expiry,omitempty"`     // the hint warns as the date nears and flags it once past
+	Label                 string   `json:"label"`
+	HelpText              string   `json:"help_text,omitempty"`
+	ShowInCreate          bool     `json:"show_in_create"`
+	ShowInUpdate          bool     `json:"show_in_update"`
+	ShowInList            bool     `json:"show_in_list"`
+	ShowInDetail          bool     `json:"show_in_detail"`
+	Sortable              bool     `json:"sortable"`
+	Filterable            bool     `json:"filterable"`
+	FilterType            string   `json:"filter_type,omitempty"`
+	Pattern               string   `json:"pattern,omitempty"`
+	Min                   any      `json:"min,omitempty"`
+	Max                   any      `json:"max,omitempty"`
+	MinItems              *int     `json:"min_items,omitempty"`
+	IsSensitive           bool     `json:"is_sensitive,omitempty"`
+	IsPII                 bool     `json:"is_pii,omitempty"`
+	IsComputed            bool     `json:"is_computed,omitempty"`
+	IsDeprecated          bool     `json:"is_deprecated,omitempty"`
+	DeprecatedReason      string   `json:"deprecated_reason,omitempty"`
+	DeprecatedSince       string   `json:"deprecated_since,omitempty"`
 }
 
 type UIEnum struct {
@@ -79,9 +93,15 @@ type UIEnum struct {
 	Groups []UIEnumGroup `json:"groups,omitempty"`
 }
 
+type UIEmbeddedType struct {
+	Name   string       `json:"name"`
+	Fields []UIFieldDef `json:"fields"`
+}
+
 type UIEnumValue struct {
-	Value string `json:"value"`
-	Label string `json:"label"`
+	Value      string `json:"value"`
+	Label      string `json:"label"`
+	Deprecated bool   `json:"deprecated,omitempty"`
 }
 
 type UIEnumGroup struct {
@@ -89,9 +109,68 @@ type UIEnumGroup struct {
 	Values []string `json:"values"`
 }
 
+// enumOptionGroup is one <optgroup> of an enum's options. Label is empty for
+// enums without groupings, which render as a single flat list.
+type enumOptionGroup struct {
+	Label   string
+	Options []UIEnumValue
+}
+
+// enumOptionGroups arranges an enum's values into its declared groups, in
+// declaration order. Values no group claims are collected into a trailing
+// "Other" group (merged into an existing "Other" group if one is declared).
+func enumOptionGroups(e UIEnum) []enumOptionGroup {
+	if len(e.Groups) == 0 {
+		return []enumOptionGroup{{Options: e.Values}}
+	}
+	byValue := make(map[string]UIEnumValue, len(e.Values))
+	for _, v := range e.Values {
+		byValue[v.Value] = v
+	}
+	claimed := make(map[string]bool)
+	var groups []enumOptionGroup
+	other := -1
+	for _, g := range e.Groups {
+		og := enumOptionGroup{Label: g.Label}
+		for _, val := range g.Values {
+			if v, ok := byValue[val]; ok && !claimed[val] {
+				og.Options = append(og.Options, v)
+				claimed[val] = true
+			}
+		}
+		if len(og.Options) == 0 {
+			continue
+		}
+		if g.Label == "Other" {
+			other = len(groups)
+		}
+		groups = append(groups, og)
+	}
+	var rest []UIEnumValue
+	for _, v := range e.Values {
+		if !claimed[v.Value] {
+			rest = append(rest, v)
+		}
+	}
+	if len(rest) > 0 {
+		if other >= 0 {
+			groups[other].Options = append(groups[other].Options, rest...)
+		} else {
+			groups = append(groups, enumOptionGroup{Label: "Other", Options: rest})
+		}
+	}
+	return groups
+}
+
 type UIForm struct {
 	Sections       []UIFormSection `json:"sections"`
 	FieldOrderRule string          `json:"field_order_rule"`
+	Steps          []UIFormStep    `json:"steps,omitempty"`
+}
+
+type UIFormStep struct {
+	Title    string   `json:"title"`
+	Sections []string `json:"sections"`
 }
 
 type UIFormSection struct {
@@ -133,19 +212,36 @@ type UIDetailSection struct {
 	EmbeddedObject string          `json:"embedded_object,omitempty"`
 	DisplayMode    string          `json:"display_mode,omitempty"`
 	VisibleWhen    *VisibilityRule `json:"visible_when,omitempty"`
+	// FieldDisplayModes overrides how single fields render, independent of
+	// the section's DisplayMode: "masked" hides a value until revealed.
+	FieldDisplayModes map[string]string `json:"field_display_modes,omitempty"`
+	// FieldLabels labels the sub-fields of an embedded_object section, whose
+	// names are relative to the embedded object.
+	FieldLabels map[string]string `json:"field_labels,omitempty"`
+}
+
+// Label returns the label of a field shown in the section.
+func (s UIDetailSection) Label(name string) string {
+	if label, ok := s.FieldLabels[name]; ok {
+		return label
+	}
+	return fieldLabel(name)
 }
 
 type UIRelatedSection struct {
-	Title        string `json:"title"`
-	Relationship string `json:"relationship"`
-	Entity       string `json:"entity"`
-	Display      string `json:"display"`
+	Title         string   `json:"title"`
+	Relationship  string   `json:"relationship"`
+	Entity        string   `json:"entity"`
+	Display       string   `json:"display"`
+	IncludeFields []string `json:"include_fields,omitempty"`
 }
 
 type UIList struct {
 	DefaultColumns []UIListColumn `json:"default_columns"`
 	Filters        []UIListFilter `json:"filters"`
 	DefaultSort    UISort         `json:"default_sort"`
+	SearchField    string         `json:"search_field,omitempty"`
+	GroupBy        string         `json:"group_by,omitempty"` // enum field the list groups rows under
 }
 
 type UIListColumn struct {
@@ -158,11 +254,13 @@ type UIListColumn struct {
 }
 
 type UIListFilter struct {
-	Field     string `json:"field"`
-	Type      string `json:"type"`
-	Label     string `json:"label,omitempty"`
-	EnumRef   string `json:"enum_ref,omitempty"`
-	RefEntity string `json:"ref_entity,omitempty"`
+	Field     string   `json:"field"`
+	Type      string   `json:"type"`
+	Label     string   `json:"label,omitempty"`
+	EnumRef   string   `json:"enum_ref,omitempty"`
+	RefEntity string   `json:"ref_entity,omitempty"`
+	Min       *float64 `json:"min,omitempty"` // money_range bounds, whole currency units
+	Max       *float64 `json:"max,omitempty"`
 }
 
 type UISort struct {
@@ -193,7 +291,8 @@ type UIRelationship struct {
 	Name         string `json:"name"`
 	TargetEntity string `json:"target_entity"`
 	Cardinality  string `json:"cardinality"`
-	APIEndpoint  string `json:"api_endpoint,omitempty"`
+	APIEndpoint  string `json:"api_endpoint,omitempty"` // list path returning the related records; {id} is this entity's ID
+	DisplayMode  string `json:"display_mode,omitempty"`
 }
 
 type UIValidation struct {
@@ -223,8 +322,9 @@ type UIAPI struct {
 }
 
 type UIAPIEndpoint struct {
-	Method string `json:"method"`
-	Path   string `json:"path"`
+	Method        string   `json:"method"`
+	Path          string   `json:"path"`
+	RequestFields []string `json:"request_fields,omitempty"` // transition body members
 }
 
 // ── Template context types ───────────────────────────────────────────────────
@@ -235,10 +335,139 @@ type templateData struct {
 	CamelName       string
 	HasStatus       bool
 	HasStateMachine bool
+	HasDelete       bool // API exposes a delete operation; detail renders a confirmed Delete button
 	StatusType      string
 	InitialStatus   string // First status enum value — used as default on create
 	RoutePath       string // e.g., "/properties" — API.BasePath with /v1 prefix stripped
 	Imports         []importDef
+	ImmutableFields []string    // form fields rendered read-only in edit mode
+	DetailImports   []importDef // enum label constants used by the detail grid
+	EmbeddedTypes   map[string]UIEmbeddedType
+	FormSteps       []formStep // wizard steps; empty renders a single-page form
+}
+
+// formStep is a wizard step as the form template needs it: the fields whose
+// validation errors keep the user from moving past the step.
+type formStep struct {
+	Title    string
+	Sections []string
+	Fields   []string
+}
+
+// SectionStep returns the index of the wizard step that shows the form
+// section, or -1 if no step does.
+func (d templateData) SectionStep(id string) int {
+	for i, step := range d.FormSteps {
+		for _, sec := range step.Sections {
+			if sec == id {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+// FieldType returns the UI type of the named field, or "" if it is unknown.
+func (d templateData) FieldType(name string) string {
+	if f := d.field(name); f != nil {
+		return f.Type
+	}
+	return ""
+}
+
+// PluralPascalName is the plural display name as an identifier, e.g.
+// "JournalEntries", used to name the typed list function.
+func (d templateData) PluralPascalName() string {
+	return strings.ReplaceAll(d.DisplayNamePlural, " ", "")
+}
+
+// TransitionFunc names the typed API function for a transition action,
+// e.g. "make-ready" on Space → "transitionSpaceMakeReady".
+func (d templateData) TransitionFunc(action string) string {
+	name := toCamelHyphen(action)
+	if name == "" {
+		return "transition" + d.PascalName
+	}
+	return "transition" + d.PascalName + strings.ToUpper(name[:1]) + name[1:]
+}
+
+// TransitionBody names the request body interface of a transition action,
+// e.g. "make-ready" on Space → "SpaceMakeReadyBody".
+func (d templateData) TransitionBody(action string) string {
+	return strings.TrimPrefix(d.TransitionFunc(action), "transition") + "Body"
+}
+
+// TransitionFieldType returns the TypeScript type of a transition body
+// member: the entity's own type for one of its fields, otherwise a string
+// (dates travel as ISO strings).
+func (d templateData) TransitionFieldType(name string) string {
+	if d.field(name) != nil {
+		return d.PascalName + "['" + name + "']"
+	}
+	return "string"
+}
+
+// Related returns the named relationship, or nil if it is unknown.
+func (d templateData) Related(name string) *UIRelationship {
+	for i := range d.Relationships {
+		if d.Relationships[i].Name == name {
+			return &d.Relationships[i]
+		}
+	}
+	return nil
+}
+
+// HasRelatedEndpoints reports whether any related section of the detail view
+// can fetch its records.
+func (d templateData) HasRelatedEndpoints() bool {
+	for _, rs := range d.Detail.RelatedSections {
+		if rel := d.Related(rs.Relationship); rel != nil && rel.APIEndpoint != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// field returns the named field definition, or nil if it is unknown.
+func (d templateData) field(name string) *UIFieldDef {
+	for i := range d.Fields {
+		if d.Fields[i].Name == name {
+			return &d.Fields[i]
+		}
+	}
+	return nil
+}
+
+// EmbeddedFieldType returns the UI type of a sub-field of an embedded value
+// type, or "" if either is unknown.
+func (d templateData) EmbeddedFieldType(typeName, name string) string {
+	for _, f := range d.EmbeddedTypes[typeName].Fields {
+		if f.Name == name {
+			return f.Type
+		}
+	}
+	return ""
+}
+
+// FieldSortable reports whether the named field can be sorted on. Columns that
+// do not map to a known field are not sortable.
+func (d templateData) FieldSortable(name string) bool {
+	for _, f := range d.Fields {
+		if f.Name == name {
+			return f.Sortable
+		}
+	}
+	return false
+}
+
+// GroupByLabels returns the label constant of the enum the list groups rows
+// by, or "" when the list is not grouped.
+func (d templateData) GroupByLabels() string {
+	fd := d.field(d.List.GroupBy)
+	if fd == nil || fd.Type != "enum" || fd.EnumRef == "" {
+		return ""
+	}
+	return toScreamingSnake(fd.EnumRef) + "_LABELS"
 }
 
 type importDef struct {
@@ -256,6 +485,29 @@ type sectionTemplateData struct {
 	Fields      []UIFieldDef
 }
 
+// EnumOptions returns the sorted option constants the section's enum fields use.
+func (d sectionTemplateData) EnumOptions() []string {
+	var consts []string
+	for _, f := range d.Fields {
+		if f.Type == "enum" && f.EnumRef != "" {
+			consts = append(consts, toScreamingSnake(f.EnumRef)+"_OPTIONS")
+		}
+	}
+	sort.Strings(consts)
+	return dedupStrings(consts)
+}
+
+// dedupStrings removes adjacent duplicates from a sorted slice.
+func dedupStrings(s []string) []string {
+	var out []string
+	for i, v := range s {
+		if i == 0 || v != s[i-1] {
+			out = append(out, v)
+		}
+	}
+	return out
+}
+
 // ── Name utilities ───────────────────────────────────────────────────────────
 
 func toPascal(s string) string {
@@ -269,6 +521,23 @@ func toPascal(s string) string {
 	return strings.Join(parts, "")
 }
 
+// toSnake converts a PascalCase type name to snake_case, keeping acronyms
+// together (CAMTerms → cam_terms).
+func toSnake(s string) string {
+	rs := []rune(s)
+	var b strings.Builder
+	for i, r := range rs {
+		if unicode.IsUpper(r) {
+			if i > 0 && (unicode.IsLower(rs[i-1]) || (i+1 < len(rs) && unicode.IsLower(rs[i+1]))) {
+				b.WriteByte('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
 func toCamel(s string) string {
 	p := toPascal(s)
 	if len(p) == 0 {
@@ -314,30 +583,49 @@ func fieldLabel(s string) string {
 	return strings.Join(parts, " ")
 }
 
+// statusColorClasses maps each state color uigen's classifyStateColor assigns
+// to its badge classes: a Skeleton soft variant for the tint plus explicit
+// text tones for light and dark mode (Tailwind darkMode: 'class'), so the
+// label keeps its contrast whichever theme is active.
+var statusColorClasses = map[string]string{
+	"success":   "variant-soft-success text-success-800 dark:text-success-200",
+	"error":     "variant-soft-error text-error-800 dark:text-error-200",
+	"warning":   "variant-soft-warning text-warning-800 dark:text-warning-200",
+	"secondary": "variant-soft-secondary text-secondary-800 dark:text-secondary-200",
+	"surface":   "variant-soft-surface text-surface-800 dark:text-surface-200",
+}
+
+// skeletonVariant returns the badge classes for a state color, falling back
+// to the neutral surface classes for colors it does not know.
 func skeletonVariant(color string) string {
-	switch color {
-	case "success":
-		return "variant-soft-success"
-	case "error":
-		return "variant-soft-error"
-	case "warning":
-		return "variant-soft-warning"
-	case "secondary":
-		return "variant-soft-secondary"
-	case "surface":
-		return "variant-soft"
-	default:
-		return "variant-soft"
+	if classes, ok := statusColorClasses[color]; ok {
+		return classes
 	}
+	return statusColorClasses["surface"]
+}
+
+// statusColorClassesTS renders statusColorClasses as the body of a TypeScript
+// object literal, one color per line in name order.
+func statusColorClassesTS() string {
+	colors := make([]string, 0, len(statusColorClasses))
+	for c := range statusColorClasses {
+		colors = append(colors, c)
+	}
+	sort.Strings(colors)
+	var b strings.Builder
+	for _, c := range colors {
+		fmt.Fprintf(&b, "    %s: '%s',\n", c, statusColorClasses[c])
+	}
+	return b.String()
 }
 
 // ── Template functions ───────────────────────────────────────────────────────
 
 func tsType(f UIFieldDef) string {
 	switch f.Type {
-	case "string", "text", "date", "datetime":
+	case "string", "text", "date", "datetime", "email", "phone", "url":
 		return "string"
-	case "int", "float":
+	case "int", "float", "percent", "duration":
 		return "number"
 	case "bool":
 		return "boolean"
@@ -398,9 +686,18 @@ func replaceIDTemplate(s string) string {
 	return strings.ReplaceAll(s, "{id}", "${entityId}")
 }
 
+// jsStringEscaper escapes text for a single-quoted JS/TS string literal.
+var jsStringEscaper = strings.NewReplacer(
+	`\`, `\\`,
+	`'`, `\'`,
+	"\n", `\n`,
+	"\r", `\r`,
+	"\u2028", `\u2028`,
+	"\u2029", `\u2029`,
+)
+
 func escapeJS(s string) string {
-	s = strings.ReplaceAll(s, "'", "\\'")
-	return s
+	return jsStringEscaper.Replace(s)
 }
 
 func dotToOptional(s string) string {
@@ -415,38 +712,47 @@ func derefBool(b *bool) bool {
 }
 
 func visibilityCheck(rule *VisibilityRule, varName string) string {
+	return "return " + visibilityExpr(rule, varName) + ";"
+}
+
+// visibilityExpr renders a VisibilityRule as a JavaScript boolean expression
+// over varName, for use in {#if} blocks.
+func visibilityExpr(rule *VisibilityRule, varName string) string {
 	if rule == nil {
-		return "return true;"
+		return "true"
 	}
 	switch rule.Operator {
 	case "eq":
 		switch v := rule.Value.(type) {
 		case bool:
-			return fmt.Sprintf("return %s.%s === %v;", varName, rule.Field, v)
+			return fmt.Sprintf("%s.%s === %v", varName, rule.Field, v)
 		default:
-			return fmt.Sprintf("return %s.%s === '%v';", varName, rule.Field, v)
+			return fmt.Sprintf("%s.%s === '%v'", varName, rule.Field, v)
 		}
 	case "in":
 		vals := make([]string, len(rule.Values))
 		for i, v := range rule.Values {
 			vals[i] = fmt.Sprintf("'%s'", v)
 		}
-		return fmt.Sprintf("return [%s].includes(%s.%s ?? '');", strings.Join(vals, ", "), varName, rule.Field)
+		return fmt.Sprintf("[%s].includes(%s.%s ?? '')", strings.Join(vals, ", "), varName, rule.Field)
 	case "truthy":
-		return fmt.Sprintf("return !!%s.%s;", varName, rule.Field)
+		return fmt.Sprintf("!!%s.%s", varName, rule.Field)
 	default:
-		return "return true;"
+		return "true"
 	}
 }
 
 func formFieldRender(data any, fieldName string) string {
 	// Extract fields from either UISchema or templateData
 	var fields []UIFieldDef
+	var validation UIValidation
 	switch d := data.(type) {
 	case templateData:
 		fields = d.Fields
+		validation = d.Validation
 	case UISchema:
 		fields = d.Fields
+		validation = d.Validation
 	default:
 		return fmt.Sprintf("    <!-- Unknown field: %s -->", fieldName)
 	}
@@ -466,13 +772,217 @@ func formFieldRender(data any, fieldName string) string {
 	req := ""
 	if fd.Required {
 		req = " required"
+	} else if fd.ConditionallyRequired {
+		req = requiredIfAttr(fd.Name, validation)
+	}
+
+	out := validateOnBlur(fieldControlRender(fd, req), fd.Name)
+	if fd.Immutable || fd.ReadonlyAfterCreate {
+		out = lockInEditMode(out)
+	}
+	return out
+}
+
+// validateOnBlur hooks a rendered FormField's focusout to the form's
+// handleBlur, so the field is validated as soon as the user leaves it.
+func validateOnBlur(field, name string) string {
+	errAttr := fmt.Sprintf(" error={errors['%s']}>", name)
+	return strings.Replace(field, errAttr, fmt.Sprintf(" error={errors['%s']} on:focusout={(e) => handleBlur(e, '%s')}>", name, name), 1)
+}
+
+// requiredIfAttr renders the FormField requiredIf marker for a conditionally
+// required field, carrying the messages of the cross-field rules that require
+// it so the form can explain when the field becomes mandatory.
+func requiredIfAttr(fieldName string, v UIValidation) string {
+	var conditions []string
+	for _, r := range v.CrossFieldRules {
+		if r.Then.Field == fieldName && r.Then.Rule == "required" {
+			conditions = append(conditions, r.Message)
+		}
+	}
+	if len(conditions) == 0 {
+		return " requiredIf"
+	}
+	// Braces would start a Svelte expression inside the attribute value.
+	text := html.EscapeString(strings.Join(conditions, "; "))
+	text = strings.NewReplacer("{", "&#123;", "}", "&#125;").Replace(text)
+	return ` requiredIf="` + text + `"`
+}
+
+// currenciesAttr renders the MoneyInput currencies prop for a money field whose
+// allowed currencies differ from the USD-only default.
+func currenciesAttr(currencies []string) string {
+	if len(currencies) < 2 && (len(currencies) == 0 || currencies[0] == "USD") {
+		return ""
+	}
+	quoted := make([]string, len(currencies))
+	for i, c := range currencies {
+		quoted[i] = "'" + c + "'"
+	}
+	return " currencies={[" + strings.Join(quoted, ", ") + "]}"
+}
+
+// detailFieldRender renders the value cell of a detail grid field by its UI
+// type: money and dates through their display components, the status through
+// the entity's color-mapped badge, other enums as labeled badges and entity
+// references as links to the referenced record.
+func detailFieldRender(data templateData, fieldName string) string {
+	fd := data.field(fieldName)
+	if fd == nil {
+		return fmt.Sprintf("<dd>{entity.%s}</dd>", fieldName)
+	}
+	v := "entity." + fd.Name
+	switch fd.Type {
+	case "money":
+		return fmt.Sprintf("<dd><MoneyDisplay value={%s} /></dd>", v)
+	case "date":
+		return fmt.Sprintf("<dd><DateDisplay value={%s} />%s</dd>", v, relativeTimeHint(fd, v))
+	case "datetime":
+		return fmt.Sprintf("<dd><DateTimeDisplay value={%s} />%s</dd>", v, relativeTimeHint(fd, v))
+	case "date_range":
+		return fmt.Sprintf("<dd><DateRangeDisplay value={%s} /></dd>", v)
+	case "address":
+		return fmt.Sprintf("<dd><AddressDisplay value={%s} /></dd>", v)
+	case "enum":
+		if fd.Name == "status" && data.HasStatus {
+			return fmt.Sprintf("<dd><%sStatusBadge status={%s} /></dd>", data.PascalName, v)
+		}
+		labels := ""
+		if fd.EnumRef != "" {
+			labels = fmt.Sprintf(" labels={%s_LABELS}", toScreamingSnake(fd.EnumRef))
+		}
+		return fmt.Sprintf("<dd>{#if %s}<EnumBadge value={%s}%s />{/if}</dd>", v, v, labels)
+	case "entity_ref":
+		route := entityRoute(fd.RefEntity)
+		if route == "" {
+			return fmt.Sprintf("<dd>{%s}</dd>", v)
+		}
+		return fmt.Sprintf(`<dd>{#if %s}<a class="anchor" href="#%s/{%s}">{%s}</a>{/if}</dd>`, v, route, v, v)
+	case "email":
+		return fmt.Sprintf(`<dd>{#if %s}<a class="anchor" href="mailto:{%s}">{%s}</a>{/if}</dd>`, v, v, v)
+	case "phone":
+		return fmt.Sprintf(`<dd>{#if %s}<a class="anchor" href="tel:{%s}">{%s}</a>{/if}</dd>`, v, v, v)
+	case "url":
+		return fmt.Sprintf(`<dd>{#if %s}<a class="anchor" href={%s} target="_blank" rel="noopener noreferrer">{%s}</a>{/if}</dd>`, v, v, v)
+	default:
+		return fmt.Sprintf("<dd>{%s}</dd>", v)
+	}
+}
+
+// relativeTimeHint renders the RelativeTime hint that follows a
+// @relative_time() date in the detail grid, or "" for other fields.
+func relativeTimeHint(fd *UIFieldDef, v string) string {
+	if !fd.RelativeTime {
+		return ""
+	}
+	if fd.IsExpiry {
+		return fmt.Sprintf(" <RelativeTime value={%s} expiry />", v)
+	}
+	return fmt.Sprintf(" <RelativeTime value={%s} />", v)
+}
+
+// entityRoute returns the hash route of an entity's pages, e.g. "/properties",
+// or "" when the entity has no API.
+func entityRoute(entity string) string {
+	return strings.TrimPrefix(entityBasePaths[entity], "/v1")
+}
+
+// computeDetailImports returns the enum label constants the detail grid's
+// enum badges need, and the RelativeTime component when a date in the grid
+// carries a relative hint.
+func computeDetailImports(schema UISchema) []importDef {
+	data := templateData{UISchema: schema}
+	seen := make(map[string]bool)
+	var labels []string
+	relative := false
+	for _, sec := range schema.Detail.Sections {
+		if sec.EmbeddedObject != "" {
+			continue
+		}
+		for _, name := range sec.Fields {
+			fd := data.field(name)
+			if fd != nil && fd.RelativeTime && (fd.Type == "date" || fd.Type == "datetime") {
+				relative = true
+			}
+			if fd == nil || fd.Type != "enum" || fd.EnumRef == "" || (name == "status" && schema.Status != nil) {
+				continue
+			}
+			c := toScreamingSnake(fd.EnumRef) + "_LABELS"
+			if !seen[c] {
+				seen[c] = true
+				labels = append(labels, c)
+			}
+		}
+	}
+	var imports []importDef
+	if len(labels) > 0 {
+		sort.Strings(labels)
+		imports = append(imports, importDef{Name: "{ " + strings.Join(labels, ", ") + " }", Path: "../../../types/enums"})
+	}
+	if relative {
+		imports = append(imports, importDef{Name: "RelativeTime", Path: "../../shared/RelativeTime.svelte"})
+	}
+	return imports
+}
+
+// embeddedObjectField returns the embedded_object field holding a value of the
+// given type, or nil if there is none or the type has no section component.
+func embeddedObjectField(fields []UIFieldDef, typeName string) *UIFieldDef {
+	if typeName == "" || !embeddedSections[typeName] {
+		return nil
+	}
+	for i := range fields {
+		if fields[i].Type == "embedded_object" && fields[i].ObjectRef == typeName {
+			return &fields[i]
+		}
+	}
+	return nil
+}
+
+// embeddedSectionRender renders the form body of an embedded_object section:
+// the type's section component bound to its field, or a named slot when no
+// component or field exists.
+func embeddedSectionRender(data templateData, sec UIFormSection) string {
+	fd := embeddedObjectField(data.Fields, sec.EmbeddedObject)
+	if fd == nil {
+		return fmt.Sprintf(`<!-- Embedded: %s -->
+    <slot name="%s" />`, sec.EmbeddedObject, sec.ID)
+	}
+	return fmt.Sprintf(`<%sSection prefix="%s" value={values.%s ?? {}} {errors} on:change={(e) => handleChange('%s', e.detail)} on:focusout={(e) => handleBlur(e, '%s')} />`,
+		sec.EmbeddedObject, fd.Name, fd.Name, fd.Name, fd.Name)
+}
+
+// lockInEditMode wraps a rendered FormField so its controls are disabled when
+// the shared form is used for updates (mode === 'edit'). A disabled fieldset
+// also reaches the native inputs inside shared components like MoneyInput.
+func lockInEditMode(field string) string {
+	if !strings.HasPrefix(field, "    <FormField ") {
+		return field
+	}
+	lines := strings.Split(field, "\n")
+	lines[0] = strings.Replace(lines[0], " error={", " helpText={mode === 'edit' ? 'Locked: set at creation and cannot be changed.' : undefined} error={", 1)
+	inner := lines[1 : len(lines)-1]
+	for i := range inner {
+		inner[i] = "  " + inner[i]
 	}
+	wrapped := []string{lines[0], `      <fieldset class="contents" disabled={mode === 'edit'}>`}
+	wrapped = append(wrapped, inner...)
+	wrapped = append(wrapped, "      </fieldset>", lines[len(lines)-1])
+	return strings.Join(wrapped, "\n")
+}
 
+// fieldControlRender renders the FormField markup for a single field by type.
+func fieldControlRender(fd *UIFieldDef, req string) string {
 	switch fd.Type {
 	case "string":
 		return fmt.Sprintf(`    <FormField label="%s"%s error={errors['%s']}>
       <input type="text" class="input" value={values.%s ?? ''} on:input={(e) => handleChange('%s', inputValue(e))} />
     </FormField>`, fd.Label, req, fd.Name, fd.Name, fd.Name)
+	case "email", "phone", "url":
+		in := specialInputs[fd.Type]
+		return fmt.Sprintf(`    <FormField label="%s"%s error={errors['%s']}>
+      <input type="%s" autocomplete="%s" class="input" value={values.%s ?? ''} on:input={(e) => handleChange('%s', inputValue(e))} />
+    </FormField>`, fd.Label, req, fd.Name, in.inputType, in.autocomplete, fd.Name, fd.Name)
 	case "text":
 		return fmt.Sprintf(`    <FormField label="%s"%s error={errors['%s']}>
       <textarea class="textarea" value={values.%s ?? ''} on:input={(e) => handleChange('%s', textareaValue(e))} />
@@ -485,6 +995,20 @@ func formFieldRender(data any, fieldName string) string {
 		return fmt.Sprintf(`    <FormField label="%s"%s error={errors['%s']}>
       <input type="number" step="any" class="input" value={values.%s ?? ''} on:input={(e) => handleChange('%s', parseFloat(inputValue(e)))} />
     </FormField>`, fd.Label, req, fd.Name, fd.Name, fd.Name)
+	case "percent":
+		return fmt.Sprintf(`    <FormField label="%s"%s error={errors['%s']}>
+      <div class="input-group input-group-divider grid-cols-[1fr_auto]">
+        <input type="number" step="any" value={values.%s ?? ''} on:input={(e) => handleChange('%s', parseFloat(inputValue(e)))} />
+        <div class="input-group-shim">%%</div>
+      </div>
+    </FormField>`, fd.Label, req, fd.Name, fd.Name, fd.Name)
+	case "duration":
+		return fmt.Sprintf(`    <FormField label="%s"%s error={errors['%s']}>
+      <div class="input-group input-group-divider grid-cols-[1fr_auto]">
+        <input type="number" step="1" value={values.%s ?? ''} on:input={(e) => handleChange('%s', parseInt(inputValue(e)))} />
+        <div class="input-group-shim">%s</div>
+      </div>
+    </FormField>`, fd.Label, req, fd.Name, fd.Name, fd.Name, fd.DurationUnit)
 	case "bool":
 		return fmt.Sprintf(`    <FormField label="%s" error={errors['%s']}>
       <input type="checkbox" class="checkbox" checked={values.%s ?? false} on:change={(e) => handleChange('%s', inputChecked(e))} />
@@ -500,18 +1024,29 @@ func formFieldRender(data any, fieldName string) string {
 	case "enum":
 		optConst := toScreamingSnake(fd.EnumRef) + "_OPTIONS"
 		return fmt.Sprintf(`    <FormField label="%s"%s error={errors['%s']}>
-      <EnumSelect options={%s} value={values.%s} on:change={(e) => handleChange('%s', e.detail)} />
+      <EnumSelect groups={%s} value={values.%s} on:change={(e) => handleChange('%s', e.detail)} />
     </FormField>`, fd.Label, req, fd.Name, optConst, fd.Name, fd.Name)
 	case "money":
 		return fmt.Sprintf(`    <FormField label="%s"%s error={errors['%s']}>
-      <MoneyInput value={values.%s} on:change={(e) => handleChange('%s', e.detail)} />
-    </FormField>`, fd.Label, req, fd.Name, fd.Name, fd.Name)
+      <MoneyInput value={values.%s}%s on:change={(e) => handleChange('%s', e.detail)} />
+    </FormField>`, fd.Label, req, fd.Name, fd.Name, currenciesAttr(fd.Currencies), fd.Name)
 	case "entity_ref":
 		bp := entityBasePaths[fd.RefEntity]
 		df := fd.RefDisplay
 		if df == "" {
 			df = "name"
 		}
+		if fd.RefFilter != "" {
+			// Scope options by the dependent field. EntityRefSelect loads on
+			// mount, so re-key it when the dependent value changes; while the
+			// dependent field is empty the options are left unscoped.
+			dep := fd.RefFilter
+			return fmt.Sprintf(`    <FormField label="%s"%s error={errors['%s']}>
+      {#key values.%s}
+        <EntityRefSelect entityType="%s" basePath="%s" displayField="%s" filter={values.%s ? { %s: values.%s } : {}} value={values.%s} on:change={(e) => handleChange('%s', e.detail)} />
+      {/key}
+    </FormField>`, fd.Label, req, fd.Name, dep, fd.RefEntity, bp, df, dep, dep, dep, fd.Name, fd.Name)
+		}
 		return fmt.Sprintf(`    <FormField label="%s"%s error={errors['%s']}>
       <EntityRefSelect entityType="%s" basePath="%s" displayField="%s" value={values.%s} on:change={(e) => handleChange('%s', e.detail)} />
     </FormField>`, fd.Label, req, fd.Name, fd.RefEntity, bp, df, fd.Name, fd.Name)
@@ -541,6 +1076,79 @@ func formFieldRender(data any, fieldName string) string {
 	}
 }
 
+// transitionTargets returns the distinct target statuses reachable from status,
+// in declaration order.
+func transitionTargets(sm *UIStateMachine, status string) []string {
+	if sm == nil {
+		return nil
+	}
+	var targets []string
+	seen := map[string]bool{}
+	for _, t := range sm.Transitions[status] {
+		if !seen[t.Target] {
+			seen[t.Target] = true
+			targets = append(targets, t.Target)
+		}
+	}
+	return targets
+}
+
+// computeFormSteps resolves the form's wizard steps to the fields each step
+// validates: the section fields plus the fields behind embedded sections.
+func computeFormSteps(schema UISchema) []formStep {
+	sections := make(map[string]UIFormSection, len(schema.Form.Sections))
+	for _, sec := range schema.Form.Sections {
+		sections[sec.ID] = sec
+	}
+	embedded := func(kind, typeName string) string {
+		for _, f := range schema.Fields {
+			if f.Type == kind && f.ObjectRef == typeName {
+				return f.Name
+			}
+		}
+		return ""
+	}
+	var steps []formStep
+	for _, s := range schema.Form.Steps {
+		step := formStep{Title: s.Title, Sections: s.Sections}
+		for _, id := range s.Sections {
+			sec, ok := sections[id]
+			if !ok {
+				continue
+			}
+			step.Fields = append(step.Fields, sec.Fields...)
+			if name := embedded("embedded_object", sec.EmbeddedObject); name != "" {
+				step.Fields = append(step.Fields, name)
+			}
+			if name := embedded("embedded_array", sec.EmbeddedArray); name != "" {
+				step.Fields = append(step.Fields, name)
+			}
+		}
+		steps = append(steps, step)
+	}
+	return steps
+}
+
+// immutableFormFields lists, in form order, the form fields locked in edit
+// mode: those marked immutable or readonly after create.
+func immutableFormFields(schema UISchema) []string {
+	immutable := map[string]bool{}
+	for _, f := range schema.Fields {
+		if f.Immutable || f.ReadonlyAfterCreate {
+			immutable[f.Name] = true
+		}
+	}
+	var names []string
+	for _, sec := range schema.Form.Sections {
+		for _, name := range sec.Fields {
+			if immutable[name] {
+				names = append(names, name)
+			}
+		}
+	}
+	return names
+}
+
 // computeFormImports scans form sections to determine which shared components
 // and enum option constants the form template needs to import.
 func computeFormImports(schema UISchema) []importDef {
@@ -592,6 +1200,18 @@ func computeFormImports(schema UISchema) []importDef {
 		})
 	}
 
+	// Section components for embedded_object form sections
+	var sectionTypes []string
+	for _, sec := range schema.Form.Sections {
+		if embeddedObjectField(schema.Fields, sec.EmbeddedObject) != nil {
+			sectionTypes = append(sectionTypes, sec.EmbeddedObject)
+		}
+	}
+	sort.Strings(sectionTypes)
+	for _, t := range dedupStrings(sectionTypes) {
+		imports = append(imports, importDef{Name: t + "Section", Path: "../../sections/" + t + "Section.svelte"})
+	}
+
 	// Sorted component imports for deterministic output
 	componentPaths := map[string]string{
 		"AddressForm":        "../../shared/AddressForm.svelte",
@@ -627,7 +1247,7 @@ func requiredCheck(rule UIFieldRule, data templateData) string {
 		}
 	}
 	switch fieldType {
-	case "string", "text", "date", "datetime":
+	case "string", "text", "date", "datetime", "email", "phone", "url":
 		return fmt.Sprintf(`  if (input.%s == null || input.%s === '') {
     errors['%s'] = '%s is required';
   }`, accessor, accessor, rule.Field, escapeJS(label))
@@ -638,6 +1258,34 @@ func requiredCheck(rule UIFieldRule, data templateData) string {
 	}
 }
 
+// specialInputs holds the HTML input settings and validation of the email,
+// phone and url field types. The regexes are deliberately loose: they catch
+// typos, and the server stays the authority on what it accepts.
+var specialInputs = map[string]struct {
+	inputType    string
+	autocomplete string
+	noun         string
+	regex        string
+}{
+	"email": {"email", "email", "email address", `/^[^\s@]+@[^\s@]+\.[^\s@]+$/`},
+	"phone": {"tel", "tel", "phone number", `/^\+?[0-9 ().-]{7,}$/`},
+	"url":   {"url", "url", "URL", `/^https?:\/\/\S+$/`},
+}
+
+// formatCheck generates the validation for a "format" rule, whose value names
+// one of the specialInputs types. Empty values are left to the required check.
+func formatCheck(rule UIFieldRule) string {
+	kind, _ := rule.Value.(string)
+	in, ok := specialInputs[kind]
+	if !ok {
+		return ""
+	}
+	accessor := dotToOptional(rule.Field)
+	return fmt.Sprintf(`  if (input.%s && !%s.test(input.%s)) {
+    errors['%s'] = '%s must be a valid %s';
+  }`, accessor, in.regex, accessor, rule.Field, escapeJS(fieldLabel(rule.Field)), in.noun)
+}
+
 func crossFieldCheck(rule UICrossFieldRule) string {
 	if rule.Condition == nil {
 		return ""
@@ -685,18 +1333,42 @@ var sharedComponents = map[string]string{
   export let readonly = false;
   const dispatch = createEventDispatcher();
   let displayValue = value ? (value.amount_cents / 100).toFixed(2) : '';
-  let currency = value?.currency ?? 'USD';
-  function handleBlur() {
+  let currency = value?.currency ?? currencies[0] ?? 'USD';
+  $: symbol = currencySymbol(currency);
+  function currencySymbol(code: string): string {
+    try {
+      const parts = new Intl.NumberFormat('en-US', { style: 'currency', currency: code }).formatToParts(0);
+      return parts.find((p) => p.type === 'currency')?.value ?? code;
+    } catch {
+      return code;
+    }
+  }
+  function emit() {
     const parsed = parseFloat(displayValue);
     if (isNaN(parsed)) return;
     if (min !== null && parsed * 100 < min) return;
     dispatch('change', { amount_cents: Math.round(parsed * 100), currency });
   }
+  function handleBlur() {
+    emit();
+  }
+  function handleCurrency(e: Event) {
+    currency = (e.target as HTMLSelectElement).value;
+    emit();
+  }
 </script>
 <div class="input-group input-group-divider grid-cols-[auto_1fr_auto]">
-  <div class="input-group-shim">$</div>
+  <div class="input-group-shim">{symbol}</div>
   <input type="text" inputmode="decimal" bind:value={displayValue} on:blur={handleBlur} disabled={disabled || readonly} class="input" placeholder="0.00" />
-  <div class="input-group-shim">{currency}</div>
+  {#if currencies.length > 1}
+    <select class="select" aria-label="Currency" value={currency} on:change={handleCurrency} disabled={disabled || readonly}>
+      {#each currencies as code}
+        <option value={code}>{code}</option>
+      {/each}
+    </select>
+  {:else}
+    <div class="input-group-shim">{currency}</div>
+  {/if}
 </div>`,
 
 	"MoneyDisplay.svelte": `<!-- GENERATED FROM PROPELLER ONTOLOGY. DO NOT HAND-EDIT. -->
@@ -779,6 +1451,80 @@ var sharedComponents = map[string]string{
   <span class="text-surface-400">—</span>
 {/if}`,
 
+	"MaskedValue.svelte": `<!-- GENERATED FROM PROPELLER ONTOLOGY. DO NOT HAND-EDIT. -->
+<script lang="ts">
+  // Sensitive values stay hidden until the user asks to see them, and hide
+  // again on a second click.
+  export let value: unknown = null;
+  let revealed = false;
+</script>
+{#if value === null || value === undefined || value === ''}
+  <span class="text-surface-400">—</span>
+{:else}
+  <button type="button" class="font-mono text-left" aria-pressed={revealed} title={revealed ? 'Click to hide' : 'Click to reveal'} on:click={() => (revealed = !revealed)}>
+    {revealed ? value : '••••••••'}
+  </button>
+{/if}`,
+
+	"DateDisplay.svelte": `<!-- GENERATED FROM PROPELLER ONTOLOGY. DO NOT HAND-EDIT. -->
+<script lang="ts">
+  export let value: string | null = null;
+  // Dates are stored as midnight UTC; format in UTC so they don't shift a day.
+</script>
+{#if value}
+  <span>{new Date(value).toLocaleDateString(undefined, { timeZone: 'UTC' })}</span>
+{:else}
+  <span class="text-surface-400">—</span>
+{/if}`,
+
+	"RelativeTime.svelte": `<!-- GENERATED FROM PROPELLER ONTOLOGY. DO NOT HAND-EDIT. -->
+<script lang="ts">
+  // A hint such as "in 12 days" or "3 days ago" shown beside a date. Expiry
+  // dates read "expires in …" and turn amber within 30 days, red once past.
+  export let value: string | null = null;
+  export let expiry = false;
+  const WARN_WITHIN_DAYS = 30;
+  const DAY_MS = 86400000;
+
+  // Count whole calendar days in UTC, matching how DateDisplay formats dates.
+  function daysFromToday(v: string): number {
+    const d = new Date(v);
+    const now = new Date();
+    const target = Date.UTC(d.getUTCFullYear(), d.getUTCMonth(), d.getUTCDate());
+    const today = Date.UTC(now.getUTCFullYear(), now.getUTCMonth(), now.getUTCDate());
+    return Math.round((target - today) / DAY_MS);
+  }
+  function plural(n: number): string {
+    return n === 1 ? '1 day' : n + ' days';
+  }
+  function describe(days: number): string {
+    if (days === 0) return expiry ? 'expires today' : 'today';
+    if (days > 0) return (expiry ? 'expires in ' : 'in ') + plural(days);
+    return (expiry ? 'expired ' : '') + plural(-days) + ' ago';
+  }
+  function tone(days: number): string {
+    if (!expiry) return 'text-surface-500';
+    if (days < 0) return 'text-error-500';
+    if (days <= WARN_WITHIN_DAYS) return 'text-warning-500';
+    return 'text-surface-500';
+  }
+
+  $: days = value && !isNaN(Date.parse(value)) ? daysFromToday(value) : null;
+</script>
+{#if days !== null}
+  <span class="text-sm {tone(days)}">({describe(days)})</span>
+{/if}`,
+
+	"DateTimeDisplay.svelte": `<!-- GENERATED FROM PROPELLER ONTOLOGY. DO NOT HAND-EDIT. -->
+<script lang="ts">
+  export let value: string | null = null;
+</script>
+{#if value}
+  <span>{new Date(value).toLocaleString()}</span>
+{:else}
+  <span class="text-surface-400">—</span>
+{/if}`,
+
 	"ContactMethodInput.svelte": `<!-- GENERATED FROM PROPELLER ONTOLOGY. DO NOT HAND-EDIT. -->
 <script lang="ts">
   import { createEventDispatcher } from 'svelte';
@@ -814,7 +1560,7 @@ var sharedComponents = map[string]string{
 	"EntityRefSelect.svelte": `<!-- GENERATED FROM PROPELLER ONTOLOGY. DO NOT HAND-EDIT. -->
 <script lang="ts">
   import { createEventDispatcher, onMount } from 'svelte';
-  import { apiClient } from '../../api/client';
+  import { fetchRefItems } from '../../stores/refOptions';
   export let entityType: string;
   export let basePath: string = '';
   export let displayField: string = 'name';
@@ -822,15 +1568,17 @@ var sharedComponents = map[string]string{
   export let multiple = false;
   export let value: string | string[] | null = null;
   const dispatch = createEventDispatcher();
+  // Unique per instance so aria-controls/aria-activedescendant resolve.
+  const listId = ` + "`" + `ref-${entityType}-${Math.random().toString(36).slice(2, 8)}` + "`" + `;
   let allItems: Array<{ label: string; value: string }> = [];
   let options: Array<{ label: string; value: string }> = [];
   let showDropdown = false;
   let inputValue = '';
+  let activeIndex = -1;
   onMount(async () => {
     if (!basePath) return;
     try {
-      const response = await apiClient.get<any>(basePath, { ...filter, limit: 100 });
-      const items = Array.isArray(response) ? response : (response.data ?? []);
+      const items = await fetchRefItems(basePath, filter);
       allItems = items.map((item: any) => ({ label: item[displayField] ?? item.id, value: item.id }));
       // If we have a current value, show its label
       if (value) {
@@ -841,59 +1589,132 @@ var sharedComponents = map[string]string{
       allItems = [];
     }
   });
+  function open(list: Array<{ label: string; value: string }>) {
+    options = list;
+    showDropdown = true;
+    activeIndex = -1;
+  }
+  function close() {
+    showDropdown = false;
+    activeIndex = -1;
+  }
   function handleInput(event: Event) {
     const query = (event.target as HTMLInputElement).value;
     inputValue = query;
-    showDropdown = true;
     if (basePath) {
       const lower = query.toLowerCase();
-      options = lower ? allItems.filter(i => i.label.toLowerCase().includes(lower)) : allItems;
+      open(lower ? allItems.filter(i => i.label.toLowerCase().includes(lower)) : allItems);
     } else {
+      showDropdown = true;
       dispatch('change', query);
     }
   }
   function handleFocus() {
-    showDropdown = true;
-    options = allItems;
+    open(allItems);
+  }
+  function handleKeydown(event: KeyboardEvent) {
+    switch (event.key) {
+      case 'ArrowDown':
+        event.preventDefault();
+        if (!showDropdown) open(allItems);
+        if (options.length > 0) activeIndex = (activeIndex + 1) % options.length;
+        break;
+      case 'ArrowUp':
+        event.preventDefault();
+        if (!showDropdown) open(allItems);
+        if (options.length > 0) activeIndex = activeIndex <= 0 ? options.length - 1 : activeIndex - 1;
+        break;
+      case 'Enter':
+        if (showDropdown && activeIndex >= 0 && activeIndex < options.length) {
+          event.preventDefault();
+          select(options[activeIndex]);
+        }
+        break;
+      case 'Escape':
+        if (showDropdown) {
+          event.preventDefault();
+          close();
+        }
+        break;
+    }
   }
   function select(item: { label: string; value: string }) {
     inputValue = item.label;
-    showDropdown = false;
+    close();
     options = [];
     dispatch('change', item.value);
   }
+  function optionId(i: number): string {
+    return ` + "`" + `${listId}-${i}` + "`" + `;
+  }
 </script>
 <div class="relative">
-  <input type="text" class="input" placeholder="Search {entityType}..." value={inputValue} on:input={handleInput} on:focus={handleFocus} on:blur={() => setTimeout(() => showDropdown = false, 200)} />
+  <input
+    type="text"
+    class="input"
+    role="combobox"
+    aria-autocomplete="list"
+    aria-expanded={showDropdown && options.length > 0}
+    aria-controls={listId}
+    aria-activedescendant={activeIndex >= 0 ? optionId(activeIndex) : undefined}
+    placeholder="Search {entityType}..."
+    value={inputValue}
+    on:input={handleInput}
+    on:focus={handleFocus}
+    on:keydown={handleKeydown}
+    on:blur={() => setTimeout(close, 200)}
+  />
   {#if showDropdown && options.length > 0}
-    <ul class="card list p-1 mt-1 max-h-40 overflow-y-auto absolute z-10 w-full shadow-lg">
-      {#each options as opt}
-        <li>
-          <button type="button" class="btn btn-sm w-full text-left hover:variant-soft" on:click={() => select(opt)}>
-            {opt.label}
-          </button>
+    <ul id={listId} role="listbox" aria-multiselectable={multiple} class="card list p-1 mt-1 max-h-40 overflow-y-auto absolute z-10 w-full shadow-lg">
+      {#each options as opt, i}
+        <li
+          id={optionId(i)}
+          role="option"
+          aria-selected={i === activeIndex}
+          class="btn btn-sm w-full text-left hover:variant-soft"
+          class:variant-soft={i === activeIndex}
+          on:mousedown|preventDefault={() => select(opt)}
+          on:mouseenter={() => (activeIndex = i)}
+        >
+          {opt.label}
         </li>
       {/each}
     </ul>
   {/if}
   {#if showDropdown && options.length === 0 && inputValue}
-    <div class="card p-2 mt-1 text-sm text-surface-500 absolute z-10 w-full">No {entityType} found</div>
+    <div class="card p-2 mt-1 text-sm text-surface-500 absolute z-10 w-full" role="status">No {entityType} found</div>
   {/if}
 </div>`,
 
 	"EnumSelect.svelte": `<!-- GENERATED FROM PROPELLER ONTOLOGY. DO NOT HAND-EDIT. -->
 <script lang="ts">
   import { createEventDispatcher } from 'svelte';
-  export let options: Array<{ value: string; label: string }> = [];
+  type Option = { value: string; label: string; deprecated?: boolean };
+  // Option groups as emitted in types/enums.ts; unlabelled groups render flat.
+  export let groups: Array<{ label?: string; options: Option[] }> = [];
   export let value: string | null = null;
   export let disabled = false;
   const dispatch = createEventDispatcher();
   function sv(e: Event): string { return (e.target as HTMLSelectElement).value; }
+  // Deprecated values stay selectable only on records that already hold them.
+  $: visible = groups
+    .map((g) => ({ ...g, options: g.options.filter((o) => !o.deprecated || o.value === value) }))
+    .filter((g) => g.options.length > 0);
 </script>
 <select class="select" {disabled} value={value ?? ''} on:change={(e) => dispatch('change', sv(e))}>
   <option value="">Select...</option>
-  {#each options as opt}
-    <option value={opt.value}>{opt.label}</option>
+  {#each visible as group}
+    {#if group.label}
+      <optgroup label={group.label}>
+        {#each group.options as opt}
+          <option value={opt.value}>{opt.label}{opt.deprecated ? ' (deprecated)' : ''}</option>
+        {/each}
+      </optgroup>
+    {:else}
+      {#each group.options as opt}
+        <option value={opt.value}>{opt.label}{opt.deprecated ? ' (deprecated)' : ''}</option>
+      {/each}
+    {/if}
   {/each}
 </select>`,
 
@@ -911,7 +1732,7 @@ var sharedComponents = map[string]string{
   export let error: string | undefined = undefined;
   export let helpText: string | undefined = undefined;
 </script>
-<label class="label">
+<label class="label" on:focusout>
   <span class="text-sm font-medium">
     {label}{#if required}<span class="text-error-500 ml-0.5">*</span>{/if}
   </span>
@@ -980,10 +1801,19 @@ var sharedComponents = map[string]string{
 
 	"StatusBadge.svelte": `<!-- GENERATED FROM PROPELLER ONTOLOGY. DO NOT HAND-EDIT. -->
 <script lang="ts">
+  // colorMap is a schema's status.color_mapping, status → color name. Names
+  // resolve to light/dark-aware badge classes; anything else is used as
+  // classes verbatim.
   export let status: string;
   export let colorMap: Record<string, string> = {};
+  const classes: Record<string, string> = {
+` + statusColorClassesTS() + `  };
+  function badgeClass(color: string | undefined): string {
+    if (!color) return classes.surface;
+    return classes[color] ?? color;
+  }
 </script>
-<span class="badge {colorMap[status] ?? 'variant-soft'}">
+<span class="badge {badgeClass(colorMap[status])}">
   {status.replace(/_/g, ' ').replace(/\b\w/g, (c) => c.toUpperCase())}
 </span>`,
 
@@ -997,6 +1827,41 @@ var sharedComponents = map[string]string{
 </script>
 <button type="button" class="btn {variantClass}" on:click={() => dispatch('click')}>{label}</button>`,
 
+	"Pagination.svelte": `<!-- GENERATED FROM PROPELLER ONTOLOGY. DO NOT HAND-EDIT. -->
+<script lang="ts">
+  import { createEventDispatcher } from 'svelte';
+  // Prev/next paging and a page-size select over entityListStore's pagination
+  // state. page is zero-based and size is the total row count; 'page' and
+  // 'amount' events carry the requested page and page size.
+  export let pagination: { page: number; limit: number; size: number; amounts: number[] };
+  const dispatch = createEventDispatcher();
+  $: pageCount = Math.max(1, Math.ceil(pagination.size / pagination.limit));
+  $: first = pagination.size === 0 ? 0 : pagination.page * pagination.limit + 1;
+  $: last = Math.min((pagination.page + 1) * pagination.limit, pagination.size);
+  function go(page: number) {
+    if (page >= 0 && page < pageCount && page !== pagination.page) dispatch('page', page);
+  }
+  function setAmount(e: Event) {
+    dispatch('amount', Number((e.target as HTMLSelectElement).value));
+  }
+</script>
+<nav class="flex items-center justify-between gap-4 p-2" aria-label="Pagination">
+  <label class="flex items-center gap-2 text-sm">
+    <span>Rows per page</span>
+    <select class="select w-auto" value={pagination.limit} on:change={setAmount}>
+      {#each pagination.amounts as amount}
+        <option value={amount}>{amount}</option>
+      {/each}
+    </select>
+  </label>
+  <span class="text-sm text-surface-500">{first}–{last} of {pagination.size}</span>
+  <div class="flex items-center gap-2">
+    <button type="button" class="btn btn-sm variant-soft" disabled={pagination.page === 0} on:click={() => go(pagination.page - 1)}>Previous</button>
+    <span class="text-sm">Page {pagination.page + 1} of {pageCount}</span>
+    <button type="button" class="btn btn-sm variant-soft" disabled={pagination.page >= pageCount - 1} on:click={() => go(pagination.page + 1)}>Next</button>
+  </div>
+</nav>`,
+
 	"ConfirmDialog.svelte": `<!-- GENERATED FROM PROPELLER ONTOLOGY. DO NOT HAND-EDIT. -->
 <script lang="ts">
   import { createEventDispatcher } from 'svelte';
@@ -1056,10 +1921,15 @@ interface ListConfig {
   basePath: string;
   defaultSort: { field: string; direction: string };
   pageSize?: number;
+  // 'offset' (default) pages with offset/limit. 'cursor' follows the
+  // response's next_cursor and accumulates rows via loadMore(), which stays
+  // fast on large lists where deep offsets are slow.
+  mode?: 'offset' | 'cursor';
 }
 
 export function entityListStore<T>(config: ListConfig) {
   const pageSize = config.pageSize ?? 25;
+  const cursorMode = config.mode === 'cursor';
   const state = writable<{
     data: T[];
     total: number;
@@ -1069,6 +1939,8 @@ export function entityListStore<T>(config: ListConfig) {
     sort: { field: string; direction: string };
     filters: Record<string, any>;
     pagination: { page: number; limit: number; size: number; amounts: number[] };
+    nextCursor: string | null;
+    hasMore: boolean;
   }>({
     data: [],
     total: 0,
@@ -1078,39 +1950,79 @@ export function entityListStore<T>(config: ListConfig) {
     sort: config.defaultSort,
     filters: {},
     pagination: { page: 0, limit: pageSize, size: 0, amounts: [10, 25, 50, 100] },
+    nextCursor: null,
+    hasMore: false,
   });
 
-  async function fetch() {
+  // In-flight list request; each fetch aborts the previous one so a stale
+  // response can never overwrite a fresher one.
+  let controller: AbortController | null = null;
+
+  // fetch loads the current page. In cursor mode, append continues from the
+  // last next_cursor and adds to data instead of replacing it.
+  async function fetch(append = false) {
+    controller?.abort();
+    const ctrl = new AbortController();
+    controller = ctrl;
     state.update(s => ({ ...s, loading: true }));
     try {
-      let s: any;
-      state.subscribe(v => s = v)();
+      const s = snapshot();
+      const limit = s.pagination.limit;
       const params: Record<string, any> = {
-        offset: s.page * pageSize,
-        limit: pageSize,
+        limit,
         sort: s.sort.field,
         order: s.sort.direction,
         ...s.filters,
       };
-      const result = await apiClient.get<T[] | { data: T[]; total: number }>(config.basePath, params);
-      // Handle both plain array and { data, total } response formats
+      if (!cursorMode) {
+        params.offset = s.page * limit;
+      } else if (append && s.nextCursor) {
+        params.cursor = s.nextCursor;
+      }
+      const result = await apiClient.get<T[] | { data: T[]; total?: number; next_cursor?: string }>(config.basePath, params, ctrl.signal);
+      if (controller !== ctrl) return; // superseded while the response was in flight
+      // Handle both plain array and { data, total, next_cursor } response formats
       const items = Array.isArray(result) ? result : (result.data ?? []);
-      const total = Array.isArray(result) ? items.length : (result.total ?? items.length);
-      state.update(st => ({
-        ...st,
-        data: items,
-        total,
-        loading: false,
-        error: null,
-        pagination: { ...st.pagination, size: total },
-      }));
+      const nextCursor = Array.isArray(result) ? null : (result.next_cursor ?? null);
+      state.update(st => {
+        const data = append ? [...st.data, ...items] : items;
+        const total = Array.isArray(result) ? data.length : (result.total ?? data.length);
+        return {
+          ...st,
+          data,
+          total,
+          loading: false,
+          error: null,
+          pagination: { ...st.pagination, size: total },
+          nextCursor,
+          hasMore: nextCursor != null,
+        };
+      });
     } catch (error) {
+      // Superseded by a newer fetch, which owns the loading state now.
+      if ((error as Error).name === 'AbortError') return;
       state.update(s => ({ ...s, loading: false, error: error as Error }));
+    } finally {
+      if (controller === ctrl) controller = null;
     }
   }
 
+  // loadMore fetches the next cursor page and appends it (cursor mode only).
+  function loadMore() {
+    const s = snapshot();
+    if (!cursorMode || s.loading || !s.hasMore) return;
+    fetch(true);
+  }
+
   function setPage(page: number) {
-    state.update(s => ({ ...s, page }));
+    state.update(s => ({ ...s, page, pagination: { ...s.pagination, page } }));
+    fetch();
+  }
+
+  // setPageSize changes the page size and returns to the first page, since
+  // the current offset means little under a different limit.
+  function setPageSize(limit: number) {
+    state.update(s => ({ ...s, page: 0, pagination: { ...s.pagination, page: 0, limit } }));
     fetch();
   }
 
@@ -1125,18 +2037,87 @@ export function entityListStore<T>(config: ListConfig) {
   }
 
   function setFilters(filters: Record<string, any>) {
-    state.update(s => ({ ...s, filters, page: 0 }));
+    state.update(s => ({ ...s, filters, page: 0, pagination: { ...s.pagination, page: 0 } }));
     fetch();
   }
 
+  function snapshot() {
+    let s: any;
+    state.subscribe(v => s = v)();
+    return s;
+  }
+
+  // Position of item in the current page under the active sort, or -1 when the
+  // server would order it differently than we can (non-scalar sort values) or
+  // it belongs on another page.
+  function sortedIndex(data: T[], item: T, sort: { field: string; direction: string }, full: boolean): number {
+    const key = (x: any) => x?.[sort.field];
+    const v = key(item);
+    if (v != null && typeof v === 'object') return -1;
+    const dir = sort.direction === 'desc' ? -1 : 1;
+    const cmp = (a: any, b: any) => {
+      if (a == null) return b == null ? 0 : 1;
+      if (b == null) return -1;
+      return (typeof a === 'string' ? a.localeCompare(b) : (a < b ? -1 : a > b ? 1 : 0)) * dir;
+    };
+    const idx = data.findIndex(x => cmp(v, key(x)) < 0);
+    if (idx === -1) return full ? -1 : data.length;
+    return idx;
+  }
+
+  // insert adds a newly created item without a round trip. It falls back to a
+  // refetch when filters are active or the item sorts outside the current page.
+  function insert(item: T) {
+    const s = snapshot();
+    if (Object.keys(s.filters).length > 0) {
+      fetch();
+      return;
+    }
+    const full = cursorMode ? s.hasMore : s.data.length >= s.pagination.limit;
+    const idx = s.page === 0 ? sortedIndex(s.data, item, s.sort, full) : -1;
+    if (idx === -1) {
+      fetch();
+      return;
+    }
+    const inserted = [...s.data.slice(0, idx), item, ...s.data.slice(idx)];
+    const data = cursorMode ? inserted : inserted.slice(0, s.pagination.limit);
+    const total = s.total + 1;
+    state.set({ ...s, data, total, pagination: { ...s.pagination, size: total } });
+  }
+
+  // upsert replaces an item already on the page in place, or inserts it. A
+  // change to the sort field refetches since the item may need to move.
+  function upsert(item: T) {
+    const s = snapshot();
+    const id = (item as any).id;
+    const idx = s.data.findIndex((x: any) => x.id === id);
+    if (idx === -1) {
+      insert(item);
+      return;
+    }
+    if ((s.data[idx] as any)[s.sort.field] !== (item as any)[s.sort.field]) {
+      fetch();
+      return;
+    }
+    const data = [...s.data];
+    data[idx] = item;
+    state.set({ ...s, data });
+  }
+
   fetch();
-  return { subscribe: state.subscribe, set: state.set, update: state.update, setPage, toggleSort, setFilters, refetch: fetch };
+  return { subscribe: state.subscribe, set: state.set, update: state.update, setPage, setPageSize, toggleSort, setFilters, insert, upsert, loadMore, refetch: () => fetch() };
 }`,
 
 	"entityMutation.ts": `// GENERATED FROM PROPELLER ONTOLOGY. DO NOT HAND-EDIT.
 import { writable } from 'svelte/store';
 import { apiClient } from '../api/client';
 
+// Optional list store to patch after a successful mutation (see entityListStore).
+interface ListSink<T> {
+  insert(item: T): void;
+  upsert(item: T): void;
+}
+
 export function entityMutationStore<TCreate, TUpdate, TResult>(
   entityType: string,
   basePath: string,
@@ -1146,11 +2127,12 @@ export function entityMutationStore<TCreate, TUpdate, TResult>(
     error: Error | null;
   }>({ loading: false, error: null });
 
-  async function create(input: TCreate): Promise<TResult> {
+  async function create(input: TCreate, list?: ListSink<TResult>): Promise<TResult> {
     state.set({ loading: true, error: null });
     try {
       const result = await apiClient.post<TResult>(basePath, input);
       state.set({ loading: false, error: null });
+      list?.insert(result);
       return result;
     } catch (error) {
       state.set({ loading: false, error: error as Error });
@@ -1158,11 +2140,12 @@ export function entityMutationStore<TCreate, TUpdate, TResult>(
     }
   }
 
-  async function update(id: string, input: TUpdate): Promise<TResult> {
+  async function update(id: string, input: TUpdate, list?: ListSink<TResult>): Promise<TResult> {
     state.set({ loading: true, error: null });
     try {
       const result = await apiClient.patch<TResult>(` + "`" + `${basePath}/${id}` + "`" + `, input);
       state.set({ loading: false, error: null });
+      list?.upsert(result);
       return result;
     } catch (error) {
       state.set({ loading: false, error: error as Error });
@@ -1173,6 +2156,55 @@ export function entityMutationStore<TCreate, TUpdate, TResult>(
   return { subscribe: state.subscribe, create, update };
 }`,
 
+	"refOptions.ts": `// GENERATED FROM PROPELLER ONTOLOGY. DO NOT HAND-EDIT.
+import { apiClient, onMutation } from '../api/client';
+
+// Option rows for EntityRefSelect, shared across instances. A form with
+// several references to the same entity (e.g. a lease's property and spaces)
+// fetches each list once; concurrent mounts share the in-flight request.
+const TTL_MS = 30_000;
+const LIMIT = 100;
+
+const cache = new Map<string, { fetchedAt: number; items: Promise<any[]> }>();
+
+function cacheKey(basePath: string, filter: Record<string, any>): string {
+  const params = Object.keys(filter)
+    .sort()
+    .map((k) => ` + "`" + `${k}=${filter[k]}` + "`" + `)
+    .join('&');
+  return ` + "`" + `${basePath}?${params}` + "`" + `;
+}
+
+export function fetchRefItems(basePath: string, filter: Record<string, any> = {}): Promise<any[]> {
+  const key = cacheKey(basePath, filter);
+  const hit = cache.get(key);
+  if (hit && Date.now() - hit.fetchedAt < TTL_MS) return hit.items;
+
+  const items = apiClient
+    .get<any>(basePath, { ...filter, limit: LIMIT })
+    .then((response) => (Array.isArray(response) ? response : (response.data ?? [])));
+  // Failed fetches are not cached.
+  items.catch(() => cache.delete(key));
+  cache.set(key, { fetchedAt: Date.now(), items });
+  return items;
+}
+
+// invalidateRefOptions drops every cached list under basePath.
+export function invalidateRefOptions(basePath: string) {
+  for (const key of cache.keys()) {
+    if (key.startsWith(basePath + '?')) cache.delete(key);
+  }
+}
+
+// Any create, update, transition or delete under an entity's base path
+// invalidates that entity's cached options.
+onMutation((path) => {
+  for (const key of cache.keys()) {
+    const base = key.slice(0, key.indexOf('?'));
+    if (path === base || path.startsWith(base + '/')) cache.delete(key);
+  }
+});`,
+
 	"stateMachine.ts": `// GENERATED FROM PROPELLER ONTOLOGY. DO NOT HAND-EDIT.
 import { writable, derived } from 'svelte/store';
 import { apiClient } from '../api/client';
@@ -1209,7 +2241,7 @@ export function stateMachineStore(
 import { writable } from 'svelte/store';
 import { apiClient } from '../api/client';
 
-export function relatedStore<T>(entityType: string, entityId: string, relationship: string) {
+export function relatedStore<T>(path: string) {
   const { subscribe, set, update } = writable<{
     data: T[];
     loading: boolean;
@@ -1219,7 +2251,7 @@ export function relatedStore<T>(entityType: string, entityId: string, relationsh
   async function fetch() {
     update(s => ({ ...s, loading: true }));
     try {
-      const result = await apiClient.get<{ data: T[] }>(` + "`" + `/v1/${entityType}s/${entityId}/${relationship}` + "`" + `);
+      const result = await apiClient.get<{ data: T[] }>(path);
       set({ data: result.data ?? [], loading: false, error: null });
     } catch (error) {
       set({ data: [], loading: false, error: error as Error });
@@ -1270,27 +2302,14 @@ func main() {
 		log.Fatalf("loading enums: %v", err)
 	}
 
-	// Build template functions
-	funcMap := template.FuncMap{
-		"tsType":             tsType,
-		"toPascal":           toPascal,
-		"toCamel":            toCamel,
-		"toCamelHyphen":      toCamelHyphen,
-		"toScreamingSnake":   toScreamingSnake,
-		"fieldLabel":         fieldLabel,
-		"skeletonVariant":    skeletonVariant,
-		"replaceID":          replaceID,
-		"replaceIDTemplate":  replaceIDTemplate,
-		"escapeJS":           escapeJS,
-		"dotToOptional":      dotToOptional,
-		"derefBool":          derefBool,
-		"visibilityCheck":    visibilityCheck,
-		"formFieldRender":    formFieldRender,
-		"crossFieldCheck":    crossFieldCheck,
-		"commonTypeImports":  commonTypeImports,
-		"requiredCheck":      requiredCheck,
+	// Load embedded value types (rendered as form section components)
+	embeddedTypes, err := loadEmbeddedTypes(filepath.Join(schemaDir, "_types.schema.json"))
+	if err != nil {
+		log.Fatalf("loading embedded types: %v", err)
 	}
 
+	funcMap := templateFuncs()
+
 	// Parse templates
 	tmplTypes := mustParseTemplate("types.ts.tmpl", funcMap)
 	tmplAPI := mustParseTemplate("api.ts.tmpl", funcMap)
@@ -1301,6 +2320,8 @@ func main() {
 	tmplStatusBadge := mustParseTemplate("status_badge.svelte.tmpl", funcMap)
 	tmplActions := mustParseTemplate("actions.svelte.tmpl", funcMap)
 	tmplEnums := mustParseTemplate("enums.ts.tmpl", funcMap)
+	tmplIndex := mustParseTemplate("index.ts.tmpl", funcMap)
+	tmplSection := mustParseTemplate("section.svelte.tmpl", funcMap)
 
 	// Ensure output directories
 	dirs := []string{
@@ -1317,6 +2338,25 @@ func main() {
 
 	componentCount := 0
 
+	// Generate a section component per embedded value type. Forms render
+	// their embedded_object sections with these, so this runs first.
+	typeNames := make([]string, 0, len(embeddedTypes))
+	for name := range embeddedTypes {
+		typeNames = append(typeNames, name)
+	}
+	sort.Strings(typeNames)
+	for _, name := range typeNames {
+		et := embeddedTypes[name]
+		if len(et.Fields) == 0 {
+			continue
+		}
+		data := sectionTemplateData{TypeName: name, FieldPrefix: toSnake(name), Fields: et.Fields}
+		renderTemplate(tmplSection, data, filepath.Join(outDir, "components", "sections", name+"Section.svelte"))
+		embeddedSections[name] = true
+		componentCount++
+	}
+	fmt.Printf("Generated %d section components\n", len(embeddedSections))
+
 	// Generate per-entity files
 	for _, schema := range schemas {
 		pascal := toPascal(schema.Entity)
@@ -1332,7 +2372,9 @@ func main() {
 			HasStatus:       schema.Status != nil,
 			HasStateMachine: schema.StateMachine != nil,
 			RoutePath:       routePath,
+			EmbeddedTypes:   embeddedTypes,
 		}
+		_, data.HasDelete = schema.API.Operations["delete"]
 
 		if schema.Status != nil {
 			for _, f := range schema.Fields {
@@ -1348,6 +2390,9 @@ func main() {
 
 		// Compute imports needed for form based on field types used in form sections
 		data.Imports = computeFormImports(schema)
+		data.ImmutableFields = immutableFormFields(schema)
+		data.FormSteps = computeFormSteps(schema)
+		data.DetailImports = computeDetailImports(schema)
 
 		// Types
 		renderTemplate(tmplTypes, data, filepath.Join(outDir, "types", schema.Entity+".types.ts"))
@@ -1385,6 +2430,10 @@ func main() {
 			componentCount++
 		}
 
+		// Barrel index re-exporting the entity's components and modules
+		renderTemplate(tmplIndex, data, filepath.Join(outDir, "components", "entities", schema.Entity, "index.ts"))
+		componentCount++
+
 		fmt.Printf("Generated %s components\n", pascal)
 	}
 
@@ -1457,6 +2506,19 @@ func loadSchemas(dir string) ([]UISchema, error) {
 	return schemas, nil
 }
 
+func loadEmbeddedTypes(path string) (map[string]UIEmbeddedType, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var types map[string]UIEmbeddedType
+	if err := json.Unmarshal(data, &types); err != nil {
+		return nil, err
+	}
+	return types, nil
+}
+
 func loadEnums(path string) (map[string]UIEnum, error) {
 	data, err := os.ReadFile(path)
 	if err != nil {
@@ -1470,6 +2532,37 @@ func loadEnums(path string) (map[string]UIEnum, error) {
 	return enums, nil
 }
 
+// templateFuncs returns the helper functions available to every template.
+func templateFuncs() template.FuncMap {
+	return template.FuncMap{
+		"tsType":                tsType,
+		"toPascal":              toPascal,
+		"toCamel":               toCamel,
+		"toCamelHyphen":         toCamelHyphen,
+		"toScreamingSnake":      toScreamingSnake,
+		"fieldLabel":            fieldLabel,
+		"skeletonVariant":       skeletonVariant,
+		"replaceID":             replaceID,
+		"replaceIDTemplate":     replaceIDTemplate,
+		"escapeJS":              escapeJS,
+		"dotToOptional":         dotToOptional,
+		"derefBool":             derefBool,
+		"visibilityCheck":       visibilityCheck,
+		"visibilityExpr":        visibilityExpr,
+		"formFieldRender":       formFieldRender,
+		"detailFieldRender":     detailFieldRender,
+		"entityRoute":           entityRoute,
+		"embeddedSectionRender": embeddedSectionRender,
+		"currenciesAttr":        currenciesAttr,
+		"crossFieldCheck":       crossFieldCheck,
+		"commonTypeImports":     commonTypeImports,
+		"requiredCheck":         requiredCheck,
+		"formatCheck":           formatCheck,
+		"enumOptionGroups":      enumOptionGroups,
+		"transitionTargets":     transitionTargets,
+	}
+}
+
 func mustParseTemplate(name string, funcMap template.FuncMap) *template.Template {
 	data, err := templateFS.ReadFile("templates/" + name)
 	if err != nil {
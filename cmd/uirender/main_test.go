@@ -0,0 +1,636 @@
+package main
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestEscapeJS(t *testing.T) {
+	tests := []struct {
+		in, want string
+	}{
+		{"Owner's Unit", `Owner\'s Unit`},
+		{`C:\units`, `C:\\units`},
+		{`it\'s`, `it\\\'s`},
+		{"line\nbreak", `line\nbreak`},
+		{"plain", "plain"},
+	}
+	for _, tt := range tests {
+		if got := escapeJS(tt.in); got != tt.want {
+			t.Errorf("escapeJS(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestEnumsTemplate_EscapesLabelsAndValues(t *testing.T) {
+	tmpl := mustParseTemplate("enums.ts.tmpl", templateFuncs())
+	data := enumsTemplateData{Enums: map[string]UIEnum{
+		"SpaceKind": {
+			Values: []UIEnumValue{
+				{Value: "owner's_unit", Label: "Owner's Unit"},
+				{Value: "manager", Label: `Manager\Office`},
+			},
+			Groups: []UIEnumGroup{{Label: "Owner's", Values: []string{"owner's_unit"}}},
+		},
+	}}
+
+	var buf strings.Builder
+	if err := tmpl.Execute(&buf, data); err != nil {
+		t.Fatalf("Execute: %v", err)
+	}
+	out := buf.String()
+
+	for _, want := range []string{
+		`export type SpaceKind = 'owner\'s_unit' | 'manager';`,
+		`{ label: 'Owner\'s', options: [`,
+		`{ value: 'owner\'s_unit', label: 'Owner\'s Unit' },`,
+		`{ value: 'manager', label: 'Manager\\Office' },`,
+		`'owner\'s_unit': 'Owner\'s Unit',`,
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("enums.ts missing %q\n%s", want, out)
+		}
+	}
+	if strings.Contains(out, "'Owner's") {
+		t.Errorf("enums.ts contains an unescaped apostrophe:\n%s", out)
+	}
+}
+
+func TestFormFieldRender_ConditionallyRequired(t *testing.T) {
+	schema := UISchema{
+		Fields: []UIFieldDef{
+			{Name: "move_in_date", Type: "date", Label: "Move In Date", ConditionallyRequired: true},
+			{Name: "notes", Type: "text", Label: "Notes"},
+		},
+		Validation: UIValidation{CrossFieldRules: []UICrossFieldRule{{
+			Condition: &VisibilityRule{Field: "status", Operator: "in", Values: []string{"active"}},
+			Then:      UIFieldRule{Field: "move_in_date", Rule: "required"},
+			Message:   "Move In Date is required when Status is active",
+		}}},
+	}
+
+	out := formFieldRender(schema, "move_in_date")
+	want := `requiredIf="Move In Date is required when Status is active"`
+	if !strings.Contains(out, want) {
+		t.Errorf("conditionally required field missing %s:\n%s", want, out)
+	}
+	if strings.Contains(out, `"Move In Date" required error`) {
+		t.Errorf("conditionally required field rendered as always required:\n%s", out)
+	}
+	if out := formFieldRender(schema, "notes"); strings.Contains(out, "requiredIf") {
+		t.Errorf("optional field rendered requiredIf:\n%s", out)
+	}
+}
+
+func TestEnumsTemplate_MarksDeprecatedValues(t *testing.T) {
+	tmpl := mustParseTemplate("enums.ts.tmpl", templateFuncs())
+	data := enumsTemplateData{Enums: map[string]UIEnum{
+		"LeaseType": {Values: []UIEnumValue{
+			{Value: "fixed_term", Label: "Fixed Term"},
+			{Value: "membership", Label: "Membership", Deprecated: true},
+		}},
+	}}
+
+	var buf strings.Builder
+	if err := tmpl.Execute(&buf, data); err != nil {
+		t.Fatalf("Execute: %v", err)
+	}
+	out := buf.String()
+
+	if !strings.Contains(out, `{ value: 'membership', label: 'Membership', deprecated: true },`) {
+		t.Errorf("enums.ts does not flag the deprecated option:\n%s", out)
+	}
+	if !strings.Contains(out, `{ value: 'fixed_term', label: 'Fixed Term' },`) {
+		t.Errorf("enums.ts flags a current option:\n%s", out)
+	}
+}
+
+func TestEmbeddedSectionRender(t *testing.T) {
+	embeddedSections["CAMTerms"] = true
+	defer delete(embeddedSections, "CAMTerms")
+
+	data := templateData{UISchema: UISchema{Fields: []UIFieldDef{
+		{Name: "cam_terms", Type: "embedded_object", ObjectRef: "CAMTerms"},
+	}}}
+
+	out := embeddedSectionRender(data, UIFormSection{ID: "cam", EmbeddedObject: "CAMTerms"})
+	want := `<CAMTermsSection prefix="cam_terms" value={values.cam_terms ?? {}} {errors} on:change={(e) => handleChange('cam_terms', e.detail)} on:focusout={(e) => handleBlur(e, 'cam_terms')} />`
+	if out != want {
+		t.Errorf("embeddedSectionRender = %s\nwant %s", out, want)
+	}
+
+	// Types without a generated component fall back to a named slot.
+	out = embeddedSectionRender(data, UIFormSection{ID: "late_fee", EmbeddedObject: "LateFeePolicy"})
+	if !strings.Contains(out, `<slot name="late_fee" />`) {
+		t.Errorf("missing slot fallback:\n%s", out)
+	}
+}
+
+func TestToSnake(t *testing.T) {
+	for in, want := range map[string]string{
+		"CAMTerms":      "cam_terms",
+		"LateFeePolicy": "late_fee_policy",
+		"Address":       "address",
+	} {
+		if got := toSnake(in); got != want {
+			t.Errorf("toSnake(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestCurrenciesAttr(t *testing.T) {
+	for _, tc := range []struct {
+		in   []string
+		want string
+	}{
+		{nil, ""},
+		{[]string{"USD"}, ""},
+		{[]string{"CAD"}, " currencies={['CAD']}"},
+		{[]string{"USD", "CAD", "EUR"}, " currencies={['USD', 'CAD', 'EUR']}"},
+	} {
+		if got := currenciesAttr(tc.in); got != tc.want {
+			t.Errorf("currenciesAttr(%v) = %q, want %q", tc.in, got, tc.want)
+		}
+	}
+}
+
+func TestVisibilityExpr(t *testing.T) {
+	for _, tc := range []struct {
+		rule *VisibilityRule
+		want string
+	}{
+		{nil, "true"},
+		{&VisibilityRule{Field: "cam_terms", Operator: "truthy"}, "!!entity.cam_terms"},
+		{&VisibilityRule{Field: "is_sublease", Operator: "eq", Value: true}, "entity.is_sublease === true"},
+		{&VisibilityRule{Field: "status", Operator: "in", Values: []string{"eviction", "terminated"}}, "['eviction', 'terminated'].includes(entity.status ?? '')"},
+	} {
+		if got := visibilityExpr(tc.rule, "entity"); got != tc.want {
+			t.Errorf("visibilityExpr(%+v) = %q, want %q", tc.rule, got, tc.want)
+		}
+	}
+}
+
+func TestFormatCheck(t *testing.T) {
+	got := formatCheck(UIFieldRule{Field: "regulatory_url", Rule: "format", Value: "url"})
+	for _, want := range []string{
+		"input.regulatory_url && !/^https?:\\/\\/\\S+$/.test(input.regulatory_url)",
+		"must be a valid URL",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("formatCheck(url) = %q, missing %q", got, want)
+		}
+	}
+	if got := formatCheck(UIFieldRule{Field: "x", Rule: "format", Value: "fax"}); got != "" {
+		t.Errorf("formatCheck(fax) = %q, want empty", got)
+	}
+}
+
+func TestFormFieldRender_ValidatesOnBlur(t *testing.T) {
+	fd := UIFieldDef{Name: "lease_type", Label: "Lease Type", Type: "string", Immutable: true}
+	got := formFieldRender(UISchema{Fields: []UIFieldDef{fd}}, "lease_type")
+	want := "error={errors['lease_type']} on:focusout={(e) => handleBlur(e, 'lease_type')}>"
+	if !strings.Contains(got, want) {
+		t.Errorf("formFieldRender() = %q, missing %q", got, want)
+	}
+}
+
+func TestDetailTemplate_MasksSensitiveFields(t *testing.T) {
+	tmpl := mustParseTemplate("detail.svelte.tmpl", templateFuncs())
+	data := templateData{
+		UISchema: UISchema{
+			Entity: "bank_account",
+			Fields: []UIFieldDef{{Name: "name", Type: "string"}, {Name: "account_mask", Type: "string", IsSensitive: true}},
+			Detail: UIDetail{Sections: []UIDetailSection{{
+				ID: "overview", Title: "Overview", Fields: []string{"name", "account_mask"},
+				FieldDisplayModes: map[string]string{"account_mask": "masked"},
+			}}},
+		},
+		PascalName: "BankAccount",
+	}
+
+	var buf strings.Builder
+	if err := tmpl.Execute(&buf, data); err != nil {
+		t.Fatalf("Execute: %v", err)
+	}
+	out := buf.String()
+	if !strings.Contains(out, "<dd><MaskedValue value={entity.account_mask} /></dd>") {
+		t.Errorf("account_mask is not masked:\n%s", out)
+	}
+	if !strings.Contains(out, "<dd>{entity.name}</dd>") {
+		t.Errorf("name should render plainly:\n%s", out)
+	}
+}
+
+func TestDetailTemplate_DeleteAction(t *testing.T) {
+	tmpl := mustParseTemplate("detail.svelte.tmpl", templateFuncs())
+	data := templateData{
+		UISchema: UISchema{
+			Entity:      "building",
+			DisplayName: "Building",
+			API: UIAPI{BasePath: "/v1/buildings", Operations: map[string]UIAPIEndpoint{
+				"delete": {Method: "DELETE", Path: "/v1/buildings/{id}"},
+			}},
+		},
+		PascalName: "Building",
+		RoutePath:  "/buildings",
+		HasDelete:  true,
+	}
+
+	var buf strings.Builder
+	if err := tmpl.Execute(&buf, data); err != nil {
+		t.Fatalf("Execute: %v", err)
+	}
+	out := buf.String()
+	for _, want := range []string{
+		"import ConfirmDialog from '../../shared/ConfirmDialog.svelte';",
+		"await apiClient.delete(`/v1/buildings/${id}`);",
+		"window.location.hash = '/buildings';",
+		`<button class="btn variant-filled-error" on:click={() => (deleteOpen = true)}>Delete</button>`,
+		`message="Delete this Building? This cannot be undone."`,
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("detail is missing %q:\n%s", want, out)
+		}
+	}
+
+	data.HasDelete = false
+	buf.Reset()
+	if err := tmpl.Execute(&buf, data); err != nil {
+		t.Fatalf("Execute: %v", err)
+	}
+	if out := buf.String(); strings.Contains(out, "ConfirmDialog") || strings.Contains(out, "apiClient.delete") {
+		t.Errorf("detail without a delete operation renders a Delete action:\n%s", out)
+	}
+}
+
+func TestDetailTemplate_EmbeddedObjectSubFields(t *testing.T) {
+	tmpl := mustParseTemplate("detail.svelte.tmpl", templateFuncs())
+	data := templateData{
+		UISchema: UISchema{
+			Entity: "lease",
+			Fields: []UIFieldDef{{Name: "late_fee_policy", Type: "embedded_object", ObjectRef: "LateFeePolicy"}},
+			Detail: UIDetail{Sections: []UIDetailSection{{
+				ID: "late_fee_policy", Title: "Late Fee Policy", EmbeddedObject: "LateFeePolicy",
+				Fields:      []string{"grace_period_days", "max_fee"},
+				FieldLabels: map[string]string{"grace_period_days": "Grace Period Days", "max_fee": "Max Fee"},
+			}}},
+		},
+		PascalName: "Lease",
+		EmbeddedTypes: map[string]UIEmbeddedType{"LateFeePolicy": {Name: "LateFeePolicy", Fields: []UIFieldDef{
+			{Name: "grace_period_days", Type: "int"},
+			{Name: "max_fee", Type: "money"},
+		}}},
+	}
+
+	var buf strings.Builder
+	if err := tmpl.Execute(&buf, data); err != nil {
+		t.Fatalf("Execute: %v", err)
+	}
+	out := buf.String()
+	for _, want := range []string{
+		`<dt class="text-sm text-surface-500">Grace Period Days</dt>`,
+		"<dd>{entity.late_fee_policy?.grace_period_days}</dd>",
+		"<dd><MoneyDisplay value={entity.late_fee_policy?.max_fee} /></dd>",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("detail is missing %q:\n%s", want, out)
+		}
+	}
+}
+
+func TestDetailFieldRender(t *testing.T) {
+	entityBasePaths["property"] = "/v1/properties"
+	defer delete(entityBasePaths, "property")
+
+	data := templateData{
+		UISchema: UISchema{Fields: []UIFieldDef{
+			{Name: "base_rent", Type: "money"},
+			{Name: "status", Type: "enum", EnumRef: "LeaseStatus"},
+			{Name: "lease_type", Type: "enum", EnumRef: "LeaseType"},
+			{Name: "property_id", Type: "entity_ref", RefEntity: "property"},
+			{Name: "unit_number", Type: "string"},
+			{Name: "signed_date", Type: "date"},
+			{Name: "move_in_date", Type: "date", RelativeTime: true},
+			{Name: "insurance_expiry", Type: "date", RelativeTime: true, IsExpiry: true},
+		}},
+		PascalName: "Lease",
+		HasStatus:  true,
+	}
+	tests := map[string]string{
+		"signed_date":      "<dd><DateDisplay value={entity.signed_date} /></dd>",
+		"move_in_date":     "<dd><DateDisplay value={entity.move_in_date} /> <RelativeTime value={entity.move_in_date} /></dd>",
+		"insurance_expiry": "<dd><DateDisplay value={entity.insurance_expiry} /> <RelativeTime value={entity.insurance_expiry} expiry /></dd>",
+		"base_rent":        "<dd><MoneyDisplay value={entity.base_rent} /></dd>",
+		"status":           "<dd><LeaseStatusBadge status={entity.status} /></dd>",
+		"lease_type":       "<dd>{#if entity.lease_type}<EnumBadge value={entity.lease_type} labels={LEASE_TYPE_LABELS} />{/if}</dd>",
+		"property_id":      `<dd>{#if entity.property_id}<a class="anchor" href="#/properties/{entity.property_id}">{entity.property_id}</a>{/if}</dd>`,
+		"unit_number":      "<dd>{entity.unit_number}</dd>",
+	}
+	for name, want := range tests {
+		if got := detailFieldRender(data, name); got != want {
+			t.Errorf("detailFieldRender(%q) = %q, want %q", name, got, want)
+		}
+	}
+}
+
+func TestComputeDetailImports_RelativeTime(t *testing.T) {
+	schema := UISchema{
+		Fields: []UIFieldDef{
+			{Name: "move_in_date", Type: "date", RelativeTime: true},
+			{Name: "move_out_date", Type: "date"},
+		},
+		Detail: UIDetail{Sections: []UIDetailSection{{ID: "dates", Fields: []string{"move_out_date"}}}},
+	}
+	if got := computeDetailImports(schema); len(got) != 0 {
+		t.Errorf("imports without a relative date = %v, want none", got)
+	}
+	schema.Detail.Sections[0].Fields = append(schema.Detail.Sections[0].Fields, "move_in_date")
+	got := computeDetailImports(schema)
+	if len(got) != 1 || got[0].Name != "RelativeTime" || got[0].Path != "../../shared/RelativeTime.svelte" {
+		t.Errorf("imports = %v, want the RelativeTime component", got)
+	}
+}
+
+func TestDetailTemplate_RelatedSectionFetchesEndpoint(t *testing.T) {
+	entityBasePaths["space"] = "/v1/spaces"
+	defer delete(entityBasePaths, "space")
+
+	tmpl := mustParseTemplate("detail.svelte.tmpl", templateFuncs())
+	data := templateData{
+		UISchema: UISchema{
+			Entity: "property",
+			Relationships: []UIRelationship{
+				{Name: "spaces", TargetEntity: "space", Cardinality: "o2m", APIEndpoint: "/v1/spaces?property_id={id}", DisplayMode: "table"},
+				{Name: "portfolio", TargetEntity: "portfolio", Cardinality: "m2o", DisplayMode: "list"},
+			},
+			Detail: UIDetail{RelatedSections: []UIRelatedSection{
+				{Title: "Spaces", Relationship: "spaces", Entity: "space", Display: "table", IncludeFields: []string{"space_number"}},
+				{Title: "Portfolio", Relationship: "portfolio", Entity: "portfolio", Display: "list"},
+			}},
+		},
+		PascalName: "Property",
+	}
+
+	var buf strings.Builder
+	if err := tmpl.Execute(&buf, data); err != nil {
+		t.Fatalf("Execute: %v", err)
+	}
+	out := buf.String()
+	for _, want := range []string{
+		"import { relatedStore } from '../../../stores/related';",
+		"const spacesRelated = relatedStore<Record<string, any>>(`/v1/spaces?property_id=${id}`);",
+		"{#each $spacesRelated.data as item}",
+		"<td>{item.space_number ?? '—'}</td>",
+		"window.location.hash = `/spaces/${item.id}`",
+		"<p class=\"text-surface-400\">Related portfolio data</p>",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("detail is missing %q:\n%s", want, out)
+		}
+	}
+	if strings.Contains(out, "portfolioRelated") {
+		t.Errorf("relationship without an endpoint should not fetch:\n%s", out)
+	}
+}
+
+func TestReadonlyAfterCreateLocksInEditMode(t *testing.T) {
+	schema := UISchema{
+		Fields: []UIFieldDef{
+			{Name: "account_type", Label: "Account Type", Type: "string", ShowInUpdate: true, ReadonlyAfterCreate: true},
+			{Name: "name", Label: "Name", Type: "string", ShowInUpdate: true},
+		},
+		Form: UIForm{Sections: []UIFormSection{{ID: "main", Fields: []string{"account_type", "name"}}}},
+	}
+	if got := formFieldRender(schema, "account_type"); !strings.Contains(got, "disabled={mode === 'edit'}") {
+		t.Errorf("account_type is not locked in edit mode:\n%s", got)
+	}
+	if got := formFieldRender(schema, "name"); strings.Contains(got, "disabled=") {
+		t.Errorf("name should stay editable:\n%s", got)
+	}
+	if got := immutableFormFields(schema); len(got) != 1 || got[0] != "account_type" {
+		t.Errorf("immutableFormFields() = %v, want [account_type]", got)
+	}
+}
+
+func TestFormTemplate_WizardSteps(t *testing.T) {
+	schema := UISchema{
+		Entity:      "lease",
+		DisplayName: "Lease",
+		Fields: []UIFieldDef{
+			{Name: "name", Label: "Name", Type: "string", ShowInCreate: true},
+			{Name: "cam_terms", Label: "CAM Terms", Type: "embedded_object", ObjectRef: "CAMTerms"},
+			{Name: "notes", Label: "Notes", Type: "string", ShowInCreate: true},
+		},
+		Form: UIForm{
+			Sections: []UIFormSection{
+				{ID: "identity", Title: "Details", Fields: []string{"name"}},
+				{ID: "cam", Title: "CAM", EmbeddedObject: "CAMTerms"},
+				{ID: "extra", Title: "Extra", Fields: []string{"notes"}},
+			},
+			Steps: []UIFormStep{
+				{Title: "Basics", Sections: []string{"identity", "cam"}},
+				{Title: "Review", Sections: []string{"extra"}},
+			},
+		},
+	}
+	data := templateData{UISchema: schema, PascalName: "Lease", FormSteps: computeFormSteps(schema)}
+
+	var buf strings.Builder
+	if err := mustParseTemplate("form.svelte.tmpl", templateFuncs()).Execute(&buf, data); err != nil {
+		t.Fatalf("Execute: %v", err)
+	}
+	out := buf.String()
+	for _, want := range []string{
+		"{ title: 'Basics', sections: ['identity', 'cam'], fields: ['name', 'cam_terms'] },",
+		"{ title: 'Review', sections: ['extra'], fields: ['notes'] },",
+		"{#if step === 0}\n  <FormSection title=\"CAM\">",
+		"{#if step === 1}\n  <FormSection title=\"Extra\">",
+		"on:click={nextStep}>Next</button>",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("wizard form missing %q", want)
+		}
+	}
+
+	data.FormSteps = nil
+	buf.Reset()
+	if err := mustParseTemplate("form.svelte.tmpl", templateFuncs()).Execute(&buf, data); err != nil {
+		t.Fatalf("Execute: %v", err)
+	}
+	if strings.Contains(buf.String(), "step") {
+		t.Errorf("single-page form should not render wizard steps")
+	}
+}
+
+func TestAPITemplate_TypedFunctions(t *testing.T) {
+	data := templateData{
+		UISchema: UISchema{
+			Entity:            "space",
+			DisplayNamePlural: "Spaces",
+			API: UIAPI{
+				Operations: map[string]UIAPIEndpoint{
+					"create": {Method: "POST", Path: "/v1/spaces"},
+					"list":   {Method: "GET", Path: "/v1/spaces"},
+				},
+				Transitions: map[string]UIAPIEndpoint{
+					"make-ready": {Method: "POST", Path: "/v1/spaces/{id}/make-ready"},
+					"vacate": {
+						Method:        "POST",
+						Path:          "/v1/spaces/{id}/vacate",
+						RequestFields: []string{"status_reason", "vacated_on"},
+					},
+				},
+			},
+			Fields: []UIFieldDef{{Name: "status_reason", Type: "string"}},
+		},
+		PascalName: "Space",
+		CamelName:  "space",
+	}
+
+	var buf strings.Builder
+	if err := mustParseTemplate("api.ts.tmpl", templateFuncs()).Execute(&buf, data); err != nil {
+		t.Fatalf("Execute: %v", err)
+	}
+	out := buf.String()
+	for _, want := range []string{
+		"export async function createSpace(input: SpaceCreateInput): Promise<Space> {",
+		"export async function listSpaces(params?: Record<string, any>): Promise<SpaceListResponse> {",
+		"export async function transitionSpaceMakeReady(id: string): Promise<Space> {",
+		"export interface SpaceVacateBody {\n  status_reason?: Space['status_reason'];\n  vacated_on?: string;\n}",
+		"export async function transitionSpaceVacate(id: string, body?: SpaceVacateBody): Promise<Space> {",
+		"  makeReady: transitionSpaceMakeReady,",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("api.ts missing %q", want)
+		}
+	}
+	if strings.Contains(out, "getSpace") {
+		t.Errorf("api.ts should not define getSpace without a get operation")
+	}
+}
+
+func TestListTemplate_Pagination(t *testing.T) {
+	tmpl := mustParseTemplate("list.svelte.tmpl", templateFuncs())
+	data := templateData{
+		UISchema: UISchema{
+			Entity: "property",
+			Fields: []UIFieldDef{{Name: "name", Type: "string"}},
+			List: UIList{
+				DefaultColumns: []UIListColumn{{Field: "name"}},
+				DefaultSort:    UISort{Field: "name", Direction: "asc"},
+			},
+		},
+		PascalName: "Property",
+	}
+
+	var buf strings.Builder
+	if err := tmpl.Execute(&buf, data); err != nil {
+		t.Fatalf("Execute: %v", err)
+	}
+	out := buf.String()
+	for _, want := range []string{
+		"import Pagination from '../../shared/Pagination.svelte';",
+		"store.setPageSize(e.detail);",
+		"<Pagination pagination={$store.pagination} on:page={handlePage} on:amount={handleAmount} />",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("list template missing %q:\n%s", want, out)
+		}
+	}
+	if !strings.Contains(storeFiles["entityList.ts"], "function setPageSize(limit: number)") {
+		t.Error("entityListStore does not expose setPageSize")
+	}
+}
+
+func TestListTemplate_GroupBy(t *testing.T) {
+	tmpl := mustParseTemplate("list.svelte.tmpl", templateFuncs())
+	data := templateData{
+		UISchema: UISchema{
+			Entity: "account",
+			Fields: []UIFieldDef{{Name: "name", Type: "string"}, {Name: "account_type", Type: "enum", EnumRef: "AccountType"}},
+			List: UIList{
+				DefaultColumns: []UIListColumn{{Field: "name"}, {Field: "account_type"}},
+				DefaultSort:    UISort{Field: "name", Direction: "asc"},
+			},
+		},
+		PascalName: "Account",
+	}
+
+	render := func() string {
+		var buf strings.Builder
+		if err := tmpl.Execute(&buf, data); err != nil {
+			t.Fatalf("Execute: %v", err)
+		}
+		return buf.String()
+	}
+	if out := render(); strings.Contains(out, "groupRows") || !strings.Contains(out, "{#each $store.data as item}") {
+		t.Errorf("ungrouped list should render rows flat:\n%s", out)
+	}
+
+	data.List.GroupBy = "account_type"
+	out := render()
+	for _, want := range []string{
+		"import { ACCOUNT_TYPE_LABELS } from '../../../types/enums';",
+		"const groupOrder = Object.keys(ACCOUNT_TYPE_LABELS);",
+		"const key = String(item.account_type ?? '');",
+		"{#each groups as group (group.key)}",
+		`<th colspan="2" scope="rowgroup">`,
+		"{#each group.items as item}",
+		`<tr class="cursor-pointer" on:click={() => handleRowClick(item)}>`,
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("grouped list missing %q:\n%s", want, out)
+		}
+	}
+	if strings.Contains(out, "{#each $store.data as item}") {
+		t.Errorf("grouped list should not also render rows flat:\n%s", out)
+	}
+}
+
+// TestSkeletonVariant_CoversUIGenColors reads the colors uigen's
+// classifyStateColor can return from its source, since the two commands
+// cannot import each other, and checks each has light and dark badge classes.
+func TestSkeletonVariant_CoversUIGenColors(t *testing.T) {
+	file, err := parser.ParseFile(token.NewFileSet(), "../uigen/main.go", nil, 0)
+	if err != nil {
+		t.Fatalf("parsing uigen: %v", err)
+	}
+	var colors []string
+	for _, decl := range file.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || fn.Name.Name != "classifyStateColor" {
+			continue
+		}
+		ast.Inspect(fn.Body, func(n ast.Node) bool {
+			ret, ok := n.(*ast.ReturnStmt)
+			if !ok || len(ret.Results) != 1 {
+				return true
+			}
+			if lit, ok := ret.Results[0].(*ast.BasicLit); ok && lit.Kind == token.STRING {
+				color, _ := strconv.Unquote(lit.Value)
+				colors = append(colors, color)
+			}
+			return true
+		})
+	}
+	if len(colors) == 0 {
+		t.Fatal("found no colors returned by classifyStateColor")
+	}
+	for _, color := range colors {
+		classes, ok := statusColorClasses[color]
+		if !ok {
+			t.Errorf("classifyStateColor returns %q, which has no statusColorClasses entry", color)
+			continue
+		}
+		if !strings.Contains(classes, "variant-soft-"+color) || !strings.Contains(classes, "dark:") {
+			t.Errorf("classes for %q = %q, want its soft variant and a dark-mode tone", color, classes)
+		}
+	}
+	if got, want := skeletonVariant("unknown"), statusColorClasses["surface"]; got != want {
+		t.Errorf("skeletonVariant(unknown) = %q, want the surface classes %q", got, want)
+	}
+}
@@ -4,16 +4,17 @@
 package codegen
 
 #ServiceDef: {
-	name:       string
-	base_path:  string
-	entities:   [...string]
-	operations: [...#OperationDef]
+	name:        string
+	description: string // shown for the service's tag in API docs
+	base_path:   string
+	entities:    [...string]
+	operations:  [...#OperationDef]
 }
 
 #OperationDef: {
 	name:        string // RPC method name
 	entity:      string
-	type:        "create" | "get" | "list" | "update" | "delete" | "transition"
+	type:        "create" | "bulk" | "get" | "exists" | "list" | "update" | "delete" | "transition"
 	// REST route path segment for the entity (e.g., "persons", "person-roles")
 	entity_path?: string
 	// For transition operations
@@ -21,28 +22,37 @@ package codegen
 	from_status?: [...string]
 	to_status?:   string
 	extra_fields?: [...string] // Additional request fields
+	// For list operations: false returns a bare array instead of the
+	// {data, total, offset, limit} envelope (default true)
+	paginated?: bool
 	// Mark transition as having custom handler logic (not generated)
 	custom?:     bool | *false
+	// Exempt the operation from the spec's global bearer-token requirement
+	public?:     bool | *false
 	description: string
 }
 
 services: [...#ServiceDef]
 services: [
 	{
-		name:      "PersonService"
-		base_path: "/v1"
+		name:        "PersonService"
+		description: "People, organizations, and the roles people hold with them"
+		base_path:   "/v1"
 		entities: ["Person", "Organization", "PersonRole"]
 		operations: [
 			{name: "CreatePerson", entity: "Person", entity_path: "persons", type: "create", description: "Create a new person"},
 			{name: "GetPerson", entity: "Person", entity_path: "persons", type: "get", description: "Get person by ID"},
+			{name: "CheckPersonExists", entity: "Person", entity_path: "persons", type: "exists", description: "Check whether a person exists"},
 			{name: "ListPersons", entity: "Person", entity_path: "persons", type: "list", description: "List persons with filtering"},
 			{name: "UpdatePerson", entity: "Person", entity_path: "persons", type: "update", description: "Update person fields"},
 			{name: "CreateOrganization", entity: "Organization", entity_path: "organizations", type: "create", description: "Create a new organization"},
 			{name: "GetOrganization", entity: "Organization", entity_path: "organizations", type: "get", description: "Get organization by ID"},
+			{name: "CheckOrganizationExists", entity: "Organization", entity_path: "organizations", type: "exists", description: "Check whether an organization exists"},
 			{name: "ListOrganizations", entity: "Organization", entity_path: "organizations", type: "list", description: "List organizations"},
 			{name: "UpdateOrganization", entity: "Organization", entity_path: "organizations", type: "update", description: "Update organization"},
 			{name: "CreatePersonRole", entity: "PersonRole", entity_path: "person-roles", type: "create", description: "Assign a role to a person"},
 			{name: "GetPersonRole", entity: "PersonRole", entity_path: "person-roles", type: "get", description: "Get person role by ID"},
+			{name: "CheckPersonRoleExists", entity: "PersonRole", entity_path: "person-roles", type: "exists", description: "Check whether a person role exists"},
 			{name: "ListPersonRoles", entity: "PersonRole", entity_path: "person-roles", type: "list", description: "List person roles"},
 			{name: "ActivateRole", entity: "PersonRole", entity_path: "person-roles", type: "transition", action: "activate",
 				from_status: ["pending"], to_status: "active",
@@ -56,13 +66,15 @@ services: [
 		]
 	},
 	{
-		name:      "PropertyService"
-		base_path: "/v1"
+		name:        "PropertyService"
+		description: "Portfolios, properties, buildings, and rentable spaces"
+		base_path:   "/v1"
 		entities: ["Portfolio", "Property", "Building", "Space"]
 		operations: [
 			// Portfolio CRUD + transitions
 			{name: "CreatePortfolio", entity: "Portfolio", entity_path: "portfolios", type: "create", description: "Create a new portfolio"},
 			{name: "GetPortfolio", entity: "Portfolio", entity_path: "portfolios", type: "get", description: "Get portfolio by ID"},
+			{name: "CheckPortfolioExists", entity: "Portfolio", entity_path: "portfolios", type: "exists", description: "Check whether a portfolio exists"},
 			{name: "ListPortfolios", entity: "Portfolio", entity_path: "portfolios", type: "list", description: "List portfolios"},
 			{name: "UpdatePortfolio", entity: "Portfolio", entity_path: "portfolios", type: "update", description: "Update portfolio"},
 			{name: "ActivatePortfolio", entity: "Portfolio", entity_path: "portfolios", type: "transition", action: "activate",
@@ -72,6 +84,7 @@ services: [
 			// Property CRUD + transitions
 			{name: "CreateProperty", entity: "Property", entity_path: "properties", type: "create", description: "Create a new property"},
 			{name: "GetProperty", entity: "Property", entity_path: "properties", type: "get", description: "Get property by ID"},
+			{name: "CheckPropertyExists", entity: "Property", entity_path: "properties", type: "exists", description: "Check whether a property exists"},
 			{name: "ListProperties", entity: "Property", entity_path: "properties", type: "list", description: "List properties with filtering"},
 			{name: "UpdateProperty", entity: "Property", entity_path: "properties", type: "update", description: "Update property fields"},
 			{name: "ActivateProperty", entity: "Property", entity_path: "properties", type: "transition", action: "activate",
@@ -81,8 +94,10 @@ services: [
 			// Building CRUD + transitions
 			{name: "CreateBuilding", entity: "Building", entity_path: "buildings", type: "create", description: "Create a new building"},
 			{name: "GetBuilding", entity: "Building", entity_path: "buildings", type: "get", description: "Get building by ID"},
+			{name: "CheckBuildingExists", entity: "Building", entity_path: "buildings", type: "exists", description: "Check whether a building exists"},
 			{name: "ListBuildings", entity: "Building", entity_path: "buildings", type: "list", description: "List buildings"},
 			{name: "UpdateBuilding", entity: "Building", entity_path: "buildings", type: "update", description: "Update building"},
+			{name: "DeleteBuilding", entity: "Building", entity_path: "buildings", type: "delete", description: "Delete a building; it is kept, marked deleted"},
 			{name: "DeactivateBuilding", entity: "Building", entity_path: "buildings", type: "transition", action: "deactivate",
 				from_status: ["active"], to_status: "inactive",
 				description: "Deactivate a building"},
@@ -96,6 +111,7 @@ services: [
 			// Space CRUD + transitions
 			{name: "CreateSpace", entity: "Space", entity_path: "spaces", type: "create", description: "Create a new space within a property"},
 			{name: "GetSpace", entity: "Space", entity_path: "spaces", type: "get", description: "Get space by ID"},
+			{name: "CheckSpaceExists", entity: "Space", entity_path: "spaces", type: "exists", description: "Check whether a space exists"},
 			{name: "ListSpaces", entity: "Space", entity_path: "spaces", type: "list", description: "List spaces with filtering"},
 			{name: "UpdateSpace", entity: "Space", entity_path: "spaces", type: "update", description: "Update space fields"},
 			{name: "OccupySpace", entity: "Space", entity_path: "spaces", type: "transition", action: "occupy",
@@ -125,13 +141,15 @@ services: [
 		]
 	},
 	{
-		name:      "LeaseService"
-		base_path: "/v1"
+		name:        "LeaseService"
+		description: "Leases, the spaces they cover, and rental applications"
+		base_path:   "/v1"
 		entities: ["Lease", "LeaseSpace", "Application"]
 		operations: [
 			// Lease CRUD + transitions
 			{name: "CreateLease", entity: "Lease", entity_path: "leases", type: "create", description: "Create a new lease draft"},
 			{name: "GetLease", entity: "Lease", entity_path: "leases", type: "get", description: "Get lease by ID"},
+			{name: "CheckLeaseExists", entity: "Lease", entity_path: "leases", type: "exists", description: "Check whether a lease exists"},
 			{name: "ListLeases", entity: "Lease", entity_path: "leases", type: "list", description: "List leases with filtering"},
 			{name: "UpdateLease", entity: "Lease", entity_path: "leases", type: "update", description: "Update lease fields (draft only)"},
 			{name: "SubmitForApproval", entity: "Lease", entity_path: "leases", type: "transition", action: "submit",
@@ -180,12 +198,14 @@ services: [
 			// LeaseSpace CRUD
 			{name: "CreateLeaseSpace", entity: "LeaseSpace", entity_path: "lease-spaces", type: "create", description: "Create a lease-space association"},
 			{name: "GetLeaseSpace", entity: "LeaseSpace", entity_path: "lease-spaces", type: "get", description: "Get lease-space by ID"},
+			{name: "CheckLeaseSpaceExists", entity: "LeaseSpace", entity_path: "lease-spaces", type: "exists", description: "Check whether a lease-space exists"},
 			{name: "ListLeaseSpaces", entity: "LeaseSpace", entity_path: "lease-spaces", type: "list", description: "List lease-space associations"},
 			{name: "UpdateLeaseSpace", entity: "LeaseSpace", entity_path: "lease-spaces", type: "update", description: "Update lease-space association"},
 
 			// Application CRUD + transitions
 			{name: "CreateApplication", entity: "Application", entity_path: "applications", type: "create", description: "Submit a new lease application"},
 			{name: "GetApplication", entity: "Application", entity_path: "applications", type: "get", description: "Get application by ID"},
+			{name: "CheckApplicationExists", entity: "Application", entity_path: "applications", type: "exists", description: "Check whether an application exists"},
 			{name: "ListApplications", entity: "Application", entity_path: "applications", type: "list", description: "List applications"},
 			{name: "ApproveApplication", entity: "Application", entity_path: "applications", type: "transition", action: "approve",
 				from_status: ["under_review"], to_status: "approved",
@@ -198,18 +218,23 @@ services: [
 		]
 	},
 	{
-		name:      "AccountingService"
-		base_path: "/v1"
+		name:        "AccountingService"
+		description: "Chart of accounts, ledger and journal entries, bank accounts, and reconciliations"
+		base_path:   "/v1"
 		entities: ["Account", "LedgerEntry", "JournalEntry", "BankAccount", "Reconciliation"]
 		operations: [
 			{name: "CreateAccount", entity: "Account", entity_path: "accounts", type: "create", description: "Create a new GL account"},
 			{name: "GetAccount", entity: "Account", entity_path: "accounts", type: "get", description: "Get GL account by ID"},
+			{name: "CheckAccountExists", entity: "Account", entity_path: "accounts", type: "exists", description: "Check whether a GL account exists"},
 			{name: "ListAccounts", entity: "Account", entity_path: "accounts", type: "list", description: "List GL accounts"},
 			{name: "UpdateAccount", entity: "Account", entity_path: "accounts", type: "update", description: "Update GL account"},
 			{name: "GetLedgerEntry", entity: "LedgerEntry", entity_path: "ledger-entries", type: "get", description: "Get ledger entry by ID"},
+			{name: "CheckLedgerEntryExists", entity: "LedgerEntry", entity_path: "ledger-entries", type: "exists", description: "Check whether a ledger entry exists"},
 			{name: "ListLedgerEntries", entity: "LedgerEntry", entity_path: "ledger-entries", type: "list", description: "List ledger entries with filtering"},
+			{name: "BulkCreateLedgerEntries", entity: "LedgerEntry", entity_path: "ledger-entries", type: "bulk", description: "Create ledger entries atomically in one transaction"},
 			{name: "CreateJournalEntry", entity: "JournalEntry", entity_path: "journal-entries", type: "create", description: "Create a new journal entry"},
 			{name: "GetJournalEntry", entity: "JournalEntry", entity_path: "journal-entries", type: "get", description: "Get journal entry by ID"},
+			{name: "CheckJournalEntryExists", entity: "JournalEntry", entity_path: "journal-entries", type: "exists", description: "Check whether a journal entry exists"},
 			{name: "ListJournalEntries", entity: "JournalEntry", entity_path: "journal-entries", type: "list", description: "List journal entries"},
 			{name: "PostJournalEntry", entity: "JournalEntry", entity_path: "journal-entries", type: "transition", action: "post",
 				from_status: ["draft", "pending_approval"], to_status: "posted",
@@ -221,10 +246,12 @@ services: [
 				description: "Void a posted journal entry. Creates reversal entry"},
 			{name: "CreateBankAccount", entity: "BankAccount", entity_path: "bank-accounts", type: "create", description: "Create a bank account"},
 			{name: "GetBankAccount", entity: "BankAccount", entity_path: "bank-accounts", type: "get", description: "Get bank account by ID"},
+			{name: "CheckBankAccountExists", entity: "BankAccount", entity_path: "bank-accounts", type: "exists", description: "Check whether a bank account exists"},
 			{name: "ListBankAccounts", entity: "BankAccount", entity_path: "bank-accounts", type: "list", description: "List bank accounts"},
 			{name: "UpdateBankAccount", entity: "BankAccount", entity_path: "bank-accounts", type: "update", description: "Update bank account"},
 			{name: "CreateReconciliation", entity: "Reconciliation", entity_path: "reconciliations", type: "create", description: "Start a bank reconciliation"},
 			{name: "GetReconciliation", entity: "Reconciliation", entity_path: "reconciliations", type: "get", description: "Get reconciliation by ID"},
+			{name: "CheckReconciliationExists", entity: "Reconciliation", entity_path: "reconciliations", type: "exists", description: "Check whether a reconciliation exists"},
 			{name: "ListReconciliations", entity: "Reconciliation", entity_path: "reconciliations", type: "list", description: "List reconciliations"},
 			{name: "ApproveReconciliation", entity: "Reconciliation", entity_path: "reconciliations", type: "transition", action: "approve",
 				from_status: ["balanced"], to_status: "approved",
@@ -233,8 +260,9 @@ services: [
 		]
 	},
 	{
-		name:      "ActivityService"
-		base_path: "/v1"
+		name:        "ActivityService"
+		description: "Activity feeds and signal summaries built from recorded domain events"
+		base_path:   "/v1"
 		entities: []
 		operations: [
 			{name: "GetEntityActivity", entity: "ActivityEntry", entity_path: "activity/entity", type: "get",
@@ -252,13 +280,15 @@ services: [
 		]
 	},
 	{
-		name:      "JurisdictionService"
-		base_path: "/v1"
+		name:        "JurisdictionService"
+		description: "Jurisdictions, the properties they govern, and the rules they enforce"
+		base_path:   "/v1"
 		entities: ["Jurisdiction", "PropertyJurisdiction", "JurisdictionRule"]
 		operations: [
 			// Jurisdiction CRUD + transitions
 			{name: "CreateJurisdiction", entity: "Jurisdiction", entity_path: "jurisdictions", type: "create", description: "Create a new jurisdiction"},
 			{name: "GetJurisdiction", entity: "Jurisdiction", entity_path: "jurisdictions", type: "get", description: "Get jurisdiction by ID"},
+			{name: "CheckJurisdictionExists", entity: "Jurisdiction", entity_path: "jurisdictions", type: "exists", description: "Check whether a jurisdiction exists"},
 			{name: "ListJurisdictions", entity: "Jurisdiction", entity_path: "jurisdictions", type: "list", description: "List jurisdictions with filtering"},
 			{name: "UpdateJurisdiction", entity: "Jurisdiction", entity_path: "jurisdictions", type: "update", description: "Update jurisdiction fields"},
 			{name: "ActivateJurisdiction", entity: "Jurisdiction", entity_path: "jurisdictions", type: "transition", action: "activate",
@@ -276,12 +306,14 @@ services: [
 			// PropertyJurisdiction CRUD
 			{name: "CreatePropertyJurisdiction", entity: "PropertyJurisdiction", entity_path: "property-jurisdictions", type: "create", description: "Link a property to a jurisdiction"},
 			{name: "GetPropertyJurisdiction", entity: "PropertyJurisdiction", entity_path: "property-jurisdictions", type: "get", description: "Get property-jurisdiction link by ID"},
+			{name: "CheckPropertyJurisdictionExists", entity: "PropertyJurisdiction", entity_path: "property-jurisdictions", type: "exists", description: "Check whether a property-jurisdiction link exists"},
 			{name: "ListPropertyJurisdictions", entity: "PropertyJurisdiction", entity_path: "property-jurisdictions", type: "list", description: "List property-jurisdiction links"},
 			{name: "UpdatePropertyJurisdiction", entity: "PropertyJurisdiction", entity_path: "property-jurisdictions", type: "update", description: "Update property-jurisdiction link"},
 
 			// JurisdictionRule CRUD + transitions
 			{name: "CreateJurisdictionRule", entity: "JurisdictionRule", entity_path: "jurisdiction-rules", type: "create", description: "Create a new jurisdiction rule"},
 			{name: "GetJurisdictionRule", entity: "JurisdictionRule", entity_path: "jurisdiction-rules", type: "get", description: "Get jurisdiction rule by ID"},
+			{name: "CheckJurisdictionRuleExists", entity: "JurisdictionRule", entity_path: "jurisdiction-rules", type: "exists", description: "Check whether a jurisdiction rule exists"},
 			{name: "ListJurisdictionRules", entity: "JurisdictionRule", entity_path: "jurisdiction-rules", type: "list", description: "List jurisdiction rules"},
 			{name: "UpdateJurisdictionRule", entity: "JurisdictionRule", entity_path: "jurisdiction-rules", type: "update", description: "Update jurisdiction rule"},
 			{name: "ActivateRule", entity: "JurisdictionRule", entity_path: "jurisdiction-rules", type: "transition", action: "activate",
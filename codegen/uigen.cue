@@ -1,5 +1,5 @@
 // codegen/uigen.cue
-// UI generation overrides: display names, hidden fields, enum groupings.
+// UI generation overrides: display names, hidden fields, list columns, enum groupings.
 // These are values not derivable from the ontology alone.
 package codegen
 
@@ -9,6 +9,14 @@ package codegen
 	primary_display_template?: string // e.g., "{space_number} — {tenant_name}"
 	hidden_fields?: [...string]
 	field_overrides?: [string]: #UIFieldOverride
+	// form_steps renders the form as a wizard, one step per entry. Sections
+	// no step lists are appended to the last step.
+	form_steps?: [...#UIFormStep]
+}
+
+#UIFormStep: {
+	title: string
+	sections: [...string] // form section IDs, e.g. "identity"
 }
 
 #UIFieldOverride: {
@@ -20,14 +28,32 @@ package codegen
 	show_in_detail?: bool
 }
 
+// #UIListOverride replaces the generated list view for an entity. When
+// columns is set it is used verbatim instead of the column heuristic.
+#UIListOverride: {
+	columns?: [...#UIListColumnOverride]
+	default_sort?: {
+		field:      string
+		direction?: "asc" | "desc"
+	}
+}
+
+#UIListColumnOverride: {
+	field:      string
+	label?:     string
+	width?:     string
+	component?: string // e.g., "enum_badge", "status_badge", "money", "date"
+}
+
 #UIEnumGrouping: {
 	values: [...#UIEnumValue]
 	groups?: [...#UIEnumGroup]
 }
 
 #UIEnumValue: {
-	value: string
-	label: string
+	value:       string
+	label:       string
+	deprecated?: bool // kept for existing records, hidden from new selections
 }
 
 #UIEnumGroup: {
@@ -77,6 +103,13 @@ ui_entity_overrides: {
 		display_name:             "Lease"
 		display_name_plural:      "Leases"
 		primary_display_template: "{lease_type}"
+		form_steps: [
+			{title: "Basics", sections:          ["identity", "term"]},
+			{title: "Rent & Charges", sections:  ["financial", "rent_schedule", "recurring_charges", "usage_charges", "late_fee"]},
+			{title: "Lease Type Terms", sections: ["cam", "percentage_rent", "subsidy", "short_term", "membership"]},
+			{title: "Options", sections:         ["renewal_options", "expansion", "contraction", "tenant_improvement", "sublease"]},
+			{title: "Signing", sections:         ["signing", "additional"]},
+		]
 	}
 	LeaseSpace: {
 		display_name:             "Lease Space"
@@ -130,6 +163,10 @@ ui_entity_overrides: {
 	}
 }
 
+// Per-entity list column and sort overrides
+ui_list_overrides: [string]: #UIListOverride
+ui_list_overrides: {}
+
 // Enum grouping overrides (for enums that benefit from grouped display)
 ui_enum_groupings: [string]: #UIEnumGrouping
 ui_enum_groupings: {
@@ -169,6 +169,8 @@ var (
 	CreatedByValidator func(string) error
 	// UpdatedByValidator is a validator for the "updated_by" field. It is called by the builders before save.
 	UpdatedByValidator func(string) error
+	// DepthValidator is a validator for the "depth" field. It is called by the builders before save.
+	DepthValidator func(int) error
 	// DefaultIsHeader holds the default value on creation for the "is_header" field.
 	DefaultIsHeader bool
 	// DefaultIsSystem holds the default value on creation for the "is_system" field.
@@ -502,6 +502,11 @@ func (_c *AccountCreate) check() error {
 	if _, ok := _c.mutation.Depth(); !ok {
 		return &ValidationError{Name: "depth", err: errors.New(`ent: missing required field "Account.depth"`)}
 	}
+	if v, ok := _c.mutation.Depth(); ok {
+		if err := account.DepthValidator(v); err != nil {
+			return &ValidationError{Name: "depth", err: fmt.Errorf(`ent: validator failed for field "Account.depth": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.NormalBalance(); !ok {
 		return &ValidationError{Name: "normal_balance", err: errors.New(`ent: missing required field "Account.normal_balance"`)}
 	}
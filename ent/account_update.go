@@ -627,6 +627,11 @@ func (_u *AccountUpdate) check() error {
 			return &ValidationError{Name: "account_subtype", err: fmt.Errorf(`ent: validator failed for field "Account.account_subtype": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.Depth(); ok {
+		if err := account.DepthValidator(v); err != nil {
+			return &ValidationError{Name: "depth", err: fmt.Errorf(`ent: validator failed for field "Account.depth": %w`, err)}
+		}
+	}
 	if v, ok := _u.mutation.NormalBalance(); ok {
 		if err := account.NormalBalanceValidator(v); err != nil {
 			return &ValidationError{Name: "normal_balance", err: fmt.Errorf(`ent: validator failed for field "Account.normal_balance": %w`, err)}
@@ -1559,6 +1564,11 @@ func (_u *AccountUpdateOne) check() error {
 			return &ValidationError{Name: "account_subtype", err: fmt.Errorf(`ent: validator failed for field "Account.account_subtype": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.Depth(); ok {
+		if err := account.DepthValidator(v); err != nil {
+			return &ValidationError{Name: "depth", err: fmt.Errorf(`ent: validator failed for field "Account.depth": %w`, err)}
+		}
+	}
 	if v, ok := _u.mutation.NormalBalance(); ok {
 		if err := account.NormalBalanceValidator(v); err != nil {
 			return &ValidationError{Name: "normal_balance", err: fmt.Errorf(`ent: validator failed for field "Account.normal_balance": %w`, err)}
@@ -175,10 +175,16 @@ var (
 	CreatedByValidator func(string) error
 	// UpdatedByValidator is a validator for the "updated_by" field. It is called by the builders before save.
 	UpdatedByValidator func(string) error
+	// DesiredLeaseTermMonthsValidator is a validator for the "desired_lease_term_months" field. It is called by the builders before save.
+	DesiredLeaseTermMonthsValidator func(int) error
+	// CreditScoreValidator is a validator for the "credit_score" field. It is called by the builders before save.
+	CreditScoreValidator func(int) error
 	// DefaultBackgroundClear holds the default value on creation for the "background_clear" field.
 	DefaultBackgroundClear bool
 	// DefaultIncomeVerified holds the default value on creation for the "income_verified" field.
 	DefaultIncomeVerified bool
+	// IncomeToRentRatioValidator is a validator for the "income_to_rent_ratio" field. It is called by the builders before save.
+	IncomeToRentRatioValidator func(float64) error
 	// DefaultApplicationFeeCurrency holds the default value on creation for the "application_fee_currency" field.
 	DefaultApplicationFeeCurrency string
 	// ApplicationFeeCurrencyValidator is a validator for the "application_fee_currency" field. It is called by the builders before save.
@@ -489,12 +489,27 @@ func (_c *ApplicationCreate) check() error {
 	if _, ok := _c.mutation.DesiredLeaseTermMonths(); !ok {
 		return &ValidationError{Name: "desired_lease_term_months", err: errors.New(`ent: missing required field "Application.desired_lease_term_months"`)}
 	}
+	if v, ok := _c.mutation.DesiredLeaseTermMonths(); ok {
+		if err := application.DesiredLeaseTermMonthsValidator(v); err != nil {
+			return &ValidationError{Name: "desired_lease_term_months", err: fmt.Errorf(`ent: validator failed for field "Application.desired_lease_term_months": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.CreditScore(); ok {
+		if err := application.CreditScoreValidator(v); err != nil {
+			return &ValidationError{Name: "credit_score", err: fmt.Errorf(`ent: validator failed for field "Application.credit_score": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.BackgroundClear(); !ok {
 		return &ValidationError{Name: "background_clear", err: errors.New(`ent: missing required field "Application.background_clear"`)}
 	}
 	if _, ok := _c.mutation.IncomeVerified(); !ok {
 		return &ValidationError{Name: "income_verified", err: errors.New(`ent: missing required field "Application.income_verified"`)}
 	}
+	if v, ok := _c.mutation.IncomeToRentRatio(); ok {
+		if err := application.IncomeToRentRatioValidator(v); err != nil {
+			return &ValidationError{Name: "income_to_rent_ratio", err: fmt.Errorf(`ent: validator failed for field "Application.income_to_rent_ratio": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.ApplicationFeeAmountCents(); !ok {
 		return &ValidationError{Name: "application_fee_amount_cents", err: errors.New(`ent: missing required field "Application.application_fee_amount_cents"`)}
 	}
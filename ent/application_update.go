@@ -587,6 +587,21 @@ func (_u *ApplicationUpdate) check() error {
 			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Application.status": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.DesiredLeaseTermMonths(); ok {
+		if err := application.DesiredLeaseTermMonthsValidator(v); err != nil {
+			return &ValidationError{Name: "desired_lease_term_months", err: fmt.Errorf(`ent: validator failed for field "Application.desired_lease_term_months": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.CreditScore(); ok {
+		if err := application.CreditScoreValidator(v); err != nil {
+			return &ValidationError{Name: "credit_score", err: fmt.Errorf(`ent: validator failed for field "Application.credit_score": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.IncomeToRentRatio(); ok {
+		if err := application.IncomeToRentRatioValidator(v); err != nil {
+			return &ValidationError{Name: "income_to_rent_ratio", err: fmt.Errorf(`ent: validator failed for field "Application.income_to_rent_ratio": %w`, err)}
+		}
+	}
 	if v, ok := _u.mutation.ApplicationFeeCurrency(); ok {
 		if err := application.ApplicationFeeCurrencyValidator(v); err != nil {
 			return &ValidationError{Name: "application_fee_currency", err: fmt.Errorf(`ent: validator failed for field "Application.application_fee_currency": %w`, err)}
@@ -1428,6 +1443,21 @@ func (_u *ApplicationUpdateOne) check() error {
 			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Application.status": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.DesiredLeaseTermMonths(); ok {
+		if err := application.DesiredLeaseTermMonthsValidator(v); err != nil {
+			return &ValidationError{Name: "desired_lease_term_months", err: fmt.Errorf(`ent: validator failed for field "Application.desired_lease_term_months": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.CreditScore(); ok {
+		if err := application.CreditScoreValidator(v); err != nil {
+			return &ValidationError{Name: "credit_score", err: fmt.Errorf(`ent: validator failed for field "Application.credit_score": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.IncomeToRentRatio(); ok {
+		if err := application.IncomeToRentRatioValidator(v); err != nil {
+			return &ValidationError{Name: "income_to_rent_ratio", err: fmt.Errorf(`ent: validator failed for field "Application.income_to_rent_ratio": %w`, err)}
+		}
+	}
 	if v, ok := _u.mutation.ApplicationFeeCurrency(); ok {
 		if err := application.ApplicationFeeCurrencyValidator(v); err != nil {
 			return &ValidationError{Name: "application_fee_currency", err: fmt.Errorf(`ent: validator failed for field "Application.application_fee_currency": %w`, err)}
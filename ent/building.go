@@ -36,6 +36,8 @@ type Building struct {
 	CorrelationID *string `json:"correlation_id,omitempty"`
 	// If source == 'agent', which goal triggered this change
 	AgentGoalID *string `json:"agent_goal_id,omitempty"`
+	// When the entity was soft-deleted; nil while it is live
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
 	// Name holds the value of the "name" field.
 	Name string `json:"name,omitempty"`
 	// BuildingType holds the value of the "building_type" field.
@@ -54,6 +56,8 @@ type Building struct {
 	TotalSquareFootage *float64 `json:"total_square_footage,omitempty"`
 	// TotalRentableSquareFootage holds the value of the "total_rentable_square_footage" field.
 	TotalRentableSquareFootage *float64 `json:"total_rentable_square_footage,omitempty"`
+	// Optimistic concurrency token; incremented on every update
+	Version int `json:"version,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the BuildingQuery when eager-loading is set.
 	Edges              BuildingEdges `json:"edges"`
@@ -101,11 +105,11 @@ func (*Building) scanValues(columns []string) ([]any, error) {
 			values[i] = new([]byte)
 		case building.FieldTotalSquareFootage, building.FieldTotalRentableSquareFootage:
 			values[i] = new(sql.NullFloat64)
-		case building.FieldFloors, building.FieldYearBuilt:
+		case building.FieldFloors, building.FieldYearBuilt, building.FieldVersion:
 			values[i] = new(sql.NullInt64)
 		case building.FieldCreatedBy, building.FieldUpdatedBy, building.FieldSource, building.FieldCorrelationID, building.FieldAgentGoalID, building.FieldName, building.FieldBuildingType, building.FieldDescription, building.FieldStatus:
 			values[i] = new(sql.NullString)
-		case building.FieldCreatedAt, building.FieldUpdatedAt:
+		case building.FieldCreatedAt, building.FieldUpdatedAt, building.FieldDeletedAt:
 			values[i] = new(sql.NullTime)
 		case building.FieldID:
 			values[i] = new(uuid.UUID)
@@ -176,6 +180,13 @@ func (_m *Building) assignValues(columns []string, values []any) error {
 				_m.AgentGoalID = new(string)
 				*_m.AgentGoalID = value.String
 			}
+		case building.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				_m.DeletedAt = new(time.Time)
+				*_m.DeletedAt = value.Time
+			}
 		case building.FieldName:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field name", values[i])
@@ -237,6 +248,12 @@ func (_m *Building) assignValues(columns []string, values []any) error {
 				_m.TotalRentableSquareFootage = new(float64)
 				*_m.TotalRentableSquareFootage = value.Float64
 			}
+		case building.FieldVersion:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field version", values[i])
+			} else if value.Valid {
+				_m.Version = int(value.Int64)
+			}
 		case building.ForeignKeys[0]:
 			if value, ok := values[i].(*sql.NullScanner); !ok {
 				return fmt.Errorf("unexpected type %T for field property_buildings", values[i])
@@ -315,6 +332,11 @@ func (_m *Building) String() string {
 		builder.WriteString(*v)
 	}
 	builder.WriteString(", ")
+	if v := _m.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("name=")
 	builder.WriteString(_m.Name)
 	builder.WriteString(", ")
@@ -351,6 +373,9 @@ func (_m *Building) String() string {
 		builder.WriteString("total_rentable_square_footage=")
 		builder.WriteString(fmt.Sprintf("%v", *v))
 	}
+	builder.WriteString(", ")
+	builder.WriteString("version=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Version))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"time"
 
+	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/google/uuid"
@@ -30,6 +31,8 @@ const (
 	FieldCorrelationID = "correlation_id"
 	// FieldAgentGoalID holds the string denoting the agent_goal_id field in the database.
 	FieldAgentGoalID = "agent_goal_id"
+	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
+	FieldDeletedAt = "deleted_at"
 	// FieldName holds the string denoting the name field in the database.
 	FieldName = "name"
 	// FieldBuildingType holds the string denoting the building_type field in the database.
@@ -48,6 +51,8 @@ const (
 	FieldTotalSquareFootage = "total_square_footage"
 	// FieldTotalRentableSquareFootage holds the string denoting the total_rentable_square_footage field in the database.
 	FieldTotalRentableSquareFootage = "total_rentable_square_footage"
+	// FieldVersion holds the string denoting the version field in the database.
+	FieldVersion = "version"
 	// EdgeProperty holds the string denoting the property edge name in mutations.
 	EdgeProperty = "property"
 	// EdgeSpaces holds the string denoting the spaces edge name in mutations.
@@ -80,6 +85,7 @@ var Columns = []string{
 	FieldSource,
 	FieldCorrelationID,
 	FieldAgentGoalID,
+	FieldDeletedAt,
 	FieldName,
 	FieldBuildingType,
 	FieldAddress,
@@ -89,6 +95,7 @@ var Columns = []string{
 	FieldYearBuilt,
 	FieldTotalSquareFootage,
 	FieldTotalRentableSquareFootage,
+	FieldVersion,
 }
 
 // ForeignKeys holds the SQL foreign-keys that are owned by the "buildings"
@@ -112,7 +119,14 @@ func ValidColumn(column string) bool {
 	return false
 }
 
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "github.com/matthewbaird/ontology/ent/runtime"
 var (
+	Hooks        [1]ent.Hook
+	Interceptors [1]ent.Interceptor
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
@@ -125,6 +139,18 @@ var (
 	UpdatedByValidator func(string) error
 	// NameValidator is a validator for the "name" field. It is called by the builders before save.
 	NameValidator func(string) error
+	// FloorsValidator is a validator for the "floors" field. It is called by the builders before save.
+	FloorsValidator func(int) error
+	// YearBuiltValidator is a validator for the "year_built" field. It is called by the builders before save.
+	YearBuiltValidator func(int) error
+	// TotalSquareFootageValidator is a validator for the "total_square_footage" field. It is called by the builders before save.
+	TotalSquareFootageValidator func(float64) error
+	// TotalRentableSquareFootageValidator is a validator for the "total_rentable_square_footage" field. It is called by the builders before save.
+	TotalRentableSquareFootageValidator func(float64) error
+	// DefaultVersion holds the default value on creation for the "version" field.
+	DefaultVersion int
+	// VersionValidator is a validator for the "version" field. It is called by the builders before save.
+	VersionValidator func(int) error
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() uuid.UUID
 )
@@ -250,6 +276,11 @@ func ByAgentGoalID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldAgentGoalID, opts...).ToFunc()
 }
 
+// ByDeletedAt orders the results by the deleted_at field.
+func ByDeletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletedAt, opts...).ToFunc()
+}
+
 // ByName orders the results by the name field.
 func ByName(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldName, opts...).ToFunc()
@@ -290,6 +321,11 @@ func ByTotalRentableSquareFootage(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldTotalRentableSquareFootage, opts...).ToFunc()
 }
 
+// ByVersion orders the results by the version field.
+func ByVersion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVersion, opts...).ToFunc()
+}
+
 // ByPropertyField orders the results by property field.
 func ByPropertyField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -98,6 +98,20 @@ func (_c *BuildingCreate) SetNillableAgentGoalID(v *string) *BuildingCreate {
 	return _c
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (_c *BuildingCreate) SetDeletedAt(v time.Time) *BuildingCreate {
+	_c.mutation.SetDeletedAt(v)
+	return _c
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_c *BuildingCreate) SetNillableDeletedAt(v *time.Time) *BuildingCreate {
+	if v != nil {
+		_c.SetDeletedAt(*v)
+	}
+	return _c
+}
+
 // SetName sets the "name" field.
 func (_c *BuildingCreate) SetName(v string) *BuildingCreate {
 	_c.mutation.SetName(v)
@@ -192,6 +206,20 @@ func (_c *BuildingCreate) SetNillableTotalRentableSquareFootage(v *float64) *Bui
 	return _c
 }
 
+// SetVersion sets the "version" field.
+func (_c *BuildingCreate) SetVersion(v int) *BuildingCreate {
+	_c.mutation.SetVersion(v)
+	return _c
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (_c *BuildingCreate) SetNillableVersion(v *int) *BuildingCreate {
+	if v != nil {
+		_c.SetVersion(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *BuildingCreate) SetID(v uuid.UUID) *BuildingCreate {
 	_c.mutation.SetID(v)
@@ -239,7 +267,9 @@ func (_c *BuildingCreate) Mutation() *BuildingMutation {
 
 // Save creates the Building in the database.
 func (_c *BuildingCreate) Save(ctx context.Context) (*Building, error) {
-	_c.defaults()
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
 }
 
@@ -266,19 +296,33 @@ func (_c *BuildingCreate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (_c *BuildingCreate) defaults() {
+func (_c *BuildingCreate) defaults() error {
 	if _, ok := _c.mutation.CreatedAt(); !ok {
+		if building.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized building.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
 		v := building.DefaultCreatedAt()
 		_c.mutation.SetCreatedAt(v)
 	}
 	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		if building.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized building.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
 		v := building.DefaultUpdatedAt()
 		_c.mutation.SetUpdatedAt(v)
 	}
+	if _, ok := _c.mutation.Version(); !ok {
+		v := building.DefaultVersion
+		_c.mutation.SetVersion(v)
+	}
 	if _, ok := _c.mutation.ID(); !ok {
+		if building.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized building.DefaultID (forgotten import ent/runtime?)")
+		}
 		v := building.DefaultID()
 		_c.mutation.SetID(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -337,6 +381,34 @@ func (_c *BuildingCreate) check() error {
 			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Building.status": %w`, err)}
 		}
 	}
+	if v, ok := _c.mutation.Floors(); ok {
+		if err := building.FloorsValidator(v); err != nil {
+			return &ValidationError{Name: "floors", err: fmt.Errorf(`ent: validator failed for field "Building.floors": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.YearBuilt(); ok {
+		if err := building.YearBuiltValidator(v); err != nil {
+			return &ValidationError{Name: "year_built", err: fmt.Errorf(`ent: validator failed for field "Building.year_built": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.TotalSquareFootage(); ok {
+		if err := building.TotalSquareFootageValidator(v); err != nil {
+			return &ValidationError{Name: "total_square_footage", err: fmt.Errorf(`ent: validator failed for field "Building.total_square_footage": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.TotalRentableSquareFootage(); ok {
+		if err := building.TotalRentableSquareFootageValidator(v); err != nil {
+			return &ValidationError{Name: "total_rentable_square_footage", err: fmt.Errorf(`ent: validator failed for field "Building.total_rentable_square_footage": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Version(); !ok {
+		return &ValidationError{Name: "version", err: errors.New(`ent: missing required field "Building.version"`)}
+	}
+	if v, ok := _c.mutation.Version(); ok {
+		if err := building.VersionValidator(v); err != nil {
+			return &ValidationError{Name: "version", err: fmt.Errorf(`ent: validator failed for field "Building.version": %w`, err)}
+		}
+	}
 	if len(_c.mutation.PropertyIDs()) == 0 {
 		return &ValidationError{Name: "property", err: errors.New(`ent: missing required edge "Building.property"`)}
 	}
@@ -403,6 +475,10 @@ func (_c *BuildingCreate) createSpec() (*Building, *sqlgraph.CreateSpec) {
 		_spec.SetField(building.FieldAgentGoalID, field.TypeString, value)
 		_node.AgentGoalID = &value
 	}
+	if value, ok := _c.mutation.DeletedAt(); ok {
+		_spec.SetField(building.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
 	if value, ok := _c.mutation.Name(); ok {
 		_spec.SetField(building.FieldName, field.TypeString, value)
 		_node.Name = value
@@ -439,6 +515,10 @@ func (_c *BuildingCreate) createSpec() (*Building, *sqlgraph.CreateSpec) {
 		_spec.SetField(building.FieldTotalRentableSquareFootage, field.TypeFloat64, value)
 		_node.TotalRentableSquareFootage = &value
 	}
+	if value, ok := _c.mutation.Version(); ok {
+		_spec.SetField(building.FieldVersion, field.TypeInt, value)
+		_node.Version = value
+	}
 	if nodes := _c.mutation.PropertyIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -120,6 +120,26 @@ func (_u *BuildingUpdate) ClearAgentGoalID() *BuildingUpdate {
 	return _u
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *BuildingUpdate) SetDeletedAt(v time.Time) *BuildingUpdate {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *BuildingUpdate) SetNillableDeletedAt(v *time.Time) *BuildingUpdate {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *BuildingUpdate) ClearDeletedAt() *BuildingUpdate {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
 // SetName sets the "name" field.
 func (_u *BuildingUpdate) SetName(v string) *BuildingUpdate {
 	_u.mutation.SetName(v)
@@ -302,6 +322,27 @@ func (_u *BuildingUpdate) ClearTotalRentableSquareFootage() *BuildingUpdate {
 	return _u
 }
 
+// SetVersion sets the "version" field.
+func (_u *BuildingUpdate) SetVersion(v int) *BuildingUpdate {
+	_u.mutation.ResetVersion()
+	_u.mutation.SetVersion(v)
+	return _u
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (_u *BuildingUpdate) SetNillableVersion(v *int) *BuildingUpdate {
+	if v != nil {
+		_u.SetVersion(*v)
+	}
+	return _u
+}
+
+// AddVersion adds value to the "version" field.
+func (_u *BuildingUpdate) AddVersion(v int) *BuildingUpdate {
+	_u.mutation.AddVersion(v)
+	return _u
+}
+
 // SetPropertyID sets the "property" edge to the Property entity by ID.
 func (_u *BuildingUpdate) SetPropertyID(id uuid.UUID) *BuildingUpdate {
 	_u.mutation.SetPropertyID(id)
@@ -362,7 +403,9 @@ func (_u *BuildingUpdate) RemoveSpaces(v ...*Space) *BuildingUpdate {
 
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *BuildingUpdate) Save(ctx context.Context) (int, error) {
-	_u.defaults()
+	if err := _u.defaults(); err != nil {
+		return 0, err
+	}
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
 }
 
@@ -389,11 +432,15 @@ func (_u *BuildingUpdate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (_u *BuildingUpdate) defaults() {
+func (_u *BuildingUpdate) defaults() error {
 	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		if building.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized building.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
 		v := building.UpdateDefaultUpdatedAt()
 		_u.mutation.SetUpdatedAt(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -428,6 +475,31 @@ func (_u *BuildingUpdate) check() error {
 			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Building.status": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.Floors(); ok {
+		if err := building.FloorsValidator(v); err != nil {
+			return &ValidationError{Name: "floors", err: fmt.Errorf(`ent: validator failed for field "Building.floors": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.YearBuilt(); ok {
+		if err := building.YearBuiltValidator(v); err != nil {
+			return &ValidationError{Name: "year_built", err: fmt.Errorf(`ent: validator failed for field "Building.year_built": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.TotalSquareFootage(); ok {
+		if err := building.TotalSquareFootageValidator(v); err != nil {
+			return &ValidationError{Name: "total_square_footage", err: fmt.Errorf(`ent: validator failed for field "Building.total_square_footage": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.TotalRentableSquareFootage(); ok {
+		if err := building.TotalRentableSquareFootageValidator(v); err != nil {
+			return &ValidationError{Name: "total_rentable_square_footage", err: fmt.Errorf(`ent: validator failed for field "Building.total_rentable_square_footage": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Version(); ok {
+		if err := building.VersionValidator(v); err != nil {
+			return &ValidationError{Name: "version", err: fmt.Errorf(`ent: validator failed for field "Building.version": %w`, err)}
+		}
+	}
 	if _u.mutation.PropertyCleared() && len(_u.mutation.PropertyIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Building.property"`)
 	}
@@ -470,6 +542,12 @@ func (_u *BuildingUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.AgentGoalIDCleared() {
 		_spec.ClearField(building.FieldAgentGoalID, field.TypeString)
 	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(building.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(building.FieldDeletedAt, field.TypeTime)
+	}
 	if value, ok := _u.mutation.Name(); ok {
 		_spec.SetField(building.FieldName, field.TypeString, value)
 	}
@@ -527,6 +605,12 @@ func (_u *BuildingUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.TotalRentableSquareFootageCleared() {
 		_spec.ClearField(building.FieldTotalRentableSquareFootage, field.TypeFloat64)
 	}
+	if value, ok := _u.mutation.Version(); ok {
+		_spec.SetField(building.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedVersion(); ok {
+		_spec.AddField(building.FieldVersion, field.TypeInt, value)
+	}
 	if _u.mutation.PropertyCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -709,6 +793,26 @@ func (_u *BuildingUpdateOne) ClearAgentGoalID() *BuildingUpdateOne {
 	return _u
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *BuildingUpdateOne) SetDeletedAt(v time.Time) *BuildingUpdateOne {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *BuildingUpdateOne) SetNillableDeletedAt(v *time.Time) *BuildingUpdateOne {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *BuildingUpdateOne) ClearDeletedAt() *BuildingUpdateOne {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
 // SetName sets the "name" field.
 func (_u *BuildingUpdateOne) SetName(v string) *BuildingUpdateOne {
 	_u.mutation.SetName(v)
@@ -891,6 +995,27 @@ func (_u *BuildingUpdateOne) ClearTotalRentableSquareFootage() *BuildingUpdateOn
 	return _u
 }
 
+// SetVersion sets the "version" field.
+func (_u *BuildingUpdateOne) SetVersion(v int) *BuildingUpdateOne {
+	_u.mutation.ResetVersion()
+	_u.mutation.SetVersion(v)
+	return _u
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (_u *BuildingUpdateOne) SetNillableVersion(v *int) *BuildingUpdateOne {
+	if v != nil {
+		_u.SetVersion(*v)
+	}
+	return _u
+}
+
+// AddVersion adds value to the "version" field.
+func (_u *BuildingUpdateOne) AddVersion(v int) *BuildingUpdateOne {
+	_u.mutation.AddVersion(v)
+	return _u
+}
+
 // SetPropertyID sets the "property" edge to the Property entity by ID.
 func (_u *BuildingUpdateOne) SetPropertyID(id uuid.UUID) *BuildingUpdateOne {
 	_u.mutation.SetPropertyID(id)
@@ -964,7 +1089,9 @@ func (_u *BuildingUpdateOne) Select(field string, fields ...string) *BuildingUpd
 
 // Save executes the query and returns the updated Building entity.
 func (_u *BuildingUpdateOne) Save(ctx context.Context) (*Building, error) {
-	_u.defaults()
+	if err := _u.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
 }
 
@@ -991,11 +1118,15 @@ func (_u *BuildingUpdateOne) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (_u *BuildingUpdateOne) defaults() {
+func (_u *BuildingUpdateOne) defaults() error {
 	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		if building.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized building.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
 		v := building.UpdateDefaultUpdatedAt()
 		_u.mutation.SetUpdatedAt(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -1030,6 +1161,31 @@ func (_u *BuildingUpdateOne) check() error {
 			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Building.status": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.Floors(); ok {
+		if err := building.FloorsValidator(v); err != nil {
+			return &ValidationError{Name: "floors", err: fmt.Errorf(`ent: validator failed for field "Building.floors": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.YearBuilt(); ok {
+		if err := building.YearBuiltValidator(v); err != nil {
+			return &ValidationError{Name: "year_built", err: fmt.Errorf(`ent: validator failed for field "Building.year_built": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.TotalSquareFootage(); ok {
+		if err := building.TotalSquareFootageValidator(v); err != nil {
+			return &ValidationError{Name: "total_square_footage", err: fmt.Errorf(`ent: validator failed for field "Building.total_square_footage": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.TotalRentableSquareFootage(); ok {
+		if err := building.TotalRentableSquareFootageValidator(v); err != nil {
+			return &ValidationError{Name: "total_rentable_square_footage", err: fmt.Errorf(`ent: validator failed for field "Building.total_rentable_square_footage": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Version(); ok {
+		if err := building.VersionValidator(v); err != nil {
+			return &ValidationError{Name: "version", err: fmt.Errorf(`ent: validator failed for field "Building.version": %w`, err)}
+		}
+	}
 	if _u.mutation.PropertyCleared() && len(_u.mutation.PropertyIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Building.property"`)
 	}
@@ -1089,6 +1245,12 @@ func (_u *BuildingUpdateOne) sqlSave(ctx context.Context) (_node *Building, err
 	if _u.mutation.AgentGoalIDCleared() {
 		_spec.ClearField(building.FieldAgentGoalID, field.TypeString)
 	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(building.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(building.FieldDeletedAt, field.TypeTime)
+	}
 	if value, ok := _u.mutation.Name(); ok {
 		_spec.SetField(building.FieldName, field.TypeString, value)
 	}
@@ -1146,6 +1308,12 @@ func (_u *BuildingUpdateOne) sqlSave(ctx context.Context) (_node *Building, err
 	if _u.mutation.TotalRentableSquareFootageCleared() {
 		_spec.ClearField(building.FieldTotalRentableSquareFootage, field.TypeFloat64)
 	}
+	if value, ok := _u.mutation.Version(); ok {
+		_spec.SetField(building.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedVersion(); ok {
+		_spec.AddField(building.FieldVersion, field.TypeInt, value)
+	}
 	if _u.mutation.PropertyCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
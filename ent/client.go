@@ -1242,12 +1242,14 @@ func (c *BuildingClient) QuerySpaces(_m *Building) *SpaceQuery {
 
 // Hooks returns the client hooks.
 func (c *BuildingClient) Hooks() []Hook {
-	return c.hooks.Building
+	hooks := c.hooks.Building
+	return append(hooks[:len(hooks):len(hooks)], building.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
 func (c *BuildingClient) Interceptors() []Interceptor {
-	return c.inters.Building
+	inters := c.inters.Building
+	return append(inters[:len(inters):len(inters)], building.Interceptors[:]...)
 }
 
 func (c *BuildingClient) mutate(ctx context.Context, m *BuildingMutation) (Value, error) {
@@ -1,3 +1,3 @@
 // Package ent is the entrypoint for ent code generation.
-//go:generate go run -mod=mod entgo.io/ent/cmd/ent generate ./schema
+//go:generate go run -mod=mod entgo.io/ent/cmd/ent generate --feature intercept ./schema
 package ent
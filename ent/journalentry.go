@@ -61,7 +61,7 @@ type JournalEntry struct {
 	ReversesJournalID *string `json:"reverses_journal_id,omitempty"`
 	// ReversedByJournalID holds the value of the "reversed_by_journal_id" field.
 	ReversedByJournalID *string `json:"reversed_by_journal_id,omitempty"`
-	// Lines holds the value of the "lines" field.
+	// Line items — minimum 2 lines for double-entry
 	Lines []types.JournalLine `json:"lines,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the JournalEntryQuery when eager-loading is set.
@@ -143,6 +143,14 @@ func (_c *JurisdictionCreate) SetCountryCode(v string) *JurisdictionCreate {
 	return _c
 }
 
+// SetNillableCountryCode sets the "country_code" field if the given value is not nil.
+func (_c *JurisdictionCreate) SetNillableCountryCode(v *string) *JurisdictionCreate {
+	if v != nil {
+		_c.SetCountryCode(*v)
+	}
+	return _c
+}
+
 // SetStatus sets the "status" field.
 func (_c *JurisdictionCreate) SetStatus(v jurisdiction.Status) *JurisdictionCreate {
 	_c.mutation.SetStatus(v)
@@ -340,6 +348,10 @@ func (_c *JurisdictionCreate) defaults() {
 		v := jurisdiction.DefaultUpdatedAt()
 		_c.mutation.SetUpdatedAt(v)
 	}
+	if _, ok := _c.mutation.CountryCode(); !ok {
+		v := jurisdiction.DefaultCountryCode
+		_c.mutation.SetCountryCode(v)
+	}
 	if _, ok := _c.mutation.ID(); !ok {
 		v := jurisdiction.DefaultID()
 		_c.mutation.SetID(v)
@@ -47,7 +47,7 @@ type JurisdictionRule struct {
 	AppliesToSpaceTypes []string `json:"applies_to_space_types,omitempty"`
 	// Exemptions holds the value of the "exemptions" field.
 	Exemptions json.RawMessage `json:"exemptions,omitempty"`
-	// RuleDefinition holds the value of the "rule_definition" field.
+	// Typed rule definition — schema varies by rule_type Stored as JSON; validated at application layer per rule_type
 	RuleDefinition json.RawMessage `json:"rule_definition,omitempty"`
 	// StatuteReference holds the value of the "statute_reference" field.
 	StatuteReference *string `json:"statute_reference,omitempty"`
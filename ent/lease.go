@@ -66,7 +66,7 @@ type Lease struct {
 	SecurityDepositCurrency string `json:"security_deposit_currency,omitempty"`
 	// RentSchedule holds the value of the "rent_schedule" field.
 	RentSchedule []types.RentScheduleEntry `json:"rent_schedule,omitempty"`
-	// RecurringCharges holds the value of the "recurring_charges" field.
+	// Recurring charges beyond base rent
 	RecurringCharges []types.RecurringCharge `json:"recurring_charges,omitempty"`
 	// LateFeePolicy holds the value of the "late_fee_policy" field.
 	LateFeePolicy *types.LateFeePolicy `json:"late_fee_policy,omitempty"`
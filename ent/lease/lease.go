@@ -275,6 +275,10 @@ var (
 	DefaultSecurityDepositCurrency string
 	// SecurityDepositCurrencyValidator is a validator for the "security_deposit_currency" field. It is called by the builders before save.
 	SecurityDepositCurrencyValidator func(string) error
+	// DefaultNoticeRequiredDays holds the default value on creation for the "notice_required_days" field.
+	DefaultNoticeRequiredDays int
+	// NoticeRequiredDaysValidator is a validator for the "notice_required_days" field. It is called by the builders before save.
+	NoticeRequiredDaysValidator func(int) error
 	// DefaultCleaningFeeCurrency holds the default value on creation for the "cleaning_fee_currency" field.
 	DefaultCleaningFeeCurrency string
 	// CleaningFeeCurrencyValidator is a validator for the "cleaning_fee_currency" field. It is called by the builders before save.
@@ -346,6 +346,14 @@ func (_c *LeaseCreate) SetNoticeRequiredDays(v int) *LeaseCreate {
 	return _c
 }
 
+// SetNillableNoticeRequiredDays sets the "notice_required_days" field if the given value is not nil.
+func (_c *LeaseCreate) SetNillableNoticeRequiredDays(v *int) *LeaseCreate {
+	if v != nil {
+		_c.SetNoticeRequiredDays(*v)
+	}
+	return _c
+}
+
 // SetCheckInTime sets the "check_in_time" field.
 func (_c *LeaseCreate) SetCheckInTime(v string) *LeaseCreate {
 	_c.mutation.SetCheckInTime(v)
@@ -690,6 +698,10 @@ func (_c *LeaseCreate) defaults() error {
 		v := lease.DefaultSecurityDepositCurrency
 		_c.mutation.SetSecurityDepositCurrency(v)
 	}
+	if _, ok := _c.mutation.NoticeRequiredDays(); !ok {
+		v := lease.DefaultNoticeRequiredDays
+		_c.mutation.SetNoticeRequiredDays(v)
+	}
 	if _, ok := _c.mutation.CleaningFeeCurrency(); !ok {
 		v := lease.DefaultCleaningFeeCurrency
 		_c.mutation.SetCleaningFeeCurrency(v)
@@ -802,6 +814,11 @@ func (_c *LeaseCreate) check() error {
 	if _, ok := _c.mutation.NoticeRequiredDays(); !ok {
 		return &ValidationError{Name: "notice_required_days", err: errors.New(`ent: missing required field "Lease.notice_required_days"`)}
 	}
+	if v, ok := _c.mutation.NoticeRequiredDays(); ok {
+		if err := lease.NoticeRequiredDaysValidator(v); err != nil {
+			return &ValidationError{Name: "notice_required_days", err: fmt.Errorf(`ent: validator failed for field "Lease.notice_required_days": %w`, err)}
+		}
+	}
 	if v, ok := _c.mutation.CleaningFeeCurrency(); ok {
 		if err := lease.CleaningFeeCurrencyValidator(v); err != nil {
 			return &ValidationError{Name: "cleaning_fee_currency", err: fmt.Errorf(`ent: validator failed for field "Lease.cleaning_fee_currency": %w`, err)}
@@ -1128,6 +1128,11 @@ func (_u *LeaseUpdate) check() error {
 			return &ValidationError{Name: "security_deposit_currency", err: fmt.Errorf(`ent: validator failed for field "Lease.security_deposit_currency": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.NoticeRequiredDays(); ok {
+		if err := lease.NoticeRequiredDaysValidator(v); err != nil {
+			return &ValidationError{Name: "notice_required_days", err: fmt.Errorf(`ent: validator failed for field "Lease.notice_required_days": %w`, err)}
+		}
+	}
 	if v, ok := _u.mutation.CleaningFeeCurrency(); ok {
 		if err := lease.CleaningFeeCurrencyValidator(v); err != nil {
 			return &ValidationError{Name: "cleaning_fee_currency", err: fmt.Errorf(`ent: validator failed for field "Lease.cleaning_fee_currency": %w`, err)}
@@ -2849,6 +2854,11 @@ func (_u *LeaseUpdateOne) check() error {
 			return &ValidationError{Name: "security_deposit_currency", err: fmt.Errorf(`ent: validator failed for field "Lease.security_deposit_currency": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.NoticeRequiredDays(); ok {
+		if err := lease.NoticeRequiredDaysValidator(v); err != nil {
+			return &ValidationError{Name: "notice_required_days", err: fmt.Errorf(`ent: validator failed for field "Lease.notice_required_days": %w`, err)}
+		}
+	}
 	if v, ok := _u.mutation.CleaningFeeCurrency(); ok {
 		if err := lease.CleaningFeeCurrencyValidator(v); err != nil {
 			return &ValidationError{Name: "cleaning_fee_currency", err: fmt.Errorf(`ent: validator failed for field "Lease.cleaning_fee_currency": %w`, err)}
@@ -113,6 +113,8 @@ var (
 	UpdatedByValidator func(string) error
 	// DefaultIsPrimary holds the default value on creation for the "is_primary" field.
 	DefaultIsPrimary bool
+	// SquareFootageLeasedValidator is a validator for the "square_footage_leased" field. It is called by the builders before save.
+	SquareFootageLeasedValidator func(float64) error
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() uuid.UUID
 )
@@ -273,6 +273,11 @@ func (_c *LeaseSpaceCreate) check() error {
 	if _, ok := _c.mutation.Effective(); !ok {
 		return &ValidationError{Name: "effective", err: errors.New(`ent: missing required field "LeaseSpace.effective"`)}
 	}
+	if v, ok := _c.mutation.SquareFootageLeased(); ok {
+		if err := leasespace.SquareFootageLeasedValidator(v); err != nil {
+			return &ValidationError{Name: "square_footage_leased", err: fmt.Errorf(`ent: validator failed for field "LeaseSpace.square_footage_leased": %w`, err)}
+		}
+	}
 	if len(_c.mutation.LeaseIDs()) == 0 {
 		return &ValidationError{Name: "lease", err: errors.New(`ent: missing required edge "LeaseSpace.lease"`)}
 	}
@@ -278,6 +278,11 @@ func (_u *LeaseSpaceUpdate) check() error {
 			return &ValidationError{Name: "relationship", err: fmt.Errorf(`ent: validator failed for field "LeaseSpace.relationship": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.SquareFootageLeased(); ok {
+		if err := leasespace.SquareFootageLeasedValidator(v); err != nil {
+			return &ValidationError{Name: "square_footage_leased", err: fmt.Errorf(`ent: validator failed for field "LeaseSpace.square_footage_leased": %w`, err)}
+		}
+	}
 	if _u.mutation.LeaseCleared() && len(_u.mutation.LeaseIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "LeaseSpace.lease"`)
 	}
@@ -678,6 +683,11 @@ func (_u *LeaseSpaceUpdateOne) check() error {
 			return &ValidationError{Name: "relationship", err: fmt.Errorf(`ent: validator failed for field "LeaseSpace.relationship": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.SquareFootageLeased(); ok {
+		if err := leasespace.SquareFootageLeasedValidator(v); err != nil {
+			return &ValidationError{Name: "square_footage_leased", err: fmt.Errorf(`ent: validator failed for field "LeaseSpace.square_footage_leased": %w`, err)}
+		}
+	}
 	if _u.mutation.LeaseCleared() && len(_u.mutation.LeaseIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "LeaseSpace.lease"`)
 	}
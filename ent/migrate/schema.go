@@ -18,9 +18,9 @@ var (
 		{Name: "source", Type: field.TypeEnum, Enums: []string{"user", "agent", "import", "system", "migration"}},
 		{Name: "correlation_id", Type: field.TypeString, Nullable: true},
 		{Name: "agent_goal_id", Type: field.TypeString, Nullable: true},
-		{Name: "account_number", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar"}},
+		{Name: "account_number", Type: field.TypeString, Unique: true, SchemaType: map[string]string{"postgres": "varchar"}},
 		{Name: "name", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar"}},
-		{Name: "description", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "varchar"}},
+		{Name: "description", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "text"}},
 		{Name: "account_type", Type: field.TypeEnum, Enums: []string{"asset", "liability", "equity", "revenue", "expense"}},
 		{Name: "account_subtype", Type: field.TypeEnum, Enums: []string{"cash", "accounts_receivable", "prepaid", "fixed_asset", "accumulated_depreciation", "other_asset", "accounts_payable", "accrued_liability", "unearned_revenue", "security_deposits_held", "other_liability", "owners_equity", "retained_earnings", "distributions", "rental_income", "other_income", "cam_recovery", "percentage_rent_income", "operating_expense", "maintenance_expense", "utility_expense", "management_fee_expense", "depreciation_expense", "other_expense"}},
 		{Name: "parent_account_id", Type: field.TypeUUID, Nullable: true},
@@ -51,6 +51,18 @@ var (
 				OnDelete:   schema.SetNull,
 			},
 		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "account_parent_account_id",
+				Unique:  false,
+				Columns: []*schema.Column{AccountsColumns[13]},
+			},
+			{
+				Name:    "account_status",
+				Unique:  false,
+				Columns: []*schema.Column{AccountsColumns[20]},
+			},
+		},
 	}
 	// ApplicationsColumns holds the columns for the "applications" table.
 	ApplicationsColumns = []*schema.Column{
@@ -73,7 +85,7 @@ var (
 		{Name: "income_to_rent_ratio", Type: field.TypeFloat64, Nullable: true},
 		{Name: "decision_by", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "varchar"}},
 		{Name: "decision_at", Type: field.TypeTime, Nullable: true},
-		{Name: "decision_reason", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "varchar"}},
+		{Name: "decision_reason", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "text"}},
 		{Name: "conditions", Type: field.TypeJSON, Nullable: true},
 		{Name: "application_fee_amount_cents", Type: field.TypeInt64},
 		{Name: "application_fee_currency", Type: field.TypeString, Default: "USD"},
@@ -121,6 +133,28 @@ var (
 				OnDelete:   schema.SetNull,
 			},
 		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "application_property_applications",
+				Unique:  false,
+				Columns: []*schema.Column{ApplicationsColumns[27]},
+			},
+			{
+				Name:    "application_space_applications",
+				Unique:  false,
+				Columns: []*schema.Column{ApplicationsColumns[28]},
+			},
+			{
+				Name:    "application_applicant_person_id",
+				Unique:  false,
+				Columns: []*schema.Column{ApplicationsColumns[24]},
+			},
+			{
+				Name:    "application_status",
+				Unique:  false,
+				Columns: []*schema.Column{ApplicationsColumns[8]},
+			},
+		},
 	}
 	// BankAccountsColumns holds the columns for the "bank_accounts" table.
 	BankAccountsColumns = []*schema.Column{
@@ -178,6 +212,18 @@ var (
 				OnDelete:   schema.SetNull,
 			},
 		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "bankaccount_bank_account_gl_account",
+				Unique:  false,
+				Columns: []*schema.Column{BankAccountsColumns[26]},
+			},
+			{
+				Name:    "bankaccount_status",
+				Unique:  false,
+				Columns: []*schema.Column{BankAccountsColumns[18]},
+			},
+		},
 	}
 	// BaseEntitiesColumns holds the columns for the "base_entities" table.
 	BaseEntitiesColumns = []*schema.Column{
@@ -206,15 +252,17 @@ var (
 		{Name: "source", Type: field.TypeEnum, Enums: []string{"user", "agent", "import", "system", "migration"}},
 		{Name: "correlation_id", Type: field.TypeString, Nullable: true},
 		{Name: "agent_goal_id", Type: field.TypeString, Nullable: true},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
 		{Name: "name", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar"}},
 		{Name: "building_type", Type: field.TypeEnum, Enums: []string{"residential", "commercial", "mixed_use", "parking_structure", "industrial", "storage", "auxiliary"}},
 		{Name: "address", Type: field.TypeJSON, Nullable: true},
-		{Name: "description", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "varchar"}},
+		{Name: "description", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "text"}},
 		{Name: "status", Type: field.TypeEnum, Enums: []string{"active", "inactive", "under_renovation"}},
 		{Name: "floors", Type: field.TypeInt, Nullable: true},
 		{Name: "year_built", Type: field.TypeInt, Nullable: true},
 		{Name: "total_square_footage", Type: field.TypeFloat64, Nullable: true},
 		{Name: "total_rentable_square_footage", Type: field.TypeFloat64, Nullable: true},
+		{Name: "version", Type: field.TypeInt, Default: 1},
 		{Name: "property_buildings", Type: field.TypeUUID},
 	}
 	// BuildingsTable holds the schema information for the "buildings" table.
@@ -225,9 +273,21 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "buildings_properties_buildings",
-				Columns:    []*schema.Column{BuildingsColumns[17]},
+				Columns:    []*schema.Column{BuildingsColumns[19]},
 				RefColumns: []*schema.Column{PropertiesColumns[0]},
-				OnDelete:   schema.NoAction,
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "building_property_buildings",
+				Unique:  false,
+				Columns: []*schema.Column{BuildingsColumns[19]},
+			},
+			{
+				Name:    "building_status",
+				Unique:  false,
+				Columns: []*schema.Column{BuildingsColumns[13]},
 			},
 		},
 	}
@@ -260,7 +320,7 @@ var (
 		{Name: "agent_goal_id", Type: field.TypeString, Nullable: true},
 		{Name: "entry_date", Type: field.TypeTime},
 		{Name: "posted_date", Type: field.TypeTime},
-		{Name: "description", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar"}},
+		{Name: "description", Type: field.TypeString, SchemaType: map[string]string{"postgres": "text"}},
 		{Name: "source_type", Type: field.TypeEnum, Enums: []string{"manual", "auto_charge", "payment", "bank_import", "cam_reconciliation", "depreciation", "accrual", "intercompany", "management_fee", "system"}},
 		{Name: "source_id", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "varchar"}},
 		{Name: "status", Type: field.TypeEnum, Enums: []string{"draft", "pending_approval", "posted", "voided"}},
@@ -278,6 +338,13 @@ var (
 		Name:       "journal_entries",
 		Columns:    JournalEntriesColumns,
 		PrimaryKey: []*schema.Column{JournalEntriesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "journalentry_status",
+				Unique:  false,
+				Columns: []*schema.Column{JournalEntriesColumns[13]},
+			},
+		},
 	}
 	// JurisdictionsColumns holds the columns for the "jurisdictions" table.
 	JurisdictionsColumns = []*schema.Column{
@@ -293,7 +360,7 @@ var (
 		{Name: "jurisdiction_type", Type: field.TypeEnum, Enums: []string{"federal", "state", "county", "city", "special_district", "unincorporated_area"}},
 		{Name: "fips_code", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "varchar"}},
 		{Name: "state_code", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "varchar"}},
-		{Name: "country_code", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar"}},
+		{Name: "country_code", Type: field.TypeString, Default: "US", SchemaType: map[string]string{"postgres": "varchar"}},
 		{Name: "status", Type: field.TypeEnum, Enums: []string{"active", "dissolved", "merged", "pending"}},
 		{Name: "successor_jurisdiction_id", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "varchar"}},
 		{Name: "effective_date", Type: field.TypeTime, Nullable: true},
@@ -315,6 +382,18 @@ var (
 				OnDelete:   schema.SetNull,
 			},
 		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "jurisdiction_jurisdiction_children",
+				Unique:  false,
+				Columns: []*schema.Column{JurisdictionsColumns[19]},
+			},
+			{
+				Name:    "jurisdiction_status",
+				Unique:  false,
+				Columns: []*schema.Column{JurisdictionsColumns[13]},
+			},
+		},
 	}
 	// JurisdictionRulesColumns holds the columns for the "jurisdiction_rules" table.
 	JurisdictionRulesColumns = []*schema.Column{
@@ -363,6 +442,18 @@ var (
 				OnDelete:   schema.SetNull,
 			},
 		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "jurisdictionrule_jurisdiction_rules",
+				Unique:  false,
+				Columns: []*schema.Column{JurisdictionRulesColumns[23]},
+			},
+			{
+				Name:    "jurisdictionrule_status",
+				Unique:  false,
+				Columns: []*schema.Column{JurisdictionRulesColumns[9]},
+			},
+		},
 	}
 	// LeasesColumns holds the columns for the "leases" table.
 	LeasesColumns = []*schema.Column{
@@ -379,7 +470,7 @@ var (
 		{Name: "guarantor_role_ids", Type: field.TypeJSON, Nullable: true},
 		{Name: "lease_type", Type: field.TypeEnum, Enums: []string{"fixed_term", "month_to_month", "commercial_nnn", "commercial_nn", "commercial_n", "commercial_gross", "commercial_modified_gross", "affordable", "section_8", "student", "ground_lease", "short_term", "membership"}},
 		{Name: "status", Type: field.TypeEnum, Enums: []string{"draft", "pending_approval", "pending_signature", "active", "expired", "month_to_month_holdover", "renewed", "terminated", "eviction"}},
-		{Name: "description", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "varchar"}},
+		{Name: "description", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "text"}},
 		{Name: "liability_type", Type: field.TypeEnum, Enums: []string{"joint_and_several", "individual", "by_the_bed", "proportional"}, Default: "joint_and_several"},
 		{Name: "term", Type: field.TypeJSON},
 		{Name: "lease_commencement_date", Type: field.TypeTime, Nullable: true},
@@ -402,7 +493,7 @@ var (
 		{Name: "move_in_date", Type: field.TypeTime, Nullable: true},
 		{Name: "move_out_date", Type: field.TypeTime, Nullable: true},
 		{Name: "notice_date", Type: field.TypeTime, Nullable: true},
-		{Name: "notice_required_days", Type: field.TypeInt},
+		{Name: "notice_required_days", Type: field.TypeInt, Default: 30},
 		{Name: "check_in_time", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "varchar"}},
 		{Name: "check_out_time", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "varchar"}},
 		{Name: "cleaning_fee_amount_cents", Type: field.TypeInt64, Nullable: true},
@@ -429,6 +520,18 @@ var (
 				OnDelete:   schema.SetNull,
 			},
 		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "lease_lease_subleases",
+				Unique:  false,
+				Columns: []*schema.Column{LeasesColumns[48]},
+			},
+			{
+				Name:    "lease_status",
+				Unique:  false,
+				Columns: []*schema.Column{LeasesColumns[12]},
+			},
+		},
 	}
 	// LeaseSpacesColumns holds the columns for the "lease_spaces" table.
 	LeaseSpacesColumns = []*schema.Column{
@@ -480,6 +583,18 @@ var (
 				OnDelete:   schema.SetNull,
 			},
 		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "leasespace_lease_space_lease",
+				Unique:  false,
+				Columns: []*schema.Column{LeaseSpacesColumns[13]},
+			},
+			{
+				Name:    "leasespace_lease_space_space",
+				Unique:  false,
+				Columns: []*schema.Column{LeaseSpacesColumns[14]},
+			},
+		},
 	}
 	// LedgerEntriesColumns holds the columns for the "ledger_entries" table.
 	LedgerEntriesColumns = []*schema.Column{
@@ -496,9 +611,9 @@ var (
 		{Name: "amount_currency", Type: field.TypeString, Default: "USD"},
 		{Name: "effective_date", Type: field.TypeTime},
 		{Name: "posted_date", Type: field.TypeTime},
-		{Name: "description", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar"}},
+		{Name: "description", Type: field.TypeString, SchemaType: map[string]string{"postgres": "text"}},
 		{Name: "charge_code", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar"}},
-		{Name: "memo", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "varchar"}},
+		{Name: "memo", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "text"}},
 		{Name: "bank_account_id", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "varchar"}},
 		{Name: "bank_transaction_id", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "varchar"}},
 		{Name: "reconciled", Type: field.TypeBool, Default: false},
@@ -590,6 +705,38 @@ var (
 				OnDelete:   schema.SetNull,
 			},
 		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "ledgerentry_lease_ledger_entries",
+				Unique:  false,
+				Columns: []*schema.Column{LedgerEntriesColumns[24]},
+			},
+			{
+				Name:    "ledgerentry_ledger_entry_journal_entry",
+				Unique:  false,
+				Columns: []*schema.Column{LedgerEntriesColumns[25]},
+			},
+			{
+				Name:    "ledgerentry_ledger_entry_account",
+				Unique:  false,
+				Columns: []*schema.Column{LedgerEntriesColumns[26]},
+			},
+			{
+				Name:    "ledgerentry_ledger_entry_property",
+				Unique:  false,
+				Columns: []*schema.Column{LedgerEntriesColumns[27]},
+			},
+			{
+				Name:    "ledgerentry_ledger_entry_space",
+				Unique:  false,
+				Columns: []*schema.Column{LedgerEntriesColumns[28]},
+			},
+			{
+				Name:    "ledgerentry_ledger_entry_person",
+				Unique:  false,
+				Columns: []*schema.Column{LedgerEntriesColumns[29]},
+			},
+		},
 	}
 	// OrganizationsColumns holds the columns for the "organizations" table.
 	OrganizationsColumns = []*schema.Column{
@@ -629,6 +776,13 @@ var (
 				OnDelete:   schema.SetNull,
 			},
 		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "organization_status",
+				Unique:  false,
+				Columns: []*schema.Column{OrganizationsColumns[13]},
+			},
+		},
 	}
 	// PersonsColumns holds the columns for the "persons" table.
 	PersonsColumns = []*schema.Column{
@@ -649,7 +803,7 @@ var (
 		{Name: "ssn_last_four", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "varchar"}},
 		{Name: "contact_methods", Type: field.TypeJSON},
 		{Name: "preferred_contact", Type: field.TypeEnum, Enums: []string{"email", "sms", "phone", "mail", "portal"}, Default: "email"},
-		{Name: "language_preference", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar"}},
+		{Name: "language_preference", Type: field.TypeString, Default: "en", SchemaType: map[string]string{"postgres": "varchar"}},
 		{Name: "timezone", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "varchar"}},
 		{Name: "do_not_contact", Type: field.TypeBool, Default: false},
 		{Name: "identity_verified", Type: field.TypeBool, Default: false},
@@ -694,6 +848,18 @@ var (
 				OnDelete:   schema.NoAction,
 			},
 		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "personrole_person_roles",
+				Unique:  false,
+				Columns: []*schema.Column{PersonRolesColumns[14]},
+			},
+			{
+				Name:    "personrole_status",
+				Unique:  false,
+				Columns: []*schema.Column{PersonRolesColumns[11]},
+			},
+		},
 	}
 	// PortfoliosColumns holds the columns for the "portfolios" table.
 	PortfoliosColumns = []*schema.Column{
@@ -707,7 +873,7 @@ var (
 		{Name: "agent_goal_id", Type: field.TypeString, Nullable: true},
 		{Name: "name", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar"}},
 		{Name: "management_type", Type: field.TypeEnum, Enums: []string{"self_managed", "third_party", "hybrid"}},
-		{Name: "description", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "varchar"}},
+		{Name: "description", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "text"}},
 		{Name: "status", Type: field.TypeEnum, Enums: []string{"active", "inactive", "onboarding", "offboarding"}},
 		{Name: "default_chart_of_accounts_id", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "varchar"}},
 		{Name: "default_bank_account_id", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "varchar"}},
@@ -733,6 +899,18 @@ var (
 				OnDelete:   schema.NoAction,
 			},
 		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "portfolio_portfolio_owner",
+				Unique:  false,
+				Columns: []*schema.Column{PortfoliosColumns[15]},
+			},
+			{
+				Name:    "portfolio_status",
+				Unique:  false,
+				Columns: []*schema.Column{PortfoliosColumns[11]},
+			},
+		},
 	}
 	// PropertiesColumns holds the columns for the "properties" table.
 	PropertiesColumns = []*schema.Column{
@@ -790,6 +968,23 @@ var (
 				OnDelete:   schema.SetNull,
 			},
 		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "property_portfolio_properties",
+				Unique:  false,
+				Columns: []*schema.Column{PropertiesColumns[26]},
+			},
+			{
+				Name:    "property_property_bank_account",
+				Unique:  false,
+				Columns: []*schema.Column{PropertiesColumns[27]},
+			},
+			{
+				Name:    "property_status",
+				Unique:  false,
+				Columns: []*schema.Column{PropertiesColumns[11]},
+			},
+		},
 	}
 	// PropertyJurisdictionsColumns holds the columns for the "property_jurisdictions" table.
 	PropertyJurisdictionsColumns = []*schema.Column{
@@ -843,6 +1038,18 @@ var (
 				OnDelete:   schema.NoAction,
 			},
 		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "propertyjurisdiction_property_jurisdiction_property",
+				Unique:  false,
+				Columns: []*schema.Column{PropertyJurisdictionsColumns[16]},
+			},
+			{
+				Name:    "propertyjurisdiction_property_jurisdiction_jurisdiction",
+				Unique:  false,
+				Columns: []*schema.Column{PropertyJurisdictionsColumns[17]},
+			},
+		},
 	}
 	// ReconciliationsColumns holds the columns for the "reconciliations" table.
 	ReconciliationsColumns = []*schema.Column{
@@ -891,6 +1098,18 @@ var (
 				OnDelete:   schema.NoAction,
 			},
 		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "reconciliation_reconciliation_bank_account",
+				Unique:  false,
+				Columns: []*schema.Column{ReconciliationsColumns[24]},
+			},
+			{
+				Name:    "reconciliation_status",
+				Unique:  false,
+				Columns: []*schema.Column{ReconciliationsColumns[17]},
+			},
+		},
 	}
 	// SpacesColumns holds the columns for the "spaces" table.
 	SpacesColumns = []*schema.Column{
@@ -941,7 +1160,7 @@ var (
 				Symbol:     "spaces_properties_spaces",
 				Columns:    []*schema.Column{SpacesColumns[28]},
 				RefColumns: []*schema.Column{PropertiesColumns[0]},
-				OnDelete:   schema.NoAction,
+				OnDelete:   schema.Cascade,
 			},
 			{
 				Symbol:     "spaces_spaces_children",
@@ -950,6 +1169,33 @@ var (
 				OnDelete:   schema.SetNull,
 			},
 		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "space_space_number_property_spaces",
+				Unique:  true,
+				Columns: []*schema.Column{SpacesColumns[8], SpacesColumns[28]},
+			},
+			{
+				Name:    "space_property_spaces",
+				Unique:  false,
+				Columns: []*schema.Column{SpacesColumns[28]},
+			},
+			{
+				Name:    "space_building_spaces",
+				Unique:  false,
+				Columns: []*schema.Column{SpacesColumns[27]},
+			},
+			{
+				Name:    "space_space_children",
+				Unique:  false,
+				Columns: []*schema.Column{SpacesColumns[29]},
+			},
+			{
+				Name:    "space_status",
+				Unique:  false,
+				Columns: []*schema.Column{SpacesColumns[10]},
+			},
+		},
 	}
 	// StatefulEntitiesColumns holds the columns for the "stateful_entities" table.
 	StatefulEntitiesColumns = []*schema.Column{
@@ -0,0 +1,4 @@
+-- Create index "accounts_account_number_key" to table: "accounts"
+CREATE UNIQUE INDEX `accounts_account_number_key` ON `accounts` (`account_number`);
+-- Create index "space_space_number_property_spaces" to table: "spaces"
+CREATE UNIQUE INDEX `space_space_number_property_spaces` ON `spaces` (`space_number`, `property_spaces`);
@@ -0,0 +1,28 @@
+-- Disable the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = off;
+-- Create "new_leases" table
+CREATE TABLE `new_leases` (`id` uuid NOT NULL, `created_at` datetime NOT NULL, `updated_at` datetime NOT NULL, `created_by` text NOT NULL, `updated_by` text NOT NULL, `source` text NOT NULL, `correlation_id` text NULL, `agent_goal_id` text NULL, `property_id` text NOT NULL, `tenant_role_ids` json NOT NULL, `guarantor_role_ids` json NULL, `lease_type` text NOT NULL, `status` text NOT NULL, `description` text NULL, `liability_type` text NOT NULL DEFAULT ('joint_and_several'), `term` json NOT NULL, `lease_commencement_date` datetime NULL, `rent_commencement_date` datetime NULL, `base_rent_amount_cents` integer NOT NULL, `base_rent_currency` text NOT NULL DEFAULT ('USD'), `security_deposit_amount_cents` integer NOT NULL, `security_deposit_currency` text NOT NULL DEFAULT ('USD'), `rent_schedule` json NULL, `recurring_charges` json NULL, `late_fee_policy` json NULL, `cam_terms` json NULL, `tenant_improvement` json NULL, `renewal_options` json NULL, `usage_charges` json NULL, `percentage_rent` json NULL, `expansion_rights` json NULL, `contraction_rights` json NULL, `subsidy` json NULL, `move_in_date` datetime NULL, `move_out_date` datetime NULL, `notice_date` datetime NULL, `notice_required_days` integer NOT NULL DEFAULT (30), `check_in_time` text NULL, `check_out_time` text NULL, `cleaning_fee_amount_cents` integer NULL, `cleaning_fee_currency` text NULL DEFAULT ('USD'), `platform_booking_id` text NULL, `membership_tier` text NULL, `is_sublease` bool NOT NULL DEFAULT (false), `sublease_billing` text NOT NULL DEFAULT ('through_master_tenant'), `signing_method` text NULL, `signed_at` datetime NULL, `document_id` text NULL, `lease_subleases` uuid NULL, PRIMARY KEY (`id`), CONSTRAINT `leases_leases_subleases` FOREIGN KEY (`lease_subleases`) REFERENCES `leases` (`id`) ON DELETE SET NULL);
+-- Copy rows from old table "leases" to new temporary table "new_leases"
+INSERT INTO `new_leases` (`id`, `created_at`, `updated_at`, `created_by`, `updated_by`, `source`, `correlation_id`, `agent_goal_id`, `property_id`, `tenant_role_ids`, `guarantor_role_ids`, `lease_type`, `status`, `description`, `liability_type`, `term`, `lease_commencement_date`, `rent_commencement_date`, `base_rent_amount_cents`, `base_rent_currency`, `security_deposit_amount_cents`, `security_deposit_currency`, `rent_schedule`, `recurring_charges`, `late_fee_policy`, `cam_terms`, `tenant_improvement`, `renewal_options`, `usage_charges`, `percentage_rent`, `expansion_rights`, `contraction_rights`, `subsidy`, `move_in_date`, `move_out_date`, `notice_date`, `notice_required_days`, `check_in_time`, `check_out_time`, `cleaning_fee_amount_cents`, `cleaning_fee_currency`, `platform_booking_id`, `membership_tier`, `is_sublease`, `sublease_billing`, `signing_method`, `signed_at`, `document_id`, `lease_subleases`) SELECT `id`, `created_at`, `updated_at`, `created_by`, `updated_by`, `source`, `correlation_id`, `agent_goal_id`, `property_id`, `tenant_role_ids`, `guarantor_role_ids`, `lease_type`, `status`, `description`, `liability_type`, `term`, `lease_commencement_date`, `rent_commencement_date`, `base_rent_amount_cents`, `base_rent_currency`, `security_deposit_amount_cents`, `security_deposit_currency`, `rent_schedule`, `recurring_charges`, `late_fee_policy`, `cam_terms`, `tenant_improvement`, `renewal_options`, `usage_charges`, `percentage_rent`, `expansion_rights`, `contraction_rights`, `subsidy`, `move_in_date`, `move_out_date`, `notice_date`, IFNULL(`notice_required_days`, (30)) AS `notice_required_days`, `check_in_time`, `check_out_time`, `cleaning_fee_amount_cents`, `cleaning_fee_currency`, `platform_booking_id`, `membership_tier`, `is_sublease`, `sublease_billing`, `signing_method`, `signed_at`, `document_id`, `lease_subleases` FROM `leases`;
+-- Drop "leases" table after copying rows
+DROP TABLE `leases`;
+-- Rename temporary table "new_leases" to "leases"
+ALTER TABLE `new_leases` RENAME TO `leases`;
+-- Create "new_persons" table
+CREATE TABLE `new_persons` (`id` uuid NOT NULL, `created_at` datetime NOT NULL, `updated_at` datetime NOT NULL, `created_by` text NOT NULL, `updated_by` text NOT NULL, `source` text NOT NULL, `correlation_id` text NULL, `agent_goal_id` text NULL, `first_name` text NOT NULL, `middle_name` text NULL, `last_name` text NOT NULL, `display_name` text NOT NULL, `record_source` text NOT NULL DEFAULT ('user'), `date_of_birth` datetime NULL, `ssn_last_four` text NULL, `contact_methods` json NOT NULL, `preferred_contact` text NOT NULL DEFAULT ('email'), `language_preference` text NOT NULL DEFAULT ('en'), `timezone` text NULL, `do_not_contact` bool NOT NULL DEFAULT (false), `identity_verified` bool NOT NULL DEFAULT (false), `verification_method` text NULL, `verified_at` datetime NULL, `tags` json NULL, PRIMARY KEY (`id`));
+-- Copy rows from old table "persons" to new temporary table "new_persons"
+INSERT INTO `new_persons` (`id`, `created_at`, `updated_at`, `created_by`, `updated_by`, `source`, `correlation_id`, `agent_goal_id`, `first_name`, `middle_name`, `last_name`, `display_name`, `record_source`, `date_of_birth`, `ssn_last_four`, `contact_methods`, `preferred_contact`, `language_preference`, `timezone`, `do_not_contact`, `identity_verified`, `verification_method`, `verified_at`, `tags`) SELECT `id`, `created_at`, `updated_at`, `created_by`, `updated_by`, `source`, `correlation_id`, `agent_goal_id`, `first_name`, `middle_name`, `last_name`, `display_name`, `record_source`, `date_of_birth`, `ssn_last_four`, `contact_methods`, `preferred_contact`, IFNULL(`language_preference`, ('en')) AS `language_preference`, `timezone`, `do_not_contact`, `identity_verified`, `verification_method`, `verified_at`, `tags` FROM `persons`;
+-- Drop "persons" table after copying rows
+DROP TABLE `persons`;
+-- Rename temporary table "new_persons" to "persons"
+ALTER TABLE `new_persons` RENAME TO `persons`;
+-- Create "new_jurisdictions" table
+CREATE TABLE `new_jurisdictions` (`id` uuid NOT NULL, `created_at` datetime NOT NULL, `updated_at` datetime NOT NULL, `created_by` text NOT NULL, `updated_by` text NOT NULL, `source` text NOT NULL, `correlation_id` text NULL, `agent_goal_id` text NULL, `name` text NOT NULL, `jurisdiction_type` text NOT NULL, `fips_code` text NULL, `state_code` text NULL, `country_code` text NOT NULL DEFAULT ('US'), `status` text NOT NULL, `successor_jurisdiction_id` text NULL, `effective_date` datetime NULL, `dissolution_date` datetime NULL, `governing_body` text NULL, `regulatory_url` text NULL, `jurisdiction_children` uuid NULL, PRIMARY KEY (`id`), CONSTRAINT `jurisdictions_jurisdictions_children` FOREIGN KEY (`jurisdiction_children`) REFERENCES `jurisdictions` (`id`) ON DELETE SET NULL);
+-- Copy rows from old table "jurisdictions" to new temporary table "new_jurisdictions"
+INSERT INTO `new_jurisdictions` (`id`, `created_at`, `updated_at`, `created_by`, `updated_by`, `source`, `correlation_id`, `agent_goal_id`, `name`, `jurisdiction_type`, `fips_code`, `state_code`, `country_code`, `status`, `successor_jurisdiction_id`, `effective_date`, `dissolution_date`, `governing_body`, `regulatory_url`, `jurisdiction_children`) SELECT `id`, `created_at`, `updated_at`, `created_by`, `updated_by`, `source`, `correlation_id`, `agent_goal_id`, `name`, `jurisdiction_type`, `fips_code`, `state_code`, IFNULL(`country_code`, ('US')) AS `country_code`, `status`, `successor_jurisdiction_id`, `effective_date`, `dissolution_date`, `governing_body`, `regulatory_url`, `jurisdiction_children` FROM `jurisdictions`;
+-- Drop "jurisdictions" table after copying rows
+DROP TABLE `jurisdictions`;
+-- Rename temporary table "new_jurisdictions" to "jurisdictions"
+ALTER TABLE `new_jurisdictions` RENAME TO `jurisdictions`;
+-- Enable back the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = on;
@@ -0,0 +1,22 @@
+-- Disable the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = off;
+-- Create "new_buildings" table
+CREATE TABLE `new_buildings` (`id` uuid NOT NULL, `created_at` datetime NOT NULL, `updated_at` datetime NOT NULL, `created_by` text NOT NULL, `updated_by` text NOT NULL, `source` text NOT NULL, `correlation_id` text NULL, `agent_goal_id` text NULL, `name` text NOT NULL, `building_type` text NOT NULL, `address` json NULL, `description` text NULL, `status` text NOT NULL, `floors` integer NULL, `year_built` integer NULL, `total_square_footage` real NULL, `total_rentable_square_footage` real NULL, `property_buildings` uuid NOT NULL, PRIMARY KEY (`id`), CONSTRAINT `buildings_properties_buildings` FOREIGN KEY (`property_buildings`) REFERENCES `properties` (`id`) ON DELETE CASCADE);
+-- Copy rows from old table "buildings" to new temporary table "new_buildings"
+INSERT INTO `new_buildings` (`id`, `created_at`, `updated_at`, `created_by`, `updated_by`, `source`, `correlation_id`, `agent_goal_id`, `name`, `building_type`, `address`, `description`, `status`, `floors`, `year_built`, `total_square_footage`, `total_rentable_square_footage`, `property_buildings`) SELECT `id`, `created_at`, `updated_at`, `created_by`, `updated_by`, `source`, `correlation_id`, `agent_goal_id`, `name`, `building_type`, `address`, `description`, `status`, `floors`, `year_built`, `total_square_footage`, `total_rentable_square_footage`, `property_buildings` FROM `buildings`;
+-- Drop "buildings" table after copying rows
+DROP TABLE `buildings`;
+-- Rename temporary table "new_buildings" to "buildings"
+ALTER TABLE `new_buildings` RENAME TO `buildings`;
+-- Create "new_spaces" table
+CREATE TABLE `new_spaces` (`id` uuid NOT NULL, `created_at` datetime NOT NULL, `updated_at` datetime NOT NULL, `created_by` text NOT NULL, `updated_by` text NOT NULL, `source` text NOT NULL, `correlation_id` text NULL, `agent_goal_id` text NULL, `space_number` text NOT NULL, `space_type` text NOT NULL, `status` text NOT NULL, `leasable` bool NOT NULL, `shared_with_parent` bool NOT NULL DEFAULT (false), `square_footage` real NOT NULL, `bedrooms` integer NULL, `bathrooms` real NULL, `floor` integer NULL, `amenities` json NULL, `floor_plan` text NULL, `ada_accessible` bool NOT NULL DEFAULT (false), `pet_friendly` bool NOT NULL DEFAULT (true), `furnished` bool NOT NULL DEFAULT (false), `specialized_infrastructure` json NULL, `market_rent_amount_cents` integer NULL, `market_rent_currency` text NULL DEFAULT ('USD'), `ami_restriction` integer NULL, `active_lease_id` text NULL, `building_spaces` uuid NULL, `property_spaces` uuid NOT NULL, `space_children` uuid NULL, PRIMARY KEY (`id`), CONSTRAINT `spaces_buildings_spaces` FOREIGN KEY (`building_spaces`) REFERENCES `buildings` (`id`) ON DELETE SET NULL, CONSTRAINT `spaces_properties_spaces` FOREIGN KEY (`property_spaces`) REFERENCES `properties` (`id`) ON DELETE CASCADE, CONSTRAINT `spaces_spaces_children` FOREIGN KEY (`space_children`) REFERENCES `spaces` (`id`) ON DELETE SET NULL);
+-- Copy rows from old table "spaces" to new temporary table "new_spaces"
+INSERT INTO `new_spaces` (`id`, `created_at`, `updated_at`, `created_by`, `updated_by`, `source`, `correlation_id`, `agent_goal_id`, `space_number`, `space_type`, `status`, `leasable`, `shared_with_parent`, `square_footage`, `bedrooms`, `bathrooms`, `floor`, `amenities`, `floor_plan`, `ada_accessible`, `pet_friendly`, `furnished`, `specialized_infrastructure`, `market_rent_amount_cents`, `market_rent_currency`, `ami_restriction`, `active_lease_id`, `building_spaces`, `property_spaces`, `space_children`) SELECT `id`, `created_at`, `updated_at`, `created_by`, `updated_by`, `source`, `correlation_id`, `agent_goal_id`, `space_number`, `space_type`, `status`, `leasable`, `shared_with_parent`, `square_footage`, `bedrooms`, `bathrooms`, `floor`, `amenities`, `floor_plan`, `ada_accessible`, `pet_friendly`, `furnished`, `specialized_infrastructure`, `market_rent_amount_cents`, `market_rent_currency`, `ami_restriction`, `active_lease_id`, `building_spaces`, `property_spaces`, `space_children` FROM `spaces`;
+-- Drop "spaces" table after copying rows
+DROP TABLE `spaces`;
+-- Rename temporary table "new_spaces" to "spaces"
+ALTER TABLE `new_spaces` RENAME TO `spaces`;
+-- Create index "space_space_number_property_spaces" to table: "spaces"
+CREATE UNIQUE INDEX `space_space_number_property_spaces` ON `spaces` (`space_number`, `property_spaces`);
+-- Enable back the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = on;
@@ -0,0 +1,82 @@
+-- Create index "account_parent_account_id" to table: "accounts"
+CREATE INDEX `account_parent_account_id` ON `accounts` (`parent_account_id`);
+-- Create index "account_status" to table: "accounts"
+CREATE INDEX `account_status` ON `accounts` (`status`);
+-- Create index "journalentry_status" to table: "journal_entries"
+CREATE INDEX `journalentry_status` ON `journal_entries` (`status`);
+-- Create index "leasespace_lease_space_lease" to table: "lease_spaces"
+CREATE INDEX `leasespace_lease_space_lease` ON `lease_spaces` (`lease_space_lease`);
+-- Create index "leasespace_lease_space_space" to table: "lease_spaces"
+CREATE INDEX `leasespace_lease_space_space` ON `lease_spaces` (`lease_space_space`);
+-- Create index "ledgerentry_lease_ledger_entries" to table: "ledger_entries"
+CREATE INDEX `ledgerentry_lease_ledger_entries` ON `ledger_entries` (`lease_ledger_entries`);
+-- Create index "ledgerentry_ledger_entry_journal_entry" to table: "ledger_entries"
+CREATE INDEX `ledgerentry_ledger_entry_journal_entry` ON `ledger_entries` (`ledger_entry_journal_entry`);
+-- Create index "ledgerentry_ledger_entry_account" to table: "ledger_entries"
+CREATE INDEX `ledgerentry_ledger_entry_account` ON `ledger_entries` (`ledger_entry_account`);
+-- Create index "ledgerentry_ledger_entry_property" to table: "ledger_entries"
+CREATE INDEX `ledgerentry_ledger_entry_property` ON `ledger_entries` (`ledger_entry_property`);
+-- Create index "ledgerentry_ledger_entry_space" to table: "ledger_entries"
+CREATE INDEX `ledgerentry_ledger_entry_space` ON `ledger_entries` (`ledger_entry_space`);
+-- Create index "ledgerentry_ledger_entry_person" to table: "ledger_entries"
+CREATE INDEX `ledgerentry_ledger_entry_person` ON `ledger_entries` (`ledger_entry_person`);
+-- Create index "organization_status" to table: "organizations"
+CREATE INDEX `organization_status` ON `organizations` (`status`);
+-- Create index "application_property_applications" to table: "applications"
+CREATE INDEX `application_property_applications` ON `applications` (`property_applications`);
+-- Create index "application_space_applications" to table: "applications"
+CREATE INDEX `application_space_applications` ON `applications` (`space_applications`);
+-- Create index "application_applicant_person_id" to table: "applications"
+CREATE INDEX `application_applicant_person_id` ON `applications` (`applicant_person_id`);
+-- Create index "application_status" to table: "applications"
+CREATE INDEX `application_status` ON `applications` (`status`);
+-- Create index "personrole_person_roles" to table: "person_roles"
+CREATE INDEX `personrole_person_roles` ON `person_roles` (`person_roles`);
+-- Create index "personrole_status" to table: "person_roles"
+CREATE INDEX `personrole_status` ON `person_roles` (`status`);
+-- Create index "property_portfolio_properties" to table: "properties"
+CREATE INDEX `property_portfolio_properties` ON `properties` (`portfolio_properties`);
+-- Create index "property_property_bank_account" to table: "properties"
+CREATE INDEX `property_property_bank_account` ON `properties` (`property_bank_account`);
+-- Create index "property_status" to table: "properties"
+CREATE INDEX `property_status` ON `properties` (`status`);
+-- Create index "bankaccount_bank_account_gl_account" to table: "bank_accounts"
+CREATE INDEX `bankaccount_bank_account_gl_account` ON `bank_accounts` (`bank_account_gl_account`);
+-- Create index "bankaccount_status" to table: "bank_accounts"
+CREATE INDEX `bankaccount_status` ON `bank_accounts` (`status`);
+-- Create index "reconciliation_reconciliation_bank_account" to table: "reconciliations"
+CREATE INDEX `reconciliation_reconciliation_bank_account` ON `reconciliations` (`reconciliation_bank_account`);
+-- Create index "reconciliation_status" to table: "reconciliations"
+CREATE INDEX `reconciliation_status` ON `reconciliations` (`status`);
+-- Create index "portfolio_portfolio_owner" to table: "portfolios"
+CREATE INDEX `portfolio_portfolio_owner` ON `portfolios` (`portfolio_owner`);
+-- Create index "portfolio_status" to table: "portfolios"
+CREATE INDEX `portfolio_status` ON `portfolios` (`status`);
+-- Create index "jurisdictionrule_jurisdiction_rules" to table: "jurisdiction_rules"
+CREATE INDEX `jurisdictionrule_jurisdiction_rules` ON `jurisdiction_rules` (`jurisdiction_rules`);
+-- Create index "jurisdictionrule_status" to table: "jurisdiction_rules"
+CREATE INDEX `jurisdictionrule_status` ON `jurisdiction_rules` (`status`);
+-- Create index "propertyjurisdiction_property_jurisdiction_property" to table: "property_jurisdictions"
+CREATE INDEX `propertyjurisdiction_property_jurisdiction_property` ON `property_jurisdictions` (`property_jurisdiction_property`);
+-- Create index "propertyjurisdiction_property_jurisdiction_jurisdiction" to table: "property_jurisdictions"
+CREATE INDEX `propertyjurisdiction_property_jurisdiction_jurisdiction` ON `property_jurisdictions` (`property_jurisdiction_jurisdiction`);
+-- Create index "lease_lease_subleases" to table: "leases"
+CREATE INDEX `lease_lease_subleases` ON `leases` (`lease_subleases`);
+-- Create index "lease_status" to table: "leases"
+CREATE INDEX `lease_status` ON `leases` (`status`);
+-- Create index "jurisdiction_jurisdiction_children" to table: "jurisdictions"
+CREATE INDEX `jurisdiction_jurisdiction_children` ON `jurisdictions` (`jurisdiction_children`);
+-- Create index "jurisdiction_status" to table: "jurisdictions"
+CREATE INDEX `jurisdiction_status` ON `jurisdictions` (`status`);
+-- Create index "building_property_buildings" to table: "buildings"
+CREATE INDEX `building_property_buildings` ON `buildings` (`property_buildings`);
+-- Create index "building_status" to table: "buildings"
+CREATE INDEX `building_status` ON `buildings` (`status`);
+-- Create index "space_property_spaces" to table: "spaces"
+CREATE INDEX `space_property_spaces` ON `spaces` (`property_spaces`);
+-- Create index "space_building_spaces" to table: "spaces"
+CREATE INDEX `space_building_spaces` ON `spaces` (`building_spaces`);
+-- Create index "space_space_children" to table: "spaces"
+CREATE INDEX `space_space_children` ON `spaces` (`space_children`);
+-- Create index "space_status" to table: "spaces"
+CREATE INDEX `space_status` ON `spaces` (`status`);
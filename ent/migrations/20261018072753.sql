@@ -0,0 +1,2 @@
+-- Add column "deleted_at" to table: "buildings"
+ALTER TABLE `buildings` ADD COLUMN `deleted_at` datetime NULL;
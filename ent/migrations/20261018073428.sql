@@ -0,0 +1,16 @@
+-- Disable the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = off;
+-- Create "new_buildings" table
+CREATE TABLE `new_buildings` (`id` uuid NOT NULL, `created_at` datetime NOT NULL, `updated_at` datetime NOT NULL, `created_by` text NOT NULL, `updated_by` text NOT NULL, `source` text NOT NULL, `correlation_id` text NULL, `agent_goal_id` text NULL, `deleted_at` datetime NULL, `name` text NOT NULL, `building_type` text NOT NULL, `address` json NULL, `description` text NULL, `status` text NOT NULL, `floors` integer NULL, `year_built` integer NULL, `total_square_footage` real NULL, `total_rentable_square_footage` real NULL, `version` integer NOT NULL DEFAULT (1), `property_buildings` uuid NOT NULL, PRIMARY KEY (`id`), CONSTRAINT `buildings_properties_buildings` FOREIGN KEY (`property_buildings`) REFERENCES `properties` (`id`) ON DELETE CASCADE);
+-- Copy rows from old table "buildings" to new temporary table "new_buildings"
+INSERT INTO `new_buildings` (`id`, `created_at`, `updated_at`, `created_by`, `updated_by`, `source`, `correlation_id`, `agent_goal_id`, `deleted_at`, `name`, `building_type`, `address`, `description`, `status`, `floors`, `year_built`, `total_square_footage`, `total_rentable_square_footage`, `property_buildings`) SELECT `id`, `created_at`, `updated_at`, `created_by`, `updated_by`, `source`, `correlation_id`, `agent_goal_id`, `deleted_at`, `name`, `building_type`, `address`, `description`, `status`, `floors`, `year_built`, `total_square_footage`, `total_rentable_square_footage`, `property_buildings` FROM `buildings`;
+-- Drop "buildings" table after copying rows
+DROP TABLE `buildings`;
+-- Rename temporary table "new_buildings" to "buildings"
+ALTER TABLE `new_buildings` RENAME TO `buildings`;
+-- Create index "building_property_buildings" to table: "buildings"
+CREATE INDEX `building_property_buildings` ON `buildings` (`property_buildings`);
+-- Create index "building_status" to table: "buildings"
+CREATE INDEX `building_status` ON `buildings` (`status`);
+-- Enable back the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = on;
@@ -7233,6 +7233,7 @@ type BuildingMutation struct {
 	source                           *building.Source
 	correlation_id                   *string
 	agent_goal_id                    *string
+	deleted_at                       *time.Time
 	name                             *string
 	building_type                    *building.BuildingType
 	address                          **types.Address
@@ -7246,6 +7247,8 @@ type BuildingMutation struct {
 	addtotal_square_footage          *float64
 	total_rentable_square_footage    *float64
 	addtotal_rentable_square_footage *float64
+	version                          *int
+	addversion                       *int
 	clearedFields                    map[string]struct{}
 	property                         *uuid.UUID
 	clearedproperty                  bool
@@ -7639,6 +7642,55 @@ func (m *BuildingMutation) ResetAgentGoalID() {
 	delete(m.clearedFields, building.FieldAgentGoalID)
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (m *BuildingMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
+}
+
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *BuildingMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeletedAt returns the old "deleted_at" field's value of the Building entity.
+// If the Building object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BuildingMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+	}
+	return oldValue.DeletedAt, nil
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *BuildingMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[building.FieldDeletedAt] = struct{}{}
+}
+
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *BuildingMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[building.FieldDeletedAt]
+	return ok
+}
+
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *BuildingMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, building.FieldDeletedAt)
+}
+
 // SetName sets the "name" field.
 func (m *BuildingMutation) SetName(s string) {
 	m.name = &s
@@ -8125,6 +8177,62 @@ func (m *BuildingMutation) ResetTotalRentableSquareFootage() {
 	delete(m.clearedFields, building.FieldTotalRentableSquareFootage)
 }
 
+// SetVersion sets the "version" field.
+func (m *BuildingMutation) SetVersion(i int) {
+	m.version = &i
+	m.addversion = nil
+}
+
+// Version returns the value of the "version" field in the mutation.
+func (m *BuildingMutation) Version() (r int, exists bool) {
+	v := m.version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVersion returns the old "version" field's value of the Building entity.
+// If the Building object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BuildingMutation) OldVersion(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVersion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVersion: %w", err)
+	}
+	return oldValue.Version, nil
+}
+
+// AddVersion adds i to the "version" field.
+func (m *BuildingMutation) AddVersion(i int) {
+	if m.addversion != nil {
+		*m.addversion += i
+	} else {
+		m.addversion = &i
+	}
+}
+
+// AddedVersion returns the value that was added to the "version" field in this mutation.
+func (m *BuildingMutation) AddedVersion() (r int, exists bool) {
+	v := m.addversion
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetVersion resets all changes to the "version" field.
+func (m *BuildingMutation) ResetVersion() {
+	m.version = nil
+	m.addversion = nil
+}
+
 // SetPropertyID sets the "property" edge to the Property entity by id.
 func (m *BuildingMutation) SetPropertyID(id uuid.UUID) {
 	m.property = &id
@@ -8252,7 +8360,7 @@ func (m *BuildingMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *BuildingMutation) Fields() []string {
-	fields := make([]string, 0, 16)
+	fields := make([]string, 0, 18)
 	if m.created_at != nil {
 		fields = append(fields, building.FieldCreatedAt)
 	}
@@ -8274,6 +8382,9 @@ func (m *BuildingMutation) Fields() []string {
 	if m.agent_goal_id != nil {
 		fields = append(fields, building.FieldAgentGoalID)
 	}
+	if m.deleted_at != nil {
+		fields = append(fields, building.FieldDeletedAt)
+	}
 	if m.name != nil {
 		fields = append(fields, building.FieldName)
 	}
@@ -8301,6 +8412,9 @@ func (m *BuildingMutation) Fields() []string {
 	if m.total_rentable_square_footage != nil {
 		fields = append(fields, building.FieldTotalRentableSquareFootage)
 	}
+	if m.version != nil {
+		fields = append(fields, building.FieldVersion)
+	}
 	return fields
 }
 
@@ -8323,6 +8437,8 @@ func (m *BuildingMutation) Field(name string) (ent.Value, bool) {
 		return m.CorrelationID()
 	case building.FieldAgentGoalID:
 		return m.AgentGoalID()
+	case building.FieldDeletedAt:
+		return m.DeletedAt()
 	case building.FieldName:
 		return m.Name()
 	case building.FieldBuildingType:
@@ -8341,6 +8457,8 @@ func (m *BuildingMutation) Field(name string) (ent.Value, bool) {
 		return m.TotalSquareFootage()
 	case building.FieldTotalRentableSquareFootage:
 		return m.TotalRentableSquareFootage()
+	case building.FieldVersion:
+		return m.Version()
 	}
 	return nil, false
 }
@@ -8364,6 +8482,8 @@ func (m *BuildingMutation) OldField(ctx context.Context, name string) (ent.Value
 		return m.OldCorrelationID(ctx)
 	case building.FieldAgentGoalID:
 		return m.OldAgentGoalID(ctx)
+	case building.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
 	case building.FieldName:
 		return m.OldName(ctx)
 	case building.FieldBuildingType:
@@ -8382,6 +8502,8 @@ func (m *BuildingMutation) OldField(ctx context.Context, name string) (ent.Value
 		return m.OldTotalSquareFootage(ctx)
 	case building.FieldTotalRentableSquareFootage:
 		return m.OldTotalRentableSquareFootage(ctx)
+	case building.FieldVersion:
+		return m.OldVersion(ctx)
 	}
 	return nil, fmt.Errorf("unknown Building field %s", name)
 }
@@ -8440,6 +8562,13 @@ func (m *BuildingMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetAgentGoalID(v)
 		return nil
+	case building.FieldDeletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedAt(v)
+		return nil
 	case building.FieldName:
 		v, ok := value.(string)
 		if !ok {
@@ -8503,6 +8632,13 @@ func (m *BuildingMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetTotalRentableSquareFootage(v)
 		return nil
+	case building.FieldVersion:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVersion(v)
+		return nil
 	}
 	return fmt.Errorf("unknown Building field %s", name)
 }
@@ -8523,6 +8659,9 @@ func (m *BuildingMutation) AddedFields() []string {
 	if m.addtotal_rentable_square_footage != nil {
 		fields = append(fields, building.FieldTotalRentableSquareFootage)
 	}
+	if m.addversion != nil {
+		fields = append(fields, building.FieldVersion)
+	}
 	return fields
 }
 
@@ -8539,6 +8678,8 @@ func (m *BuildingMutation) AddedField(name string) (ent.Value, bool) {
 		return m.AddedTotalSquareFootage()
 	case building.FieldTotalRentableSquareFootage:
 		return m.AddedTotalRentableSquareFootage()
+	case building.FieldVersion:
+		return m.AddedVersion()
 	}
 	return nil, false
 }
@@ -8576,6 +8717,13 @@ func (m *BuildingMutation) AddField(name string, value ent.Value) error {
 		}
 		m.AddTotalRentableSquareFootage(v)
 		return nil
+	case building.FieldVersion:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddVersion(v)
+		return nil
 	}
 	return fmt.Errorf("unknown Building numeric field %s", name)
 }
@@ -8590,6 +8738,9 @@ func (m *BuildingMutation) ClearedFields() []string {
 	if m.FieldCleared(building.FieldAgentGoalID) {
 		fields = append(fields, building.FieldAgentGoalID)
 	}
+	if m.FieldCleared(building.FieldDeletedAt) {
+		fields = append(fields, building.FieldDeletedAt)
+	}
 	if m.FieldCleared(building.FieldAddress) {
 		fields = append(fields, building.FieldAddress)
 	}
@@ -8628,6 +8779,9 @@ func (m *BuildingMutation) ClearField(name string) error {
 	case building.FieldAgentGoalID:
 		m.ClearAgentGoalID()
 		return nil
+	case building.FieldDeletedAt:
+		m.ClearDeletedAt()
+		return nil
 	case building.FieldAddress:
 		m.ClearAddress()
 		return nil
@@ -8675,6 +8829,9 @@ func (m *BuildingMutation) ResetField(name string) error {
 	case building.FieldAgentGoalID:
 		m.ResetAgentGoalID()
 		return nil
+	case building.FieldDeletedAt:
+		m.ResetDeletedAt()
+		return nil
 	case building.FieldName:
 		m.ResetName()
 		return nil
@@ -8702,6 +8859,9 @@ func (m *BuildingMutation) ResetField(name string) error {
 	case building.FieldTotalRentableSquareFootage:
 		m.ResetTotalRentableSquareFootage()
 		return nil
+	case building.FieldVersion:
+		m.ResetVersion()
+		return nil
 	}
 	return fmt.Errorf("unknown Building field %s", name)
 }
@@ -65,7 +65,7 @@ type Person struct {
 	VerificationMethod *person.VerificationMethod `json:"verification_method,omitempty"`
 	// VerifiedAt holds the value of the "verified_at" field.
 	VerifiedAt *time.Time `json:"verified_at,omitempty"`
-	// Tags holds the value of the "tags" field.
+	// Tags for flexible categorization
 	Tags []string `json:"tags,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the PersonQuery when eager-loading is set.
@@ -161,6 +161,8 @@ var (
 	LastNameValidator func(string) error
 	// DisplayNameValidator is a validator for the "display_name" field. It is called by the builders before save.
 	DisplayNameValidator func(string) error
+	// DefaultLanguagePreference holds the default value on creation for the "language_preference" field.
+	DefaultLanguagePreference string
 	// DefaultDoNotContact holds the default value on creation for the "do_not_contact" field.
 	DefaultDoNotContact bool
 	// DefaultIdentityVerified holds the default value on creation for the "identity_verified" field.
@@ -200,6 +200,14 @@ func (_c *PersonCreate) SetLanguagePreference(v string) *PersonCreate {
 	return _c
 }
 
+// SetNillableLanguagePreference sets the "language_preference" field if the given value is not nil.
+func (_c *PersonCreate) SetNillableLanguagePreference(v *string) *PersonCreate {
+	if v != nil {
+		_c.SetLanguagePreference(*v)
+	}
+	return _c
+}
+
 // SetTimezone sets the "timezone" field.
 func (_c *PersonCreate) SetTimezone(v string) *PersonCreate {
 	_c.mutation.SetTimezone(v)
@@ -401,6 +409,10 @@ func (_c *PersonCreate) defaults() {
 		v := person.DefaultPreferredContact
 		_c.mutation.SetPreferredContact(v)
 	}
+	if _, ok := _c.mutation.LanguagePreference(); !ok {
+		v := person.DefaultLanguagePreference
+		_c.mutation.SetLanguagePreference(v)
+	}
 	if _, ok := _c.mutation.DoNotContact(); !ok {
 		v := person.DefaultDoNotContact
 		_c.mutation.SetDoNotContact(v)
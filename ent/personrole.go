@@ -46,7 +46,7 @@ type PersonRole struct {
 	Status personrole.Status `json:"status,omitempty"`
 	// Effective holds the value of the "effective" field.
 	Effective *types.DateRange `json:"effective,omitempty"`
-	// Attributes holds the value of the "attributes" field.
+	// Role-specific attributes stored as structured JSON
 	Attributes *types.TenantAttributes `json:"attributes,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the PersonRoleQuery when eager-loading is set.
@@ -203,6 +203,18 @@ var (
 	UpdatedByValidator func(string) error
 	// NameValidator is a validator for the "name" field. It is called by the builders before save.
 	NameValidator func(string) error
+	// YearBuiltValidator is a validator for the "year_built" field. It is called by the builders before save.
+	YearBuiltValidator func(int) error
+	// TotalSquareFootageValidator is a validator for the "total_square_footage" field. It is called by the builders before save.
+	TotalSquareFootageValidator func(float64) error
+	// TotalSpacesValidator is a validator for the "total_spaces" field. It is called by the builders before save.
+	TotalSpacesValidator func(int) error
+	// LotSizeSqftValidator is a validator for the "lot_size_sqft" field. It is called by the builders before save.
+	LotSizeSqftValidator func(float64) error
+	// StoriesValidator is a validator for the "stories" field. It is called by the builders before save.
+	StoriesValidator func(int) error
+	// ParkingSpacesValidator is a validator for the "parking_spaces" field. It is called by the builders before save.
+	ParkingSpacesValidator func(int) error
 	// DefaultRentControlled holds the default value on creation for the "rent_controlled" field.
 	DefaultRentControlled bool
 	// DefaultID holds the default value on creation for the "id" field.
@@ -515,12 +515,42 @@ func (_c *PropertyCreate) check() error {
 	if _, ok := _c.mutation.YearBuilt(); !ok {
 		return &ValidationError{Name: "year_built", err: errors.New(`ent: missing required field "Property.year_built"`)}
 	}
+	if v, ok := _c.mutation.YearBuilt(); ok {
+		if err := property.YearBuiltValidator(v); err != nil {
+			return &ValidationError{Name: "year_built", err: fmt.Errorf(`ent: validator failed for field "Property.year_built": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.TotalSquareFootage(); !ok {
 		return &ValidationError{Name: "total_square_footage", err: errors.New(`ent: missing required field "Property.total_square_footage"`)}
 	}
+	if v, ok := _c.mutation.TotalSquareFootage(); ok {
+		if err := property.TotalSquareFootageValidator(v); err != nil {
+			return &ValidationError{Name: "total_square_footage", err: fmt.Errorf(`ent: validator failed for field "Property.total_square_footage": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.TotalSpaces(); !ok {
 		return &ValidationError{Name: "total_spaces", err: errors.New(`ent: missing required field "Property.total_spaces"`)}
 	}
+	if v, ok := _c.mutation.TotalSpaces(); ok {
+		if err := property.TotalSpacesValidator(v); err != nil {
+			return &ValidationError{Name: "total_spaces", err: fmt.Errorf(`ent: validator failed for field "Property.total_spaces": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.LotSizeSqft(); ok {
+		if err := property.LotSizeSqftValidator(v); err != nil {
+			return &ValidationError{Name: "lot_size_sqft", err: fmt.Errorf(`ent: validator failed for field "Property.lot_size_sqft": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Stories(); ok {
+		if err := property.StoriesValidator(v); err != nil {
+			return &ValidationError{Name: "stories", err: fmt.Errorf(`ent: validator failed for field "Property.stories": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.ParkingSpaces(); ok {
+		if err := property.ParkingSpacesValidator(v); err != nil {
+			return &ValidationError{Name: "parking_spaces", err: fmt.Errorf(`ent: validator failed for field "Property.parking_spaces": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.RentControlled(); !ok {
 		return &ValidationError{Name: "rent_controlled", err: errors.New(`ent: missing required field "Property.rent_controlled"`)}
 	}
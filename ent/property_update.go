@@ -745,6 +745,36 @@ func (_u *PropertyUpdate) check() error {
 			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Property.status": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.YearBuilt(); ok {
+		if err := property.YearBuiltValidator(v); err != nil {
+			return &ValidationError{Name: "year_built", err: fmt.Errorf(`ent: validator failed for field "Property.year_built": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.TotalSquareFootage(); ok {
+		if err := property.TotalSquareFootageValidator(v); err != nil {
+			return &ValidationError{Name: "total_square_footage", err: fmt.Errorf(`ent: validator failed for field "Property.total_square_footage": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.TotalSpaces(); ok {
+		if err := property.TotalSpacesValidator(v); err != nil {
+			return &ValidationError{Name: "total_spaces", err: fmt.Errorf(`ent: validator failed for field "Property.total_spaces": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.LotSizeSqft(); ok {
+		if err := property.LotSizeSqftValidator(v); err != nil {
+			return &ValidationError{Name: "lot_size_sqft", err: fmt.Errorf(`ent: validator failed for field "Property.lot_size_sqft": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Stories(); ok {
+		if err := property.StoriesValidator(v); err != nil {
+			return &ValidationError{Name: "stories", err: fmt.Errorf(`ent: validator failed for field "Property.stories": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ParkingSpaces(); ok {
+		if err := property.ParkingSpacesValidator(v); err != nil {
+			return &ValidationError{Name: "parking_spaces", err: fmt.Errorf(`ent: validator failed for field "Property.parking_spaces": %w`, err)}
+		}
+	}
 	if _u.mutation.PortfolioCleared() && len(_u.mutation.PortfolioIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Property.portfolio"`)
 	}
@@ -1908,6 +1938,36 @@ func (_u *PropertyUpdateOne) check() error {
 			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Property.status": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.YearBuilt(); ok {
+		if err := property.YearBuiltValidator(v); err != nil {
+			return &ValidationError{Name: "year_built", err: fmt.Errorf(`ent: validator failed for field "Property.year_built": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.TotalSquareFootage(); ok {
+		if err := property.TotalSquareFootageValidator(v); err != nil {
+			return &ValidationError{Name: "total_square_footage", err: fmt.Errorf(`ent: validator failed for field "Property.total_square_footage": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.TotalSpaces(); ok {
+		if err := property.TotalSpacesValidator(v); err != nil {
+			return &ValidationError{Name: "total_spaces", err: fmt.Errorf(`ent: validator failed for field "Property.total_spaces": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.LotSizeSqft(); ok {
+		if err := property.LotSizeSqftValidator(v); err != nil {
+			return &ValidationError{Name: "lot_size_sqft", err: fmt.Errorf(`ent: validator failed for field "Property.lot_size_sqft": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Stories(); ok {
+		if err := property.StoriesValidator(v); err != nil {
+			return &ValidationError{Name: "stories", err: fmt.Errorf(`ent: validator failed for field "Property.stories": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ParkingSpaces(); ok {
+		if err := property.ParkingSpacesValidator(v); err != nil {
+			return &ValidationError{Name: "parking_spaces", err: fmt.Errorf(`ent: validator failed for field "Property.parking_spaces": %w`, err)}
+		}
+	}
 	if _u.mutation.PortfolioCleared() && len(_u.mutation.PortfolioIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Property.portfolio"`)
 	}
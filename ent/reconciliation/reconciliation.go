@@ -145,6 +145,8 @@ var (
 	DefaultDifferenceCurrency string
 	// DifferenceCurrencyValidator is a validator for the "difference_currency" field. It is called by the builders before save.
 	DifferenceCurrencyValidator func(string) error
+	// UnreconciledItemsValidator is a validator for the "unreconciled_items" field. It is called by the builders before save.
+	UnreconciledItemsValidator func(int) error
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() uuid.UUID
 )
@@ -420,6 +420,11 @@ func (_c *ReconciliationCreate) check() error {
 			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Reconciliation.status": %w`, err)}
 		}
 	}
+	if v, ok := _c.mutation.UnreconciledItems(); ok {
+		if err := reconciliation.UnreconciledItemsValidator(v); err != nil {
+			return &ValidationError{Name: "unreconciled_items", err: fmt.Errorf(`ent: validator failed for field "Reconciliation.unreconciled_items": %w`, err)}
+		}
+	}
 	if len(_c.mutation.BankAccountIDs()) == 0 {
 		return &ValidationError{Name: "bank_account", err: errors.New(`ent: missing required edge "Reconciliation.bank_account"`)}
 	}
@@ -493,6 +493,11 @@ func (_u *ReconciliationUpdate) check() error {
 			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Reconciliation.status": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.UnreconciledItems(); ok {
+		if err := reconciliation.UnreconciledItemsValidator(v); err != nil {
+			return &ValidationError{Name: "unreconciled_items", err: fmt.Errorf(`ent: validator failed for field "Reconciliation.unreconciled_items": %w`, err)}
+		}
+	}
 	if _u.mutation.BankAccountCleared() && len(_u.mutation.BankAccountIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Reconciliation.bank_account"`)
 	}
@@ -1138,6 +1143,11 @@ func (_u *ReconciliationUpdateOne) check() error {
 			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Reconciliation.status": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.UnreconciledItems(); ok {
+		if err := reconciliation.UnreconciledItemsValidator(v); err != nil {
+			return &ValidationError{Name: "unreconciled_items", err: fmt.Errorf(`ent: validator failed for field "Reconciliation.unreconciled_items": %w`, err)}
+		}
+	}
 	if _u.mutation.BankAccountCleared() && len(_u.mutation.BankAccountIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Reconciliation.bank_account"`)
 	}
@@ -59,6 +59,10 @@ func init() {
 	accountDescUpdatedBy := accountMixinFields0[3].Descriptor()
 	// account.UpdatedByValidator is a validator for the "updated_by" field. It is called by the builders before save.
 	account.UpdatedByValidator = accountDescUpdatedBy.Validators[0].(func(string) error)
+	// accountDescDepth is the schema descriptor for depth field.
+	accountDescDepth := accountFields[7].Descriptor()
+	// account.DepthValidator is a validator for the "depth" field. It is called by the builders before save.
+	account.DepthValidator = accountDescDepth.Validators[0].(func(int) error)
 	// accountDescIsHeader is the schema descriptor for is_header field.
 	accountDescIsHeader := accountFields[10].Descriptor()
 	// account.DefaultIsHeader holds the default value on creation for the is_header field.
@@ -110,6 +114,28 @@ func init() {
 	applicationDescUpdatedBy := applicationMixinFields0[3].Descriptor()
 	// application.UpdatedByValidator is a validator for the "updated_by" field. It is called by the builders before save.
 	application.UpdatedByValidator = applicationDescUpdatedBy.Validators[0].(func(string) error)
+	// applicationDescDesiredLeaseTermMonths is the schema descriptor for desired_lease_term_months field.
+	applicationDescDesiredLeaseTermMonths := applicationFields[4].Descriptor()
+	// application.DesiredLeaseTermMonthsValidator is a validator for the "desired_lease_term_months" field. It is called by the builders before save.
+	application.DesiredLeaseTermMonthsValidator = applicationDescDesiredLeaseTermMonths.Validators[0].(func(int) error)
+	// applicationDescCreditScore is the schema descriptor for credit_score field.
+	applicationDescCreditScore := applicationFields[7].Descriptor()
+	// application.CreditScoreValidator is a validator for the "credit_score" field. It is called by the builders before save.
+	application.CreditScoreValidator = func() func(int) error {
+		validators := applicationDescCreditScore.Validators
+		fns := [...]func(int) error{
+			validators[0].(func(int) error),
+			validators[1].(func(int) error),
+		}
+		return func(credit_score int) error {
+			for _, fn := range fns {
+				if err := fn(credit_score); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
 	// applicationDescBackgroundClear is the schema descriptor for background_clear field.
 	applicationDescBackgroundClear := applicationFields[8].Descriptor()
 	// application.DefaultBackgroundClear holds the default value on creation for the background_clear field.
@@ -118,6 +144,10 @@ func init() {
 	applicationDescIncomeVerified := applicationFields[9].Descriptor()
 	// application.DefaultIncomeVerified holds the default value on creation for the income_verified field.
 	application.DefaultIncomeVerified = applicationDescIncomeVerified.Default.(bool)
+	// applicationDescIncomeToRentRatio is the schema descriptor for income_to_rent_ratio field.
+	applicationDescIncomeToRentRatio := applicationFields[10].Descriptor()
+	// application.IncomeToRentRatioValidator is a validator for the "income_to_rent_ratio" field. It is called by the builders before save.
+	application.IncomeToRentRatioValidator = applicationDescIncomeToRentRatio.Validators[0].(func(float64) error)
 	// applicationDescApplicationFeeCurrency is the schema descriptor for application_fee_currency field.
 	applicationDescApplicationFeeCurrency := applicationFields[16].Descriptor()
 	// application.DefaultApplicationFeeCurrency holds the default value on creation for the application_fee_currency field.
@@ -205,6 +235,10 @@ func init() {
 	// baseentity.DefaultID holds the default value on creation for the id field.
 	baseentity.DefaultID = baseentityDescID.Default.(func() uuid.UUID)
 	buildingMixin := schema.Building{}.Mixin()
+	buildingHooks := schema.Building{}.Hooks()
+	building.Hooks[0] = buildingHooks[0]
+	buildingMixinInters1 := buildingMixin[1].Interceptors()
+	building.Interceptors[0] = buildingMixinInters1[0]
 	buildingMixinFields0 := buildingMixin[0].Fields()
 	_ = buildingMixinFields0
 	buildingFields := schema.Building{}.Fields()
@@ -231,6 +265,42 @@ func init() {
 	buildingDescName := buildingFields[1].Descriptor()
 	// building.NameValidator is a validator for the "name" field. It is called by the builders before save.
 	building.NameValidator = buildingDescName.Validators[0].(func(string) error)
+	// buildingDescFloors is the schema descriptor for floors field.
+	buildingDescFloors := buildingFields[6].Descriptor()
+	// building.FloorsValidator is a validator for the "floors" field. It is called by the builders before save.
+	building.FloorsValidator = buildingDescFloors.Validators[0].(func(int) error)
+	// buildingDescYearBuilt is the schema descriptor for year_built field.
+	buildingDescYearBuilt := buildingFields[7].Descriptor()
+	// building.YearBuiltValidator is a validator for the "year_built" field. It is called by the builders before save.
+	building.YearBuiltValidator = func() func(int) error {
+		validators := buildingDescYearBuilt.Validators
+		fns := [...]func(int) error{
+			validators[0].(func(int) error),
+			validators[1].(func(int) error),
+		}
+		return func(year_built int) error {
+			for _, fn := range fns {
+				if err := fn(year_built); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// buildingDescTotalSquareFootage is the schema descriptor for total_square_footage field.
+	buildingDescTotalSquareFootage := buildingFields[8].Descriptor()
+	// building.TotalSquareFootageValidator is a validator for the "total_square_footage" field. It is called by the builders before save.
+	building.TotalSquareFootageValidator = buildingDescTotalSquareFootage.Validators[0].(func(float64) error)
+	// buildingDescTotalRentableSquareFootage is the schema descriptor for total_rentable_square_footage field.
+	buildingDescTotalRentableSquareFootage := buildingFields[9].Descriptor()
+	// building.TotalRentableSquareFootageValidator is a validator for the "total_rentable_square_footage" field. It is called by the builders before save.
+	building.TotalRentableSquareFootageValidator = buildingDescTotalRentableSquareFootage.Validators[0].(func(float64) error)
+	// buildingDescVersion is the schema descriptor for version field.
+	buildingDescVersion := buildingFields[10].Descriptor()
+	// building.DefaultVersion holds the default value on creation for the version field.
+	building.DefaultVersion = buildingDescVersion.Default.(int)
+	// building.VersionValidator is a validator for the "version" field. It is called by the builders before save.
+	building.VersionValidator = buildingDescVersion.Validators[0].(func(int) error)
 	// buildingDescID is the schema descriptor for id field.
 	buildingDescID := buildingFields[0].Descriptor()
 	// building.DefaultID holds the default value on creation for the id field.
@@ -318,6 +388,10 @@ func init() {
 	jurisdictionDescName := jurisdictionFields[1].Descriptor()
 	// jurisdiction.NameValidator is a validator for the "name" field. It is called by the builders before save.
 	jurisdiction.NameValidator = jurisdictionDescName.Validators[0].(func(string) error)
+	// jurisdictionDescCountryCode is the schema descriptor for country_code field.
+	jurisdictionDescCountryCode := jurisdictionFields[5].Descriptor()
+	// jurisdiction.DefaultCountryCode holds the default value on creation for the country_code field.
+	jurisdiction.DefaultCountryCode = jurisdictionDescCountryCode.Default.(string)
 	// jurisdictionDescID is the schema descriptor for id field.
 	jurisdictionDescID := jurisdictionFields[0].Descriptor()
 	// jurisdiction.DefaultID holds the default value on creation for the id field.
@@ -386,6 +460,12 @@ func init() {
 	lease.DefaultSecurityDepositCurrency = leaseDescSecurityDepositCurrency.Default.(string)
 	// lease.SecurityDepositCurrencyValidator is a validator for the "security_deposit_currency" field. It is called by the builders before save.
 	lease.SecurityDepositCurrencyValidator = leaseDescSecurityDepositCurrency.Validators[0].(func(string) error)
+	// leaseDescNoticeRequiredDays is the schema descriptor for notice_required_days field.
+	leaseDescNoticeRequiredDays := leaseFields[29].Descriptor()
+	// lease.DefaultNoticeRequiredDays holds the default value on creation for the notice_required_days field.
+	lease.DefaultNoticeRequiredDays = leaseDescNoticeRequiredDays.Default.(int)
+	// lease.NoticeRequiredDaysValidator is a validator for the "notice_required_days" field. It is called by the builders before save.
+	lease.NoticeRequiredDaysValidator = leaseDescNoticeRequiredDays.Validators[0].(func(int) error)
 	// leaseDescCleaningFeeCurrency is the schema descriptor for cleaning_fee_currency field.
 	leaseDescCleaningFeeCurrency := leaseFields[33].Descriptor()
 	// lease.DefaultCleaningFeeCurrency holds the default value on creation for the cleaning_fee_currency field.
@@ -427,6 +507,10 @@ func init() {
 	leasespaceDescIsPrimary := leasespaceFields[1].Descriptor()
 	// leasespace.DefaultIsPrimary holds the default value on creation for the is_primary field.
 	leasespace.DefaultIsPrimary = leasespaceDescIsPrimary.Default.(bool)
+	// leasespaceDescSquareFootageLeased is the schema descriptor for square_footage_leased field.
+	leasespaceDescSquareFootageLeased := leasespaceFields[4].Descriptor()
+	// leasespace.SquareFootageLeasedValidator is a validator for the "square_footage_leased" field. It is called by the builders before save.
+	leasespace.SquareFootageLeasedValidator = leasespaceDescSquareFootageLeased.Validators[0].(func(float64) error)
 	// leasespaceDescID is the schema descriptor for id field.
 	leasespaceDescID := leasespaceFields[0].Descriptor()
 	// leasespace.DefaultID holds the default value on creation for the id field.
@@ -536,6 +620,10 @@ func init() {
 	personDescDisplayName := personFields[4].Descriptor()
 	// person.DisplayNameValidator is a validator for the "display_name" field. It is called by the builders before save.
 	person.DisplayNameValidator = personDescDisplayName.Validators[0].(func(string) error)
+	// personDescLanguagePreference is the schema descriptor for language_preference field.
+	personDescLanguagePreference := personFields[10].Descriptor()
+	// person.DefaultLanguagePreference holds the default value on creation for the language_preference field.
+	person.DefaultLanguagePreference = personDescLanguagePreference.Default.(string)
 	// personDescDoNotContact is the schema descriptor for do_not_contact field.
 	personDescDoNotContact := personFields[12].Descriptor()
 	// person.DefaultDoNotContact holds the default value on creation for the do_not_contact field.
@@ -635,6 +723,44 @@ func init() {
 	propertyDescName := propertyFields[1].Descriptor()
 	// property.NameValidator is a validator for the "name" field. It is called by the builders before save.
 	property.NameValidator = propertyDescName.Validators[0].(func(string) error)
+	// propertyDescYearBuilt is the schema descriptor for year_built field.
+	propertyDescYearBuilt := propertyFields[5].Descriptor()
+	// property.YearBuiltValidator is a validator for the "year_built" field. It is called by the builders before save.
+	property.YearBuiltValidator = func() func(int) error {
+		validators := propertyDescYearBuilt.Validators
+		fns := [...]func(int) error{
+			validators[0].(func(int) error),
+			validators[1].(func(int) error),
+		}
+		return func(year_built int) error {
+			for _, fn := range fns {
+				if err := fn(year_built); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// propertyDescTotalSquareFootage is the schema descriptor for total_square_footage field.
+	propertyDescTotalSquareFootage := propertyFields[6].Descriptor()
+	// property.TotalSquareFootageValidator is a validator for the "total_square_footage" field. It is called by the builders before save.
+	property.TotalSquareFootageValidator = propertyDescTotalSquareFootage.Validators[0].(func(float64) error)
+	// propertyDescTotalSpaces is the schema descriptor for total_spaces field.
+	propertyDescTotalSpaces := propertyFields[7].Descriptor()
+	// property.TotalSpacesValidator is a validator for the "total_spaces" field. It is called by the builders before save.
+	property.TotalSpacesValidator = propertyDescTotalSpaces.Validators[0].(func(int) error)
+	// propertyDescLotSizeSqft is the schema descriptor for lot_size_sqft field.
+	propertyDescLotSizeSqft := propertyFields[8].Descriptor()
+	// property.LotSizeSqftValidator is a validator for the "lot_size_sqft" field. It is called by the builders before save.
+	property.LotSizeSqftValidator = propertyDescLotSizeSqft.Validators[0].(func(float64) error)
+	// propertyDescStories is the schema descriptor for stories field.
+	propertyDescStories := propertyFields[9].Descriptor()
+	// property.StoriesValidator is a validator for the "stories" field. It is called by the builders before save.
+	property.StoriesValidator = propertyDescStories.Validators[0].(func(int) error)
+	// propertyDescParkingSpaces is the schema descriptor for parking_spaces field.
+	propertyDescParkingSpaces := propertyFields[10].Descriptor()
+	// property.ParkingSpacesValidator is a validator for the "parking_spaces" field. It is called by the builders before save.
+	property.ParkingSpacesValidator = propertyDescParkingSpaces.Validators[0].(func(int) error)
 	// propertyDescRentControlled is the schema descriptor for rent_controlled field.
 	propertyDescRentControlled := propertyFields[12].Descriptor()
 	// property.DefaultRentControlled holds the default value on creation for the rent_controlled field.
@@ -715,6 +841,10 @@ func init() {
 	reconciliation.DefaultDifferenceCurrency = reconciliationDescDifferenceCurrency.Default.(string)
 	// reconciliation.DifferenceCurrencyValidator is a validator for the "difference_currency" field. It is called by the builders before save.
 	reconciliation.DifferenceCurrencyValidator = reconciliationDescDifferenceCurrency.Validators[0].(func(string) error)
+	// reconciliationDescUnreconciledItems is the schema descriptor for unreconciled_items field.
+	reconciliationDescUnreconciledItems := reconciliationFields[11].Descriptor()
+	// reconciliation.UnreconciledItemsValidator is a validator for the "unreconciled_items" field. It is called by the builders before save.
+	reconciliation.UnreconciledItemsValidator = reconciliationDescUnreconciledItems.Validators[0].(func(int) error)
 	// reconciliationDescID is the schema descriptor for id field.
 	reconciliationDescID := reconciliationFields[0].Descriptor()
 	// reconciliation.DefaultID holds the default value on creation for the id field.
@@ -752,6 +882,18 @@ func init() {
 	spaceDescSharedWithParent := spaceFields[5].Descriptor()
 	// space.DefaultSharedWithParent holds the default value on creation for the shared_with_parent field.
 	space.DefaultSharedWithParent = spaceDescSharedWithParent.Default.(bool)
+	// spaceDescSquareFootage is the schema descriptor for square_footage field.
+	spaceDescSquareFootage := spaceFields[6].Descriptor()
+	// space.SquareFootageValidator is a validator for the "square_footage" field. It is called by the builders before save.
+	space.SquareFootageValidator = spaceDescSquareFootage.Validators[0].(func(float64) error)
+	// spaceDescBedrooms is the schema descriptor for bedrooms field.
+	spaceDescBedrooms := spaceFields[7].Descriptor()
+	// space.BedroomsValidator is a validator for the "bedrooms" field. It is called by the builders before save.
+	space.BedroomsValidator = spaceDescBedrooms.Validators[0].(func(int) error)
+	// spaceDescBathrooms is the schema descriptor for bathrooms field.
+	spaceDescBathrooms := spaceFields[8].Descriptor()
+	// space.BathroomsValidator is a validator for the "bathrooms" field. It is called by the builders before save.
+	space.BathroomsValidator = spaceDescBathrooms.Validators[0].(func(float64) error)
 	// spaceDescAdaAccessible is the schema descriptor for ada_accessible field.
 	spaceDescAdaAccessible := spaceFields[12].Descriptor()
 	// space.DefaultAdaAccessible holds the default value on creation for the ada_accessible field.
@@ -770,6 +912,24 @@ func init() {
 	space.DefaultMarketRentCurrency = spaceDescMarketRentCurrency.Default.(string)
 	// space.MarketRentCurrencyValidator is a validator for the "market_rent_currency" field. It is called by the builders before save.
 	space.MarketRentCurrencyValidator = spaceDescMarketRentCurrency.Validators[0].(func(string) error)
+	// spaceDescAmiRestriction is the schema descriptor for ami_restriction field.
+	spaceDescAmiRestriction := spaceFields[18].Descriptor()
+	// space.AmiRestrictionValidator is a validator for the "ami_restriction" field. It is called by the builders before save.
+	space.AmiRestrictionValidator = func() func(int) error {
+		validators := spaceDescAmiRestriction.Validators
+		fns := [...]func(int) error{
+			validators[0].(func(int) error),
+			validators[1].(func(int) error),
+		}
+		return func(ami_restriction int) error {
+			for _, fn := range fns {
+				if err := fn(ami_restriction); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
 	// spaceDescID is the schema descriptor for id field.
 	spaceDescID := spaceFields[0].Descriptor()
 	// space.DefaultID holds the default value on creation for the id field.
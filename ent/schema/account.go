@@ -9,6 +9,7 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 	"entgo.io/ent/schema/mixin"
 	"github.com/google/uuid"
 	"github.com/matthewbaird/ontology/internal/types"
@@ -33,21 +34,21 @@ func (Account) Mixin() []ent.Mixin {
 func (Account) Fields() []ent.Field {
 	return []ent.Field{
 		field.UUID("id", uuid.UUID{}).Default(uuid.New).Immutable().Comment("Primary key"),
-		field.String("account_number").SchemaType(map[string]string{"postgres": "varchar"}),
+		field.String("account_number").Unique().SchemaType(map[string]string{"postgres": "varchar"}),
 		field.String("name").SchemaType(map[string]string{"postgres": "varchar"}),
-		field.String("description").Optional().Nillable().SchemaType(map[string]string{"postgres": "varchar"}),
-		field.Enum("account_type").Values("asset", "liability", "equity", "revenue", "expense"),
-		field.Enum("account_subtype").Values("cash", "accounts_receivable", "prepaid", "fixed_asset", "accumulated_depreciation", "other_asset", "accounts_payable", "accrued_liability", "unearned_revenue", "security_deposits_held", "other_liability", "owners_equity", "retained_earnings", "distributions", "rental_income", "other_income", "cam_recovery", "percentage_rent_income", "operating_expense", "maintenance_expense", "utility_expense", "management_fee_expense", "depreciation_expense", "other_expense"),
-		field.UUID("parent_account_id", uuid.UUID{}).Optional().Nillable(),
-		field.Int("depth"),
+		field.String("description").Optional().Nillable().SchemaType(map[string]string{"postgres": "text"}),
+		field.Enum("account_type").Values("asset", "liability", "equity", "revenue", "expense").Annotations(FieldDoc{EnumValues: []string{"asset", "liability", "equity", "revenue", "expense"}}),
+		field.Enum("account_subtype").Values("cash", "accounts_receivable", "prepaid", "fixed_asset", "accumulated_depreciation", "other_asset", "accounts_payable", "accrued_liability", "unearned_revenue", "security_deposits_held", "other_liability", "owners_equity", "retained_earnings", "distributions", "rental_income", "other_income", "cam_recovery", "percentage_rent_income", "operating_expense", "maintenance_expense", "utility_expense", "management_fee_expense", "depreciation_expense", "other_expense").Annotations(FieldDoc{EnumValues: []string{"cash", "accounts_receivable", "prepaid", "fixed_asset", "accumulated_depreciation", "other_asset", "accounts_payable", "accrued_liability", "unearned_revenue", "security_deposits_held", "other_liability", "owners_equity", "retained_earnings", "distributions", "rental_income", "other_income", "cam_recovery", "percentage_rent_income", "operating_expense", "maintenance_expense", "utility_expense", "management_fee_expense", "depreciation_expense", "other_expense"}}),
+		field.UUID("parent_account_id", uuid.UUID{}).Optional().Nillable().Annotations(FieldDoc{Format: "uuid"}),
+		field.Int("depth").NonNegative().Annotations(FieldDoc{Format: "int32"}),
 		field.JSON("dimensions", &types.AccountDimensions{}).Optional(),
-		field.Enum("normal_balance").Values("debit", "credit"),
+		field.Enum("normal_balance").Values("debit", "credit").Annotations(FieldDoc{EnumValues: []string{"debit", "credit"}}),
 		field.Bool("is_header").Default(false),
 		field.Bool("is_system").Default(false),
 		field.Bool("allows_direct_posting").Default(true),
-		field.Enum("status").Values("active", "inactive", "archived"),
+		field.Enum("status").Values("active", "inactive", "archived").Annotations(FieldDoc{EnumValues: []string{"active", "inactive", "archived"}}),
 		field.Bool("is_trust_account").Default(false),
-		field.Enum("trust_type").Values("operating", "security_deposit", "escrow").Optional().Nillable(),
+		field.Enum("trust_type").Values("operating", "security_deposit", "escrow").Optional().Nillable().Annotations(FieldDoc{EnumValues: []string{"operating", "security_deposit", "escrow"}}),
 		field.Int64("budget_amount_amount_cents").Optional().Nillable().Comment("budget_amount — amount in cents"),
 		field.String("budget_amount_currency").Optional().Nillable().Default("USD").Match(regexp.MustCompile(`^[A-Z]{3}$`)).Comment("budget_amount — ISO 4217 currency code"),
 		field.String("tax_line").Optional().Nillable().SchemaType(map[string]string{"postgres": "varchar"}),
@@ -64,6 +65,14 @@ func (Account) Edges() []ent.Edge {
 	}
 }
 
+// Indexes of the Account.
+func (Account) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("parent_account_id"),
+		index.Fields("status"),
+	}
+}
+
 // Hooks returns cross-field constraint validation hooks.
 // Generated from CUE ontology conditional blocks.
 func (Account) Hooks() []ent.Hook {
@@ -105,13 +114,13 @@ func validateAccountConstraints() ent.Hook {
 				at := fmt.Sprint(v)
 				if at == "asset" || at == "expense" {
 					if nb, ok := getField("normal_balance"); ok && toString(nb) != "debit" {
-						return nil, fmt.Errorf("%s account must have normal_balance=debit", at)
+						return nil, constraintErrorf("normal_balance", "%s account must have normal_balance=debit", at)
 					}
 				}
 				// Liability, equity, and revenue accounts must have credit normal balance
 				if at == "liability" || at == "equity" || at == "revenue" {
 					if nb, ok := getField("normal_balance"); ok && toString(nb) != "credit" {
-						return nil, fmt.Errorf("%s account must have normal_balance=credit", at)
+						return nil, constraintErrorf("normal_balance", "%s account must have normal_balance=credit", at)
 					}
 				}
 			}
@@ -119,7 +128,7 @@ func validateAccountConstraints() ent.Hook {
 			// Header accounts cannot accept direct postings
 			if v, ok := getField("is_header"); ok && fmt.Sprint(v) == "true" {
 				if adp, ok := getField("allows_direct_posting"); ok && fmt.Sprint(adp) == "true" {
-					return nil, fmt.Errorf("header account must have allows_direct_posting=false")
+					return nil, constraintErrorf("allows_direct_posting", "header account must have allows_direct_posting=false")
 				}
 			}
 
@@ -127,7 +136,7 @@ func validateAccountConstraints() ent.Hook {
 			if v, ok := getField("is_trust_account"); ok && fmt.Sprint(v) == "true" {
 				tt, ttOk := getField("trust_type")
 				if !ttOk || toString(tt) == "" {
-					return nil, fmt.Errorf("trust account must have trust_type set")
+					return nil, constraintErrorf("trust_type", "trust account must have trust_type set")
 				}
 			}
 			return next.Mutate(ctx, m)
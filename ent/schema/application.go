@@ -9,6 +9,7 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 	"entgo.io/ent/schema/mixin"
 	"github.com/google/uuid"
 )
@@ -32,19 +33,19 @@ func (Application) Mixin() []ent.Mixin {
 func (Application) Fields() []ent.Field {
 	return []ent.Field{
 		field.UUID("id", uuid.UUID{}).Default(uuid.New).Immutable().Comment("Primary key"),
-		field.UUID("applicant_person_id", uuid.UUID{}),
-		field.Enum("status").Values("submitted", "screening", "under_review", "approved", "conditionally_approved", "denied", "withdrawn", "expired"),
-		field.Time("desired_move_in"),
-		field.Int("desired_lease_term_months"),
+		field.UUID("applicant_person_id", uuid.UUID{}).Annotations(FieldDoc{Format: "uuid"}),
+		field.Enum("status").Values("submitted", "screening", "under_review", "approved", "conditionally_approved", "denied", "withdrawn", "expired").Annotations(FieldDoc{EnumValues: []string{"submitted", "screening", "under_review", "approved", "conditionally_approved", "denied", "withdrawn", "expired"}}),
+		field.Time("desired_move_in").Annotations(FieldDoc{Format: "date-time"}),
+		field.Int("desired_lease_term_months").Positive().Annotations(FieldDoc{Format: "int32"}),
 		field.String("screening_request_id").Optional().Nillable().SchemaType(map[string]string{"postgres": "varchar"}),
-		field.Time("screening_completed").Optional().Nillable(),
-		field.Int("credit_score").Optional().Nillable(),
+		field.Time("screening_completed").Optional().Nillable().Annotations(FieldDoc{Format: "date-time"}),
+		field.Int("credit_score").Optional().Nillable().Min(300).Max(850).Annotations(FieldDoc{Format: "int32"}),
 		field.Bool("background_clear").Default(false),
 		field.Bool("income_verified").Default(false),
-		field.Float("income_to_rent_ratio").Optional().Nillable(),
+		field.Float("income_to_rent_ratio").Optional().Nillable().Min(0).Annotations(FieldDoc{Format: "double"}),
 		field.String("decision_by").Optional().Nillable().SchemaType(map[string]string{"postgres": "varchar"}),
-		field.Time("decision_at").Optional().Nillable(),
-		field.String("decision_reason").Optional().Nillable().SchemaType(map[string]string{"postgres": "varchar"}),
+		field.Time("decision_at").Optional().Nillable().Annotations(FieldDoc{Format: "date-time"}),
+		field.String("decision_reason").Optional().Nillable().SchemaType(map[string]string{"postgres": "text"}),
 		field.JSON("conditions", []string{}).Optional(),
 		field.Int64("application_fee_amount_cents").Comment("application_fee — amount in cents"),
 		field.String("application_fee_currency").Default("USD").Match(regexp.MustCompile(`^[A-Z]{3}$`)).Comment("application_fee — ISO 4217 currency code"),
@@ -62,6 +63,16 @@ func (Application) Edges() []ent.Edge {
 	}
 }
 
+// Indexes of the Application.
+func (Application) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Edges("property"),
+		index.Edges("space"),
+		index.Fields("applicant_person_id"),
+		index.Fields("status"),
+	}
+}
+
 // ValidApplicationTransitions defines the allowed state machine transitions.
 // Generated from CUE ontology state_machines.cue.
 var ValidApplicationTransitions = map[string][]string{
@@ -75,6 +86,10 @@ var ValidApplicationTransitions = map[string][]string{
 	"withdrawn":              {},
 }
 
+// ApplicationTransitionGuards holds the conditions of guarded transitions, keyed
+// by source then target state. Transitions not listed here are unconditional.
+var ApplicationTransitionGuards = map[string]map[string]TransitionGuard{}
+
 // Hooks returns cross-field constraint validation hooks.
 // Generated from CUE ontology conditional blocks.
 func (Application) Hooks() []ent.Hook {
@@ -121,10 +136,10 @@ func validateApplicationConstraints() ent.Hook {
 				if m.Op().Is(ent.OpCreate) {
 					db, dbOk := getField("decision_by")
 					if !dbOk || toString(db) == "" {
-						return nil, fmt.Errorf("%s application must have decision_by set", status)
+						return nil, constraintErrorf("decision_by", "%s application must have decision_by set", status)
 					}
 					if _, ok := getField("decision_at"); !ok {
-						return nil, fmt.Errorf("%s application must have decision_at set", status)
+						return nil, constraintErrorf("decision_at", "%s application must have decision_at set", status)
 					}
 				}
 			}
@@ -134,7 +149,7 @@ func validateApplicationConstraints() ent.Hook {
 				dr, drOk := getField("decision_reason")
 				if !drOk || toString(dr) == "" {
 					if m.Op().Is(ent.OpCreate) {
-						return nil, fmt.Errorf("denied application must have decision_reason set (fair housing compliance)")
+						return nil, constraintErrorf("decision_reason", "denied application must have decision_reason set (fair housing compliance)")
 					}
 				}
 			}
@@ -7,6 +7,7 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 	"entgo.io/ent/schema/mixin"
 	"github.com/google/uuid"
 )
@@ -31,7 +32,7 @@ func (BankAccount) Fields() []ent.Field {
 	return []ent.Field{
 		field.UUID("id", uuid.UUID{}).Default(uuid.New).Immutable().Comment("Primary key"),
 		field.String("name").SchemaType(map[string]string{"postgres": "varchar"}),
-		field.Enum("account_type").Values("operating", "trust", "security_deposit", "escrow", "reserve"),
+		field.Enum("account_type").Values("operating", "trust", "security_deposit", "escrow", "reserve").Annotations(FieldDoc{EnumValues: []string{"operating", "trust", "security_deposit", "escrow", "reserve"}}),
 		field.String("institution_name").SchemaType(map[string]string{"postgres": "varchar"}),
 		field.String("routing_number").Sensitive().SchemaType(map[string]string{"postgres": "varchar"}),
 		field.String("account_mask").Sensitive().SchemaType(map[string]string{"postgres": "varchar"}),
@@ -40,13 +41,13 @@ func (BankAccount) Fields() []ent.Field {
 		field.String("plaid_access_token").Optional().Nillable().Sensitive().SchemaType(map[string]string{"postgres": "varchar"}),
 		field.String("property_id").Optional().Nillable().SchemaType(map[string]string{"postgres": "varchar"}),
 		field.String("entity_id").Optional().Nillable().SchemaType(map[string]string{"postgres": "varchar"}),
-		field.Enum("status").Values("active", "inactive", "frozen", "closed"),
+		field.Enum("status").Values("active", "inactive", "frozen", "closed").Annotations(FieldDoc{EnumValues: []string{"active", "inactive", "frozen", "closed"}}),
 		field.Bool("is_default").Default(false),
 		field.Bool("accepts_deposits").Default(true),
 		field.Bool("accepts_payments").Default(true),
 		field.Int64("current_balance_amount_cents").Optional().Nillable().Comment("current_balance — amount in cents"),
 		field.String("current_balance_currency").Optional().Nillable().Default("USD").Match(regexp.MustCompile(`^[A-Z]{3}$`)).Comment("current_balance — ISO 4217 currency code"),
-		field.Time("last_statement_date").Optional().Nillable(),
+		field.Time("last_statement_date").Optional().Nillable().Annotations(FieldDoc{Format: "date-time"}),
 	}
 }
 
@@ -60,6 +61,14 @@ func (BankAccount) Edges() []ent.Edge {
 	}
 }
 
+// Indexes of the BankAccount.
+func (BankAccount) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Edges("gl_account"),
+		index.Fields("status"),
+	}
+}
+
 // ValidBankAccountTransitions defines the allowed state machine transitions.
 // Generated from CUE ontology state_machines.cue.
 var ValidBankAccountTransitions = map[string][]string{
@@ -68,3 +77,7 @@ var ValidBankAccountTransitions = map[string][]string{
 	"frozen":   {"active", "closed"},
 	"inactive": {"active", "closed"},
 }
+
+// BankAccountTransitionGuards holds the conditions of guarded transitions, keyed
+// by source then target state. Transitions not listed here are unconditional.
+var BankAccountTransitionGuards = map[string]map[string]TransitionGuard{}
@@ -2,9 +2,12 @@
 package schema
 
 import (
+	"context"
+
 	"entgo.io/ent"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 	"entgo.io/ent/schema/mixin"
 	"github.com/google/uuid"
 	"github.com/matthewbaird/ontology/internal/types"
@@ -22,6 +25,7 @@ type Building struct {
 func (Building) Mixin() []ent.Mixin {
 	return []ent.Mixin{
 		AuditMixin{},
+		SoftDeleteMixin{},
 	}
 }
 
@@ -30,14 +34,15 @@ func (Building) Fields() []ent.Field {
 	return []ent.Field{
 		field.UUID("id", uuid.UUID{}).Default(uuid.New).Immutable().Comment("Primary key"),
 		field.String("name").NotEmpty().SchemaType(map[string]string{"postgres": "varchar"}),
-		field.Enum("building_type").Values("residential", "commercial", "mixed_use", "parking_structure", "industrial", "storage", "auxiliary"),
+		field.Enum("building_type").Values("residential", "commercial", "mixed_use", "parking_structure", "industrial", "storage", "auxiliary").Annotations(FieldDoc{EnumValues: []string{"residential", "commercial", "mixed_use", "parking_structure", "industrial", "storage", "auxiliary"}}),
 		field.JSON("address", &types.Address{}).Optional(),
-		field.String("description").Optional().Nillable().SchemaType(map[string]string{"postgres": "varchar"}),
-		field.Enum("status").Values("active", "inactive", "under_renovation"),
-		field.Int("floors").Optional().Nillable(),
-		field.Int("year_built").Optional().Nillable(),
-		field.Float("total_square_footage").Optional().Nillable(),
-		field.Float("total_rentable_square_footage").Optional().Nillable(),
+		field.String("description").Optional().Nillable().SchemaType(map[string]string{"postgres": "text"}),
+		field.Enum("status").Values("active", "inactive", "under_renovation").Annotations(FieldDoc{EnumValues: []string{"active", "inactive", "under_renovation"}}),
+		field.Int("floors").Optional().Nillable().Min(1).Annotations(FieldDoc{Format: "int32"}),
+		field.Int("year_built").Optional().Nillable().Min(1800).Max(2030).Annotations(FieldDoc{Format: "int32"}),
+		field.Float("total_square_footage").Optional().Nillable().Positive().Annotations(FieldDoc{Format: "double"}),
+		field.Float("total_rentable_square_footage").Optional().Nillable().Positive().Annotations(FieldDoc{Format: "double"}),
+		field.Int("version").Default(1).NonNegative().Comment("Optimistic concurrency token; incremented on every update"),
 	}
 }
 
@@ -49,6 +54,14 @@ func (Building) Edges() []ent.Edge {
 	}
 }
 
+// Indexes of the Building.
+func (Building) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Edges("property"),
+		index.Fields("status"),
+	}
+}
+
 // ValidBuildingTransitions defines the allowed state machine transitions.
 // Generated from CUE ontology state_machines.cue.
 var ValidBuildingTransitions = map[string][]string{
@@ -56,3 +69,27 @@ var ValidBuildingTransitions = map[string][]string{
 	"inactive":         {"active"},
 	"under_renovation": {"active"},
 }
+
+// BuildingTransitionGuards holds the conditions of guarded transitions, keyed
+// by source then target state. Transitions not listed here are unconditional.
+var BuildingTransitionGuards = map[string]map[string]TransitionGuard{}
+
+// Hooks returns the version stamping hook.
+func (Building) Hooks() []ent.Hook {
+	return []ent.Hook{
+		stampBuildingVersion(),
+	}
+}
+
+func stampBuildingVersion() ent.Hook {
+	return func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if m.Op().Is(ent.OpUpdate | ent.OpUpdateOne) {
+				if err := m.AddField("version", 1); err != nil {
+					return nil, err
+				}
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 	"entgo.io/ent/schema/mixin"
 	"github.com/google/uuid"
 	"github.com/matthewbaird/ontology/internal/types"
@@ -32,20 +33,20 @@ func (JournalEntry) Mixin() []ent.Mixin {
 func (JournalEntry) Fields() []ent.Field {
 	return []ent.Field{
 		field.UUID("id", uuid.UUID{}).Default(uuid.New).Immutable().Comment("Primary key"),
-		field.Time("entry_date").Immutable(),
-		field.Time("posted_date"),
-		field.String("description").Immutable().SchemaType(map[string]string{"postgres": "varchar"}),
-		field.Enum("source_type").Values("manual", "auto_charge", "payment", "bank_import", "cam_reconciliation", "depreciation", "accrual", "intercompany", "management_fee", "system").Immutable(),
+		field.Time("entry_date").Immutable().Annotations(FieldDoc{Format: "date-time"}),
+		field.Time("posted_date").Annotations(FieldDoc{Format: "date-time"}),
+		field.String("description").Immutable().SchemaType(map[string]string{"postgres": "text"}),
+		field.Enum("source_type").Values("manual", "auto_charge", "payment", "bank_import", "cam_reconciliation", "depreciation", "accrual", "intercompany", "management_fee", "system").Immutable().Annotations(FieldDoc{EnumValues: []string{"manual", "auto_charge", "payment", "bank_import", "cam_reconciliation", "depreciation", "accrual", "intercompany", "management_fee", "system"}}),
 		field.String("source_id").Optional().Nillable().Immutable().SchemaType(map[string]string{"postgres": "varchar"}),
-		field.Enum("status").Values("draft", "pending_approval", "posted", "voided"),
+		field.Enum("status").Values("draft", "pending_approval", "posted", "voided").Annotations(FieldDoc{EnumValues: []string{"draft", "pending_approval", "posted", "voided"}}),
 		field.String("approved_by").Optional().Nillable().SchemaType(map[string]string{"postgres": "varchar"}),
-		field.Time("approved_at").Optional().Nillable(),
+		field.Time("approved_at").Optional().Nillable().Annotations(FieldDoc{Format: "date-time"}),
 		field.String("batch_id").Optional().Nillable().Immutable().SchemaType(map[string]string{"postgres": "varchar"}),
 		field.String("entity_id").Optional().Nillable().Immutable().SchemaType(map[string]string{"postgres": "varchar"}),
 		field.String("property_id").Optional().Nillable().Immutable().SchemaType(map[string]string{"postgres": "varchar"}),
 		field.String("reverses_journal_id").Optional().Nillable().Immutable().SchemaType(map[string]string{"postgres": "varchar"}),
 		field.String("reversed_by_journal_id").Optional().Nillable().SchemaType(map[string]string{"postgres": "varchar"}),
-		field.JSON("lines", []types.JournalLine{}).Immutable(),
+		field.JSON("lines", []types.JournalLine{}).Immutable().Comment("Line items — minimum 2 lines for double-entry"),
 	}
 }
 
@@ -56,6 +57,13 @@ func (JournalEntry) Edges() []ent.Edge {
 	}
 }
 
+// Indexes of the JournalEntry.
+func (JournalEntry) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("status"),
+	}
+}
+
 // ValidJournalEntryTransitions defines the allowed state machine transitions.
 // Generated from CUE ontology state_machines.cue.
 var ValidJournalEntryTransitions = map[string][]string{
@@ -65,6 +73,10 @@ var ValidJournalEntryTransitions = map[string][]string{
 	"voided":           {},
 }
 
+// JournalEntryTransitionGuards holds the conditions of guarded transitions, keyed
+// by source then target state. Transitions not listed here are unconditional.
+var JournalEntryTransitionGuards = map[string]map[string]TransitionGuard{}
+
 // Hooks returns cross-field constraint validation hooks.
 // Generated from CUE ontology conditional blocks.
 func (JournalEntry) Hooks() []ent.Hook {
@@ -115,11 +127,11 @@ func validateJournalEntryConstraints() ent.Hook {
 				ab, abOk := getField("approved_by")
 				if !abOk || toString(ab) == "" {
 					if m.Op().Is(ent.OpCreate) {
-						return nil, fmt.Errorf("posted manual journal entry must have approved_by set")
+						return nil, constraintErrorf("approved_by", "posted manual journal entry must have approved_by set")
 					}
 				}
 				if _, ok := getField("approved_at"); !ok && m.Op().Is(ent.OpCreate) {
-					return nil, fmt.Errorf("posted manual journal entry must have approved_at set")
+					return nil, constraintErrorf("approved_at", "posted manual journal entry must have approved_at set")
 				}
 			}
 
@@ -128,7 +140,7 @@ func validateJournalEntryConstraints() ent.Hook {
 				rbj, rbjOk := getField("reversed_by_journal_id")
 				if !rbjOk || toString(rbj) == "" {
 					if m.Op().Is(ent.OpCreate) {
-						return nil, fmt.Errorf("voided journal entry must have reversed_by_journal_id set")
+						return nil, constraintErrorf("reversed_by_journal_id", "voided journal entry must have reversed_by_journal_id set")
 					}
 				}
 			}
@@ -5,6 +5,7 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 	"entgo.io/ent/schema/mixin"
 	"github.com/google/uuid"
 )
@@ -29,14 +30,14 @@ func (Jurisdiction) Fields() []ent.Field {
 	return []ent.Field{
 		field.UUID("id", uuid.UUID{}).Default(uuid.New).Immutable().Comment("Primary key"),
 		field.String("name").NotEmpty().SchemaType(map[string]string{"postgres": "varchar"}),
-		field.Enum("jurisdiction_type").Values("federal", "state", "county", "city", "special_district", "unincorporated_area"),
+		field.Enum("jurisdiction_type").Values("federal", "state", "county", "city", "special_district", "unincorporated_area").Annotations(FieldDoc{EnumValues: []string{"federal", "state", "county", "city", "special_district", "unincorporated_area"}}),
 		field.String("fips_code").Optional().Nillable().SchemaType(map[string]string{"postgres": "varchar"}),
 		field.String("state_code").Optional().Nillable().SchemaType(map[string]string{"postgres": "varchar"}),
-		field.String("country_code").SchemaType(map[string]string{"postgres": "varchar"}),
-		field.Enum("status").Values("active", "dissolved", "merged", "pending"),
+		field.String("country_code").Default("US").SchemaType(map[string]string{"postgres": "varchar"}),
+		field.Enum("status").Values("active", "dissolved", "merged", "pending").Annotations(FieldDoc{EnumValues: []string{"active", "dissolved", "merged", "pending"}}),
 		field.String("successor_jurisdiction_id").Optional().Nillable().SchemaType(map[string]string{"postgres": "varchar"}),
-		field.Time("effective_date").Optional().Nillable(),
-		field.Time("dissolution_date").Optional().Nillable(),
+		field.Time("effective_date").Optional().Nillable().Annotations(FieldDoc{Format: "date-time"}),
+		field.Time("dissolution_date").Optional().Nillable().Annotations(FieldDoc{Format: "date-time"}),
 		field.String("governing_body").Optional().Nillable().SchemaType(map[string]string{"postgres": "varchar"}),
 		field.String("regulatory_url").Optional().Nillable().SchemaType(map[string]string{"postgres": "varchar"}),
 	}
@@ -52,6 +53,14 @@ func (Jurisdiction) Edges() []ent.Edge {
 	}
 }
 
+// Indexes of the Jurisdiction.
+func (Jurisdiction) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Edges("parent_jurisdiction"),
+		index.Fields("status"),
+	}
+}
+
 // ValidJurisdictionTransitions defines the allowed state machine transitions.
 // Generated from CUE ontology state_machines.cue.
 var ValidJurisdictionTransitions = map[string][]string{
@@ -60,3 +69,7 @@ var ValidJurisdictionTransitions = map[string][]string{
 	"merged":    {},
 	"pending":   {"active"},
 }
+
+// JurisdictionTransitionGuards holds the conditions of guarded transitions, keyed
+// by source then target state. Transitions not listed here are unconditional.
+var JurisdictionTransitionGuards = map[string]map[string]TransitionGuard{}
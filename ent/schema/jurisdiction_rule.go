@@ -7,6 +7,7 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 	"entgo.io/ent/schema/mixin"
 	"github.com/google/uuid"
 )
@@ -30,19 +31,19 @@ func (JurisdictionRule) Mixin() []ent.Mixin {
 func (JurisdictionRule) Fields() []ent.Field {
 	return []ent.Field{
 		field.UUID("id", uuid.UUID{}).Default(uuid.New).Immutable().Comment("Primary key"),
-		field.Enum("rule_type").Values("security_deposit_limit", "notice_period", "rent_increase_cap", "required_disclosure", "eviction_procedure", "late_fee_cap", "rent_control", "habitability_standard", "tenant_screening_restriction", "lease_term_restriction", "fee_restriction", "relocation_assistance", "right_to_counsel", "just_cause_eviction", "source_of_income_protection", "lead_paint_disclosure", "mold_disclosure", "bed_bug_disclosure", "flood_zone_disclosure", "utility_billing_restriction", "short_term_rental_restriction"),
-		field.Enum("status").Values("draft", "active", "superseded", "expired", "repealed"),
+		field.Enum("rule_type").Values("security_deposit_limit", "notice_period", "rent_increase_cap", "required_disclosure", "eviction_procedure", "late_fee_cap", "rent_control", "habitability_standard", "tenant_screening_restriction", "lease_term_restriction", "fee_restriction", "relocation_assistance", "right_to_counsel", "just_cause_eviction", "source_of_income_protection", "lead_paint_disclosure", "mold_disclosure", "bed_bug_disclosure", "flood_zone_disclosure", "utility_billing_restriction", "short_term_rental_restriction").Annotations(FieldDoc{EnumValues: []string{"security_deposit_limit", "notice_period", "rent_increase_cap", "required_disclosure", "eviction_procedure", "late_fee_cap", "rent_control", "habitability_standard", "tenant_screening_restriction", "lease_term_restriction", "fee_restriction", "relocation_assistance", "right_to_counsel", "just_cause_eviction", "source_of_income_protection", "lead_paint_disclosure", "mold_disclosure", "bed_bug_disclosure", "flood_zone_disclosure", "utility_billing_restriction", "short_term_rental_restriction"}}),
+		field.Enum("status").Values("draft", "active", "superseded", "expired", "repealed").Annotations(FieldDoc{EnumValues: []string{"draft", "active", "superseded", "expired", "repealed"}}),
 		field.JSON("applies_to_lease_types", []string{}).Optional(),
 		field.JSON("applies_to_property_types", []string{}).Optional(),
 		field.JSON("applies_to_space_types", []string{}).Optional(),
 		field.JSON("exemptions", json.RawMessage{}).Optional(),
-		field.JSON("rule_definition", json.RawMessage{}),
+		field.JSON("rule_definition", json.RawMessage{}).Comment("Typed rule definition — schema varies by rule_type Stored as JSON; validated at application layer per rule_type"),
 		field.String("statute_reference").Optional().Nillable().SchemaType(map[string]string{"postgres": "varchar"}),
 		field.String("ordinance_number").Optional().Nillable().SchemaType(map[string]string{"postgres": "varchar"}),
 		field.String("statute_url").Optional().Nillable().SchemaType(map[string]string{"postgres": "varchar"}),
-		field.Time("effective_date"),
-		field.Time("expiration_date").Optional().Nillable(),
-		field.Time("last_verified").Optional().Nillable(),
+		field.Time("effective_date").Annotations(FieldDoc{Format: "date-time"}),
+		field.Time("expiration_date").Optional().Nillable().Annotations(FieldDoc{Format: "date-time"}),
+		field.Time("last_verified").Optional().Nillable().Annotations(FieldDoc{Format: "date-time"}),
 		field.String("verified_by").Optional().Nillable().SchemaType(map[string]string{"postgres": "varchar"}),
 		field.String("verification_source").Optional().Nillable().SchemaType(map[string]string{"postgres": "varchar"}),
 	}
@@ -57,6 +58,14 @@ func (JurisdictionRule) Edges() []ent.Edge {
 	}
 }
 
+// Indexes of the JurisdictionRule.
+func (JurisdictionRule) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Edges("jurisdiction"),
+		index.Fields("status"),
+	}
+}
+
 // ValidJurisdictionRuleTransitions defines the allowed state machine transitions.
 // Generated from CUE ontology state_machines.cue.
 var ValidJurisdictionRuleTransitions = map[string][]string{
@@ -66,3 +75,7 @@ var ValidJurisdictionRuleTransitions = map[string][]string{
 	"repealed":   {},
 	"superseded": {},
 }
+
+// JurisdictionRuleTransitionGuards holds the conditions of guarded transitions, keyed
+// by source then target state. Transitions not listed here are unconditional.
+var JurisdictionRuleTransitionGuards = map[string]map[string]TransitionGuard{}
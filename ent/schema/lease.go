@@ -9,6 +9,7 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 	"entgo.io/ent/schema/mixin"
 	"github.com/google/uuid"
 	"github.com/matthewbaird/ontology/internal/types"
@@ -36,19 +37,19 @@ func (Lease) Fields() []ent.Field {
 		field.String("property_id").SchemaType(map[string]string{"postgres": "varchar"}),
 		field.JSON("tenant_role_ids", []string{}),
 		field.JSON("guarantor_role_ids", []string{}).Optional(),
-		field.Enum("lease_type").Values("fixed_term", "month_to_month", "commercial_nnn", "commercial_nn", "commercial_n", "commercial_gross", "commercial_modified_gross", "affordable", "section_8", "student", "ground_lease", "short_term", "membership"),
-		field.Enum("status").Values("draft", "pending_approval", "pending_signature", "active", "expired", "month_to_month_holdover", "renewed", "terminated", "eviction"),
-		field.String("description").Optional().Nillable().SchemaType(map[string]string{"postgres": "varchar"}),
-		field.Enum("liability_type").Values("joint_and_several", "individual", "by_the_bed", "proportional").Default("joint_and_several"),
+		field.Enum("lease_type").Values("fixed_term", "month_to_month", "commercial_nnn", "commercial_nn", "commercial_n", "commercial_gross", "commercial_modified_gross", "affordable", "section_8", "student", "ground_lease", "short_term", "membership").Annotations(FieldDoc{EnumValues: []string{"fixed_term", "month_to_month", "commercial_nnn", "commercial_nn", "commercial_n", "commercial_gross", "commercial_modified_gross", "affordable", "section_8", "student", "ground_lease", "short_term", "membership"}}),
+		field.Enum("status").Values("draft", "pending_approval", "pending_signature", "active", "expired", "month_to_month_holdover", "renewed", "terminated", "eviction").Annotations(FieldDoc{EnumValues: []string{"draft", "pending_approval", "pending_signature", "active", "expired", "month_to_month_holdover", "renewed", "terminated", "eviction"}}),
+		field.String("description").Optional().Nillable().SchemaType(map[string]string{"postgres": "text"}),
+		field.Enum("liability_type").Values("joint_and_several", "individual", "by_the_bed", "proportional").Default("joint_and_several").Annotations(FieldDoc{EnumValues: []string{"joint_and_several", "individual", "by_the_bed", "proportional"}}),
 		field.JSON("term", &types.DateRange{}),
-		field.Time("lease_commencement_date").Optional().Nillable(),
-		field.Time("rent_commencement_date").Optional().Nillable(),
+		field.Time("lease_commencement_date").Optional().Nillable().Annotations(FieldDoc{Format: "date-time"}),
+		field.Time("rent_commencement_date").Optional().Nillable().Annotations(FieldDoc{Format: "date-time"}),
 		field.Int64("base_rent_amount_cents").Comment("base_rent — amount in cents"),
 		field.String("base_rent_currency").Default("USD").Match(regexp.MustCompile(`^[A-Z]{3}$`)).Comment("base_rent — ISO 4217 currency code"),
 		field.Int64("security_deposit_amount_cents").Comment("security_deposit — amount in cents"),
 		field.String("security_deposit_currency").Default("USD").Match(regexp.MustCompile(`^[A-Z]{3}$`)).Comment("security_deposit — ISO 4217 currency code"),
 		field.JSON("rent_schedule", []types.RentScheduleEntry{}).Optional(),
-		field.JSON("recurring_charges", []types.RecurringCharge{}).Optional(),
+		field.JSON("recurring_charges", []types.RecurringCharge{}).Optional().Comment("Recurring charges beyond base rent"),
 		field.JSON("late_fee_policy", &types.LateFeePolicy{}).Optional(),
 		field.JSON("cam_terms", &types.CAMTerms{}).Optional(),
 		field.JSON("tenant_improvement", &types.TenantImprovement{}).Optional(),
@@ -58,20 +59,20 @@ func (Lease) Fields() []ent.Field {
 		field.JSON("expansion_rights", []types.ExpansionRight{}).Optional(),
 		field.JSON("contraction_rights", []types.ContractionRight{}).Optional(),
 		field.JSON("subsidy", &types.SubsidyTerms{}).Optional(),
-		field.Time("move_in_date").Optional().Nillable(),
-		field.Time("move_out_date").Optional().Nillable(),
-		field.Time("notice_date").Optional().Nillable(),
-		field.Int("notice_required_days"),
+		field.Time("move_in_date").Optional().Nillable().Annotations(FieldDoc{Format: "date-time"}),
+		field.Time("move_out_date").Optional().Nillable().Annotations(FieldDoc{Format: "date-time"}),
+		field.Time("notice_date").Optional().Nillable().Annotations(FieldDoc{Format: "date-time"}),
+		field.Int("notice_required_days").NonNegative().Default(30).Annotations(FieldDoc{Format: "int32"}),
 		field.String("check_in_time").Optional().Nillable().SchemaType(map[string]string{"postgres": "varchar"}),
 		field.String("check_out_time").Optional().Nillable().SchemaType(map[string]string{"postgres": "varchar"}),
 		field.Int64("cleaning_fee_amount_cents").Optional().Nillable().Comment("cleaning_fee — amount in cents"),
 		field.String("cleaning_fee_currency").Optional().Nillable().Default("USD").Match(regexp.MustCompile(`^[A-Z]{3}$`)).Comment("cleaning_fee — ISO 4217 currency code"),
 		field.String("platform_booking_id").Optional().Nillable().SchemaType(map[string]string{"postgres": "varchar"}),
-		field.Enum("membership_tier").Values("hot_desk", "dedicated_desk", "office", "suite", "virtual").Optional().Nillable(),
+		field.Enum("membership_tier").Values("hot_desk", "dedicated_desk", "office", "suite", "virtual").Optional().Nillable().Annotations(FieldDoc{EnumValues: []string{"hot_desk", "dedicated_desk", "office", "suite", "virtual"}}),
 		field.Bool("is_sublease").Default(false),
-		field.Enum("sublease_billing").Values("through_master_tenant", "direct_to_landlord").Default("through_master_tenant"),
-		field.Enum("signing_method").Values("electronic", "wet_ink", "both").Optional().Nillable(),
-		field.Time("signed_at").Optional().Nillable(),
+		field.Enum("sublease_billing").Values("through_master_tenant", "direct_to_landlord").Default("through_master_tenant").Annotations(FieldDoc{EnumValues: []string{"through_master_tenant", "direct_to_landlord"}}),
+		field.Enum("signing_method").Values("electronic", "wet_ink", "both").Optional().Nillable().Annotations(FieldDoc{EnumValues: []string{"electronic", "wet_ink", "both"}}),
+		field.Time("signed_at").Optional().Nillable().Annotations(FieldDoc{Format: "date-time"}),
 		field.String("document_id").Optional().Nillable().SchemaType(map[string]string{"postgres": "varchar"}),
 	}
 }
@@ -89,6 +90,14 @@ func (Lease) Edges() []ent.Edge {
 	}
 }
 
+// Indexes of the Lease.
+func (Lease) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Edges("parent_lease"),
+		index.Fields("status"),
+	}
+}
+
 // ValidLeaseTransitions defines the allowed state machine transitions.
 // Generated from CUE ontology state_machines.cue.
 var ValidLeaseTransitions = map[string][]string{
@@ -103,6 +112,10 @@ var ValidLeaseTransitions = map[string][]string{
 	"terminated":              {},
 }
 
+// LeaseTransitionGuards holds the conditions of guarded transitions, keyed
+// by source then target state. Transitions not listed here are unconditional.
+var LeaseTransitionGuards = map[string]map[string]TransitionGuard{}
+
 // Hooks returns cross-field constraint validation hooks.
 // Generated from CUE ontology conditional blocks.
 func (Lease) Hooks() []ent.Hook {
@@ -147,14 +160,14 @@ func validateLeaseConstraints() ent.Hook {
 			// Active leases must have a move-in date
 			if status == "active" {
 				if _, ok := getField("move_in_date"); !ok && m.Op().Is(ent.OpCreate) {
-					return nil, fmt.Errorf("active lease must have move_in_date set")
+					return nil, constraintErrorf("move_in_date", "active lease must have move_in_date set")
 				}
 			}
 
 			// Active/expired/renewed leases must be signed
 			if status == "active" || status == "expired" || status == "renewed" {
 				if _, ok := getField("signed_at"); !ok && m.Op().Is(ent.OpCreate) {
-					return nil, fmt.Errorf("%s lease must have signed_at set", status)
+					return nil, constraintErrorf("signed_at", "%s lease must have signed_at set", status)
 				}
 			}
 
@@ -163,7 +176,7 @@ func validateLeaseConstraints() ent.Hook {
 				if b, isBool := toBool(v); isBool && b {
 					// parent_lease_id is managed via the parent_lease edge
 					if len(m.AddedIDs("parent_lease")) == 0 && m.Op().Is(ent.OpCreate) {
-						return nil, fmt.Errorf("sublease must have parent_lease set")
+						return nil, constraintErrorf("parent_lease_id", "sublease must have parent_lease set")
 					}
 				}
 			}
@@ -175,7 +188,7 @@ func validateLeaseConstraints() ent.Hook {
 			}
 			if commercialTypes[leaseType] && m.Op().Is(ent.OpCreate) {
 				if v, ok := getField("cam_terms"); !ok || v == nil {
-					return nil, fmt.Errorf("commercial lease type %s requires cam_terms", leaseType)
+					return nil, constraintErrorf("cam_terms", "commercial lease type %s requires cam_terms", leaseType)
 				}
 			}
 
@@ -184,7 +197,7 @@ func validateLeaseConstraints() ent.Hook {
 				if v, ok := getField("cam_terms"); ok && v != nil {
 					if ct, isCAM := v.(*types.CAMTerms); isCAM && ct != nil {
 						if !ct.IncludesPropertyTax || !ct.IncludesInsurance || !ct.IncludesUtilities {
-							return nil, fmt.Errorf("NNN lease cam_terms must include property_tax, insurance, and utilities")
+							return nil, constraintErrorf("cam_terms", "NNN lease cam_terms must include property_tax, insurance, and utilities")
 						}
 					}
 				}
@@ -195,7 +208,7 @@ func validateLeaseConstraints() ent.Hook {
 				if v, ok := getField("cam_terms"); ok && v != nil {
 					if ct, isCAM := v.(*types.CAMTerms); isCAM && ct != nil {
 						if !ct.IncludesPropertyTax || !ct.IncludesInsurance {
-							return nil, fmt.Errorf("NN lease cam_terms must include property_tax and insurance")
+							return nil, constraintErrorf("cam_terms", "NN lease cam_terms must include property_tax and insurance")
 						}
 					}
 				}
@@ -206,7 +219,7 @@ func validateLeaseConstraints() ent.Hook {
 				if v, ok := getField("cam_terms"); ok && v != nil {
 					if ct, isCAM := v.(*types.CAMTerms); isCAM && ct != nil {
 						if !ct.IncludesPropertyTax {
-							return nil, fmt.Errorf("N lease cam_terms must include property_tax")
+							return nil, constraintErrorf("cam_terms", "N lease cam_terms must include property_tax")
 						}
 					}
 				}
@@ -215,7 +228,7 @@ func validateLeaseConstraints() ent.Hook {
 			// Section 8 leases require subsidy terms
 			if leaseType == "section_8" && m.Op().Is(ent.OpCreate) {
 				if v, ok := getField("subsidy"); !ok || v == nil {
-					return nil, fmt.Errorf("section_8 lease requires subsidy terms")
+					return nil, constraintErrorf("subsidy", "section_8 lease requires subsidy terms")
 				}
 			}
 
@@ -224,7 +237,7 @@ func validateLeaseConstraints() ent.Hook {
 				if v, ok := getField("term"); ok && v != nil {
 					if dr, isDR := v.(*types.DateRange); isDR && dr != nil {
 						if dr.End == nil {
-							return nil, fmt.Errorf("%s lease must have term.end set", leaseType)
+							return nil, constraintErrorf("term", "%s lease must have term.end set", leaseType)
 						}
 					}
 				}
@@ -5,6 +5,7 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 	"entgo.io/ent/schema/mixin"
 	"github.com/google/uuid"
 	"github.com/matthewbaird/ontology/internal/types"
@@ -30,9 +31,9 @@ func (LeaseSpace) Fields() []ent.Field {
 	return []ent.Field{
 		field.UUID("id", uuid.UUID{}).Default(uuid.New).Immutable().Comment("Primary key"),
 		field.Bool("is_primary").Default(true),
-		field.Enum("relationship").Values("primary", "expansion", "sublease", "shared_access", "parking", "storage", "loading_dock", "rooftop", "patio", "signage", "included", "membership"),
+		field.Enum("relationship").Values("primary", "expansion", "sublease", "shared_access", "parking", "storage", "loading_dock", "rooftop", "patio", "signage", "included", "membership").Annotations(FieldDoc{EnumValues: []string{"primary", "expansion", "sublease", "shared_access", "parking", "storage", "loading_dock", "rooftop", "patio", "signage", "included", "membership"}}),
 		field.JSON("effective", &types.DateRange{}),
-		field.Float("square_footage_leased").Optional().Nillable(),
+		field.Float("square_footage_leased").Optional().Nillable().Positive().Annotations(FieldDoc{Format: "double"}),
 	}
 }
 
@@ -43,3 +44,11 @@ func (LeaseSpace) Edges() []ent.Edge {
 		edge.To("space", Space.Type).Unique().Required().Comment("LeaseSpace references Space"),
 	}
 }
+
+// Indexes of the LeaseSpace.
+func (LeaseSpace) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Edges("lease"),
+		index.Edges("space"),
+	}
+}
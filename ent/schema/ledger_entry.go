@@ -9,6 +9,7 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 	"entgo.io/ent/schema/mixin"
 	"github.com/google/uuid"
 )
@@ -32,19 +33,19 @@ func (LedgerEntry) Mixin() []ent.Mixin {
 func (LedgerEntry) Fields() []ent.Field {
 	return []ent.Field{
 		field.UUID("id", uuid.UUID{}).Default(uuid.New).Immutable().Comment("Primary key"),
-		field.Enum("entry_type").Values("charge", "payment", "credit", "adjustment", "refund", "deposit", "nsf", "write_off", "late_fee", "management_fee", "owner_draw").Immutable(),
+		field.Enum("entry_type").Values("charge", "payment", "credit", "adjustment", "refund", "deposit", "nsf", "write_off", "late_fee", "management_fee", "owner_draw").Immutable().Annotations(FieldDoc{EnumValues: []string{"charge", "payment", "credit", "adjustment", "refund", "deposit", "nsf", "write_off", "late_fee", "management_fee", "owner_draw"}}),
 		field.Int64("amount_amount_cents").Immutable().Comment("amount — amount in cents"),
 		field.String("amount_currency").Immutable().Default("USD").Match(regexp.MustCompile(`^[A-Z]{3}$`)).Comment("amount — ISO 4217 currency code"),
-		field.Time("effective_date").Immutable(),
-		field.Time("posted_date").Immutable(),
-		field.String("description").Immutable().SchemaType(map[string]string{"postgres": "varchar"}),
+		field.Time("effective_date").Immutable().Annotations(FieldDoc{Format: "date-time"}),
+		field.Time("posted_date").Immutable().Annotations(FieldDoc{Format: "date-time"}),
+		field.String("description").Immutable().SchemaType(map[string]string{"postgres": "text"}),
 		field.String("charge_code").Immutable().SchemaType(map[string]string{"postgres": "varchar"}),
-		field.String("memo").Optional().Nillable().Immutable().SchemaType(map[string]string{"postgres": "varchar"}),
+		field.String("memo").Optional().Nillable().Immutable().SchemaType(map[string]string{"postgres": "text"}),
 		field.String("bank_account_id").Optional().Nillable().Immutable().SchemaType(map[string]string{"postgres": "varchar"}),
 		field.String("bank_transaction_id").Optional().Nillable().Immutable().SchemaType(map[string]string{"postgres": "varchar"}),
 		field.Bool("reconciled").Default(false),
 		field.String("reconciliation_id").Optional().Nillable().SchemaType(map[string]string{"postgres": "varchar"}),
-		field.Time("reconciled_at").Optional().Nillable(),
+		field.Time("reconciled_at").Optional().Nillable().Annotations(FieldDoc{Format: "date-time"}),
 		field.String("adjusts_entry_id").Optional().Nillable().Immutable().SchemaType(map[string]string{"postgres": "varchar"}),
 	}
 }
@@ -61,6 +62,18 @@ func (LedgerEntry) Edges() []ent.Edge {
 	}
 }
 
+// Indexes of the LedgerEntry.
+func (LedgerEntry) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Edges("lease"),
+		index.Edges("journal_entry"),
+		index.Edges("account"),
+		index.Edges("property"),
+		index.Edges("space"),
+		index.Edges("person"),
+	}
+}
+
 // Hooks returns cross-field constraint validation hooks.
 // Generated from CUE ontology conditional blocks.
 func (LedgerEntry) Hooks() []ent.Hook {
@@ -116,14 +129,14 @@ func validateLedgerEntryConstraints() ent.Hook {
 			// Payment/refund/nsf entries require a person (person edge)
 			if entryType == "payment" || entryType == "refund" || entryType == "nsf" {
 				if len(m.AddedIDs("person")) == 0 && m.Op().Is(ent.OpCreate) {
-					return nil, fmt.Errorf("%s ledger entry must have person set", entryType)
+					return nil, constraintErrorf("person_id", "%s ledger entry must have person set", entryType)
 				}
 			}
 
 			// Charge/late_fee entries require a lease (lease edge)
 			if entryType == "charge" || entryType == "late_fee" {
 				if len(m.AddedIDs("lease")) == 0 && m.Op().Is(ent.OpCreate) {
-					return nil, fmt.Errorf("%s ledger entry must have lease set", entryType)
+					return nil, constraintErrorf("lease_id", "%s ledger entry must have lease set", entryType)
 				}
 			}
 
@@ -132,7 +145,7 @@ func validateLedgerEntryConstraints() ent.Hook {
 				aeid, aeidOk := getField("adjusts_entry_id")
 				if !aeidOk || toString(aeid) == "" {
 					if m.Op().Is(ent.OpCreate) {
-						return nil, fmt.Errorf("adjustment ledger entry must have adjusts_entry_id set")
+						return nil, constraintErrorf("adjusts_entry_id", "adjustment ledger entry must have adjusts_entry_id set")
 					}
 				}
 			}
@@ -142,10 +155,10 @@ func validateLedgerEntryConstraints() ent.Hook {
 				if b, isBool := toBool(v); isBool && b {
 					rid, ridOk := getField("reconciliation_id")
 					if !ridOk || toString(rid) == "" {
-						return nil, fmt.Errorf("reconciled ledger entry must have reconciliation_id set")
+						return nil, constraintErrorf("reconciliation_id", "reconciled ledger entry must have reconciliation_id set")
 					}
 					if _, ok := getField("reconciled_at"); !ok && m.Op().Is(ent.OpCreate) {
-						return nil, fmt.Errorf("reconciled ledger entry must have reconciled_at set")
+						return nil, constraintErrorf("reconciled_at", "reconciled ledger entry must have reconciled_at set")
 					}
 				}
 			}
@@ -5,6 +5,7 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 	"entgo.io/ent/schema/mixin"
 	"github.com/google/uuid"
 	"github.com/matthewbaird/ontology/internal/types"
@@ -31,17 +32,17 @@ func (Organization) Fields() []ent.Field {
 		field.UUID("id", uuid.UUID{}).Default(uuid.New).Immutable().Comment("Primary key"),
 		field.String("legal_name").NotEmpty().SchemaType(map[string]string{"postgres": "varchar"}),
 		field.String("dba_name").Optional().Nillable().SchemaType(map[string]string{"postgres": "varchar"}),
-		field.Enum("org_type").Values("management_company", "ownership_entity", "vendor", "corporate_tenant", "government_agency", "hoa", "investment_fund", "other"),
+		field.Enum("org_type").Values("management_company", "ownership_entity", "vendor", "corporate_tenant", "government_agency", "hoa", "investment_fund", "other").Annotations(FieldDoc{EnumValues: []string{"management_company", "ownership_entity", "vendor", "corporate_tenant", "government_agency", "hoa", "investment_fund", "other"}}),
 		field.String("tax_id").Optional().Nillable().Sensitive().SchemaType(map[string]string{"postgres": "varchar"}),
-		field.Enum("tax_id_type").Values("ein", "ssn", "itin", "foreign").Optional().Nillable(),
-		field.Enum("status").Values("active", "inactive", "suspended", "dissolved"),
+		field.Enum("tax_id_type").Values("ein", "ssn", "itin", "foreign").Optional().Nillable().Annotations(FieldDoc{EnumValues: []string{"ein", "ssn", "itin", "foreign"}}),
+		field.Enum("status").Values("active", "inactive", "suspended", "dissolved").Annotations(FieldDoc{EnumValues: []string{"active", "inactive", "suspended", "dissolved"}}),
 		field.JSON("address", &types.Address{}).Optional(),
 		field.JSON("contact_methods", []types.ContactMethod{}).Optional(),
 		field.String("state_of_incorporation").Optional().Nillable().SchemaType(map[string]string{"postgres": "varchar"}),
-		field.Time("formation_date").Optional().Nillable(),
+		field.Time("formation_date").Optional().Nillable().Annotations(FieldDoc{Format: "date-time"}),
 		field.String("management_license").Optional().Nillable().SchemaType(map[string]string{"postgres": "varchar"}),
 		field.String("license_state").Optional().Nillable().SchemaType(map[string]string{"postgres": "varchar"}),
-		field.Time("license_expiry").Optional().Nillable(),
+		field.Time("license_expiry").Optional().Nillable().Annotations(FieldDoc{Format: "date-time"}),
 	}
 }
 
@@ -55,6 +56,13 @@ func (Organization) Edges() []ent.Edge {
 	}
 }
 
+// Indexes of the Organization.
+func (Organization) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("status"),
+	}
+}
+
 // ValidOrganizationTransitions defines the allowed state machine transitions.
 // Generated from CUE ontology state_machines.cue.
 var ValidOrganizationTransitions = map[string][]string{
@@ -63,3 +71,7 @@ var ValidOrganizationTransitions = map[string][]string{
 	"inactive":  {"active", "dissolved"},
 	"suspended": {"active", "dissolved"},
 }
+
+// OrganizationTransitionGuards holds the conditions of guarded transitions, keyed
+// by source then target state. Transitions not listed here are unconditional.
+var OrganizationTransitionGuards = map[string]map[string]TransitionGuard{}
@@ -33,18 +33,18 @@ func (Person) Fields() []ent.Field {
 		field.String("middle_name").Optional().Nillable().SchemaType(map[string]string{"postgres": "varchar"}),
 		field.String("last_name").NotEmpty().SchemaType(map[string]string{"postgres": "varchar"}),
 		field.String("display_name").NotEmpty().SchemaType(map[string]string{"postgres": "varchar"}),
-		field.Enum("record_source").Values("user", "applicant", "import", "system").Default("user"),
-		field.Time("date_of_birth").Optional().Nillable(),
+		field.Enum("record_source").Values("user", "applicant", "import", "system").Default("user").Annotations(FieldDoc{EnumValues: []string{"user", "applicant", "import", "system"}}),
+		field.Time("date_of_birth").Optional().Nillable().Annotations(FieldDoc{Format: "date-time"}),
 		field.String("ssn_last_four").Optional().Nillable().Sensitive().SchemaType(map[string]string{"postgres": "varchar"}),
 		field.JSON("contact_methods", []types.ContactMethod{}),
-		field.Enum("preferred_contact").Values("email", "sms", "phone", "mail", "portal").Default("email"),
-		field.String("language_preference").SchemaType(map[string]string{"postgres": "varchar"}),
+		field.Enum("preferred_contact").Values("email", "sms", "phone", "mail", "portal").Default("email").Annotations(FieldDoc{EnumValues: []string{"email", "sms", "phone", "mail", "portal"}}),
+		field.String("language_preference").Default("en").SchemaType(map[string]string{"postgres": "varchar"}),
 		field.String("timezone").Optional().Nillable().SchemaType(map[string]string{"postgres": "varchar"}),
 		field.Bool("do_not_contact").Default(false),
 		field.Bool("identity_verified").Default(false),
-		field.Enum("verification_method").Values("manual", "id_check", "credit_check", "ssn_verify").Optional().Nillable(),
-		field.Time("verified_at").Optional().Nillable(),
-		field.JSON("tags", []string{}).Optional(),
+		field.Enum("verification_method").Values("manual", "id_check", "credit_check", "ssn_verify").Optional().Nillable().Annotations(FieldDoc{EnumValues: []string{"manual", "id_check", "credit_check", "ssn_verify"}}),
+		field.Time("verified_at").Optional().Nillable().Annotations(FieldDoc{Format: "date-time"}),
+		field.JSON("tags", []string{}).Optional().Comment("Tags for flexible categorization"),
 	}
 }
 
@@ -5,6 +5,7 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 	"entgo.io/ent/schema/mixin"
 	"github.com/google/uuid"
 	"github.com/matthewbaird/ontology/internal/types"
@@ -29,12 +30,12 @@ func (PersonRole) Mixin() []ent.Mixin {
 func (PersonRole) Fields() []ent.Field {
 	return []ent.Field{
 		field.UUID("id", uuid.UUID{}).Default(uuid.New).Immutable().Comment("Primary key"),
-		field.Enum("role_type").Values("tenant", "owner", "property_manager", "maintenance_tech", "leasing_agent", "accountant", "vendor_contact", "guarantor", "emergency_contact", "authorized_occupant", "co_signer"),
-		field.Enum("scope_type").Values("organization", "portfolio", "property", "building", "space", "lease"),
+		field.Enum("role_type").Values("tenant", "owner", "property_manager", "maintenance_tech", "leasing_agent", "accountant", "vendor_contact", "guarantor", "emergency_contact", "authorized_occupant", "co_signer").Annotations(FieldDoc{EnumValues: []string{"tenant", "owner", "property_manager", "maintenance_tech", "leasing_agent", "accountant", "vendor_contact", "guarantor", "emergency_contact", "authorized_occupant", "co_signer"}}),
+		field.Enum("scope_type").Values("organization", "portfolio", "property", "building", "space", "lease").Annotations(FieldDoc{EnumValues: []string{"organization", "portfolio", "property", "building", "space", "lease"}}),
 		field.String("scope_id").SchemaType(map[string]string{"postgres": "varchar"}),
-		field.Enum("status").Values("active", "inactive", "pending", "terminated"),
+		field.Enum("status").Values("active", "inactive", "pending", "terminated").Annotations(FieldDoc{EnumValues: []string{"active", "inactive", "pending", "terminated"}}),
 		field.JSON("effective", &types.DateRange{}),
-		field.JSON("attributes", &types.TenantAttributes{}).Optional(),
+		field.JSON("attributes", &types.TenantAttributes{}).Optional().Comment("Role-specific attributes stored as structured JSON"),
 	}
 }
 
@@ -47,6 +48,14 @@ func (PersonRole) Edges() []ent.Edge {
 	}
 }
 
+// Indexes of the PersonRole.
+func (PersonRole) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Edges("person"),
+		index.Fields("status"),
+	}
+}
+
 // ValidPersonRoleTransitions defines the allowed state machine transitions.
 // Generated from CUE ontology state_machines.cue.
 var ValidPersonRoleTransitions = map[string][]string{
@@ -55,3 +64,7 @@ var ValidPersonRoleTransitions = map[string][]string{
 	"pending":    {"active", "terminated"},
 	"terminated": {},
 }
+
+// PersonRoleTransitionGuards holds the conditions of guarded transitions, keyed
+// by source then target state. Transitions not listed here are unconditional.
+var PersonRoleTransitionGuards = map[string]map[string]TransitionGuard{}
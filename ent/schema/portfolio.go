@@ -5,6 +5,7 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 	"entgo.io/ent/schema/mixin"
 	"github.com/google/uuid"
 )
@@ -29,9 +30,9 @@ func (Portfolio) Fields() []ent.Field {
 	return []ent.Field{
 		field.UUID("id", uuid.UUID{}).Default(uuid.New).Immutable().Comment("Primary key"),
 		field.String("name").NotEmpty().SchemaType(map[string]string{"postgres": "varchar"}),
-		field.Enum("management_type").Values("self_managed", "third_party", "hybrid"),
-		field.String("description").Optional().Nillable().SchemaType(map[string]string{"postgres": "varchar"}),
-		field.Enum("status").Values("active", "inactive", "onboarding", "offboarding"),
+		field.Enum("management_type").Values("self_managed", "third_party", "hybrid").Annotations(FieldDoc{EnumValues: []string{"self_managed", "third_party", "hybrid"}}),
+		field.String("description").Optional().Nillable().SchemaType(map[string]string{"postgres": "text"}),
+		field.Enum("status").Values("active", "inactive", "onboarding", "offboarding").Annotations(FieldDoc{EnumValues: []string{"active", "inactive", "onboarding", "offboarding"}}),
 		field.String("default_chart_of_accounts_id").Optional().Nillable().SchemaType(map[string]string{"postgres": "varchar"}),
 		field.String("default_bank_account_id").Optional().Nillable().SchemaType(map[string]string{"postgres": "varchar"}),
 	}
@@ -46,6 +47,14 @@ func (Portfolio) Edges() []ent.Edge {
 	}
 }
 
+// Indexes of the Portfolio.
+func (Portfolio) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Edges("owner"),
+		index.Fields("status"),
+	}
+}
+
 // ValidPortfolioTransitions defines the allowed state machine transitions.
 // Generated from CUE ontology state_machines.cue.
 var ValidPortfolioTransitions = map[string][]string{
@@ -54,3 +63,7 @@ var ValidPortfolioTransitions = map[string][]string{
 	"offboarding": {"inactive"},
 	"onboarding":  {"active"},
 }
+
+// PortfolioTransitionGuards holds the conditions of guarded transitions, keyed
+// by source then target state. Transitions not listed here are unconditional.
+var PortfolioTransitionGuards = map[string]map[string]TransitionGuard{}
@@ -6,8 +6,10 @@ import (
 	"fmt"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 	"entgo.io/ent/schema/mixin"
 	"github.com/google/uuid"
 	"github.com/matthewbaird/ontology/internal/types"
@@ -34,21 +36,21 @@ func (Property) Fields() []ent.Field {
 		field.UUID("id", uuid.UUID{}).Default(uuid.New).Immutable().Comment("Primary key"),
 		field.String("name").NotEmpty().SchemaType(map[string]string{"postgres": "varchar"}),
 		field.JSON("address", &types.Address{}),
-		field.Enum("property_type").Values("single_family", "multi_family", "commercial_office", "commercial_retail", "mixed_use", "industrial", "affordable_housing", "student_housing", "senior_living", "vacation_rental", "mobile_home_park", "self_storage", "coworking", "data_center", "medical_office"),
-		field.Enum("status").Values("active", "inactive", "under_renovation", "for_sale", "onboarding"),
-		field.Int("year_built"),
-		field.Float("total_square_footage"),
-		field.Int("total_spaces"),
-		field.Float("lot_size_sqft").Optional().Nillable(),
-		field.Int("stories").Optional().Nillable(),
-		field.Int("parking_spaces").Optional().Nillable(),
+		field.Enum("property_type").Values("single_family", "multi_family", "commercial_office", "commercial_retail", "mixed_use", "industrial", "affordable_housing", "student_housing", "senior_living", "vacation_rental", "mobile_home_park", "self_storage", "coworking", "data_center", "medical_office").Annotations(FieldDoc{EnumValues: []string{"single_family", "multi_family", "commercial_office", "commercial_retail", "mixed_use", "industrial", "affordable_housing", "student_housing", "senior_living", "vacation_rental", "mobile_home_park", "self_storage", "coworking", "data_center", "medical_office"}}),
+		field.Enum("status").Values("active", "inactive", "under_renovation", "for_sale", "onboarding").Annotations(FieldDoc{EnumValues: []string{"active", "inactive", "under_renovation", "for_sale", "onboarding"}}),
+		field.Int("year_built").Min(1800).Max(2030).Annotations(FieldDoc{Format: "int32"}),
+		field.Float("total_square_footage").Positive().Annotations(FieldDoc{Format: "double"}),
+		field.Int("total_spaces").Min(1).Annotations(FieldDoc{Format: "int32"}),
+		field.Float("lot_size_sqft").Optional().Nillable().Positive().Annotations(FieldDoc{Format: "double"}),
+		field.Int("stories").Optional().Nillable().Min(1).Annotations(FieldDoc{Format: "int32"}),
+		field.Int("parking_spaces").Optional().Nillable().NonNegative().Annotations(FieldDoc{Format: "int32"}),
 		field.String("jurisdiction_id").Optional().Nillable().SchemaType(map[string]string{"postgres": "varchar"}),
 		field.Bool("rent_controlled").Default(false),
 		field.JSON("compliance_programs", []string{}).Optional(),
 		field.Bool("requires_lead_disclosure"),
 		field.String("chart_of_accounts_id").Optional().Nillable().SchemaType(map[string]string{"postgres": "varchar"}),
 		field.String("insurance_policy_number").Optional().Nillable().SchemaType(map[string]string{"postgres": "varchar"}),
-		field.Time("insurance_expiry").Optional().Nillable(),
+		field.Time("insurance_expiry").Optional().Nillable().Annotations(FieldDoc{Format: "date-time"}),
 	}
 }
 
@@ -56,8 +58,8 @@ func (Property) Fields() []ent.Field {
 func (Property) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.From("portfolio", Portfolio.Type).Ref("properties").Unique().Required().Comment("Portfolio contains Properties (inverse)"),
-		edge.To("buildings", Building.Type).Comment("Property contains Buildings"),
-		edge.To("spaces", Space.Type).Comment("Property contains Spaces"),
+		edge.To("buildings", Building.Type).Annotations(entsql.OnDelete(entsql.Cascade)).Comment("Property contains Buildings"),
+		edge.To("spaces", Space.Type).Annotations(entsql.OnDelete(entsql.Cascade)).Comment("Property contains Spaces"),
 		edge.To("bank_account", BankAccount.Type).Unique().Comment("Property uses BankAccount"),
 		edge.To("applications", Application.Type).Comment("Property receives Applications"),
 		edge.To("ledger_entries", LedgerEntry.Type).Comment("LedgerEntry relates to Property (inverse)"),
@@ -65,6 +67,15 @@ func (Property) Edges() []ent.Edge {
 	}
 }
 
+// Indexes of the Property.
+func (Property) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Edges("portfolio"),
+		index.Edges("bank_account"),
+		index.Fields("status"),
+	}
+}
+
 // ValidPropertyTransitions defines the allowed state machine transitions.
 // Generated from CUE ontology state_machines.cue.
 var ValidPropertyTransitions = map[string][]string{
@@ -75,6 +86,10 @@ var ValidPropertyTransitions = map[string][]string{
 	"under_renovation": {"active", "for_sale"},
 }
 
+// PropertyTransitionGuards holds the conditions of guarded transitions, keyed
+// by source then target state. Transitions not listed here are unconditional.
+var PropertyTransitionGuards = map[string]map[string]TransitionGuard{}
+
 // Hooks returns cross-field constraint validation hooks.
 // Generated from CUE ontology conditional blocks.
 func (Property) Hooks() []ent.Hook {
@@ -115,7 +130,7 @@ func validatePropertyConstraints() ent.Hook {
 			if v, ok := getField("property_type"); ok && fmt.Sprint(v) == "single_family" {
 				if tu, ok := getField("total_spaces"); ok {
 					if tuInt, isInt := tu.(int); isInt && tuInt != 1 {
-						return nil, fmt.Errorf("single_family property must have total_spaces=1, got %d", tuInt)
+						return nil, constraintErrorf("total_spaces", "single_family property must have total_spaces=1, got %d", tuInt)
 					}
 				}
 			}
@@ -123,11 +138,11 @@ func validatePropertyConstraints() ent.Hook {
 			if v, ok := getField("property_type"); ok && fmt.Sprint(v) == "affordable_housing" {
 				cp, cpOk := getField("compliance_programs")
 				if !cpOk && m.Op().Is(ent.OpCreate) {
-					return nil, fmt.Errorf("affordable_housing property must have at least one compliance_programs entry")
+					return nil, constraintErrorf("compliance_programs", "affordable_housing property must have at least one compliance_programs entry")
 				}
 				if cpOk {
 					if list, isList := cp.([]string); isList && len(list) == 0 {
-						return nil, fmt.Errorf("affordable_housing property must have at least one compliance_programs entry")
+						return nil, constraintErrorf("compliance_programs", "affordable_housing property must have at least one compliance_programs entry")
 					}
 				}
 			}
@@ -135,7 +150,7 @@ func validatePropertyConstraints() ent.Hook {
 			if v, ok := getField("rent_controlled"); ok && fmt.Sprint(v) == "true" {
 				jid, jidOk := getField("jurisdiction_id")
 				if !jidOk || toString(jid) == "" {
-					return nil, fmt.Errorf("rent-controlled property must have jurisdiction_id set")
+					return nil, constraintErrorf("jurisdiction_id", "rent-controlled property must have jurisdiction_id set")
 				}
 			}
 			// year_built < 1978 → requires_lead_disclosure must be true
@@ -143,7 +158,7 @@ func validatePropertyConstraints() ent.Hook {
 				if yb, isInt := v.(int); isInt && yb < 1978 {
 					if rld, ok := getField("requires_lead_disclosure"); ok {
 						if fmt.Sprint(rld) != "true" {
-							return nil, fmt.Errorf("property built before 1978 must have requires_lead_disclosure=true")
+							return nil, constraintErrorf("requires_lead_disclosure", "property built before 1978 must have requires_lead_disclosure=true")
 						}
 					}
 				}
@@ -5,6 +5,7 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 	"entgo.io/ent/schema/mixin"
 	"github.com/google/uuid"
 )
@@ -28,11 +29,11 @@ func (PropertyJurisdiction) Mixin() []ent.Mixin {
 func (PropertyJurisdiction) Fields() []ent.Field {
 	return []ent.Field{
 		field.UUID("id", uuid.UUID{}).Default(uuid.New).Immutable().Comment("Primary key"),
-		field.Time("effective_date"),
-		field.Time("end_date").Optional().Nillable(),
-		field.Enum("lookup_source").Values("address_geocode", "manual", "api_lookup", "imported"),
+		field.Time("effective_date").Annotations(FieldDoc{Format: "date-time"}),
+		field.Time("end_date").Optional().Nillable().Annotations(FieldDoc{Format: "date-time"}),
+		field.Enum("lookup_source").Values("address_geocode", "manual", "api_lookup", "imported").Annotations(FieldDoc{EnumValues: []string{"address_geocode", "manual", "api_lookup", "imported"}}),
 		field.Bool("verified").Default(false),
-		field.Time("verified_at").Optional().Nillable(),
+		field.Time("verified_at").Optional().Nillable().Annotations(FieldDoc{Format: "date-time"}),
 		field.String("verified_by").Optional().Nillable().SchemaType(map[string]string{"postgres": "varchar"}),
 	}
 }
@@ -44,3 +45,11 @@ func (PropertyJurisdiction) Edges() []ent.Edge {
 		edge.To("jurisdiction", Jurisdiction.Type).Unique().Required().Comment("PropertyJurisdiction links Jurisdiction"),
 	}
 }
+
+// Indexes of the PropertyJurisdiction.
+func (PropertyJurisdiction) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Edges("property"),
+		index.Edges("jurisdiction"),
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 	"entgo.io/ent/schema/mixin"
 	"github.com/google/uuid"
 )
@@ -30,21 +31,21 @@ func (Reconciliation) Mixin() []ent.Mixin {
 func (Reconciliation) Fields() []ent.Field {
 	return []ent.Field{
 		field.UUID("id", uuid.UUID{}).Default(uuid.New).Immutable().Comment("Primary key"),
-		field.Time("period_start"),
-		field.Time("period_end"),
-		field.Time("statement_date"),
+		field.Time("period_start").Annotations(FieldDoc{Format: "date-time"}),
+		field.Time("period_end").Annotations(FieldDoc{Format: "date-time"}),
+		field.Time("statement_date").Annotations(FieldDoc{Format: "date-time"}),
 		field.Int64("statement_balance_amount_cents").Comment("statement_balance — amount in cents"),
 		field.String("statement_balance_currency").Default("USD").Match(regexp.MustCompile(`^[A-Z]{3}$`)).Comment("statement_balance — ISO 4217 currency code"),
 		field.Int64("gl_balance_amount_cents").Comment("gl_balance — amount in cents"),
 		field.String("gl_balance_currency").Default("USD").Match(regexp.MustCompile(`^[A-Z]{3}$`)).Comment("gl_balance — ISO 4217 currency code"),
 		field.Int64("difference_amount_cents").Optional().Nillable().Comment("difference — amount in cents"),
 		field.String("difference_currency").Optional().Nillable().Default("USD").Match(regexp.MustCompile(`^[A-Z]{3}$`)).Comment("difference — ISO 4217 currency code"),
-		field.Enum("status").Values("in_progress", "balanced", "unbalanced", "approved"),
-		field.Int("unreconciled_items").Optional().Nillable(),
+		field.Enum("status").Values("in_progress", "balanced", "unbalanced", "approved").Annotations(FieldDoc{EnumValues: []string{"in_progress", "balanced", "unbalanced", "approved"}}),
+		field.Int("unreconciled_items").Optional().Nillable().NonNegative().Annotations(FieldDoc{Format: "int32"}),
 		field.String("reconciled_by").Optional().Nillable().SchemaType(map[string]string{"postgres": "varchar"}),
-		field.Time("reconciled_at").Optional().Nillable(),
+		field.Time("reconciled_at").Optional().Nillable().Annotations(FieldDoc{Format: "date-time"}),
 		field.String("approved_by").Optional().Nillable().SchemaType(map[string]string{"postgres": "varchar"}),
-		field.Time("approved_at").Optional().Nillable(),
+		field.Time("approved_at").Optional().Nillable().Annotations(FieldDoc{Format: "date-time"}),
 	}
 }
 
@@ -55,6 +56,14 @@ func (Reconciliation) Edges() []ent.Edge {
 	}
 }
 
+// Indexes of the Reconciliation.
+func (Reconciliation) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Edges("bank_account"),
+		index.Fields("status"),
+	}
+}
+
 // ValidReconciliationTransitions defines the allowed state machine transitions.
 // Generated from CUE ontology state_machines.cue.
 var ValidReconciliationTransitions = map[string][]string{
@@ -63,3 +72,7 @@ var ValidReconciliationTransitions = map[string][]string{
 	"in_progress": {"balanced", "unbalanced"},
 	"unbalanced":  {"in_progress"},
 }
+
+// ReconciliationTransitionGuards holds the conditions of guarded transitions, keyed
+// by source then target state. Transitions not listed here are unconditional.
+var ReconciliationTransitionGuards = map[string]map[string]TransitionGuard{}
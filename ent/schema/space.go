@@ -9,6 +9,7 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 	"entgo.io/ent/schema/mixin"
 	"github.com/google/uuid"
 )
@@ -33,24 +34,24 @@ func (Space) Fields() []ent.Field {
 	return []ent.Field{
 		field.UUID("id", uuid.UUID{}).Default(uuid.New).Immutable().Comment("Primary key"),
 		field.String("space_number").NotEmpty().SchemaType(map[string]string{"postgres": "varchar"}),
-		field.Enum("space_type").Values("residential_unit", "commercial_office", "commercial_retail", "storage", "parking", "common_area", "industrial", "lot_pad", "bed_space", "desk_space", "parking_garage", "private_office", "warehouse", "amenity", "rack", "cage", "server_room", "other"),
-		field.Enum("status").Values("vacant", "occupied", "notice_given", "make_ready", "down", "model", "reserved", "owner_occupied"),
+		field.Enum("space_type").Values("residential_unit", "commercial_office", "commercial_retail", "storage", "parking", "common_area", "industrial", "lot_pad", "bed_space", "desk_space", "parking_garage", "private_office", "warehouse", "amenity", "rack", "cage", "server_room", "other").Annotations(FieldDoc{EnumValues: []string{"residential_unit", "commercial_office", "commercial_retail", "storage", "parking", "common_area", "industrial", "lot_pad", "bed_space", "desk_space", "parking_garage", "private_office", "warehouse", "amenity", "rack", "cage", "server_room", "other"}}),
+		field.Enum("status").Values("vacant", "occupied", "notice_given", "make_ready", "down", "model", "reserved", "owner_occupied").Annotations(FieldDoc{EnumValues: []string{"vacant", "occupied", "notice_given", "make_ready", "down", "model", "reserved", "owner_occupied"}}),
 		field.Bool("leasable"),
 		field.Bool("shared_with_parent").Default(false),
-		field.Float("square_footage"),
-		field.Int("bedrooms").Optional().Nillable(),
-		field.Float("bathrooms").Optional().Nillable(),
-		field.Int("floor").Optional().Nillable(),
+		field.Float("square_footage").Positive().Annotations(FieldDoc{Format: "double"}),
+		field.Int("bedrooms").Optional().Nillable().NonNegative().Annotations(FieldDoc{Format: "int32"}),
+		field.Float("bathrooms").Optional().Nillable().Min(0).Annotations(FieldDoc{Format: "double"}),
+		field.Int("floor").Optional().Nillable().Annotations(FieldDoc{Format: "int32"}),
 		field.JSON("amenities", []string{}).Optional(),
 		field.String("floor_plan").Optional().Nillable().SchemaType(map[string]string{"postgres": "varchar"}),
 		field.Bool("ada_accessible").Default(false),
 		field.Bool("pet_friendly").Default(true),
 		field.Bool("furnished").Default(false),
-		field.JSON("specialized_infrastructure", []string{}).Optional(),
+		field.JSON("specialized_infrastructure", []string{}).Optional().Comment("Specialized infrastructure for commercial/industrial spaces"),
 		field.Int64("market_rent_amount_cents").Optional().Nillable().Comment("market_rent — amount in cents"),
 		field.String("market_rent_currency").Optional().Nillable().Default("USD").Match(regexp.MustCompile(`^[A-Z]{3}$`)).Comment("market_rent — ISO 4217 currency code"),
-		field.Int("ami_restriction").Optional().Nillable(),
-		field.String("active_lease_id").Optional().Nillable().SchemaType(map[string]string{"postgres": "varchar"}),
+		field.Int("ami_restriction").Optional().Nillable().NonNegative().Max(150).Comment("For affordable housing — space-level income restrictions").Annotations(FieldDoc{Format: "int32"}),
+		field.String("active_lease_id").Optional().Nillable().SchemaType(map[string]string{"postgres": "varchar"}).Comment("Active lease (computed from LeaseSpace relationship traversal)"),
 	}
 }
 
@@ -67,6 +68,17 @@ func (Space) Edges() []ent.Edge {
 	}
 }
 
+// Indexes of the Space.
+func (Space) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("space_number").Edges("property").Unique(),
+		index.Edges("property"),
+		index.Edges("building"),
+		index.Edges("parent_space"),
+		index.Fields("status"),
+	}
+}
+
 // ValidSpaceTransitions defines the allowed state machine transitions.
 // Generated from CUE ontology state_machines.cue.
 var ValidSpaceTransitions = map[string][]string{
@@ -80,6 +92,10 @@ var ValidSpaceTransitions = map[string][]string{
 	"vacant":         {"occupied", "make_ready", "down", "model", "reserved"},
 }
 
+// SpaceTransitionGuards holds the conditions of guarded transitions, keyed
+// by source then target state. Transitions not listed here are unconditional.
+var SpaceTransitionGuards = map[string]map[string]TransitionGuard{}
+
 // Hooks returns cross-field constraint validation hooks.
 // Generated from CUE ontology conditional blocks.
 func (Space) Hooks() []ent.Hook {
@@ -141,10 +157,10 @@ func validateSpaceConstraints() ent.Hook {
 			// residential_unit → bedrooms and bathrooms must be set
 			if v, ok := getField("space_type"); ok && fmt.Sprint(v) == "residential_unit" {
 				if _, ok := getField("bedrooms"); !ok && m.Op().Is(ent.OpCreate) {
-					return nil, fmt.Errorf("residential_unit space must have bedrooms set")
+					return nil, constraintErrorf("bedrooms", "residential_unit space must have bedrooms set")
 				}
 				if _, ok := getField("bathrooms"); !ok && m.Op().Is(ent.OpCreate) {
-					return nil, fmt.Errorf("residential_unit space must have bathrooms set")
+					return nil, constraintErrorf("bathrooms", "residential_unit space must have bathrooms set")
 				}
 			}
 			// parking or storage → bedrooms == 0 and bathrooms == 0
@@ -153,12 +169,12 @@ func validateSpaceConstraints() ent.Hook {
 				if st == "parking" || st == "storage" {
 					if bd, ok := getField("bedrooms"); ok {
 						if bdInt, ok := toInt(bd); ok && bdInt != 0 {
-							return nil, fmt.Errorf("%s space must have bedrooms=0, got %d", st, bdInt)
+							return nil, constraintErrorf("bedrooms", "%s space must have bedrooms=0, got %d", st, bdInt)
 						}
 					}
 					if bt, ok := getField("bathrooms"); ok {
 						if btFloat, ok := toFloat(bt); ok && btFloat != 0 {
-							return nil, fmt.Errorf("%s space must have bathrooms=0, got %v", st, btFloat)
+							return nil, constraintErrorf("bathrooms", "%s space must have bathrooms=0, got %v", st, btFloat)
 						}
 					}
 				}
@@ -166,7 +182,7 @@ func validateSpaceConstraints() ent.Hook {
 			// common_area → leasable must be false
 			if v, ok := getField("space_type"); ok && fmt.Sprint(v) == "common_area" {
 				if lv, ok := getField("leasable"); ok && fmt.Sprint(lv) == "true" {
-					return nil, fmt.Errorf("common_area space must have leasable=false")
+					return nil, constraintErrorf("leasable", "common_area space must have leasable=false")
 				}
 			}
 			// occupied → active_lease_id must be set
@@ -174,7 +190,7 @@ func validateSpaceConstraints() ent.Hook {
 				alid, alidOk := getField("active_lease_id")
 				if !alidOk || toString(alid) == "" {
 					if m.Op().Is(ent.OpCreate) {
-						return nil, fmt.Errorf("occupied space must have active_lease_id set")
+						return nil, constraintErrorf("active_lease_id", "occupied space must have active_lease_id set")
 					}
 				}
 			}
@@ -64,15 +64,15 @@ type Space struct {
 	PetFriendly bool `json:"pet_friendly,omitempty"`
 	// Furnished holds the value of the "furnished" field.
 	Furnished bool `json:"furnished,omitempty"`
-	// SpecializedInfrastructure holds the value of the "specialized_infrastructure" field.
+	// Specialized infrastructure for commercial/industrial spaces
 	SpecializedInfrastructure []string `json:"specialized_infrastructure,omitempty"`
 	// market_rent — amount in cents
 	MarketRentAmountCents *int64 `json:"market_rent_amount_cents,omitempty"`
 	// market_rent — ISO 4217 currency code
 	MarketRentCurrency *string `json:"market_rent_currency,omitempty"`
-	// AmiRestriction holds the value of the "ami_restriction" field.
+	// For affordable housing — space-level income restrictions
 	AmiRestriction *int `json:"ami_restriction,omitempty"`
-	// ActiveLeaseID holds the value of the "active_lease_id" field.
+	// Active lease (computed from LeaseSpace relationship traversal)
 	ActiveLeaseID *string `json:"active_lease_id,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the SpaceQuery when eager-loading is set.
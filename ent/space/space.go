@@ -205,6 +205,12 @@ var (
 	SpaceNumberValidator func(string) error
 	// DefaultSharedWithParent holds the default value on creation for the "shared_with_parent" field.
 	DefaultSharedWithParent bool
+	// SquareFootageValidator is a validator for the "square_footage" field. It is called by the builders before save.
+	SquareFootageValidator func(float64) error
+	// BedroomsValidator is a validator for the "bedrooms" field. It is called by the builders before save.
+	BedroomsValidator func(int) error
+	// BathroomsValidator is a validator for the "bathrooms" field. It is called by the builders before save.
+	BathroomsValidator func(float64) error
 	// DefaultAdaAccessible holds the default value on creation for the "ada_accessible" field.
 	DefaultAdaAccessible bool
 	// DefaultPetFriendly holds the default value on creation for the "pet_friendly" field.
@@ -215,6 +221,8 @@ var (
 	DefaultMarketRentCurrency string
 	// MarketRentCurrencyValidator is a validator for the "market_rent_currency" field. It is called by the builders before save.
 	MarketRentCurrencyValidator func(string) error
+	// AmiRestrictionValidator is a validator for the "ami_restriction" field. It is called by the builders before save.
+	AmiRestrictionValidator func(int) error
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() uuid.UUID
 )
@@ -579,6 +579,21 @@ func (_c *SpaceCreate) check() error {
 	if _, ok := _c.mutation.SquareFootage(); !ok {
 		return &ValidationError{Name: "square_footage", err: errors.New(`ent: missing required field "Space.square_footage"`)}
 	}
+	if v, ok := _c.mutation.SquareFootage(); ok {
+		if err := space.SquareFootageValidator(v); err != nil {
+			return &ValidationError{Name: "square_footage", err: fmt.Errorf(`ent: validator failed for field "Space.square_footage": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Bedrooms(); ok {
+		if err := space.BedroomsValidator(v); err != nil {
+			return &ValidationError{Name: "bedrooms", err: fmt.Errorf(`ent: validator failed for field "Space.bedrooms": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Bathrooms(); ok {
+		if err := space.BathroomsValidator(v); err != nil {
+			return &ValidationError{Name: "bathrooms", err: fmt.Errorf(`ent: validator failed for field "Space.bathrooms": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.AdaAccessible(); !ok {
 		return &ValidationError{Name: "ada_accessible", err: errors.New(`ent: missing required field "Space.ada_accessible"`)}
 	}
@@ -593,6 +608,11 @@ func (_c *SpaceCreate) check() error {
 			return &ValidationError{Name: "market_rent_currency", err: fmt.Errorf(`ent: validator failed for field "Space.market_rent_currency": %w`, err)}
 		}
 	}
+	if v, ok := _c.mutation.AmiRestriction(); ok {
+		if err := space.AmiRestrictionValidator(v); err != nil {
+			return &ValidationError{Name: "ami_restriction", err: fmt.Errorf(`ent: validator failed for field "Space.ami_restriction": %w`, err)}
+		}
+	}
 	if len(_c.mutation.PropertyIDs()) == 0 {
 		return &ValidationError{Name: "property", err: errors.New(`ent: missing required edge "Space.property"`)}
 	}
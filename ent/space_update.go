@@ -777,11 +777,31 @@ func (_u *SpaceUpdate) check() error {
 			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Space.status": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.SquareFootage(); ok {
+		if err := space.SquareFootageValidator(v); err != nil {
+			return &ValidationError{Name: "square_footage", err: fmt.Errorf(`ent: validator failed for field "Space.square_footage": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Bedrooms(); ok {
+		if err := space.BedroomsValidator(v); err != nil {
+			return &ValidationError{Name: "bedrooms", err: fmt.Errorf(`ent: validator failed for field "Space.bedrooms": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Bathrooms(); ok {
+		if err := space.BathroomsValidator(v); err != nil {
+			return &ValidationError{Name: "bathrooms", err: fmt.Errorf(`ent: validator failed for field "Space.bathrooms": %w`, err)}
+		}
+	}
 	if v, ok := _u.mutation.MarketRentCurrency(); ok {
 		if err := space.MarketRentCurrencyValidator(v); err != nil {
 			return &ValidationError{Name: "market_rent_currency", err: fmt.Errorf(`ent: validator failed for field "Space.market_rent_currency": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.AmiRestriction(); ok {
+		if err := space.AmiRestrictionValidator(v); err != nil {
+			return &ValidationError{Name: "ami_restriction", err: fmt.Errorf(`ent: validator failed for field "Space.ami_restriction": %w`, err)}
+		}
+	}
 	if _u.mutation.PropertyCleared() && len(_u.mutation.PropertyIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Space.property"`)
 	}
@@ -1981,11 +2001,31 @@ func (_u *SpaceUpdateOne) check() error {
 			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Space.status": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.SquareFootage(); ok {
+		if err := space.SquareFootageValidator(v); err != nil {
+			return &ValidationError{Name: "square_footage", err: fmt.Errorf(`ent: validator failed for field "Space.square_footage": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Bedrooms(); ok {
+		if err := space.BedroomsValidator(v); err != nil {
+			return &ValidationError{Name: "bedrooms", err: fmt.Errorf(`ent: validator failed for field "Space.bedrooms": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Bathrooms(); ok {
+		if err := space.BathroomsValidator(v); err != nil {
+			return &ValidationError{Name: "bathrooms", err: fmt.Errorf(`ent: validator failed for field "Space.bathrooms": %w`, err)}
+		}
+	}
 	if v, ok := _u.mutation.MarketRentCurrency(); ok {
 		if err := space.MarketRentCurrencyValidator(v); err != nil {
 			return &ValidationError{Name: "market_rent_currency", err: fmt.Errorf(`ent: validator failed for field "Space.market_rent_currency": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.AmiRestriction(); ok {
+		if err := space.AmiRestrictionValidator(v); err != nil {
+			return &ValidationError{Name: "ami_restriction", err: fmt.Errorf(`ent: validator failed for field "Space.ami_restriction": %w`, err)}
+		}
+	}
 	if _u.mutation.PropertyCleared() && len(_u.mutation.PropertyIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Space.property"`)
 	}
@@ -0,0 +1,107 @@
+package cueparse
+
+import (
+	"fmt"
+
+	"cuelang.org/go/cue"
+)
+
+// Bound is one side of a numeric constraint such as >=0 or <365.
+type Bound struct {
+	Value     string // the literal as written in CUE, e.g. "0" or "-90"
+	Exclusive bool   // > or < rather than >= or <=
+}
+
+// NumericBounds returns the lower and upper bounds of a numeric constraint,
+// or nil for a side that is unbounded. A default (*30 | int & >=0) is looked
+// through.
+func NumericBounds(val cue.Value) (lo, hi *Bound) {
+	op, args := val.Expr()
+	switch op {
+	case cue.AndOp, cue.OrOp:
+		for _, arg := range args {
+			l, h := NumericBounds(arg)
+			if l != nil {
+				lo = l
+			}
+			if h != nil {
+				hi = h
+			}
+		}
+		return lo, hi
+	case cue.NoOp:
+		if len(args) == 1 {
+			if inner, _ := args[0].Expr(); inner != cue.NoOp {
+				return NumericBounds(args[0])
+			}
+		}
+		return nil, nil
+	}
+	if len(args) == 0 {
+		return nil, nil
+	}
+	b := &Bound{Value: fmt.Sprint(args[len(args)-1])}
+	switch op {
+	case cue.GreaterThanOp:
+		b.Exclusive = true
+		return b, nil
+	case cue.GreaterThanEqualOp:
+		return b, nil
+	case cue.LessThanOp:
+		b.Exclusive = true
+		return nil, b
+	case cue.LessThanEqualOp:
+		return nil, b
+	}
+	return nil, nil
+}
+
+// Pattern returns the regular expression of a =~ constraint, or "".
+func Pattern(val cue.Value) string {
+	op, args := val.Expr()
+	switch op {
+	case cue.AndOp, cue.OrOp:
+		for _, arg := range args {
+			if p := Pattern(arg); p != "" {
+				return p
+			}
+		}
+	case cue.NoOp:
+		// A default (*"US" | =~"^[A-Z]{2}$") wraps the disjunction in a NoOp.
+		if len(args) == 1 {
+			if inner, _ := args[0].Expr(); inner != cue.NoOp {
+				return Pattern(args[0])
+			}
+		}
+	case cue.RegexMatchOp:
+		if s, err := args[len(args)-1].String(); err == nil {
+			return s
+		}
+	}
+	return ""
+}
+
+// NonEmpty reports whether a string value is constrained to be non-empty,
+// by !="" or strings.MinRunes(n) with n >= 1.
+func NonEmpty(val cue.Value) bool {
+	op, args := val.Expr()
+	switch op {
+	case cue.AndOp:
+		for _, arg := range args {
+			if NonEmpty(arg) {
+				return true
+			}
+		}
+	case cue.NotEqualOp:
+		if s, err := args[len(args)-1].String(); err == nil && s == "" {
+			return true
+		}
+	case cue.CallOp:
+		if len(args) >= 2 {
+			if n, err := args[1].Int64(); err == nil && n >= 1 {
+				return true
+			}
+		}
+	}
+	return false
+}
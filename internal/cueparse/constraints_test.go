@@ -0,0 +1,54 @@
+package cueparse
+
+import (
+	"testing"
+
+	"cuelang.org/go/cue"
+	"cuelang.org/go/cue/cuecontext"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testConstraints = `
+import "strings"
+
+days:     *30 | int & >=0 & <=365
+percent:  float & >0 & <100
+lat:      float & >=-90
+plain:    int
+code:     =~"^[A-Z]{2}$"
+country:  *"US" | =~"^[A-Z]{2}$"
+name:     string & !=""
+line1:    string & strings.MinRunes(1)
+note:     string
+`
+
+func TestConstraints(t *testing.T) {
+	val := cuecontext.New().CompileString(testConstraints)
+	require.NoError(t, val.Err())
+	field := func(name string) cue.Value { return val.LookupPath(cue.ParsePath(name)) }
+
+	lo, hi := NumericBounds(field("days"))
+	assert.Equal(t, &Bound{Value: "0"}, lo)
+	assert.Equal(t, &Bound{Value: "365"}, hi)
+
+	lo, hi = NumericBounds(field("percent"))
+	assert.Equal(t, &Bound{Value: "0", Exclusive: true}, lo)
+	assert.Equal(t, &Bound{Value: "100", Exclusive: true}, hi)
+
+	lo, hi = NumericBounds(field("lat"))
+	assert.Equal(t, &Bound{Value: "-90"}, lo)
+	assert.Nil(t, hi)
+
+	lo, hi = NumericBounds(field("plain"))
+	assert.Nil(t, lo)
+	assert.Nil(t, hi)
+
+	assert.Equal(t, "^[A-Z]{2}$", Pattern(field("code")))
+	assert.Equal(t, "^[A-Z]{2}$", Pattern(field("country")))
+	assert.Empty(t, Pattern(field("note")))
+
+	assert.True(t, NonEmpty(field("name")))
+	assert.True(t, NonEmpty(field("line1")))
+	assert.False(t, NonEmpty(field("note")))
+}
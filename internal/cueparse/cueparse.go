@@ -0,0 +1,230 @@
+// Package cueparse classifies the ontology's CUE definitions into a neutral
+// entity model. Every generator in cmd/ reads entities and fields through it,
+// so a field is a list, an enum or a value type for all of them or for none.
+package cueparse
+
+import "cuelang.org/go/cue"
+
+// MoneyTypes are the CUE definitions of money values. Generators flatten a
+// money field into an amount in cents and a currency code.
+var MoneyTypes = map[string]bool{
+	"#Money":            true,
+	"#NonNegativeMoney": true,
+	"#PositiveMoney":    true,
+}
+
+// ValueTypes maps the CUE definitions of known value types to the Go type
+// that stores them, e.g. "#Address" to "types.Address".
+var ValueTypes = map[string]string{
+	"#Money":               "types.Money",
+	"#NonNegativeMoney":    "types.Money",
+	"#PositiveMoney":       "types.Money",
+	"#Address":             "types.Address",
+	"#ContactMethod":       "types.ContactMethod",
+	"#DateRange":           "types.DateRange",
+	"#EntityRef":           "types.EntityRef",
+	"#RentScheduleEntry":   "types.RentScheduleEntry",
+	"#RecurringCharge":     "types.RecurringCharge",
+	"#LateFeePolicy":       "types.LateFeePolicy",
+	"#CAMTerms":            "types.CAMTerms",
+	"#TenantImprovement":   "types.TenantImprovement",
+	"#RenewalOption":       "types.RenewalOption",
+	"#SubsidyTerms":        "types.SubsidyTerms",
+	"#AccountDimensions":   "types.AccountDimensions",
+	"#JournalLine":         "types.JournalLine",
+	"#RoleAttributes":      "json.RawMessage",
+	"#TenantAttributes":    "types.TenantAttributes",
+	"#OwnerAttributes":     "types.OwnerAttributes",
+	"#ManagerAttributes":   "types.ManagerAttributes",
+	"#GuarantorAttributes": "types.GuarantorAttributes",
+	"#UsageBasedCharge":    "types.UsageBasedCharge",
+	"#PercentageRent":      "types.PercentageRent",
+	"#RentAdjustment":      "types.RentAdjustment",
+	"#ExpansionRight":      "types.ExpansionRight",
+	"#ContractionRight":    "types.ContractionRight",
+	"#CAMCategoryTerms":    "types.CAMCategoryTerms",
+}
+
+// baseEntities are the embedded base definitions that carry id and audit
+// but are not domain entities themselves.
+var baseEntities = map[string]bool{
+	"BaseEntity":      true,
+	"StatefulEntity":  true,
+	"ImmutableEntity": true,
+}
+
+// FindReference recursively searches a CUE value expression tree for a
+// reference to a definition (like #Money or #Address) or time.Time, and
+// returns its last selector. It returns "" when there is none.
+func FindReference(val cue.Value) string {
+	// Direct reference
+	_, path := val.ReferencePath()
+	if path.String() != "" {
+		selectors := path.Selectors()
+		if len(selectors) > 0 {
+			return selectors[len(selectors)-1].String()
+		}
+	}
+
+	// Check expression tree for references within unifications
+	op, args := val.Expr()
+	if op == cue.AndOp || op == cue.OrOp {
+		for _, arg := range args {
+			if ref := FindReference(arg); ref != "" {
+				return ref
+			}
+		}
+	}
+	// time.Time shows as a selector on the time package
+	if op == cue.SelectorOp && len(args) >= 2 {
+		if s, err := args[1].String(); err == nil && s == "Time" {
+			return "time.Time"
+		}
+	}
+	return ""
+}
+
+// IsTime reports whether a CUE value is a time.Time.
+func IsTime(val cue.Value) bool {
+	ref := FindReference(val)
+	return ref == "time.Time" || ref == "Time"
+}
+
+// Kind returns the kind of a CUE value. A value that CUE reports as bottom
+// because of conflicting conditional constraints is classified by its
+// expression tree instead.
+func Kind(val cue.Value) cue.Kind {
+	kind := val.IncompleteKind()
+	if kind == cue.BottomKind {
+		kind = InferKind(val)
+	}
+	return kind
+}
+
+// InferKind walks the expression tree to find the underlying kind of a value
+// that CUE reports as BottomKind due to conditional constraints.
+func InferKind(val cue.Value) cue.Kind {
+	op, args := val.Expr()
+	if op == cue.AndOp || op == cue.OrOp {
+		for _, arg := range args {
+			if k := arg.IncompleteKind(); k != cue.BottomKind {
+				return k
+			}
+			if k := InferKind(arg); k != cue.BottomKind {
+				return k
+			}
+		}
+	}
+	if op == cue.NoOp && len(args) == 0 {
+		return val.IncompleteKind()
+	}
+	return cue.BottomKind
+}
+
+// IsList reports whether a CUE value is a list.
+func IsList(val cue.Value) bool {
+	return Kind(val) == cue.ListKind
+}
+
+// ListElem returns the element type of a list value. For lists built from
+// conditional constraints it searches the expression tree.
+func ListElem(val cue.Value) (cue.Value, bool) {
+	if elem := val.LookupPath(cue.MakePath(cue.AnyIndex)); elem.Err() == nil {
+		return elem, true
+	}
+	op, args := val.Expr()
+	if op == cue.AndOp || op == cue.OrOp {
+		for _, arg := range args {
+			if elem, ok := ListElem(arg); ok {
+				return elem, true
+			}
+		}
+	}
+	return cue.Value{}, false
+}
+
+// IsEnum reports whether a CUE value is a disjunction of string literals.
+func IsEnum(val cue.Value) bool {
+	return isStringDisjunction(enumDisjunction(val))
+}
+
+// EnumValues returns the string literals of an enum's disjunction, or nil
+// when the value is not an enum.
+func EnumValues(val cue.Value) []string {
+	op, args := enumDisjunction(val)
+	if !isStringDisjunction(op, args) {
+		return nil
+	}
+	values := make([]string, 0, len(args))
+	for _, arg := range args {
+		if s, ok := literal(arg); ok {
+			values = append(values, s)
+		}
+	}
+	return values
+}
+
+// enumDisjunction extracts the OrOp disjunction from a value. The disjunction
+// may sit behind a reference, or be wrapped in an AndOp when a `status: string`
+// from an embedded base type is unified with `status: "a" | "b"`.
+func enumDisjunction(val cue.Value) (cue.Op, []cue.Value) {
+	op, args := val.Expr()
+	if op == cue.OrOp {
+		return op, args
+	}
+	if dOp, dArgs := cue.Dereference(val).Expr(); dOp == cue.OrOp {
+		return dOp, dArgs
+	}
+	if op == cue.AndOp {
+		for _, arg := range args {
+			if argOp, argArgs := arg.Expr(); isStringDisjunction(argOp, argArgs) {
+				return argOp, argArgs
+			}
+		}
+	}
+	return op, args
+}
+
+// isStringDisjunction reports whether op and args form a disjunction of at
+// least two string literals, any of which may be marked as the default.
+func isStringDisjunction(op cue.Op, args []cue.Value) bool {
+	if op != cue.OrOp || len(args) < 2 {
+		return false
+	}
+	for _, arg := range args {
+		if _, ok := literal(arg); !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// literal returns the string a disjunct stands for, looking through a
+// default marker.
+func literal(arg cue.Value) (string, bool) {
+	check := arg
+	if op, args := arg.Expr(); op == cue.SelectorOp && len(args) > 0 {
+		check = args[0]
+	}
+	if check.IncompleteKind() != cue.StringKind {
+		return "", false
+	}
+	if s, err := check.String(); err == nil {
+		return s, true
+	}
+	if d, ok := check.Default(); ok {
+		if s, err := d.String(); err == nil {
+			return s, true
+		}
+	}
+	return "", false
+}
+
+// TransitionTarget returns the target state of a #StateMachine list element,
+// which is either a state name or a #GuardedTransition.
+func TransitionTarget(v cue.Value) cue.Value {
+	if to := v.LookupPath(cue.ParsePath("to")); to.Exists() {
+		return to
+	}
+	return v
+}
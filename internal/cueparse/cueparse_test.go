@@ -0,0 +1,121 @@
+package cueparse
+
+import (
+	"testing"
+
+	"cuelang.org/go/cue"
+	"cuelang.org/go/cue/cuecontext"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testOntology = `
+import "time"
+
+#Money: {amount_cents: int, currency: string}
+#Address: {line1: string, city: string}
+#Extra: {note: string}
+
+#BaseEntity: {
+	id:    string
+	audit: {created_by: string}
+}
+
+#StatefulEntity: {
+	#BaseEntity
+	status: string
+}
+
+#Lease: {
+	#StatefulEntity
+	status:       "draft" | "active" | "terminated"
+	name:         string
+	count:        int & >=0
+	ratio:        float
+	flag:         *false | bool
+	signed_at:    time.Time
+	rent:         #Money
+	address?:     #Address
+	addresses:    [...#Address]
+	tags:         [...string]
+	kinds:        [...("a" | "b")]
+	extras:       [...#Extra]
+	liability:    *"joint" | "several"
+	blob:         {x: int}
+	anything:     _
+	_hidden:      string
+}
+`
+
+func compile(t *testing.T) cue.Value {
+	t.Helper()
+	val := cuecontext.New().CompileString(testOntology)
+	require.NoError(t, val.Err())
+	return val
+}
+
+func TestEntitiesSkipsBaseDefinitions(t *testing.T) {
+	entities := Entities(compile(t))
+	require.Len(t, entities, 1)
+	assert.Equal(t, "Lease", entities[0].Name)
+}
+
+func TestClassifyFields(t *testing.T) {
+	fields := map[string]Field{}
+	for _, f := range Entities(compile(t))[0].Fields {
+		fields[f.Name] = f
+	}
+	assert.NotContains(t, fields, "id")
+	assert.NotContains(t, fields, "audit")
+	assert.NotContains(t, fields, "_hidden")
+
+	kinds := map[string]FieldKind{
+		"status":    Enum,
+		"name":      String,
+		"count":     Int,
+		"ratio":     Float,
+		"flag":      Bool,
+		"signed_at": Time,
+		"rent":      Money,
+		"address":   ValueType,
+		"addresses": ValueType,
+		"tags":      List,
+		"kinds":     List,
+		"extras":    List,
+		"liability": Enum,
+		"blob":      Struct,
+		"anything":  Any,
+	}
+	for name, kind := range kinds {
+		assert.Equal(t, kind, fields[name].Kind, name)
+	}
+
+	assert.Equal(t, []string{"draft", "active", "terminated"}, fields["status"].EnumValues)
+	assert.Equal(t, []string{"joint", "several"}, fields["liability"].EnumValues)
+	assert.Equal(t, "#Money", fields["rent"].Ref)
+	assert.True(t, fields["address"].Optional)
+	assert.False(t, fields["address"].List)
+	assert.Equal(t, "#Address", fields["addresses"].Ref)
+	assert.True(t, fields["addresses"].List)
+	assert.Equal(t, String, fields["tags"].ElemKind)
+	assert.Equal(t, String, fields["kinds"].ElemKind)
+	assert.Equal(t, Unknown, fields["extras"].ElemKind, "#Extra is not a known value type")
+}
+
+func TestEnumValuesOfNonEnum(t *testing.T) {
+	val := cuecontext.New().CompileString(`x: "a" | 1`)
+	require.NoError(t, val.Err())
+	x := val.LookupPath(cue.ParsePath("x"))
+	assert.False(t, IsEnum(x))
+	assert.Nil(t, EnumValues(x))
+}
+
+func TestTransitionTarget(t *testing.T) {
+	val := cuecontext.New().CompileString(`["active", {to: "terminated", guard: "signed_at != null"}]`)
+	require.NoError(t, val.Err())
+	for i, want := range []string{"active", "terminated"} {
+		got, err := TransitionTarget(val.LookupPath(cue.MakePath(cue.Index(i)))).String()
+		require.NoError(t, err)
+		assert.Equal(t, want, got)
+	}
+}
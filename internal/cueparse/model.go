@@ -0,0 +1,159 @@
+package cueparse
+
+import (
+	"strings"
+
+	"cuelang.org/go/cue"
+)
+
+// FieldKind is the neutral classification of an entity field. Each generator
+// maps it onto its own types (Ent field builders, OpenAPI schemas, UI widgets).
+type FieldKind int
+
+const (
+	// Unknown is a field whose type could not be determined; generators skip it.
+	Unknown FieldKind = iota
+	String
+	Int
+	Float
+	Bool
+	Time
+	Enum
+	// Money is a #Money field (or a variant); Ref names the definition.
+	Money
+	// ValueType is a known value type such as #Address; Ref names the
+	// definition and List reports a list of them.
+	ValueType
+	// List is a list of anything but a known value type; ElemKind is String
+	// for string and enum lists and Unknown otherwise.
+	List
+	// Struct is an inline struct with no known definition.
+	Struct
+	// Any is the top type or a value of mixed kinds.
+	Any
+)
+
+// Field is one classified field of an entity.
+type Field struct {
+	Name       string
+	Kind       FieldKind
+	Optional   bool
+	Ref        string // definition of a Money or ValueType field, e.g. "#Address"
+	List       bool   // a list of ValueType
+	ElemKind   FieldKind
+	EnumValues []string
+	Value      cue.Value // the field's CUE value, for attributes, defaults and constraints
+}
+
+// Entity is a domain entity: a CUE definition with id and audit fields.
+type Entity struct {
+	Name   string // definition name without the leading #
+	Value  cue.Value
+	Fields []Field // in CUE order, without id, audit and hidden fields
+}
+
+// Entities returns the domain entities defined in an ontology value, in
+// definition order. Base definitions like #BaseEntity are skipped.
+func Entities(val cue.Value) []Entity {
+	var entities []Entity
+	iter, _ := val.Fields(cue.Definitions(true))
+	for iter.Next() {
+		defVal := iter.Value()
+		if defVal.LookupPath(cue.ParsePath("id")).Err() != nil {
+			continue
+		}
+		if defVal.LookupPath(cue.ParsePath("audit")).Err() != nil {
+			continue
+		}
+		name := strings.TrimPrefix(iter.Selector().String(), "#")
+		if baseEntities[name] {
+			continue
+		}
+		entities = append(entities, Entity{Name: name, Value: defVal, Fields: Fields(defVal)})
+	}
+	return entities
+}
+
+// Fields classifies the fields of an entity struct, skipping id, audit and
+// hidden fields.
+func Fields(structVal cue.Value) []Field {
+	var fields []Field
+	iter, _ := structVal.Fields(cue.Optional(true))
+	for iter.Next() {
+		label := strings.TrimSuffix(iter.Selector().String(), "?")
+		if label == "id" || label == "audit" || strings.HasPrefix(label, "_") {
+			continue
+		}
+		fields = append(fields, Classify(label, iter.Value(), iter.IsOptional()))
+	}
+	return fields
+}
+
+// Classify determines the kind of a single field.
+func Classify(name string, val cue.Value, optional bool) Field {
+	f := Field{Name: name, Optional: optional, Value: val}
+
+	if IsTime(val) {
+		f.Kind = Time
+		return f
+	}
+
+	if ref := FindReference(val); ref != "" {
+		if MoneyTypes[ref] {
+			f.Kind, f.Ref = Money, ref
+			return f
+		}
+		if _, ok := ValueTypes[ref]; ok {
+			f.Kind, f.Ref, f.List = ValueType, ref, IsList(val)
+			return f
+		}
+	}
+
+	if IsList(val) {
+		classifyList(&f)
+		return f
+	}
+
+	// Enums are string-like, so they are checked before the kind.
+	if IsEnum(val) {
+		f.Kind = Enum
+		f.EnumValues = EnumValues(val)
+		return f
+	}
+
+	switch kind := Kind(val); kind {
+	case cue.StringKind:
+		f.Kind = String
+	case cue.IntKind:
+		f.Kind = Int
+	case cue.FloatKind, cue.NumberKind:
+		f.Kind = Float
+	case cue.BoolKind:
+		f.Kind = Bool
+	case cue.StructKind:
+		f.Kind = Struct
+	default:
+		if kind != 0 && kind != cue.BottomKind {
+			f.Kind = Any
+		}
+	}
+	return f
+}
+
+// classifyList fills in a list field from its element type.
+func classifyList(f *Field) {
+	f.Kind = List
+	elem, ok := ListElem(f.Value)
+	if !ok {
+		return
+	}
+	if ref := FindReference(elem); ref != "" {
+		if _, ok := ValueTypes[ref]; ok {
+			f.Kind, f.Ref, f.List = ValueType, ref, true
+			return
+		}
+	}
+	if Kind(elem) == cue.StringKind || IsEnum(elem) {
+		f.ElemKind = String
+	}
+}
@@ -0,0 +1,158 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/matthewbaird/ontology/ent"
+)
+
+// Audit operations recorded by the generated handlers.
+const (
+	AuditOpCreate     = "create"
+	AuditOpUpdate     = "update"
+	AuditOpTransition = "transition"
+	AuditOpDelete     = "delete"
+)
+
+// AuditEvent is one row of the audit trail: a single create, update,
+// transition or delete of one entity and the fields it changed.
+type AuditEvent struct {
+	Entity        string                 `json:"entity"`
+	EntityID      uuid.UUID              `json:"entity_id"`
+	Op            string                 `json:"op"`
+	Actor         string                 `json:"actor"`
+	Source        string                 `json:"source"`
+	CorrelationID *string                `json:"correlation_id,omitempty"`
+	Changes       map[string]FieldChange `json:"changes"`
+	OccurredAt    time.Time              `json:"occurred_at"`
+}
+
+// FieldChange is the value of one field before and after a mutation.
+// Before is nil on create; After is nil when the field was cleared.
+type FieldChange struct {
+	Before any `json:"before"`
+	After  any `json:"after"`
+}
+
+// AuditRecorder appends audit events. Append runs inside the mutation's
+// transaction, so a recorder that writes through tx commits or rolls back
+// with the change; returning an error aborts the mutation.
+type AuditRecorder interface {
+	Append(ctx context.Context, tx *ent.Tx, evt AuditEvent) error
+}
+
+// auditChanges collects the before and after values of every field m sets
+// or clears. Old values are loaded from the database on demand, so it must
+// run before the mutation is saved and outside any open transaction.
+func auditChanges(ctx context.Context, m ent.Mutation) (map[string]FieldChange, error) {
+	changes := make(map[string]FieldChange)
+	for _, name := range m.Fields() {
+		after, _ := m.Field(name)
+		changes[name] = FieldChange{After: after}
+	}
+	for _, name := range m.ClearedFields() {
+		changes[name] = FieldChange{}
+	}
+	if !m.Op().Is(ent.OpUpdateOne) {
+		return changes, nil
+	}
+	for name, c := range changes {
+		before, err := m.OldField(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+		c.Before = before
+		changes[name] = c
+	}
+	return changes, nil
+}
+
+// appendAudit builds the audit event for a saved mutation and hands it to
+// rec. The mutation carries the entity ID once it has been saved.
+func appendAudit(ctx context.Context, tx *ent.Tx, rec AuditRecorder, op string, info AuditInfo, m ent.Mutation, changes map[string]FieldChange) error {
+	idm, ok := m.(interface{ ID() (uuid.UUID, bool) })
+	if !ok {
+		return fmt.Errorf("audit: %s mutation has no uuid id", m.Type())
+	}
+	id, _ := idm.ID()
+	return rec.Append(ctx, tx, AuditEvent{
+		Entity:        m.Type(),
+		EntityID:      id,
+		Op:            op,
+		Actor:         info.Actor,
+		Source:        info.Source,
+		CorrelationID: info.CorrelationID,
+		Changes:       changes,
+		OccurredAt:    time.Now().UTC(),
+	})
+}
+
+// saveAudited saves m and, when rec is set, appends its audit event in the
+// same transaction. Without a recorder it is a plain save through client.
+func saveAudited[T any](ctx context.Context, client *ent.Client, rec AuditRecorder, op string, info AuditInfo, m ent.Mutation) (T, error) {
+	var zero T
+	if rec == nil {
+		v, err := client.Mutate(ctx, m)
+		if err != nil {
+			return zero, err
+		}
+		return v.(T), nil
+	}
+	changes, err := auditChanges(ctx, m)
+	if err != nil {
+		return zero, err
+	}
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return zero, err
+	}
+	v, err := tx.Client().Mutate(ctx, m)
+	if err != nil {
+		tx.Rollback()
+		return zero, err
+	}
+	if err := appendAudit(ctx, tx, rec, op, info, m, changes); err != nil {
+		tx.Rollback()
+		return zero, err
+	}
+	if err := tx.Commit(); err != nil {
+		return zero, err
+	}
+	return v.(T), nil
+}
+
+// deleteAudited runs del and, when rec is set, appends an AuditOpDelete event
+// for the row in the same transaction. A hard delete leaves no field values
+// to diff, so the event's changes are empty.
+func deleteAudited(ctx context.Context, client *ent.Client, rec AuditRecorder, info AuditInfo, entity string, id uuid.UUID, del func(*ent.Client) error) error {
+	if rec == nil {
+		return del(client)
+	}
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	if err := del(tx.Client()); err != nil {
+		tx.Rollback()
+		return err
+	}
+	err = rec.Append(ctx, tx, AuditEvent{
+		Entity:        entity,
+		EntityID:      id,
+		Op:            AuditOpDelete,
+		Actor:         info.Actor,
+		Source:        info.Source,
+		CorrelationID: info.CorrelationID,
+		Changes:       map[string]FieldChange{},
+		OccurredAt:    time.Now().UTC(),
+	})
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
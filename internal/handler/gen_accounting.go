@@ -2,7 +2,9 @@
 package handler
 
 import (
+	"context"
 	"net/http"
+	"regexp"
 	"time"
 
 	"github.com/google/uuid"
@@ -10,7 +12,10 @@ import (
 	"github.com/matthewbaird/ontology/ent/account"
 	"github.com/matthewbaird/ontology/ent/bankaccount"
 	"github.com/matthewbaird/ontology/ent/journalentry"
+	"github.com/matthewbaird/ontology/ent/lease"
 	"github.com/matthewbaird/ontology/ent/ledgerentry"
+	"github.com/matthewbaird/ontology/ent/person"
+	"github.com/matthewbaird/ontology/ent/property"
 	"github.com/matthewbaird/ontology/ent/reconciliation"
 	"github.com/matthewbaird/ontology/internal/types"
 )
@@ -25,17 +30,28 @@ var (
 // AccountingHandler implements HTTP handlers for AccountingService entities.
 type AccountingHandler struct {
 	client *ent.Client
+	audit  AuditRecorder
 }
 
-// NewAccountingHandler creates a new AccountingHandler.
-func NewAccountingHandler(client *ent.Client) *AccountingHandler {
-	return &AccountingHandler{client: client}
+// NewAccountingHandler creates a new AccountingHandler. Mutations append to audit when it is
+// non-nil; a nil recorder keeps no audit trail.
+func NewAccountingHandler(client *ent.Client, audit AuditRecorder) *AccountingHandler {
+	return &AccountingHandler{client: client, audit: audit}
 }
 
 // ============================================================================
 // Account
 // ============================================================================
 
+// Accepted values of the Account enum fields.
+var (
+	accountAccountTypeValues    = []string{"asset", "liability", "equity", "revenue", "expense"}
+	accountAccountSubtypeValues = []string{"cash", "accounts_receivable", "prepaid", "fixed_asset", "accumulated_depreciation", "other_asset", "accounts_payable", "accrued_liability", "unearned_revenue", "security_deposits_held", "other_liability", "owners_equity", "retained_earnings", "distributions", "rental_income", "other_income", "cam_recovery", "percentage_rent_income", "operating_expense", "maintenance_expense", "utility_expense", "management_fee_expense", "depreciation_expense", "other_expense"}
+	accountNormalBalanceValues  = []string{"debit", "credit"}
+	accountStatusValues         = []string{"active", "inactive", "archived"}
+	accountTrustTypeValues      = []string{"operating", "security_deposit", "escrow"}
+)
+
 type createAccountRequest struct {
 	AccountNumber           string                   `json:"account_number"`
 	Name                    string                   `json:"name"`
@@ -57,17 +73,32 @@ type createAccountRequest struct {
 	ParentAccountID         *string                  `json:"parent_account_id,omitempty"`
 }
 
-func (h *AccountingHandler) CreateAccount(w http.ResponseWriter, r *http.Request) {
-	audit, ok := parseAuditContext(w, r)
-	if !ok {
-		return
+func validateAccountCreate(req *createAccountRequest) fieldErrors {
+	errs := fieldErrors{}
+	errs.add(checkRequired("account_number", req.AccountNumber == ""))
+	errs.add(checkRequired("name", req.Name == ""))
+	errs.add(checkRequired("account_type", req.AccountType == ""))
+	errs.add(checkEnum("account_type", req.AccountType, accountAccountTypeValues))
+	errs.add(checkRequired("account_subtype", req.AccountSubtype == ""))
+	errs.add(checkEnum("account_subtype", req.AccountSubtype, accountAccountSubtypeValues))
+	errs.add(checkMin("depth", float64(req.Depth), 0, false))
+	errs.add(checkRequired("normal_balance", req.NormalBalance == ""))
+	errs.add(checkEnum("normal_balance", req.NormalBalance, accountNormalBalanceValues))
+	errs.add(checkRequired("status", req.Status == ""))
+	errs.add(checkEnum("status", req.Status, accountStatusValues))
+	if req.TrustType != nil {
+		errs.add(checkEnum("trust_type", *req.TrustType, accountTrustTypeValues))
 	}
-	var req createAccountRequest
-	if err := decodeJSON(r, &req); err != nil {
-		writeError(w, http.StatusBadRequest, "INVALID_JSON", err.Error())
-		return
+	if req.BudgetAmountCurrency != nil {
+		errs.add(checkCurrency("budget_amount_currency", *req.BudgetAmountCurrency))
 	}
-	builder := h.client.Account.Create()
+	if req.ParentAccountID != nil {
+		errs.add(checkID("parent_account_id", *req.ParentAccountID))
+	}
+	return errs
+}
+
+func (req *createAccountRequest) apply(builder *ent.AccountCreate) {
 	builder.SetAccountNumber(req.AccountNumber)
 	builder.SetName(req.Name)
 	if req.Description != nil {
@@ -92,24 +123,39 @@ func (h *AccountingHandler) CreateAccount(w http.ResponseWriter, r *http.Request
 		builder.SetNillableBudgetAmountAmountCents(req.BudgetAmountAmountCents)
 	}
 	if req.BudgetAmountCurrency != nil {
-		builder.SetNillableBudgetAmountCurrency(req.BudgetAmountCurrency)
+		builder.SetBudgetAmountCurrency(*req.BudgetAmountCurrency)
 	}
 	if req.TaxLine != nil {
 		builder.SetNillableTaxLine(req.TaxLine)
 	}
 	if req.ParentAccountID != nil {
-		uid, err := uuid.Parse(*req.ParentAccountID)
-		if err != nil {
-			writeError(w, http.StatusBadRequest, "INVALID_ID", "invalid parent_account_id")
-			return
-		}
-		builder.SetParentID(uid)
+		builder.SetParentID(uuid.MustParse(*req.ParentAccountID))
 	}
+}
+
+func (h *AccountingHandler) CreateAccount(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), handlerTimeout)
+	defer cancel()
+	audit, ok := parseAuditContext(w, r)
+	if !ok {
+		return
+	}
+	var req createAccountRequest
+	if err := decodeJSON(r, &req); err != nil {
+		writeError(w, http.StatusBadRequest, "INVALID_JSON", err.Error())
+		return
+	}
+	if errs := validateAccountCreate(&req); len(errs) > 0 {
+		writeFieldErrors(w, errs)
+		return
+	}
+	builder := h.client.Account.Create()
+	req.apply(builder)
 	builder.SetCreatedBy(audit.Actor).SetUpdatedBy(audit.Actor).SetSource(account.Source(audit.Source))
 	if audit.CorrelationID != nil {
 		builder.SetCorrelationID(*audit.CorrelationID)
 	}
-	result, err := builder.Save(r.Context())
+	result, err := saveAudited[*ent.Account](ctx, h.client, h.audit, AuditOpCreate, audit, builder.Mutation())
 	if err != nil {
 		entErrorToHTTP(w, err)
 		return
@@ -117,30 +163,184 @@ func (h *AccountingHandler) CreateAccount(w http.ResponseWriter, r *http.Request
 	writeJSON(w, http.StatusCreated, result)
 }
 
+// accountIncludes maps ?include= edge names to eager loaders on a Account query.
+var accountIncludes = map[string]func(*ent.AccountQuery){
+	"children":      func(q *ent.AccountQuery) { q.WithChildren() },
+	"parent":        func(q *ent.AccountQuery) { q.WithParent() },
+	"entries":       func(q *ent.AccountQuery) { q.WithEntries() },
+	"bank_accounts": func(q *ent.AccountQuery) { q.WithBankAccounts() },
+}
+
 func (h *AccountingHandler) GetAccount(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), handlerTimeout)
+	defer cancel()
 	id, ok := parseUUID(w, r, "id")
 	if !ok {
 		return
 	}
-	result, err := h.client.Account.Get(r.Context(), id)
+	query := h.client.Account.Query().Where(account.ID(id))
+	withIncludes(r, query, accountIncludes)
+	result, err := query.Only(ctx)
 	if err != nil {
 		entErrorToHTTP(w, err)
 		return
 	}
+	setETag(w, entityETag(result.UpdatedAt))
 	writeJSON(w, http.StatusOK, result)
 }
 
+func (h *AccountingHandler) ListChildrenOfAccount(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), handlerTimeout)
+	defer cancel()
+	id, ok := parseUUID(w, r, "id")
+	if !ok {
+		return
+	}
+	parent, err := h.client.Account.Get(ctx, id)
+	if err != nil {
+		entErrorToHTTP(w, err)
+		return
+	}
+	pg := parsePagination(r)
+	query := h.client.Account.QueryChildren(parent)
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		entErrorToHTTP(w, err)
+		return
+	}
+	items, err := query.
+		Limit(pg.Limit).Offset(pg.Offset).
+		Order(listOrder(r, nil, account.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		entErrorToHTTP(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, listResponse{Data: items, Total: total, Offset: pg.Offset, Limit: pg.Limit})
+}
+
+func (h *AccountingHandler) ListEntriesOfAccount(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), handlerTimeout)
+	defer cancel()
+	id, ok := parseUUID(w, r, "id")
+	if !ok {
+		return
+	}
+	parent, err := h.client.Account.Get(ctx, id)
+	if err != nil {
+		entErrorToHTTP(w, err)
+		return
+	}
+	pg := parsePagination(r)
+	query := h.client.Account.QueryEntries(parent)
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		entErrorToHTTP(w, err)
+		return
+	}
+	items, err := query.
+		Limit(pg.Limit).Offset(pg.Offset).
+		Order(listOrder(r, nil, ledgerentry.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		entErrorToHTTP(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, listResponse{Data: items, Total: total, Offset: pg.Offset, Limit: pg.Limit})
+}
+
+func (h *AccountingHandler) ListBankAccountsOfAccount(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), handlerTimeout)
+	defer cancel()
+	id, ok := parseUUID(w, r, "id")
+	if !ok {
+		return
+	}
+	parent, err := h.client.Account.Get(ctx, id)
+	if err != nil {
+		entErrorToHTTP(w, err)
+		return
+	}
+	pg := parsePagination(r)
+	query := h.client.Account.QueryBankAccounts(parent)
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		entErrorToHTTP(w, err)
+		return
+	}
+	items, err := query.
+		Limit(pg.Limit).Offset(pg.Offset).
+		Order(listOrder(r, nil, bankaccount.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		entErrorToHTTP(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, listResponse{Data: items, Total: total, Offset: pg.Offset, Limit: pg.Limit})
+}
+
+func (h *AccountingHandler) CheckAccountExists(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), handlerTimeout)
+	defer cancel()
+	id, ok := parseUUID(w, r, "id")
+	if !ok {
+		return
+	}
+	exists, err := h.client.Account.Query().Where(account.ID(id)).Exist(ctx)
+	if err != nil {
+		entErrorToHTTP(w, err)
+		return
+	}
+	if !exists {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// accountSortColumns allowlists the ?sort= values accepted by ListAccounts.
+var accountSortColumns = map[string]string{
+	"created_at":            account.FieldCreatedAt,
+	"updated_at":            account.FieldUpdatedAt,
+	"account_number":        account.FieldAccountNumber,
+	"name":                  account.FieldName,
+	"description":           account.FieldDescription,
+	"account_type":          account.FieldAccountType,
+	"account_subtype":       account.FieldAccountSubtype,
+	"depth":                 account.FieldDepth,
+	"normal_balance":        account.FieldNormalBalance,
+	"is_header":             account.FieldIsHeader,
+	"is_system":             account.FieldIsSystem,
+	"allows_direct_posting": account.FieldAllowsDirectPosting,
+	"status":                account.FieldStatus,
+	"is_trust_account":      account.FieldIsTrustAccount,
+	"trust_type":            account.FieldTrustType,
+	"tax_line":              account.FieldTaxLine,
+}
+
 func (h *AccountingHandler) ListAccounts(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), handlerTimeout)
+	defer cancel()
 	pg := parsePagination(r)
-	items, err := h.client.Account.Query().
+	query := h.client.Account.Query()
+	if q := r.URL.Query().Get("q"); q != "" {
+		query.Where(account.NameContainsFold(q))
+	}
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		entErrorToHTTP(w, err)
+		return
+	}
+	withIncludes(r, query, accountIncludes)
+	items, err := query.
 		Limit(pg.Limit).Offset(pg.Offset).
-		Order(ent.Desc(account.FieldCreatedAt)).
-		All(r.Context())
+		Order(listOrder(r, accountSortColumns, account.FieldCreatedAt)).
+		All(ctx)
 	if err != nil {
 		entErrorToHTTP(w, err)
 		return
 	}
-	writeJSON(w, http.StatusOK, items)
+	writeJSON(w, http.StatusOK, listResponse{Data: items, Total: total, Offset: pg.Offset, Limit: pg.Limit})
 }
 
 type updateAccountRequest struct {
@@ -164,7 +364,44 @@ type updateAccountRequest struct {
 	ParentAccountID         *string                  `json:"parent_account_id,omitempty"`
 }
 
+func validateAccountUpdate(req *updateAccountRequest) fieldErrors {
+	errs := fieldErrors{}
+	if req.AccountNumber != nil {
+		errs.add(checkRequired("account_number", *req.AccountNumber == ""))
+	}
+	if req.Name != nil {
+		errs.add(checkRequired("name", *req.Name == ""))
+	}
+	if req.AccountType != nil {
+		errs.add(checkEnum("account_type", *req.AccountType, accountAccountTypeValues))
+	}
+	if req.AccountSubtype != nil {
+		errs.add(checkEnum("account_subtype", *req.AccountSubtype, accountAccountSubtypeValues))
+	}
+	if req.Depth != nil {
+		errs.add(checkMin("depth", float64(*req.Depth), 0, false))
+	}
+	if req.NormalBalance != nil {
+		errs.add(checkEnum("normal_balance", *req.NormalBalance, accountNormalBalanceValues))
+	}
+	if req.Status != nil {
+		errs.add(checkEnum("status", *req.Status, accountStatusValues))
+	}
+	if req.TrustType != nil {
+		errs.add(checkEnum("trust_type", *req.TrustType, accountTrustTypeValues))
+	}
+	if req.BudgetAmountCurrency != nil {
+		errs.add(checkCurrency("budget_amount_currency", *req.BudgetAmountCurrency))
+	}
+	if req.ParentAccountID != nil {
+		errs.add(checkID("parent_account_id", *req.ParentAccountID))
+	}
+	return errs
+}
+
 func (h *AccountingHandler) UpdateAccount(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), handlerTimeout)
+	defer cancel()
 	id, ok := parseUUID(w, r, "id")
 	if !ok {
 		return
@@ -174,11 +411,42 @@ func (h *AccountingHandler) UpdateAccount(w http.ResponseWriter, r *http.Request
 		return
 	}
 	var req updateAccountRequest
-	if err := decodeJSON(r, &req); err != nil {
+	patch, err := decodeUpdate(r, &req)
+	if err != nil {
 		writeError(w, http.StatusBadRequest, "INVALID_JSON", err.Error())
 		return
 	}
+	if field := patch.firstNull("account_number", "name", "account_type", "account_subtype", "depth", "normal_balance", "is_header", "is_system", "allows_direct_posting", "status", "is_trust_account"); field != "" {
+		writeError(w, http.StatusBadRequest, "INVALID_PATCH", field+" cannot be null")
+		return
+	}
+	if patch.hasObject("dimensions") {
+		stored, err := h.client.Account.Get(ctx, id)
+		if err != nil {
+			entErrorToHTTP(w, err)
+			return
+		}
+		if req.Dimensions, err = mergeObject(patch, "dimensions", stored.Dimensions, req.Dimensions); err != nil {
+			writeError(w, http.StatusBadRequest, "INVALID_JSON", err.Error())
+			return
+		}
+	}
+	if errs := validateAccountUpdate(&req); len(errs) > 0 {
+		writeFieldErrors(w, errs)
+		return
+	}
 	builder := h.client.Account.UpdateOneID(id)
+	if hasIfMatch(r) {
+		current, err := h.client.Account.Get(ctx, id)
+		if err != nil {
+			entErrorToHTTP(w, err)
+			return
+		}
+		if !checkIfMatch(w, r, entityETag(current.UpdatedAt)) {
+			return
+		}
+		builder.Where(account.UpdatedAtEQ(current.UpdatedAt))
+	}
 	if req.AccountNumber != nil {
 		builder.SetAccountNumber(*req.AccountNumber)
 	}
@@ -231,22 +499,39 @@ func (h *AccountingHandler) UpdateAccount(w http.ResponseWriter, r *http.Request
 		builder.SetNillableTaxLine(req.TaxLine)
 	}
 	if req.ParentAccountID != nil {
-		uid, err := uuid.Parse(*req.ParentAccountID)
-		if err != nil {
-			writeError(w, http.StatusBadRequest, "INVALID_ID", "invalid parent_account_id")
-			return
-		}
-		builder.SetParentID(uid)
+		builder.SetParentID(uuid.MustParse(*req.ParentAccountID))
+	}
+	if patch.isNull("description") {
+		builder.ClearDescription()
+	}
+	if patch.isNull("dimensions") {
+		builder.ClearDimensions()
+	}
+	if patch.isNull("trust_type") {
+		builder.ClearTrustType()
+	}
+	if patch.isNull("budget_amount_amount_cents") {
+		builder.ClearBudgetAmountAmountCents()
+	}
+	if patch.isNull("budget_amount_currency") {
+		builder.ClearBudgetAmountCurrency()
+	}
+	if patch.isNull("tax_line") {
+		builder.ClearTaxLine()
+	}
+	if patch.isNull("parent_account_id") {
+		builder.ClearParent()
 	}
 	builder.SetUpdatedBy(audit.Actor).SetSource(account.Source(audit.Source))
 	if audit.CorrelationID != nil {
 		builder.SetCorrelationID(*audit.CorrelationID)
 	}
-	result, err := builder.Save(r.Context())
+	result, err := saveAudited[*ent.Account](ctx, h.client, h.audit, AuditOpUpdate, audit, builder.Mutation())
 	if err != nil {
-		entErrorToHTTP(w, err)
+		writeGuardedSaveError(w, r, err)
 		return
 	}
+	setETag(w, entityETag(result.UpdatedAt))
 	writeJSON(w, http.StatusOK, result)
 }
 
@@ -254,12 +539,193 @@ func (h *AccountingHandler) UpdateAccount(w http.ResponseWriter, r *http.Request
 // LedgerEntry
 // ============================================================================
 
+// Accepted values of the LedgerEntry enum fields.
+var (
+	ledgerEntryEntryTypeValues = []string{"charge", "payment", "credit", "adjustment", "refund", "deposit", "nsf", "write_off", "late_fee", "management_fee", "owner_draw"}
+)
+
+type createLedgerEntryRequest struct {
+	EntryType         string     `json:"entry_type"`
+	AmountAmountCents int64      `json:"amount_amount_cents"`
+	AmountCurrency    string     `json:"amount_currency,omitempty"`
+	EffectiveDate     time.Time  `json:"effective_date"`
+	PostedDate        time.Time  `json:"posted_date"`
+	Description       string     `json:"description"`
+	ChargeCode        string     `json:"charge_code"`
+	Memo              *string    `json:"memo,omitempty"`
+	BankAccountID     *string    `json:"bank_account_id,omitempty"`
+	BankTransactionID *string    `json:"bank_transaction_id,omitempty"`
+	Reconciled        bool       `json:"reconciled"`
+	ReconciliationID  *string    `json:"reconciliation_id,omitempty"`
+	ReconciledAt      *time.Time `json:"reconciled_at,omitempty"`
+	AdjustsEntryID    *string    `json:"adjusts_entry_id,omitempty"`
+	LeaseID           *string    `json:"lease_id,omitempty"`
+	JournalEntryID    string     `json:"journal_entry_id"`
+	AccountID         string     `json:"account_id"`
+	PropertyID        string     `json:"property_id"`
+	SpaceID           *string    `json:"space_id,omitempty"`
+	PersonID          *string    `json:"person_id,omitempty"`
+}
+
+func validateLedgerEntryCreate(req *createLedgerEntryRequest) fieldErrors {
+	errs := fieldErrors{}
+	errs.add(checkRequired("entry_type", req.EntryType == ""))
+	errs.add(checkEnum("entry_type", req.EntryType, ledgerEntryEntryTypeValues))
+	if req.AmountCurrency != "" {
+		errs.add(checkCurrency("amount_currency", req.AmountCurrency))
+	}
+	errs.add(checkRequired("effective_date", req.EffectiveDate.IsZero()))
+	errs.add(checkRequired("posted_date", req.PostedDate.IsZero()))
+	errs.add(checkRequired("description", req.Description == ""))
+	errs.add(checkRequired("charge_code", req.ChargeCode == ""))
+	if req.ReconciliationID != nil {
+		errs.add(checkRequired("reconciliation_id", *req.ReconciliationID == ""))
+	}
+	if req.AdjustsEntryID != nil {
+		errs.add(checkRequired("adjusts_entry_id", *req.AdjustsEntryID == ""))
+	}
+	if req.LeaseID != nil {
+		errs.add(checkID("lease_id", *req.LeaseID))
+	}
+	errs.add(checkRequired("journal_entry_id", req.JournalEntryID == ""))
+	errs.add(checkID("journal_entry_id", req.JournalEntryID))
+	errs.add(checkRequired("account_id", req.AccountID == ""))
+	errs.add(checkID("account_id", req.AccountID))
+	errs.add(checkRequired("property_id", req.PropertyID == ""))
+	errs.add(checkID("property_id", req.PropertyID))
+	if req.SpaceID != nil {
+		errs.add(checkID("space_id", *req.SpaceID))
+	}
+	if req.PersonID != nil {
+		errs.add(checkID("person_id", *req.PersonID))
+	}
+	return errs
+}
+
+func (req *createLedgerEntryRequest) apply(builder *ent.LedgerEntryCreate) {
+	builder.SetEntryType(ledgerentry.EntryType(req.EntryType))
+	builder.SetAmountAmountCents(req.AmountAmountCents)
+	if req.AmountCurrency != "" {
+		builder.SetAmountCurrency(req.AmountCurrency)
+	}
+	builder.SetEffectiveDate(req.EffectiveDate)
+	builder.SetPostedDate(req.PostedDate)
+	builder.SetDescription(req.Description)
+	builder.SetChargeCode(req.ChargeCode)
+	if req.Memo != nil {
+		builder.SetNillableMemo(req.Memo)
+	}
+	if req.BankAccountID != nil {
+		builder.SetNillableBankAccountID(req.BankAccountID)
+	}
+	if req.BankTransactionID != nil {
+		builder.SetNillableBankTransactionID(req.BankTransactionID)
+	}
+	builder.SetReconciled(req.Reconciled)
+	if req.ReconciliationID != nil {
+		builder.SetNillableReconciliationID(req.ReconciliationID)
+	}
+	if req.ReconciledAt != nil {
+		builder.SetNillableReconciledAt(req.ReconciledAt)
+	}
+	if req.AdjustsEntryID != nil {
+		builder.SetNillableAdjustsEntryID(req.AdjustsEntryID)
+	}
+	if req.LeaseID != nil {
+		builder.SetLeaseID(uuid.MustParse(*req.LeaseID))
+	}
+	builder.SetJournalEntryID(uuid.MustParse(req.JournalEntryID))
+	builder.SetAccountID(uuid.MustParse(req.AccountID))
+	builder.SetPropertyID(uuid.MustParse(req.PropertyID))
+	if req.SpaceID != nil {
+		builder.SetSpaceID(uuid.MustParse(*req.SpaceID))
+	}
+	if req.PersonID != nil {
+		builder.SetPersonID(uuid.MustParse(*req.PersonID))
+	}
+}
+
+func (h *AccountingHandler) BulkCreateLedgerEntries(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), handlerTimeout)
+	defer cancel()
+	audit, ok := parseAuditContext(w, r)
+	if !ok {
+		return
+	}
+	var reqs []createLedgerEntryRequest
+	if err := decodeJSON(r, &reqs); err != nil {
+		writeError(w, http.StatusBadRequest, "INVALID_JSON", err.Error())
+		return
+	}
+	if !checkBulkSize(w, len(reqs)) {
+		return
+	}
+	errs := map[int]fieldErrors{}
+	for i := range reqs {
+		if itemErrs := validateLedgerEntryCreate(&reqs[i]); len(itemErrs) > 0 {
+			errs[i] = itemErrs
+		}
+	}
+	if len(errs) > 0 {
+		writeBulkErrors(w, errs)
+		return
+	}
+	tx, err := h.client.Tx(ctx)
+	if err != nil {
+		entErrorToHTTP(w, err)
+		return
+	}
+	created := make([]*ent.LedgerEntry, 0, len(reqs))
+	for i := range reqs {
+		builder := tx.LedgerEntry.Create()
+		reqs[i].apply(builder)
+		builder.SetCreatedBy(audit.Actor).SetUpdatedBy(audit.Actor).SetSource(ledgerentry.Source(audit.Source))
+		if audit.CorrelationID != nil {
+			builder.SetCorrelationID(*audit.CorrelationID)
+		}
+		result, err := builder.Save(ctx)
+		if err != nil {
+			tx.Rollback()
+			entErrorToHTTP(w, err)
+			return
+		}
+		if h.audit != nil {
+			changes, _ := auditChanges(ctx, builder.Mutation()) // create reads no old values
+			if err := appendAudit(ctx, tx, h.audit, AuditOpCreate, audit, builder.Mutation(), changes); err != nil {
+				tx.Rollback()
+				entErrorToHTTP(w, err)
+				return
+			}
+		}
+		created = append(created, result)
+	}
+	if err := tx.Commit(); err != nil {
+		writeCommitError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusCreated, created)
+}
+
+// ledgerEntryIncludes maps ?include= edge names to eager loaders on a LedgerEntry query.
+var ledgerEntryIncludes = map[string]func(*ent.LedgerEntryQuery){
+	"lease":         func(q *ent.LedgerEntryQuery) { q.WithLease() },
+	"journal_entry": func(q *ent.LedgerEntryQuery) { q.WithJournalEntry() },
+	"account":       func(q *ent.LedgerEntryQuery) { q.WithAccount() },
+	"property":      func(q *ent.LedgerEntryQuery) { q.WithProperty() },
+	"space":         func(q *ent.LedgerEntryQuery) { q.WithSpace() },
+	"person":        func(q *ent.LedgerEntryQuery) { q.WithPerson() },
+}
+
 func (h *AccountingHandler) GetLedgerEntry(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), handlerTimeout)
+	defer cancel()
 	id, ok := parseUUID(w, r, "id")
 	if !ok {
 		return
 	}
-	result, err := h.client.LedgerEntry.Get(r.Context(), id)
+	query := h.client.LedgerEntry.Query().Where(ledgerentry.ID(id))
+	withIncludes(r, query, ledgerEntryIncludes)
+	result, err := query.Only(ctx)
 	if err != nil {
 		entErrorToHTTP(w, err)
 		return
@@ -267,23 +733,117 @@ func (h *AccountingHandler) GetLedgerEntry(w http.ResponseWriter, r *http.Reques
 	writeJSON(w, http.StatusOK, result)
 }
 
+func (h *AccountingHandler) CheckLedgerEntryExists(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), handlerTimeout)
+	defer cancel()
+	id, ok := parseUUID(w, r, "id")
+	if !ok {
+		return
+	}
+	exists, err := h.client.LedgerEntry.Query().Where(ledgerentry.ID(id)).Exist(ctx)
+	if err != nil {
+		entErrorToHTTP(w, err)
+		return
+	}
+	if !exists {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// ledgerEntrySortColumns allowlists the ?sort= values accepted by ListLedgerEntries.
+var ledgerEntrySortColumns = map[string]string{
+	"created_at":          ledgerentry.FieldCreatedAt,
+	"updated_at":          ledgerentry.FieldUpdatedAt,
+	"entry_type":          ledgerentry.FieldEntryType,
+	"effective_date":      ledgerentry.FieldEffectiveDate,
+	"posted_date":         ledgerentry.FieldPostedDate,
+	"description":         ledgerentry.FieldDescription,
+	"charge_code":         ledgerentry.FieldChargeCode,
+	"memo":                ledgerentry.FieldMemo,
+	"bank_account_id":     ledgerentry.FieldBankAccountID,
+	"bank_transaction_id": ledgerentry.FieldBankTransactionID,
+	"reconciled":          ledgerentry.FieldReconciled,
+	"reconciliation_id":   ledgerentry.FieldReconciliationID,
+	"reconciled_at":       ledgerentry.FieldReconciledAt,
+	"adjusts_entry_id":    ledgerentry.FieldAdjustsEntryID,
+}
+
 func (h *AccountingHandler) ListLedgerEntries(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), handlerTimeout)
+	defer cancel()
 	pg := parsePagination(r)
-	items, err := h.client.LedgerEntry.Query().
+	query := h.client.LedgerEntry.Query()
+	if v := r.URL.Query().Get("lease_id"); v != "" {
+		uid, err := uuid.Parse(v)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "INVALID_FILTER", "invalid lease_id")
+			return
+		}
+		query.Where(ledgerentry.HasLeaseWith(lease.ID(uid)))
+	}
+	if v := r.URL.Query().Get("account_id"); v != "" {
+		uid, err := uuid.Parse(v)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "INVALID_FILTER", "invalid account_id")
+			return
+		}
+		query.Where(ledgerentry.HasAccountWith(account.ID(uid)))
+	}
+	if v := r.URL.Query().Get("property_id"); v != "" {
+		uid, err := uuid.Parse(v)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "INVALID_FILTER", "invalid property_id")
+			return
+		}
+		query.Where(ledgerentry.HasPropertyWith(property.ID(uid)))
+	}
+	if v := r.URL.Query().Get("person_id"); v != "" {
+		uid, err := uuid.Parse(v)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "INVALID_FILTER", "invalid person_id")
+			return
+		}
+		query.Where(ledgerentry.HasPersonWith(person.ID(uid)))
+	}
+	if r.Header.Get("If-Modified-Since") != "" {
+		lastModified, err := latestUpdatedAt(ctx, query.Clone().Order(ent.Desc(ledgerentry.FieldUpdatedAt)).Limit(1).Select(ledgerentry.FieldUpdatedAt))
+		if err != nil {
+			entErrorToHTTP(w, err)
+			return
+		}
+		if !checkIfModifiedSince(w, r, lastModified) {
+			return
+		}
+	}
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		entErrorToHTTP(w, err)
+		return
+	}
+	withIncludes(r, query, ledgerEntryIncludes)
+	items, err := query.
 		Limit(pg.Limit).Offset(pg.Offset).
-		Order(ent.Desc(ledgerentry.FieldCreatedAt)).
-		All(r.Context())
+		Order(listOrder(r, ledgerEntrySortColumns, ledgerentry.FieldCreatedAt)).
+		All(ctx)
 	if err != nil {
 		entErrorToHTTP(w, err)
 		return
 	}
-	writeJSON(w, http.StatusOK, items)
+	writeJSON(w, http.StatusOK, listResponse{Data: items, Total: total, Offset: pg.Offset, Limit: pg.Limit})
 }
 
 // ============================================================================
 // JournalEntry
 // ============================================================================
 
+// Accepted values of the JournalEntry enum fields.
+var (
+	journalEntrySourceTypeValues = []string{"manual", "auto_charge", "payment", "bank_import", "cam_reconciliation", "depreciation", "accrual", "intercompany", "management_fee", "system"}
+	journalEntryStatusValues     = []string{"draft", "pending_approval", "posted", "voided"}
+)
+
 type createJournalEntryRequest struct {
 	EntryDate           time.Time           `json:"entry_date"`
 	PostedDate          time.Time           `json:"posted_date"`
@@ -301,17 +861,23 @@ type createJournalEntryRequest struct {
 	Lines               []types.JournalLine `json:"lines"`
 }
 
-func (h *AccountingHandler) CreateJournalEntry(w http.ResponseWriter, r *http.Request) {
-	audit, ok := parseAuditContext(w, r)
-	if !ok {
-		return
-	}
-	var req createJournalEntryRequest
-	if err := decodeJSON(r, &req); err != nil {
-		writeError(w, http.StatusBadRequest, "INVALID_JSON", err.Error())
-		return
+func validateJournalEntryCreate(req *createJournalEntryRequest) fieldErrors {
+	errs := fieldErrors{}
+	errs.add(checkRequired("entry_date", req.EntryDate.IsZero()))
+	errs.add(checkRequired("posted_date", req.PostedDate.IsZero()))
+	errs.add(checkRequired("description", req.Description == ""))
+	errs.add(checkRequired("source_type", req.SourceType == ""))
+	errs.add(checkEnum("source_type", req.SourceType, journalEntrySourceTypeValues))
+	errs.add(checkRequired("status", req.Status == ""))
+	errs.add(checkEnum("status", req.Status, journalEntryStatusValues))
+	if req.ReversedByJournalID != nil {
+		errs.add(checkRequired("reversed_by_journal_id", *req.ReversedByJournalID == ""))
 	}
-	builder := h.client.JournalEntry.Create()
+	errs.add(checkRequired("lines", req.Lines == nil))
+	return errs
+}
+
+func (req *createJournalEntryRequest) apply(builder *ent.JournalEntryCreate) {
 	builder.SetEntryDate(req.EntryDate)
 	builder.SetPostedDate(req.PostedDate)
 	builder.SetDescription(req.Description)
@@ -342,11 +908,31 @@ func (h *AccountingHandler) CreateJournalEntry(w http.ResponseWriter, r *http.Re
 		builder.SetNillableReversedByJournalID(req.ReversedByJournalID)
 	}
 	builder.SetLines(req.Lines)
+}
+
+func (h *AccountingHandler) CreateJournalEntry(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), handlerTimeout)
+	defer cancel()
+	audit, ok := parseAuditContext(w, r)
+	if !ok {
+		return
+	}
+	var req createJournalEntryRequest
+	if err := decodeJSON(r, &req); err != nil {
+		writeError(w, http.StatusBadRequest, "INVALID_JSON", err.Error())
+		return
+	}
+	if errs := validateJournalEntryCreate(&req); len(errs) > 0 {
+		writeFieldErrors(w, errs)
+		return
+	}
+	builder := h.client.JournalEntry.Create()
+	req.apply(builder)
 	builder.SetCreatedBy(audit.Actor).SetUpdatedBy(audit.Actor).SetSource(journalentry.Source(audit.Source))
 	if audit.CorrelationID != nil {
 		builder.SetCorrelationID(*audit.CorrelationID)
 	}
-	result, err := builder.Save(r.Context())
+	result, err := saveAudited[*ent.JournalEntry](ctx, h.client, h.audit, AuditOpCreate, audit, builder.Mutation())
 	if err != nil {
 		entErrorToHTTP(w, err)
 		return
@@ -354,36 +940,135 @@ func (h *AccountingHandler) CreateJournalEntry(w http.ResponseWriter, r *http.Re
 	writeJSON(w, http.StatusCreated, result)
 }
 
+// journalEntryIncludes maps ?include= edge names to eager loaders on a JournalEntry query.
+var journalEntryIncludes = map[string]func(*ent.JournalEntryQuery){
+	"ledger_entries": func(q *ent.JournalEntryQuery) { q.WithLedgerEntries() },
+}
+
 func (h *AccountingHandler) GetJournalEntry(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), handlerTimeout)
+	defer cancel()
 	id, ok := parseUUID(w, r, "id")
 	if !ok {
 		return
 	}
-	result, err := h.client.JournalEntry.Get(r.Context(), id)
+	query := h.client.JournalEntry.Query().Where(journalentry.ID(id))
+	withIncludes(r, query, journalEntryIncludes)
+	result, err := query.Only(ctx)
 	if err != nil {
 		entErrorToHTTP(w, err)
 		return
 	}
+	setETag(w, entityETag(result.UpdatedAt))
 	writeJSON(w, http.StatusOK, result)
 }
 
+func (h *AccountingHandler) ListLedgerEntriesOfJournalEntry(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), handlerTimeout)
+	defer cancel()
+	id, ok := parseUUID(w, r, "id")
+	if !ok {
+		return
+	}
+	parent, err := h.client.JournalEntry.Get(ctx, id)
+	if err != nil {
+		entErrorToHTTP(w, err)
+		return
+	}
+	pg := parsePagination(r)
+	query := h.client.JournalEntry.QueryLedgerEntries(parent)
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		entErrorToHTTP(w, err)
+		return
+	}
+	items, err := query.
+		Limit(pg.Limit).Offset(pg.Offset).
+		Order(listOrder(r, nil, ledgerentry.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		entErrorToHTTP(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, listResponse{Data: items, Total: total, Offset: pg.Offset, Limit: pg.Limit})
+}
+
+func (h *AccountingHandler) CheckJournalEntryExists(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), handlerTimeout)
+	defer cancel()
+	id, ok := parseUUID(w, r, "id")
+	if !ok {
+		return
+	}
+	exists, err := h.client.JournalEntry.Query().Where(journalentry.ID(id)).Exist(ctx)
+	if err != nil {
+		entErrorToHTTP(w, err)
+		return
+	}
+	if !exists {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// journalEntrySortColumns allowlists the ?sort= values accepted by ListJournalEntries.
+var journalEntrySortColumns = map[string]string{
+	"created_at":             journalentry.FieldCreatedAt,
+	"updated_at":             journalentry.FieldUpdatedAt,
+	"entry_date":             journalentry.FieldEntryDate,
+	"posted_date":            journalentry.FieldPostedDate,
+	"description":            journalentry.FieldDescription,
+	"source_type":            journalentry.FieldSourceType,
+	"source_id":              journalentry.FieldSourceID,
+	"status":                 journalentry.FieldStatus,
+	"approved_by":            journalentry.FieldApprovedBy,
+	"approved_at":            journalentry.FieldApprovedAt,
+	"batch_id":               journalentry.FieldBatchID,
+	"entity_id":              journalentry.FieldEntityID,
+	"property_id":            journalentry.FieldPropertyID,
+	"reverses_journal_id":    journalentry.FieldReversesJournalID,
+	"reversed_by_journal_id": journalentry.FieldReversedByJournalID,
+}
+
 func (h *AccountingHandler) ListJournalEntries(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), handlerTimeout)
+	defer cancel()
 	pg := parsePagination(r)
-	items, err := h.client.JournalEntry.Query().
+	query := h.client.JournalEntry.Query()
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		entErrorToHTTP(w, err)
+		return
+	}
+	withIncludes(r, query, journalEntryIncludes)
+	items, err := query.
 		Limit(pg.Limit).Offset(pg.Offset).
-		Order(ent.Desc(journalentry.FieldCreatedAt)).
-		All(r.Context())
+		Order(listOrder(r, journalEntrySortColumns, journalentry.FieldCreatedAt)).
+		All(ctx)
 	if err != nil {
 		entErrorToHTTP(w, err)
 		return
 	}
-	writeJSON(w, http.StatusOK, items)
+	writeJSON(w, http.StatusOK, listResponse{Data: items, Total: total, Offset: pg.Offset, Limit: pg.Limit})
 }
 
 // ============================================================================
 // BankAccount
 // ============================================================================
 
+// Accepted values of the BankAccount enum fields.
+var (
+	bankAccountAccountTypeValues = []string{"operating", "trust", "security_deposit", "escrow", "reserve"}
+	bankAccountStatusValues      = []string{"active", "inactive", "frozen", "closed"}
+)
+
+// Patterns the BankAccount string fields must match.
+var (
+	bankAccountRoutingNumberPattern = regexp.MustCompile(`^[0-9]{9}$`)
+	bankAccountAccountMaskPattern   = regexp.MustCompile(`^\*{4}[0-9]{4}$`)
+)
+
 type createBankAccountRequest struct {
 	Name                   string     `json:"name"`
 	AccountType            string     `json:"account_type"`
@@ -404,17 +1089,27 @@ type createBankAccountRequest struct {
 	GlAccountID            string     `json:"gl_account_id"`
 }
 
-func (h *AccountingHandler) CreateBankAccount(w http.ResponseWriter, r *http.Request) {
-	audit, ok := parseAuditContext(w, r)
-	if !ok {
-		return
-	}
-	var req createBankAccountRequest
-	if err := decodeJSON(r, &req); err != nil {
-		writeError(w, http.StatusBadRequest, "INVALID_JSON", err.Error())
-		return
+func validateBankAccountCreate(req *createBankAccountRequest) fieldErrors {
+	errs := fieldErrors{}
+	errs.add(checkRequired("name", req.Name == ""))
+	errs.add(checkRequired("account_type", req.AccountType == ""))
+	errs.add(checkEnum("account_type", req.AccountType, bankAccountAccountTypeValues))
+	errs.add(checkRequired("institution_name", req.InstitutionName == ""))
+	errs.add(checkRequired("routing_number", req.RoutingNumber == ""))
+	errs.add(checkPattern("routing_number", req.RoutingNumber, bankAccountRoutingNumberPattern))
+	errs.add(checkRequired("account_mask", req.AccountMask == ""))
+	errs.add(checkPattern("account_mask", req.AccountMask, bankAccountAccountMaskPattern))
+	errs.add(checkRequired("status", req.Status == ""))
+	errs.add(checkEnum("status", req.Status, bankAccountStatusValues))
+	if req.PortfolioID != nil {
+		errs.add(checkID("portfolio_id", *req.PortfolioID))
 	}
-	builder := h.client.BankAccount.Create()
+	errs.add(checkRequired("gl_account_id", req.GlAccountID == ""))
+	errs.add(checkID("gl_account_id", req.GlAccountID))
+	return errs
+}
+
+func (req *createBankAccountRequest) apply(builder *ent.BankAccountCreate) {
 	builder.SetName(req.Name)
 	builder.SetAccountType(bankaccount.AccountType(req.AccountType))
 	builder.SetInstitutionName(req.InstitutionName)
@@ -443,26 +1138,34 @@ func (h *AccountingHandler) CreateBankAccount(w http.ResponseWriter, r *http.Req
 		builder.SetNillableLastStatementDate(req.LastStatementDate)
 	}
 	if req.PortfolioID != nil {
-		uid, err := uuid.Parse(*req.PortfolioID)
-		if err != nil {
-			writeError(w, http.StatusBadRequest, "INVALID_ID", "invalid portfolio_id")
-			return
-		}
-		builder.SetTrustPortfolioID(uid)
+		builder.SetTrustPortfolioID(uuid.MustParse(*req.PortfolioID))
 	}
-	{
-		uid, err := uuid.Parse(req.GlAccountID)
-		if err != nil {
-			writeError(w, http.StatusBadRequest, "INVALID_ID", "invalid gl_account_id")
-			return
-		}
-		builder.SetGlAccountID(uid)
+	builder.SetGlAccountID(uuid.MustParse(req.GlAccountID))
+}
+
+func (h *AccountingHandler) CreateBankAccount(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), handlerTimeout)
+	defer cancel()
+	audit, ok := parseAuditContext(w, r)
+	if !ok {
+		return
+	}
+	var req createBankAccountRequest
+	if err := decodeJSON(r, &req); err != nil {
+		writeError(w, http.StatusBadRequest, "INVALID_JSON", err.Error())
+		return
+	}
+	if errs := validateBankAccountCreate(&req); len(errs) > 0 {
+		writeFieldErrors(w, errs)
+		return
 	}
+	builder := h.client.BankAccount.Create()
+	req.apply(builder)
 	builder.SetCreatedBy(audit.Actor).SetUpdatedBy(audit.Actor).SetSource(bankaccount.Source(audit.Source))
 	if audit.CorrelationID != nil {
 		builder.SetCorrelationID(*audit.CorrelationID)
 	}
-	result, err := builder.Save(r.Context())
+	result, err := saveAudited[*ent.BankAccount](ctx, h.client, h.audit, AuditOpCreate, audit, builder.Mutation())
 	if err != nil {
 		entErrorToHTTP(w, err)
 		return
@@ -470,30 +1173,155 @@ func (h *AccountingHandler) CreateBankAccount(w http.ResponseWriter, r *http.Req
 	writeJSON(w, http.StatusCreated, result)
 }
 
+// bankAccountIncludes maps ?include= edge names to eager loaders on a BankAccount query.
+var bankAccountIncludes = map[string]func(*ent.BankAccountQuery){
+	"trust_portfolio": func(q *ent.BankAccountQuery) { q.WithTrustPortfolio() },
+	"properties":      func(q *ent.BankAccountQuery) { q.WithProperties() },
+	"gl_account":      func(q *ent.BankAccountQuery) { q.WithGlAccount() },
+	"reconciliations": func(q *ent.BankAccountQuery) { q.WithReconciliations() },
+}
+
 func (h *AccountingHandler) GetBankAccount(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), handlerTimeout)
+	defer cancel()
 	id, ok := parseUUID(w, r, "id")
 	if !ok {
 		return
 	}
-	result, err := h.client.BankAccount.Get(r.Context(), id)
+	query := h.client.BankAccount.Query().Where(bankaccount.ID(id))
+	withIncludes(r, query, bankAccountIncludes)
+	result, err := query.Only(ctx)
 	if err != nil {
 		entErrorToHTTP(w, err)
 		return
 	}
+	setETag(w, entityETag(result.UpdatedAt))
 	writeJSON(w, http.StatusOK, result)
 }
 
+func (h *AccountingHandler) ListPropertiesOfBankAccount(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), handlerTimeout)
+	defer cancel()
+	id, ok := parseUUID(w, r, "id")
+	if !ok {
+		return
+	}
+	parent, err := h.client.BankAccount.Get(ctx, id)
+	if err != nil {
+		entErrorToHTTP(w, err)
+		return
+	}
+	pg := parsePagination(r)
+	query := h.client.BankAccount.QueryProperties(parent)
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		entErrorToHTTP(w, err)
+		return
+	}
+	items, err := query.
+		Limit(pg.Limit).Offset(pg.Offset).
+		Order(listOrder(r, nil, property.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		entErrorToHTTP(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, listResponse{Data: items, Total: total, Offset: pg.Offset, Limit: pg.Limit})
+}
+
+func (h *AccountingHandler) ListReconciliationsOfBankAccount(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), handlerTimeout)
+	defer cancel()
+	id, ok := parseUUID(w, r, "id")
+	if !ok {
+		return
+	}
+	parent, err := h.client.BankAccount.Get(ctx, id)
+	if err != nil {
+		entErrorToHTTP(w, err)
+		return
+	}
+	pg := parsePagination(r)
+	query := h.client.BankAccount.QueryReconciliations(parent)
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		entErrorToHTTP(w, err)
+		return
+	}
+	items, err := query.
+		Limit(pg.Limit).Offset(pg.Offset).
+		Order(listOrder(r, nil, reconciliation.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		entErrorToHTTP(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, listResponse{Data: items, Total: total, Offset: pg.Offset, Limit: pg.Limit})
+}
+
+func (h *AccountingHandler) CheckBankAccountExists(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), handlerTimeout)
+	defer cancel()
+	id, ok := parseUUID(w, r, "id")
+	if !ok {
+		return
+	}
+	exists, err := h.client.BankAccount.Query().Where(bankaccount.ID(id)).Exist(ctx)
+	if err != nil {
+		entErrorToHTTP(w, err)
+		return
+	}
+	if !exists {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// bankAccountSortColumns allowlists the ?sort= values accepted by ListBankAccounts.
+var bankAccountSortColumns = map[string]string{
+	"created_at":               bankaccount.FieldCreatedAt,
+	"updated_at":               bankaccount.FieldUpdatedAt,
+	"name":                     bankaccount.FieldName,
+	"account_type":             bankaccount.FieldAccountType,
+	"institution_name":         bankaccount.FieldInstitutionName,
+	"routing_number":           bankaccount.FieldRoutingNumber,
+	"account_mask":             bankaccount.FieldAccountMask,
+	"account_number_encrypted": bankaccount.FieldAccountNumberEncrypted,
+	"plaid_account_id":         bankaccount.FieldPlaidAccountID,
+	"plaid_access_token":       bankaccount.FieldPlaidAccessToken,
+	"property_id":              bankaccount.FieldPropertyID,
+	"entity_id":                bankaccount.FieldEntityID,
+	"status":                   bankaccount.FieldStatus,
+	"is_default":               bankaccount.FieldIsDefault,
+	"accepts_deposits":         bankaccount.FieldAcceptsDeposits,
+	"accepts_payments":         bankaccount.FieldAcceptsPayments,
+	"last_statement_date":      bankaccount.FieldLastStatementDate,
+}
+
 func (h *AccountingHandler) ListBankAccounts(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), handlerTimeout)
+	defer cancel()
 	pg := parsePagination(r)
-	items, err := h.client.BankAccount.Query().
+	query := h.client.BankAccount.Query()
+	if q := r.URL.Query().Get("q"); q != "" {
+		query.Where(bankaccount.NameContainsFold(q))
+	}
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		entErrorToHTTP(w, err)
+		return
+	}
+	withIncludes(r, query, bankAccountIncludes)
+	items, err := query.
 		Limit(pg.Limit).Offset(pg.Offset).
-		Order(ent.Desc(bankaccount.FieldCreatedAt)).
-		All(r.Context())
+		Order(listOrder(r, bankAccountSortColumns, bankaccount.FieldCreatedAt)).
+		All(ctx)
 	if err != nil {
 		entErrorToHTTP(w, err)
 		return
 	}
-	writeJSON(w, http.StatusOK, items)
+	writeJSON(w, http.StatusOK, listResponse{Data: items, Total: total, Offset: pg.Offset, Limit: pg.Limit})
 }
 
 type updateBankAccountRequest struct {
@@ -516,7 +1344,38 @@ type updateBankAccountRequest struct {
 	GlAccountID            *string    `json:"gl_account_id,omitempty"`
 }
 
+func validateBankAccountUpdate(req *updateBankAccountRequest) fieldErrors {
+	errs := fieldErrors{}
+	if req.Name != nil {
+		errs.add(checkRequired("name", *req.Name == ""))
+	}
+	if req.AccountType != nil {
+		errs.add(checkEnum("account_type", *req.AccountType, bankAccountAccountTypeValues))
+	}
+	if req.InstitutionName != nil {
+		errs.add(checkRequired("institution_name", *req.InstitutionName == ""))
+	}
+	if req.RoutingNumber != nil {
+		errs.add(checkPattern("routing_number", *req.RoutingNumber, bankAccountRoutingNumberPattern))
+	}
+	if req.AccountMask != nil {
+		errs.add(checkPattern("account_mask", *req.AccountMask, bankAccountAccountMaskPattern))
+	}
+	if req.Status != nil {
+		errs.add(checkEnum("status", *req.Status, bankAccountStatusValues))
+	}
+	if req.PortfolioID != nil {
+		errs.add(checkID("portfolio_id", *req.PortfolioID))
+	}
+	if req.GlAccountID != nil {
+		errs.add(checkID("gl_account_id", *req.GlAccountID))
+	}
+	return errs
+}
+
 func (h *AccountingHandler) UpdateBankAccount(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), handlerTimeout)
+	defer cancel()
 	id, ok := parseUUID(w, r, "id")
 	if !ok {
 		return
@@ -526,11 +1385,31 @@ func (h *AccountingHandler) UpdateBankAccount(w http.ResponseWriter, r *http.Req
 		return
 	}
 	var req updateBankAccountRequest
-	if err := decodeJSON(r, &req); err != nil {
+	patch, err := decodeUpdate(r, &req)
+	if err != nil {
 		writeError(w, http.StatusBadRequest, "INVALID_JSON", err.Error())
 		return
 	}
+	if field := patch.firstNull("name", "account_type", "institution_name", "routing_number", "account_mask", "status", "is_default", "accepts_deposits", "accepts_payments", "gl_account_id"); field != "" {
+		writeError(w, http.StatusBadRequest, "INVALID_PATCH", field+" cannot be null")
+		return
+	}
+	if errs := validateBankAccountUpdate(&req); len(errs) > 0 {
+		writeFieldErrors(w, errs)
+		return
+	}
 	builder := h.client.BankAccount.UpdateOneID(id)
+	if hasIfMatch(r) {
+		current, err := h.client.BankAccount.Get(ctx, id)
+		if err != nil {
+			entErrorToHTTP(w, err)
+			return
+		}
+		if !checkIfMatch(w, r, entityETag(current.UpdatedAt)) {
+			return
+		}
+		builder.Where(bankaccount.UpdatedAtEQ(current.UpdatedAt))
+	}
 	if req.Name != nil {
 		builder.SetName(*req.Name)
 	}
@@ -577,30 +1456,42 @@ func (h *AccountingHandler) UpdateBankAccount(w http.ResponseWriter, r *http.Req
 		builder.SetNillableLastStatementDate(req.LastStatementDate)
 	}
 	if req.PortfolioID != nil {
-		uid, err := uuid.Parse(*req.PortfolioID)
-		if err != nil {
-			writeError(w, http.StatusBadRequest, "INVALID_ID", "invalid portfolio_id")
-			return
-		}
-		builder.SetTrustPortfolioID(uid)
+		builder.SetTrustPortfolioID(uuid.MustParse(*req.PortfolioID))
 	}
 	if req.GlAccountID != nil {
-		uid, err := uuid.Parse(*req.GlAccountID)
-		if err != nil {
-			writeError(w, http.StatusBadRequest, "INVALID_ID", "invalid gl_account_id")
-			return
-		}
-		builder.SetGlAccountID(uid)
+		builder.SetGlAccountID(uuid.MustParse(*req.GlAccountID))
+	}
+	if patch.isNull("account_number_encrypted") {
+		builder.ClearAccountNumberEncrypted()
+	}
+	if patch.isNull("plaid_account_id") {
+		builder.ClearPlaidAccountID()
+	}
+	if patch.isNull("plaid_access_token") {
+		builder.ClearPlaidAccessToken()
+	}
+	if patch.isNull("property_id") {
+		builder.ClearPropertyID()
+	}
+	if patch.isNull("entity_id") {
+		builder.ClearEntityID()
+	}
+	if patch.isNull("last_statement_date") {
+		builder.ClearLastStatementDate()
+	}
+	if patch.isNull("portfolio_id") {
+		builder.ClearTrustPortfolio()
 	}
 	builder.SetUpdatedBy(audit.Actor).SetSource(bankaccount.Source(audit.Source))
 	if audit.CorrelationID != nil {
 		builder.SetCorrelationID(*audit.CorrelationID)
 	}
-	result, err := builder.Save(r.Context())
+	result, err := saveAudited[*ent.BankAccount](ctx, h.client, h.audit, AuditOpUpdate, audit, builder.Mutation())
 	if err != nil {
-		entErrorToHTTP(w, err)
+		writeGuardedSaveError(w, r, err)
 		return
 	}
+	setETag(w, entityETag(result.UpdatedAt))
 	writeJSON(w, http.StatusOK, result)
 }
 
@@ -608,6 +1499,11 @@ func (h *AccountingHandler) UpdateBankAccount(w http.ResponseWriter, r *http.Req
 // Reconciliation
 // ============================================================================
 
+// Accepted values of the Reconciliation enum fields.
+var (
+	reconciliationStatusValues = []string{"in_progress", "balanced", "unbalanced", "approved"}
+)
+
 type createReconciliationRequest struct {
 	PeriodStart                 time.Time  `json:"period_start"`
 	PeriodEnd                   time.Time  `json:"period_end"`
@@ -624,17 +1520,25 @@ type createReconciliationRequest struct {
 	BankAccountID               string     `json:"bank_account_id"`
 }
 
-func (h *AccountingHandler) CreateReconciliation(w http.ResponseWriter, r *http.Request) {
-	audit, ok := parseAuditContext(w, r)
-	if !ok {
-		return
+func validateReconciliationCreate(req *createReconciliationRequest) fieldErrors {
+	errs := fieldErrors{}
+	errs.add(checkRequired("period_start", req.PeriodStart.IsZero()))
+	errs.add(checkRequired("period_end", req.PeriodEnd.IsZero()))
+	errs.add(checkRequired("statement_date", req.StatementDate.IsZero()))
+	if req.StatementBalanceCurrency != "" {
+		errs.add(checkCurrency("statement_balance_currency", req.StatementBalanceCurrency))
 	}
-	var req createReconciliationRequest
-	if err := decodeJSON(r, &req); err != nil {
-		writeError(w, http.StatusBadRequest, "INVALID_JSON", err.Error())
-		return
+	if req.GlBalanceCurrency != "" {
+		errs.add(checkCurrency("gl_balance_currency", req.GlBalanceCurrency))
 	}
-	builder := h.client.Reconciliation.Create()
+	errs.add(checkRequired("status", req.Status == ""))
+	errs.add(checkEnum("status", req.Status, reconciliationStatusValues))
+	errs.add(checkRequired("bank_account_id", req.BankAccountID == ""))
+	errs.add(checkID("bank_account_id", req.BankAccountID))
+	return errs
+}
+
+func (req *createReconciliationRequest) apply(builder *ent.ReconciliationCreate) {
 	builder.SetPeriodStart(req.PeriodStart)
 	builder.SetPeriodEnd(req.PeriodEnd)
 	builder.SetStatementDate(req.StatementDate)
@@ -659,19 +1563,32 @@ func (h *AccountingHandler) CreateReconciliation(w http.ResponseWriter, r *http.
 	if req.ApprovedAt != nil {
 		builder.SetNillableApprovedAt(req.ApprovedAt)
 	}
-	{
-		uid, err := uuid.Parse(req.BankAccountID)
-		if err != nil {
-			writeError(w, http.StatusBadRequest, "INVALID_ID", "invalid bank_account_id")
-			return
-		}
-		builder.SetBankAccountID(uid)
+	builder.SetBankAccountID(uuid.MustParse(req.BankAccountID))
+}
+
+func (h *AccountingHandler) CreateReconciliation(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), handlerTimeout)
+	defer cancel()
+	audit, ok := parseAuditContext(w, r)
+	if !ok {
+		return
+	}
+	var req createReconciliationRequest
+	if err := decodeJSON(r, &req); err != nil {
+		writeError(w, http.StatusBadRequest, "INVALID_JSON", err.Error())
+		return
+	}
+	if errs := validateReconciliationCreate(&req); len(errs) > 0 {
+		writeFieldErrors(w, errs)
+		return
 	}
+	builder := h.client.Reconciliation.Create()
+	req.apply(builder)
 	builder.SetCreatedBy(audit.Actor).SetUpdatedBy(audit.Actor).SetSource(reconciliation.Source(audit.Source))
 	if audit.CorrelationID != nil {
 		builder.SetCorrelationID(*audit.CorrelationID)
 	}
-	result, err := builder.Save(r.Context())
+	result, err := saveAudited[*ent.Reconciliation](ctx, h.client, h.audit, AuditOpCreate, audit, builder.Mutation())
 	if err != nil {
 		entErrorToHTTP(w, err)
 		return
@@ -679,28 +1596,81 @@ func (h *AccountingHandler) CreateReconciliation(w http.ResponseWriter, r *http.
 	writeJSON(w, http.StatusCreated, result)
 }
 
+// reconciliationIncludes maps ?include= edge names to eager loaders on a Reconciliation query.
+var reconciliationIncludes = map[string]func(*ent.ReconciliationQuery){
+	"bank_account": func(q *ent.ReconciliationQuery) { q.WithBankAccount() },
+}
+
 func (h *AccountingHandler) GetReconciliation(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), handlerTimeout)
+	defer cancel()
 	id, ok := parseUUID(w, r, "id")
 	if !ok {
 		return
 	}
-	result, err := h.client.Reconciliation.Get(r.Context(), id)
+	query := h.client.Reconciliation.Query().Where(reconciliation.ID(id))
+	withIncludes(r, query, reconciliationIncludes)
+	result, err := query.Only(ctx)
 	if err != nil {
 		entErrorToHTTP(w, err)
 		return
 	}
+	setETag(w, entityETag(result.UpdatedAt))
 	writeJSON(w, http.StatusOK, result)
 }
 
+func (h *AccountingHandler) CheckReconciliationExists(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), handlerTimeout)
+	defer cancel()
+	id, ok := parseUUID(w, r, "id")
+	if !ok {
+		return
+	}
+	exists, err := h.client.Reconciliation.Query().Where(reconciliation.ID(id)).Exist(ctx)
+	if err != nil {
+		entErrorToHTTP(w, err)
+		return
+	}
+	if !exists {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// reconciliationSortColumns allowlists the ?sort= values accepted by ListReconciliations.
+var reconciliationSortColumns = map[string]string{
+	"created_at":         reconciliation.FieldCreatedAt,
+	"updated_at":         reconciliation.FieldUpdatedAt,
+	"period_start":       reconciliation.FieldPeriodStart,
+	"period_end":         reconciliation.FieldPeriodEnd,
+	"statement_date":     reconciliation.FieldStatementDate,
+	"status":             reconciliation.FieldStatus,
+	"unreconciled_items": reconciliation.FieldUnreconciledItems,
+	"reconciled_by":      reconciliation.FieldReconciledBy,
+	"reconciled_at":      reconciliation.FieldReconciledAt,
+	"approved_by":        reconciliation.FieldApprovedBy,
+	"approved_at":        reconciliation.FieldApprovedAt,
+}
+
 func (h *AccountingHandler) ListReconciliations(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), handlerTimeout)
+	defer cancel()
 	pg := parsePagination(r)
-	items, err := h.client.Reconciliation.Query().
+	query := h.client.Reconciliation.Query()
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		entErrorToHTTP(w, err)
+		return
+	}
+	withIncludes(r, query, reconciliationIncludes)
+	items, err := query.
 		Limit(pg.Limit).Offset(pg.Offset).
-		Order(ent.Desc(reconciliation.FieldCreatedAt)).
-		All(r.Context())
+		Order(listOrder(r, reconciliationSortColumns, reconciliation.FieldCreatedAt)).
+		All(ctx)
 	if err != nil {
 		entErrorToHTTP(w, err)
 		return
 	}
-	writeJSON(w, http.StatusOK, items)
+	writeJSON(w, http.StatusOK, listResponse{Data: items, Total: total, Offset: pg.Offset, Limit: pg.Limit})
 }
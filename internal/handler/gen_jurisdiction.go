@@ -2,7 +2,9 @@
 package handler
 
 import (
+	"context"
 	"net/http"
+	"regexp"
 	"time"
 
 	"github.com/google/uuid"
@@ -23,23 +25,38 @@ var (
 // JurisdictionHandler implements HTTP handlers for JurisdictionService entities.
 type JurisdictionHandler struct {
 	client *ent.Client
+	audit  AuditRecorder
 }
 
-// NewJurisdictionHandler creates a new JurisdictionHandler.
-func NewJurisdictionHandler(client *ent.Client) *JurisdictionHandler {
-	return &JurisdictionHandler{client: client}
+// NewJurisdictionHandler creates a new JurisdictionHandler. Mutations append to audit when it is
+// non-nil; a nil recorder keeps no audit trail.
+func NewJurisdictionHandler(client *ent.Client, audit AuditRecorder) *JurisdictionHandler {
+	return &JurisdictionHandler{client: client, audit: audit}
 }
 
 // ============================================================================
 // Jurisdiction
 // ============================================================================
 
+// Accepted values of the Jurisdiction enum fields.
+var (
+	jurisdictionJurisdictionTypeValues = []string{"federal", "state", "county", "city", "special_district", "unincorporated_area"}
+	jurisdictionStatusValues           = []string{"active", "dissolved", "merged", "pending"}
+)
+
+// Patterns the Jurisdiction string fields must match.
+var (
+	jurisdictionFipsCodePattern    = regexp.MustCompile(`^[0-9]{5,10}$`)
+	jurisdictionStateCodePattern   = regexp.MustCompile(`^[A-Z]{2}$`)
+	jurisdictionCountryCodePattern = regexp.MustCompile(`^[A-Z]{2}$`)
+)
+
 type createJurisdictionRequest struct {
 	Name                    string     `json:"name"`
 	JurisdictionType        string     `json:"jurisdiction_type"`
 	FipsCode                *string    `json:"fips_code,omitempty"`
 	StateCode               *string    `json:"state_code,omitempty"`
-	CountryCode             string     `json:"country_code"`
+	CountryCode             *string    `json:"country_code,omitempty"`
 	Status                  string     `json:"status"`
 	SuccessorJurisdictionID *string    `json:"successor_jurisdiction_id,omitempty"`
 	EffectiveDate           *time.Time `json:"effective_date,omitempty"`
@@ -49,17 +66,32 @@ type createJurisdictionRequest struct {
 	ParentJurisdictionID    *string    `json:"parent_jurisdiction_id,omitempty"`
 }
 
-func (h *JurisdictionHandler) CreateJurisdiction(w http.ResponseWriter, r *http.Request) {
-	audit, ok := parseAuditContext(w, r)
-	if !ok {
-		return
+func validateJurisdictionCreate(req *createJurisdictionRequest) fieldErrors {
+	errs := fieldErrors{}
+	errs.add(checkRequired("name", req.Name == ""))
+	errs.add(checkRequired("jurisdiction_type", req.JurisdictionType == ""))
+	errs.add(checkEnum("jurisdiction_type", req.JurisdictionType, jurisdictionJurisdictionTypeValues))
+	if req.FipsCode != nil {
+		errs.add(checkPattern("fips_code", *req.FipsCode, jurisdictionFipsCodePattern))
 	}
-	var req createJurisdictionRequest
-	if err := decodeJSON(r, &req); err != nil {
-		writeError(w, http.StatusBadRequest, "INVALID_JSON", err.Error())
-		return
+	if req.StateCode != nil {
+		errs.add(checkPattern("state_code", *req.StateCode, jurisdictionStateCodePattern))
 	}
-	builder := h.client.Jurisdiction.Create()
+	if req.CountryCode != nil {
+		errs.add(checkPattern("country_code", *req.CountryCode, jurisdictionCountryCodePattern))
+	}
+	errs.add(checkRequired("status", req.Status == ""))
+	errs.add(checkEnum("status", req.Status, jurisdictionStatusValues))
+	if req.SuccessorJurisdictionID != nil {
+		errs.add(checkRequired("successor_jurisdiction_id", *req.SuccessorJurisdictionID == ""))
+	}
+	if req.ParentJurisdictionID != nil {
+		errs.add(checkID("parent_jurisdiction_id", *req.ParentJurisdictionID))
+	}
+	return errs
+}
+
+func (req *createJurisdictionRequest) apply(builder *ent.JurisdictionCreate) {
 	builder.SetName(req.Name)
 	builder.SetJurisdictionType(jurisdiction.JurisdictionType(req.JurisdictionType))
 	if req.FipsCode != nil {
@@ -68,7 +100,9 @@ func (h *JurisdictionHandler) CreateJurisdiction(w http.ResponseWriter, r *http.
 	if req.StateCode != nil {
 		builder.SetNillableStateCode(req.StateCode)
 	}
-	builder.SetCountryCode(req.CountryCode)
+	if req.CountryCode != nil {
+		builder.SetNillableCountryCode(req.CountryCode)
+	}
 	builder.SetStatus(jurisdiction.Status(req.Status))
 	if req.SuccessorJurisdictionID != nil {
 		builder.SetNillableSuccessorJurisdictionID(req.SuccessorJurisdictionID)
@@ -86,18 +120,33 @@ func (h *JurisdictionHandler) CreateJurisdiction(w http.ResponseWriter, r *http.
 		builder.SetNillableRegulatoryURL(req.RegulatoryURL)
 	}
 	if req.ParentJurisdictionID != nil {
-		uid, err := uuid.Parse(*req.ParentJurisdictionID)
-		if err != nil {
-			writeError(w, http.StatusBadRequest, "INVALID_ID", "invalid parent_jurisdiction_id")
-			return
-		}
-		builder.SetParentJurisdictionID(uid)
+		builder.SetParentJurisdictionID(uuid.MustParse(*req.ParentJurisdictionID))
+	}
+}
+
+func (h *JurisdictionHandler) CreateJurisdiction(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), handlerTimeout)
+	defer cancel()
+	audit, ok := parseAuditContext(w, r)
+	if !ok {
+		return
+	}
+	var req createJurisdictionRequest
+	if err := decodeJSON(r, &req); err != nil {
+		writeError(w, http.StatusBadRequest, "INVALID_JSON", err.Error())
+		return
 	}
+	if errs := validateJurisdictionCreate(&req); len(errs) > 0 {
+		writeFieldErrors(w, errs)
+		return
+	}
+	builder := h.client.Jurisdiction.Create()
+	req.apply(builder)
 	builder.SetCreatedBy(audit.Actor).SetUpdatedBy(audit.Actor).SetSource(jurisdiction.Source(audit.Source))
 	if audit.CorrelationID != nil {
 		builder.SetCorrelationID(*audit.CorrelationID)
 	}
-	result, err := builder.Save(r.Context())
+	result, err := saveAudited[*ent.Jurisdiction](ctx, h.client, h.audit, AuditOpCreate, audit, builder.Mutation())
 	if err != nil {
 		entErrorToHTTP(w, err)
 		return
@@ -105,30 +154,181 @@ func (h *JurisdictionHandler) CreateJurisdiction(w http.ResponseWriter, r *http.
 	writeJSON(w, http.StatusCreated, result)
 }
 
+// jurisdictionIncludes maps ?include= edge names to eager loaders on a Jurisdiction query.
+var jurisdictionIncludes = map[string]func(*ent.JurisdictionQuery){
+	"children":               func(q *ent.JurisdictionQuery) { q.WithChildren() },
+	"parent_jurisdiction":    func(q *ent.JurisdictionQuery) { q.WithParentJurisdiction() },
+	"rules":                  func(q *ent.JurisdictionQuery) { q.WithRules() },
+	"property_jurisdictions": func(q *ent.JurisdictionQuery) { q.WithPropertyJurisdictions() },
+}
+
 func (h *JurisdictionHandler) GetJurisdiction(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), handlerTimeout)
+	defer cancel()
 	id, ok := parseUUID(w, r, "id")
 	if !ok {
 		return
 	}
-	result, err := h.client.Jurisdiction.Get(r.Context(), id)
+	query := h.client.Jurisdiction.Query().Where(jurisdiction.ID(id))
+	withIncludes(r, query, jurisdictionIncludes)
+	result, err := query.Only(ctx)
 	if err != nil {
 		entErrorToHTTP(w, err)
 		return
 	}
+	setETag(w, entityETag(result.UpdatedAt))
 	writeJSON(w, http.StatusOK, result)
 }
 
+func (h *JurisdictionHandler) ListChildrenOfJurisdiction(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), handlerTimeout)
+	defer cancel()
+	id, ok := parseUUID(w, r, "id")
+	if !ok {
+		return
+	}
+	parent, err := h.client.Jurisdiction.Get(ctx, id)
+	if err != nil {
+		entErrorToHTTP(w, err)
+		return
+	}
+	pg := parsePagination(r)
+	query := h.client.Jurisdiction.QueryChildren(parent)
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		entErrorToHTTP(w, err)
+		return
+	}
+	items, err := query.
+		Limit(pg.Limit).Offset(pg.Offset).
+		Order(listOrder(r, nil, jurisdiction.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		entErrorToHTTP(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, listResponse{Data: items, Total: total, Offset: pg.Offset, Limit: pg.Limit})
+}
+
+func (h *JurisdictionHandler) ListRulesOfJurisdiction(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), handlerTimeout)
+	defer cancel()
+	id, ok := parseUUID(w, r, "id")
+	if !ok {
+		return
+	}
+	parent, err := h.client.Jurisdiction.Get(ctx, id)
+	if err != nil {
+		entErrorToHTTP(w, err)
+		return
+	}
+	pg := parsePagination(r)
+	query := h.client.Jurisdiction.QueryRules(parent)
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		entErrorToHTTP(w, err)
+		return
+	}
+	items, err := query.
+		Limit(pg.Limit).Offset(pg.Offset).
+		Order(listOrder(r, nil, jurisdictionrule.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		entErrorToHTTP(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, listResponse{Data: items, Total: total, Offset: pg.Offset, Limit: pg.Limit})
+}
+
+func (h *JurisdictionHandler) ListPropertyJurisdictionsOfJurisdiction(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), handlerTimeout)
+	defer cancel()
+	id, ok := parseUUID(w, r, "id")
+	if !ok {
+		return
+	}
+	parent, err := h.client.Jurisdiction.Get(ctx, id)
+	if err != nil {
+		entErrorToHTTP(w, err)
+		return
+	}
+	pg := parsePagination(r)
+	query := h.client.Jurisdiction.QueryPropertyJurisdictions(parent)
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		entErrorToHTTP(w, err)
+		return
+	}
+	items, err := query.
+		Limit(pg.Limit).Offset(pg.Offset).
+		Order(listOrder(r, nil, propertyjurisdiction.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		entErrorToHTTP(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, listResponse{Data: items, Total: total, Offset: pg.Offset, Limit: pg.Limit})
+}
+
+func (h *JurisdictionHandler) CheckJurisdictionExists(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), handlerTimeout)
+	defer cancel()
+	id, ok := parseUUID(w, r, "id")
+	if !ok {
+		return
+	}
+	exists, err := h.client.Jurisdiction.Query().Where(jurisdiction.ID(id)).Exist(ctx)
+	if err != nil {
+		entErrorToHTTP(w, err)
+		return
+	}
+	if !exists {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// jurisdictionSortColumns allowlists the ?sort= values accepted by ListJurisdictions.
+var jurisdictionSortColumns = map[string]string{
+	"created_at":                jurisdiction.FieldCreatedAt,
+	"updated_at":                jurisdiction.FieldUpdatedAt,
+	"name":                      jurisdiction.FieldName,
+	"jurisdiction_type":         jurisdiction.FieldJurisdictionType,
+	"fips_code":                 jurisdiction.FieldFipsCode,
+	"state_code":                jurisdiction.FieldStateCode,
+	"country_code":              jurisdiction.FieldCountryCode,
+	"status":                    jurisdiction.FieldStatus,
+	"successor_jurisdiction_id": jurisdiction.FieldSuccessorJurisdictionID,
+	"effective_date":            jurisdiction.FieldEffectiveDate,
+	"dissolution_date":          jurisdiction.FieldDissolutionDate,
+	"governing_body":            jurisdiction.FieldGoverningBody,
+	"regulatory_url":            jurisdiction.FieldRegulatoryURL,
+}
+
 func (h *JurisdictionHandler) ListJurisdictions(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), handlerTimeout)
+	defer cancel()
 	pg := parsePagination(r)
-	items, err := h.client.Jurisdiction.Query().
+	query := h.client.Jurisdiction.Query()
+	if q := r.URL.Query().Get("q"); q != "" {
+		query.Where(jurisdiction.NameContainsFold(q))
+	}
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		entErrorToHTTP(w, err)
+		return
+	}
+	withIncludes(r, query, jurisdictionIncludes)
+	items, err := query.
 		Limit(pg.Limit).Offset(pg.Offset).
-		Order(ent.Desc(jurisdiction.FieldCreatedAt)).
-		All(r.Context())
+		Order(listOrder(r, jurisdictionSortColumns, jurisdiction.FieldCreatedAt)).
+		All(ctx)
 	if err != nil {
 		entErrorToHTTP(w, err)
 		return
 	}
-	writeJSON(w, http.StatusOK, items)
+	writeJSON(w, http.StatusOK, listResponse{Data: items, Total: total, Offset: pg.Offset, Limit: pg.Limit})
 }
 
 type updateJurisdictionRequest struct {
@@ -146,7 +346,38 @@ type updateJurisdictionRequest struct {
 	ParentJurisdictionID    *string    `json:"parent_jurisdiction_id,omitempty"`
 }
 
+func validateJurisdictionUpdate(req *updateJurisdictionRequest) fieldErrors {
+	errs := fieldErrors{}
+	if req.Name != nil {
+		errs.add(checkRequired("name", *req.Name == ""))
+	}
+	if req.JurisdictionType != nil {
+		errs.add(checkEnum("jurisdiction_type", *req.JurisdictionType, jurisdictionJurisdictionTypeValues))
+	}
+	if req.FipsCode != nil {
+		errs.add(checkPattern("fips_code", *req.FipsCode, jurisdictionFipsCodePattern))
+	}
+	if req.StateCode != nil {
+		errs.add(checkPattern("state_code", *req.StateCode, jurisdictionStateCodePattern))
+	}
+	if req.CountryCode != nil {
+		errs.add(checkPattern("country_code", *req.CountryCode, jurisdictionCountryCodePattern))
+	}
+	if req.Status != nil {
+		errs.add(checkEnum("status", *req.Status, jurisdictionStatusValues))
+	}
+	if req.SuccessorJurisdictionID != nil {
+		errs.add(checkRequired("successor_jurisdiction_id", *req.SuccessorJurisdictionID == ""))
+	}
+	if req.ParentJurisdictionID != nil {
+		errs.add(checkID("parent_jurisdiction_id", *req.ParentJurisdictionID))
+	}
+	return errs
+}
+
 func (h *JurisdictionHandler) UpdateJurisdiction(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), handlerTimeout)
+	defer cancel()
 	id, ok := parseUUID(w, r, "id")
 	if !ok {
 		return
@@ -156,11 +387,31 @@ func (h *JurisdictionHandler) UpdateJurisdiction(w http.ResponseWriter, r *http.
 		return
 	}
 	var req updateJurisdictionRequest
-	if err := decodeJSON(r, &req); err != nil {
+	patch, err := decodeUpdate(r, &req)
+	if err != nil {
 		writeError(w, http.StatusBadRequest, "INVALID_JSON", err.Error())
 		return
 	}
+	if field := patch.firstNull("name", "jurisdiction_type", "country_code", "status"); field != "" {
+		writeError(w, http.StatusBadRequest, "INVALID_PATCH", field+" cannot be null")
+		return
+	}
+	if errs := validateJurisdictionUpdate(&req); len(errs) > 0 {
+		writeFieldErrors(w, errs)
+		return
+	}
 	builder := h.client.Jurisdiction.UpdateOneID(id)
+	if hasIfMatch(r) {
+		current, err := h.client.Jurisdiction.Get(ctx, id)
+		if err != nil {
+			entErrorToHTTP(w, err)
+			return
+		}
+		if !checkIfMatch(w, r, entityETag(current.UpdatedAt)) {
+			return
+		}
+		builder.Where(jurisdiction.UpdatedAtEQ(current.UpdatedAt))
+	}
 	if req.Name != nil {
 		builder.SetName(*req.Name)
 	}
@@ -195,26 +446,48 @@ func (h *JurisdictionHandler) UpdateJurisdiction(w http.ResponseWriter, r *http.
 		builder.SetNillableRegulatoryURL(req.RegulatoryURL)
 	}
 	if req.ParentJurisdictionID != nil {
-		uid, err := uuid.Parse(*req.ParentJurisdictionID)
-		if err != nil {
-			writeError(w, http.StatusBadRequest, "INVALID_ID", "invalid parent_jurisdiction_id")
-			return
-		}
-		builder.SetParentJurisdictionID(uid)
+		builder.SetParentJurisdictionID(uuid.MustParse(*req.ParentJurisdictionID))
+	}
+	if patch.isNull("fips_code") {
+		builder.ClearFipsCode()
+	}
+	if patch.isNull("state_code") {
+		builder.ClearStateCode()
+	}
+	if patch.isNull("successor_jurisdiction_id") {
+		builder.ClearSuccessorJurisdictionID()
+	}
+	if patch.isNull("effective_date") {
+		builder.ClearEffectiveDate()
+	}
+	if patch.isNull("dissolution_date") {
+		builder.ClearDissolutionDate()
+	}
+	if patch.isNull("governing_body") {
+		builder.ClearGoverningBody()
+	}
+	if patch.isNull("regulatory_url") {
+		builder.ClearRegulatoryURL()
+	}
+	if patch.isNull("parent_jurisdiction_id") {
+		builder.ClearParentJurisdiction()
 	}
 	builder.SetUpdatedBy(audit.Actor).SetSource(jurisdiction.Source(audit.Source))
 	if audit.CorrelationID != nil {
 		builder.SetCorrelationID(*audit.CorrelationID)
 	}
-	result, err := builder.Save(r.Context())
+	result, err := saveAudited[*ent.Jurisdiction](ctx, h.client, h.audit, AuditOpUpdate, audit, builder.Mutation())
 	if err != nil {
-		entErrorToHTTP(w, err)
+		writeGuardedSaveError(w, r, err)
 		return
 	}
+	setETag(w, entityETag(result.UpdatedAt))
 	writeJSON(w, http.StatusOK, result)
 }
 
 func (h *JurisdictionHandler) transitionJurisdiction(w http.ResponseWriter, r *http.Request, targetStatus string, applyExtra func(*ent.JurisdictionUpdateOne)) {
+	ctx, cancel := context.WithTimeout(r.Context(), handlerTimeout)
+	defer cancel()
 	id, ok := parseUUID(w, r, "id")
 	if !ok {
 		return
@@ -223,13 +496,12 @@ func (h *JurisdictionHandler) transitionJurisdiction(w http.ResponseWriter, r *h
 	if !ok {
 		return
 	}
-	current, err := h.client.Jurisdiction.Get(r.Context(), id)
+	current, err := h.client.Jurisdiction.Get(ctx, id)
 	if err != nil {
 		entErrorToHTTP(w, err)
 		return
 	}
-	if err := ValidateTransition(schema.ValidJurisdictionTransitions, string(current.Status), targetStatus); err != nil {
-		writeError(w, http.StatusConflict, "INVALID_TRANSITION", err.Error())
+	if !checkIfMatch(w, r, entityETag(current.UpdatedAt)) {
 		return
 	}
 	builder := h.client.Jurisdiction.UpdateOneID(id).
@@ -239,14 +511,22 @@ func (h *JurisdictionHandler) transitionJurisdiction(w http.ResponseWriter, r *h
 	if audit.CorrelationID != nil {
 		builder.SetCorrelationID(*audit.CorrelationID)
 	}
+	if hasIfMatch(r) {
+		builder.Where(jurisdiction.UpdatedAtEQ(current.UpdatedAt))
+	}
 	if applyExtra != nil {
 		applyExtra(builder)
 	}
-	updated, err := builder.Save(r.Context())
+	if err := schema.ValidateTransitionWithGuard(schema.ValidJurisdictionTransitions, schema.JurisdictionTransitionGuards, string(current.Status), targetStatus, guardValues(ctx, builder.Mutation())); err != nil {
+		writeError(w, http.StatusConflict, "INVALID_TRANSITION", err.Error())
+		return
+	}
+	updated, err := saveAudited[*ent.Jurisdiction](ctx, h.client, h.audit, AuditOpTransition, audit, builder.Mutation())
 	if err != nil {
-		entErrorToHTTP(w, err)
+		writeGuardedSaveError(w, r, err)
 		return
 	}
+	setETag(w, entityETag(updated.UpdatedAt))
 	writeJSON(w, http.StatusOK, updated)
 }
 
@@ -256,28 +536,56 @@ func (h *JurisdictionHandler) ActivateJurisdiction(w http.ResponseWriter, r *htt
 
 func (h *JurisdictionHandler) DissolveJurisdiction(w http.ResponseWriter, r *http.Request) {
 	type extraFields struct {
-		SuccessorJurisdictionID string     `json:"successor_jurisdiction_id,omitempty"`
+		SuccessorJurisdictionID *string    `json:"successor_jurisdiction_id,omitempty"`
 		DissolutionDate         *time.Time `json:"dissolution_date,omitempty"`
 	}
-	var extra extraFields
-	_ = decodeJSON(r, &extra)
-	h.transitionJurisdiction(w, r, "dissolved", func(b *ent.JurisdictionUpdateOne) {
-		if extra.DissolutionDate != nil {
-			b.SetNillableDissolutionDate(extra.DissolutionDate)
+	var req extraFields
+	if err := decodeOptionalJSON(r, &req); err != nil {
+		writeError(w, http.StatusBadRequest, "INVALID_JSON", err.Error())
+		return
+	}
+	errs := fieldErrors{}
+	if req.SuccessorJurisdictionID != nil {
+		errs.add(checkRequired("successor_jurisdiction_id", *req.SuccessorJurisdictionID == ""))
+	}
+	if len(errs) > 0 {
+		writeFieldErrors(w, errs)
+		return
+	}
+	h.transitionJurisdiction(w, r, "dissolved", func(builder *ent.JurisdictionUpdateOne) {
+		if req.SuccessorJurisdictionID != nil {
+			builder.SetNillableSuccessorJurisdictionID(req.SuccessorJurisdictionID)
+		}
+		if req.DissolutionDate != nil {
+			builder.SetNillableDissolutionDate(req.DissolutionDate)
 		}
 	})
 }
 
 func (h *JurisdictionHandler) MergeJurisdiction(w http.ResponseWriter, r *http.Request) {
 	type extraFields struct {
-		SuccessorJurisdictionID string     `json:"successor_jurisdiction_id,omitempty"`
+		SuccessorJurisdictionID *string    `json:"successor_jurisdiction_id,omitempty"`
 		DissolutionDate         *time.Time `json:"dissolution_date,omitempty"`
 	}
-	var extra extraFields
-	_ = decodeJSON(r, &extra)
-	h.transitionJurisdiction(w, r, "merged", func(b *ent.JurisdictionUpdateOne) {
-		if extra.DissolutionDate != nil {
-			b.SetNillableDissolutionDate(extra.DissolutionDate)
+	var req extraFields
+	if err := decodeOptionalJSON(r, &req); err != nil {
+		writeError(w, http.StatusBadRequest, "INVALID_JSON", err.Error())
+		return
+	}
+	errs := fieldErrors{}
+	if req.SuccessorJurisdictionID != nil {
+		errs.add(checkRequired("successor_jurisdiction_id", *req.SuccessorJurisdictionID == ""))
+	}
+	if len(errs) > 0 {
+		writeFieldErrors(w, errs)
+		return
+	}
+	h.transitionJurisdiction(w, r, "merged", func(builder *ent.JurisdictionUpdateOne) {
+		if req.SuccessorJurisdictionID != nil {
+			builder.SetNillableSuccessorJurisdictionID(req.SuccessorJurisdictionID)
+		}
+		if req.DissolutionDate != nil {
+			builder.SetNillableDissolutionDate(req.DissolutionDate)
 		}
 	})
 }
@@ -286,6 +594,11 @@ func (h *JurisdictionHandler) MergeJurisdiction(w http.ResponseWriter, r *http.R
 // PropertyJurisdiction
 // ============================================================================
 
+// Accepted values of the PropertyJurisdiction enum fields.
+var (
+	propertyJurisdictionLookupSourceValues = []string{"address_geocode", "manual", "api_lookup", "imported"}
+)
+
 type createPropertyJurisdictionRequest struct {
 	EffectiveDate  time.Time  `json:"effective_date"`
 	EndDate        *time.Time `json:"end_date,omitempty"`
@@ -297,17 +610,19 @@ type createPropertyJurisdictionRequest struct {
 	JurisdictionID string     `json:"jurisdiction_id"`
 }
 
-func (h *JurisdictionHandler) CreatePropertyJurisdiction(w http.ResponseWriter, r *http.Request) {
-	audit, ok := parseAuditContext(w, r)
-	if !ok {
-		return
-	}
-	var req createPropertyJurisdictionRequest
-	if err := decodeJSON(r, &req); err != nil {
-		writeError(w, http.StatusBadRequest, "INVALID_JSON", err.Error())
-		return
-	}
-	builder := h.client.PropertyJurisdiction.Create()
+func validatePropertyJurisdictionCreate(req *createPropertyJurisdictionRequest) fieldErrors {
+	errs := fieldErrors{}
+	errs.add(checkRequired("effective_date", req.EffectiveDate.IsZero()))
+	errs.add(checkRequired("lookup_source", req.LookupSource == ""))
+	errs.add(checkEnum("lookup_source", req.LookupSource, propertyJurisdictionLookupSourceValues))
+	errs.add(checkRequired("property_id", req.PropertyID == ""))
+	errs.add(checkID("property_id", req.PropertyID))
+	errs.add(checkRequired("jurisdiction_id", req.JurisdictionID == ""))
+	errs.add(checkID("jurisdiction_id", req.JurisdictionID))
+	return errs
+}
+
+func (req *createPropertyJurisdictionRequest) apply(builder *ent.PropertyJurisdictionCreate) {
 	builder.SetEffectiveDate(req.EffectiveDate)
 	if req.EndDate != nil {
 		builder.SetNillableEndDate(req.EndDate)
@@ -320,27 +635,33 @@ func (h *JurisdictionHandler) CreatePropertyJurisdiction(w http.ResponseWriter,
 	if req.VerifiedBy != nil {
 		builder.SetNillableVerifiedBy(req.VerifiedBy)
 	}
-	{
-		uid, err := uuid.Parse(req.PropertyID)
-		if err != nil {
-			writeError(w, http.StatusBadRequest, "INVALID_ID", "invalid property_id")
-			return
-		}
-		builder.SetPropertyID(uid)
+	builder.SetPropertyID(uuid.MustParse(req.PropertyID))
+	builder.SetJurisdictionID(uuid.MustParse(req.JurisdictionID))
+}
+
+func (h *JurisdictionHandler) CreatePropertyJurisdiction(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), handlerTimeout)
+	defer cancel()
+	audit, ok := parseAuditContext(w, r)
+	if !ok {
+		return
 	}
-	{
-		uid, err := uuid.Parse(req.JurisdictionID)
-		if err != nil {
-			writeError(w, http.StatusBadRequest, "INVALID_ID", "invalid jurisdiction_id")
-			return
-		}
-		builder.SetJurisdictionID(uid)
+	var req createPropertyJurisdictionRequest
+	if err := decodeJSON(r, &req); err != nil {
+		writeError(w, http.StatusBadRequest, "INVALID_JSON", err.Error())
+		return
+	}
+	if errs := validatePropertyJurisdictionCreate(&req); len(errs) > 0 {
+		writeFieldErrors(w, errs)
+		return
 	}
+	builder := h.client.PropertyJurisdiction.Create()
+	req.apply(builder)
 	builder.SetCreatedBy(audit.Actor).SetUpdatedBy(audit.Actor).SetSource(propertyjurisdiction.Source(audit.Source))
 	if audit.CorrelationID != nil {
 		builder.SetCorrelationID(*audit.CorrelationID)
 	}
-	result, err := builder.Save(r.Context())
+	result, err := saveAudited[*ent.PropertyJurisdiction](ctx, h.client, h.audit, AuditOpCreate, audit, builder.Mutation())
 	if err != nil {
 		entErrorToHTTP(w, err)
 		return
@@ -348,30 +669,81 @@ func (h *JurisdictionHandler) CreatePropertyJurisdiction(w http.ResponseWriter,
 	writeJSON(w, http.StatusCreated, result)
 }
 
+// propertyJurisdictionIncludes maps ?include= edge names to eager loaders on a PropertyJurisdiction query.
+var propertyJurisdictionIncludes = map[string]func(*ent.PropertyJurisdictionQuery){
+	"property":     func(q *ent.PropertyJurisdictionQuery) { q.WithProperty() },
+	"jurisdiction": func(q *ent.PropertyJurisdictionQuery) { q.WithJurisdiction() },
+}
+
 func (h *JurisdictionHandler) GetPropertyJurisdiction(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), handlerTimeout)
+	defer cancel()
 	id, ok := parseUUID(w, r, "id")
 	if !ok {
 		return
 	}
-	result, err := h.client.PropertyJurisdiction.Get(r.Context(), id)
+	query := h.client.PropertyJurisdiction.Query().Where(propertyjurisdiction.ID(id))
+	withIncludes(r, query, propertyJurisdictionIncludes)
+	result, err := query.Only(ctx)
 	if err != nil {
 		entErrorToHTTP(w, err)
 		return
 	}
+	setETag(w, entityETag(result.UpdatedAt))
 	writeJSON(w, http.StatusOK, result)
 }
 
+func (h *JurisdictionHandler) CheckPropertyJurisdictionExists(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), handlerTimeout)
+	defer cancel()
+	id, ok := parseUUID(w, r, "id")
+	if !ok {
+		return
+	}
+	exists, err := h.client.PropertyJurisdiction.Query().Where(propertyjurisdiction.ID(id)).Exist(ctx)
+	if err != nil {
+		entErrorToHTTP(w, err)
+		return
+	}
+	if !exists {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// propertyJurisdictionSortColumns allowlists the ?sort= values accepted by ListPropertyJurisdictions.
+var propertyJurisdictionSortColumns = map[string]string{
+	"created_at":     propertyjurisdiction.FieldCreatedAt,
+	"updated_at":     propertyjurisdiction.FieldUpdatedAt,
+	"effective_date": propertyjurisdiction.FieldEffectiveDate,
+	"end_date":       propertyjurisdiction.FieldEndDate,
+	"lookup_source":  propertyjurisdiction.FieldLookupSource,
+	"verified":       propertyjurisdiction.FieldVerified,
+	"verified_at":    propertyjurisdiction.FieldVerifiedAt,
+	"verified_by":    propertyjurisdiction.FieldVerifiedBy,
+}
+
 func (h *JurisdictionHandler) ListPropertyJurisdictions(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), handlerTimeout)
+	defer cancel()
 	pg := parsePagination(r)
-	items, err := h.client.PropertyJurisdiction.Query().
+	query := h.client.PropertyJurisdiction.Query()
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		entErrorToHTTP(w, err)
+		return
+	}
+	withIncludes(r, query, propertyJurisdictionIncludes)
+	items, err := query.
 		Limit(pg.Limit).Offset(pg.Offset).
-		Order(ent.Desc(propertyjurisdiction.FieldCreatedAt)).
-		All(r.Context())
+		Order(listOrder(r, propertyJurisdictionSortColumns, propertyjurisdiction.FieldCreatedAt)).
+		All(ctx)
 	if err != nil {
 		entErrorToHTTP(w, err)
 		return
 	}
-	writeJSON(w, http.StatusOK, items)
+	writeJSON(w, http.StatusOK, listResponse{Data: items, Total: total, Offset: pg.Offset, Limit: pg.Limit})
 }
 
 type updatePropertyJurisdictionRequest struct {
@@ -385,7 +757,23 @@ type updatePropertyJurisdictionRequest struct {
 	JurisdictionID *string    `json:"jurisdiction_id,omitempty"`
 }
 
+func validatePropertyJurisdictionUpdate(req *updatePropertyJurisdictionRequest) fieldErrors {
+	errs := fieldErrors{}
+	if req.LookupSource != nil {
+		errs.add(checkEnum("lookup_source", *req.LookupSource, propertyJurisdictionLookupSourceValues))
+	}
+	if req.PropertyID != nil {
+		errs.add(checkID("property_id", *req.PropertyID))
+	}
+	if req.JurisdictionID != nil {
+		errs.add(checkID("jurisdiction_id", *req.JurisdictionID))
+	}
+	return errs
+}
+
 func (h *JurisdictionHandler) UpdatePropertyJurisdiction(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), handlerTimeout)
+	defer cancel()
 	id, ok := parseUUID(w, r, "id")
 	if !ok {
 		return
@@ -395,11 +783,31 @@ func (h *JurisdictionHandler) UpdatePropertyJurisdiction(w http.ResponseWriter,
 		return
 	}
 	var req updatePropertyJurisdictionRequest
-	if err := decodeJSON(r, &req); err != nil {
+	patch, err := decodeUpdate(r, &req)
+	if err != nil {
 		writeError(w, http.StatusBadRequest, "INVALID_JSON", err.Error())
 		return
 	}
+	if field := patch.firstNull("effective_date", "lookup_source", "verified", "property_id", "jurisdiction_id"); field != "" {
+		writeError(w, http.StatusBadRequest, "INVALID_PATCH", field+" cannot be null")
+		return
+	}
+	if errs := validatePropertyJurisdictionUpdate(&req); len(errs) > 0 {
+		writeFieldErrors(w, errs)
+		return
+	}
 	builder := h.client.PropertyJurisdiction.UpdateOneID(id)
+	if hasIfMatch(r) {
+		current, err := h.client.PropertyJurisdiction.Get(ctx, id)
+		if err != nil {
+			entErrorToHTTP(w, err)
+			return
+		}
+		if !checkIfMatch(w, r, entityETag(current.UpdatedAt)) {
+			return
+		}
+		builder.Where(propertyjurisdiction.UpdatedAtEQ(current.UpdatedAt))
+	}
 	if req.EffectiveDate != nil {
 		builder.SetNillableEffectiveDate(req.EffectiveDate)
 	}
@@ -419,30 +827,30 @@ func (h *JurisdictionHandler) UpdatePropertyJurisdiction(w http.ResponseWriter,
 		builder.SetNillableVerifiedBy(req.VerifiedBy)
 	}
 	if req.PropertyID != nil {
-		uid, err := uuid.Parse(*req.PropertyID)
-		if err != nil {
-			writeError(w, http.StatusBadRequest, "INVALID_ID", "invalid property_id")
-			return
-		}
-		builder.SetPropertyID(uid)
+		builder.SetPropertyID(uuid.MustParse(*req.PropertyID))
 	}
 	if req.JurisdictionID != nil {
-		uid, err := uuid.Parse(*req.JurisdictionID)
-		if err != nil {
-			writeError(w, http.StatusBadRequest, "INVALID_ID", "invalid jurisdiction_id")
-			return
-		}
-		builder.SetJurisdictionID(uid)
+		builder.SetJurisdictionID(uuid.MustParse(*req.JurisdictionID))
+	}
+	if patch.isNull("end_date") {
+		builder.ClearEndDate()
+	}
+	if patch.isNull("verified_at") {
+		builder.ClearVerifiedAt()
+	}
+	if patch.isNull("verified_by") {
+		builder.ClearVerifiedBy()
 	}
 	builder.SetUpdatedBy(audit.Actor).SetSource(propertyjurisdiction.Source(audit.Source))
 	if audit.CorrelationID != nil {
 		builder.SetCorrelationID(*audit.CorrelationID)
 	}
-	result, err := builder.Save(r.Context())
+	result, err := saveAudited[*ent.PropertyJurisdiction](ctx, h.client, h.audit, AuditOpUpdate, audit, builder.Mutation())
 	if err != nil {
-		entErrorToHTTP(w, err)
+		writeGuardedSaveError(w, r, err)
 		return
 	}
+	setETag(w, entityETag(result.UpdatedAt))
 	writeJSON(w, http.StatusOK, result)
 }
 
@@ -450,6 +858,12 @@ func (h *JurisdictionHandler) UpdatePropertyJurisdiction(w http.ResponseWriter,
 // JurisdictionRule
 // ============================================================================
 
+// Accepted values of the JurisdictionRule enum fields.
+var (
+	jurisdictionRuleRuleTypeValues = []string{"security_deposit_limit", "notice_period", "rent_increase_cap", "required_disclosure", "eviction_procedure", "late_fee_cap", "rent_control", "habitability_standard", "tenant_screening_restriction", "lease_term_restriction", "fee_restriction", "relocation_assistance", "right_to_counsel", "just_cause_eviction", "source_of_income_protection", "lead_paint_disclosure", "mold_disclosure", "bed_bug_disclosure", "flood_zone_disclosure", "utility_billing_restriction", "short_term_rental_restriction"}
+	jurisdictionRuleStatusValues   = []string{"draft", "active", "superseded", "expired", "repealed"}
+)
+
 type createJurisdictionRuleRequest struct {
 	RuleType               string     `json:"rule_type"`
 	Status                 string     `json:"status"`
@@ -468,17 +882,22 @@ type createJurisdictionRuleRequest struct {
 	SupersededByID         *string    `json:"superseded_by_id,omitempty"`
 }
 
-func (h *JurisdictionHandler) CreateJurisdictionRule(w http.ResponseWriter, r *http.Request) {
-	audit, ok := parseAuditContext(w, r)
-	if !ok {
-		return
-	}
-	var req createJurisdictionRuleRequest
-	if err := decodeJSON(r, &req); err != nil {
-		writeError(w, http.StatusBadRequest, "INVALID_JSON", err.Error())
-		return
+func validateJurisdictionRuleCreate(req *createJurisdictionRuleRequest) fieldErrors {
+	errs := fieldErrors{}
+	errs.add(checkRequired("rule_type", req.RuleType == ""))
+	errs.add(checkEnum("rule_type", req.RuleType, jurisdictionRuleRuleTypeValues))
+	errs.add(checkRequired("status", req.Status == ""))
+	errs.add(checkEnum("status", req.Status, jurisdictionRuleStatusValues))
+	errs.add(checkRequired("effective_date", req.EffectiveDate.IsZero()))
+	errs.add(checkRequired("jurisdiction_id", req.JurisdictionID == ""))
+	errs.add(checkID("jurisdiction_id", req.JurisdictionID))
+	if req.SupersededByID != nil {
+		errs.add(checkID("superseded_by_id", *req.SupersededByID))
 	}
-	builder := h.client.JurisdictionRule.Create()
+	return errs
+}
+
+func (req *createJurisdictionRuleRequest) apply(builder *ent.JurisdictionRuleCreate) {
 	builder.SetRuleType(jurisdictionrule.RuleType(req.RuleType))
 	builder.SetStatus(jurisdictionrule.Status(req.Status))
 	if len(req.AppliesToLeaseTypes) > 0 {
@@ -512,27 +931,35 @@ func (h *JurisdictionHandler) CreateJurisdictionRule(w http.ResponseWriter, r *h
 	if req.VerificationSource != nil {
 		builder.SetNillableVerificationSource(req.VerificationSource)
 	}
-	{
-		uid, err := uuid.Parse(req.JurisdictionID)
-		if err != nil {
-			writeError(w, http.StatusBadRequest, "INVALID_ID", "invalid jurisdiction_id")
-			return
-		}
-		builder.SetJurisdictionID(uid)
-	}
+	builder.SetJurisdictionID(uuid.MustParse(req.JurisdictionID))
 	if req.SupersededByID != nil {
-		uid, err := uuid.Parse(*req.SupersededByID)
-		if err != nil {
-			writeError(w, http.StatusBadRequest, "INVALID_ID", "invalid superseded_by_id")
-			return
-		}
-		builder.SetSupersededByID(uid)
+		builder.SetSupersededByID(uuid.MustParse(*req.SupersededByID))
 	}
+}
+
+func (h *JurisdictionHandler) CreateJurisdictionRule(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), handlerTimeout)
+	defer cancel()
+	audit, ok := parseAuditContext(w, r)
+	if !ok {
+		return
+	}
+	var req createJurisdictionRuleRequest
+	if err := decodeJSON(r, &req); err != nil {
+		writeError(w, http.StatusBadRequest, "INVALID_JSON", err.Error())
+		return
+	}
+	if errs := validateJurisdictionRuleCreate(&req); len(errs) > 0 {
+		writeFieldErrors(w, errs)
+		return
+	}
+	builder := h.client.JurisdictionRule.Create()
+	req.apply(builder)
 	builder.SetCreatedBy(audit.Actor).SetUpdatedBy(audit.Actor).SetSource(jurisdictionrule.Source(audit.Source))
 	if audit.CorrelationID != nil {
 		builder.SetCorrelationID(*audit.CorrelationID)
 	}
-	result, err := builder.Save(r.Context())
+	result, err := saveAudited[*ent.JurisdictionRule](ctx, h.client, h.audit, AuditOpCreate, audit, builder.Mutation())
 	if err != nil {
 		entErrorToHTTP(w, err)
 		return
@@ -540,30 +967,86 @@ func (h *JurisdictionHandler) CreateJurisdictionRule(w http.ResponseWriter, r *h
 	writeJSON(w, http.StatusCreated, result)
 }
 
+// jurisdictionRuleIncludes maps ?include= edge names to eager loaders on a JurisdictionRule query.
+var jurisdictionRuleIncludes = map[string]func(*ent.JurisdictionRuleQuery){
+	"jurisdiction":  func(q *ent.JurisdictionRuleQuery) { q.WithJurisdiction() },
+	"superseded_by": func(q *ent.JurisdictionRuleQuery) { q.WithSupersededBy() },
+	"supersedes":    func(q *ent.JurisdictionRuleQuery) { q.WithSupersedes() },
+}
+
 func (h *JurisdictionHandler) GetJurisdictionRule(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), handlerTimeout)
+	defer cancel()
 	id, ok := parseUUID(w, r, "id")
 	if !ok {
 		return
 	}
-	result, err := h.client.JurisdictionRule.Get(r.Context(), id)
+	query := h.client.JurisdictionRule.Query().Where(jurisdictionrule.ID(id))
+	withIncludes(r, query, jurisdictionRuleIncludes)
+	result, err := query.Only(ctx)
 	if err != nil {
 		entErrorToHTTP(w, err)
 		return
 	}
+	setETag(w, entityETag(result.UpdatedAt))
 	writeJSON(w, http.StatusOK, result)
 }
 
+func (h *JurisdictionHandler) CheckJurisdictionRuleExists(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), handlerTimeout)
+	defer cancel()
+	id, ok := parseUUID(w, r, "id")
+	if !ok {
+		return
+	}
+	exists, err := h.client.JurisdictionRule.Query().Where(jurisdictionrule.ID(id)).Exist(ctx)
+	if err != nil {
+		entErrorToHTTP(w, err)
+		return
+	}
+	if !exists {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// jurisdictionRuleSortColumns allowlists the ?sort= values accepted by ListJurisdictionRules.
+var jurisdictionRuleSortColumns = map[string]string{
+	"created_at":          jurisdictionrule.FieldCreatedAt,
+	"updated_at":          jurisdictionrule.FieldUpdatedAt,
+	"rule_type":           jurisdictionrule.FieldRuleType,
+	"status":              jurisdictionrule.FieldStatus,
+	"statute_reference":   jurisdictionrule.FieldStatuteReference,
+	"ordinance_number":    jurisdictionrule.FieldOrdinanceNumber,
+	"statute_url":         jurisdictionrule.FieldStatuteURL,
+	"effective_date":      jurisdictionrule.FieldEffectiveDate,
+	"expiration_date":     jurisdictionrule.FieldExpirationDate,
+	"last_verified":       jurisdictionrule.FieldLastVerified,
+	"verified_by":         jurisdictionrule.FieldVerifiedBy,
+	"verification_source": jurisdictionrule.FieldVerificationSource,
+}
+
 func (h *JurisdictionHandler) ListJurisdictionRules(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), handlerTimeout)
+	defer cancel()
 	pg := parsePagination(r)
-	items, err := h.client.JurisdictionRule.Query().
+	query := h.client.JurisdictionRule.Query()
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		entErrorToHTTP(w, err)
+		return
+	}
+	withIncludes(r, query, jurisdictionRuleIncludes)
+	items, err := query.
 		Limit(pg.Limit).Offset(pg.Offset).
-		Order(ent.Desc(jurisdictionrule.FieldCreatedAt)).
-		All(r.Context())
+		Order(listOrder(r, jurisdictionRuleSortColumns, jurisdictionrule.FieldCreatedAt)).
+		All(ctx)
 	if err != nil {
 		entErrorToHTTP(w, err)
 		return
 	}
-	writeJSON(w, http.StatusOK, items)
+	writeJSON(w, http.StatusOK, listResponse{Data: items, Total: total, Offset: pg.Offset, Limit: pg.Limit})
 }
 
 type updateJurisdictionRuleRequest struct {
@@ -584,7 +1067,26 @@ type updateJurisdictionRuleRequest struct {
 	SupersededByID         *string    `json:"superseded_by_id,omitempty"`
 }
 
+func validateJurisdictionRuleUpdate(req *updateJurisdictionRuleRequest) fieldErrors {
+	errs := fieldErrors{}
+	if req.RuleType != nil {
+		errs.add(checkEnum("rule_type", *req.RuleType, jurisdictionRuleRuleTypeValues))
+	}
+	if req.Status != nil {
+		errs.add(checkEnum("status", *req.Status, jurisdictionRuleStatusValues))
+	}
+	if req.JurisdictionID != nil {
+		errs.add(checkID("jurisdiction_id", *req.JurisdictionID))
+	}
+	if req.SupersededByID != nil {
+		errs.add(checkID("superseded_by_id", *req.SupersededByID))
+	}
+	return errs
+}
+
 func (h *JurisdictionHandler) UpdateJurisdictionRule(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), handlerTimeout)
+	defer cancel()
 	id, ok := parseUUID(w, r, "id")
 	if !ok {
 		return
@@ -594,11 +1096,31 @@ func (h *JurisdictionHandler) UpdateJurisdictionRule(w http.ResponseWriter, r *h
 		return
 	}
 	var req updateJurisdictionRuleRequest
-	if err := decodeJSON(r, &req); err != nil {
+	patch, err := decodeUpdate(r, &req)
+	if err != nil {
 		writeError(w, http.StatusBadRequest, "INVALID_JSON", err.Error())
 		return
 	}
+	if field := patch.firstNull("rule_type", "status", "effective_date", "jurisdiction_id"); field != "" {
+		writeError(w, http.StatusBadRequest, "INVALID_PATCH", field+" cannot be null")
+		return
+	}
+	if errs := validateJurisdictionRuleUpdate(&req); len(errs) > 0 {
+		writeFieldErrors(w, errs)
+		return
+	}
 	builder := h.client.JurisdictionRule.UpdateOneID(id)
+	if hasIfMatch(r) {
+		current, err := h.client.JurisdictionRule.Get(ctx, id)
+		if err != nil {
+			entErrorToHTTP(w, err)
+			return
+		}
+		if !checkIfMatch(w, r, entityETag(current.UpdatedAt)) {
+			return
+		}
+		builder.Where(jurisdictionrule.UpdatedAtEQ(current.UpdatedAt))
+	}
 	if req.RuleType != nil {
 		builder.SetRuleType(jurisdictionrule.RuleType(*req.RuleType))
 	}
@@ -639,34 +1161,60 @@ func (h *JurisdictionHandler) UpdateJurisdictionRule(w http.ResponseWriter, r *h
 		builder.SetNillableVerificationSource(req.VerificationSource)
 	}
 	if req.JurisdictionID != nil {
-		uid, err := uuid.Parse(*req.JurisdictionID)
-		if err != nil {
-			writeError(w, http.StatusBadRequest, "INVALID_ID", "invalid jurisdiction_id")
-			return
-		}
-		builder.SetJurisdictionID(uid)
+		builder.SetJurisdictionID(uuid.MustParse(*req.JurisdictionID))
 	}
 	if req.SupersededByID != nil {
-		uid, err := uuid.Parse(*req.SupersededByID)
-		if err != nil {
-			writeError(w, http.StatusBadRequest, "INVALID_ID", "invalid superseded_by_id")
-			return
-		}
-		builder.SetSupersededByID(uid)
+		builder.SetSupersededByID(uuid.MustParse(*req.SupersededByID))
+	}
+	if patch.isNull("applies_to_lease_types") {
+		builder.ClearAppliesToLeaseTypes()
+	}
+	if patch.isNull("applies_to_property_types") {
+		builder.ClearAppliesToPropertyTypes()
+	}
+	if patch.isNull("applies_to_space_types") {
+		builder.ClearAppliesToSpaceTypes()
+	}
+	if patch.isNull("statute_reference") {
+		builder.ClearStatuteReference()
+	}
+	if patch.isNull("ordinance_number") {
+		builder.ClearOrdinanceNumber()
+	}
+	if patch.isNull("statute_url") {
+		builder.ClearStatuteURL()
+	}
+	if patch.isNull("expiration_date") {
+		builder.ClearExpirationDate()
+	}
+	if patch.isNull("last_verified") {
+		builder.ClearLastVerified()
+	}
+	if patch.isNull("verified_by") {
+		builder.ClearVerifiedBy()
+	}
+	if patch.isNull("verification_source") {
+		builder.ClearVerificationSource()
+	}
+	if patch.isNull("superseded_by_id") {
+		builder.ClearSupersededBy()
 	}
 	builder.SetUpdatedBy(audit.Actor).SetSource(jurisdictionrule.Source(audit.Source))
 	if audit.CorrelationID != nil {
 		builder.SetCorrelationID(*audit.CorrelationID)
 	}
-	result, err := builder.Save(r.Context())
+	result, err := saveAudited[*ent.JurisdictionRule](ctx, h.client, h.audit, AuditOpUpdate, audit, builder.Mutation())
 	if err != nil {
-		entErrorToHTTP(w, err)
+		writeGuardedSaveError(w, r, err)
 		return
 	}
+	setETag(w, entityETag(result.UpdatedAt))
 	writeJSON(w, http.StatusOK, result)
 }
 
 func (h *JurisdictionHandler) transitionJurisdictionRule(w http.ResponseWriter, r *http.Request, targetStatus string, applyExtra func(*ent.JurisdictionRuleUpdateOne)) {
+	ctx, cancel := context.WithTimeout(r.Context(), handlerTimeout)
+	defer cancel()
 	id, ok := parseUUID(w, r, "id")
 	if !ok {
 		return
@@ -675,13 +1223,12 @@ func (h *JurisdictionHandler) transitionJurisdictionRule(w http.ResponseWriter,
 	if !ok {
 		return
 	}
-	current, err := h.client.JurisdictionRule.Get(r.Context(), id)
+	current, err := h.client.JurisdictionRule.Get(ctx, id)
 	if err != nil {
 		entErrorToHTTP(w, err)
 		return
 	}
-	if err := ValidateTransition(schema.ValidJurisdictionRuleTransitions, string(current.Status), targetStatus); err != nil {
-		writeError(w, http.StatusConflict, "INVALID_TRANSITION", err.Error())
+	if !checkIfMatch(w, r, entityETag(current.UpdatedAt)) {
 		return
 	}
 	builder := h.client.JurisdictionRule.UpdateOneID(id).
@@ -691,14 +1238,22 @@ func (h *JurisdictionHandler) transitionJurisdictionRule(w http.ResponseWriter,
 	if audit.CorrelationID != nil {
 		builder.SetCorrelationID(*audit.CorrelationID)
 	}
+	if hasIfMatch(r) {
+		builder.Where(jurisdictionrule.UpdatedAtEQ(current.UpdatedAt))
+	}
 	if applyExtra != nil {
 		applyExtra(builder)
 	}
-	updated, err := builder.Save(r.Context())
+	if err := schema.ValidateTransitionWithGuard(schema.ValidJurisdictionRuleTransitions, schema.JurisdictionRuleTransitionGuards, string(current.Status), targetStatus, guardValues(ctx, builder.Mutation())); err != nil {
+		writeError(w, http.StatusConflict, "INVALID_TRANSITION", err.Error())
+		return
+	}
+	updated, err := saveAudited[*ent.JurisdictionRule](ctx, h.client, h.audit, AuditOpTransition, audit, builder.Mutation())
 	if err != nil {
-		entErrorToHTTP(w, err)
+		writeGuardedSaveError(w, r, err)
 		return
 	}
+	setETag(w, entityETag(updated.UpdatedAt))
 	writeJSON(w, http.StatusOK, updated)
 }
 
@@ -708,11 +1263,25 @@ func (h *JurisdictionHandler) ActivateRule(w http.ResponseWriter, r *http.Reques
 
 func (h *JurisdictionHandler) SupersedeRule(w http.ResponseWriter, r *http.Request) {
 	type extraFields struct {
-		SupersededByID string `json:"superseded_by_id,omitempty"`
+		SupersededByID *string `json:"superseded_by_id,omitempty"`
+	}
+	var req extraFields
+	if err := decodeOptionalJSON(r, &req); err != nil {
+		writeError(w, http.StatusBadRequest, "INVALID_JSON", err.Error())
+		return
+	}
+	errs := fieldErrors{}
+	if req.SupersededByID != nil {
+		errs.add(checkID("superseded_by_id", *req.SupersededByID))
 	}
-	var extra extraFields
-	_ = decodeJSON(r, &extra)
-	h.transitionJurisdictionRule(w, r, "superseded", func(b *ent.JurisdictionRuleUpdateOne) {
+	if len(errs) > 0 {
+		writeFieldErrors(w, errs)
+		return
+	}
+	h.transitionJurisdictionRule(w, r, "superseded", func(builder *ent.JurisdictionRuleUpdateOne) {
+		if req.SupersededByID != nil {
+			builder.SetSupersededByID(uuid.MustParse(*req.SupersededByID))
+		}
 	})
 }
 
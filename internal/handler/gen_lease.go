@@ -2,6 +2,7 @@
 package handler
 
 import (
+	"context"
 	"net/http"
 	"time"
 
@@ -10,6 +11,9 @@ import (
 	"github.com/matthewbaird/ontology/ent/application"
 	"github.com/matthewbaird/ontology/ent/lease"
 	"github.com/matthewbaird/ontology/ent/leasespace"
+	"github.com/matthewbaird/ontology/ent/ledgerentry"
+	"github.com/matthewbaird/ontology/ent/personrole"
+	"github.com/matthewbaird/ontology/ent/property"
 	"github.com/matthewbaird/ontology/ent/schema"
 	"github.com/matthewbaird/ontology/internal/types"
 )
@@ -25,17 +29,29 @@ var (
 // LeaseHandler implements HTTP handlers for LeaseService entities.
 type LeaseHandler struct {
 	client *ent.Client
+	audit  AuditRecorder
 }
 
-// NewLeaseHandler creates a new LeaseHandler.
-func NewLeaseHandler(client *ent.Client) *LeaseHandler {
-	return &LeaseHandler{client: client}
+// NewLeaseHandler creates a new LeaseHandler. Mutations append to audit when it is
+// non-nil; a nil recorder keeps no audit trail.
+func NewLeaseHandler(client *ent.Client, audit AuditRecorder) *LeaseHandler {
+	return &LeaseHandler{client: client, audit: audit}
 }
 
 // ============================================================================
 // Lease
 // ============================================================================
 
+// Accepted values of the Lease enum fields.
+var (
+	leaseLeaseTypeValues       = []string{"fixed_term", "month_to_month", "commercial_nnn", "commercial_nn", "commercial_n", "commercial_gross", "commercial_modified_gross", "affordable", "section_8", "student", "ground_lease", "short_term", "membership"}
+	leaseStatusValues          = []string{"draft", "pending_approval", "pending_signature", "active", "expired", "month_to_month_holdover", "renewed", "terminated", "eviction"}
+	leaseLiabilityTypeValues   = []string{"joint_and_several", "individual", "by_the_bed", "proportional"}
+	leaseMembershipTierValues  = []string{"hot_desk", "dedicated_desk", "office", "suite", "virtual"}
+	leaseSubleaseBillingValues = []string{"through_master_tenant", "direct_to_landlord"}
+	leaseSigningMethodValues   = []string{"electronic", "wet_ink", "both"}
+)
+
 type createLeaseRequest struct {
 	PropertyID                 string                    `json:"property_id"`
 	TenantRoleIds              []string                  `json:"tenant_role_ids"`
@@ -65,7 +81,7 @@ type createLeaseRequest struct {
 	MoveInDate                 *time.Time                `json:"move_in_date,omitempty"`
 	MoveOutDate                *time.Time                `json:"move_out_date,omitempty"`
 	NoticeDate                 *time.Time                `json:"notice_date,omitempty"`
-	NoticeRequiredDays         int                       `json:"notice_required_days"`
+	NoticeRequiredDays         *int                      `json:"notice_required_days,omitempty"`
 	CheckInTime                *string                   `json:"check_in_time,omitempty"`
 	CheckOutTime               *string                   `json:"check_out_time,omitempty"`
 	CleaningFeeAmountCents     *int64                    `json:"cleaning_fee_amount_cents,omitempty"`
@@ -80,17 +96,50 @@ type createLeaseRequest struct {
 	ParentLeaseID              *string                   `json:"parent_lease_id,omitempty"`
 }
 
-func (h *LeaseHandler) CreateLease(w http.ResponseWriter, r *http.Request) {
-	audit, ok := parseAuditContext(w, r)
-	if !ok {
-		return
+func validateLeaseCreate(req *createLeaseRequest) fieldErrors {
+	errs := fieldErrors{}
+	errs.add(checkRequired("property_id", req.PropertyID == ""))
+	errs.add(checkRequired("tenant_role_ids", req.TenantRoleIds == nil))
+	errs.add(checkRequired("lease_type", req.LeaseType == ""))
+	errs.add(checkEnum("lease_type", req.LeaseType, leaseLeaseTypeValues))
+	errs.add(checkRequired("status", req.Status == ""))
+	errs.add(checkEnum("status", req.Status, leaseStatusValues))
+	if req.LiabilityType != "" {
+		errs.add(checkEnum("liability_type", req.LiabilityType, leaseLiabilityTypeValues))
 	}
-	var req createLeaseRequest
-	if err := decodeJSON(r, &req); err != nil {
-		writeError(w, http.StatusBadRequest, "INVALID_JSON", err.Error())
-		return
+	if req.BaseRentCurrency != "" {
+		errs.add(checkCurrency("base_rent_currency", req.BaseRentCurrency))
 	}
-	builder := h.client.Lease.Create()
+	errs.add(checkMin("base_rent_amount_cents", float64(req.BaseRentAmountCents), 0, false))
+	if req.SecurityDepositCurrency != "" {
+		errs.add(checkCurrency("security_deposit_currency", req.SecurityDepositCurrency))
+	}
+	errs.add(checkMin("security_deposit_amount_cents", float64(req.SecurityDepositAmountCents), 0, false))
+	if req.NoticeRequiredDays != nil {
+		errs.add(checkMin("notice_required_days", float64(*req.NoticeRequiredDays), 0, false))
+	}
+	if req.CleaningFeeCurrency != nil {
+		errs.add(checkCurrency("cleaning_fee_currency", *req.CleaningFeeCurrency))
+	}
+	if req.CleaningFeeAmountCents != nil {
+		errs.add(checkMin("cleaning_fee_amount_cents", float64(*req.CleaningFeeAmountCents), 0, false))
+	}
+	if req.MembershipTier != nil {
+		errs.add(checkEnum("membership_tier", *req.MembershipTier, leaseMembershipTierValues))
+	}
+	if req.SubleaseBilling != "" {
+		errs.add(checkEnum("sublease_billing", req.SubleaseBilling, leaseSubleaseBillingValues))
+	}
+	if req.SigningMethod != nil {
+		errs.add(checkEnum("signing_method", *req.SigningMethod, leaseSigningMethodValues))
+	}
+	if req.ParentLeaseID != nil {
+		errs.add(checkID("parent_lease_id", *req.ParentLeaseID))
+	}
+	return errs
+}
+
+func (req *createLeaseRequest) apply(builder *ent.LeaseCreate) {
 	builder.SetPropertyID(req.PropertyID)
 	builder.SetTenantRoleIds(req.TenantRoleIds)
 	if len(req.GuarantorRoleIds) > 0 {
@@ -161,7 +210,9 @@ func (h *LeaseHandler) CreateLease(w http.ResponseWriter, r *http.Request) {
 	if req.NoticeDate != nil {
 		builder.SetNillableNoticeDate(req.NoticeDate)
 	}
-	builder.SetNoticeRequiredDays(req.NoticeRequiredDays)
+	if req.NoticeRequiredDays != nil {
+		builder.SetNillableNoticeRequiredDays(req.NoticeRequiredDays)
+	}
 	if req.CheckInTime != nil {
 		builder.SetNillableCheckInTime(req.CheckInTime)
 	}
@@ -172,7 +223,7 @@ func (h *LeaseHandler) CreateLease(w http.ResponseWriter, r *http.Request) {
 		builder.SetNillableCleaningFeeAmountCents(req.CleaningFeeAmountCents)
 	}
 	if req.CleaningFeeCurrency != nil {
-		builder.SetNillableCleaningFeeCurrency(req.CleaningFeeCurrency)
+		builder.SetCleaningFeeCurrency(*req.CleaningFeeCurrency)
 	}
 	if req.PlatformBookingID != nil {
 		builder.SetNillablePlatformBookingID(req.PlatformBookingID)
@@ -194,18 +245,33 @@ func (h *LeaseHandler) CreateLease(w http.ResponseWriter, r *http.Request) {
 		builder.SetNillableDocumentID(req.DocumentID)
 	}
 	if req.ParentLeaseID != nil {
-		uid, err := uuid.Parse(*req.ParentLeaseID)
-		if err != nil {
-			writeError(w, http.StatusBadRequest, "INVALID_ID", "invalid parent_lease_id")
-			return
-		}
-		builder.SetParentLeaseID(uid)
+		builder.SetParentLeaseID(uuid.MustParse(*req.ParentLeaseID))
+	}
+}
+
+func (h *LeaseHandler) CreateLease(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), handlerTimeout)
+	defer cancel()
+	audit, ok := parseAuditContext(w, r)
+	if !ok {
+		return
+	}
+	var req createLeaseRequest
+	if err := decodeJSON(r, &req); err != nil {
+		writeError(w, http.StatusBadRequest, "INVALID_JSON", err.Error())
+		return
+	}
+	if errs := validateLeaseCreate(&req); len(errs) > 0 {
+		writeFieldErrors(w, errs)
+		return
 	}
+	builder := h.client.Lease.Create()
+	req.apply(builder)
 	builder.SetCreatedBy(audit.Actor).SetUpdatedBy(audit.Actor).SetSource(lease.Source(audit.Source))
 	if audit.CorrelationID != nil {
 		builder.SetCorrelationID(*audit.CorrelationID)
 	}
-	result, err := builder.Save(r.Context())
+	result, err := saveAudited[*ent.Lease](ctx, h.client, h.audit, AuditOpCreate, audit, builder.Mutation())
 	if err != nil {
 		entErrorToHTTP(w, err)
 		return
@@ -213,30 +279,267 @@ func (h *LeaseHandler) CreateLease(w http.ResponseWriter, r *http.Request) {
 	writeJSON(w, http.StatusCreated, result)
 }
 
+// leaseIncludes maps ?include= edge names to eager loaders on a Lease query.
+var leaseIncludes = map[string]func(*ent.LeaseQuery){
+	"lease_spaces":    func(q *ent.LeaseQuery) { q.WithLeaseSpaces() },
+	"tenant_roles":    func(q *ent.LeaseQuery) { q.WithTenantRoles() },
+	"guarantor_roles": func(q *ent.LeaseQuery) { q.WithGuarantorRoles() },
+	"ledger_entries":  func(q *ent.LeaseQuery) { q.WithLedgerEntries() },
+	"application":     func(q *ent.LeaseQuery) { q.WithApplication() },
+	"subleases":       func(q *ent.LeaseQuery) { q.WithSubleases() },
+	"parent_lease":    func(q *ent.LeaseQuery) { q.WithParentLease() },
+}
+
 func (h *LeaseHandler) GetLease(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), handlerTimeout)
+	defer cancel()
 	id, ok := parseUUID(w, r, "id")
 	if !ok {
 		return
 	}
-	result, err := h.client.Lease.Get(r.Context(), id)
+	query := h.client.Lease.Query().Where(lease.ID(id))
+	withIncludes(r, query, leaseIncludes)
+	result, err := query.Only(ctx)
 	if err != nil {
 		entErrorToHTTP(w, err)
 		return
 	}
+	setETag(w, entityETag(result.UpdatedAt))
 	writeJSON(w, http.StatusOK, result)
 }
 
+func (h *LeaseHandler) ListLeaseSpacesOfLease(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), handlerTimeout)
+	defer cancel()
+	id, ok := parseUUID(w, r, "id")
+	if !ok {
+		return
+	}
+	parent, err := h.client.Lease.Get(ctx, id)
+	if err != nil {
+		entErrorToHTTP(w, err)
+		return
+	}
+	pg := parsePagination(r)
+	query := h.client.Lease.QueryLeaseSpaces(parent)
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		entErrorToHTTP(w, err)
+		return
+	}
+	items, err := query.
+		Limit(pg.Limit).Offset(pg.Offset).
+		Order(listOrder(r, nil, leasespace.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		entErrorToHTTP(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, listResponse{Data: items, Total: total, Offset: pg.Offset, Limit: pg.Limit})
+}
+
+func (h *LeaseHandler) ListTenantRolesOfLease(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), handlerTimeout)
+	defer cancel()
+	id, ok := parseUUID(w, r, "id")
+	if !ok {
+		return
+	}
+	parent, err := h.client.Lease.Get(ctx, id)
+	if err != nil {
+		entErrorToHTTP(w, err)
+		return
+	}
+	pg := parsePagination(r)
+	query := h.client.Lease.QueryTenantRoles(parent)
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		entErrorToHTTP(w, err)
+		return
+	}
+	items, err := query.
+		Limit(pg.Limit).Offset(pg.Offset).
+		Order(listOrder(r, nil, personrole.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		entErrorToHTTP(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, listResponse{Data: items, Total: total, Offset: pg.Offset, Limit: pg.Limit})
+}
+
+func (h *LeaseHandler) ListGuarantorRolesOfLease(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), handlerTimeout)
+	defer cancel()
+	id, ok := parseUUID(w, r, "id")
+	if !ok {
+		return
+	}
+	parent, err := h.client.Lease.Get(ctx, id)
+	if err != nil {
+		entErrorToHTTP(w, err)
+		return
+	}
+	pg := parsePagination(r)
+	query := h.client.Lease.QueryGuarantorRoles(parent)
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		entErrorToHTTP(w, err)
+		return
+	}
+	items, err := query.
+		Limit(pg.Limit).Offset(pg.Offset).
+		Order(listOrder(r, nil, personrole.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		entErrorToHTTP(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, listResponse{Data: items, Total: total, Offset: pg.Offset, Limit: pg.Limit})
+}
+
+func (h *LeaseHandler) ListLedgerEntriesOfLease(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), handlerTimeout)
+	defer cancel()
+	id, ok := parseUUID(w, r, "id")
+	if !ok {
+		return
+	}
+	parent, err := h.client.Lease.Get(ctx, id)
+	if err != nil {
+		entErrorToHTTP(w, err)
+		return
+	}
+	pg := parsePagination(r)
+	query := h.client.Lease.QueryLedgerEntries(parent)
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		entErrorToHTTP(w, err)
+		return
+	}
+	items, err := query.
+		Limit(pg.Limit).Offset(pg.Offset).
+		Order(listOrder(r, nil, ledgerentry.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		entErrorToHTTP(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, listResponse{Data: items, Total: total, Offset: pg.Offset, Limit: pg.Limit})
+}
+
+func (h *LeaseHandler) ListSubleasesOfLease(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), handlerTimeout)
+	defer cancel()
+	id, ok := parseUUID(w, r, "id")
+	if !ok {
+		return
+	}
+	parent, err := h.client.Lease.Get(ctx, id)
+	if err != nil {
+		entErrorToHTTP(w, err)
+		return
+	}
+	pg := parsePagination(r)
+	query := h.client.Lease.QuerySubleases(parent)
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		entErrorToHTTP(w, err)
+		return
+	}
+	items, err := query.
+		Limit(pg.Limit).Offset(pg.Offset).
+		Order(listOrder(r, nil, lease.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		entErrorToHTTP(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, listResponse{Data: items, Total: total, Offset: pg.Offset, Limit: pg.Limit})
+}
+
+func (h *LeaseHandler) CheckLeaseExists(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), handlerTimeout)
+	defer cancel()
+	id, ok := parseUUID(w, r, "id")
+	if !ok {
+		return
+	}
+	exists, err := h.client.Lease.Query().Where(lease.ID(id)).Exist(ctx)
+	if err != nil {
+		entErrorToHTTP(w, err)
+		return
+	}
+	if !exists {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// leaseSortColumns allowlists the ?sort= values accepted by ListLeases.
+var leaseSortColumns = map[string]string{
+	"created_at":              lease.FieldCreatedAt,
+	"updated_at":              lease.FieldUpdatedAt,
+	"property_id":             lease.FieldPropertyID,
+	"lease_type":              lease.FieldLeaseType,
+	"status":                  lease.FieldStatus,
+	"description":             lease.FieldDescription,
+	"liability_type":          lease.FieldLiabilityType,
+	"lease_commencement_date": lease.FieldLeaseCommencementDate,
+	"rent_commencement_date":  lease.FieldRentCommencementDate,
+	"move_in_date":            lease.FieldMoveInDate,
+	"move_out_date":           lease.FieldMoveOutDate,
+	"notice_date":             lease.FieldNoticeDate,
+	"notice_required_days":    lease.FieldNoticeRequiredDays,
+	"check_in_time":           lease.FieldCheckInTime,
+	"check_out_time":          lease.FieldCheckOutTime,
+	"platform_booking_id":     lease.FieldPlatformBookingID,
+	"membership_tier":         lease.FieldMembershipTier,
+	"is_sublease":             lease.FieldIsSublease,
+	"sublease_billing":        lease.FieldSubleaseBilling,
+	"signing_method":          lease.FieldSigningMethod,
+	"signed_at":               lease.FieldSignedAt,
+	"document_id":             lease.FieldDocumentID,
+}
+
 func (h *LeaseHandler) ListLeases(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), handlerTimeout)
+	defer cancel()
 	pg := parsePagination(r)
-	items, err := h.client.Lease.Query().
+	query := h.client.Lease.Query()
+	if v := r.URL.Query().Get("property_id"); v != "" {
+		query.Where(lease.PropertyIDEQ(v))
+	}
+	if v := r.URL.Query().Get("lease_type"); v != "" {
+		if err := lease.LeaseTypeValidator(lease.LeaseType(v)); err != nil {
+			writeError(w, http.StatusBadRequest, "INVALID_FILTER", err.Error())
+			return
+		}
+		query.Where(lease.LeaseTypeEQ(lease.LeaseType(v)))
+	}
+	if v := r.URL.Query().Get("status"); v != "" {
+		if err := lease.StatusValidator(lease.Status(v)); err != nil {
+			writeError(w, http.StatusBadRequest, "INVALID_FILTER", err.Error())
+			return
+		}
+		query.Where(lease.StatusEQ(lease.Status(v)))
+	}
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		entErrorToHTTP(w, err)
+		return
+	}
+	withIncludes(r, query, leaseIncludes)
+	items, err := query.
 		Limit(pg.Limit).Offset(pg.Offset).
-		Order(ent.Desc(lease.FieldCreatedAt)).
-		All(r.Context())
+		Order(listOrder(r, leaseSortColumns, lease.FieldCreatedAt)).
+		All(ctx)
 	if err != nil {
 		entErrorToHTTP(w, err)
 		return
 	}
-	writeJSON(w, http.StatusOK, items)
+	writeJSON(w, http.StatusOK, listResponse{Data: items, Total: total, Offset: pg.Offset, Limit: pg.Limit})
 }
 
 type updateLeaseRequest struct {
@@ -283,7 +586,59 @@ type updateLeaseRequest struct {
 	ParentLeaseID              *string                   `json:"parent_lease_id,omitempty"`
 }
 
+func validateLeaseUpdate(req *updateLeaseRequest) fieldErrors {
+	errs := fieldErrors{}
+	if req.PropertyID != nil {
+		errs.add(checkRequired("property_id", *req.PropertyID == ""))
+	}
+	if req.LeaseType != nil {
+		errs.add(checkEnum("lease_type", *req.LeaseType, leaseLeaseTypeValues))
+	}
+	if req.Status != nil {
+		errs.add(checkEnum("status", *req.Status, leaseStatusValues))
+	}
+	if req.LiabilityType != nil {
+		errs.add(checkEnum("liability_type", *req.LiabilityType, leaseLiabilityTypeValues))
+	}
+	if req.BaseRentCurrency != nil {
+		errs.add(checkCurrency("base_rent_currency", *req.BaseRentCurrency))
+	}
+	if req.BaseRentAmountCents != nil {
+		errs.add(checkMin("base_rent_amount_cents", float64(*req.BaseRentAmountCents), 0, false))
+	}
+	if req.SecurityDepositCurrency != nil {
+		errs.add(checkCurrency("security_deposit_currency", *req.SecurityDepositCurrency))
+	}
+	if req.SecurityDepositAmountCents != nil {
+		errs.add(checkMin("security_deposit_amount_cents", float64(*req.SecurityDepositAmountCents), 0, false))
+	}
+	if req.NoticeRequiredDays != nil {
+		errs.add(checkMin("notice_required_days", float64(*req.NoticeRequiredDays), 0, false))
+	}
+	if req.CleaningFeeCurrency != nil {
+		errs.add(checkCurrency("cleaning_fee_currency", *req.CleaningFeeCurrency))
+	}
+	if req.CleaningFeeAmountCents != nil {
+		errs.add(checkMin("cleaning_fee_amount_cents", float64(*req.CleaningFeeAmountCents), 0, false))
+	}
+	if req.MembershipTier != nil {
+		errs.add(checkEnum("membership_tier", *req.MembershipTier, leaseMembershipTierValues))
+	}
+	if req.SubleaseBilling != nil {
+		errs.add(checkEnum("sublease_billing", *req.SubleaseBilling, leaseSubleaseBillingValues))
+	}
+	if req.SigningMethod != nil {
+		errs.add(checkEnum("signing_method", *req.SigningMethod, leaseSigningMethodValues))
+	}
+	if req.ParentLeaseID != nil {
+		errs.add(checkID("parent_lease_id", *req.ParentLeaseID))
+	}
+	return errs
+}
+
 func (h *LeaseHandler) UpdateLease(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), handlerTimeout)
+	defer cancel()
 	id, ok := parseUUID(w, r, "id")
 	if !ok {
 		return
@@ -293,11 +648,62 @@ func (h *LeaseHandler) UpdateLease(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 	var req updateLeaseRequest
-	if err := decodeJSON(r, &req); err != nil {
+	patch, err := decodeUpdate(r, &req)
+	if err != nil {
 		writeError(w, http.StatusBadRequest, "INVALID_JSON", err.Error())
 		return
 	}
+	if field := patch.firstNull("property_id", "tenant_role_ids", "lease_type", "status", "liability_type", "term", "base_rent_amount_cents", "base_rent_currency", "security_deposit_amount_cents", "security_deposit_currency", "notice_required_days", "is_sublease", "sublease_billing"); field != "" {
+		writeError(w, http.StatusBadRequest, "INVALID_PATCH", field+" cannot be null")
+		return
+	}
+	if patch.hasObject("term", "late_fee_policy", "cam_terms", "tenant_improvement", "percentage_rent", "subsidy") {
+		stored, err := h.client.Lease.Get(ctx, id)
+		if err != nil {
+			entErrorToHTTP(w, err)
+			return
+		}
+		if req.Term, err = mergeObject(patch, "term", stored.Term, req.Term); err != nil {
+			writeError(w, http.StatusBadRequest, "INVALID_JSON", err.Error())
+			return
+		}
+		if req.LateFeePolicy, err = mergeObject(patch, "late_fee_policy", stored.LateFeePolicy, req.LateFeePolicy); err != nil {
+			writeError(w, http.StatusBadRequest, "INVALID_JSON", err.Error())
+			return
+		}
+		if req.CamTerms, err = mergeObject(patch, "cam_terms", stored.CamTerms, req.CamTerms); err != nil {
+			writeError(w, http.StatusBadRequest, "INVALID_JSON", err.Error())
+			return
+		}
+		if req.TenantImprovement, err = mergeObject(patch, "tenant_improvement", stored.TenantImprovement, req.TenantImprovement); err != nil {
+			writeError(w, http.StatusBadRequest, "INVALID_JSON", err.Error())
+			return
+		}
+		if req.PercentageRent, err = mergeObject(patch, "percentage_rent", stored.PercentageRent, req.PercentageRent); err != nil {
+			writeError(w, http.StatusBadRequest, "INVALID_JSON", err.Error())
+			return
+		}
+		if req.Subsidy, err = mergeObject(patch, "subsidy", stored.Subsidy, req.Subsidy); err != nil {
+			writeError(w, http.StatusBadRequest, "INVALID_JSON", err.Error())
+			return
+		}
+	}
+	if errs := validateLeaseUpdate(&req); len(errs) > 0 {
+		writeFieldErrors(w, errs)
+		return
+	}
 	builder := h.client.Lease.UpdateOneID(id)
+	if hasIfMatch(r) {
+		current, err := h.client.Lease.Get(ctx, id)
+		if err != nil {
+			entErrorToHTTP(w, err)
+			return
+		}
+		if !checkIfMatch(w, r, entityETag(current.UpdatedAt)) {
+			return
+		}
+		builder.Where(lease.UpdatedAtEQ(current.UpdatedAt))
+	}
 	if req.PropertyID != nil {
 		builder.SetPropertyID(*req.PropertyID)
 	}
@@ -419,26 +825,108 @@ func (h *LeaseHandler) UpdateLease(w http.ResponseWriter, r *http.Request) {
 		builder.SetNillableDocumentID(req.DocumentID)
 	}
 	if req.ParentLeaseID != nil {
-		uid, err := uuid.Parse(*req.ParentLeaseID)
-		if err != nil {
-			writeError(w, http.StatusBadRequest, "INVALID_ID", "invalid parent_lease_id")
-			return
-		}
-		builder.SetParentLeaseID(uid)
+		builder.SetParentLeaseID(uuid.MustParse(*req.ParentLeaseID))
+	}
+	if patch.isNull("guarantor_role_ids") {
+		builder.ClearGuarantorRoleIds()
+	}
+	if patch.isNull("description") {
+		builder.ClearDescription()
+	}
+	if patch.isNull("lease_commencement_date") {
+		builder.ClearLeaseCommencementDate()
+	}
+	if patch.isNull("rent_commencement_date") {
+		builder.ClearRentCommencementDate()
+	}
+	if patch.isNull("rent_schedule") {
+		builder.ClearRentSchedule()
+	}
+	if patch.isNull("recurring_charges") {
+		builder.ClearRecurringCharges()
+	}
+	if patch.isNull("late_fee_policy") {
+		builder.ClearLateFeePolicy()
+	}
+	if patch.isNull("cam_terms") {
+		builder.ClearCamTerms()
+	}
+	if patch.isNull("tenant_improvement") {
+		builder.ClearTenantImprovement()
+	}
+	if patch.isNull("renewal_options") {
+		builder.ClearRenewalOptions()
+	}
+	if patch.isNull("usage_charges") {
+		builder.ClearUsageCharges()
+	}
+	if patch.isNull("percentage_rent") {
+		builder.ClearPercentageRent()
+	}
+	if patch.isNull("expansion_rights") {
+		builder.ClearExpansionRights()
+	}
+	if patch.isNull("contraction_rights") {
+		builder.ClearContractionRights()
+	}
+	if patch.isNull("subsidy") {
+		builder.ClearSubsidy()
+	}
+	if patch.isNull("move_in_date") {
+		builder.ClearMoveInDate()
+	}
+	if patch.isNull("move_out_date") {
+		builder.ClearMoveOutDate()
+	}
+	if patch.isNull("notice_date") {
+		builder.ClearNoticeDate()
+	}
+	if patch.isNull("check_in_time") {
+		builder.ClearCheckInTime()
+	}
+	if patch.isNull("check_out_time") {
+		builder.ClearCheckOutTime()
+	}
+	if patch.isNull("cleaning_fee_amount_cents") {
+		builder.ClearCleaningFeeAmountCents()
+	}
+	if patch.isNull("cleaning_fee_currency") {
+		builder.ClearCleaningFeeCurrency()
+	}
+	if patch.isNull("platform_booking_id") {
+		builder.ClearPlatformBookingID()
+	}
+	if patch.isNull("membership_tier") {
+		builder.ClearMembershipTier()
+	}
+	if patch.isNull("signing_method") {
+		builder.ClearSigningMethod()
+	}
+	if patch.isNull("signed_at") {
+		builder.ClearSignedAt()
+	}
+	if patch.isNull("document_id") {
+		builder.ClearDocumentID()
+	}
+	if patch.isNull("parent_lease_id") {
+		builder.ClearParentLease()
 	}
 	builder.SetUpdatedBy(audit.Actor).SetSource(lease.Source(audit.Source))
 	if audit.CorrelationID != nil {
 		builder.SetCorrelationID(*audit.CorrelationID)
 	}
-	result, err := builder.Save(r.Context())
+	result, err := saveAudited[*ent.Lease](ctx, h.client, h.audit, AuditOpUpdate, audit, builder.Mutation())
 	if err != nil {
-		entErrorToHTTP(w, err)
+		writeGuardedSaveError(w, r, err)
 		return
 	}
+	setETag(w, entityETag(result.UpdatedAt))
 	writeJSON(w, http.StatusOK, result)
 }
 
 func (h *LeaseHandler) transitionLease(w http.ResponseWriter, r *http.Request, targetStatus string, applyExtra func(*ent.LeaseUpdateOne)) {
+	ctx, cancel := context.WithTimeout(r.Context(), handlerTimeout)
+	defer cancel()
 	id, ok := parseUUID(w, r, "id")
 	if !ok {
 		return
@@ -447,13 +935,12 @@ func (h *LeaseHandler) transitionLease(w http.ResponseWriter, r *http.Request, t
 	if !ok {
 		return
 	}
-	current, err := h.client.Lease.Get(r.Context(), id)
+	current, err := h.client.Lease.Get(ctx, id)
 	if err != nil {
 		entErrorToHTTP(w, err)
 		return
 	}
-	if err := ValidateTransition(schema.ValidLeaseTransitions, string(current.Status), targetStatus); err != nil {
-		writeError(w, http.StatusConflict, "INVALID_TRANSITION", err.Error())
+	if !checkIfMatch(w, r, entityETag(current.UpdatedAt)) {
 		return
 	}
 	builder := h.client.Lease.UpdateOneID(id).
@@ -463,14 +950,22 @@ func (h *LeaseHandler) transitionLease(w http.ResponseWriter, r *http.Request, t
 	if audit.CorrelationID != nil {
 		builder.SetCorrelationID(*audit.CorrelationID)
 	}
+	if hasIfMatch(r) {
+		builder.Where(lease.UpdatedAtEQ(current.UpdatedAt))
+	}
 	if applyExtra != nil {
 		applyExtra(builder)
 	}
-	updated, err := builder.Save(r.Context())
+	if err := schema.ValidateTransitionWithGuard(schema.ValidLeaseTransitions, schema.LeaseTransitionGuards, string(current.Status), targetStatus, guardValues(ctx, builder.Mutation())); err != nil {
+		writeError(w, http.StatusConflict, "INVALID_TRANSITION", err.Error())
+		return
+	}
+	updated, err := saveAudited[*ent.Lease](ctx, h.client, h.audit, AuditOpTransition, audit, builder.Mutation())
 	if err != nil {
-		entErrorToHTTP(w, err)
+		writeGuardedSaveError(w, r, err)
 		return
 	}
+	setETag(w, entityETag(updated.UpdatedAt))
 	writeJSON(w, http.StatusOK, updated)
 }
 
@@ -486,11 +981,19 @@ func (h *LeaseHandler) ActivateLease(w http.ResponseWriter, r *http.Request) {
 	type extraFields struct {
 		MoveInDate *time.Time `json:"move_in_date,omitempty"`
 	}
-	var extra extraFields
-	_ = decodeJSON(r, &extra)
-	h.transitionLease(w, r, "active", func(b *ent.LeaseUpdateOne) {
-		if extra.MoveInDate != nil {
-			b.SetNillableMoveInDate(extra.MoveInDate)
+	var req extraFields
+	if err := decodeOptionalJSON(r, &req); err != nil {
+		writeError(w, http.StatusBadRequest, "INVALID_JSON", err.Error())
+		return
+	}
+	errs := fieldErrors{}
+	if len(errs) > 0 {
+		writeFieldErrors(w, errs)
+		return
+	}
+	h.transitionLease(w, r, "active", func(builder *ent.LeaseUpdateOne) {
+		if req.MoveInDate != nil {
+			builder.SetNillableMoveInDate(req.MoveInDate)
 		}
 	})
 }
@@ -500,11 +1003,19 @@ func (h *LeaseHandler) TerminateLease(w http.ResponseWriter, r *http.Request) {
 		Reason      string     `json:"reason,omitempty"`
 		MoveOutDate *time.Time `json:"move_out_date,omitempty"`
 	}
-	var extra extraFields
-	_ = decodeJSON(r, &extra)
-	h.transitionLease(w, r, "terminated", func(b *ent.LeaseUpdateOne) {
-		if extra.MoveOutDate != nil {
-			b.SetNillableMoveOutDate(extra.MoveOutDate)
+	var req extraFields
+	if err := decodeOptionalJSON(r, &req); err != nil {
+		writeError(w, http.StatusBadRequest, "INVALID_JSON", err.Error())
+		return
+	}
+	errs := fieldErrors{}
+	if len(errs) > 0 {
+		writeFieldErrors(w, errs)
+		return
+	}
+	h.transitionLease(w, r, "terminated", func(builder *ent.LeaseUpdateOne) {
+		if req.MoveOutDate != nil {
+			builder.SetNillableMoveOutDate(req.MoveOutDate)
 		}
 	})
 }
@@ -521,6 +1032,11 @@ func (h *LeaseHandler) InitiateEviction(w http.ResponseWriter, r *http.Request)
 // LeaseSpace
 // ============================================================================
 
+// Accepted values of the LeaseSpace enum fields.
+var (
+	leaseSpaceRelationshipValues = []string{"primary", "expansion", "sublease", "shared_access", "parking", "storage", "loading_dock", "rooftop", "patio", "signage", "included", "membership"}
+)
+
 type createLeaseSpaceRequest struct {
 	IsPrimary           bool            `json:"is_primary"`
 	Relationship        string          `json:"relationship"`
@@ -530,7 +1046,34 @@ type createLeaseSpaceRequest struct {
 	SpaceID             string          `json:"space_id"`
 }
 
+func validateLeaseSpaceCreate(req *createLeaseSpaceRequest) fieldErrors {
+	errs := fieldErrors{}
+	errs.add(checkRequired("relationship", req.Relationship == ""))
+	errs.add(checkEnum("relationship", req.Relationship, leaseSpaceRelationshipValues))
+	if req.SquareFootageLeased != nil {
+		errs.add(checkMin("square_footage_leased", float64(*req.SquareFootageLeased), 0, true))
+	}
+	errs.add(checkRequired("lease_id", req.LeaseID == ""))
+	errs.add(checkID("lease_id", req.LeaseID))
+	errs.add(checkRequired("space_id", req.SpaceID == ""))
+	errs.add(checkID("space_id", req.SpaceID))
+	return errs
+}
+
+func (req *createLeaseSpaceRequest) apply(builder *ent.LeaseSpaceCreate) {
+	builder.SetIsPrimary(req.IsPrimary)
+	builder.SetRelationship(leasespace.Relationship(req.Relationship))
+	builder.SetEffective(&req.Effective)
+	if req.SquareFootageLeased != nil {
+		builder.SetNillableSquareFootageLeased(req.SquareFootageLeased)
+	}
+	builder.SetLeaseID(uuid.MustParse(req.LeaseID))
+	builder.SetSpaceID(uuid.MustParse(req.SpaceID))
+}
+
 func (h *LeaseHandler) CreateLeaseSpace(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), handlerTimeout)
+	defer cancel()
 	audit, ok := parseAuditContext(w, r)
 	if !ok {
 		return
@@ -540,34 +1083,17 @@ func (h *LeaseHandler) CreateLeaseSpace(w http.ResponseWriter, r *http.Request)
 		writeError(w, http.StatusBadRequest, "INVALID_JSON", err.Error())
 		return
 	}
-	builder := h.client.LeaseSpace.Create()
-	builder.SetIsPrimary(req.IsPrimary)
-	builder.SetRelationship(leasespace.Relationship(req.Relationship))
-	builder.SetEffective(&req.Effective)
-	if req.SquareFootageLeased != nil {
-		builder.SetNillableSquareFootageLeased(req.SquareFootageLeased)
-	}
-	{
-		uid, err := uuid.Parse(req.LeaseID)
-		if err != nil {
-			writeError(w, http.StatusBadRequest, "INVALID_ID", "invalid lease_id")
-			return
-		}
-		builder.SetLeaseID(uid)
-	}
-	{
-		uid, err := uuid.Parse(req.SpaceID)
-		if err != nil {
-			writeError(w, http.StatusBadRequest, "INVALID_ID", "invalid space_id")
-			return
-		}
-		builder.SetSpaceID(uid)
+	if errs := validateLeaseSpaceCreate(&req); len(errs) > 0 {
+		writeFieldErrors(w, errs)
+		return
 	}
+	builder := h.client.LeaseSpace.Create()
+	req.apply(builder)
 	builder.SetCreatedBy(audit.Actor).SetUpdatedBy(audit.Actor).SetSource(leasespace.Source(audit.Source))
 	if audit.CorrelationID != nil {
 		builder.SetCorrelationID(*audit.CorrelationID)
 	}
-	result, err := builder.Save(r.Context())
+	result, err := saveAudited[*ent.LeaseSpace](ctx, h.client, h.audit, AuditOpCreate, audit, builder.Mutation())
 	if err != nil {
 		entErrorToHTTP(w, err)
 		return
@@ -575,30 +1101,78 @@ func (h *LeaseHandler) CreateLeaseSpace(w http.ResponseWriter, r *http.Request)
 	writeJSON(w, http.StatusCreated, result)
 }
 
+// leaseSpaceIncludes maps ?include= edge names to eager loaders on a LeaseSpace query.
+var leaseSpaceIncludes = map[string]func(*ent.LeaseSpaceQuery){
+	"lease": func(q *ent.LeaseSpaceQuery) { q.WithLease() },
+	"space": func(q *ent.LeaseSpaceQuery) { q.WithSpace() },
+}
+
 func (h *LeaseHandler) GetLeaseSpace(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), handlerTimeout)
+	defer cancel()
 	id, ok := parseUUID(w, r, "id")
 	if !ok {
 		return
 	}
-	result, err := h.client.LeaseSpace.Get(r.Context(), id)
+	query := h.client.LeaseSpace.Query().Where(leasespace.ID(id))
+	withIncludes(r, query, leaseSpaceIncludes)
+	result, err := query.Only(ctx)
 	if err != nil {
 		entErrorToHTTP(w, err)
 		return
 	}
+	setETag(w, entityETag(result.UpdatedAt))
 	writeJSON(w, http.StatusOK, result)
 }
 
+func (h *LeaseHandler) CheckLeaseSpaceExists(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), handlerTimeout)
+	defer cancel()
+	id, ok := parseUUID(w, r, "id")
+	if !ok {
+		return
+	}
+	exists, err := h.client.LeaseSpace.Query().Where(leasespace.ID(id)).Exist(ctx)
+	if err != nil {
+		entErrorToHTTP(w, err)
+		return
+	}
+	if !exists {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// leaseSpaceSortColumns allowlists the ?sort= values accepted by ListLeaseSpaces.
+var leaseSpaceSortColumns = map[string]string{
+	"created_at":            leasespace.FieldCreatedAt,
+	"updated_at":            leasespace.FieldUpdatedAt,
+	"is_primary":            leasespace.FieldIsPrimary,
+	"relationship":          leasespace.FieldRelationship,
+	"square_footage_leased": leasespace.FieldSquareFootageLeased,
+}
+
 func (h *LeaseHandler) ListLeaseSpaces(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), handlerTimeout)
+	defer cancel()
 	pg := parsePagination(r)
-	items, err := h.client.LeaseSpace.Query().
+	query := h.client.LeaseSpace.Query()
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		entErrorToHTTP(w, err)
+		return
+	}
+	withIncludes(r, query, leaseSpaceIncludes)
+	items, err := query.
 		Limit(pg.Limit).Offset(pg.Offset).
-		Order(ent.Desc(leasespace.FieldCreatedAt)).
-		All(r.Context())
+		Order(listOrder(r, leaseSpaceSortColumns, leasespace.FieldCreatedAt)).
+		All(ctx)
 	if err != nil {
 		entErrorToHTTP(w, err)
 		return
 	}
-	writeJSON(w, http.StatusOK, items)
+	writeJSON(w, http.StatusOK, listResponse{Data: items, Total: total, Offset: pg.Offset, Limit: pg.Limit})
 }
 
 type updateLeaseSpaceRequest struct {
@@ -610,7 +1184,26 @@ type updateLeaseSpaceRequest struct {
 	SpaceID             *string          `json:"space_id,omitempty"`
 }
 
+func validateLeaseSpaceUpdate(req *updateLeaseSpaceRequest) fieldErrors {
+	errs := fieldErrors{}
+	if req.Relationship != nil {
+		errs.add(checkEnum("relationship", *req.Relationship, leaseSpaceRelationshipValues))
+	}
+	if req.SquareFootageLeased != nil {
+		errs.add(checkMin("square_footage_leased", float64(*req.SquareFootageLeased), 0, true))
+	}
+	if req.LeaseID != nil {
+		errs.add(checkID("lease_id", *req.LeaseID))
+	}
+	if req.SpaceID != nil {
+		errs.add(checkID("space_id", *req.SpaceID))
+	}
+	return errs
+}
+
 func (h *LeaseHandler) UpdateLeaseSpace(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), handlerTimeout)
+	defer cancel()
 	id, ok := parseUUID(w, r, "id")
 	if !ok {
 		return
@@ -620,11 +1213,42 @@ func (h *LeaseHandler) UpdateLeaseSpace(w http.ResponseWriter, r *http.Request)
 		return
 	}
 	var req updateLeaseSpaceRequest
-	if err := decodeJSON(r, &req); err != nil {
+	patch, err := decodeUpdate(r, &req)
+	if err != nil {
 		writeError(w, http.StatusBadRequest, "INVALID_JSON", err.Error())
 		return
 	}
+	if field := patch.firstNull("is_primary", "relationship", "effective", "lease_id", "space_id"); field != "" {
+		writeError(w, http.StatusBadRequest, "INVALID_PATCH", field+" cannot be null")
+		return
+	}
+	if patch.hasObject("effective") {
+		stored, err := h.client.LeaseSpace.Get(ctx, id)
+		if err != nil {
+			entErrorToHTTP(w, err)
+			return
+		}
+		if req.Effective, err = mergeObject(patch, "effective", stored.Effective, req.Effective); err != nil {
+			writeError(w, http.StatusBadRequest, "INVALID_JSON", err.Error())
+			return
+		}
+	}
+	if errs := validateLeaseSpaceUpdate(&req); len(errs) > 0 {
+		writeFieldErrors(w, errs)
+		return
+	}
 	builder := h.client.LeaseSpace.UpdateOneID(id)
+	if hasIfMatch(r) {
+		current, err := h.client.LeaseSpace.Get(ctx, id)
+		if err != nil {
+			entErrorToHTTP(w, err)
+			return
+		}
+		if !checkIfMatch(w, r, entityETag(current.UpdatedAt)) {
+			return
+		}
+		builder.Where(leasespace.UpdatedAtEQ(current.UpdatedAt))
+	}
 	if req.IsPrimary != nil {
 		builder.SetIsPrimary(*req.IsPrimary)
 	}
@@ -638,30 +1262,24 @@ func (h *LeaseHandler) UpdateLeaseSpace(w http.ResponseWriter, r *http.Request)
 		builder.SetNillableSquareFootageLeased(req.SquareFootageLeased)
 	}
 	if req.LeaseID != nil {
-		uid, err := uuid.Parse(*req.LeaseID)
-		if err != nil {
-			writeError(w, http.StatusBadRequest, "INVALID_ID", "invalid lease_id")
-			return
-		}
-		builder.SetLeaseID(uid)
+		builder.SetLeaseID(uuid.MustParse(*req.LeaseID))
 	}
 	if req.SpaceID != nil {
-		uid, err := uuid.Parse(*req.SpaceID)
-		if err != nil {
-			writeError(w, http.StatusBadRequest, "INVALID_ID", "invalid space_id")
-			return
-		}
-		builder.SetSpaceID(uid)
+		builder.SetSpaceID(uuid.MustParse(*req.SpaceID))
+	}
+	if patch.isNull("square_footage_leased") {
+		builder.ClearSquareFootageLeased()
 	}
 	builder.SetUpdatedBy(audit.Actor).SetSource(leasespace.Source(audit.Source))
 	if audit.CorrelationID != nil {
 		builder.SetCorrelationID(*audit.CorrelationID)
 	}
-	result, err := builder.Save(r.Context())
+	result, err := saveAudited[*ent.LeaseSpace](ctx, h.client, h.audit, AuditOpUpdate, audit, builder.Mutation())
 	if err != nil {
-		entErrorToHTTP(w, err)
+		writeGuardedSaveError(w, r, err)
 		return
 	}
+	setETag(w, entityETag(result.UpdatedAt))
 	writeJSON(w, http.StatusOK, result)
 }
 
@@ -669,6 +1287,11 @@ func (h *LeaseHandler) UpdateLeaseSpace(w http.ResponseWriter, r *http.Request)
 // Application
 // ============================================================================
 
+// Accepted values of the Application enum fields.
+var (
+	applicationStatusValues = []string{"submitted", "screening", "under_review", "approved", "conditionally_approved", "denied", "withdrawn", "expired"}
+)
+
 type createApplicationRequest struct {
 	Status                    string     `json:"status"`
 	DesiredMoveIn             time.Time  `json:"desired_move_in"`
@@ -691,17 +1314,40 @@ type createApplicationRequest struct {
 	ApplicantPersonID         string     `json:"applicant_person_id"`
 }
 
-func (h *LeaseHandler) CreateApplication(w http.ResponseWriter, r *http.Request) {
-	audit, ok := parseAuditContext(w, r)
-	if !ok {
-		return
+func validateApplicationCreate(req *createApplicationRequest) fieldErrors {
+	errs := fieldErrors{}
+	errs.add(checkRequired("status", req.Status == ""))
+	errs.add(checkEnum("status", req.Status, applicationStatusValues))
+	errs.add(checkRequired("desired_move_in", req.DesiredMoveIn.IsZero()))
+	errs.add(checkMin("desired_lease_term_months", float64(req.DesiredLeaseTermMonths), 0, true))
+	if req.CreditScore != nil {
+		errs.add(checkMin("credit_score", float64(*req.CreditScore), 300, false))
+		errs.add(checkMax("credit_score", float64(*req.CreditScore), 850, false))
 	}
-	var req createApplicationRequest
-	if err := decodeJSON(r, &req); err != nil {
-		writeError(w, http.StatusBadRequest, "INVALID_JSON", err.Error())
-		return
+	if req.IncomeToRentRatio != nil {
+		errs.add(checkMin("income_to_rent_ratio", float64(*req.IncomeToRentRatio), 0, false))
 	}
-	builder := h.client.Application.Create()
+	if req.DecisionBy != nil {
+		errs.add(checkRequired("decision_by", *req.DecisionBy == ""))
+	}
+	if req.DecisionReason != nil {
+		errs.add(checkRequired("decision_reason", *req.DecisionReason == ""))
+	}
+	if req.ApplicationFeeCurrency != "" {
+		errs.add(checkCurrency("application_fee_currency", req.ApplicationFeeCurrency))
+	}
+	errs.add(checkMin("application_fee_amount_cents", float64(req.ApplicationFeeAmountCents), 0, false))
+	errs.add(checkRequired("property_id", req.PropertyID == ""))
+	errs.add(checkID("property_id", req.PropertyID))
+	if req.SpaceID != nil {
+		errs.add(checkID("space_id", *req.SpaceID))
+	}
+	errs.add(checkRequired("applicant_person_id", req.ApplicantPersonID == ""))
+	errs.add(checkID("applicant_person_id", req.ApplicantPersonID))
+	return errs
+}
+
+func (req *createApplicationRequest) apply(builder *ent.ApplicationCreate) {
 	builder.SetStatus(application.Status(req.Status))
 	builder.SetDesiredMoveIn(req.DesiredMoveIn)
 	builder.SetDesiredLeaseTermMonths(req.DesiredLeaseTermMonths)
@@ -736,35 +1382,36 @@ func (h *LeaseHandler) CreateApplication(w http.ResponseWriter, r *http.Request)
 		builder.SetApplicationFeeCurrency(req.ApplicationFeeCurrency)
 	}
 	builder.SetFeePaid(req.FeePaid)
-	{
-		uid, err := uuid.Parse(req.PropertyID)
-		if err != nil {
-			writeError(w, http.StatusBadRequest, "INVALID_ID", "invalid property_id")
-			return
-		}
-		builder.SetPropertyID(uid)
-	}
+	builder.SetPropertyID(uuid.MustParse(req.PropertyID))
 	if req.SpaceID != nil {
-		uid, err := uuid.Parse(*req.SpaceID)
-		if err != nil {
-			writeError(w, http.StatusBadRequest, "INVALID_ID", "invalid space_id")
-			return
-		}
-		builder.SetSpaceID(uid)
+		builder.SetSpaceID(uuid.MustParse(*req.SpaceID))
 	}
-	{
-		uid, err := uuid.Parse(req.ApplicantPersonID)
-		if err != nil {
-			writeError(w, http.StatusBadRequest, "INVALID_ID", "invalid applicant_person_id")
-			return
-		}
-		builder.SetApplicantID(uid)
+	builder.SetApplicantID(uuid.MustParse(req.ApplicantPersonID))
+}
+
+func (h *LeaseHandler) CreateApplication(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), handlerTimeout)
+	defer cancel()
+	audit, ok := parseAuditContext(w, r)
+	if !ok {
+		return
+	}
+	var req createApplicationRequest
+	if err := decodeJSON(r, &req); err != nil {
+		writeError(w, http.StatusBadRequest, "INVALID_JSON", err.Error())
+		return
+	}
+	if errs := validateApplicationCreate(&req); len(errs) > 0 {
+		writeFieldErrors(w, errs)
+		return
 	}
+	builder := h.client.Application.Create()
+	req.apply(builder)
 	builder.SetCreatedBy(audit.Actor).SetUpdatedBy(audit.Actor).SetSource(application.Source(audit.Source))
 	if audit.CorrelationID != nil {
 		builder.SetCorrelationID(*audit.CorrelationID)
 	}
-	result, err := builder.Save(r.Context())
+	result, err := saveAudited[*ent.Application](ctx, h.client, h.audit, AuditOpCreate, audit, builder.Mutation())
 	if err != nil {
 		entErrorToHTTP(w, err)
 		return
@@ -772,28 +1419,103 @@ func (h *LeaseHandler) CreateApplication(w http.ResponseWriter, r *http.Request)
 	writeJSON(w, http.StatusCreated, result)
 }
 
+// applicationIncludes maps ?include= edge names to eager loaders on a Application query.
+var applicationIncludes = map[string]func(*ent.ApplicationQuery){
+	"property":        func(q *ent.ApplicationQuery) { q.WithProperty() },
+	"space":           func(q *ent.ApplicationQuery) { q.WithSpace() },
+	"resulting_lease": func(q *ent.ApplicationQuery) { q.WithResultingLease() },
+	"applicant":       func(q *ent.ApplicationQuery) { q.WithApplicant() },
+}
+
 func (h *LeaseHandler) GetApplication(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), handlerTimeout)
+	defer cancel()
 	id, ok := parseUUID(w, r, "id")
 	if !ok {
 		return
 	}
-	result, err := h.client.Application.Get(r.Context(), id)
+	query := h.client.Application.Query().Where(application.ID(id))
+	withIncludes(r, query, applicationIncludes)
+	result, err := query.Only(ctx)
 	if err != nil {
 		entErrorToHTTP(w, err)
 		return
 	}
+	setETag(w, entityETag(result.UpdatedAt))
 	writeJSON(w, http.StatusOK, result)
 }
 
+func (h *LeaseHandler) CheckApplicationExists(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), handlerTimeout)
+	defer cancel()
+	id, ok := parseUUID(w, r, "id")
+	if !ok {
+		return
+	}
+	exists, err := h.client.Application.Query().Where(application.ID(id)).Exist(ctx)
+	if err != nil {
+		entErrorToHTTP(w, err)
+		return
+	}
+	if !exists {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// applicationSortColumns allowlists the ?sort= values accepted by ListApplications.
+var applicationSortColumns = map[string]string{
+	"created_at":                application.FieldCreatedAt,
+	"updated_at":                application.FieldUpdatedAt,
+	"status":                    application.FieldStatus,
+	"desired_move_in":           application.FieldDesiredMoveIn,
+	"desired_lease_term_months": application.FieldDesiredLeaseTermMonths,
+	"screening_request_id":      application.FieldScreeningRequestID,
+	"screening_completed":       application.FieldScreeningCompleted,
+	"credit_score":              application.FieldCreditScore,
+	"background_clear":          application.FieldBackgroundClear,
+	"income_verified":           application.FieldIncomeVerified,
+	"income_to_rent_ratio":      application.FieldIncomeToRentRatio,
+	"decision_by":               application.FieldDecisionBy,
+	"decision_at":               application.FieldDecisionAt,
+	"decision_reason":           application.FieldDecisionReason,
+	"fee_paid":                  application.FieldFeePaid,
+}
+
 func (h *LeaseHandler) ListApplications(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), handlerTimeout)
+	defer cancel()
 	pg := parsePagination(r)
-	items, err := h.client.Application.Query().
+	query := h.client.Application.Query()
+	if v := r.URL.Query().Get("status"); v != "" {
+		if err := application.StatusValidator(application.Status(v)); err != nil {
+			writeError(w, http.StatusBadRequest, "INVALID_FILTER", err.Error())
+			return
+		}
+		query.Where(application.StatusEQ(application.Status(v)))
+	}
+	if v := r.URL.Query().Get("property_id"); v != "" {
+		uid, err := uuid.Parse(v)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "INVALID_FILTER", "invalid property_id")
+			return
+		}
+		query.Where(application.HasPropertyWith(property.ID(uid)))
+	}
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		entErrorToHTTP(w, err)
+		return
+	}
+	withIncludes(r, query, applicationIncludes)
+	items, err := query.
 		Limit(pg.Limit).Offset(pg.Offset).
-		Order(ent.Desc(application.FieldCreatedAt)).
-		All(r.Context())
+		Order(listOrder(r, applicationSortColumns, application.FieldCreatedAt)).
+		All(ctx)
 	if err != nil {
 		entErrorToHTTP(w, err)
 		return
 	}
-	writeJSON(w, http.StatusOK, items)
+	writeJSON(w, http.StatusOK, listResponse{Data: items, Total: total, Offset: pg.Offset, Limit: pg.Limit})
 }
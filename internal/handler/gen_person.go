@@ -2,14 +2,20 @@
 package handler
 
 import (
+	"context"
 	"net/http"
+	"regexp"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/matthewbaird/ontology/ent"
+	"github.com/matthewbaird/ontology/ent/application"
+	"github.com/matthewbaird/ontology/ent/lease"
+	"github.com/matthewbaird/ontology/ent/ledgerentry"
 	"github.com/matthewbaird/ontology/ent/organization"
 	"github.com/matthewbaird/ontology/ent/person"
 	"github.com/matthewbaird/ontology/ent/personrole"
+	"github.com/matthewbaird/ontology/ent/portfolio"
 	"github.com/matthewbaird/ontology/ent/schema"
 	"github.com/matthewbaird/ontology/internal/types"
 )
@@ -25,17 +31,32 @@ var (
 // PersonHandler implements HTTP handlers for PersonService entities.
 type PersonHandler struct {
 	client *ent.Client
+	audit  AuditRecorder
 }
 
-// NewPersonHandler creates a new PersonHandler.
-func NewPersonHandler(client *ent.Client) *PersonHandler {
-	return &PersonHandler{client: client}
+// NewPersonHandler creates a new PersonHandler. Mutations append to audit when it is
+// non-nil; a nil recorder keeps no audit trail.
+func NewPersonHandler(client *ent.Client, audit AuditRecorder) *PersonHandler {
+	return &PersonHandler{client: client, audit: audit}
 }
 
 // ============================================================================
 // Person
 // ============================================================================
 
+// Accepted values of the Person enum fields.
+var (
+	personRecordSourceValues       = []string{"user", "applicant", "import", "system"}
+	personPreferredContactValues   = []string{"email", "sms", "phone", "mail", "portal"}
+	personVerificationMethodValues = []string{"manual", "id_check", "credit_check", "ssn_verify"}
+)
+
+// Patterns the Person string fields must match.
+var (
+	personSsnLastFourPattern        = regexp.MustCompile(`^[0-9]{4}$`)
+	personLanguagePreferencePattern = regexp.MustCompile(`^[a-z]{2}$`)
+)
+
 type createPersonRequest struct {
 	FirstName          string                `json:"first_name"`
 	MiddleName         *string               `json:"middle_name,omitempty"`
@@ -46,7 +67,7 @@ type createPersonRequest struct {
 	SsnLastFour        *string               `json:"ssn_last_four,omitempty"`
 	ContactMethods     []types.ContactMethod `json:"contact_methods"`
 	PreferredContact   string                `json:"preferred_contact"`
-	LanguagePreference string                `json:"language_preference"`
+	LanguagePreference *string               `json:"language_preference,omitempty"`
 	Timezone           *string               `json:"timezone,omitempty"`
 	DoNotContact       bool                  `json:"do_not_contact"`
 	IdentityVerified   bool                  `json:"identity_verified"`
@@ -55,17 +76,31 @@ type createPersonRequest struct {
 	Tags               []string              `json:"tags,omitempty"`
 }
 
-func (h *PersonHandler) CreatePerson(w http.ResponseWriter, r *http.Request) {
-	audit, ok := parseAuditContext(w, r)
-	if !ok {
-		return
+func validatePersonCreate(req *createPersonRequest) fieldErrors {
+	errs := fieldErrors{}
+	errs.add(checkRequired("first_name", req.FirstName == ""))
+	errs.add(checkRequired("last_name", req.LastName == ""))
+	errs.add(checkRequired("display_name", req.DisplayName == ""))
+	if req.RecordSource != "" {
+		errs.add(checkEnum("record_source", req.RecordSource, personRecordSourceValues))
 	}
-	var req createPersonRequest
-	if err := decodeJSON(r, &req); err != nil {
-		writeError(w, http.StatusBadRequest, "INVALID_JSON", err.Error())
-		return
+	if req.SsnLastFour != nil {
+		errs.add(checkPattern("ssn_last_four", *req.SsnLastFour, personSsnLastFourPattern))
 	}
-	builder := h.client.Person.Create()
+	errs.add(checkRequired("contact_methods", req.ContactMethods == nil))
+	if req.PreferredContact != "" {
+		errs.add(checkEnum("preferred_contact", req.PreferredContact, personPreferredContactValues))
+	}
+	if req.LanguagePreference != nil {
+		errs.add(checkPattern("language_preference", *req.LanguagePreference, personLanguagePreferencePattern))
+	}
+	if req.VerificationMethod != nil {
+		errs.add(checkEnum("verification_method", *req.VerificationMethod, personVerificationMethodValues))
+	}
+	return errs
+}
+
+func (req *createPersonRequest) apply(builder *ent.PersonCreate) {
 	builder.SetFirstName(req.FirstName)
 	if req.MiddleName != nil {
 		builder.SetNillableMiddleName(req.MiddleName)
@@ -85,7 +120,9 @@ func (h *PersonHandler) CreatePerson(w http.ResponseWriter, r *http.Request) {
 	if req.PreferredContact != "" {
 		builder.SetPreferredContact(person.PreferredContact(req.PreferredContact))
 	}
-	builder.SetLanguagePreference(req.LanguagePreference)
+	if req.LanguagePreference != nil {
+		builder.SetNillableLanguagePreference(req.LanguagePreference)
+	}
 	if req.Timezone != nil {
 		builder.SetNillableTimezone(req.Timezone)
 	}
@@ -100,11 +137,31 @@ func (h *PersonHandler) CreatePerson(w http.ResponseWriter, r *http.Request) {
 	if len(req.Tags) > 0 {
 		builder.SetTags(req.Tags)
 	}
+}
+
+func (h *PersonHandler) CreatePerson(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), handlerTimeout)
+	defer cancel()
+	audit, ok := parseAuditContext(w, r)
+	if !ok {
+		return
+	}
+	var req createPersonRequest
+	if err := decodeJSON(r, &req); err != nil {
+		writeError(w, http.StatusBadRequest, "INVALID_JSON", err.Error())
+		return
+	}
+	if errs := validatePersonCreate(&req); len(errs) > 0 {
+		writeFieldErrors(w, errs)
+		return
+	}
+	builder := h.client.Person.Create()
+	req.apply(builder)
 	builder.SetCreatedBy(audit.Actor).SetUpdatedBy(audit.Actor).SetSource(person.Source(audit.Source))
 	if audit.CorrelationID != nil {
 		builder.SetCorrelationID(*audit.CorrelationID)
 	}
-	result, err := builder.Save(r.Context())
+	result, err := saveAudited[*ent.Person](ctx, h.client, h.audit, AuditOpCreate, audit, builder.Mutation())
 	if err != nil {
 		entErrorToHTTP(w, err)
 		return
@@ -112,30 +169,214 @@ func (h *PersonHandler) CreatePerson(w http.ResponseWriter, r *http.Request) {
 	writeJSON(w, http.StatusCreated, result)
 }
 
+// personIncludes maps ?include= edge names to eager loaders on a Person query.
+var personIncludes = map[string]func(*ent.PersonQuery){
+	"roles":          func(q *ent.PersonQuery) { q.WithRoles() },
+	"organizations":  func(q *ent.PersonQuery) { q.WithOrganizations() },
+	"ledger_entries": func(q *ent.PersonQuery) { q.WithLedgerEntries() },
+	"applications":   func(q *ent.PersonQuery) { q.WithApplications() },
+}
+
 func (h *PersonHandler) GetPerson(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), handlerTimeout)
+	defer cancel()
 	id, ok := parseUUID(w, r, "id")
 	if !ok {
 		return
 	}
-	result, err := h.client.Person.Get(r.Context(), id)
+	query := h.client.Person.Query().Where(person.ID(id))
+	withIncludes(r, query, personIncludes)
+	result, err := query.Only(ctx)
 	if err != nil {
 		entErrorToHTTP(w, err)
 		return
 	}
+	setETag(w, entityETag(result.UpdatedAt))
 	writeJSON(w, http.StatusOK, result)
 }
 
+func (h *PersonHandler) ListRolesOfPerson(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), handlerTimeout)
+	defer cancel()
+	id, ok := parseUUID(w, r, "id")
+	if !ok {
+		return
+	}
+	parent, err := h.client.Person.Get(ctx, id)
+	if err != nil {
+		entErrorToHTTP(w, err)
+		return
+	}
+	pg := parsePagination(r)
+	query := h.client.Person.QueryRoles(parent)
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		entErrorToHTTP(w, err)
+		return
+	}
+	items, err := query.
+		Limit(pg.Limit).Offset(pg.Offset).
+		Order(listOrder(r, nil, personrole.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		entErrorToHTTP(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, listResponse{Data: items, Total: total, Offset: pg.Offset, Limit: pg.Limit})
+}
+
+func (h *PersonHandler) ListOrganizationsOfPerson(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), handlerTimeout)
+	defer cancel()
+	id, ok := parseUUID(w, r, "id")
+	if !ok {
+		return
+	}
+	parent, err := h.client.Person.Get(ctx, id)
+	if err != nil {
+		entErrorToHTTP(w, err)
+		return
+	}
+	pg := parsePagination(r)
+	query := h.client.Person.QueryOrganizations(parent)
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		entErrorToHTTP(w, err)
+		return
+	}
+	items, err := query.
+		Limit(pg.Limit).Offset(pg.Offset).
+		Order(listOrder(r, nil, organization.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		entErrorToHTTP(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, listResponse{Data: items, Total: total, Offset: pg.Offset, Limit: pg.Limit})
+}
+
+func (h *PersonHandler) ListLedgerEntriesOfPerson(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), handlerTimeout)
+	defer cancel()
+	id, ok := parseUUID(w, r, "id")
+	if !ok {
+		return
+	}
+	parent, err := h.client.Person.Get(ctx, id)
+	if err != nil {
+		entErrorToHTTP(w, err)
+		return
+	}
+	pg := parsePagination(r)
+	query := h.client.Person.QueryLedgerEntries(parent)
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		entErrorToHTTP(w, err)
+		return
+	}
+	items, err := query.
+		Limit(pg.Limit).Offset(pg.Offset).
+		Order(listOrder(r, nil, ledgerentry.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		entErrorToHTTP(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, listResponse{Data: items, Total: total, Offset: pg.Offset, Limit: pg.Limit})
+}
+
+func (h *PersonHandler) ListApplicationsOfPerson(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), handlerTimeout)
+	defer cancel()
+	id, ok := parseUUID(w, r, "id")
+	if !ok {
+		return
+	}
+	parent, err := h.client.Person.Get(ctx, id)
+	if err != nil {
+		entErrorToHTTP(w, err)
+		return
+	}
+	pg := parsePagination(r)
+	query := h.client.Person.QueryApplications(parent)
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		entErrorToHTTP(w, err)
+		return
+	}
+	items, err := query.
+		Limit(pg.Limit).Offset(pg.Offset).
+		Order(listOrder(r, nil, application.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		entErrorToHTTP(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, listResponse{Data: items, Total: total, Offset: pg.Offset, Limit: pg.Limit})
+}
+
+func (h *PersonHandler) CheckPersonExists(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), handlerTimeout)
+	defer cancel()
+	id, ok := parseUUID(w, r, "id")
+	if !ok {
+		return
+	}
+	exists, err := h.client.Person.Query().Where(person.ID(id)).Exist(ctx)
+	if err != nil {
+		entErrorToHTTP(w, err)
+		return
+	}
+	if !exists {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// personSortColumns allowlists the ?sort= values accepted by ListPersons.
+var personSortColumns = map[string]string{
+	"created_at":          person.FieldCreatedAt,
+	"updated_at":          person.FieldUpdatedAt,
+	"first_name":          person.FieldFirstName,
+	"middle_name":         person.FieldMiddleName,
+	"last_name":           person.FieldLastName,
+	"display_name":        person.FieldDisplayName,
+	"record_source":       person.FieldRecordSource,
+	"date_of_birth":       person.FieldDateOfBirth,
+	"ssn_last_four":       person.FieldSsnLastFour,
+	"preferred_contact":   person.FieldPreferredContact,
+	"language_preference": person.FieldLanguagePreference,
+	"timezone":            person.FieldTimezone,
+	"do_not_contact":      person.FieldDoNotContact,
+	"identity_verified":   person.FieldIdentityVerified,
+	"verification_method": person.FieldVerificationMethod,
+	"verified_at":         person.FieldVerifiedAt,
+}
+
 func (h *PersonHandler) ListPersons(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), handlerTimeout)
+	defer cancel()
 	pg := parsePagination(r)
-	items, err := h.client.Person.Query().
+	query := h.client.Person.Query()
+	if q := r.URL.Query().Get("q"); q != "" {
+		query.Where(person.DisplayNameContainsFold(q))
+	}
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		entErrorToHTTP(w, err)
+		return
+	}
+	withIncludes(r, query, personIncludes)
+	items, err := query.
 		Limit(pg.Limit).Offset(pg.Offset).
-		Order(ent.Desc(person.FieldCreatedAt)).
-		All(r.Context())
+		Order(listOrder(r, personSortColumns, person.FieldCreatedAt)).
+		All(ctx)
 	if err != nil {
 		entErrorToHTTP(w, err)
 		return
 	}
-	writeJSON(w, http.StatusOK, items)
+	writeJSON(w, http.StatusOK, listResponse{Data: items, Total: total, Offset: pg.Offset, Limit: pg.Limit})
 }
 
 type updatePersonRequest struct {
@@ -157,7 +398,38 @@ type updatePersonRequest struct {
 	Tags               []string              `json:"tags,omitempty"`
 }
 
+func validatePersonUpdate(req *updatePersonRequest) fieldErrors {
+	errs := fieldErrors{}
+	if req.FirstName != nil {
+		errs.add(checkRequired("first_name", *req.FirstName == ""))
+	}
+	if req.LastName != nil {
+		errs.add(checkRequired("last_name", *req.LastName == ""))
+	}
+	if req.DisplayName != nil {
+		errs.add(checkRequired("display_name", *req.DisplayName == ""))
+	}
+	if req.RecordSource != nil {
+		errs.add(checkEnum("record_source", *req.RecordSource, personRecordSourceValues))
+	}
+	if req.SsnLastFour != nil {
+		errs.add(checkPattern("ssn_last_four", *req.SsnLastFour, personSsnLastFourPattern))
+	}
+	if req.PreferredContact != nil {
+		errs.add(checkEnum("preferred_contact", *req.PreferredContact, personPreferredContactValues))
+	}
+	if req.LanguagePreference != nil {
+		errs.add(checkPattern("language_preference", *req.LanguagePreference, personLanguagePreferencePattern))
+	}
+	if req.VerificationMethod != nil {
+		errs.add(checkEnum("verification_method", *req.VerificationMethod, personVerificationMethodValues))
+	}
+	return errs
+}
+
 func (h *PersonHandler) UpdatePerson(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), handlerTimeout)
+	defer cancel()
 	id, ok := parseUUID(w, r, "id")
 	if !ok {
 		return
@@ -167,11 +439,31 @@ func (h *PersonHandler) UpdatePerson(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 	var req updatePersonRequest
-	if err := decodeJSON(r, &req); err != nil {
+	patch, err := decodeUpdate(r, &req)
+	if err != nil {
 		writeError(w, http.StatusBadRequest, "INVALID_JSON", err.Error())
 		return
 	}
+	if field := patch.firstNull("first_name", "last_name", "display_name", "record_source", "contact_methods", "preferred_contact", "language_preference", "do_not_contact", "identity_verified"); field != "" {
+		writeError(w, http.StatusBadRequest, "INVALID_PATCH", field+" cannot be null")
+		return
+	}
+	if errs := validatePersonUpdate(&req); len(errs) > 0 {
+		writeFieldErrors(w, errs)
+		return
+	}
 	builder := h.client.Person.UpdateOneID(id)
+	if hasIfMatch(r) {
+		current, err := h.client.Person.Get(ctx, id)
+		if err != nil {
+			entErrorToHTTP(w, err)
+			return
+		}
+		if !checkIfMatch(w, r, entityETag(current.UpdatedAt)) {
+			return
+		}
+		builder.Where(person.UpdatedAtEQ(current.UpdatedAt))
+	}
 	if req.FirstName != nil {
 		builder.SetFirstName(*req.FirstName)
 	}
@@ -220,15 +512,37 @@ func (h *PersonHandler) UpdatePerson(w http.ResponseWriter, r *http.Request) {
 	if req.Tags != nil {
 		builder.SetTags(req.Tags)
 	}
+	if patch.isNull("middle_name") {
+		builder.ClearMiddleName()
+	}
+	if patch.isNull("date_of_birth") {
+		builder.ClearDateOfBirth()
+	}
+	if patch.isNull("ssn_last_four") {
+		builder.ClearSsnLastFour()
+	}
+	if patch.isNull("timezone") {
+		builder.ClearTimezone()
+	}
+	if patch.isNull("verification_method") {
+		builder.ClearVerificationMethod()
+	}
+	if patch.isNull("verified_at") {
+		builder.ClearVerifiedAt()
+	}
+	if patch.isNull("tags") {
+		builder.ClearTags()
+	}
 	builder.SetUpdatedBy(audit.Actor).SetSource(person.Source(audit.Source))
 	if audit.CorrelationID != nil {
 		builder.SetCorrelationID(*audit.CorrelationID)
 	}
-	result, err := builder.Save(r.Context())
+	result, err := saveAudited[*ent.Person](ctx, h.client, h.audit, AuditOpUpdate, audit, builder.Mutation())
 	if err != nil {
-		entErrorToHTTP(w, err)
+		writeGuardedSaveError(w, r, err)
 		return
 	}
+	setETag(w, entityETag(result.UpdatedAt))
 	writeJSON(w, http.StatusOK, result)
 }
 
@@ -236,6 +550,19 @@ func (h *PersonHandler) UpdatePerson(w http.ResponseWriter, r *http.Request) {
 // Organization
 // ============================================================================
 
+// Accepted values of the Organization enum fields.
+var (
+	organizationOrgTypeValues   = []string{"management_company", "ownership_entity", "vendor", "corporate_tenant", "government_agency", "hoa", "investment_fund", "other"}
+	organizationTaxIDTypeValues = []string{"ein", "ssn", "itin", "foreign"}
+	organizationStatusValues    = []string{"active", "inactive", "suspended", "dissolved"}
+)
+
+// Patterns the Organization string fields must match.
+var (
+	organizationStateOfIncorporationPattern = regexp.MustCompile(`^[A-Z]{2}$`)
+	organizationLicenseStatePattern         = regexp.MustCompile(`^[A-Z]{2}$`)
+)
+
 type createOrganizationRequest struct {
 	LegalName            string                `json:"legal_name"`
 	DbaName              *string               `json:"dba_name,omitempty"`
@@ -252,17 +579,26 @@ type createOrganizationRequest struct {
 	LicenseExpiry        *time.Time            `json:"license_expiry,omitempty"`
 }
 
-func (h *PersonHandler) CreateOrganization(w http.ResponseWriter, r *http.Request) {
-	audit, ok := parseAuditContext(w, r)
-	if !ok {
-		return
+func validateOrganizationCreate(req *createOrganizationRequest) fieldErrors {
+	errs := fieldErrors{}
+	errs.add(checkRequired("legal_name", req.LegalName == ""))
+	errs.add(checkRequired("org_type", req.OrgType == ""))
+	errs.add(checkEnum("org_type", req.OrgType, organizationOrgTypeValues))
+	if req.TaxIDType != nil {
+		errs.add(checkEnum("tax_id_type", *req.TaxIDType, organizationTaxIDTypeValues))
 	}
-	var req createOrganizationRequest
-	if err := decodeJSON(r, &req); err != nil {
-		writeError(w, http.StatusBadRequest, "INVALID_JSON", err.Error())
-		return
+	errs.add(checkRequired("status", req.Status == ""))
+	errs.add(checkEnum("status", req.Status, organizationStatusValues))
+	if req.StateOfIncorporation != nil {
+		errs.add(checkPattern("state_of_incorporation", *req.StateOfIncorporation, organizationStateOfIncorporationPattern))
 	}
-	builder := h.client.Organization.Create()
+	if req.LicenseState != nil {
+		errs.add(checkPattern("license_state", *req.LicenseState, organizationLicenseStatePattern))
+	}
+	return errs
+}
+
+func (req *createOrganizationRequest) apply(builder *ent.OrganizationCreate) {
 	builder.SetLegalName(req.LegalName)
 	if req.DbaName != nil {
 		builder.SetNillableDbaName(req.DbaName)
@@ -296,11 +632,31 @@ func (h *PersonHandler) CreateOrganization(w http.ResponseWriter, r *http.Reques
 	if req.LicenseExpiry != nil {
 		builder.SetNillableLicenseExpiry(req.LicenseExpiry)
 	}
+}
+
+func (h *PersonHandler) CreateOrganization(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), handlerTimeout)
+	defer cancel()
+	audit, ok := parseAuditContext(w, r)
+	if !ok {
+		return
+	}
+	var req createOrganizationRequest
+	if err := decodeJSON(r, &req); err != nil {
+		writeError(w, http.StatusBadRequest, "INVALID_JSON", err.Error())
+		return
+	}
+	if errs := validateOrganizationCreate(&req); len(errs) > 0 {
+		writeFieldErrors(w, errs)
+		return
+	}
+	builder := h.client.Organization.Create()
+	req.apply(builder)
 	builder.SetCreatedBy(audit.Actor).SetUpdatedBy(audit.Actor).SetSource(organization.Source(audit.Source))
 	if audit.CorrelationID != nil {
 		builder.SetCorrelationID(*audit.CorrelationID)
 	}
-	result, err := builder.Save(r.Context())
+	result, err := saveAudited[*ent.Organization](ctx, h.client, h.audit, AuditOpCreate, audit, builder.Mutation())
 	if err != nil {
 		entErrorToHTTP(w, err)
 		return
@@ -308,30 +664,181 @@ func (h *PersonHandler) CreateOrganization(w http.ResponseWriter, r *http.Reques
 	writeJSON(w, http.StatusCreated, result)
 }
 
+// organizationIncludes maps ?include= edge names to eager loaders on a Organization query.
+var organizationIncludes = map[string]func(*ent.OrganizationQuery){
+	"owned_portfolios": func(q *ent.OrganizationQuery) { q.WithOwnedPortfolios() },
+	"people":           func(q *ent.OrganizationQuery) { q.WithPeople() },
+	"subsidiaries":     func(q *ent.OrganizationQuery) { q.WithSubsidiaries() },
+	"parent_org":       func(q *ent.OrganizationQuery) { q.WithParentOrg() },
+}
+
 func (h *PersonHandler) GetOrganization(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), handlerTimeout)
+	defer cancel()
 	id, ok := parseUUID(w, r, "id")
 	if !ok {
 		return
 	}
-	result, err := h.client.Organization.Get(r.Context(), id)
+	query := h.client.Organization.Query().Where(organization.ID(id))
+	withIncludes(r, query, organizationIncludes)
+	result, err := query.Only(ctx)
 	if err != nil {
 		entErrorToHTTP(w, err)
 		return
 	}
+	setETag(w, entityETag(result.UpdatedAt))
 	writeJSON(w, http.StatusOK, result)
 }
 
+func (h *PersonHandler) ListOwnedPortfoliosOfOrganization(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), handlerTimeout)
+	defer cancel()
+	id, ok := parseUUID(w, r, "id")
+	if !ok {
+		return
+	}
+	parent, err := h.client.Organization.Get(ctx, id)
+	if err != nil {
+		entErrorToHTTP(w, err)
+		return
+	}
+	pg := parsePagination(r)
+	query := h.client.Organization.QueryOwnedPortfolios(parent)
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		entErrorToHTTP(w, err)
+		return
+	}
+	items, err := query.
+		Limit(pg.Limit).Offset(pg.Offset).
+		Order(listOrder(r, nil, portfolio.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		entErrorToHTTP(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, listResponse{Data: items, Total: total, Offset: pg.Offset, Limit: pg.Limit})
+}
+
+func (h *PersonHandler) ListPeopleOfOrganization(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), handlerTimeout)
+	defer cancel()
+	id, ok := parseUUID(w, r, "id")
+	if !ok {
+		return
+	}
+	parent, err := h.client.Organization.Get(ctx, id)
+	if err != nil {
+		entErrorToHTTP(w, err)
+		return
+	}
+	pg := parsePagination(r)
+	query := h.client.Organization.QueryPeople(parent)
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		entErrorToHTTP(w, err)
+		return
+	}
+	items, err := query.
+		Limit(pg.Limit).Offset(pg.Offset).
+		Order(listOrder(r, nil, person.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		entErrorToHTTP(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, listResponse{Data: items, Total: total, Offset: pg.Offset, Limit: pg.Limit})
+}
+
+func (h *PersonHandler) ListSubsidiariesOfOrganization(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), handlerTimeout)
+	defer cancel()
+	id, ok := parseUUID(w, r, "id")
+	if !ok {
+		return
+	}
+	parent, err := h.client.Organization.Get(ctx, id)
+	if err != nil {
+		entErrorToHTTP(w, err)
+		return
+	}
+	pg := parsePagination(r)
+	query := h.client.Organization.QuerySubsidiaries(parent)
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		entErrorToHTTP(w, err)
+		return
+	}
+	items, err := query.
+		Limit(pg.Limit).Offset(pg.Offset).
+		Order(listOrder(r, nil, organization.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		entErrorToHTTP(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, listResponse{Data: items, Total: total, Offset: pg.Offset, Limit: pg.Limit})
+}
+
+func (h *PersonHandler) CheckOrganizationExists(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), handlerTimeout)
+	defer cancel()
+	id, ok := parseUUID(w, r, "id")
+	if !ok {
+		return
+	}
+	exists, err := h.client.Organization.Query().Where(organization.ID(id)).Exist(ctx)
+	if err != nil {
+		entErrorToHTTP(w, err)
+		return
+	}
+	if !exists {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// organizationSortColumns allowlists the ?sort= values accepted by ListOrganizations.
+var organizationSortColumns = map[string]string{
+	"created_at":             organization.FieldCreatedAt,
+	"updated_at":             organization.FieldUpdatedAt,
+	"legal_name":             organization.FieldLegalName,
+	"dba_name":               organization.FieldDbaName,
+	"org_type":               organization.FieldOrgType,
+	"tax_id":                 organization.FieldTaxID,
+	"tax_id_type":            organization.FieldTaxIDType,
+	"status":                 organization.FieldStatus,
+	"state_of_incorporation": organization.FieldStateOfIncorporation,
+	"formation_date":         organization.FieldFormationDate,
+	"management_license":     organization.FieldManagementLicense,
+	"license_state":          organization.FieldLicenseState,
+	"license_expiry":         organization.FieldLicenseExpiry,
+}
+
 func (h *PersonHandler) ListOrganizations(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), handlerTimeout)
+	defer cancel()
 	pg := parsePagination(r)
-	items, err := h.client.Organization.Query().
+	query := h.client.Organization.Query()
+	if q := r.URL.Query().Get("q"); q != "" {
+		query.Where(organization.LegalNameContainsFold(q))
+	}
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		entErrorToHTTP(w, err)
+		return
+	}
+	withIncludes(r, query, organizationIncludes)
+	items, err := query.
 		Limit(pg.Limit).Offset(pg.Offset).
-		Order(ent.Desc(organization.FieldCreatedAt)).
-		All(r.Context())
+		Order(listOrder(r, organizationSortColumns, organization.FieldCreatedAt)).
+		All(ctx)
 	if err != nil {
 		entErrorToHTTP(w, err)
 		return
 	}
-	writeJSON(w, http.StatusOK, items)
+	writeJSON(w, http.StatusOK, listResponse{Data: items, Total: total, Offset: pg.Offset, Limit: pg.Limit})
 }
 
 type updateOrganizationRequest struct {
@@ -350,7 +857,32 @@ type updateOrganizationRequest struct {
 	LicenseExpiry        *time.Time            `json:"license_expiry,omitempty"`
 }
 
+func validateOrganizationUpdate(req *updateOrganizationRequest) fieldErrors {
+	errs := fieldErrors{}
+	if req.LegalName != nil {
+		errs.add(checkRequired("legal_name", *req.LegalName == ""))
+	}
+	if req.OrgType != nil {
+		errs.add(checkEnum("org_type", *req.OrgType, organizationOrgTypeValues))
+	}
+	if req.TaxIDType != nil {
+		errs.add(checkEnum("tax_id_type", *req.TaxIDType, organizationTaxIDTypeValues))
+	}
+	if req.Status != nil {
+		errs.add(checkEnum("status", *req.Status, organizationStatusValues))
+	}
+	if req.StateOfIncorporation != nil {
+		errs.add(checkPattern("state_of_incorporation", *req.StateOfIncorporation, organizationStateOfIncorporationPattern))
+	}
+	if req.LicenseState != nil {
+		errs.add(checkPattern("license_state", *req.LicenseState, organizationLicenseStatePattern))
+	}
+	return errs
+}
+
 func (h *PersonHandler) UpdateOrganization(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), handlerTimeout)
+	defer cancel()
 	id, ok := parseUUID(w, r, "id")
 	if !ok {
 		return
@@ -360,11 +892,42 @@ func (h *PersonHandler) UpdateOrganization(w http.ResponseWriter, r *http.Reques
 		return
 	}
 	var req updateOrganizationRequest
-	if err := decodeJSON(r, &req); err != nil {
+	patch, err := decodeUpdate(r, &req)
+	if err != nil {
 		writeError(w, http.StatusBadRequest, "INVALID_JSON", err.Error())
 		return
 	}
+	if field := patch.firstNull("legal_name", "org_type", "status"); field != "" {
+		writeError(w, http.StatusBadRequest, "INVALID_PATCH", field+" cannot be null")
+		return
+	}
+	if patch.hasObject("address") {
+		stored, err := h.client.Organization.Get(ctx, id)
+		if err != nil {
+			entErrorToHTTP(w, err)
+			return
+		}
+		if req.Address, err = mergeObject(patch, "address", stored.Address, req.Address); err != nil {
+			writeError(w, http.StatusBadRequest, "INVALID_JSON", err.Error())
+			return
+		}
+	}
+	if errs := validateOrganizationUpdate(&req); len(errs) > 0 {
+		writeFieldErrors(w, errs)
+		return
+	}
 	builder := h.client.Organization.UpdateOneID(id)
+	if hasIfMatch(r) {
+		current, err := h.client.Organization.Get(ctx, id)
+		if err != nil {
+			entErrorToHTTP(w, err)
+			return
+		}
+		if !checkIfMatch(w, r, entityETag(current.UpdatedAt)) {
+			return
+		}
+		builder.Where(organization.UpdatedAtEQ(current.UpdatedAt))
+	}
 	if req.LegalName != nil {
 		builder.SetLegalName(*req.LegalName)
 	}
@@ -404,15 +967,46 @@ func (h *PersonHandler) UpdateOrganization(w http.ResponseWriter, r *http.Reques
 	if req.LicenseExpiry != nil {
 		builder.SetNillableLicenseExpiry(req.LicenseExpiry)
 	}
+	if patch.isNull("dba_name") {
+		builder.ClearDbaName()
+	}
+	if patch.isNull("tax_id") {
+		builder.ClearTaxID()
+	}
+	if patch.isNull("tax_id_type") {
+		builder.ClearTaxIDType()
+	}
+	if patch.isNull("address") {
+		builder.ClearAddress()
+	}
+	if patch.isNull("contact_methods") {
+		builder.ClearContactMethods()
+	}
+	if patch.isNull("state_of_incorporation") {
+		builder.ClearStateOfIncorporation()
+	}
+	if patch.isNull("formation_date") {
+		builder.ClearFormationDate()
+	}
+	if patch.isNull("management_license") {
+		builder.ClearManagementLicense()
+	}
+	if patch.isNull("license_state") {
+		builder.ClearLicenseState()
+	}
+	if patch.isNull("license_expiry") {
+		builder.ClearLicenseExpiry()
+	}
 	builder.SetUpdatedBy(audit.Actor).SetSource(organization.Source(audit.Source))
 	if audit.CorrelationID != nil {
 		builder.SetCorrelationID(*audit.CorrelationID)
 	}
-	result, err := builder.Save(r.Context())
+	result, err := saveAudited[*ent.Organization](ctx, h.client, h.audit, AuditOpUpdate, audit, builder.Mutation())
 	if err != nil {
-		entErrorToHTTP(w, err)
+		writeGuardedSaveError(w, r, err)
 		return
 	}
+	setETag(w, entityETag(result.UpdatedAt))
 	writeJSON(w, http.StatusOK, result)
 }
 
@@ -420,6 +1014,13 @@ func (h *PersonHandler) UpdateOrganization(w http.ResponseWriter, r *http.Reques
 // PersonRole
 // ============================================================================
 
+// Accepted values of the PersonRole enum fields.
+var (
+	personRoleRoleTypeValues  = []string{"tenant", "owner", "property_manager", "maintenance_tech", "leasing_agent", "accountant", "vendor_contact", "guarantor", "emergency_contact", "authorized_occupant", "co_signer"}
+	personRoleScopeTypeValues = []string{"organization", "portfolio", "property", "building", "space", "lease"}
+	personRoleStatusValues    = []string{"active", "inactive", "pending", "terminated"}
+)
+
 type createPersonRoleRequest struct {
 	RoleType   string                  `json:"role_type"`
 	ScopeType  string                  `json:"scope_type"`
@@ -430,7 +1031,35 @@ type createPersonRoleRequest struct {
 	PersonID   string                  `json:"person_id"`
 }
 
+func validatePersonRoleCreate(req *createPersonRoleRequest) fieldErrors {
+	errs := fieldErrors{}
+	errs.add(checkRequired("role_type", req.RoleType == ""))
+	errs.add(checkEnum("role_type", req.RoleType, personRoleRoleTypeValues))
+	errs.add(checkRequired("scope_type", req.ScopeType == ""))
+	errs.add(checkEnum("scope_type", req.ScopeType, personRoleScopeTypeValues))
+	errs.add(checkRequired("scope_id", req.ScopeID == ""))
+	errs.add(checkRequired("status", req.Status == ""))
+	errs.add(checkEnum("status", req.Status, personRoleStatusValues))
+	errs.add(checkRequired("person_id", req.PersonID == ""))
+	errs.add(checkID("person_id", req.PersonID))
+	return errs
+}
+
+func (req *createPersonRoleRequest) apply(builder *ent.PersonRoleCreate) {
+	builder.SetRoleType(personrole.RoleType(req.RoleType))
+	builder.SetScopeType(personrole.ScopeType(req.ScopeType))
+	builder.SetScopeID(req.ScopeID)
+	builder.SetStatus(personrole.Status(req.Status))
+	builder.SetEffective(&req.Effective)
+	if req.Attributes != nil {
+		builder.SetAttributes(req.Attributes)
+	}
+	builder.SetPersonID(uuid.MustParse(req.PersonID))
+}
+
 func (h *PersonHandler) CreatePersonRole(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), handlerTimeout)
+	defer cancel()
 	audit, ok := parseAuditContext(w, r)
 	if !ok {
 		return
@@ -440,28 +1069,17 @@ func (h *PersonHandler) CreatePersonRole(w http.ResponseWriter, r *http.Request)
 		writeError(w, http.StatusBadRequest, "INVALID_JSON", err.Error())
 		return
 	}
-	builder := h.client.PersonRole.Create()
-	builder.SetRoleType(personrole.RoleType(req.RoleType))
-	builder.SetScopeType(personrole.ScopeType(req.ScopeType))
-	builder.SetScopeID(req.ScopeID)
-	builder.SetStatus(personrole.Status(req.Status))
-	builder.SetEffective(&req.Effective)
-	if req.Attributes != nil {
-		builder.SetAttributes(req.Attributes)
-	}
-	{
-		uid, err := uuid.Parse(req.PersonID)
-		if err != nil {
-			writeError(w, http.StatusBadRequest, "INVALID_ID", "invalid person_id")
-			return
-		}
-		builder.SetPersonID(uid)
+	if errs := validatePersonRoleCreate(&req); len(errs) > 0 {
+		writeFieldErrors(w, errs)
+		return
 	}
+	builder := h.client.PersonRole.Create()
+	req.apply(builder)
 	builder.SetCreatedBy(audit.Actor).SetUpdatedBy(audit.Actor).SetSource(personrole.Source(audit.Source))
 	if audit.CorrelationID != nil {
 		builder.SetCorrelationID(*audit.CorrelationID)
 	}
-	result, err := builder.Save(r.Context())
+	result, err := saveAudited[*ent.PersonRole](ctx, h.client, h.audit, AuditOpCreate, audit, builder.Mutation())
 	if err != nil {
 		entErrorToHTTP(w, err)
 		return
@@ -469,33 +1087,145 @@ func (h *PersonHandler) CreatePersonRole(w http.ResponseWriter, r *http.Request)
 	writeJSON(w, http.StatusCreated, result)
 }
 
+// personRoleIncludes maps ?include= edge names to eager loaders on a PersonRole query.
+var personRoleIncludes = map[string]func(*ent.PersonRoleQuery){
+	"leases":            func(q *ent.PersonRoleQuery) { q.WithLeases() },
+	"guaranteed_leases": func(q *ent.PersonRoleQuery) { q.WithGuaranteedLeases() },
+	"person":            func(q *ent.PersonRoleQuery) { q.WithPerson() },
+}
+
 func (h *PersonHandler) GetPersonRole(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), handlerTimeout)
+	defer cancel()
 	id, ok := parseUUID(w, r, "id")
 	if !ok {
 		return
 	}
-	result, err := h.client.PersonRole.Get(r.Context(), id)
+	query := h.client.PersonRole.Query().Where(personrole.ID(id))
+	withIncludes(r, query, personRoleIncludes)
+	result, err := query.Only(ctx)
 	if err != nil {
 		entErrorToHTTP(w, err)
 		return
 	}
+	setETag(w, entityETag(result.UpdatedAt))
 	writeJSON(w, http.StatusOK, result)
 }
 
+func (h *PersonHandler) ListLeasesOfPersonRole(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), handlerTimeout)
+	defer cancel()
+	id, ok := parseUUID(w, r, "id")
+	if !ok {
+		return
+	}
+	parent, err := h.client.PersonRole.Get(ctx, id)
+	if err != nil {
+		entErrorToHTTP(w, err)
+		return
+	}
+	pg := parsePagination(r)
+	query := h.client.PersonRole.QueryLeases(parent)
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		entErrorToHTTP(w, err)
+		return
+	}
+	items, err := query.
+		Limit(pg.Limit).Offset(pg.Offset).
+		Order(listOrder(r, nil, lease.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		entErrorToHTTP(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, listResponse{Data: items, Total: total, Offset: pg.Offset, Limit: pg.Limit})
+}
+
+func (h *PersonHandler) ListGuaranteedLeasesOfPersonRole(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), handlerTimeout)
+	defer cancel()
+	id, ok := parseUUID(w, r, "id")
+	if !ok {
+		return
+	}
+	parent, err := h.client.PersonRole.Get(ctx, id)
+	if err != nil {
+		entErrorToHTTP(w, err)
+		return
+	}
+	pg := parsePagination(r)
+	query := h.client.PersonRole.QueryGuaranteedLeases(parent)
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		entErrorToHTTP(w, err)
+		return
+	}
+	items, err := query.
+		Limit(pg.Limit).Offset(pg.Offset).
+		Order(listOrder(r, nil, lease.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		entErrorToHTTP(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, listResponse{Data: items, Total: total, Offset: pg.Offset, Limit: pg.Limit})
+}
+
+func (h *PersonHandler) CheckPersonRoleExists(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), handlerTimeout)
+	defer cancel()
+	id, ok := parseUUID(w, r, "id")
+	if !ok {
+		return
+	}
+	exists, err := h.client.PersonRole.Query().Where(personrole.ID(id)).Exist(ctx)
+	if err != nil {
+		entErrorToHTTP(w, err)
+		return
+	}
+	if !exists {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// personRoleSortColumns allowlists the ?sort= values accepted by ListPersonRoles.
+var personRoleSortColumns = map[string]string{
+	"created_at": personrole.FieldCreatedAt,
+	"updated_at": personrole.FieldUpdatedAt,
+	"role_type":  personrole.FieldRoleType,
+	"scope_type": personrole.FieldScopeType,
+	"scope_id":   personrole.FieldScopeID,
+	"status":     personrole.FieldStatus,
+}
+
 func (h *PersonHandler) ListPersonRoles(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), handlerTimeout)
+	defer cancel()
 	pg := parsePagination(r)
-	items, err := h.client.PersonRole.Query().
+	query := h.client.PersonRole.Query()
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		entErrorToHTTP(w, err)
+		return
+	}
+	withIncludes(r, query, personRoleIncludes)
+	items, err := query.
 		Limit(pg.Limit).Offset(pg.Offset).
-		Order(ent.Desc(personrole.FieldCreatedAt)).
-		All(r.Context())
+		Order(listOrder(r, personRoleSortColumns, personrole.FieldCreatedAt)).
+		All(ctx)
 	if err != nil {
 		entErrorToHTTP(w, err)
 		return
 	}
-	writeJSON(w, http.StatusOK, items)
+	writeJSON(w, http.StatusOK, listResponse{Data: items, Total: total, Offset: pg.Offset, Limit: pg.Limit})
 }
 
 func (h *PersonHandler) transitionPersonRole(w http.ResponseWriter, r *http.Request, targetStatus string, applyExtra func(*ent.PersonRoleUpdateOne)) {
+	ctx, cancel := context.WithTimeout(r.Context(), handlerTimeout)
+	defer cancel()
 	id, ok := parseUUID(w, r, "id")
 	if !ok {
 		return
@@ -504,13 +1234,12 @@ func (h *PersonHandler) transitionPersonRole(w http.ResponseWriter, r *http.Requ
 	if !ok {
 		return
 	}
-	current, err := h.client.PersonRole.Get(r.Context(), id)
+	current, err := h.client.PersonRole.Get(ctx, id)
 	if err != nil {
 		entErrorToHTTP(w, err)
 		return
 	}
-	if err := ValidateTransition(schema.ValidPersonRoleTransitions, string(current.Status), targetStatus); err != nil {
-		writeError(w, http.StatusConflict, "INVALID_TRANSITION", err.Error())
+	if !checkIfMatch(w, r, entityETag(current.UpdatedAt)) {
 		return
 	}
 	builder := h.client.PersonRole.UpdateOneID(id).
@@ -520,14 +1249,22 @@ func (h *PersonHandler) transitionPersonRole(w http.ResponseWriter, r *http.Requ
 	if audit.CorrelationID != nil {
 		builder.SetCorrelationID(*audit.CorrelationID)
 	}
+	if hasIfMatch(r) {
+		builder.Where(personrole.UpdatedAtEQ(current.UpdatedAt))
+	}
 	if applyExtra != nil {
 		applyExtra(builder)
 	}
-	updated, err := builder.Save(r.Context())
+	if err := schema.ValidateTransitionWithGuard(schema.ValidPersonRoleTransitions, schema.PersonRoleTransitionGuards, string(current.Status), targetStatus, guardValues(ctx, builder.Mutation())); err != nil {
+		writeError(w, http.StatusConflict, "INVALID_TRANSITION", err.Error())
+		return
+	}
+	updated, err := saveAudited[*ent.PersonRole](ctx, h.client, h.audit, AuditOpTransition, audit, builder.Mutation())
 	if err != nil {
-		entErrorToHTTP(w, err)
+		writeGuardedSaveError(w, r, err)
 		return
 	}
+	setETag(w, entityETag(updated.UpdatedAt))
 	writeJSON(w, http.StatusOK, updated)
 }
 
@@ -2,14 +2,19 @@
 package handler
 
 import (
+	"context"
 	"net/http"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/matthewbaird/ontology/ent"
+	"github.com/matthewbaird/ontology/ent/application"
 	"github.com/matthewbaird/ontology/ent/building"
+	"github.com/matthewbaird/ontology/ent/leasespace"
+	"github.com/matthewbaird/ontology/ent/ledgerentry"
 	"github.com/matthewbaird/ontology/ent/portfolio"
 	"github.com/matthewbaird/ontology/ent/property"
+	"github.com/matthewbaird/ontology/ent/propertyjurisdiction"
 	"github.com/matthewbaird/ontology/ent/schema"
 	"github.com/matthewbaird/ontology/ent/space"
 	"github.com/matthewbaird/ontology/internal/types"
@@ -26,17 +31,25 @@ var (
 // PropertyHandler implements HTTP handlers for PropertyService entities.
 type PropertyHandler struct {
 	client *ent.Client
+	audit  AuditRecorder
 }
 
-// NewPropertyHandler creates a new PropertyHandler.
-func NewPropertyHandler(client *ent.Client) *PropertyHandler {
-	return &PropertyHandler{client: client}
+// NewPropertyHandler creates a new PropertyHandler. Mutations append to audit when it is
+// non-nil; a nil recorder keeps no audit trail.
+func NewPropertyHandler(client *ent.Client, audit AuditRecorder) *PropertyHandler {
+	return &PropertyHandler{client: client, audit: audit}
 }
 
 // ============================================================================
 // Portfolio
 // ============================================================================
 
+// Accepted values of the Portfolio enum fields.
+var (
+	portfolioManagementTypeValues = []string{"self_managed", "third_party", "hybrid"}
+	portfolioStatusValues         = []string{"active", "inactive", "onboarding", "offboarding"}
+)
+
 type createPortfolioRequest struct {
 	Name                     string  `json:"name"`
 	ManagementType           string  `json:"management_type"`
@@ -47,17 +60,19 @@ type createPortfolioRequest struct {
 	OwnerID                  string  `json:"owner_id"`
 }
 
-func (h *PropertyHandler) CreatePortfolio(w http.ResponseWriter, r *http.Request) {
-	audit, ok := parseAuditContext(w, r)
-	if !ok {
-		return
-	}
-	var req createPortfolioRequest
-	if err := decodeJSON(r, &req); err != nil {
-		writeError(w, http.StatusBadRequest, "INVALID_JSON", err.Error())
-		return
-	}
-	builder := h.client.Portfolio.Create()
+func validatePortfolioCreate(req *createPortfolioRequest) fieldErrors {
+	errs := fieldErrors{}
+	errs.add(checkRequired("name", req.Name == ""))
+	errs.add(checkRequired("management_type", req.ManagementType == ""))
+	errs.add(checkEnum("management_type", req.ManagementType, portfolioManagementTypeValues))
+	errs.add(checkRequired("status", req.Status == ""))
+	errs.add(checkEnum("status", req.Status, portfolioStatusValues))
+	errs.add(checkRequired("owner_id", req.OwnerID == ""))
+	errs.add(checkID("owner_id", req.OwnerID))
+	return errs
+}
+
+func (req *createPortfolioRequest) apply(builder *ent.PortfolioCreate) {
 	builder.SetName(req.Name)
 	builder.SetManagementType(portfolio.ManagementType(req.ManagementType))
 	if req.Description != nil {
@@ -70,19 +85,32 @@ func (h *PropertyHandler) CreatePortfolio(w http.ResponseWriter, r *http.Request
 	if req.DefaultBankAccountID != nil {
 		builder.SetNillableDefaultBankAccountID(req.DefaultBankAccountID)
 	}
-	{
-		uid, err := uuid.Parse(req.OwnerID)
-		if err != nil {
-			writeError(w, http.StatusBadRequest, "INVALID_ID", "invalid owner_id")
-			return
-		}
-		builder.SetOwnerID(uid)
+	builder.SetOwnerID(uuid.MustParse(req.OwnerID))
+}
+
+func (h *PropertyHandler) CreatePortfolio(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), handlerTimeout)
+	defer cancel()
+	audit, ok := parseAuditContext(w, r)
+	if !ok {
+		return
 	}
+	var req createPortfolioRequest
+	if err := decodeJSON(r, &req); err != nil {
+		writeError(w, http.StatusBadRequest, "INVALID_JSON", err.Error())
+		return
+	}
+	if errs := validatePortfolioCreate(&req); len(errs) > 0 {
+		writeFieldErrors(w, errs)
+		return
+	}
+	builder := h.client.Portfolio.Create()
+	req.apply(builder)
 	builder.SetCreatedBy(audit.Actor).SetUpdatedBy(audit.Actor).SetSource(portfolio.Source(audit.Source))
 	if audit.CorrelationID != nil {
 		builder.SetCorrelationID(*audit.CorrelationID)
 	}
-	result, err := builder.Save(r.Context())
+	result, err := saveAudited[*ent.Portfolio](ctx, h.client, h.audit, AuditOpCreate, audit, builder.Mutation())
 	if err != nil {
 		entErrorToHTTP(w, err)
 		return
@@ -90,30 +118,115 @@ func (h *PropertyHandler) CreatePortfolio(w http.ResponseWriter, r *http.Request
 	writeJSON(w, http.StatusCreated, result)
 }
 
+// portfolioIncludes maps ?include= edge names to eager loaders on a Portfolio query.
+var portfolioIncludes = map[string]func(*ent.PortfolioQuery){
+	"properties":    func(q *ent.PortfolioQuery) { q.WithProperties() },
+	"owner":         func(q *ent.PortfolioQuery) { q.WithOwner() },
+	"trust_account": func(q *ent.PortfolioQuery) { q.WithTrustAccount() },
+}
+
 func (h *PropertyHandler) GetPortfolio(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), handlerTimeout)
+	defer cancel()
 	id, ok := parseUUID(w, r, "id")
 	if !ok {
 		return
 	}
-	result, err := h.client.Portfolio.Get(r.Context(), id)
+	query := h.client.Portfolio.Query().Where(portfolio.ID(id))
+	withIncludes(r, query, portfolioIncludes)
+	result, err := query.Only(ctx)
 	if err != nil {
 		entErrorToHTTP(w, err)
 		return
 	}
+	setETag(w, entityETag(result.UpdatedAt))
 	writeJSON(w, http.StatusOK, result)
 }
 
+func (h *PropertyHandler) ListPropertiesOfPortfolio(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), handlerTimeout)
+	defer cancel()
+	id, ok := parseUUID(w, r, "id")
+	if !ok {
+		return
+	}
+	parent, err := h.client.Portfolio.Get(ctx, id)
+	if err != nil {
+		entErrorToHTTP(w, err)
+		return
+	}
+	pg := parsePagination(r)
+	query := h.client.Portfolio.QueryProperties(parent)
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		entErrorToHTTP(w, err)
+		return
+	}
+	items, err := query.
+		Limit(pg.Limit).Offset(pg.Offset).
+		Order(listOrder(r, nil, property.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		entErrorToHTTP(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, listResponse{Data: items, Total: total, Offset: pg.Offset, Limit: pg.Limit})
+}
+
+func (h *PropertyHandler) CheckPortfolioExists(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), handlerTimeout)
+	defer cancel()
+	id, ok := parseUUID(w, r, "id")
+	if !ok {
+		return
+	}
+	exists, err := h.client.Portfolio.Query().Where(portfolio.ID(id)).Exist(ctx)
+	if err != nil {
+		entErrorToHTTP(w, err)
+		return
+	}
+	if !exists {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// portfolioSortColumns allowlists the ?sort= values accepted by ListPortfolios.
+var portfolioSortColumns = map[string]string{
+	"created_at":                   portfolio.FieldCreatedAt,
+	"updated_at":                   portfolio.FieldUpdatedAt,
+	"name":                         portfolio.FieldName,
+	"management_type":              portfolio.FieldManagementType,
+	"description":                  portfolio.FieldDescription,
+	"status":                       portfolio.FieldStatus,
+	"default_chart_of_accounts_id": portfolio.FieldDefaultChartOfAccountsID,
+	"default_bank_account_id":      portfolio.FieldDefaultBankAccountID,
+}
+
 func (h *PropertyHandler) ListPortfolios(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), handlerTimeout)
+	defer cancel()
 	pg := parsePagination(r)
-	items, err := h.client.Portfolio.Query().
+	query := h.client.Portfolio.Query()
+	if q := r.URL.Query().Get("q"); q != "" {
+		query.Where(portfolio.NameContainsFold(q))
+	}
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		entErrorToHTTP(w, err)
+		return
+	}
+	withIncludes(r, query, portfolioIncludes)
+	items, err := query.
 		Limit(pg.Limit).Offset(pg.Offset).
-		Order(ent.Desc(portfolio.FieldCreatedAt)).
-		All(r.Context())
+		Order(listOrder(r, portfolioSortColumns, portfolio.FieldCreatedAt)).
+		All(ctx)
 	if err != nil {
 		entErrorToHTTP(w, err)
 		return
 	}
-	writeJSON(w, http.StatusOK, items)
+	writeJSON(w, http.StatusOK, listResponse{Data: items, Total: total, Offset: pg.Offset, Limit: pg.Limit})
 }
 
 type updatePortfolioRequest struct {
@@ -126,7 +239,26 @@ type updatePortfolioRequest struct {
 	OwnerID                  *string `json:"owner_id,omitempty"`
 }
 
+func validatePortfolioUpdate(req *updatePortfolioRequest) fieldErrors {
+	errs := fieldErrors{}
+	if req.Name != nil {
+		errs.add(checkRequired("name", *req.Name == ""))
+	}
+	if req.ManagementType != nil {
+		errs.add(checkEnum("management_type", *req.ManagementType, portfolioManagementTypeValues))
+	}
+	if req.Status != nil {
+		errs.add(checkEnum("status", *req.Status, portfolioStatusValues))
+	}
+	if req.OwnerID != nil {
+		errs.add(checkID("owner_id", *req.OwnerID))
+	}
+	return errs
+}
+
 func (h *PropertyHandler) UpdatePortfolio(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), handlerTimeout)
+	defer cancel()
 	id, ok := parseUUID(w, r, "id")
 	if !ok {
 		return
@@ -136,11 +268,31 @@ func (h *PropertyHandler) UpdatePortfolio(w http.ResponseWriter, r *http.Request
 		return
 	}
 	var req updatePortfolioRequest
-	if err := decodeJSON(r, &req); err != nil {
+	patch, err := decodeUpdate(r, &req)
+	if err != nil {
 		writeError(w, http.StatusBadRequest, "INVALID_JSON", err.Error())
 		return
 	}
+	if field := patch.firstNull("name", "management_type", "status", "owner_id"); field != "" {
+		writeError(w, http.StatusBadRequest, "INVALID_PATCH", field+" cannot be null")
+		return
+	}
+	if errs := validatePortfolioUpdate(&req); len(errs) > 0 {
+		writeFieldErrors(w, errs)
+		return
+	}
 	builder := h.client.Portfolio.UpdateOneID(id)
+	if hasIfMatch(r) {
+		current, err := h.client.Portfolio.Get(ctx, id)
+		if err != nil {
+			entErrorToHTTP(w, err)
+			return
+		}
+		if !checkIfMatch(w, r, entityETag(current.UpdatedAt)) {
+			return
+		}
+		builder.Where(portfolio.UpdatedAtEQ(current.UpdatedAt))
+	}
 	if req.Name != nil {
 		builder.SetName(*req.Name)
 	}
@@ -160,26 +312,33 @@ func (h *PropertyHandler) UpdatePortfolio(w http.ResponseWriter, r *http.Request
 		builder.SetNillableDefaultBankAccountID(req.DefaultBankAccountID)
 	}
 	if req.OwnerID != nil {
-		uid, err := uuid.Parse(*req.OwnerID)
-		if err != nil {
-			writeError(w, http.StatusBadRequest, "INVALID_ID", "invalid owner_id")
-			return
-		}
-		builder.SetOwnerID(uid)
+		builder.SetOwnerID(uuid.MustParse(*req.OwnerID))
+	}
+	if patch.isNull("description") {
+		builder.ClearDescription()
+	}
+	if patch.isNull("default_chart_of_accounts_id") {
+		builder.ClearDefaultChartOfAccountsID()
+	}
+	if patch.isNull("default_bank_account_id") {
+		builder.ClearDefaultBankAccountID()
 	}
 	builder.SetUpdatedBy(audit.Actor).SetSource(portfolio.Source(audit.Source))
 	if audit.CorrelationID != nil {
 		builder.SetCorrelationID(*audit.CorrelationID)
 	}
-	result, err := builder.Save(r.Context())
+	result, err := saveAudited[*ent.Portfolio](ctx, h.client, h.audit, AuditOpUpdate, audit, builder.Mutation())
 	if err != nil {
-		entErrorToHTTP(w, err)
+		writeGuardedSaveError(w, r, err)
 		return
 	}
+	setETag(w, entityETag(result.UpdatedAt))
 	writeJSON(w, http.StatusOK, result)
 }
 
 func (h *PropertyHandler) transitionPortfolio(w http.ResponseWriter, r *http.Request, targetStatus string, applyExtra func(*ent.PortfolioUpdateOne)) {
+	ctx, cancel := context.WithTimeout(r.Context(), handlerTimeout)
+	defer cancel()
 	id, ok := parseUUID(w, r, "id")
 	if !ok {
 		return
@@ -188,13 +347,12 @@ func (h *PropertyHandler) transitionPortfolio(w http.ResponseWriter, r *http.Req
 	if !ok {
 		return
 	}
-	current, err := h.client.Portfolio.Get(r.Context(), id)
+	current, err := h.client.Portfolio.Get(ctx, id)
 	if err != nil {
 		entErrorToHTTP(w, err)
 		return
 	}
-	if err := ValidateTransition(schema.ValidPortfolioTransitions, string(current.Status), targetStatus); err != nil {
-		writeError(w, http.StatusConflict, "INVALID_TRANSITION", err.Error())
+	if !checkIfMatch(w, r, entityETag(current.UpdatedAt)) {
 		return
 	}
 	builder := h.client.Portfolio.UpdateOneID(id).
@@ -204,14 +362,22 @@ func (h *PropertyHandler) transitionPortfolio(w http.ResponseWriter, r *http.Req
 	if audit.CorrelationID != nil {
 		builder.SetCorrelationID(*audit.CorrelationID)
 	}
+	if hasIfMatch(r) {
+		builder.Where(portfolio.UpdatedAtEQ(current.UpdatedAt))
+	}
 	if applyExtra != nil {
 		applyExtra(builder)
 	}
-	updated, err := builder.Save(r.Context())
+	if err := schema.ValidateTransitionWithGuard(schema.ValidPortfolioTransitions, schema.PortfolioTransitionGuards, string(current.Status), targetStatus, guardValues(ctx, builder.Mutation())); err != nil {
+		writeError(w, http.StatusConflict, "INVALID_TRANSITION", err.Error())
+		return
+	}
+	updated, err := saveAudited[*ent.Portfolio](ctx, h.client, h.audit, AuditOpTransition, audit, builder.Mutation())
 	if err != nil {
-		entErrorToHTTP(w, err)
+		writeGuardedSaveError(w, r, err)
 		return
 	}
+	setETag(w, entityETag(updated.UpdatedAt))
 	writeJSON(w, http.StatusOK, updated)
 }
 
@@ -223,6 +389,12 @@ func (h *PropertyHandler) ActivatePortfolio(w http.ResponseWriter, r *http.Reque
 // Property
 // ============================================================================
 
+// Accepted values of the Property enum fields.
+var (
+	propertyPropertyTypeValues = []string{"single_family", "multi_family", "commercial_office", "commercial_retail", "mixed_use", "industrial", "affordable_housing", "student_housing", "senior_living", "vacation_rental", "mobile_home_park", "self_storage", "coworking", "data_center", "medical_office"}
+	propertyStatusValues       = []string{"active", "inactive", "under_renovation", "for_sale", "onboarding"}
+)
+
 type createPropertyRequest struct {
 	Name                   string        `json:"name"`
 	Address                types.Address `json:"address"`
@@ -245,17 +417,38 @@ type createPropertyRequest struct {
 	BankAccountID          *string       `json:"bank_account_id,omitempty"`
 }
 
-func (h *PropertyHandler) CreateProperty(w http.ResponseWriter, r *http.Request) {
-	audit, ok := parseAuditContext(w, r)
-	if !ok {
-		return
+func validatePropertyCreate(req *createPropertyRequest) fieldErrors {
+	errs := fieldErrors{}
+	errs.add(checkRequired("name", req.Name == ""))
+	errs.add(checkRequired("property_type", req.PropertyType == ""))
+	errs.add(checkEnum("property_type", req.PropertyType, propertyPropertyTypeValues))
+	errs.add(checkRequired("status", req.Status == ""))
+	errs.add(checkEnum("status", req.Status, propertyStatusValues))
+	errs.add(checkMin("year_built", float64(req.YearBuilt), 1800, false))
+	errs.add(checkMax("year_built", float64(req.YearBuilt), 2030, false))
+	errs.add(checkMin("total_square_footage", float64(req.TotalSquareFootage), 0, true))
+	errs.add(checkMin("total_spaces", float64(req.TotalSpaces), 1, false))
+	if req.LotSizeSqft != nil {
+		errs.add(checkMin("lot_size_sqft", float64(*req.LotSizeSqft), 0, true))
 	}
-	var req createPropertyRequest
-	if err := decodeJSON(r, &req); err != nil {
-		writeError(w, http.StatusBadRequest, "INVALID_JSON", err.Error())
-		return
+	if req.Stories != nil {
+		errs.add(checkMin("stories", float64(*req.Stories), 1, false))
 	}
-	builder := h.client.Property.Create()
+	if req.ParkingSpaces != nil {
+		errs.add(checkMin("parking_spaces", float64(*req.ParkingSpaces), 0, false))
+	}
+	if req.JurisdictionID != nil {
+		errs.add(checkRequired("jurisdiction_id", *req.JurisdictionID == ""))
+	}
+	errs.add(checkRequired("portfolio_id", req.PortfolioID == ""))
+	errs.add(checkID("portfolio_id", req.PortfolioID))
+	if req.BankAccountID != nil {
+		errs.add(checkID("bank_account_id", *req.BankAccountID))
+	}
+	return errs
+}
+
+func (req *createPropertyRequest) apply(builder *ent.PropertyCreate) {
 	builder.SetName(req.Name)
 	builder.SetAddress(&req.Address)
 	builder.SetPropertyType(property.PropertyType(req.PropertyType))
@@ -289,27 +482,35 @@ func (h *PropertyHandler) CreateProperty(w http.ResponseWriter, r *http.Request)
 	if req.InsuranceExpiry != nil {
 		builder.SetNillableInsuranceExpiry(req.InsuranceExpiry)
 	}
-	{
-		uid, err := uuid.Parse(req.PortfolioID)
-		if err != nil {
-			writeError(w, http.StatusBadRequest, "INVALID_ID", "invalid portfolio_id")
-			return
-		}
-		builder.SetPortfolioID(uid)
-	}
+	builder.SetPortfolioID(uuid.MustParse(req.PortfolioID))
 	if req.BankAccountID != nil {
-		uid, err := uuid.Parse(*req.BankAccountID)
-		if err != nil {
-			writeError(w, http.StatusBadRequest, "INVALID_ID", "invalid bank_account_id")
-			return
-		}
-		builder.SetBankAccountID(uid)
+		builder.SetBankAccountID(uuid.MustParse(*req.BankAccountID))
+	}
+}
+
+func (h *PropertyHandler) CreateProperty(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), handlerTimeout)
+	defer cancel()
+	audit, ok := parseAuditContext(w, r)
+	if !ok {
+		return
+	}
+	var req createPropertyRequest
+	if err := decodeJSON(r, &req); err != nil {
+		writeError(w, http.StatusBadRequest, "INVALID_JSON", err.Error())
+		return
+	}
+	if errs := validatePropertyCreate(&req); len(errs) > 0 {
+		writeFieldErrors(w, errs)
+		return
 	}
+	builder := h.client.Property.Create()
+	req.apply(builder)
 	builder.SetCreatedBy(audit.Actor).SetUpdatedBy(audit.Actor).SetSource(property.Source(audit.Source))
 	if audit.CorrelationID != nil {
 		builder.SetCorrelationID(*audit.CorrelationID)
 	}
-	result, err := builder.Save(r.Context())
+	result, err := saveAudited[*ent.Property](ctx, h.client, h.audit, AuditOpCreate, audit, builder.Mutation())
 	if err != nil {
 		entErrorToHTTP(w, err)
 		return
@@ -317,30 +518,248 @@ func (h *PropertyHandler) CreateProperty(w http.ResponseWriter, r *http.Request)
 	writeJSON(w, http.StatusCreated, result)
 }
 
+// propertyIncludes maps ?include= edge names to eager loaders on a Property query.
+var propertyIncludes = map[string]func(*ent.PropertyQuery){
+	"portfolio":              func(q *ent.PropertyQuery) { q.WithPortfolio() },
+	"buildings":              func(q *ent.PropertyQuery) { q.WithBuildings() },
+	"spaces":                 func(q *ent.PropertyQuery) { q.WithSpaces() },
+	"bank_account":           func(q *ent.PropertyQuery) { q.WithBankAccount() },
+	"applications":           func(q *ent.PropertyQuery) { q.WithApplications() },
+	"ledger_entries":         func(q *ent.PropertyQuery) { q.WithLedgerEntries() },
+	"property_jurisdictions": func(q *ent.PropertyQuery) { q.WithPropertyJurisdictions() },
+}
+
 func (h *PropertyHandler) GetProperty(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), handlerTimeout)
+	defer cancel()
 	id, ok := parseUUID(w, r, "id")
 	if !ok {
 		return
 	}
-	result, err := h.client.Property.Get(r.Context(), id)
+	query := h.client.Property.Query().Where(property.ID(id))
+	withIncludes(r, query, propertyIncludes)
+	result, err := query.Only(ctx)
 	if err != nil {
 		entErrorToHTTP(w, err)
 		return
 	}
+	setETag(w, entityETag(result.UpdatedAt))
 	writeJSON(w, http.StatusOK, result)
 }
 
+func (h *PropertyHandler) ListBuildingsOfProperty(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), handlerTimeout)
+	defer cancel()
+	id, ok := parseUUID(w, r, "id")
+	if !ok {
+		return
+	}
+	parent, err := h.client.Property.Get(ctx, id)
+	if err != nil {
+		entErrorToHTTP(w, err)
+		return
+	}
+	pg := parsePagination(r)
+	query := h.client.Property.QueryBuildings(parent)
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		entErrorToHTTP(w, err)
+		return
+	}
+	items, err := query.
+		Limit(pg.Limit).Offset(pg.Offset).
+		Order(listOrder(r, nil, building.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		entErrorToHTTP(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, listResponse{Data: items, Total: total, Offset: pg.Offset, Limit: pg.Limit})
+}
+
+func (h *PropertyHandler) ListSpacesOfProperty(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), handlerTimeout)
+	defer cancel()
+	id, ok := parseUUID(w, r, "id")
+	if !ok {
+		return
+	}
+	parent, err := h.client.Property.Get(ctx, id)
+	if err != nil {
+		entErrorToHTTP(w, err)
+		return
+	}
+	pg := parsePagination(r)
+	query := h.client.Property.QuerySpaces(parent)
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		entErrorToHTTP(w, err)
+		return
+	}
+	items, err := query.
+		Limit(pg.Limit).Offset(pg.Offset).
+		Order(listOrder(r, nil, space.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		entErrorToHTTP(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, listResponse{Data: items, Total: total, Offset: pg.Offset, Limit: pg.Limit})
+}
+
+func (h *PropertyHandler) ListApplicationsOfProperty(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), handlerTimeout)
+	defer cancel()
+	id, ok := parseUUID(w, r, "id")
+	if !ok {
+		return
+	}
+	parent, err := h.client.Property.Get(ctx, id)
+	if err != nil {
+		entErrorToHTTP(w, err)
+		return
+	}
+	pg := parsePagination(r)
+	query := h.client.Property.QueryApplications(parent)
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		entErrorToHTTP(w, err)
+		return
+	}
+	items, err := query.
+		Limit(pg.Limit).Offset(pg.Offset).
+		Order(listOrder(r, nil, application.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		entErrorToHTTP(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, listResponse{Data: items, Total: total, Offset: pg.Offset, Limit: pg.Limit})
+}
+
+func (h *PropertyHandler) ListLedgerEntriesOfProperty(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), handlerTimeout)
+	defer cancel()
+	id, ok := parseUUID(w, r, "id")
+	if !ok {
+		return
+	}
+	parent, err := h.client.Property.Get(ctx, id)
+	if err != nil {
+		entErrorToHTTP(w, err)
+		return
+	}
+	pg := parsePagination(r)
+	query := h.client.Property.QueryLedgerEntries(parent)
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		entErrorToHTTP(w, err)
+		return
+	}
+	items, err := query.
+		Limit(pg.Limit).Offset(pg.Offset).
+		Order(listOrder(r, nil, ledgerentry.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		entErrorToHTTP(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, listResponse{Data: items, Total: total, Offset: pg.Offset, Limit: pg.Limit})
+}
+
+func (h *PropertyHandler) ListPropertyJurisdictionsOfProperty(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), handlerTimeout)
+	defer cancel()
+	id, ok := parseUUID(w, r, "id")
+	if !ok {
+		return
+	}
+	parent, err := h.client.Property.Get(ctx, id)
+	if err != nil {
+		entErrorToHTTP(w, err)
+		return
+	}
+	pg := parsePagination(r)
+	query := h.client.Property.QueryPropertyJurisdictions(parent)
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		entErrorToHTTP(w, err)
+		return
+	}
+	items, err := query.
+		Limit(pg.Limit).Offset(pg.Offset).
+		Order(listOrder(r, nil, propertyjurisdiction.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		entErrorToHTTP(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, listResponse{Data: items, Total: total, Offset: pg.Offset, Limit: pg.Limit})
+}
+
+func (h *PropertyHandler) CheckPropertyExists(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), handlerTimeout)
+	defer cancel()
+	id, ok := parseUUID(w, r, "id")
+	if !ok {
+		return
+	}
+	exists, err := h.client.Property.Query().Where(property.ID(id)).Exist(ctx)
+	if err != nil {
+		entErrorToHTTP(w, err)
+		return
+	}
+	if !exists {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// propertySortColumns allowlists the ?sort= values accepted by ListProperties.
+var propertySortColumns = map[string]string{
+	"created_at":               property.FieldCreatedAt,
+	"updated_at":               property.FieldUpdatedAt,
+	"name":                     property.FieldName,
+	"property_type":            property.FieldPropertyType,
+	"status":                   property.FieldStatus,
+	"year_built":               property.FieldYearBuilt,
+	"total_square_footage":     property.FieldTotalSquareFootage,
+	"total_spaces":             property.FieldTotalSpaces,
+	"lot_size_sqft":            property.FieldLotSizeSqft,
+	"stories":                  property.FieldStories,
+	"parking_spaces":           property.FieldParkingSpaces,
+	"jurisdiction_id":          property.FieldJurisdictionID,
+	"rent_controlled":          property.FieldRentControlled,
+	"requires_lead_disclosure": property.FieldRequiresLeadDisclosure,
+	"chart_of_accounts_id":     property.FieldChartOfAccountsID,
+	"insurance_policy_number":  property.FieldInsurancePolicyNumber,
+	"insurance_expiry":         property.FieldInsuranceExpiry,
+}
+
 func (h *PropertyHandler) ListProperties(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), handlerTimeout)
+	defer cancel()
 	pg := parsePagination(r)
-	items, err := h.client.Property.Query().
+	query := h.client.Property.Query()
+	if q := r.URL.Query().Get("q"); q != "" {
+		query.Where(property.NameContainsFold(q))
+	}
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		entErrorToHTTP(w, err)
+		return
+	}
+	withIncludes(r, query, propertyIncludes)
+	items, err := query.
 		Limit(pg.Limit).Offset(pg.Offset).
-		Order(ent.Desc(property.FieldCreatedAt)).
-		All(r.Context())
+		Order(listOrder(r, propertySortColumns, property.FieldCreatedAt)).
+		All(ctx)
 	if err != nil {
 		entErrorToHTTP(w, err)
 		return
 	}
-	writeJSON(w, http.StatusOK, items)
+	writeJSON(w, http.StatusOK, listResponse{Data: items, Total: total, Offset: pg.Offset, Limit: pg.Limit})
 }
 
 type updatePropertyRequest struct {
@@ -365,7 +784,51 @@ type updatePropertyRequest struct {
 	BankAccountID          *string        `json:"bank_account_id,omitempty"`
 }
 
+func validatePropertyUpdate(req *updatePropertyRequest) fieldErrors {
+	errs := fieldErrors{}
+	if req.Name != nil {
+		errs.add(checkRequired("name", *req.Name == ""))
+	}
+	if req.PropertyType != nil {
+		errs.add(checkEnum("property_type", *req.PropertyType, propertyPropertyTypeValues))
+	}
+	if req.Status != nil {
+		errs.add(checkEnum("status", *req.Status, propertyStatusValues))
+	}
+	if req.YearBuilt != nil {
+		errs.add(checkMin("year_built", float64(*req.YearBuilt), 1800, false))
+		errs.add(checkMax("year_built", float64(*req.YearBuilt), 2030, false))
+	}
+	if req.TotalSquareFootage != nil {
+		errs.add(checkMin("total_square_footage", float64(*req.TotalSquareFootage), 0, true))
+	}
+	if req.TotalSpaces != nil {
+		errs.add(checkMin("total_spaces", float64(*req.TotalSpaces), 1, false))
+	}
+	if req.LotSizeSqft != nil {
+		errs.add(checkMin("lot_size_sqft", float64(*req.LotSizeSqft), 0, true))
+	}
+	if req.Stories != nil {
+		errs.add(checkMin("stories", float64(*req.Stories), 1, false))
+	}
+	if req.ParkingSpaces != nil {
+		errs.add(checkMin("parking_spaces", float64(*req.ParkingSpaces), 0, false))
+	}
+	if req.JurisdictionID != nil {
+		errs.add(checkRequired("jurisdiction_id", *req.JurisdictionID == ""))
+	}
+	if req.PortfolioID != nil {
+		errs.add(checkID("portfolio_id", *req.PortfolioID))
+	}
+	if req.BankAccountID != nil {
+		errs.add(checkID("bank_account_id", *req.BankAccountID))
+	}
+	return errs
+}
+
 func (h *PropertyHandler) UpdateProperty(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), handlerTimeout)
+	defer cancel()
 	id, ok := parseUUID(w, r, "id")
 	if !ok {
 		return
@@ -375,11 +838,42 @@ func (h *PropertyHandler) UpdateProperty(w http.ResponseWriter, r *http.Request)
 		return
 	}
 	var req updatePropertyRequest
-	if err := decodeJSON(r, &req); err != nil {
+	patch, err := decodeUpdate(r, &req)
+	if err != nil {
 		writeError(w, http.StatusBadRequest, "INVALID_JSON", err.Error())
 		return
 	}
+	if field := patch.firstNull("name", "address", "property_type", "status", "year_built", "total_square_footage", "total_spaces", "rent_controlled", "requires_lead_disclosure", "portfolio_id"); field != "" {
+		writeError(w, http.StatusBadRequest, "INVALID_PATCH", field+" cannot be null")
+		return
+	}
+	if patch.hasObject("address") {
+		stored, err := h.client.Property.Get(ctx, id)
+		if err != nil {
+			entErrorToHTTP(w, err)
+			return
+		}
+		if req.Address, err = mergeObject(patch, "address", stored.Address, req.Address); err != nil {
+			writeError(w, http.StatusBadRequest, "INVALID_JSON", err.Error())
+			return
+		}
+	}
+	if errs := validatePropertyUpdate(&req); len(errs) > 0 {
+		writeFieldErrors(w, errs)
+		return
+	}
 	builder := h.client.Property.UpdateOneID(id)
+	if hasIfMatch(r) {
+		current, err := h.client.Property.Get(ctx, id)
+		if err != nil {
+			entErrorToHTTP(w, err)
+			return
+		}
+		if !checkIfMatch(w, r, entityETag(current.UpdatedAt)) {
+			return
+		}
+		builder.Where(property.UpdatedAtEQ(current.UpdatedAt))
+	}
 	if req.Name != nil {
 		builder.SetName(*req.Name)
 	}
@@ -432,34 +926,54 @@ func (h *PropertyHandler) UpdateProperty(w http.ResponseWriter, r *http.Request)
 		builder.SetNillableInsuranceExpiry(req.InsuranceExpiry)
 	}
 	if req.PortfolioID != nil {
-		uid, err := uuid.Parse(*req.PortfolioID)
-		if err != nil {
-			writeError(w, http.StatusBadRequest, "INVALID_ID", "invalid portfolio_id")
-			return
-		}
-		builder.SetPortfolioID(uid)
+		builder.SetPortfolioID(uuid.MustParse(*req.PortfolioID))
 	}
 	if req.BankAccountID != nil {
-		uid, err := uuid.Parse(*req.BankAccountID)
-		if err != nil {
-			writeError(w, http.StatusBadRequest, "INVALID_ID", "invalid bank_account_id")
-			return
-		}
-		builder.SetBankAccountID(uid)
+		builder.SetBankAccountID(uuid.MustParse(*req.BankAccountID))
+	}
+	if patch.isNull("lot_size_sqft") {
+		builder.ClearLotSizeSqft()
+	}
+	if patch.isNull("stories") {
+		builder.ClearStories()
+	}
+	if patch.isNull("parking_spaces") {
+		builder.ClearParkingSpaces()
+	}
+	if patch.isNull("jurisdiction_id") {
+		builder.ClearJurisdictionID()
+	}
+	if patch.isNull("compliance_programs") {
+		builder.ClearCompliancePrograms()
+	}
+	if patch.isNull("chart_of_accounts_id") {
+		builder.ClearChartOfAccountsID()
+	}
+	if patch.isNull("insurance_policy_number") {
+		builder.ClearInsurancePolicyNumber()
+	}
+	if patch.isNull("insurance_expiry") {
+		builder.ClearInsuranceExpiry()
+	}
+	if patch.isNull("bank_account_id") {
+		builder.ClearBankAccount()
 	}
 	builder.SetUpdatedBy(audit.Actor).SetSource(property.Source(audit.Source))
 	if audit.CorrelationID != nil {
 		builder.SetCorrelationID(*audit.CorrelationID)
 	}
-	result, err := builder.Save(r.Context())
+	result, err := saveAudited[*ent.Property](ctx, h.client, h.audit, AuditOpUpdate, audit, builder.Mutation())
 	if err != nil {
-		entErrorToHTTP(w, err)
+		writeGuardedSaveError(w, r, err)
 		return
 	}
+	setETag(w, entityETag(result.UpdatedAt))
 	writeJSON(w, http.StatusOK, result)
 }
 
 func (h *PropertyHandler) transitionProperty(w http.ResponseWriter, r *http.Request, targetStatus string, applyExtra func(*ent.PropertyUpdateOne)) {
+	ctx, cancel := context.WithTimeout(r.Context(), handlerTimeout)
+	defer cancel()
 	id, ok := parseUUID(w, r, "id")
 	if !ok {
 		return
@@ -468,13 +982,12 @@ func (h *PropertyHandler) transitionProperty(w http.ResponseWriter, r *http.Requ
 	if !ok {
 		return
 	}
-	current, err := h.client.Property.Get(r.Context(), id)
+	current, err := h.client.Property.Get(ctx, id)
 	if err != nil {
 		entErrorToHTTP(w, err)
 		return
 	}
-	if err := ValidateTransition(schema.ValidPropertyTransitions, string(current.Status), targetStatus); err != nil {
-		writeError(w, http.StatusConflict, "INVALID_TRANSITION", err.Error())
+	if !checkIfMatch(w, r, entityETag(current.UpdatedAt)) {
 		return
 	}
 	builder := h.client.Property.UpdateOneID(id).
@@ -484,14 +997,22 @@ func (h *PropertyHandler) transitionProperty(w http.ResponseWriter, r *http.Requ
 	if audit.CorrelationID != nil {
 		builder.SetCorrelationID(*audit.CorrelationID)
 	}
+	if hasIfMatch(r) {
+		builder.Where(property.UpdatedAtEQ(current.UpdatedAt))
+	}
 	if applyExtra != nil {
 		applyExtra(builder)
 	}
-	updated, err := builder.Save(r.Context())
+	if err := schema.ValidateTransitionWithGuard(schema.ValidPropertyTransitions, schema.PropertyTransitionGuards, string(current.Status), targetStatus, guardValues(ctx, builder.Mutation())); err != nil {
+		writeError(w, http.StatusConflict, "INVALID_TRANSITION", err.Error())
+		return
+	}
+	updated, err := saveAudited[*ent.Property](ctx, h.client, h.audit, AuditOpTransition, audit, builder.Mutation())
 	if err != nil {
-		entErrorToHTTP(w, err)
+		writeGuardedSaveError(w, r, err)
 		return
 	}
+	setETag(w, entityETag(updated.UpdatedAt))
 	writeJSON(w, http.StatusOK, updated)
 }
 
@@ -503,6 +1024,12 @@ func (h *PropertyHandler) ActivateProperty(w http.ResponseWriter, r *http.Reques
 // Building
 // ============================================================================
 
+// Accepted values of the Building enum fields.
+var (
+	buildingBuildingTypeValues = []string{"residential", "commercial", "mixed_use", "parking_structure", "industrial", "storage", "auxiliary"}
+	buildingStatusValues       = []string{"active", "inactive", "under_renovation"}
+)
+
 type createBuildingRequest struct {
 	Name                       string         `json:"name"`
 	BuildingType               string         `json:"building_type"`
@@ -516,17 +1043,32 @@ type createBuildingRequest struct {
 	PropertyID                 string         `json:"property_id"`
 }
 
-func (h *PropertyHandler) CreateBuilding(w http.ResponseWriter, r *http.Request) {
-	audit, ok := parseAuditContext(w, r)
-	if !ok {
-		return
+func validateBuildingCreate(req *createBuildingRequest) fieldErrors {
+	errs := fieldErrors{}
+	errs.add(checkRequired("name", req.Name == ""))
+	errs.add(checkRequired("building_type", req.BuildingType == ""))
+	errs.add(checkEnum("building_type", req.BuildingType, buildingBuildingTypeValues))
+	errs.add(checkRequired("status", req.Status == ""))
+	errs.add(checkEnum("status", req.Status, buildingStatusValues))
+	if req.Floors != nil {
+		errs.add(checkMin("floors", float64(*req.Floors), 1, false))
 	}
-	var req createBuildingRequest
-	if err := decodeJSON(r, &req); err != nil {
-		writeError(w, http.StatusBadRequest, "INVALID_JSON", err.Error())
-		return
+	if req.YearBuilt != nil {
+		errs.add(checkMin("year_built", float64(*req.YearBuilt), 1800, false))
+		errs.add(checkMax("year_built", float64(*req.YearBuilt), 2030, false))
 	}
-	builder := h.client.Building.Create()
+	if req.TotalSquareFootage != nil {
+		errs.add(checkMin("total_square_footage", float64(*req.TotalSquareFootage), 0, true))
+	}
+	if req.TotalRentableSquareFootage != nil {
+		errs.add(checkMin("total_rentable_square_footage", float64(*req.TotalRentableSquareFootage), 0, true))
+	}
+	errs.add(checkRequired("property_id", req.PropertyID == ""))
+	errs.add(checkID("property_id", req.PropertyID))
+	return errs
+}
+
+func (req *createBuildingRequest) apply(builder *ent.BuildingCreate) {
 	builder.SetName(req.Name)
 	builder.SetBuildingType(building.BuildingType(req.BuildingType))
 	if req.Address != nil {
@@ -548,19 +1090,32 @@ func (h *PropertyHandler) CreateBuilding(w http.ResponseWriter, r *http.Request)
 	if req.TotalRentableSquareFootage != nil {
 		builder.SetNillableTotalRentableSquareFootage(req.TotalRentableSquareFootage)
 	}
-	{
-		uid, err := uuid.Parse(req.PropertyID)
-		if err != nil {
-			writeError(w, http.StatusBadRequest, "INVALID_ID", "invalid property_id")
-			return
-		}
-		builder.SetPropertyID(uid)
+	builder.SetPropertyID(uuid.MustParse(req.PropertyID))
+}
+
+func (h *PropertyHandler) CreateBuilding(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), handlerTimeout)
+	defer cancel()
+	audit, ok := parseAuditContext(w, r)
+	if !ok {
+		return
+	}
+	var req createBuildingRequest
+	if err := decodeJSON(r, &req); err != nil {
+		writeError(w, http.StatusBadRequest, "INVALID_JSON", err.Error())
+		return
+	}
+	if errs := validateBuildingCreate(&req); len(errs) > 0 {
+		writeFieldErrors(w, errs)
+		return
 	}
+	builder := h.client.Building.Create()
+	req.apply(builder)
 	builder.SetCreatedBy(audit.Actor).SetUpdatedBy(audit.Actor).SetSource(building.Source(audit.Source))
 	if audit.CorrelationID != nil {
 		builder.SetCorrelationID(*audit.CorrelationID)
 	}
-	result, err := builder.Save(r.Context())
+	result, err := saveAudited[*ent.Building](ctx, h.client, h.audit, AuditOpCreate, audit, builder.Mutation())
 	if err != nil {
 		entErrorToHTTP(w, err)
 		return
@@ -568,30 +1123,124 @@ func (h *PropertyHandler) CreateBuilding(w http.ResponseWriter, r *http.Request)
 	writeJSON(w, http.StatusCreated, result)
 }
 
+// buildingIncludes maps ?include= edge names to eager loaders on a Building query.
+var buildingIncludes = map[string]func(*ent.BuildingQuery){
+	"property": func(q *ent.BuildingQuery) { q.WithProperty() },
+	"spaces":   func(q *ent.BuildingQuery) { q.WithSpaces() },
+}
+
 func (h *PropertyHandler) GetBuilding(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), handlerTimeout)
+	defer cancel()
 	id, ok := parseUUID(w, r, "id")
 	if !ok {
 		return
 	}
-	result, err := h.client.Building.Get(r.Context(), id)
+	query := h.client.Building.Query().Where(building.ID(id))
+	withIncludes(r, query, buildingIncludes)
+	result, err := query.Only(ctx)
 	if err != nil {
 		entErrorToHTTP(w, err)
 		return
 	}
+	setETag(w, versionETag(result.Version))
 	writeJSON(w, http.StatusOK, result)
 }
 
+func (h *PropertyHandler) ListSpacesOfBuilding(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), handlerTimeout)
+	defer cancel()
+	id, ok := parseUUID(w, r, "id")
+	if !ok {
+		return
+	}
+	parent, err := h.client.Building.Get(ctx, id)
+	if err != nil {
+		entErrorToHTTP(w, err)
+		return
+	}
+	pg := parsePagination(r)
+	query := h.client.Building.QuerySpaces(parent)
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		entErrorToHTTP(w, err)
+		return
+	}
+	items, err := query.
+		Limit(pg.Limit).Offset(pg.Offset).
+		Order(listOrder(r, nil, space.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		entErrorToHTTP(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, listResponse{Data: items, Total: total, Offset: pg.Offset, Limit: pg.Limit})
+}
+
+func (h *PropertyHandler) CheckBuildingExists(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), handlerTimeout)
+	defer cancel()
+	id, ok := parseUUID(w, r, "id")
+	if !ok {
+		return
+	}
+	exists, err := h.client.Building.Query().Where(building.ID(id)).Exist(ctx)
+	if err != nil {
+		entErrorToHTTP(w, err)
+		return
+	}
+	if !exists {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// buildingSortColumns allowlists the ?sort= values accepted by ListBuildings.
+var buildingSortColumns = map[string]string{
+	"created_at":                    building.FieldCreatedAt,
+	"updated_at":                    building.FieldUpdatedAt,
+	"name":                          building.FieldName,
+	"building_type":                 building.FieldBuildingType,
+	"description":                   building.FieldDescription,
+	"status":                        building.FieldStatus,
+	"floors":                        building.FieldFloors,
+	"year_built":                    building.FieldYearBuilt,
+	"total_square_footage":          building.FieldTotalSquareFootage,
+	"total_rentable_square_footage": building.FieldTotalRentableSquareFootage,
+}
+
 func (h *PropertyHandler) ListBuildings(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), handlerTimeout)
+	defer cancel()
 	pg := parsePagination(r)
-	items, err := h.client.Building.Query().
+	query := h.client.Building.Query()
+	if q := r.URL.Query().Get("q"); q != "" {
+		query.Where(building.NameContainsFold(q))
+	}
+	if v := r.URL.Query().Get("property_id"); v != "" {
+		uid, err := uuid.Parse(v)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "INVALID_FILTER", "invalid property_id")
+			return
+		}
+		query.Where(building.HasPropertyWith(property.ID(uid)))
+	}
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		entErrorToHTTP(w, err)
+		return
+	}
+	withIncludes(r, query, buildingIncludes)
+	items, err := query.
 		Limit(pg.Limit).Offset(pg.Offset).
-		Order(ent.Desc(building.FieldCreatedAt)).
-		All(r.Context())
+		Order(listOrder(r, buildingSortColumns, building.FieldCreatedAt)).
+		All(ctx)
 	if err != nil {
 		entErrorToHTTP(w, err)
 		return
 	}
-	writeJSON(w, http.StatusOK, items)
+	writeJSON(w, http.StatusOK, listResponse{Data: items, Total: total, Offset: pg.Offset, Limit: pg.Limit})
 }
 
 type updateBuildingRequest struct {
@@ -607,7 +1256,39 @@ type updateBuildingRequest struct {
 	PropertyID                 *string        `json:"property_id,omitempty"`
 }
 
+func validateBuildingUpdate(req *updateBuildingRequest) fieldErrors {
+	errs := fieldErrors{}
+	if req.Name != nil {
+		errs.add(checkRequired("name", *req.Name == ""))
+	}
+	if req.BuildingType != nil {
+		errs.add(checkEnum("building_type", *req.BuildingType, buildingBuildingTypeValues))
+	}
+	if req.Status != nil {
+		errs.add(checkEnum("status", *req.Status, buildingStatusValues))
+	}
+	if req.Floors != nil {
+		errs.add(checkMin("floors", float64(*req.Floors), 1, false))
+	}
+	if req.YearBuilt != nil {
+		errs.add(checkMin("year_built", float64(*req.YearBuilt), 1800, false))
+		errs.add(checkMax("year_built", float64(*req.YearBuilt), 2030, false))
+	}
+	if req.TotalSquareFootage != nil {
+		errs.add(checkMin("total_square_footage", float64(*req.TotalSquareFootage), 0, true))
+	}
+	if req.TotalRentableSquareFootage != nil {
+		errs.add(checkMin("total_rentable_square_footage", float64(*req.TotalRentableSquareFootage), 0, true))
+	}
+	if req.PropertyID != nil {
+		errs.add(checkID("property_id", *req.PropertyID))
+	}
+	return errs
+}
+
 func (h *PropertyHandler) UpdateBuilding(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), handlerTimeout)
+	defer cancel()
 	id, ok := parseUUID(w, r, "id")
 	if !ok {
 		return
@@ -617,11 +1298,42 @@ func (h *PropertyHandler) UpdateBuilding(w http.ResponseWriter, r *http.Request)
 		return
 	}
 	var req updateBuildingRequest
-	if err := decodeJSON(r, &req); err != nil {
+	patch, err := decodeUpdate(r, &req)
+	if err != nil {
 		writeError(w, http.StatusBadRequest, "INVALID_JSON", err.Error())
 		return
 	}
+	if field := patch.firstNull("name", "building_type", "status", "property_id"); field != "" {
+		writeError(w, http.StatusBadRequest, "INVALID_PATCH", field+" cannot be null")
+		return
+	}
+	if patch.hasObject("address") {
+		stored, err := h.client.Building.Get(ctx, id)
+		if err != nil {
+			entErrorToHTTP(w, err)
+			return
+		}
+		if req.Address, err = mergeObject(patch, "address", stored.Address, req.Address); err != nil {
+			writeError(w, http.StatusBadRequest, "INVALID_JSON", err.Error())
+			return
+		}
+	}
+	if errs := validateBuildingUpdate(&req); len(errs) > 0 {
+		writeFieldErrors(w, errs)
+		return
+	}
 	builder := h.client.Building.UpdateOneID(id)
+	if hasIfMatch(r) {
+		current, err := h.client.Building.Get(ctx, id)
+		if err != nil {
+			entErrorToHTTP(w, err)
+			return
+		}
+		if !checkIfMatch(w, r, versionETag(current.Version)) {
+			return
+		}
+		builder.Where(building.VersionEQ(current.Version))
+	}
 	if req.Name != nil {
 		builder.SetName(*req.Name)
 	}
@@ -646,30 +1358,80 @@ func (h *PropertyHandler) UpdateBuilding(w http.ResponseWriter, r *http.Request)
 	if req.TotalSquareFootage != nil {
 		builder.SetNillableTotalSquareFootage(req.TotalSquareFootage)
 	}
-	if req.TotalRentableSquareFootage != nil {
-		builder.SetNillableTotalRentableSquareFootage(req.TotalRentableSquareFootage)
+	if req.TotalRentableSquareFootage != nil {
+		builder.SetNillableTotalRentableSquareFootage(req.TotalRentableSquareFootage)
+	}
+	if req.PropertyID != nil {
+		builder.SetPropertyID(uuid.MustParse(*req.PropertyID))
+	}
+	if patch.isNull("address") {
+		builder.ClearAddress()
+	}
+	if patch.isNull("description") {
+		builder.ClearDescription()
+	}
+	if patch.isNull("floors") {
+		builder.ClearFloors()
+	}
+	if patch.isNull("year_built") {
+		builder.ClearYearBuilt()
+	}
+	if patch.isNull("total_square_footage") {
+		builder.ClearTotalSquareFootage()
+	}
+	if patch.isNull("total_rentable_square_footage") {
+		builder.ClearTotalRentableSquareFootage()
+	}
+	builder.SetUpdatedBy(audit.Actor).SetSource(building.Source(audit.Source))
+	if audit.CorrelationID != nil {
+		builder.SetCorrelationID(*audit.CorrelationID)
+	}
+	result, err := saveAudited[*ent.Building](ctx, h.client, h.audit, AuditOpUpdate, audit, builder.Mutation())
+	if err != nil {
+		writeGuardedSaveError(w, r, err)
+		return
+	}
+	setETag(w, versionETag(result.Version))
+	writeJSON(w, http.StatusOK, result)
+}
+
+func (h *PropertyHandler) DeleteBuilding(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), handlerTimeout)
+	defer cancel()
+	id, ok := parseUUID(w, r, "id")
+	if !ok {
+		return
+	}
+	audit, ok := parseAuditContext(w, r)
+	if !ok {
+		return
 	}
-	if req.PropertyID != nil {
-		uid, err := uuid.Parse(*req.PropertyID)
+	builder := h.client.Building.UpdateOneID(id).
+		Where(building.DeletedAtIsNil()).
+		SetDeletedAt(time.Now()).
+		SetUpdatedBy(audit.Actor)
+	if hasIfMatch(r) {
+		current, err := h.client.Building.Get(ctx, id)
 		if err != nil {
-			writeError(w, http.StatusBadRequest, "INVALID_ID", "invalid property_id")
+			entErrorToHTTP(w, err)
 			return
 		}
-		builder.SetPropertyID(uid)
-	}
-	builder.SetUpdatedBy(audit.Actor).SetSource(building.Source(audit.Source))
-	if audit.CorrelationID != nil {
-		builder.SetCorrelationID(*audit.CorrelationID)
+		if !checkIfMatch(w, r, versionETag(current.Version)) {
+			return
+		}
+		builder.Where(building.VersionEQ(current.Version))
 	}
-	result, err := builder.Save(r.Context())
+	_, err := saveAudited[*ent.Building](ctx, h.client, h.audit, AuditOpDelete, audit, builder.Mutation())
 	if err != nil {
-		entErrorToHTTP(w, err)
+		writeGuardedSaveError(w, r, err)
 		return
 	}
-	writeJSON(w, http.StatusOK, result)
+	w.WriteHeader(http.StatusNoContent)
 }
 
 func (h *PropertyHandler) transitionBuilding(w http.ResponseWriter, r *http.Request, targetStatus string, applyExtra func(*ent.BuildingUpdateOne)) {
+	ctx, cancel := context.WithTimeout(r.Context(), handlerTimeout)
+	defer cancel()
 	id, ok := parseUUID(w, r, "id")
 	if !ok {
 		return
@@ -678,13 +1440,12 @@ func (h *PropertyHandler) transitionBuilding(w http.ResponseWriter, r *http.Requ
 	if !ok {
 		return
 	}
-	current, err := h.client.Building.Get(r.Context(), id)
+	current, err := h.client.Building.Get(ctx, id)
 	if err != nil {
 		entErrorToHTTP(w, err)
 		return
 	}
-	if err := ValidateTransition(schema.ValidBuildingTransitions, string(current.Status), targetStatus); err != nil {
-		writeError(w, http.StatusConflict, "INVALID_TRANSITION", err.Error())
+	if !checkIfMatch(w, r, versionETag(current.Version)) {
 		return
 	}
 	builder := h.client.Building.UpdateOneID(id).
@@ -694,14 +1455,22 @@ func (h *PropertyHandler) transitionBuilding(w http.ResponseWriter, r *http.Requ
 	if audit.CorrelationID != nil {
 		builder.SetCorrelationID(*audit.CorrelationID)
 	}
+	if hasIfMatch(r) {
+		builder.Where(building.VersionEQ(current.Version))
+	}
 	if applyExtra != nil {
 		applyExtra(builder)
 	}
-	updated, err := builder.Save(r.Context())
+	if err := schema.ValidateTransitionWithGuard(schema.ValidBuildingTransitions, schema.BuildingTransitionGuards, string(current.Status), targetStatus, guardValues(ctx, builder.Mutation())); err != nil {
+		writeError(w, http.StatusConflict, "INVALID_TRANSITION", err.Error())
+		return
+	}
+	updated, err := saveAudited[*ent.Building](ctx, h.client, h.audit, AuditOpTransition, audit, builder.Mutation())
 	if err != nil {
-		entErrorToHTTP(w, err)
+		writeGuardedSaveError(w, r, err)
 		return
 	}
+	setETag(w, versionETag(updated.Version))
 	writeJSON(w, http.StatusOK, updated)
 }
 
@@ -721,6 +1490,12 @@ func (h *PropertyHandler) ActivateBuilding(w http.ResponseWriter, r *http.Reques
 // Space
 // ============================================================================
 
+// Accepted values of the Space enum fields.
+var (
+	spaceSpaceTypeValues = []string{"residential_unit", "commercial_office", "commercial_retail", "storage", "parking", "common_area", "industrial", "lot_pad", "bed_space", "desk_space", "parking_garage", "private_office", "warehouse", "amenity", "rack", "cage", "server_room", "other"}
+	spaceStatusValues    = []string{"vacant", "occupied", "notice_given", "make_ready", "down", "model", "reserved", "owner_occupied"}
+)
+
 type createSpaceRequest struct {
 	SpaceNumber               string   `json:"space_number"`
 	SpaceType                 string   `json:"space_type"`
@@ -746,17 +1521,45 @@ type createSpaceRequest struct {
 	ParentSpaceID             *string  `json:"parent_space_id,omitempty"`
 }
 
-func (h *PropertyHandler) CreateSpace(w http.ResponseWriter, r *http.Request) {
-	audit, ok := parseAuditContext(w, r)
-	if !ok {
-		return
+func validateSpaceCreate(req *createSpaceRequest) fieldErrors {
+	errs := fieldErrors{}
+	errs.add(checkRequired("space_number", req.SpaceNumber == ""))
+	errs.add(checkRequired("space_type", req.SpaceType == ""))
+	errs.add(checkEnum("space_type", req.SpaceType, spaceSpaceTypeValues))
+	errs.add(checkRequired("status", req.Status == ""))
+	errs.add(checkEnum("status", req.Status, spaceStatusValues))
+	errs.add(checkMin("square_footage", float64(req.SquareFootage), 0, true))
+	if req.Bedrooms != nil {
+		errs.add(checkMin("bedrooms", float64(*req.Bedrooms), 0, false))
 	}
-	var req createSpaceRequest
-	if err := decodeJSON(r, &req); err != nil {
-		writeError(w, http.StatusBadRequest, "INVALID_JSON", err.Error())
-		return
+	if req.Bathrooms != nil {
+		errs.add(checkMin("bathrooms", float64(*req.Bathrooms), 0, false))
 	}
-	builder := h.client.Space.Create()
+	if req.MarketRentCurrency != nil {
+		errs.add(checkCurrency("market_rent_currency", *req.MarketRentCurrency))
+	}
+	if req.MarketRentAmountCents != nil {
+		errs.add(checkMin("market_rent_amount_cents", float64(*req.MarketRentAmountCents), 0, false))
+	}
+	if req.AmiRestriction != nil {
+		errs.add(checkMin("ami_restriction", float64(*req.AmiRestriction), 0, false))
+		errs.add(checkMax("ami_restriction", float64(*req.AmiRestriction), 150, false))
+	}
+	if req.ActiveLeaseID != nil {
+		errs.add(checkRequired("active_lease_id", *req.ActiveLeaseID == ""))
+	}
+	errs.add(checkRequired("property_id", req.PropertyID == ""))
+	errs.add(checkID("property_id", req.PropertyID))
+	if req.BuildingID != nil {
+		errs.add(checkID("building_id", *req.BuildingID))
+	}
+	if req.ParentSpaceID != nil {
+		errs.add(checkID("parent_space_id", *req.ParentSpaceID))
+	}
+	return errs
+}
+
+func (req *createSpaceRequest) apply(builder *ent.SpaceCreate) {
 	builder.SetSpaceNumber(req.SpaceNumber)
 	builder.SetSpaceType(space.SpaceType(req.SpaceType))
 	builder.SetStatus(space.Status(req.Status))
@@ -788,7 +1591,7 @@ func (h *PropertyHandler) CreateSpace(w http.ResponseWriter, r *http.Request) {
 		builder.SetNillableMarketRentAmountCents(req.MarketRentAmountCents)
 	}
 	if req.MarketRentCurrency != nil {
-		builder.SetNillableMarketRentCurrency(req.MarketRentCurrency)
+		builder.SetMarketRentCurrency(*req.MarketRentCurrency)
 	}
 	if req.AmiRestriction != nil {
 		builder.SetNillableAmiRestriction(req.AmiRestriction)
@@ -796,35 +1599,38 @@ func (h *PropertyHandler) CreateSpace(w http.ResponseWriter, r *http.Request) {
 	if req.ActiveLeaseID != nil {
 		builder.SetNillableActiveLeaseID(req.ActiveLeaseID)
 	}
-	{
-		uid, err := uuid.Parse(req.PropertyID)
-		if err != nil {
-			writeError(w, http.StatusBadRequest, "INVALID_ID", "invalid property_id")
-			return
-		}
-		builder.SetPropertyID(uid)
-	}
+	builder.SetPropertyID(uuid.MustParse(req.PropertyID))
 	if req.BuildingID != nil {
-		uid, err := uuid.Parse(*req.BuildingID)
-		if err != nil {
-			writeError(w, http.StatusBadRequest, "INVALID_ID", "invalid building_id")
-			return
-		}
-		builder.SetBuildingID(uid)
+		builder.SetBuildingID(uuid.MustParse(*req.BuildingID))
 	}
 	if req.ParentSpaceID != nil {
-		uid, err := uuid.Parse(*req.ParentSpaceID)
-		if err != nil {
-			writeError(w, http.StatusBadRequest, "INVALID_ID", "invalid parent_space_id")
-			return
-		}
-		builder.SetParentSpaceID(uid)
+		builder.SetParentSpaceID(uuid.MustParse(*req.ParentSpaceID))
+	}
+}
+
+func (h *PropertyHandler) CreateSpace(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), handlerTimeout)
+	defer cancel()
+	audit, ok := parseAuditContext(w, r)
+	if !ok {
+		return
+	}
+	var req createSpaceRequest
+	if err := decodeJSON(r, &req); err != nil {
+		writeError(w, http.StatusBadRequest, "INVALID_JSON", err.Error())
+		return
+	}
+	if errs := validateSpaceCreate(&req); len(errs) > 0 {
+		writeFieldErrors(w, errs)
+		return
 	}
+	builder := h.client.Space.Create()
+	req.apply(builder)
 	builder.SetCreatedBy(audit.Actor).SetUpdatedBy(audit.Actor).SetSource(space.Source(audit.Source))
 	if audit.CorrelationID != nil {
 		builder.SetCorrelationID(*audit.CorrelationID)
 	}
-	result, err := builder.Save(r.Context())
+	result, err := saveAudited[*ent.Space](ctx, h.client, h.audit, AuditOpCreate, audit, builder.Mutation())
 	if err != nil {
 		entErrorToHTTP(w, err)
 		return
@@ -832,30 +1638,240 @@ func (h *PropertyHandler) CreateSpace(w http.ResponseWriter, r *http.Request) {
 	writeJSON(w, http.StatusCreated, result)
 }
 
+// spaceIncludes maps ?include= edge names to eager loaders on a Space query.
+var spaceIncludes = map[string]func(*ent.SpaceQuery){
+	"property":       func(q *ent.SpaceQuery) { q.WithProperty() },
+	"building":       func(q *ent.SpaceQuery) { q.WithBuilding() },
+	"children":       func(q *ent.SpaceQuery) { q.WithChildren() },
+	"parent_space":   func(q *ent.SpaceQuery) { q.WithParentSpace() },
+	"applications":   func(q *ent.SpaceQuery) { q.WithApplications() },
+	"lease_spaces":   func(q *ent.SpaceQuery) { q.WithLeaseSpaces() },
+	"ledger_entries": func(q *ent.SpaceQuery) { q.WithLedgerEntries() },
+}
+
 func (h *PropertyHandler) GetSpace(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), handlerTimeout)
+	defer cancel()
 	id, ok := parseUUID(w, r, "id")
 	if !ok {
 		return
 	}
-	result, err := h.client.Space.Get(r.Context(), id)
+	query := h.client.Space.Query().Where(space.ID(id))
+	withIncludes(r, query, spaceIncludes)
+	result, err := query.Only(ctx)
 	if err != nil {
 		entErrorToHTTP(w, err)
 		return
 	}
+	setETag(w, entityETag(result.UpdatedAt))
 	writeJSON(w, http.StatusOK, result)
 }
 
+func (h *PropertyHandler) ListChildrenOfSpace(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), handlerTimeout)
+	defer cancel()
+	id, ok := parseUUID(w, r, "id")
+	if !ok {
+		return
+	}
+	parent, err := h.client.Space.Get(ctx, id)
+	if err != nil {
+		entErrorToHTTP(w, err)
+		return
+	}
+	pg := parsePagination(r)
+	query := h.client.Space.QueryChildren(parent)
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		entErrorToHTTP(w, err)
+		return
+	}
+	items, err := query.
+		Limit(pg.Limit).Offset(pg.Offset).
+		Order(listOrder(r, nil, space.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		entErrorToHTTP(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, listResponse{Data: items, Total: total, Offset: pg.Offset, Limit: pg.Limit})
+}
+
+func (h *PropertyHandler) ListApplicationsOfSpace(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), handlerTimeout)
+	defer cancel()
+	id, ok := parseUUID(w, r, "id")
+	if !ok {
+		return
+	}
+	parent, err := h.client.Space.Get(ctx, id)
+	if err != nil {
+		entErrorToHTTP(w, err)
+		return
+	}
+	pg := parsePagination(r)
+	query := h.client.Space.QueryApplications(parent)
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		entErrorToHTTP(w, err)
+		return
+	}
+	items, err := query.
+		Limit(pg.Limit).Offset(pg.Offset).
+		Order(listOrder(r, nil, application.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		entErrorToHTTP(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, listResponse{Data: items, Total: total, Offset: pg.Offset, Limit: pg.Limit})
+}
+
+func (h *PropertyHandler) ListLeaseSpacesOfSpace(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), handlerTimeout)
+	defer cancel()
+	id, ok := parseUUID(w, r, "id")
+	if !ok {
+		return
+	}
+	parent, err := h.client.Space.Get(ctx, id)
+	if err != nil {
+		entErrorToHTTP(w, err)
+		return
+	}
+	pg := parsePagination(r)
+	query := h.client.Space.QueryLeaseSpaces(parent)
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		entErrorToHTTP(w, err)
+		return
+	}
+	items, err := query.
+		Limit(pg.Limit).Offset(pg.Offset).
+		Order(listOrder(r, nil, leasespace.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		entErrorToHTTP(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, listResponse{Data: items, Total: total, Offset: pg.Offset, Limit: pg.Limit})
+}
+
+func (h *PropertyHandler) ListLedgerEntriesOfSpace(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), handlerTimeout)
+	defer cancel()
+	id, ok := parseUUID(w, r, "id")
+	if !ok {
+		return
+	}
+	parent, err := h.client.Space.Get(ctx, id)
+	if err != nil {
+		entErrorToHTTP(w, err)
+		return
+	}
+	pg := parsePagination(r)
+	query := h.client.Space.QueryLedgerEntries(parent)
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		entErrorToHTTP(w, err)
+		return
+	}
+	items, err := query.
+		Limit(pg.Limit).Offset(pg.Offset).
+		Order(listOrder(r, nil, ledgerentry.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		entErrorToHTTP(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, listResponse{Data: items, Total: total, Offset: pg.Offset, Limit: pg.Limit})
+}
+
+func (h *PropertyHandler) CheckSpaceExists(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), handlerTimeout)
+	defer cancel()
+	id, ok := parseUUID(w, r, "id")
+	if !ok {
+		return
+	}
+	exists, err := h.client.Space.Query().Where(space.ID(id)).Exist(ctx)
+	if err != nil {
+		entErrorToHTTP(w, err)
+		return
+	}
+	if !exists {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// spaceSortColumns allowlists the ?sort= values accepted by ListSpaces.
+var spaceSortColumns = map[string]string{
+	"created_at":         space.FieldCreatedAt,
+	"updated_at":         space.FieldUpdatedAt,
+	"space_number":       space.FieldSpaceNumber,
+	"space_type":         space.FieldSpaceType,
+	"status":             space.FieldStatus,
+	"leasable":           space.FieldLeasable,
+	"shared_with_parent": space.FieldSharedWithParent,
+	"square_footage":     space.FieldSquareFootage,
+	"bedrooms":           space.FieldBedrooms,
+	"bathrooms":          space.FieldBathrooms,
+	"floor":              space.FieldFloor,
+	"floor_plan":         space.FieldFloorPlan,
+	"ada_accessible":     space.FieldAdaAccessible,
+	"pet_friendly":       space.FieldPetFriendly,
+	"furnished":          space.FieldFurnished,
+	"ami_restriction":    space.FieldAmiRestriction,
+	"active_lease_id":    space.FieldActiveLeaseID,
+}
+
 func (h *PropertyHandler) ListSpaces(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), handlerTimeout)
+	defer cancel()
 	pg := parsePagination(r)
-	items, err := h.client.Space.Query().
+	query := h.client.Space.Query()
+	if q := r.URL.Query().Get("q"); q != "" {
+		query.Where(space.SpaceNumberContainsFold(q))
+	}
+	if v := r.URL.Query().Get("space_type"); v != "" {
+		if err := space.SpaceTypeValidator(space.SpaceType(v)); err != nil {
+			writeError(w, http.StatusBadRequest, "INVALID_FILTER", err.Error())
+			return
+		}
+		query.Where(space.SpaceTypeEQ(space.SpaceType(v)))
+	}
+	if v := r.URL.Query().Get("status"); v != "" {
+		if err := space.StatusValidator(space.Status(v)); err != nil {
+			writeError(w, http.StatusBadRequest, "INVALID_FILTER", err.Error())
+			return
+		}
+		query.Where(space.StatusEQ(space.Status(v)))
+	}
+	if v := r.URL.Query().Get("property_id"); v != "" {
+		uid, err := uuid.Parse(v)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "INVALID_FILTER", "invalid property_id")
+			return
+		}
+		query.Where(space.HasPropertyWith(property.ID(uid)))
+	}
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		entErrorToHTTP(w, err)
+		return
+	}
+	withIncludes(r, query, spaceIncludes)
+	items, err := query.
 		Limit(pg.Limit).Offset(pg.Offset).
-		Order(ent.Desc(space.FieldCreatedAt)).
-		All(r.Context())
+		Order(listOrder(r, spaceSortColumns, space.FieldCreatedAt)).
+		All(ctx)
 	if err != nil {
 		entErrorToHTTP(w, err)
 		return
 	}
-	writeJSON(w, http.StatusOK, items)
+	writeJSON(w, http.StatusOK, listResponse{Data: items, Total: total, Offset: pg.Offset, Limit: pg.Limit})
 }
 
 type updateSpaceRequest struct {
@@ -883,7 +1899,54 @@ type updateSpaceRequest struct {
 	ParentSpaceID             *string  `json:"parent_space_id,omitempty"`
 }
 
+func validateSpaceUpdate(req *updateSpaceRequest) fieldErrors {
+	errs := fieldErrors{}
+	if req.SpaceNumber != nil {
+		errs.add(checkRequired("space_number", *req.SpaceNumber == ""))
+	}
+	if req.SpaceType != nil {
+		errs.add(checkEnum("space_type", *req.SpaceType, spaceSpaceTypeValues))
+	}
+	if req.Status != nil {
+		errs.add(checkEnum("status", *req.Status, spaceStatusValues))
+	}
+	if req.SquareFootage != nil {
+		errs.add(checkMin("square_footage", float64(*req.SquareFootage), 0, true))
+	}
+	if req.Bedrooms != nil {
+		errs.add(checkMin("bedrooms", float64(*req.Bedrooms), 0, false))
+	}
+	if req.Bathrooms != nil {
+		errs.add(checkMin("bathrooms", float64(*req.Bathrooms), 0, false))
+	}
+	if req.MarketRentCurrency != nil {
+		errs.add(checkCurrency("market_rent_currency", *req.MarketRentCurrency))
+	}
+	if req.MarketRentAmountCents != nil {
+		errs.add(checkMin("market_rent_amount_cents", float64(*req.MarketRentAmountCents), 0, false))
+	}
+	if req.AmiRestriction != nil {
+		errs.add(checkMin("ami_restriction", float64(*req.AmiRestriction), 0, false))
+		errs.add(checkMax("ami_restriction", float64(*req.AmiRestriction), 150, false))
+	}
+	if req.ActiveLeaseID != nil {
+		errs.add(checkRequired("active_lease_id", *req.ActiveLeaseID == ""))
+	}
+	if req.PropertyID != nil {
+		errs.add(checkID("property_id", *req.PropertyID))
+	}
+	if req.BuildingID != nil {
+		errs.add(checkID("building_id", *req.BuildingID))
+	}
+	if req.ParentSpaceID != nil {
+		errs.add(checkID("parent_space_id", *req.ParentSpaceID))
+	}
+	return errs
+}
+
 func (h *PropertyHandler) UpdateSpace(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), handlerTimeout)
+	defer cancel()
 	id, ok := parseUUID(w, r, "id")
 	if !ok {
 		return
@@ -893,11 +1956,31 @@ func (h *PropertyHandler) UpdateSpace(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 	var req updateSpaceRequest
-	if err := decodeJSON(r, &req); err != nil {
+	patch, err := decodeUpdate(r, &req)
+	if err != nil {
 		writeError(w, http.StatusBadRequest, "INVALID_JSON", err.Error())
 		return
 	}
+	if field := patch.firstNull("space_number", "space_type", "status", "leasable", "shared_with_parent", "square_footage", "ada_accessible", "pet_friendly", "furnished", "property_id"); field != "" {
+		writeError(w, http.StatusBadRequest, "INVALID_PATCH", field+" cannot be null")
+		return
+	}
+	if errs := validateSpaceUpdate(&req); len(errs) > 0 {
+		writeFieldErrors(w, errs)
+		return
+	}
 	builder := h.client.Space.UpdateOneID(id)
+	if hasIfMatch(r) {
+		current, err := h.client.Space.Get(ctx, id)
+		if err != nil {
+			entErrorToHTTP(w, err)
+			return
+		}
+		if !checkIfMatch(w, r, entityETag(current.UpdatedAt)) {
+			return
+		}
+		builder.Where(space.UpdatedAtEQ(current.UpdatedAt))
+	}
 	if req.SpaceNumber != nil {
 		builder.SetSpaceNumber(*req.SpaceNumber)
 	}
@@ -956,42 +2039,66 @@ func (h *PropertyHandler) UpdateSpace(w http.ResponseWriter, r *http.Request) {
 		builder.SetNillableActiveLeaseID(req.ActiveLeaseID)
 	}
 	if req.PropertyID != nil {
-		uid, err := uuid.Parse(*req.PropertyID)
-		if err != nil {
-			writeError(w, http.StatusBadRequest, "INVALID_ID", "invalid property_id")
-			return
-		}
-		builder.SetPropertyID(uid)
+		builder.SetPropertyID(uuid.MustParse(*req.PropertyID))
 	}
 	if req.BuildingID != nil {
-		uid, err := uuid.Parse(*req.BuildingID)
-		if err != nil {
-			writeError(w, http.StatusBadRequest, "INVALID_ID", "invalid building_id")
-			return
-		}
-		builder.SetBuildingID(uid)
+		builder.SetBuildingID(uuid.MustParse(*req.BuildingID))
 	}
 	if req.ParentSpaceID != nil {
-		uid, err := uuid.Parse(*req.ParentSpaceID)
-		if err != nil {
-			writeError(w, http.StatusBadRequest, "INVALID_ID", "invalid parent_space_id")
-			return
-		}
-		builder.SetParentSpaceID(uid)
+		builder.SetParentSpaceID(uuid.MustParse(*req.ParentSpaceID))
+	}
+	if patch.isNull("bedrooms") {
+		builder.ClearBedrooms()
+	}
+	if patch.isNull("bathrooms") {
+		builder.ClearBathrooms()
+	}
+	if patch.isNull("floor") {
+		builder.ClearFloor()
+	}
+	if patch.isNull("amenities") {
+		builder.ClearAmenities()
+	}
+	if patch.isNull("floor_plan") {
+		builder.ClearFloorPlan()
+	}
+	if patch.isNull("specialized_infrastructure") {
+		builder.ClearSpecializedInfrastructure()
+	}
+	if patch.isNull("market_rent_amount_cents") {
+		builder.ClearMarketRentAmountCents()
+	}
+	if patch.isNull("market_rent_currency") {
+		builder.ClearMarketRentCurrency()
+	}
+	if patch.isNull("ami_restriction") {
+		builder.ClearAmiRestriction()
+	}
+	if patch.isNull("active_lease_id") {
+		builder.ClearActiveLeaseID()
+	}
+	if patch.isNull("building_id") {
+		builder.ClearBuilding()
+	}
+	if patch.isNull("parent_space_id") {
+		builder.ClearParentSpace()
 	}
 	builder.SetUpdatedBy(audit.Actor).SetSource(space.Source(audit.Source))
 	if audit.CorrelationID != nil {
 		builder.SetCorrelationID(*audit.CorrelationID)
 	}
-	result, err := builder.Save(r.Context())
+	result, err := saveAudited[*ent.Space](ctx, h.client, h.audit, AuditOpUpdate, audit, builder.Mutation())
 	if err != nil {
-		entErrorToHTTP(w, err)
+		writeGuardedSaveError(w, r, err)
 		return
 	}
+	setETag(w, entityETag(result.UpdatedAt))
 	writeJSON(w, http.StatusOK, result)
 }
 
 func (h *PropertyHandler) transitionSpace(w http.ResponseWriter, r *http.Request, targetStatus string, applyExtra func(*ent.SpaceUpdateOne)) {
+	ctx, cancel := context.WithTimeout(r.Context(), handlerTimeout)
+	defer cancel()
 	id, ok := parseUUID(w, r, "id")
 	if !ok {
 		return
@@ -1000,13 +2107,12 @@ func (h *PropertyHandler) transitionSpace(w http.ResponseWriter, r *http.Request
 	if !ok {
 		return
 	}
-	current, err := h.client.Space.Get(r.Context(), id)
+	current, err := h.client.Space.Get(ctx, id)
 	if err != nil {
 		entErrorToHTTP(w, err)
 		return
 	}
-	if err := ValidateTransition(schema.ValidSpaceTransitions, string(current.Status), targetStatus); err != nil {
-		writeError(w, http.StatusConflict, "INVALID_TRANSITION", err.Error())
+	if !checkIfMatch(w, r, entityETag(current.UpdatedAt)) {
 		return
 	}
 	builder := h.client.Space.UpdateOneID(id).
@@ -1016,14 +2122,22 @@ func (h *PropertyHandler) transitionSpace(w http.ResponseWriter, r *http.Request
 	if audit.CorrelationID != nil {
 		builder.SetCorrelationID(*audit.CorrelationID)
 	}
+	if hasIfMatch(r) {
+		builder.Where(space.UpdatedAtEQ(current.UpdatedAt))
+	}
 	if applyExtra != nil {
 		applyExtra(builder)
 	}
-	updated, err := builder.Save(r.Context())
+	if err := schema.ValidateTransitionWithGuard(schema.ValidSpaceTransitions, schema.SpaceTransitionGuards, string(current.Status), targetStatus, guardValues(ctx, builder.Mutation())); err != nil {
+		writeError(w, http.StatusConflict, "INVALID_TRANSITION", err.Error())
+		return
+	}
+	updated, err := saveAudited[*ent.Space](ctx, h.client, h.audit, AuditOpTransition, audit, builder.Mutation())
 	if err != nil {
-		entErrorToHTTP(w, err)
+		writeGuardedSaveError(w, r, err)
 		return
 	}
+	setETag(w, entityETag(updated.UpdatedAt))
 	writeJSON(w, http.StatusOK, updated)
 }
 
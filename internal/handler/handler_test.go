@@ -0,0 +1,817 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/matthewbaird/ontology/ent"
+	"github.com/matthewbaird/ontology/ent/property"
+	"github.com/matthewbaird/ontology/ent/schema"
+	"github.com/matthewbaird/ontology/ent/space"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestClient opens a private in-memory SQLite database with the schema applied.
+func newTestClient(t *testing.T) *ent.Client {
+	t.Helper()
+	db, err := sql.Open("sqlite", "file::memory:?_pragma=foreign_keys(1)&_time_format=sqlite")
+	require.NoError(t, err)
+	db.SetMaxOpenConns(1)
+	client := ent.NewClient(ent.Driver(entsql.OpenDB(dialect.SQLite, db)))
+	t.Cleanup(func() { client.Close() })
+	require.NoError(t, client.Schema.Create(context.Background()))
+	return client
+}
+
+// serve invokes a handler directly with an optional If-Match header.
+func serve(h http.HandlerFunc, method, id string, body any, ifMatch string) *httptest.ResponseRecorder {
+	data, _ := json.Marshal(body)
+	req := httptest.NewRequest(method, "/", bytes.NewReader(data))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Actor", "test")
+	if ifMatch != "" {
+		req.Header.Set("If-Match", ifMatch)
+	}
+	if id != "" {
+		rctx := chi.NewRouteContext()
+		rctx.URLParams.Add("id", id)
+		req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rctx))
+	}
+	rec := httptest.NewRecorder()
+	h(rec, req)
+	return rec
+}
+
+func TestApplyMergePatchFollowsRFC7386(t *testing.T) {
+	tests := []struct {
+		name, target, patch, want string
+	}{
+		{"replace member", `{"a":"b"}`, `{"a":"c"}`, `{"a":"c"}`},
+		{"add member", `{"a":"b"}`, `{"b":"c"}`, `{"a":"b","b":"c"}`},
+		{"null deletes member", `{"a":"b","b":"c"}`, `{"a":null}`, `{"b":"c"}`},
+		{"nested object merges", `{"a":{"b":"c","d":"e"}}`, `{"a":{"b":"x","d":null}}`, `{"a":{"b":"x"}}`},
+		{"array replaces", `{"a":[{"b":"c"}]}`, `{"a":[1]}`, `{"a":[1]}`},
+		{"object replaces scalar", `{"a":"b"}`, `{"a":{"c":null,"d":1}}`, `{"a":{"d":1}}`},
+		{"non-object patch replaces", `{"a":"b"}`, `["c"]`, `["c"]`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := json.Marshal(applyMergePatch(json.RawMessage(tt.target), json.RawMessage(tt.patch)))
+			require.NoError(t, err)
+			assert.JSONEq(t, tt.want, string(got))
+		})
+	}
+}
+
+// mergePatchRequest builds an application/merge-patch+json request.
+func mergePatchRequest(body string) *http.Request {
+	req := httptest.NewRequest(http.MethodPatch, "/", bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", mergePatchContentType)
+	return req
+}
+
+func TestDecodeUpdateRecordsNullsAndRejectsNonObjects(t *testing.T) {
+	var req struct {
+		Name *string `json:"name"`
+	}
+	patch, err := decodeUpdate(mergePatchRequest(`{"name":"x","note":null}`), &req)
+	require.NoError(t, err)
+	assert.Equal(t, "x", *req.Name)
+	assert.True(t, patch.isNull("note"))
+	assert.False(t, patch.isNull("name"))
+	assert.False(t, patch.isNull("absent"))
+
+	for _, body := range []string{`["x"]`, `"x"`, `null`} {
+		_, err := decodeUpdate(mergePatchRequest(body), &req)
+		assert.Error(t, err, body)
+	}
+}
+
+func TestMergePatchMergesValueTypes(t *testing.T) {
+	h := NewPersonHandler(newTestClient(t), nil)
+	fixture := organizationFixture(0)
+	fixture["address"] = map[string]any{
+		"line1": "1 Main St", "line2": "Suite 2", "city": "Salem",
+		"state": "OR", "postal_code": "97301", "country": "US",
+	}
+	rec := serve(h.CreateOrganization, http.MethodPost, "", fixture, "")
+	require.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+	var created ent.Organization
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &created))
+
+	req := mergePatchRequest(`{"address":{"city":"Portland","line2":null}}`)
+	req.Header.Set("X-Actor", "test")
+	rctx := chi.NewRouteContext()
+	rctx.URLParams.Add("id", created.ID.String())
+	req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rctx))
+	rec = httptest.NewRecorder()
+	h.UpdateOrganization(rec, req)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+	var updated ent.Organization
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &updated))
+	require.NotNil(t, updated.Address)
+	assert.Equal(t, "1 Main St", updated.Address.Line1)
+	assert.Equal(t, "Portland", updated.Address.City)
+	assert.Empty(t, updated.Address.Line2)
+}
+
+func TestConstraintViolationNamesField(t *testing.T) {
+	h := NewAccountingHandler(newTestClient(t), nil)
+	fixture := accountFixture(0)
+	fixture["normal_balance"] = "credit"
+	rec := serve(h.CreateAccount, http.MethodPost, "", fixture, "")
+	require.Equal(t, http.StatusUnprocessableEntity, rec.Code, rec.Body.String())
+	var body map[string]string
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+	assert.Equal(t, "CONSTRAINT_VIOLATION", body["code"])
+	assert.Equal(t, "normal_balance", body["field"])
+}
+
+func TestEntErrorToHTTPUnwrapsTypedErrors(t *testing.T) {
+	_, validationErr := newTestClient(t).Jurisdiction.Create().
+		SetJurisdictionType("city").
+		Save(context.Background())
+	require.True(t, ent.IsValidationError(validationErr), "%v", validationErr)
+	tests := []struct {
+		name   string
+		err    error
+		status int
+		code   string
+		field  string
+	}{
+		{
+			name:   "constraint",
+			err:    fmt.Errorf("save: %w", &schema.ConstraintError{Field: "cam_terms", Msg: "commercial lease type retail requires cam_terms"}),
+			status: http.StatusUnprocessableEntity,
+			code:   "CONSTRAINT_VIOLATION",
+			field:  "cam_terms",
+		},
+		{
+			name:   "validation",
+			err:    fmt.Errorf("save: %w", validationErr),
+			status: http.StatusBadRequest,
+			code:   "VALIDATION_ERROR",
+			field:  "created_by",
+		},
+		{
+			// Messages that merely look like a hook's are not field errors.
+			name:   "plain",
+			err:    errors.New("active lease must have move_in_date set"),
+			status: http.StatusInternalServerError,
+			code:   "INTERNAL_ERROR",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			entErrorToHTTP(rec, tt.err)
+			require.Equal(t, tt.status, rec.Code, rec.Body.String())
+			var body map[string]string
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+			assert.Equal(t, tt.code, body["code"])
+			assert.Equal(t, tt.field, body["field"])
+		})
+	}
+}
+
+// createID runs a create handler and returns the new row's id.
+func createID(t *testing.T, h http.HandlerFunc, body map[string]any) string {
+	t.Helper()
+	rec := serve(h, http.MethodPost, "", body, "")
+	require.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+	var created struct {
+		ID string `json:"id"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &created))
+	return created.ID
+}
+
+// createProperty creates a property, with the portfolio and owner it
+// requires, and returns its ID.
+func createProperty(t *testing.T, client *ent.Client) string {
+	t.Helper()
+	people := NewPersonHandler(client, nil)
+	properties := NewPropertyHandler(client, nil)
+	portfolioID := createID(t, properties.CreatePortfolio, map[string]any{
+		"name":            "portfolio",
+		"management_type": "self_managed",
+		"status":          "active",
+		"owner_id":        createID(t, people.CreateOrganization, organizationFixture(0)),
+	})
+	return createID(t, properties.CreateProperty, map[string]any{
+		"name": "property",
+		"address": map[string]any{
+			"line1": "1 Main St", "city": "Salem", "state": "OR", "postal_code": "97301", "country": "US",
+		},
+		"property_type":        "multi_family",
+		"status":               "active",
+		"year_built":           2000,
+		"total_square_footage": 1000,
+		"total_spaces":         4,
+		"portfolio_id":         portfolioID,
+	})
+}
+
+// bulkLedgerFixture creates the rows a ledger entry references and returns a
+// builder for valid bulk items.
+func bulkLedgerFixture(t *testing.T, client *ent.Client) func(i int) map[string]any {
+	t.Helper()
+	accounting := NewAccountingHandler(client, nil)
+	propertyID := createProperty(t, client)
+	accountID := createID(t, accounting.CreateAccount, accountFixture(0))
+	journalEntryID := createID(t, accounting.CreateJournalEntry, journalEntryFixture(0))
+	return func(i int) map[string]any {
+		return map[string]any{
+			"entry_type":          "credit",
+			"amount_amount_cents": 100 + i,
+			"effective_date":      fixtureTime,
+			"posted_date":         fixtureTime,
+			"description":         fmt.Sprintf("entry-%d", i),
+			"charge_code":         "rent",
+			"journal_entry_id":    journalEntryID,
+			"account_id":          accountID,
+			"property_id":         propertyID,
+		}
+	}
+}
+
+func TestBulkCreateReportsInvalidItemsByIndex(t *testing.T) {
+	client := newTestClient(t)
+	item := bulkLedgerFixture(t, client)
+	items := []map[string]any{item(0), item(1), item(2)}
+	items[0]["entry_type"] = "bogus"
+	delete(items[2], "description")
+	rec := serve(NewAccountingHandler(client, nil).BulkCreateLedgerEntries, http.MethodPost, "", items, "")
+	require.Equal(t, http.StatusUnprocessableEntity, rec.Code, rec.Body.String())
+	var body struct {
+		Code  string              `json:"code"`
+		Items map[int]fieldErrors `json:"items"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+	assert.Equal(t, "VALIDATION_FAILED", body.Code)
+	require.Len(t, body.Items, 2)
+	assert.Equal(t, "INVALID_ENUM", body.Items[0]["entry_type"].Code)
+	assert.Equal(t, "REQUIRED", body.Items[2]["description"].Code)
+	n, err := client.LedgerEntry.Query().Count(context.Background())
+	require.NoError(t, err)
+	assert.Zero(t, n)
+}
+
+func TestBulkCreateIsAllOrNothing(t *testing.T) {
+	client := newTestClient(t)
+	item := bulkLedgerFixture(t, client)
+	h := NewAccountingHandler(client, nil)
+
+	// The second item passes validation but fails its constraint hook, so the
+	// first item's insert is rolled back with it.
+	items := []map[string]any{item(0), item(1)}
+	items[1]["reconciled"] = true
+	rec := serve(h.BulkCreateLedgerEntries, http.MethodPost, "", items, "")
+	require.Equal(t, http.StatusUnprocessableEntity, rec.Code, rec.Body.String())
+	n, err := client.LedgerEntry.Query().Count(context.Background())
+	require.NoError(t, err)
+	assert.Zero(t, n)
+
+	rec = serve(h.BulkCreateLedgerEntries, http.MethodPost, "", []map[string]any{item(0), item(1)}, "")
+	require.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+	var created []ent.LedgerEntry
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &created))
+	assert.Len(t, created, 2)
+	n, err = client.LedgerEntry.Query().Count(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 2, n)
+}
+
+func TestBoundedFloatsEnforceCUERange(t *testing.T) {
+	// total_square_footage: float & >0 excludes its bound.
+	assert.Error(t, property.TotalSquareFootageValidator(0))
+	assert.NoError(t, property.TotalSquareFootageValidator(0.5))
+	// bathrooms?: float & >=0 includes it.
+	assert.NoError(t, space.BathroomsValidator(0))
+	assert.Error(t, space.BathroomsValidator(-0.5))
+}
+
+func TestUpdateHonorsIfMatch(t *testing.T) {
+	h := NewPersonHandler(newTestClient(t), nil)
+	rec := serve(h.CreatePerson, http.MethodPost, "", personFixture(0), "")
+	require.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+	var created struct {
+		ID string `json:"id"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &created))
+
+	rec = serve(h.GetPerson, http.MethodGet, created.ID, nil, "")
+	require.Equal(t, http.StatusOK, rec.Code)
+	tag := rec.Header().Get("ETag")
+	require.NotEmpty(t, tag)
+
+	time.Sleep(time.Millisecond) // updated_at must advance past the read
+	rec = serve(h.UpdatePerson, http.MethodPatch, created.ID, map[string]any{"first_name": "Ada"}, tag)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	fresh := rec.Header().Get("ETag")
+	assert.NotEqual(t, tag, fresh)
+
+	rec = serve(h.UpdatePerson, http.MethodPatch, created.ID, map[string]any{"first_name": "Grace"}, tag)
+	assert.Equal(t, http.StatusPreconditionFailed, rec.Code)
+
+	rec = serve(h.GetPerson, http.MethodGet, created.ID, nil, "")
+	assert.Equal(t, fresh, rec.Header().Get("ETag"), "update and get must agree on the tag")
+
+	rec = serve(h.UpdatePerson, http.MethodPatch, created.ID, map[string]any{"first_name": "Grace"}, "*")
+	assert.Equal(t, http.StatusOK, rec.Code)
+	rec = serve(h.UpdatePerson, http.MethodPatch, created.ID, map[string]any{"first_name": "Joan"}, "")
+	assert.Equal(t, http.StatusOK, rec.Code)
+}
+
+func TestCheckIfMatchComparesVersionTags(t *testing.T) {
+	tests := []struct {
+		header string
+		pass   bool
+	}{
+		{"", true},
+		{"*", true},
+		{`"v3"`, true},
+		{`"v2", "v3"`, true},
+		{`"v2"`, false},
+		{entityETag(fixtureTime), false},
+	}
+	for _, tt := range tests {
+		req := httptest.NewRequest(http.MethodPatch, "/", nil)
+		if tt.header != "" {
+			req.Header.Set("If-Match", tt.header)
+		}
+		rec := httptest.NewRecorder()
+		assert.Equal(t, tt.pass, checkIfMatch(rec, req, versionETag(3)), tt.header)
+		if !tt.pass {
+			assert.Equal(t, http.StatusPreconditionFailed, rec.Code, tt.header)
+		}
+	}
+}
+
+func TestIfMatchUpdateLosesRaceWithConcurrentWrite(t *testing.T) {
+	client := newTestClient(t)
+	h := NewPersonHandler(client, nil)
+	rec := serve(h.CreatePerson, http.MethodPost, "", personFixture(0), "")
+	require.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+	var created struct {
+		ID string `json:"id"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &created))
+	rec = serve(h.GetPerson, http.MethodGet, created.ID, nil, "")
+	tag := rec.Header().Get("ETag")
+
+	// Another writer commits after the handler checked If-Match but before
+	// its own save runs.
+	raced := false
+	client.Person.Use(func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if !raced && m.Op().Is(ent.OpUpdateOne) {
+				raced = true
+				time.Sleep(time.Millisecond)
+				if err := client.Person.UpdateOneID(uuid.MustParse(created.ID)).SetFirstName("Racer").Exec(ctx); err != nil {
+					return nil, err
+				}
+			}
+			return next.Mutate(ctx, m)
+		})
+	})
+	rec = serve(h.UpdatePerson, http.MethodPatch, created.ID, map[string]any{"first_name": "Ada"}, tag)
+	require.Equal(t, http.StatusPreconditionFailed, rec.Code, rec.Body.String())
+	p, err := client.Person.Get(context.Background(), uuid.MustParse(created.ID))
+	require.NoError(t, err)
+	assert.Equal(t, "Racer", p.FirstName)
+}
+
+func TestInvalidEnumIsRejectedBeforeSave(t *testing.T) {
+	h := NewPersonHandler(newTestClient(t), nil)
+	fixture := organizationFixture(0)
+	fixture["org_type"] = "pirate_ship"
+	rec := serve(h.CreateOrganization, http.MethodPost, "", fixture, "")
+	require.Equal(t, http.StatusUnprocessableEntity, rec.Code, rec.Body.String())
+	var body struct {
+		Fields map[string]fieldError `json:"fields"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+	fe := body.Fields["org_type"]
+	assert.Equal(t, "INVALID_ENUM", fe.Code)
+	assert.Equal(t, "org_type", fe.Field)
+	assert.Contains(t, fe.Allowed, "management_company")
+
+	rec = serve(h.CreateOrganization, http.MethodPost, "", organizationFixture(1), "")
+	require.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+	var created struct {
+		ID string `json:"id"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &created))
+	rec = serve(h.UpdateOrganization, http.MethodPatch, created.ID, map[string]any{"org_type": "pirate_ship"}, "")
+	assert.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+	assert.Contains(t, rec.Body.String(), "INVALID_ENUM")
+}
+
+func TestValidationReportsEveryInvalidField(t *testing.T) {
+	h := NewJurisdictionHandler(newTestClient(t), nil)
+	fixture := jurisdictionFixture(0)
+	fixture["name"] = ""
+	fixture["jurisdiction_type"] = "galactic"
+	fixture["state_code"] = "california"
+	rec := serve(h.CreateJurisdiction, http.MethodPost, "", fixture, "")
+	require.Equal(t, http.StatusUnprocessableEntity, rec.Code, rec.Body.String())
+	var body struct {
+		Code   string                `json:"code"`
+		Fields map[string]fieldError `json:"fields"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+	assert.Equal(t, "VALIDATION_FAILED", body.Code)
+	assert.Equal(t, "REQUIRED", body.Fields["name"].Code)
+	assert.Equal(t, "INVALID_ENUM", body.Fields["jurisdiction_type"].Code)
+	assert.Equal(t, "INVALID_FORMAT", body.Fields["state_code"].Code)
+	assert.Len(t, body.Fields, 3)
+}
+
+func TestValidationReportsCurrencyAndIDWithOtherFields(t *testing.T) {
+	h := NewLeaseHandler(newTestClient(t), nil)
+	fixture := leaseFixture(0)
+	fixture["status"] = "haunted"
+	fixture["base_rent_currency"] = "usd"
+	fixture["parent_lease_id"] = "not-a-uuid"
+	rec := serve(h.CreateLease, http.MethodPost, "", fixture, "")
+	require.Equal(t, http.StatusUnprocessableEntity, rec.Code, rec.Body.String())
+	var body struct {
+		Fields map[string]fieldError `json:"fields"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+	assert.Equal(t, "INVALID_ENUM", body.Fields["status"].Code)
+	assert.Equal(t, "INVALID_CURRENCY", body.Fields["base_rent_currency"].Code)
+	assert.Equal(t, "INVALID_ID", body.Fields["parent_lease_id"].Code)
+	assert.Len(t, body.Fields, 3)
+}
+
+func TestExistsRespondsWithoutBody(t *testing.T) {
+	h := NewPersonHandler(newTestClient(t), nil)
+	rec := serve(h.CreatePerson, http.MethodPost, "", personFixture(0), "")
+	require.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+	var created struct {
+		ID string `json:"id"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &created))
+
+	rec = serve(h.CheckPersonExists, http.MethodHead, created.ID, nil, "")
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Zero(t, rec.Body.Len())
+
+	rec = serve(h.CheckPersonExists, http.MethodHead, "6f1c2c56-9a52-4d9e-8a4f-0c3c1b0d2a11", nil, "")
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+	assert.Zero(t, rec.Body.Len())
+}
+
+func TestListSearchMatchesDisplayField(t *testing.T) {
+	h := NewPersonHandler(newTestClient(t), nil)
+	for i, name := range []string{"Ada Lovelace", "Grace Hopper", "ADA King"} {
+		fixture := personFixture(i)
+		fixture["display_name"] = name
+		rec := serve(h.CreatePerson, http.MethodPost, "", fixture, "")
+		require.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+	}
+
+	rec := httptest.NewRecorder()
+	h.ListPersons(rec, httptest.NewRequest(http.MethodGet, "/?q=ada", nil))
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	var page struct {
+		Data []struct {
+			DisplayName string `json:"display_name"`
+		} `json:"data"`
+		Total int `json:"total"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &page))
+	assert.Equal(t, 2, page.Total, "total must count the search")
+	var names []string
+	for _, p := range page.Data {
+		names = append(names, p.DisplayName)
+	}
+	assert.ElementsMatch(t, []string{"Ada Lovelace", "ADA King"}, names)
+}
+
+func TestListHonorsIfModifiedSince(t *testing.T) {
+	client := newTestClient(t)
+	h := NewAccountingHandler(client, nil)
+	list := func(ifModifiedSince string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		if ifModifiedSince != "" {
+			req.Header.Set("If-Modified-Since", ifModifiedSince)
+		}
+		rec := httptest.NewRecorder()
+		h.ListLedgerEntries(rec, req)
+		return rec
+	}
+	epoch := time.Unix(0, 0).UTC().Format(http.TimeFormat)
+
+	rec := list(epoch)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	assert.Empty(t, rec.Header().Get("Last-Modified"), "an empty list has no Last-Modified")
+
+	item := bulkLedgerFixture(t, client)
+	rec = serve(h.BulkCreateLedgerEntries, http.MethodPost, "", []map[string]any{item(0)}, "")
+	require.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+	rec = list(epoch)
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Empty(t, rec.Header().Get("Last-Modified"), "a change in the current second must not be hidden by a second-resolution date")
+
+	lastWeek := time.Now().Add(-7 * 24 * time.Hour)
+	require.NoError(t, client.LedgerEntry.Update().SetUpdatedAt(lastWeek).Exec(context.Background()))
+	rec = list("")
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Empty(t, rec.Header().Get("Last-Modified"), "the newest row is only looked up for a conditional request")
+	rec = list(epoch)
+	require.Equal(t, http.StatusOK, rec.Code)
+	lastModified := rec.Header().Get("Last-Modified")
+	assert.Equal(t, lastWeek.UTC().Format(http.TimeFormat), lastModified)
+
+	rec = list(lastModified)
+	assert.Equal(t, http.StatusNotModified, rec.Code)
+	assert.Empty(t, rec.Body.String())
+
+	rec = list(lastWeek.Add(-time.Hour).UTC().Format(http.TimeFormat))
+	assert.Equal(t, http.StatusOK, rec.Code, "rows changed after If-Modified-Since")
+	rec = list("not a date")
+	assert.Equal(t, http.StatusOK, rec.Code, "an unparseable If-Modified-Since is ignored")
+}
+
+func TestMutableListsIgnoreIfModifiedSince(t *testing.T) {
+	client := newTestClient(t)
+	h := NewPersonHandler(client, nil)
+	rec := serve(h.CreatePerson, http.MethodPost, "", personFixture(0), "")
+	require.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+	require.NoError(t, client.Person.Update().SetUpdatedAt(time.Now().Add(-time.Hour)).Exec(context.Background()))
+
+	// A deleted person leaves the newest updated_at where it was, so a 304
+	// here would hide the removal.
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set("If-Modified-Since", time.Now().UTC().Format(http.TimeFormat))
+	rec = httptest.NewRecorder()
+	h.ListPersons(rec, req)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Empty(t, rec.Header().Get("Last-Modified"))
+}
+
+func TestHandlerTimeoutIsReported(t *testing.T) {
+	defer func(d time.Duration) { handlerTimeout = d }(handlerTimeout)
+	handlerTimeout = 0
+	h := NewPersonHandler(newTestClient(t), nil)
+	rec := serve(h.ListPersons, http.MethodGet, "", nil, "")
+	assert.Equal(t, http.StatusServiceUnavailable, rec.Code, rec.Body.String())
+	assert.Contains(t, rec.Body.String(), "TIMEOUT")
+}
+
+func TestTransitionGuardSeesMutationBeforeStoredValues(t *testing.T) {
+	client := newTestClient(t)
+	h := NewPersonHandler(client, nil)
+	rec := serve(h.CreateOrganization, http.MethodPost, "", organizationFixture(0), "")
+	require.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+	var created struct {
+		ID uuid.UUID `json:"id"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &created))
+
+	ctx := context.Background()
+	transitions := map[string][]string{"active": {"inactive"}}
+	guards := map[string]map[string]schema.TransitionGuard{
+		"active": {"inactive": {Field: "legal_name", Op: "==", Value: "Acme"}},
+	}
+	stored := client.Organization.UpdateOneID(created.ID).Mutation()
+	err := schema.ValidateTransitionWithGuard(transitions, guards, "active", "inactive", guardValues(ctx, stored))
+	assert.ErrorContains(t, err, "requires legal_name == Acme")
+
+	renamed := client.Organization.UpdateOneID(created.ID).SetLegalName("Acme").Mutation()
+	assert.NoError(t, schema.ValidateTransitionWithGuard(transitions, guards, "active", "inactive", guardValues(ctx, renamed)))
+
+	guards["active"]["inactive"] = schema.TransitionGuard{Field: "balance", Op: ">", Value: float64(0)}
+	balance := func(v any) func(string) (any, bool) {
+		return func(string) (any, bool) { return v, v != nil }
+	}
+	assert.NoError(t, schema.ValidateTransitionWithGuard(transitions, guards, "active", "inactive", balance(int64(5))))
+	assert.Error(t, schema.ValidateTransitionWithGuard(transitions, guards, "active", "inactive", balance(int64(0))))
+	assert.Error(t, schema.ValidateTransitionWithGuard(transitions, guards, "active", "inactive", balance(nil)))
+}
+
+// auditLog is an AuditRecorder that keeps events in memory, failing every
+// append while err is set.
+type auditLog struct {
+	events []AuditEvent
+	err    error
+}
+
+func (l *auditLog) Append(_ context.Context, tx *ent.Tx, evt AuditEvent) error {
+	if tx == nil {
+		return errors.New("append outside a transaction")
+	}
+	if l.err != nil {
+		return l.err
+	}
+	l.events = append(l.events, evt)
+	return nil
+}
+
+func TestMutationsAppendAuditEvents(t *testing.T) {
+	client := newTestClient(t)
+	log := &auditLog{}
+	h := NewPersonHandler(client, log)
+
+	rec := serve(h.CreatePerson, http.MethodPost, "", personFixture(0), "")
+	require.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+	var created struct {
+		ID uuid.UUID `json:"id"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &created))
+
+	rec = serve(h.UpdatePerson, http.MethodPatch, created.ID.String(), map[string]any{"first_name": "Ada"}, "")
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+	require.Len(t, log.events, 2)
+	create, update := log.events[0], log.events[1]
+	assert.Equal(t, AuditOpCreate, create.Op)
+	assert.Equal(t, "Person", create.Entity)
+	assert.Equal(t, created.ID, create.EntityID)
+	assert.Equal(t, "test", create.Actor)
+	assert.Equal(t, FieldChange{After: "first_name-0"}, create.Changes["first_name"])
+
+	assert.Equal(t, AuditOpUpdate, update.Op)
+	assert.Equal(t, created.ID, update.EntityID)
+	assert.Equal(t, FieldChange{Before: "first_name-0", After: "Ada"}, update.Changes["first_name"])
+
+	// A failed append rolls the mutation back.
+	log.err = errors.New("audit store down")
+	rec = serve(h.UpdatePerson, http.MethodPatch, created.ID.String(), map[string]any{"first_name": "Grace"}, "")
+	assert.Equal(t, http.StatusInternalServerError, rec.Code, rec.Body.String())
+	p, err := client.Person.Get(context.Background(), created.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "Ada", p.FirstName)
+}
+
+func TestDeleteAppendsAuditEvent(t *testing.T) {
+	client := newTestClient(t)
+	log := &auditLog{}
+	h := NewPersonHandler(client, log)
+	rec := serve(h.CreatePerson, http.MethodPost, "", personFixture(0), "")
+	require.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+	var created struct {
+		ID uuid.UUID `json:"id"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &created))
+	ctx := context.Background()
+	info := AuditInfo{Actor: "test", Source: "user"}
+	del := func(c *ent.Client) error { return c.Person.DeleteOneID(created.ID).Exec(ctx) }
+
+	// A failed append keeps the row.
+	log.err = errors.New("audit store down")
+	require.Error(t, deleteAudited(ctx, client, log, info, ent.TypePerson, created.ID, del))
+	exists, err := client.Person.Query().Exist(ctx)
+	require.NoError(t, err)
+	assert.True(t, exists)
+
+	log.err = nil
+	require.NoError(t, deleteAudited(ctx, client, log, info, ent.TypePerson, created.ID, del))
+	exists, err = client.Person.Query().Exist(ctx)
+	require.NoError(t, err)
+	assert.False(t, exists)
+	evt := log.events[len(log.events)-1]
+	assert.Equal(t, AuditOpDelete, evt.Op)
+	assert.Equal(t, "Person", evt.Entity)
+	assert.Equal(t, created.ID, evt.EntityID)
+}
+
+func TestSubResourceListsRecordsThroughEdge(t *testing.T) {
+	client := newTestClient(t)
+	h := NewPersonHandler(client, nil)
+	ids := make([]uuid.UUID, 3)
+	for i := range ids {
+		rec := serve(h.CreateOrganization, http.MethodPost, "", organizationFixture(i), "")
+		require.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+		var created struct {
+			ID uuid.UUID `json:"id"`
+		}
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &created))
+		ids[i] = created.ID
+	}
+	ctx := context.Background()
+	require.NoError(t, client.Organization.UpdateOneID(ids[1]).SetParentOrgID(ids[0]).Exec(ctx))
+
+	rec := serve(h.ListSubsidiariesOfOrganization, http.MethodGet, ids[0].String(), nil, "")
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	var page struct {
+		Data []struct {
+			ID uuid.UUID `json:"id"`
+		} `json:"data"`
+		Total int `json:"total"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &page))
+	assert.Equal(t, 1, page.Total)
+	require.Len(t, page.Data, 1)
+	assert.Equal(t, ids[1], page.Data[0].ID)
+
+	rec = serve(h.ListSubsidiariesOfOrganization, http.MethodGet, "6f1c2c56-9a52-4d9e-8a4f-0c3c1b0d2a11", nil, "")
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestCORSAllowsConditionalGetHeaders(t *testing.T) {
+	req := httptest.NewRequest(http.MethodOptions, "/v1/persons", nil)
+	rec := httptest.NewRecorder()
+	CORS(http.NotFoundHandler()).ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+	assert.Contains(t, rec.Header().Get("Access-Control-Allow-Headers"), "If-Modified-Since")
+	assert.Contains(t, rec.Header().Get("Access-Control-Expose-Headers"), "Last-Modified")
+}
+
+func TestTransitionBodyMayBeEmptyButNotMalformed(t *testing.T) {
+	h := NewLeaseHandler(newTestClient(t), nil)
+	activate := func(body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/", bytes.NewBufferString(body))
+		req.Header.Set("X-Actor", "test")
+		rctx := chi.NewRouteContext()
+		rctx.URLParams.Add("id", uuid.NewString())
+		req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rctx))
+		rec := httptest.NewRecorder()
+		h.ActivateLease(rec, req)
+		return rec
+	}
+	rec := activate(`{"move_in_date":`)
+	assert.Equal(t, http.StatusBadRequest, rec.Code, rec.Body.String())
+	assert.Contains(t, rec.Body.String(), "INVALID_JSON")
+
+	// Without a body the transition goes on to look the lease up.
+	rec = activate("")
+	assert.Equal(t, http.StatusNotFound, rec.Code, rec.Body.String())
+}
+
+func TestDeleteBuildingKeepsRowMarkedDeleted(t *testing.T) {
+	client := newTestClient(t)
+	log := &auditLog{}
+	h := NewPropertyHandler(client, log)
+	id := createID(t, h.CreateBuilding, map[string]any{
+		"property_id":   createProperty(t, client),
+		"name":          "North",
+		"building_type": "residential",
+		"status":        "active",
+	})
+
+	rec := serve(h.DeleteBuilding, http.MethodDelete, id, nil, "")
+	require.Equal(t, http.StatusNoContent, rec.Code, rec.Body.String())
+	evt := log.events[len(log.events)-1]
+	assert.Equal(t, AuditOpDelete, evt.Op)
+	assert.Equal(t, "Building", evt.Entity)
+	assert.Contains(t, evt.Changes, "deleted_at")
+
+	rec = serve(h.GetBuilding, http.MethodGet, id, nil, "")
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+	ctx := schema.SkipSoftDelete(context.Background())
+	b, err := client.Building.Get(ctx, uuid.MustParse(id))
+	require.NoError(t, err, "a soft delete keeps the row")
+	assert.NotNil(t, b.DeletedAt)
+
+	rec = serve(h.DeleteBuilding, http.MethodDelete, id, nil, "")
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+	assert.JSONEq(t, `{"code":"NOT_FOUND","error":"building not found"}`, rec.Body.String())
+}
+
+func TestVersionedSoftDeleteHonorsIfMatch(t *testing.T) {
+	client := newTestClient(t)
+	h := NewPropertyHandler(client, nil)
+	id := createID(t, h.CreateBuilding, map[string]any{
+		"property_id":   createProperty(t, client),
+		"name":          "North",
+		"building_type": "residential",
+		"status":        "active",
+	})
+	rec := serve(h.GetBuilding, http.MethodGet, id, nil, "")
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	stale := rec.Header().Get("ETag")
+	assert.Equal(t, versionETag(1), stale)
+
+	rec = serve(h.UpdateBuilding, http.MethodPatch, id, map[string]any{"name": "South"}, stale)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	fresh := rec.Header().Get("ETag")
+	assert.Equal(t, versionETag(2), fresh, "every update bumps the version")
+
+	rec = serve(h.DeleteBuilding, http.MethodDelete, id, nil, stale)
+	assert.Equal(t, http.StatusPreconditionFailed, rec.Code, rec.Body.String())
+	rec = serve(h.GetBuilding, http.MethodGet, id, nil, "")
+	assert.Equal(t, http.StatusOK, rec.Code, "a stale delete leaves the building")
+
+	rec = serve(h.DeleteBuilding, http.MethodDelete, id, nil, fresh)
+	assert.Equal(t, http.StatusNoContent, rec.Code, rec.Body.String())
+	rec = serve(h.GetBuilding, http.MethodGet, id, nil, "")
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
@@ -1,15 +1,26 @@
 package handler
 
 import (
+	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
+	"io"
 	"log"
+	"mime"
 	"net/http"
+	"regexp"
+	"slices"
 	"strconv"
+	"strings"
+	"time"
 
+	"entgo.io/ent/dialect/sql"
 	"github.com/go-chi/chi/v5"
 	"github.com/google/uuid"
 	"github.com/matthewbaird/ontology/ent"
+	"github.com/matthewbaird/ontology/ent/schema"
 	"github.com/matthewbaird/ontology/internal/jurisdiction"
 )
 
@@ -20,6 +31,10 @@ type AuditInfo struct {
 	CorrelationID *string
 }
 
+// handlerTimeout bounds the database work of each generated handler so a slow
+// query cannot hold a request open indefinitely.
+var handlerTimeout = 30 * time.Second
+
 // writeJSON marshals v as JSON and writes it with the given status code.
 func writeJSON(w http.ResponseWriter, status int, v any) {
 	w.Header().Set("Content-Type", "application/json")
@@ -37,12 +52,274 @@ func writeError(w http.ResponseWriter, status int, code, message string) {
 	})
 }
 
+// fieldError is a request validation failure attributed to a single field.
+type fieldError struct {
+	Code    string   `json:"code"`
+	Field   string   `json:"field"`
+	Message string   `json:"error"`
+	Allowed []string `json:"allowed,omitempty"` // accepted values, for INVALID_ENUM
+}
+
+// checkEnum returns an INVALID_ENUM fieldError naming the accepted values when
+// value is not one of allowed, so a bad enum is rejected before it reaches Save.
+func checkEnum(field, value string, allowed []string) *fieldError {
+	if slices.Contains(allowed, value) {
+		return nil
+	}
+	return &fieldError{
+		Code:    "INVALID_ENUM",
+		Field:   field,
+		Message: fmt.Sprintf("%s must be one of: %s", field, strings.Join(allowed, ", ")),
+		Allowed: allowed,
+	}
+}
+
+// fieldErrors collects every validation failure of a request body, keyed by
+// field. Only the first failure of each field is kept.
+type fieldErrors map[string]*fieldError
+
+// add records fe unless it is nil or its field already failed.
+func (errs fieldErrors) add(fe *fieldError) {
+	if fe == nil {
+		return
+	}
+	if _, ok := errs[fe.Field]; !ok {
+		errs[fe.Field] = fe
+	}
+}
+
+// writeFieldErrors reports every invalid field of a request as a 422.
+func writeFieldErrors(w http.ResponseWriter, errs fieldErrors) {
+	writeJSON(w, http.StatusUnprocessableEntity, map[string]any{
+		"error":  fmt.Sprintf("%d invalid fields", len(errs)),
+		"code":   "VALIDATION_FAILED",
+		"fields": errs,
+	})
+}
+
+// checkRequired returns a REQUIRED fieldError when a required member is
+// missing. JSON cannot tell an omitted string from an empty one, so callers
+// pass value == "" for strings.
+func checkRequired(field string, missing bool) *fieldError {
+	if !missing {
+		return nil
+	}
+	return &fieldError{Code: "REQUIRED", Field: field, Message: field + " is required"}
+}
+
+// checkMin returns an OUT_OF_RANGE fieldError when v is below min, or equal
+// to it for an exclusive bound.
+func checkMin(field string, v, min float64, exclusive bool) *fieldError {
+	if exclusive && v <= min {
+		return &fieldError{Code: "OUT_OF_RANGE", Field: field, Message: fmt.Sprintf("%s must be greater than %g", field, min)}
+	}
+	if v < min {
+		return &fieldError{Code: "OUT_OF_RANGE", Field: field, Message: fmt.Sprintf("%s must be at least %g", field, min)}
+	}
+	return nil
+}
+
+// checkMax returns an OUT_OF_RANGE fieldError when v is above max, or equal
+// to it for an exclusive bound.
+func checkMax(field string, v, max float64, exclusive bool) *fieldError {
+	if exclusive && v >= max {
+		return &fieldError{Code: "OUT_OF_RANGE", Field: field, Message: fmt.Sprintf("%s must be less than %g", field, max)}
+	}
+	if v > max {
+		return &fieldError{Code: "OUT_OF_RANGE", Field: field, Message: fmt.Sprintf("%s must be at most %g", field, max)}
+	}
+	return nil
+}
+
+// checkPattern returns an INVALID_FORMAT fieldError when value does not
+// match the field's CUE =~ constraint.
+func checkPattern(field, value string, re *regexp.Regexp) *fieldError {
+	if re.MatchString(value) {
+		return nil
+	}
+	return &fieldError{Code: "INVALID_FORMAT", Field: field, Message: fmt.Sprintf("%s must match %s", field, re)}
+}
+
+// checkCurrency returns an INVALID_CURRENCY fieldError when code would fail
+// the ^[A-Z]{3}$ match on Money currency columns.
+func checkCurrency(field, code string) *fieldError {
+	if validCurrency(code) {
+		return nil
+	}
+	return &fieldError{Code: "INVALID_CURRENCY", Field: field, Message: field + " must be a 3-letter uppercase ISO 4217 code"}
+}
+
+// checkID returns an INVALID_ID fieldError when id is not a UUID.
+func checkID(field, id string) *fieldError {
+	if _, err := uuid.Parse(id); err != nil {
+		return &fieldError{Code: "INVALID_ID", Field: field, Message: "invalid " + field}
+	}
+	return nil
+}
+
+// maxBulkItems caps the number of rows a single bulk create may insert.
+const maxBulkItems = 500
+
+// checkBulkSize writes a 400 and returns false when a bulk request is empty
+// or exceeds maxBulkItems.
+func checkBulkSize(w http.ResponseWriter, n int) bool {
+	if n == 0 {
+		writeError(w, http.StatusBadRequest, "EMPTY_BATCH", "bulk request must contain at least one item")
+		return false
+	}
+	if n > maxBulkItems {
+		writeError(w, http.StatusBadRequest, "BATCH_TOO_LARGE", fmt.Sprintf("bulk request has %d items, max is %d", n, maxBulkItems))
+		return false
+	}
+	return true
+}
+
+// writeBulkErrors reports every invalid item of a bulk request as a 422, with
+// each item's failures keyed by field under its index in the request.
+func writeBulkErrors(w http.ResponseWriter, errs map[int]fieldErrors) {
+	writeJSON(w, http.StatusUnprocessableEntity, map[string]any{
+		"error": fmt.Sprintf("%d invalid items", len(errs)),
+		"code":  "VALIDATION_FAILED",
+		"items": errs,
+	})
+}
+
+// writeCommitError reports a failed transaction commit. The driver's message
+// can name tables and constraints, so it is logged rather than returned.
+func writeCommitError(w http.ResponseWriter, err error) {
+	log.Printf("commit error: %v", err)
+	writeError(w, http.StatusInternalServerError, "COMMIT_ERROR", "transaction could not be committed")
+}
+
 // decodeJSON decodes the request body into v.
 func decodeJSON(r *http.Request, v any) error {
 	defer r.Body.Close()
 	return json.NewDecoder(r.Body).Decode(v)
 }
 
+// decodeOptionalJSON is decodeJSON for a body the client may omit: an empty
+// body leaves v unchanged, while a malformed one is still an error.
+func decodeOptionalJSON(r *http.Request, v any) error {
+	if err := decodeJSON(r, v); err != nil && !errors.Is(err, io.EOF) {
+		return err
+	}
+	return nil
+}
+
+// mergePatchContentType is the RFC 7386 JSON Merge Patch media type.
+const mergePatchContentType = "application/merge-patch+json"
+
+// mergePatch holds the top-level members of an RFC 7386 merge patch. A nil
+// mergePatch (plain application/json body) reports no nulls and no objects,
+// so handlers keep the default PATCH behavior of ignoring nulls and replacing
+// value types wholesale.
+type mergePatch map[string]json.RawMessage
+
+// isNull reports whether the member was present with a null value.
+func (p mergePatch) isNull(member string) bool {
+	raw, ok := p[member]
+	return ok && string(raw) == "null"
+}
+
+// firstNull returns the first of the given members that was null, or "".
+func (p mergePatch) firstNull(members ...string) string {
+	for _, m := range members {
+		if p.isNull(m) {
+			return m
+		}
+	}
+	return ""
+}
+
+// hasObject reports whether any of the given members was a JSON object, i.e.
+// a partial value type that must be merged into the stored one.
+func (p mergePatch) hasObject(members ...string) bool {
+	for _, m := range members {
+		if raw := p[m]; len(raw) > 0 && raw[0] == '{' {
+			return true
+		}
+	}
+	return false
+}
+
+// mergeObject applies an object member of the patch to the stored value of a
+// value-type field, so {"address": {"city": "Oslo"}} keeps the other address
+// keys. decoded is returned unchanged when the member is not an object.
+func mergeObject[T any](p mergePatch, member string, stored, decoded *T) (*T, error) {
+	if !p.hasObject(member) {
+		return decoded, nil
+	}
+	target, err := json.Marshal(stored)
+	if err != nil {
+		return nil, err
+	}
+	merged, err := json.Marshal(applyMergePatch(target, p[member]))
+	if err != nil {
+		return nil, err
+	}
+	var out T
+	if err := json.Unmarshal(merged, &out); err != nil {
+		return nil, fmt.Errorf("%s: %w", member, err)
+	}
+	return &out, nil
+}
+
+// applyMergePatch implements the RFC 7386 MergePatch algorithm: an object
+// patch is merged member by member (null deletes the member), anything else
+// replaces the target.
+func applyMergePatch(target, patch json.RawMessage) any {
+	var patchObj map[string]json.RawMessage
+	if json.Unmarshal(patch, &patchObj) != nil || patchObj == nil {
+		var v any
+		_ = json.Unmarshal(patch, &v)
+		return v
+	}
+	var targetObj map[string]json.RawMessage
+	if json.Unmarshal(target, &targetObj) != nil || targetObj == nil {
+		targetObj = map[string]json.RawMessage{}
+	}
+	out := make(map[string]any, len(targetObj))
+	for name, raw := range targetObj {
+		out[name] = raw
+	}
+	for name, raw := range patchObj {
+		if string(bytes.TrimSpace(raw)) == "null" {
+			delete(out, name)
+			continue
+		}
+		out[name] = applyMergePatch(targetObj[name], raw)
+	}
+	return out
+}
+
+// decodeUpdate decodes a PATCH body into v. For application/merge-patch+json
+// requests the body must be a JSON object, and the returned mergePatch holds
+// its members: null clears a member, an object is merged into a value type,
+// and absent members are neither set nor cleared.
+func decodeUpdate(r *http.Request, v any) (mergePatch, error) {
+	mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if mediaType != mergePatchContentType {
+		return nil, decodeJSON(r, v)
+	}
+	defer r.Body.Close()
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		return nil, err
+	}
+	var members map[string]json.RawMessage
+	if err := json.Unmarshal(body, &members); err != nil || members == nil {
+		return nil, errors.New("merge patch must be a JSON object")
+	}
+	if err := json.Unmarshal(body, v); err != nil {
+		return nil, err
+	}
+	patch := mergePatch{}
+	for name, raw := range members {
+		patch[name] = bytes.TrimSpace(raw)
+	}
+	return patch, nil
+}
+
 // parseUUID extracts and validates a UUID path parameter.
 func parseUUID(w http.ResponseWriter, r *http.Request, paramName string) (uuid.UUID, bool) {
 	raw := chi.URLParam(r, paramName)
@@ -54,16 +331,35 @@ func parseUUID(w http.ResponseWriter, r *http.Request, paramName string) (uuid.U
 	return id, true
 }
 
+// validCurrency reports whether code is a 3-letter uppercase currency code,
+// matching the ^[A-Z]{3}$ constraint on Money currency columns.
+func validCurrency(code string) bool {
+	if len(code) != 3 {
+		return false
+	}
+	for i := 0; i < len(code); i++ {
+		if code[i] < 'A' || code[i] > 'Z' {
+			return false
+		}
+	}
+	return true
+}
+
 // Pagination holds parsed pagination parameters.
 type Pagination struct {
 	Limit  int
 	Offset int
 }
 
-// parsePagination extracts page_size and offset from query params.
+// parsePagination extracts limit (or its older alias page_size) and offset
+// from query params.
 func parsePagination(r *http.Request) Pagination {
 	p := Pagination{Limit: 20, Offset: 0}
-	if v := r.URL.Query().Get("page_size"); v != "" {
+	v := r.URL.Query().Get("limit")
+	if v == "" {
+		v = r.URL.Query().Get("page_size")
+	}
+	if v != "" {
 		if n, err := strconv.Atoi(v); err == nil && n > 0 {
 			p.Limit = n
 		}
@@ -79,6 +375,43 @@ func parsePagination(r *http.Request) Pagination {
 	return p
 }
 
+// listResponse is the envelope returned by generated list endpoints. Total is
+// the number of matching rows before offset/limit are applied.
+type listResponse struct {
+	Data   any `json:"data"`
+	Total  int `json:"total"`
+	Offset int `json:"offset"`
+	Limit  int `json:"limit"`
+}
+
+// withIncludes applies the eager loaders named in ?include=edge1,edge2 to q.
+// Tokens missing from the allowlist are ignored.
+func withIncludes[Q any](r *http.Request, q Q, loaders map[string]func(Q)) {
+	raw := r.URL.Query().Get("include")
+	if raw == "" {
+		return
+	}
+	for _, name := range strings.Split(raw, ",") {
+		if load, ok := loaders[strings.TrimSpace(name)]; ok {
+			load(q)
+		}
+	}
+}
+
+// listOrder resolves the sort and order query params against an allowlist of
+// sortable columns. A missing or unknown sort falls back to the given column,
+// so raw client input never reaches ORDER BY; order defaults to desc.
+func listOrder(r *http.Request, columns map[string]string, fallback string) func(*sql.Selector) {
+	col, ok := columns[r.URL.Query().Get("sort")]
+	if !ok {
+		col = fallback
+	}
+	if strings.EqualFold(r.URL.Query().Get("order"), "asc") {
+		return ent.Asc(col)
+	}
+	return ent.Desc(col)
+}
+
 // entErrorToHTTP maps Ent errors to appropriate HTTP responses.
 func entErrorToHTTP(w http.ResponseWriter, err error) {
 	var jv jurisdiction.Violation
@@ -86,22 +419,143 @@ func entErrorToHTTP(w http.ResponseWriter, err error) {
 		writeError(w, http.StatusBadRequest, "JURISDICTION_VIOLATION", jv.Error())
 		return
 	}
-	if ent.IsNotFound(err) {
-		writeError(w, http.StatusNotFound, "NOT_FOUND", err.Error())
+	// A not-found can surface wrapped, e.g. from reading a mutation's old
+	// values for the audit event; the client only needs what was missing.
+	var nf *ent.NotFoundError
+	if errors.As(err, &nf) {
+		writeError(w, http.StatusNotFound, "NOT_FOUND", strings.TrimPrefix(nf.Error(), "ent: "))
+		return
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		writeError(w, http.StatusServiceUnavailable, "TIMEOUT", "request exceeded the handler timeout")
 		return
 	}
-	if ent.IsValidationError(err) {
-		writeError(w, http.StatusBadRequest, "VALIDATION_ERROR", err.Error())
+	var ve *ent.ValidationError
+	if errors.As(err, &ve) {
+		writeJSON(w, http.StatusBadRequest, map[string]string{
+			"error": ve.Error(),
+			"code":  "VALIDATION_ERROR",
+			"field": ve.Name,
+		})
 		return
 	}
 	if ent.IsConstraintError(err) {
 		writeError(w, http.StatusConflict, "CONSTRAINT_ERROR", err.Error())
 		return
 	}
+	var ce *schema.ConstraintError
+	if errors.As(err, &ce) {
+		writeJSON(w, http.StatusUnprocessableEntity, map[string]string{
+			"error": ce.Msg,
+			"code":  "CONSTRAINT_VIOLATION",
+			"field": ce.Field,
+		})
+		return
+	}
 	log.Printf("internal error: %v", err)
 	writeError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "internal server error")
 }
 
+// entityETag derives a strong ETag from a row's updated_at. Microsecond
+// precision matches what the database round-trips, so the tag returned by an
+// update equals the one a later get computes.
+func entityETag(updatedAt time.Time) string {
+	return `"` + strconv.FormatInt(updatedAt.UnixMicro(), 36) + `"`
+}
+
+// versionETag derives a strong ETag from the version column of a
+// @versioned() entity. Unlike updated_at it changes on every update however
+// close together two writes land.
+func versionETag(version int) string {
+	return `"v` + strconv.Itoa(version) + `"`
+}
+
+// setETag sets the ETag header to tag.
+func setETag(w http.ResponseWriter, tag string) {
+	w.Header().Set("ETag", tag)
+}
+
+// hasIfMatch reports whether the request carries an If-Match precondition, so
+// handlers only pay for fetching the current row when a client asks.
+func hasIfMatch(r *http.Request) bool {
+	return r.Header.Get("If-Match") != ""
+}
+
+// checkIfMatch compares the request's If-Match header against the current
+// row's ETag. It writes a 412 and returns false when no listed tag matches; a
+// missing header or "*" always passes.
+func checkIfMatch(w http.ResponseWriter, r *http.Request, want string) bool {
+	header := r.Header.Get("If-Match")
+	if header == "" {
+		return true
+	}
+	for _, tag := range strings.Split(header, ",") {
+		if tag = strings.TrimSpace(tag); tag == "*" || tag == want {
+			return true
+		}
+	}
+	writePreconditionFailed(w)
+	return false
+}
+
+// writePreconditionFailed reports that the row changed since the client read it.
+func writePreconditionFailed(w http.ResponseWriter) {
+	writeError(w, http.StatusPreconditionFailed, "PRECONDITION_FAILED", "resource was modified since it was read; refetch and retry")
+}
+
+// writeGuardedSaveError answers a failed update save. When the client sent
+// If-Match the update was guarded on the column behind the checked ETag, so a
+// not-found means the row changed (or vanished) after the check: a 412, like
+// a stale tag.
+func writeGuardedSaveError(w http.ResponseWriter, r *http.Request, err error) {
+	if hasIfMatch(r) && ent.IsNotFound(err) {
+		writePreconditionFailed(w)
+		return
+	}
+	entErrorToHTTP(w, err)
+}
+
+// updatedAtScanner is the Scan method of ent's generated select builders.
+type updatedAtScanner interface {
+	Scan(ctx context.Context, v any) error
+}
+
+// latestUpdatedAt runs a list's updated_at select, ordered newest first and
+// limited to one row, and returns that row's updated_at: the max across the
+// rows the list matches. It returns the zero time when none match. Selecting
+// the column rather than MAX() keeps its type, which SQLite drops from
+// aggregates.
+func latestUpdatedAt(ctx context.Context, sel updatedAtScanner) (time.Time, error) {
+	var rows []struct {
+		UpdatedAt time.Time `json:"updated_at"`
+	}
+	if err := sel.Scan(ctx, &rows); err != nil || len(rows) == 0 {
+		return time.Time{}, err
+	}
+	return rows[0].UpdatedAt, nil
+}
+
+// checkIfModifiedSince sets Last-Modified for an append-only list whose
+// newest row was updated at lastModified. When the request's
+// If-Modified-Since is no earlier it writes a 304 and returns false, sparing
+// polling clients the payload. HTTP dates have one-second resolution, so
+// lastModified is truncated before comparing, and no Last-Modified is sent
+// while the current second could still bring another change the truncated
+// date would hide. An empty list has none either and always passes.
+func checkIfModifiedSince(w http.ResponseWriter, r *http.Request, lastModified time.Time) bool {
+	if lastModified.IsZero() || !lastModified.Before(time.Now().Truncate(time.Second)) {
+		return true
+	}
+	lastModified = lastModified.UTC().Truncate(time.Second)
+	w.Header().Set("Last-Modified", lastModified.Format(http.TimeFormat))
+	since, err := http.ParseTime(r.Header.Get("If-Modified-Since"))
+	if err != nil || lastModified.After(since) {
+		return true
+	}
+	w.WriteHeader(http.StatusNotModified)
+	return false
+}
+
 // parseAuditContext extracts audit metadata from request headers.
 func parseAuditContext(w http.ResponseWriter, r *http.Request) (AuditInfo, bool) {
 	actor := r.Header.Get("X-Actor")
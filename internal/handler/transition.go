@@ -1,6 +1,11 @@
 package handler
 
-import "fmt"
+import (
+	"context"
+	"fmt"
+
+	"github.com/matthewbaird/ontology/ent"
+)
 
 // ValidateTransition checks whether transitioning from current to target is
 // allowed according to the given transition map. It returns nil if the
@@ -17,3 +22,15 @@ func ValidateTransition(transitions map[string][]string, current, target string)
 	}
 	return fmt.Errorf("transition from %q to %q is not allowed", current, target)
 }
+
+// guardValues looks up fields for schema.ValidateTransitionWithGuard: a value
+// set on the mutation wins, otherwise the stored value is read.
+func guardValues(ctx context.Context, m ent.Mutation) func(field string) (any, bool) {
+	return func(field string) (any, bool) {
+		if v, ok := m.Field(field); ok {
+			return v, true
+		}
+		v, err := m.OldField(ctx, field)
+		return v, err == nil
+	}
+}
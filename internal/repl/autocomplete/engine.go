@@ -27,7 +27,7 @@ func New(registry *schema.Registry) *Engine {
 }
 
 // verbs is the list of available PQL verbs.
-var verbs = []string{"find", "get", "count", "create", "update", "delete"}
+var verbs = []string{"find", "get", "count", "aggregate", "create", "update", "delete", "describe"}
 
 // clauses is the list of PQL clause keywords.
 var clauses = []string{"where", "select", "include", "order", "limit", "offset"}
@@ -114,6 +114,11 @@ func (e *Engine) contextualComplete(tokens []pql.Token, cursorAtEndOfLastToken,
 
 	// After verb + entity, detect what clause context we're in
 	if len(tokens) >= 2 && first.Type.IsVerb() {
+		// describe <entity> takes no clauses
+		if first.Type == pql.TokenDescribe {
+			return nil
+		}
+
 		entityName := strings.ToLower(tokens[1].Literal)
 		es := e.registry.Entity(entityName)
 
@@ -6,6 +6,7 @@ import (
 	"context"
 	"fmt"
 
+	"entgo.io/ent/dialect/sql"
 	"github.com/google/uuid"
 	"github.com/matthewbaird/ontology/ent"
 	"github.com/matthewbaird/ontology/internal/repl/planner"
@@ -27,6 +28,8 @@ type QueryHandle interface {
 	OrderBy(field string, desc bool) QueryHandle
 	Limit(n int) QueryHandle
 	Offset(n int) QueryHandle
+	// ShowSensitive disables masking of @sensitive()/@pii() fields in All.
+	ShowSensitive() QueryHandle
 	All(ctx context.Context) ([]any, error)
 	Count(ctx context.Context) (int, error)
 }
@@ -42,6 +45,62 @@ type MutationDispatcher interface {
 	Delete(ctx context.Context, client *ent.Client, id uuid.UUID) error
 }
 
+// EdgeTraversal follows one named edge from a single entity instance.
+type EdgeTraversal struct {
+	Target string // Target entity PQL name
+	Unique bool   // True for O2O and M2O: the edge yields at most one entity
+	// Query returns a handle over the entities related to the source with the
+	// given ID. Unique edges are already limited to a single row.
+	Query func(client *ent.Client, id uuid.UUID) QueryHandle
+}
+
+// EdgeDispatcher exposes an entity's edges for traversal queries such as
+// lease.spaces. Each generated entity dispatcher implements this interface.
+type EdgeDispatcher interface {
+	// Edges returns the entity's traversals keyed by edge name.
+	Edges() map[string]EdgeTraversal
+}
+
+// AggregateFunc names an aggregation supported by AggregateDispatcher.
+type AggregateFunc string
+
+const (
+	AggregateCount AggregateFunc = "count"
+	AggregateSum   AggregateFunc = "sum"
+	AggregateAvg   AggregateFunc = "avg"
+)
+
+// AggregateDispatcher computes aggregates over an entity's rows.
+// Each generated entity dispatcher implements this interface.
+type AggregateDispatcher interface {
+	// Aggregate applies fn over the rows matching specs. Count ignores
+	// column; sum and avg require one of the entity's numeric columns and
+	// return zero when no rows match.
+	Aggregate(ctx context.Context, client *ent.Client, fn AggregateFunc, column string, specs ...planner.PredicateSpec) (float64, error)
+}
+
+// aggregateColumn returns the Ent aggregation for a sum or avg over column.
+// SQL yields NULL for either over an empty set, so the result is coalesced
+// to zero.
+func aggregateColumn(fn AggregateFunc, column string) (ent.AggregateFunc, error) {
+	var agg ent.AggregateFunc
+	switch fn {
+	case AggregateSum:
+		agg = ent.Sum(column)
+	case AggregateAvg:
+		agg = ent.Mean(column)
+	default:
+		return nil, fmt.Errorf("unsupported aggregate '%s'", fn)
+	}
+	return func(s *sql.Selector) string {
+		expr := agg(s)
+		if expr == "" {
+			return ""
+		}
+		return "COALESCE(" + expr + ", 0)"
+	}, nil
+}
+
 // DispatchRegistry maps PQL entity names to their dispatchers.
 type DispatchRegistry struct {
 	dispatchers map[string]EntityDispatcher
@@ -77,3 +136,35 @@ func (r *DispatchRegistry) GetMutation(entity string) (MutationDispatcher, error
 	}
 	return md, nil
 }
+
+// GetEdge returns the traversal for an entity's edge, or an error if the
+// entity or edge is unknown.
+func (r *DispatchRegistry) GetEdge(entity, edge string) (EdgeTraversal, error) {
+	d := r.dispatchers[entity]
+	if d == nil {
+		return EdgeTraversal{}, fmt.Errorf("no dispatcher for entity '%s'", entity)
+	}
+	ed, ok := d.(EdgeDispatcher)
+	if !ok {
+		return EdgeTraversal{}, fmt.Errorf("entity '%s' does not support edge traversal", entity)
+	}
+	t, ok := ed.Edges()[edge]
+	if !ok {
+		return EdgeTraversal{}, fmt.Errorf("entity '%s' has no edge '%s'", entity, edge)
+	}
+	return t, nil
+}
+
+// GetAggregate returns the AggregateDispatcher for an entity, or an error
+// if the dispatcher doesn't support aggregation.
+func (r *DispatchRegistry) GetAggregate(entity string) (AggregateDispatcher, error) {
+	d := r.dispatchers[entity]
+	if d == nil {
+		return nil, fmt.Errorf("no dispatcher for entity '%s'", entity)
+	}
+	ad, ok := d.(AggregateDispatcher)
+	if !ok {
+		return nil, fmt.Errorf("entity '%s' does not support aggregation", entity)
+	}
+	return ad, nil
+}
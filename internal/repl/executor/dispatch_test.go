@@ -0,0 +1,150 @@
+package executor
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	"github.com/matthewbaird/ontology/ent"
+	"github.com/matthewbaird/ontology/ent/account"
+	"github.com/matthewbaird/ontology/ent/person"
+	"github.com/matthewbaird/ontology/internal/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	_ "github.com/matthewbaird/ontology/ent/runtime"
+	_ "modernc.org/sqlite"
+)
+
+// newTestClient opens a private in-memory SQLite database with the schema applied.
+func newTestClient(t *testing.T) *ent.Client {
+	t.Helper()
+	db, err := sql.Open("sqlite", "file::memory:?_pragma=foreign_keys(1)&_time_format=sqlite")
+	require.NoError(t, err)
+	db.SetMaxOpenConns(1)
+	client := ent.NewClient(ent.Driver(entsql.OpenDB(dialect.SQLite, db)))
+	t.Cleanup(func() { client.Close() })
+	require.NoError(t, client.Schema.Create(context.Background()))
+	return client
+}
+
+// queryPersonRow runs a person query and decodes its single row as JSON.
+func queryPersonRow(t *testing.T, qh QueryHandle) map[string]any {
+	t.Helper()
+	rows, err := qh.All(context.Background())
+	require.NoError(t, err)
+	require.Len(t, rows, 1)
+	data, err := json.Marshal(rows[0])
+	require.NoError(t, err)
+	var row map[string]any
+	require.NoError(t, json.Unmarshal(data, &row))
+	return row
+}
+
+func TestQueryHandleRedactsPIIByDefault(t *testing.T) {
+	ctx := context.Background()
+	client := newTestClient(t)
+	dob := time.Date(1990, 5, 17, 0, 0, 0, 0, time.UTC)
+	client.Person.Create().
+		SetFirstName("Ada").
+		SetLastName("Lovelace").
+		SetDisplayName("Ada Lovelace").
+		SetDateOfBirth(dob).
+		SetContactMethods([]types.ContactMethod{}).
+		SetCreatedBy("test").
+		SetUpdatedBy("test").
+		SetSource(person.SourceSystem).
+		SaveX(ctx)
+
+	d := InitDispatchers().Get("person")
+	require.NotNil(t, d)
+
+	row := queryPersonRow(t, d.Query(client))
+	assert.Equal(t, redactedValue, row["date_of_birth"])
+	assert.Equal(t, "Ada", row["first_name"])
+
+	row = queryPersonRow(t, d.Query(client).ShowSensitive())
+	assert.Equal(t, dob.Format(time.RFC3339), row["date_of_birth"])
+}
+
+func TestAggregateOverNumericFields(t *testing.T) {
+	ctx := context.Background()
+	client := newTestClient(t)
+	for i, depth := range []int{1, 2, 6} {
+		client.Account.Create().
+			SetAccountNumber(fmt.Sprintf("100%d", i)).
+			SetName("Cash").
+			SetAccountType(account.AccountTypeAsset).
+			SetAccountSubtype(account.AccountSubtypeCash).
+			SetDepth(depth).
+			SetNormalBalance(account.NormalBalanceDebit).
+			SetStatus(account.StatusActive).
+			SetCreatedBy("test").
+			SetUpdatedBy("test").
+			SetSource(account.SourceSystem).
+			SaveX(ctx)
+	}
+
+	ad, err := InitDispatchers().GetAggregate("account")
+	require.NoError(t, err)
+
+	n, err := ad.Aggregate(ctx, client, AggregateCount, "")
+	require.NoError(t, err)
+	assert.Equal(t, float64(3), n)
+
+	sum, err := ad.Aggregate(ctx, client, AggregateSum, "depth")
+	require.NoError(t, err)
+	assert.Equal(t, float64(9), sum)
+
+	avg, err := ad.Aggregate(ctx, client, AggregateAvg, "depth")
+	require.NoError(t, err)
+	assert.Equal(t, float64(3), avg)
+
+	_, err = ad.Aggregate(ctx, client, AggregateSum, "name")
+	assert.Error(t, err)
+}
+
+func TestQueryHandleOrdersAndLimits(t *testing.T) {
+	ctx := context.Background()
+	client := newTestClient(t)
+	for i, depth := range []int{2, 6, 1} {
+		client.Account.Create().
+			SetAccountNumber(fmt.Sprintf("100%d", i)).
+			SetName("Cash").
+			SetAccountType(account.AccountTypeAsset).
+			SetAccountSubtype(account.AccountSubtypeCash).
+			SetDepth(depth).
+			SetNormalBalance(account.NormalBalanceDebit).
+			SetStatus(account.StatusActive).
+			SetCreatedBy("test").
+			SetUpdatedBy("test").
+			SetSource(account.SourceSystem).
+			SaveX(ctx)
+	}
+
+	d := InitDispatchers().Get("account")
+	require.NotNil(t, d)
+	rows, err := d.Query(client).OrderBy("depth", true).Limit(2).All(ctx)
+	require.NoError(t, err)
+	require.Len(t, rows, 2)
+	assert.Equal(t, 6, rows[0].(*ent.Account).Depth)
+	assert.Equal(t, 2, rows[1].(*ent.Account).Depth)
+
+	_, err = d.Query(client).OrderBy("no_such_column", false).All(ctx)
+	assert.Error(t, err, "unknown columns are rejected by Ent")
+}
+
+func TestAggregateEmptySetIsZero(t *testing.T) {
+	client := newTestClient(t)
+	ad, err := InitDispatchers().GetAggregate("account")
+	require.NoError(t, err)
+
+	avg, err := ad.Aggregate(context.Background(), client, AggregateAvg, "depth")
+	require.NoError(t, err)
+	assert.Zero(t, avg)
+}
@@ -12,9 +12,25 @@ import (
 
 // Result holds the output of a query execution.
 type Result struct {
-	Rows  []json.RawMessage `json:"rows,omitempty"`
-	Count *int              `json:"count,omitempty"`
-	Meta  *ResultMeta       `json:"meta,omitempty"`
+	Rows      []json.RawMessage `json:"rows,omitempty"`
+	Count     *int              `json:"count,omitempty"`
+	Aggregate *AggregateResult  `json:"aggregate,omitempty"`
+	Meta      *ResultMeta       `json:"meta,omitempty"`
+}
+
+// AggregateResult is the value of an aggregate query such as sum(depth).
+type AggregateResult struct {
+	Func   string  `json:"func"`
+	Column string  `json:"column,omitempty"`
+	Value  float64 `json:"value"`
+}
+
+// Label returns the aggregate as written in PQL, e.g. "sum(depth)" or "count(*)".
+func (a *AggregateResult) Label() string {
+	if a.Column == "" {
+		return a.Func + "(*)"
+	}
+	return a.Func + "(" + a.Column + ")"
 }
 
 // ResultMeta provides metadata about the result.
@@ -52,6 +68,10 @@ func (e *Executor) Execute(ctx context.Context, plan *planner.QueryPlan) (*Resul
 		return e.execUpdate(ctx, plan)
 	case planner.PlanDelete:
 		return e.execDelete(ctx, plan)
+	case planner.PlanTraverse:
+		return e.execTraverse(ctx, plan)
+	case planner.PlanAggregate:
+		return e.execAggregate(ctx, plan)
 	case planner.PlanMeta:
 		// Meta-commands handled externally
 		return nil, fmt.Errorf("meta-commands should be handled by the meta-command handler")
@@ -94,6 +114,11 @@ func (e *Executor) execFind(ctx context.Context, plan *planner.QueryPlan) (*Resu
 		qh = qh.Offset(plan.Offset)
 	}
 
+	// Sensitive fields are masked unless explicitly requested
+	if plan.ShowSensitive {
+		qh = qh.ShowSensitive()
+	}
+
 	// Execute
 	entities, err := qh.All(ctx)
 	if err != nil {
@@ -147,6 +172,51 @@ func (e *Executor) execGet(ctx context.Context, plan *planner.QueryPlan) (*Resul
 	}, nil
 }
 
+// execTraverse returns the entities related to one entity through an edge.
+func (e *Executor) execTraverse(ctx context.Context, plan *planner.QueryPlan) (*Result, error) {
+	t, err := e.dispatchers.GetEdge(plan.Entity, plan.Edge)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := uuid.Parse(plan.ID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid UUID: %s", plan.ID)
+	}
+
+	qh := t.Query(e.client, id)
+
+	for _, edge := range plan.Edges {
+		qh = qh.WithEdge(edge)
+	}
+
+	if plan.Limit > 0 {
+		qh = qh.Limit(plan.Limit)
+	}
+
+	if plan.ShowSensitive {
+		qh = qh.ShowSensitive()
+	}
+
+	entities, err := qh.All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("query failed: %w", err)
+	}
+
+	rows, err := serializeResults(entities, nil)
+	if err != nil {
+		return nil, fmt.Errorf("serialization failed: %w", err)
+	}
+
+	return &Result{
+		Rows: rows,
+		Meta: &ResultMeta{
+			Entity: t.Target,
+			Total:  len(rows),
+		},
+	}, nil
+}
+
 // execCount executes a count query.
 func (e *Executor) execCount(ctx context.Context, plan *planner.QueryPlan) (*Result, error) {
 	d := e.dispatchers.Get(plan.Entity)
@@ -174,6 +244,31 @@ func (e *Executor) execCount(ctx context.Context, plan *planner.QueryPlan) (*Res
 	}, nil
 }
 
+// execAggregate computes count, sum or avg over the matching rows.
+func (e *Executor) execAggregate(ctx context.Context, plan *planner.QueryPlan) (*Result, error) {
+	ad, err := e.dispatchers.GetAggregate(plan.Entity)
+	if err != nil {
+		return nil, err
+	}
+
+	value, err := ad.Aggregate(ctx, e.client, AggregateFunc(plan.AggregateFunc), plan.AggregateColumn, plan.Predicates...)
+	if err != nil {
+		return nil, fmt.Errorf("aggregate failed: %w", err)
+	}
+
+	return &Result{
+		Aggregate: &AggregateResult{
+			Func:   plan.AggregateFunc,
+			Column: plan.AggregateColumn,
+			Value:  value,
+		},
+		Meta: &ResultMeta{
+			Entity: plan.Entity,
+			Total:  1,
+		},
+	}, nil
+}
+
 // execCreate inserts a new entity.
 func (e *Executor) execCreate(ctx context.Context, plan *planner.QueryPlan) (*Result, error) {
 	md, err := e.dispatchers.GetMutation(plan.Entity)
@@ -0,0 +1,118 @@
+package executor
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/matthewbaird/ontology/ent"
+	"github.com/matthewbaird/ontology/ent/account"
+	"github.com/matthewbaird/ontology/internal/repl/planner"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// createAccount inserts an asset account, optionally under parent.
+func createAccount(t *testing.T, client *ent.Client, number string, depth int, parent *ent.Account) *ent.Account {
+	t.Helper()
+	c := client.Account.Create().
+		SetAccountNumber(number).
+		SetName("Cash " + number).
+		SetAccountType(account.AccountTypeAsset).
+		SetAccountSubtype(account.AccountSubtypeCash).
+		SetDepth(depth).
+		SetNormalBalance(account.NormalBalanceDebit).
+		SetStatus(account.StatusActive).
+		SetCreatedBy("test").
+		SetUpdatedBy("test").
+		SetSource(account.SourceSystem)
+	if parent != nil {
+		c.SetParent(parent)
+	}
+	return c.SaveX(context.Background())
+}
+
+// accountNumbers decodes the account_number of each result row.
+func accountNumbers(t *testing.T, res *Result) []string {
+	t.Helper()
+	var numbers []string
+	for _, raw := range res.Rows {
+		var row struct {
+			AccountNumber string `json:"account_number"`
+		}
+		require.NoError(t, json.Unmarshal(raw, &row))
+		numbers = append(numbers, row.AccountNumber)
+	}
+	return numbers
+}
+
+func TestExecuteTraversesEdges(t *testing.T) {
+	ctx := context.Background()
+	client := newTestClient(t)
+	root := createAccount(t, client, "1000", 0, nil)
+	createAccount(t, client, "1010", 1, root)
+	child := createAccount(t, client, "1020", 1, root)
+	createAccount(t, client, "2000", 0, nil)
+
+	exec := New(client, InitDispatchers())
+
+	res, err := exec.Execute(ctx, &planner.QueryPlan{
+		Type:   planner.PlanTraverse,
+		Entity: "account",
+		ID:     root.ID.String(),
+		Edge:   "children",
+		Limit:  planner.DefaultLimit,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "account", res.Meta.Entity)
+	assert.Equal(t, 2, res.Meta.Total)
+	assert.ElementsMatch(t, []string{"1010", "1020"}, accountNumbers(t, res))
+
+	res, err = exec.Execute(ctx, &planner.QueryPlan{
+		Type:   planner.PlanTraverse,
+		Entity: "account",
+		ID:     child.ID.String(),
+		Edge:   "parent",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"1000"}, accountNumbers(t, res))
+
+	_, err = exec.Execute(ctx, &planner.QueryPlan{
+		Type:   planner.PlanTraverse,
+		Entity: "account",
+		ID:     root.ID.String(),
+		Edge:   "cousins",
+	})
+	assert.ErrorContains(t, err, "no edge 'cousins'")
+}
+
+func TestExecuteAggregates(t *testing.T) {
+	ctx := context.Background()
+	client := newTestClient(t)
+	root := createAccount(t, client, "1000", 0, nil)
+	createAccount(t, client, "1010", 1, root)
+	createAccount(t, client, "1020", 3, root)
+
+	exec := New(client, InitDispatchers())
+
+	res, err := exec.Execute(ctx, &planner.QueryPlan{
+		Type:            planner.PlanAggregate,
+		Entity:          "account",
+		AggregateFunc:   "sum",
+		AggregateColumn: "depth",
+		Predicates:      []planner.PredicateSpec{{Field: "depth", Op: planner.OpGT, Value: 0}},
+	})
+	require.NoError(t, err)
+	require.NotNil(t, res.Aggregate)
+	assert.Equal(t, "sum(depth)", res.Aggregate.Label())
+	assert.Equal(t, float64(4), res.Aggregate.Value)
+
+	res, err = exec.Execute(ctx, &planner.QueryPlan{
+		Type:          planner.PlanAggregate,
+		Entity:        "account",
+		AggregateFunc: "count",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "count(*)", res.Aggregate.Label())
+	assert.Equal(t, float64(3), res.Aggregate.Value)
+}
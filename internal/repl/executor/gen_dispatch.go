@@ -3,6 +3,7 @@ package executor
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 
 	"entgo.io/ent/dialect/sql"
@@ -61,6 +62,56 @@ func InitDispatchers() *DispatchRegistry {
 	return dr
 }
 
+// sensitiveFields lists each entity's @sensitive()/@pii() columns. Their
+// values are masked in REPL output unless sensitive output is requested.
+var sensitiveFields = map[string]map[string]bool{
+	"bank_account": {
+		"routing_number":           true,
+		"account_mask":             true,
+		"account_number_encrypted": true,
+		"plaid_access_token":       true,
+	},
+	"lease": {
+		"security_deposit_amount_cents": true,
+		"security_deposit_currency":     true,
+	},
+	"organization": {
+		"tax_id": true,
+	},
+	"person": {
+		"date_of_birth": true,
+		"ssn_last_four": true,
+	},
+}
+
+// redactedValue replaces sensitive values in REPL output.
+const redactedValue = "***"
+
+// redactSensitive returns row with the entity's sensitive columns masked.
+// Rows of entities without sensitive columns are returned unchanged;
+// others are re-encoded as a JSON object so only present values are masked.
+func redactSensitive(entity string, row any) (any, error) {
+	fields := sensitiveFields[entity]
+	if len(fields) == 0 {
+		return row, nil
+	}
+	data, err := json.Marshal(row)
+	if err != nil {
+		return nil, err
+	}
+	var obj map[string]json.RawMessage
+	if err := json.Unmarshal(data, &obj); err != nil {
+		return nil, err
+	}
+	masked, _ := json.Marshal(redactedValue)
+	for name := range fields {
+		if v, ok := obj[name]; ok && string(v) != "null" {
+			obj[name] = masked
+		}
+	}
+	return obj, nil
+}
+
 // buildSQLPredicate converts a PredicateSpec to a raw SQL selector predicate.
 func buildSQLPredicate(spec planner.PredicateSpec) func(*sql.Selector) {
 	switch spec.Op {
@@ -105,17 +156,136 @@ func (d *accountDispatcher) Get(ctx context.Context, client *ent.Client, id uuid
 }
 
 type accountQueryHandle struct {
-	q *ent.AccountQuery
+	q             *ent.AccountQuery
+	showSensitive bool
 }
 
 func (h *accountQueryHandle) Where(specs ...planner.PredicateSpec) QueryHandle {
 	for _, spec := range specs {
+		if p, ok := accountEnumPredicate(spec); ok {
+			h.q = h.q.Where(p)
+			continue
+		}
 		p := buildSQLPredicate(spec)
 		h.q = h.q.Where(predicate.Account(p))
 	}
 	return h
 }
 
+// accountEnumPredicate builds typed Ent predicates for enum fields. It
+// reports false for other fields, other operators and non-string values,
+// which fall back to buildSQLPredicate.
+func accountEnumPredicate(spec planner.PredicateSpec) (predicate.Account, bool) {
+	switch spec.Field {
+	case "account_type":
+		switch spec.Op {
+		case planner.OpEQ:
+			if v, ok := spec.Value.(string); ok {
+				return account.AccountTypeEQ(account.AccountType(v)), true
+			}
+		case planner.OpNEQ:
+			if v, ok := spec.Value.(string); ok {
+				return account.AccountTypeNEQ(account.AccountType(v)), true
+			}
+		case planner.OpIn:
+			vs := make([]account.AccountType, 0, len(spec.Values))
+			for _, v := range spec.Values {
+				s, ok := v.(string)
+				if !ok {
+					return nil, false
+				}
+				vs = append(vs, account.AccountType(s))
+			}
+			return account.AccountTypeIn(vs...), true
+		}
+	case "account_subtype":
+		switch spec.Op {
+		case planner.OpEQ:
+			if v, ok := spec.Value.(string); ok {
+				return account.AccountSubtypeEQ(account.AccountSubtype(v)), true
+			}
+		case planner.OpNEQ:
+			if v, ok := spec.Value.(string); ok {
+				return account.AccountSubtypeNEQ(account.AccountSubtype(v)), true
+			}
+		case planner.OpIn:
+			vs := make([]account.AccountSubtype, 0, len(spec.Values))
+			for _, v := range spec.Values {
+				s, ok := v.(string)
+				if !ok {
+					return nil, false
+				}
+				vs = append(vs, account.AccountSubtype(s))
+			}
+			return account.AccountSubtypeIn(vs...), true
+		}
+	case "normal_balance":
+		switch spec.Op {
+		case planner.OpEQ:
+			if v, ok := spec.Value.(string); ok {
+				return account.NormalBalanceEQ(account.NormalBalance(v)), true
+			}
+		case planner.OpNEQ:
+			if v, ok := spec.Value.(string); ok {
+				return account.NormalBalanceNEQ(account.NormalBalance(v)), true
+			}
+		case planner.OpIn:
+			vs := make([]account.NormalBalance, 0, len(spec.Values))
+			for _, v := range spec.Values {
+				s, ok := v.(string)
+				if !ok {
+					return nil, false
+				}
+				vs = append(vs, account.NormalBalance(s))
+			}
+			return account.NormalBalanceIn(vs...), true
+		}
+	case "status":
+		switch spec.Op {
+		case planner.OpEQ:
+			if v, ok := spec.Value.(string); ok {
+				return account.StatusEQ(account.Status(v)), true
+			}
+		case planner.OpNEQ:
+			if v, ok := spec.Value.(string); ok {
+				return account.StatusNEQ(account.Status(v)), true
+			}
+		case planner.OpIn:
+			vs := make([]account.Status, 0, len(spec.Values))
+			for _, v := range spec.Values {
+				s, ok := v.(string)
+				if !ok {
+					return nil, false
+				}
+				vs = append(vs, account.Status(s))
+			}
+			return account.StatusIn(vs...), true
+		}
+	case "trust_type":
+		switch spec.Op {
+		case planner.OpEQ:
+			if v, ok := spec.Value.(string); ok {
+				return account.TrustTypeEQ(account.TrustType(v)), true
+			}
+		case planner.OpNEQ:
+			if v, ok := spec.Value.(string); ok {
+				return account.TrustTypeNEQ(account.TrustType(v)), true
+			}
+		case planner.OpIn:
+			vs := make([]account.TrustType, 0, len(spec.Values))
+			for _, v := range spec.Values {
+				s, ok := v.(string)
+				if !ok {
+					return nil, false
+				}
+				vs = append(vs, account.TrustType(s))
+			}
+			return account.TrustTypeIn(vs...), true
+		}
+	}
+	return nil, false
+}
+
 func (h *accountQueryHandle) WithEdge(name string) QueryHandle {
 	switch name {
 	case "children":
@@ -130,12 +300,85 @@ func (h *accountQueryHandle) WithEdge(name string) QueryHandle {
 	return h
 }
 
+// accountEdges maps each Account edge to a traversal returning the
+// related entities' query handle.
+var accountEdges = map[string]EdgeTraversal{
+	"children": {
+		Target: "account",
+		Unique: false,
+		Query: func(client *ent.Client, id uuid.UUID) QueryHandle {
+			return &accountQueryHandle{q: client.Account.Query().Where(account.ID(id)).QueryChildren()}
+		},
+	},
+	"parent": {
+		Target: "account",
+		Unique: true,
+		Query: func(client *ent.Client, id uuid.UUID) QueryHandle {
+			return &accountQueryHandle{q: client.Account.Query().Where(account.ID(id)).QueryParent().Limit(1)}
+		},
+	},
+	"entries": {
+		Target: "ledger_entry",
+		Unique: false,
+		Query: func(client *ent.Client, id uuid.UUID) QueryHandle {
+			return &ledgerentryQueryHandle{q: client.Account.Query().Where(account.ID(id)).QueryEntries()}
+		},
+	},
+	"bank_accounts": {
+		Target: "bank_account",
+		Unique: false,
+		Query: func(client *ent.Client, id uuid.UUID) QueryHandle {
+			return &bankaccountQueryHandle{q: client.Account.Query().Where(account.ID(id)).QueryBankAccounts()}
+		},
+	},
+}
+
+// Edges returns the Account edge traversals.
+func (d *accountDispatcher) Edges() map[string]EdgeTraversal {
+	return accountEdges
+}
+
+// accountOrderColumns maps each orderable Account field to its Ent
+// column. JSON fields have no meaningful order and edge FKs no column of
+// their own, so both are left out.
+var accountOrderColumns = map[string]string{
+	"id":                         account.FieldID,
+	"account_number":             account.FieldAccountNumber,
+	"name":                       account.FieldName,
+	"description":                account.FieldDescription,
+	"account_type":               account.FieldAccountType,
+	"account_subtype":            account.FieldAccountSubtype,
+	"parent_account_id":          account.FieldParentAccountID,
+	"depth":                      account.FieldDepth,
+	"normal_balance":             account.FieldNormalBalance,
+	"is_header":                  account.FieldIsHeader,
+	"is_system":                  account.FieldIsSystem,
+	"allows_direct_posting":      account.FieldAllowsDirectPosting,
+	"status":                     account.FieldStatus,
+	"is_trust_account":           account.FieldIsTrustAccount,
+	"trust_type":                 account.FieldTrustType,
+	"budget_amount_amount_cents": account.FieldBudgetAmountAmountCents,
+	"budget_amount_currency":     account.FieldBudgetAmountCurrency,
+	"tax_line":                   account.FieldTaxLine,
+}
+
+// OrderBy sorts by an orderable column. The planner has already validated the
+// field; anything else is passed through so Ent rejects it when the query runs.
 func (h *accountQueryHandle) OrderBy(field string, desc bool) QueryHandle {
-	var opts []sql.OrderTermOption
+	column, ok := accountOrderColumns[field]
+	if !ok {
+		column = field
+	}
+	order := ent.Asc
 	if desc {
-		opts = append(opts, sql.OrderDesc())
+		order = ent.Desc
 	}
-	h.q = h.q.Order(account.OrderOption(sql.OrderByField(field, opts...).ToFunc()))
+	h.q = h.q.Order(order(column))
+	return h
+}
+
+func (h *accountQueryHandle) ShowSensitive() QueryHandle {
+	h.showSensitive = true
 	return h
 }
 
@@ -156,7 +399,13 @@ func (h *accountQueryHandle) All(ctx context.Context) ([]any, error) {
 	}
 	out := make([]any, len(results))
 	for i, r := range results {
-		out[i] = r
+		if h.showSensitive {
+			out[i] = r
+			continue
+		}
+		if out[i], err = redactSensitive("account", r); err != nil {
+			return nil, err
+		}
 	}
 	return out, nil
 }
@@ -165,6 +414,28 @@ func (h *accountQueryHandle) Count(ctx context.Context) (int, error) {
 	return h.q.Count(ctx)
 }
 
+// accountAggregatable lists the Account columns valid for sum and avg.
+var accountAggregatable = map[string]bool{
+	"depth":                      true,
+	"budget_amount_amount_cents": true,
+}
+
+func (d *accountDispatcher) Aggregate(ctx context.Context, client *ent.Client, fn AggregateFunc, column string, specs ...planner.PredicateSpec) (float64, error) {
+	h := d.Query(client).Where(specs...).(*accountQueryHandle)
+	if fn == AggregateCount {
+		n, err := h.q.Count(ctx)
+		return float64(n), err
+	}
+	if !accountAggregatable[column] {
+		return 0, fmt.Errorf("cannot %s '%s': not a numeric field of account", fn, column)
+	}
+	agg, err := aggregateColumn(fn, column)
+	if err != nil {
+		return 0, err
+	}
+	return h.q.Aggregate(agg).Float64(ctx)
+}
+
 func (d *accountDispatcher) Create(ctx context.Context, client *ent.Client, fields map[string]any) (any, error) {
 	builder := client.Account.Create()
 	m := builder.Mutation()
@@ -204,17 +475,52 @@ func (d *applicationDispatcher) Get(ctx context.Context, client *ent.Client, id
 }
 
 type applicationQueryHandle struct {
-	q *ent.ApplicationQuery
+	q             *ent.ApplicationQuery
+	showSensitive bool
 }
 
 func (h *applicationQueryHandle) Where(specs ...planner.PredicateSpec) QueryHandle {
 	for _, spec := range specs {
+		if p, ok := applicationEnumPredicate(spec); ok {
+			h.q = h.q.Where(p)
+			continue
+		}
 		p := buildSQLPredicate(spec)
 		h.q = h.q.Where(predicate.Application(p))
 	}
 	return h
 }
 
+// applicationEnumPredicate builds typed Ent predicates for enum fields. It
+// reports false for other fields, other operators and non-string values,
+// which fall back to buildSQLPredicate.
+func applicationEnumPredicate(spec planner.PredicateSpec) (predicate.Application, bool) {
+	switch spec.Field {
+	case "status":
+		switch spec.Op {
+		case planner.OpEQ:
+			if v, ok := spec.Value.(string); ok {
+				return application.StatusEQ(application.Status(v)), true
+			}
+		case planner.OpNEQ:
+			if v, ok := spec.Value.(string); ok {
+				return application.StatusNEQ(application.Status(v)), true
+			}
+		case planner.OpIn:
+			vs := make([]application.Status, 0, len(spec.Values))
+			for _, v := range spec.Values {
+				s, ok := v.(string)
+				if !ok {
+					return nil, false
+				}
+				vs = append(vs, application.Status(s))
+			}
+			return application.StatusIn(vs...), true
+		}
+	}
+	return nil, false
+}
+
 func (h *applicationQueryHandle) WithEdge(name string) QueryHandle {
 	switch name {
 	case "property":
@@ -229,12 +535,84 @@ func (h *applicationQueryHandle) WithEdge(name string) QueryHandle {
 	return h
 }
 
+// applicationEdges maps each Application edge to a traversal returning the
+// related entities' query handle.
+var applicationEdges = map[string]EdgeTraversal{
+	"property": {
+		Target: "property",
+		Unique: true,
+		Query: func(client *ent.Client, id uuid.UUID) QueryHandle {
+			return &propertyQueryHandle{q: client.Application.Query().Where(application.ID(id)).QueryProperty().Limit(1)}
+		},
+	},
+	"space": {
+		Target: "space",
+		Unique: true,
+		Query: func(client *ent.Client, id uuid.UUID) QueryHandle {
+			return &spaceQueryHandle{q: client.Application.Query().Where(application.ID(id)).QuerySpace().Limit(1)}
+		},
+	},
+	"resulting_lease": {
+		Target: "lease",
+		Unique: true,
+		Query: func(client *ent.Client, id uuid.UUID) QueryHandle {
+			return &leaseQueryHandle{q: client.Application.Query().Where(application.ID(id)).QueryResultingLease().Limit(1)}
+		},
+	},
+	"applicant": {
+		Target: "person",
+		Unique: true,
+		Query: func(client *ent.Client, id uuid.UUID) QueryHandle {
+			return &personQueryHandle{q: client.Application.Query().Where(application.ID(id)).QueryApplicant().Limit(1)}
+		},
+	},
+}
+
+// Edges returns the Application edge traversals.
+func (d *applicationDispatcher) Edges() map[string]EdgeTraversal {
+	return applicationEdges
+}
+
+// applicationOrderColumns maps each orderable Application field to its Ent
+// column. JSON fields have no meaningful order and edge FKs no column of
+// their own, so both are left out.
+var applicationOrderColumns = map[string]string{
+	"id":                           application.FieldID,
+	"applicant_person_id":          application.FieldApplicantPersonID,
+	"status":                       application.FieldStatus,
+	"desired_move_in":              application.FieldDesiredMoveIn,
+	"desired_lease_term_months":    application.FieldDesiredLeaseTermMonths,
+	"screening_request_id":         application.FieldScreeningRequestID,
+	"screening_completed":          application.FieldScreeningCompleted,
+	"credit_score":                 application.FieldCreditScore,
+	"background_clear":             application.FieldBackgroundClear,
+	"income_verified":              application.FieldIncomeVerified,
+	"income_to_rent_ratio":         application.FieldIncomeToRentRatio,
+	"decision_by":                  application.FieldDecisionBy,
+	"decision_at":                  application.FieldDecisionAt,
+	"decision_reason":              application.FieldDecisionReason,
+	"application_fee_amount_cents": application.FieldApplicationFeeAmountCents,
+	"application_fee_currency":     application.FieldApplicationFeeCurrency,
+	"fee_paid":                     application.FieldFeePaid,
+}
+
+// OrderBy sorts by an orderable column. The planner has already validated the
+// field; anything else is passed through so Ent rejects it when the query runs.
 func (h *applicationQueryHandle) OrderBy(field string, desc bool) QueryHandle {
-	var opts []sql.OrderTermOption
+	column, ok := applicationOrderColumns[field]
+	if !ok {
+		column = field
+	}
+	order := ent.Asc
 	if desc {
-		opts = append(opts, sql.OrderDesc())
+		order = ent.Desc
 	}
-	h.q = h.q.Order(application.OrderOption(sql.OrderByField(field, opts...).ToFunc()))
+	h.q = h.q.Order(order(column))
+	return h
+}
+
+func (h *applicationQueryHandle) ShowSensitive() QueryHandle {
+	h.showSensitive = true
 	return h
 }
 
@@ -255,7 +633,13 @@ func (h *applicationQueryHandle) All(ctx context.Context) ([]any, error) {
 	}
 	out := make([]any, len(results))
 	for i, r := range results {
-		out[i] = r
+		if h.showSensitive {
+			out[i] = r
+			continue
+		}
+		if out[i], err = redactSensitive("application", r); err != nil {
+			return nil, err
+		}
 	}
 	return out, nil
 }
@@ -264,6 +648,30 @@ func (h *applicationQueryHandle) Count(ctx context.Context) (int, error) {
 	return h.q.Count(ctx)
 }
 
+// applicationAggregatable lists the Application columns valid for sum and avg.
+var applicationAggregatable = map[string]bool{
+	"desired_lease_term_months":    true,
+	"credit_score":                 true,
+	"income_to_rent_ratio":         true,
+	"application_fee_amount_cents": true,
+}
+
+func (d *applicationDispatcher) Aggregate(ctx context.Context, client *ent.Client, fn AggregateFunc, column string, specs ...planner.PredicateSpec) (float64, error) {
+	h := d.Query(client).Where(specs...).(*applicationQueryHandle)
+	if fn == AggregateCount {
+		n, err := h.q.Count(ctx)
+		return float64(n), err
+	}
+	if !applicationAggregatable[column] {
+		return 0, fmt.Errorf("cannot %s '%s': not a numeric field of application", fn, column)
+	}
+	agg, err := aggregateColumn(fn, column)
+	if err != nil {
+		return 0, err
+	}
+	return h.q.Aggregate(agg).Float64(ctx)
+}
+
 func (d *applicationDispatcher) Create(ctx context.Context, client *ent.Client, fields map[string]any) (any, error) {
 	builder := client.Application.Create()
 	m := builder.Mutation()
@@ -303,17 +711,73 @@ func (d *bankaccountDispatcher) Get(ctx context.Context, client *ent.Client, id
 }
 
 type bankaccountQueryHandle struct {
-	q *ent.BankAccountQuery
+	q             *ent.BankAccountQuery
+	showSensitive bool
 }
 
 func (h *bankaccountQueryHandle) Where(specs ...planner.PredicateSpec) QueryHandle {
 	for _, spec := range specs {
+		if p, ok := bankaccountEnumPredicate(spec); ok {
+			h.q = h.q.Where(p)
+			continue
+		}
 		p := buildSQLPredicate(spec)
 		h.q = h.q.Where(predicate.BankAccount(p))
 	}
 	return h
 }
 
+// bankaccountEnumPredicate builds typed Ent predicates for enum fields. It
+// reports false for other fields, other operators and non-string values,
+// which fall back to buildSQLPredicate.
+func bankaccountEnumPredicate(spec planner.PredicateSpec) (predicate.BankAccount, bool) {
+	switch spec.Field {
+	case "account_type":
+		switch spec.Op {
+		case planner.OpEQ:
+			if v, ok := spec.Value.(string); ok {
+				return bankaccount.AccountTypeEQ(bankaccount.AccountType(v)), true
+			}
+		case planner.OpNEQ:
+			if v, ok := spec.Value.(string); ok {
+				return bankaccount.AccountTypeNEQ(bankaccount.AccountType(v)), true
+			}
+		case planner.OpIn:
+			vs := make([]bankaccount.AccountType, 0, len(spec.Values))
+			for _, v := range spec.Values {
+				s, ok := v.(string)
+				if !ok {
+					return nil, false
+				}
+				vs = append(vs, bankaccount.AccountType(s))
+			}
+			return bankaccount.AccountTypeIn(vs...), true
+		}
+	case "status":
+		switch spec.Op {
+		case planner.OpEQ:
+			if v, ok := spec.Value.(string); ok {
+				return bankaccount.StatusEQ(bankaccount.Status(v)), true
+			}
+		case planner.OpNEQ:
+			if v, ok := spec.Value.(string); ok {
+				return bankaccount.StatusNEQ(bankaccount.Status(v)), true
+			}
+		case planner.OpIn:
+			vs := make([]bankaccount.Status, 0, len(spec.Values))
+			for _, v := range spec.Values {
+				s, ok := v.(string)
+				if !ok {
+					return nil, false
+				}
+				vs = append(vs, bankaccount.Status(s))
+			}
+			return bankaccount.StatusIn(vs...), true
+		}
+	}
+	return nil, false
+}
+
 func (h *bankaccountQueryHandle) WithEdge(name string) QueryHandle {
 	switch name {
 	case "trust_portfolio":
@@ -328,12 +792,85 @@ func (h *bankaccountQueryHandle) WithEdge(name string) QueryHandle {
 	return h
 }
 
+// bankaccountEdges maps each BankAccount edge to a traversal returning the
+// related entities' query handle.
+var bankaccountEdges = map[string]EdgeTraversal{
+	"trust_portfolio": {
+		Target: "portfolio",
+		Unique: true,
+		Query: func(client *ent.Client, id uuid.UUID) QueryHandle {
+			return &portfolioQueryHandle{q: client.BankAccount.Query().Where(bankaccount.ID(id)).QueryTrustPortfolio().Limit(1)}
+		},
+	},
+	"properties": {
+		Target: "property",
+		Unique: false,
+		Query: func(client *ent.Client, id uuid.UUID) QueryHandle {
+			return &propertyQueryHandle{q: client.BankAccount.Query().Where(bankaccount.ID(id)).QueryProperties()}
+		},
+	},
+	"gl_account": {
+		Target: "account",
+		Unique: true,
+		Query: func(client *ent.Client, id uuid.UUID) QueryHandle {
+			return &accountQueryHandle{q: client.BankAccount.Query().Where(bankaccount.ID(id)).QueryGlAccount().Limit(1)}
+		},
+	},
+	"reconciliations": {
+		Target: "reconciliation",
+		Unique: false,
+		Query: func(client *ent.Client, id uuid.UUID) QueryHandle {
+			return &reconciliationQueryHandle{q: client.BankAccount.Query().Where(bankaccount.ID(id)).QueryReconciliations()}
+		},
+	},
+}
+
+// Edges returns the BankAccount edge traversals.
+func (d *bankaccountDispatcher) Edges() map[string]EdgeTraversal {
+	return bankaccountEdges
+}
+
+// bankaccountOrderColumns maps each orderable BankAccount field to its Ent
+// column. JSON fields have no meaningful order and edge FKs no column of
+// their own, so both are left out.
+var bankaccountOrderColumns = map[string]string{
+	"id":                           bankaccount.FieldID,
+	"name":                         bankaccount.FieldName,
+	"account_type":                 bankaccount.FieldAccountType,
+	"institution_name":             bankaccount.FieldInstitutionName,
+	"routing_number":               bankaccount.FieldRoutingNumber,
+	"account_mask":                 bankaccount.FieldAccountMask,
+	"account_number_encrypted":     bankaccount.FieldAccountNumberEncrypted,
+	"plaid_account_id":             bankaccount.FieldPlaidAccountID,
+	"plaid_access_token":           bankaccount.FieldPlaidAccessToken,
+	"property_id":                  bankaccount.FieldPropertyID,
+	"entity_id":                    bankaccount.FieldEntityID,
+	"status":                       bankaccount.FieldStatus,
+	"is_default":                   bankaccount.FieldIsDefault,
+	"accepts_deposits":             bankaccount.FieldAcceptsDeposits,
+	"accepts_payments":             bankaccount.FieldAcceptsPayments,
+	"current_balance_amount_cents": bankaccount.FieldCurrentBalanceAmountCents,
+	"current_balance_currency":     bankaccount.FieldCurrentBalanceCurrency,
+	"last_statement_date":          bankaccount.FieldLastStatementDate,
+}
+
+// OrderBy sorts by an orderable column. The planner has already validated the
+// field; anything else is passed through so Ent rejects it when the query runs.
 func (h *bankaccountQueryHandle) OrderBy(field string, desc bool) QueryHandle {
-	var opts []sql.OrderTermOption
+	column, ok := bankaccountOrderColumns[field]
+	if !ok {
+		column = field
+	}
+	order := ent.Asc
 	if desc {
-		opts = append(opts, sql.OrderDesc())
+		order = ent.Desc
 	}
-	h.q = h.q.Order(bankaccount.OrderOption(sql.OrderByField(field, opts...).ToFunc()))
+	h.q = h.q.Order(order(column))
+	return h
+}
+
+func (h *bankaccountQueryHandle) ShowSensitive() QueryHandle {
+	h.showSensitive = true
 	return h
 }
 
@@ -354,7 +891,13 @@ func (h *bankaccountQueryHandle) All(ctx context.Context) ([]any, error) {
 	}
 	out := make([]any, len(results))
 	for i, r := range results {
-		out[i] = r
+		if h.showSensitive {
+			out[i] = r
+			continue
+		}
+		if out[i], err = redactSensitive("bank_account", r); err != nil {
+			return nil, err
+		}
 	}
 	return out, nil
 }
@@ -363,6 +906,27 @@ func (h *bankaccountQueryHandle) Count(ctx context.Context) (int, error) {
 	return h.q.Count(ctx)
 }
 
+// bankaccountAggregatable lists the BankAccount columns valid for sum and avg.
+var bankaccountAggregatable = map[string]bool{
+	"current_balance_amount_cents": true,
+}
+
+func (d *bankaccountDispatcher) Aggregate(ctx context.Context, client *ent.Client, fn AggregateFunc, column string, specs ...planner.PredicateSpec) (float64, error) {
+	h := d.Query(client).Where(specs...).(*bankaccountQueryHandle)
+	if fn == AggregateCount {
+		n, err := h.q.Count(ctx)
+		return float64(n), err
+	}
+	if !bankaccountAggregatable[column] {
+		return 0, fmt.Errorf("cannot %s '%s': not a numeric field of bank_account", fn, column)
+	}
+	agg, err := aggregateColumn(fn, column)
+	if err != nil {
+		return 0, err
+	}
+	return h.q.Aggregate(agg).Float64(ctx)
+}
+
 func (d *bankaccountDispatcher) Create(ctx context.Context, client *ent.Client, fields map[string]any) (any, error) {
 	builder := client.BankAccount.Create()
 	m := builder.Mutation()
@@ -402,17 +966,73 @@ func (d *buildingDispatcher) Get(ctx context.Context, client *ent.Client, id uui
 }
 
 type buildingQueryHandle struct {
-	q *ent.BuildingQuery
+	q             *ent.BuildingQuery
+	showSensitive bool
 }
 
 func (h *buildingQueryHandle) Where(specs ...planner.PredicateSpec) QueryHandle {
 	for _, spec := range specs {
+		if p, ok := buildingEnumPredicate(spec); ok {
+			h.q = h.q.Where(p)
+			continue
+		}
 		p := buildSQLPredicate(spec)
 		h.q = h.q.Where(predicate.Building(p))
 	}
 	return h
 }
 
+// buildingEnumPredicate builds typed Ent predicates for enum fields. It
+// reports false for other fields, other operators and non-string values,
+// which fall back to buildSQLPredicate.
+func buildingEnumPredicate(spec planner.PredicateSpec) (predicate.Building, bool) {
+	switch spec.Field {
+	case "building_type":
+		switch spec.Op {
+		case planner.OpEQ:
+			if v, ok := spec.Value.(string); ok {
+				return building.BuildingTypeEQ(building.BuildingType(v)), true
+			}
+		case planner.OpNEQ:
+			if v, ok := spec.Value.(string); ok {
+				return building.BuildingTypeNEQ(building.BuildingType(v)), true
+			}
+		case planner.OpIn:
+			vs := make([]building.BuildingType, 0, len(spec.Values))
+			for _, v := range spec.Values {
+				s, ok := v.(string)
+				if !ok {
+					return nil, false
+				}
+				vs = append(vs, building.BuildingType(s))
+			}
+			return building.BuildingTypeIn(vs...), true
+		}
+	case "status":
+		switch spec.Op {
+		case planner.OpEQ:
+			if v, ok := spec.Value.(string); ok {
+				return building.StatusEQ(building.Status(v)), true
+			}
+		case planner.OpNEQ:
+			if v, ok := spec.Value.(string); ok {
+				return building.StatusNEQ(building.Status(v)), true
+			}
+		case planner.OpIn:
+			vs := make([]building.Status, 0, len(spec.Values))
+			for _, v := range spec.Values {
+				s, ok := v.(string)
+				if !ok {
+					return nil, false
+				}
+				vs = append(vs, building.Status(s))
+			}
+			return building.StatusIn(vs...), true
+		}
+	}
+	return nil, false
+}
+
 func (h *buildingQueryHandle) WithEdge(name string) QueryHandle {
 	switch name {
 	case "property":
@@ -423,12 +1043,62 @@ func (h *buildingQueryHandle) WithEdge(name string) QueryHandle {
 	return h
 }
 
+// buildingEdges maps each Building edge to a traversal returning the
+// related entities' query handle.
+var buildingEdges = map[string]EdgeTraversal{
+	"property": {
+		Target: "property",
+		Unique: true,
+		Query: func(client *ent.Client, id uuid.UUID) QueryHandle {
+			return &propertyQueryHandle{q: client.Building.Query().Where(building.ID(id)).QueryProperty().Limit(1)}
+		},
+	},
+	"spaces": {
+		Target: "space",
+		Unique: false,
+		Query: func(client *ent.Client, id uuid.UUID) QueryHandle {
+			return &spaceQueryHandle{q: client.Building.Query().Where(building.ID(id)).QuerySpaces()}
+		},
+	},
+}
+
+// Edges returns the Building edge traversals.
+func (d *buildingDispatcher) Edges() map[string]EdgeTraversal {
+	return buildingEdges
+}
+
+// buildingOrderColumns maps each orderable Building field to its Ent
+// column. JSON fields have no meaningful order and edge FKs no column of
+// their own, so both are left out.
+var buildingOrderColumns = map[string]string{
+	"id":                            building.FieldID,
+	"name":                          building.FieldName,
+	"building_type":                 building.FieldBuildingType,
+	"description":                   building.FieldDescription,
+	"status":                        building.FieldStatus,
+	"floors":                        building.FieldFloors,
+	"year_built":                    building.FieldYearBuilt,
+	"total_square_footage":          building.FieldTotalSquareFootage,
+	"total_rentable_square_footage": building.FieldTotalRentableSquareFootage,
+}
+
+// OrderBy sorts by an orderable column. The planner has already validated the
+// field; anything else is passed through so Ent rejects it when the query runs.
 func (h *buildingQueryHandle) OrderBy(field string, desc bool) QueryHandle {
-	var opts []sql.OrderTermOption
+	column, ok := buildingOrderColumns[field]
+	if !ok {
+		column = field
+	}
+	order := ent.Asc
 	if desc {
-		opts = append(opts, sql.OrderDesc())
+		order = ent.Desc
 	}
-	h.q = h.q.Order(building.OrderOption(sql.OrderByField(field, opts...).ToFunc()))
+	h.q = h.q.Order(order(column))
+	return h
+}
+
+func (h *buildingQueryHandle) ShowSensitive() QueryHandle {
+	h.showSensitive = true
 	return h
 }
 
@@ -449,7 +1119,13 @@ func (h *buildingQueryHandle) All(ctx context.Context) ([]any, error) {
 	}
 	out := make([]any, len(results))
 	for i, r := range results {
-		out[i] = r
+		if h.showSensitive {
+			out[i] = r
+			continue
+		}
+		if out[i], err = redactSensitive("building", r); err != nil {
+			return nil, err
+		}
 	}
 	return out, nil
 }
@@ -458,6 +1134,30 @@ func (h *buildingQueryHandle) Count(ctx context.Context) (int, error) {
 	return h.q.Count(ctx)
 }
 
+// buildingAggregatable lists the Building columns valid for sum and avg.
+var buildingAggregatable = map[string]bool{
+	"floors":                        true,
+	"year_built":                    true,
+	"total_square_footage":          true,
+	"total_rentable_square_footage": true,
+}
+
+func (d *buildingDispatcher) Aggregate(ctx context.Context, client *ent.Client, fn AggregateFunc, column string, specs ...planner.PredicateSpec) (float64, error) {
+	h := d.Query(client).Where(specs...).(*buildingQueryHandle)
+	if fn == AggregateCount {
+		n, err := h.q.Count(ctx)
+		return float64(n), err
+	}
+	if !buildingAggregatable[column] {
+		return 0, fmt.Errorf("cannot %s '%s': not a numeric field of building", fn, column)
+	}
+	agg, err := aggregateColumn(fn, column)
+	if err != nil {
+		return 0, err
+	}
+	return h.q.Aggregate(agg).Float64(ctx)
+}
+
 func (d *buildingDispatcher) Create(ctx context.Context, client *ent.Client, fields map[string]any) (any, error) {
 	builder := client.Building.Create()
 	m := builder.Mutation()
@@ -497,17 +1197,73 @@ func (d *journalentryDispatcher) Get(ctx context.Context, client *ent.Client, id
 }
 
 type journalentryQueryHandle struct {
-	q *ent.JournalEntryQuery
+	q             *ent.JournalEntryQuery
+	showSensitive bool
 }
 
 func (h *journalentryQueryHandle) Where(specs ...planner.PredicateSpec) QueryHandle {
 	for _, spec := range specs {
+		if p, ok := journalentryEnumPredicate(spec); ok {
+			h.q = h.q.Where(p)
+			continue
+		}
 		p := buildSQLPredicate(spec)
 		h.q = h.q.Where(predicate.JournalEntry(p))
 	}
 	return h
 }
 
+// journalentryEnumPredicate builds typed Ent predicates for enum fields. It
+// reports false for other fields, other operators and non-string values,
+// which fall back to buildSQLPredicate.
+func journalentryEnumPredicate(spec planner.PredicateSpec) (predicate.JournalEntry, bool) {
+	switch spec.Field {
+	case "source_type":
+		switch spec.Op {
+		case planner.OpEQ:
+			if v, ok := spec.Value.(string); ok {
+				return journalentry.SourceTypeEQ(journalentry.SourceType(v)), true
+			}
+		case planner.OpNEQ:
+			if v, ok := spec.Value.(string); ok {
+				return journalentry.SourceTypeNEQ(journalentry.SourceType(v)), true
+			}
+		case planner.OpIn:
+			vs := make([]journalentry.SourceType, 0, len(spec.Values))
+			for _, v := range spec.Values {
+				s, ok := v.(string)
+				if !ok {
+					return nil, false
+				}
+				vs = append(vs, journalentry.SourceType(s))
+			}
+			return journalentry.SourceTypeIn(vs...), true
+		}
+	case "status":
+		switch spec.Op {
+		case planner.OpEQ:
+			if v, ok := spec.Value.(string); ok {
+				return journalentry.StatusEQ(journalentry.Status(v)), true
+			}
+		case planner.OpNEQ:
+			if v, ok := spec.Value.(string); ok {
+				return journalentry.StatusNEQ(journalentry.Status(v)), true
+			}
+		case planner.OpIn:
+			vs := make([]journalentry.Status, 0, len(spec.Values))
+			for _, v := range spec.Values {
+				s, ok := v.(string)
+				if !ok {
+					return nil, false
+				}
+				vs = append(vs, journalentry.Status(s))
+			}
+			return journalentry.StatusIn(vs...), true
+		}
+	}
+	return nil, false
+}
+
 func (h *journalentryQueryHandle) WithEdge(name string) QueryHandle {
 	switch name {
 	case "ledger_entries":
@@ -516,12 +1272,60 @@ func (h *journalentryQueryHandle) WithEdge(name string) QueryHandle {
 	return h
 }
 
+// journalentryEdges maps each JournalEntry edge to a traversal returning the
+// related entities' query handle.
+var journalentryEdges = map[string]EdgeTraversal{
+	"ledger_entries": {
+		Target: "ledger_entry",
+		Unique: false,
+		Query: func(client *ent.Client, id uuid.UUID) QueryHandle {
+			return &ledgerentryQueryHandle{q: client.JournalEntry.Query().Where(journalentry.ID(id)).QueryLedgerEntries()}
+		},
+	},
+}
+
+// Edges returns the JournalEntry edge traversals.
+func (d *journalentryDispatcher) Edges() map[string]EdgeTraversal {
+	return journalentryEdges
+}
+
+// journalentryOrderColumns maps each orderable JournalEntry field to its Ent
+// column. JSON fields have no meaningful order and edge FKs no column of
+// their own, so both are left out.
+var journalentryOrderColumns = map[string]string{
+	"id":                     journalentry.FieldID,
+	"entry_date":             journalentry.FieldEntryDate,
+	"posted_date":            journalentry.FieldPostedDate,
+	"description":            journalentry.FieldDescription,
+	"source_type":            journalentry.FieldSourceType,
+	"source_id":              journalentry.FieldSourceID,
+	"status":                 journalentry.FieldStatus,
+	"approved_by":            journalentry.FieldApprovedBy,
+	"approved_at":            journalentry.FieldApprovedAt,
+	"batch_id":               journalentry.FieldBatchID,
+	"entity_id":              journalentry.FieldEntityID,
+	"property_id":            journalentry.FieldPropertyID,
+	"reverses_journal_id":    journalentry.FieldReversesJournalID,
+	"reversed_by_journal_id": journalentry.FieldReversedByJournalID,
+}
+
+// OrderBy sorts by an orderable column. The planner has already validated the
+// field; anything else is passed through so Ent rejects it when the query runs.
 func (h *journalentryQueryHandle) OrderBy(field string, desc bool) QueryHandle {
-	var opts []sql.OrderTermOption
+	column, ok := journalentryOrderColumns[field]
+	if !ok {
+		column = field
+	}
+	order := ent.Asc
 	if desc {
-		opts = append(opts, sql.OrderDesc())
+		order = ent.Desc
 	}
-	h.q = h.q.Order(journalentry.OrderOption(sql.OrderByField(field, opts...).ToFunc()))
+	h.q = h.q.Order(order(column))
+	return h
+}
+
+func (h *journalentryQueryHandle) ShowSensitive() QueryHandle {
+	h.showSensitive = true
 	return h
 }
 
@@ -542,7 +1346,13 @@ func (h *journalentryQueryHandle) All(ctx context.Context) ([]any, error) {
 	}
 	out := make([]any, len(results))
 	for i, r := range results {
-		out[i] = r
+		if h.showSensitive {
+			out[i] = r
+			continue
+		}
+		if out[i], err = redactSensitive("journal_entry", r); err != nil {
+			return nil, err
+		}
 	}
 	return out, nil
 }
@@ -551,6 +1361,25 @@ func (h *journalentryQueryHandle) Count(ctx context.Context) (int, error) {
 	return h.q.Count(ctx)
 }
 
+// journalentryAggregatable lists the JournalEntry columns valid for sum and avg.
+var journalentryAggregatable = map[string]bool{}
+
+func (d *journalentryDispatcher) Aggregate(ctx context.Context, client *ent.Client, fn AggregateFunc, column string, specs ...planner.PredicateSpec) (float64, error) {
+	h := d.Query(client).Where(specs...).(*journalentryQueryHandle)
+	if fn == AggregateCount {
+		n, err := h.q.Count(ctx)
+		return float64(n), err
+	}
+	if !journalentryAggregatable[column] {
+		return 0, fmt.Errorf("cannot %s '%s': not a numeric field of journal_entry", fn, column)
+	}
+	agg, err := aggregateColumn(fn, column)
+	if err != nil {
+		return 0, err
+	}
+	return h.q.Aggregate(agg).Float64(ctx)
+}
+
 func (d *journalentryDispatcher) Create(ctx context.Context, client *ent.Client, fields map[string]any) (any, error) {
 	builder := client.JournalEntry.Create()
 	m := builder.Mutation()
@@ -590,17 +1419,73 @@ func (d *jurisdictionDispatcher) Get(ctx context.Context, client *ent.Client, id
 }
 
 type jurisdictionQueryHandle struct {
-	q *ent.JurisdictionQuery
+	q             *ent.JurisdictionQuery
+	showSensitive bool
 }
 
 func (h *jurisdictionQueryHandle) Where(specs ...planner.PredicateSpec) QueryHandle {
 	for _, spec := range specs {
+		if p, ok := jurisdictionEnumPredicate(spec); ok {
+			h.q = h.q.Where(p)
+			continue
+		}
 		p := buildSQLPredicate(spec)
 		h.q = h.q.Where(predicate.Jurisdiction(p))
 	}
 	return h
 }
 
+// jurisdictionEnumPredicate builds typed Ent predicates for enum fields. It
+// reports false for other fields, other operators and non-string values,
+// which fall back to buildSQLPredicate.
+func jurisdictionEnumPredicate(spec planner.PredicateSpec) (predicate.Jurisdiction, bool) {
+	switch spec.Field {
+	case "jurisdiction_type":
+		switch spec.Op {
+		case planner.OpEQ:
+			if v, ok := spec.Value.(string); ok {
+				return jurisdiction.JurisdictionTypeEQ(jurisdiction.JurisdictionType(v)), true
+			}
+		case planner.OpNEQ:
+			if v, ok := spec.Value.(string); ok {
+				return jurisdiction.JurisdictionTypeNEQ(jurisdiction.JurisdictionType(v)), true
+			}
+		case planner.OpIn:
+			vs := make([]jurisdiction.JurisdictionType, 0, len(spec.Values))
+			for _, v := range spec.Values {
+				s, ok := v.(string)
+				if !ok {
+					return nil, false
+				}
+				vs = append(vs, jurisdiction.JurisdictionType(s))
+			}
+			return jurisdiction.JurisdictionTypeIn(vs...), true
+		}
+	case "status":
+		switch spec.Op {
+		case planner.OpEQ:
+			if v, ok := spec.Value.(string); ok {
+				return jurisdiction.StatusEQ(jurisdiction.Status(v)), true
+			}
+		case planner.OpNEQ:
+			if v, ok := spec.Value.(string); ok {
+				return jurisdiction.StatusNEQ(jurisdiction.Status(v)), true
+			}
+		case planner.OpIn:
+			vs := make([]jurisdiction.Status, 0, len(spec.Values))
+			for _, v := range spec.Values {
+				s, ok := v.(string)
+				if !ok {
+					return nil, false
+				}
+				vs = append(vs, jurisdiction.Status(s))
+			}
+			return jurisdiction.StatusIn(vs...), true
+		}
+	}
+	return nil, false
+}
+
 func (h *jurisdictionQueryHandle) WithEdge(name string) QueryHandle {
 	switch name {
 	case "children":
@@ -615,12 +1500,79 @@ func (h *jurisdictionQueryHandle) WithEdge(name string) QueryHandle {
 	return h
 }
 
+// jurisdictionEdges maps each Jurisdiction edge to a traversal returning the
+// related entities' query handle.
+var jurisdictionEdges = map[string]EdgeTraversal{
+	"children": {
+		Target: "jurisdiction",
+		Unique: false,
+		Query: func(client *ent.Client, id uuid.UUID) QueryHandle {
+			return &jurisdictionQueryHandle{q: client.Jurisdiction.Query().Where(jurisdiction.ID(id)).QueryChildren()}
+		},
+	},
+	"parent_jurisdiction": {
+		Target: "jurisdiction",
+		Unique: true,
+		Query: func(client *ent.Client, id uuid.UUID) QueryHandle {
+			return &jurisdictionQueryHandle{q: client.Jurisdiction.Query().Where(jurisdiction.ID(id)).QueryParentJurisdiction().Limit(1)}
+		},
+	},
+	"rules": {
+		Target: "jurisdiction_rule",
+		Unique: false,
+		Query: func(client *ent.Client, id uuid.UUID) QueryHandle {
+			return &jurisdictionruleQueryHandle{q: client.Jurisdiction.Query().Where(jurisdiction.ID(id)).QueryRules()}
+		},
+	},
+	"property_jurisdictions": {
+		Target: "property_jurisdiction",
+		Unique: false,
+		Query: func(client *ent.Client, id uuid.UUID) QueryHandle {
+			return &propertyjurisdictionQueryHandle{q: client.Jurisdiction.Query().Where(jurisdiction.ID(id)).QueryPropertyJurisdictions()}
+		},
+	},
+}
+
+// Edges returns the Jurisdiction edge traversals.
+func (d *jurisdictionDispatcher) Edges() map[string]EdgeTraversal {
+	return jurisdictionEdges
+}
+
+// jurisdictionOrderColumns maps each orderable Jurisdiction field to its Ent
+// column. JSON fields have no meaningful order and edge FKs no column of
+// their own, so both are left out.
+var jurisdictionOrderColumns = map[string]string{
+	"id":                        jurisdiction.FieldID,
+	"name":                      jurisdiction.FieldName,
+	"jurisdiction_type":         jurisdiction.FieldJurisdictionType,
+	"fips_code":                 jurisdiction.FieldFipsCode,
+	"state_code":                jurisdiction.FieldStateCode,
+	"country_code":              jurisdiction.FieldCountryCode,
+	"status":                    jurisdiction.FieldStatus,
+	"successor_jurisdiction_id": jurisdiction.FieldSuccessorJurisdictionID,
+	"effective_date":            jurisdiction.FieldEffectiveDate,
+	"dissolution_date":          jurisdiction.FieldDissolutionDate,
+	"governing_body":            jurisdiction.FieldGoverningBody,
+	"regulatory_url":            jurisdiction.FieldRegulatoryURL,
+}
+
+// OrderBy sorts by an orderable column. The planner has already validated the
+// field; anything else is passed through so Ent rejects it when the query runs.
 func (h *jurisdictionQueryHandle) OrderBy(field string, desc bool) QueryHandle {
-	var opts []sql.OrderTermOption
+	column, ok := jurisdictionOrderColumns[field]
+	if !ok {
+		column = field
+	}
+	order := ent.Asc
 	if desc {
-		opts = append(opts, sql.OrderDesc())
+		order = ent.Desc
 	}
-	h.q = h.q.Order(jurisdiction.OrderOption(sql.OrderByField(field, opts...).ToFunc()))
+	h.q = h.q.Order(order(column))
+	return h
+}
+
+func (h *jurisdictionQueryHandle) ShowSensitive() QueryHandle {
+	h.showSensitive = true
 	return h
 }
 
@@ -641,7 +1593,13 @@ func (h *jurisdictionQueryHandle) All(ctx context.Context) ([]any, error) {
 	}
 	out := make([]any, len(results))
 	for i, r := range results {
-		out[i] = r
+		if h.showSensitive {
+			out[i] = r
+			continue
+		}
+		if out[i], err = redactSensitive("jurisdiction", r); err != nil {
+			return nil, err
+		}
 	}
 	return out, nil
 }
@@ -650,6 +1608,25 @@ func (h *jurisdictionQueryHandle) Count(ctx context.Context) (int, error) {
 	return h.q.Count(ctx)
 }
 
+// jurisdictionAggregatable lists the Jurisdiction columns valid for sum and avg.
+var jurisdictionAggregatable = map[string]bool{}
+
+func (d *jurisdictionDispatcher) Aggregate(ctx context.Context, client *ent.Client, fn AggregateFunc, column string, specs ...planner.PredicateSpec) (float64, error) {
+	h := d.Query(client).Where(specs...).(*jurisdictionQueryHandle)
+	if fn == AggregateCount {
+		n, err := h.q.Count(ctx)
+		return float64(n), err
+	}
+	if !jurisdictionAggregatable[column] {
+		return 0, fmt.Errorf("cannot %s '%s': not a numeric field of jurisdiction", fn, column)
+	}
+	agg, err := aggregateColumn(fn, column)
+	if err != nil {
+		return 0, err
+	}
+	return h.q.Aggregate(agg).Float64(ctx)
+}
+
 func (d *jurisdictionDispatcher) Create(ctx context.Context, client *ent.Client, fields map[string]any) (any, error) {
 	builder := client.Jurisdiction.Create()
 	m := builder.Mutation()
@@ -689,17 +1666,73 @@ func (d *jurisdictionruleDispatcher) Get(ctx context.Context, client *ent.Client
 }
 
 type jurisdictionruleQueryHandle struct {
-	q *ent.JurisdictionRuleQuery
+	q             *ent.JurisdictionRuleQuery
+	showSensitive bool
 }
 
 func (h *jurisdictionruleQueryHandle) Where(specs ...planner.PredicateSpec) QueryHandle {
 	for _, spec := range specs {
+		if p, ok := jurisdictionruleEnumPredicate(spec); ok {
+			h.q = h.q.Where(p)
+			continue
+		}
 		p := buildSQLPredicate(spec)
 		h.q = h.q.Where(predicate.JurisdictionRule(p))
 	}
 	return h
 }
 
+// jurisdictionruleEnumPredicate builds typed Ent predicates for enum fields. It
+// reports false for other fields, other operators and non-string values,
+// which fall back to buildSQLPredicate.
+func jurisdictionruleEnumPredicate(spec planner.PredicateSpec) (predicate.JurisdictionRule, bool) {
+	switch spec.Field {
+	case "rule_type":
+		switch spec.Op {
+		case planner.OpEQ:
+			if v, ok := spec.Value.(string); ok {
+				return jurisdictionrule.RuleTypeEQ(jurisdictionrule.RuleType(v)), true
+			}
+		case planner.OpNEQ:
+			if v, ok := spec.Value.(string); ok {
+				return jurisdictionrule.RuleTypeNEQ(jurisdictionrule.RuleType(v)), true
+			}
+		case planner.OpIn:
+			vs := make([]jurisdictionrule.RuleType, 0, len(spec.Values))
+			for _, v := range spec.Values {
+				s, ok := v.(string)
+				if !ok {
+					return nil, false
+				}
+				vs = append(vs, jurisdictionrule.RuleType(s))
+			}
+			return jurisdictionrule.RuleTypeIn(vs...), true
+		}
+	case "status":
+		switch spec.Op {
+		case planner.OpEQ:
+			if v, ok := spec.Value.(string); ok {
+				return jurisdictionrule.StatusEQ(jurisdictionrule.Status(v)), true
+			}
+		case planner.OpNEQ:
+			if v, ok := spec.Value.(string); ok {
+				return jurisdictionrule.StatusNEQ(jurisdictionrule.Status(v)), true
+			}
+		case planner.OpIn:
+			vs := make([]jurisdictionrule.Status, 0, len(spec.Values))
+			for _, v := range spec.Values {
+				s, ok := v.(string)
+				if !ok {
+					return nil, false
+				}
+				vs = append(vs, jurisdictionrule.Status(s))
+			}
+			return jurisdictionrule.StatusIn(vs...), true
+		}
+	}
+	return nil, false
+}
+
 func (h *jurisdictionruleQueryHandle) WithEdge(name string) QueryHandle {
 	switch name {
 	case "jurisdiction":
@@ -712,12 +1745,71 @@ func (h *jurisdictionruleQueryHandle) WithEdge(name string) QueryHandle {
 	return h
 }
 
+// jurisdictionruleEdges maps each JurisdictionRule edge to a traversal returning the
+// related entities' query handle.
+var jurisdictionruleEdges = map[string]EdgeTraversal{
+	"jurisdiction": {
+		Target: "jurisdiction",
+		Unique: true,
+		Query: func(client *ent.Client, id uuid.UUID) QueryHandle {
+			return &jurisdictionQueryHandle{q: client.JurisdictionRule.Query().Where(jurisdictionrule.ID(id)).QueryJurisdiction().Limit(1)}
+		},
+	},
+	"superseded_by": {
+		Target: "jurisdiction_rule",
+		Unique: true,
+		Query: func(client *ent.Client, id uuid.UUID) QueryHandle {
+			return &jurisdictionruleQueryHandle{q: client.JurisdictionRule.Query().Where(jurisdictionrule.ID(id)).QuerySupersededBy().Limit(1)}
+		},
+	},
+	"supersedes": {
+		Target: "jurisdiction_rule",
+		Unique: true,
+		Query: func(client *ent.Client, id uuid.UUID) QueryHandle {
+			return &jurisdictionruleQueryHandle{q: client.JurisdictionRule.Query().Where(jurisdictionrule.ID(id)).QuerySupersedes().Limit(1)}
+		},
+	},
+}
+
+// Edges returns the JurisdictionRule edge traversals.
+func (d *jurisdictionruleDispatcher) Edges() map[string]EdgeTraversal {
+	return jurisdictionruleEdges
+}
+
+// jurisdictionruleOrderColumns maps each orderable JurisdictionRule field to its Ent
+// column. JSON fields have no meaningful order and edge FKs no column of
+// their own, so both are left out.
+var jurisdictionruleOrderColumns = map[string]string{
+	"id":                  jurisdictionrule.FieldID,
+	"rule_type":           jurisdictionrule.FieldRuleType,
+	"status":              jurisdictionrule.FieldStatus,
+	"statute_reference":   jurisdictionrule.FieldStatuteReference,
+	"ordinance_number":    jurisdictionrule.FieldOrdinanceNumber,
+	"statute_url":         jurisdictionrule.FieldStatuteURL,
+	"effective_date":      jurisdictionrule.FieldEffectiveDate,
+	"expiration_date":     jurisdictionrule.FieldExpirationDate,
+	"last_verified":       jurisdictionrule.FieldLastVerified,
+	"verified_by":         jurisdictionrule.FieldVerifiedBy,
+	"verification_source": jurisdictionrule.FieldVerificationSource,
+}
+
+// OrderBy sorts by an orderable column. The planner has already validated the
+// field; anything else is passed through so Ent rejects it when the query runs.
 func (h *jurisdictionruleQueryHandle) OrderBy(field string, desc bool) QueryHandle {
-	var opts []sql.OrderTermOption
+	column, ok := jurisdictionruleOrderColumns[field]
+	if !ok {
+		column = field
+	}
+	order := ent.Asc
 	if desc {
-		opts = append(opts, sql.OrderDesc())
+		order = ent.Desc
 	}
-	h.q = h.q.Order(jurisdictionrule.OrderOption(sql.OrderByField(field, opts...).ToFunc()))
+	h.q = h.q.Order(order(column))
+	return h
+}
+
+func (h *jurisdictionruleQueryHandle) ShowSensitive() QueryHandle {
+	h.showSensitive = true
 	return h
 }
 
@@ -738,7 +1830,13 @@ func (h *jurisdictionruleQueryHandle) All(ctx context.Context) ([]any, error) {
 	}
 	out := make([]any, len(results))
 	for i, r := range results {
-		out[i] = r
+		if h.showSensitive {
+			out[i] = r
+			continue
+		}
+		if out[i], err = redactSensitive("jurisdiction_rule", r); err != nil {
+			return nil, err
+		}
 	}
 	return out, nil
 }
@@ -747,6 +1845,25 @@ func (h *jurisdictionruleQueryHandle) Count(ctx context.Context) (int, error) {
 	return h.q.Count(ctx)
 }
 
+// jurisdictionruleAggregatable lists the JurisdictionRule columns valid for sum and avg.
+var jurisdictionruleAggregatable = map[string]bool{}
+
+func (d *jurisdictionruleDispatcher) Aggregate(ctx context.Context, client *ent.Client, fn AggregateFunc, column string, specs ...planner.PredicateSpec) (float64, error) {
+	h := d.Query(client).Where(specs...).(*jurisdictionruleQueryHandle)
+	if fn == AggregateCount {
+		n, err := h.q.Count(ctx)
+		return float64(n), err
+	}
+	if !jurisdictionruleAggregatable[column] {
+		return 0, fmt.Errorf("cannot %s '%s': not a numeric field of jurisdiction_rule", fn, column)
+	}
+	agg, err := aggregateColumn(fn, column)
+	if err != nil {
+		return 0, err
+	}
+	return h.q.Aggregate(agg).Float64(ctx)
+}
+
 func (d *jurisdictionruleDispatcher) Create(ctx context.Context, client *ent.Client, fields map[string]any) (any, error) {
 	builder := client.JurisdictionRule.Create()
 	m := builder.Mutation()
@@ -786,17 +1903,157 @@ func (d *leaseDispatcher) Get(ctx context.Context, client *ent.Client, id uuid.U
 }
 
 type leaseQueryHandle struct {
-	q *ent.LeaseQuery
+	q             *ent.LeaseQuery
+	showSensitive bool
 }
 
 func (h *leaseQueryHandle) Where(specs ...planner.PredicateSpec) QueryHandle {
 	for _, spec := range specs {
+		if p, ok := leaseEnumPredicate(spec); ok {
+			h.q = h.q.Where(p)
+			continue
+		}
 		p := buildSQLPredicate(spec)
 		h.q = h.q.Where(predicate.Lease(p))
 	}
 	return h
 }
 
+// leaseEnumPredicate builds typed Ent predicates for enum fields. It
+// reports false for other fields, other operators and non-string values,
+// which fall back to buildSQLPredicate.
+func leaseEnumPredicate(spec planner.PredicateSpec) (predicate.Lease, bool) {
+	switch spec.Field {
+	case "lease_type":
+		switch spec.Op {
+		case planner.OpEQ:
+			if v, ok := spec.Value.(string); ok {
+				return lease.LeaseTypeEQ(lease.LeaseType(v)), true
+			}
+		case planner.OpNEQ:
+			if v, ok := spec.Value.(string); ok {
+				return lease.LeaseTypeNEQ(lease.LeaseType(v)), true
+			}
+		case planner.OpIn:
+			vs := make([]lease.LeaseType, 0, len(spec.Values))
+			for _, v := range spec.Values {
+				s, ok := v.(string)
+				if !ok {
+					return nil, false
+				}
+				vs = append(vs, lease.LeaseType(s))
+			}
+			return lease.LeaseTypeIn(vs...), true
+		}
+	case "status":
+		switch spec.Op {
+		case planner.OpEQ:
+			if v, ok := spec.Value.(string); ok {
+				return lease.StatusEQ(lease.Status(v)), true
+			}
+		case planner.OpNEQ:
+			if v, ok := spec.Value.(string); ok {
+				return lease.StatusNEQ(lease.Status(v)), true
+			}
+		case planner.OpIn:
+			vs := make([]lease.Status, 0, len(spec.Values))
+			for _, v := range spec.Values {
+				s, ok := v.(string)
+				if !ok {
+					return nil, false
+				}
+				vs = append(vs, lease.Status(s))
+			}
+			return lease.StatusIn(vs...), true
+		}
+	case "liability_type":
+		switch spec.Op {
+		case planner.OpEQ:
+			if v, ok := spec.Value.(string); ok {
+				return lease.LiabilityTypeEQ(lease.LiabilityType(v)), true
+			}
+		case planner.OpNEQ:
+			if v, ok := spec.Value.(string); ok {
+				return lease.LiabilityTypeNEQ(lease.LiabilityType(v)), true
+			}
+		case planner.OpIn:
+			vs := make([]lease.LiabilityType, 0, len(spec.Values))
+			for _, v := range spec.Values {
+				s, ok := v.(string)
+				if !ok {
+					return nil, false
+				}
+				vs = append(vs, lease.LiabilityType(s))
+			}
+			return lease.LiabilityTypeIn(vs...), true
+		}
+	case "membership_tier":
+		switch spec.Op {
+		case planner.OpEQ:
+			if v, ok := spec.Value.(string); ok {
+				return lease.MembershipTierEQ(lease.MembershipTier(v)), true
+			}
+		case planner.OpNEQ:
+			if v, ok := spec.Value.(string); ok {
+				return lease.MembershipTierNEQ(lease.MembershipTier(v)), true
+			}
+		case planner.OpIn:
+			vs := make([]lease.MembershipTier, 0, len(spec.Values))
+			for _, v := range spec.Values {
+				s, ok := v.(string)
+				if !ok {
+					return nil, false
+				}
+				vs = append(vs, lease.MembershipTier(s))
+			}
+			return lease.MembershipTierIn(vs...), true
+		}
+	case "sublease_billing":
+		switch spec.Op {
+		case planner.OpEQ:
+			if v, ok := spec.Value.(string); ok {
+				return lease.SubleaseBillingEQ(lease.SubleaseBilling(v)), true
+			}
+		case planner.OpNEQ:
+			if v, ok := spec.Value.(string); ok {
+				return lease.SubleaseBillingNEQ(lease.SubleaseBilling(v)), true
+			}
+		case planner.OpIn:
+			vs := make([]lease.SubleaseBilling, 0, len(spec.Values))
+			for _, v := range spec.Values {
+				s, ok := v.(string)
+				if !ok {
+					return nil, false
+				}
+				vs = append(vs, lease.SubleaseBilling(s))
+			}
+			return lease.SubleaseBillingIn(vs...), true
+		}
+	case "signing_method":
+		switch spec.Op {
+		case planner.OpEQ:
+			if v, ok := spec.Value.(string); ok {
+				return lease.SigningMethodEQ(lease.SigningMethod(v)), true
+			}
+		case planner.OpNEQ:
+			if v, ok := spec.Value.(string); ok {
+				return lease.SigningMethodNEQ(lease.SigningMethod(v)), true
+			}
+		case planner.OpIn:
+			vs := make([]lease.SigningMethod, 0, len(spec.Values))
+			for _, v := range spec.Values {
+				s, ok := v.(string)
+				if !ok {
+					return nil, false
+				}
+				vs = append(vs, lease.SigningMethod(s))
+			}
+			return lease.SigningMethodIn(vs...), true
+		}
+	}
+	return nil, false
+}
+
 func (h *leaseQueryHandle) WithEdge(name string) QueryHandle {
 	switch name {
 	case "lease_spaces":
@@ -817,12 +2074,115 @@ func (h *leaseQueryHandle) WithEdge(name string) QueryHandle {
 	return h
 }
 
+// leaseEdges maps each Lease edge to a traversal returning the
+// related entities' query handle.
+var leaseEdges = map[string]EdgeTraversal{
+	"lease_spaces": {
+		Target: "lease_space",
+		Unique: false,
+		Query: func(client *ent.Client, id uuid.UUID) QueryHandle {
+			return &leasespaceQueryHandle{q: client.Lease.Query().Where(lease.ID(id)).QueryLeaseSpaces()}
+		},
+	},
+	"tenant_roles": {
+		Target: "person_role",
+		Unique: false,
+		Query: func(client *ent.Client, id uuid.UUID) QueryHandle {
+			return &personroleQueryHandle{q: client.Lease.Query().Where(lease.ID(id)).QueryTenantRoles()}
+		},
+	},
+	"guarantor_roles": {
+		Target: "person_role",
+		Unique: false,
+		Query: func(client *ent.Client, id uuid.UUID) QueryHandle {
+			return &personroleQueryHandle{q: client.Lease.Query().Where(lease.ID(id)).QueryGuarantorRoles()}
+		},
+	},
+	"ledger_entries": {
+		Target: "ledger_entry",
+		Unique: false,
+		Query: func(client *ent.Client, id uuid.UUID) QueryHandle {
+			return &ledgerentryQueryHandle{q: client.Lease.Query().Where(lease.ID(id)).QueryLedgerEntries()}
+		},
+	},
+	"application": {
+		Target: "application",
+		Unique: true,
+		Query: func(client *ent.Client, id uuid.UUID) QueryHandle {
+			return &applicationQueryHandle{q: client.Lease.Query().Where(lease.ID(id)).QueryApplication().Limit(1)}
+		},
+	},
+	"subleases": {
+		Target: "lease",
+		Unique: false,
+		Query: func(client *ent.Client, id uuid.UUID) QueryHandle {
+			return &leaseQueryHandle{q: client.Lease.Query().Where(lease.ID(id)).QuerySubleases()}
+		},
+	},
+	"parent_lease": {
+		Target: "lease",
+		Unique: true,
+		Query: func(client *ent.Client, id uuid.UUID) QueryHandle {
+			return &leaseQueryHandle{q: client.Lease.Query().Where(lease.ID(id)).QueryParentLease().Limit(1)}
+		},
+	},
+}
+
+// Edges returns the Lease edge traversals.
+func (d *leaseDispatcher) Edges() map[string]EdgeTraversal {
+	return leaseEdges
+}
+
+// leaseOrderColumns maps each orderable Lease field to its Ent
+// column. JSON fields have no meaningful order and edge FKs no column of
+// their own, so both are left out.
+var leaseOrderColumns = map[string]string{
+	"id":                            lease.FieldID,
+	"property_id":                   lease.FieldPropertyID,
+	"lease_type":                    lease.FieldLeaseType,
+	"status":                        lease.FieldStatus,
+	"description":                   lease.FieldDescription,
+	"liability_type":                lease.FieldLiabilityType,
+	"lease_commencement_date":       lease.FieldLeaseCommencementDate,
+	"rent_commencement_date":        lease.FieldRentCommencementDate,
+	"base_rent_amount_cents":        lease.FieldBaseRentAmountCents,
+	"base_rent_currency":            lease.FieldBaseRentCurrency,
+	"security_deposit_amount_cents": lease.FieldSecurityDepositAmountCents,
+	"security_deposit_currency":     lease.FieldSecurityDepositCurrency,
+	"move_in_date":                  lease.FieldMoveInDate,
+	"move_out_date":                 lease.FieldMoveOutDate,
+	"notice_date":                   lease.FieldNoticeDate,
+	"notice_required_days":          lease.FieldNoticeRequiredDays,
+	"check_in_time":                 lease.FieldCheckInTime,
+	"check_out_time":                lease.FieldCheckOutTime,
+	"cleaning_fee_amount_cents":     lease.FieldCleaningFeeAmountCents,
+	"cleaning_fee_currency":         lease.FieldCleaningFeeCurrency,
+	"platform_booking_id":           lease.FieldPlatformBookingID,
+	"membership_tier":               lease.FieldMembershipTier,
+	"is_sublease":                   lease.FieldIsSublease,
+	"sublease_billing":              lease.FieldSubleaseBilling,
+	"signing_method":                lease.FieldSigningMethod,
+	"signed_at":                     lease.FieldSignedAt,
+	"document_id":                   lease.FieldDocumentID,
+}
+
+// OrderBy sorts by an orderable column. The planner has already validated the
+// field; anything else is passed through so Ent rejects it when the query runs.
 func (h *leaseQueryHandle) OrderBy(field string, desc bool) QueryHandle {
-	var opts []sql.OrderTermOption
+	column, ok := leaseOrderColumns[field]
+	if !ok {
+		column = field
+	}
+	order := ent.Asc
 	if desc {
-		opts = append(opts, sql.OrderDesc())
+		order = ent.Desc
 	}
-	h.q = h.q.Order(lease.OrderOption(sql.OrderByField(field, opts...).ToFunc()))
+	h.q = h.q.Order(order(column))
+	return h
+}
+
+func (h *leaseQueryHandle) ShowSensitive() QueryHandle {
+	h.showSensitive = true
 	return h
 }
 
@@ -843,7 +2203,13 @@ func (h *leaseQueryHandle) All(ctx context.Context) ([]any, error) {
 	}
 	out := make([]any, len(results))
 	for i, r := range results {
-		out[i] = r
+		if h.showSensitive {
+			out[i] = r
+			continue
+		}
+		if out[i], err = redactSensitive("lease", r); err != nil {
+			return nil, err
+		}
 	}
 	return out, nil
 }
@@ -852,6 +2218,30 @@ func (h *leaseQueryHandle) Count(ctx context.Context) (int, error) {
 	return h.q.Count(ctx)
 }
 
+// leaseAggregatable lists the Lease columns valid for sum and avg.
+var leaseAggregatable = map[string]bool{
+	"base_rent_amount_cents":        true,
+	"security_deposit_amount_cents": true,
+	"notice_required_days":          true,
+	"cleaning_fee_amount_cents":     true,
+}
+
+func (d *leaseDispatcher) Aggregate(ctx context.Context, client *ent.Client, fn AggregateFunc, column string, specs ...planner.PredicateSpec) (float64, error) {
+	h := d.Query(client).Where(specs...).(*leaseQueryHandle)
+	if fn == AggregateCount {
+		n, err := h.q.Count(ctx)
+		return float64(n), err
+	}
+	if !leaseAggregatable[column] {
+		return 0, fmt.Errorf("cannot %s '%s': not a numeric field of lease", fn, column)
+	}
+	agg, err := aggregateColumn(fn, column)
+	if err != nil {
+		return 0, err
+	}
+	return h.q.Aggregate(agg).Float64(ctx)
+}
+
 func (d *leaseDispatcher) Create(ctx context.Context, client *ent.Client, fields map[string]any) (any, error) {
 	builder := client.Lease.Create()
 	m := builder.Mutation()
@@ -891,17 +2281,52 @@ func (d *leasespaceDispatcher) Get(ctx context.Context, client *ent.Client, id u
 }
 
 type leasespaceQueryHandle struct {
-	q *ent.LeaseSpaceQuery
+	q             *ent.LeaseSpaceQuery
+	showSensitive bool
 }
 
 func (h *leasespaceQueryHandle) Where(specs ...planner.PredicateSpec) QueryHandle {
 	for _, spec := range specs {
+		if p, ok := leasespaceEnumPredicate(spec); ok {
+			h.q = h.q.Where(p)
+			continue
+		}
 		p := buildSQLPredicate(spec)
 		h.q = h.q.Where(predicate.LeaseSpace(p))
 	}
 	return h
 }
 
+// leasespaceEnumPredicate builds typed Ent predicates for enum fields. It
+// reports false for other fields, other operators and non-string values,
+// which fall back to buildSQLPredicate.
+func leasespaceEnumPredicate(spec planner.PredicateSpec) (predicate.LeaseSpace, bool) {
+	switch spec.Field {
+	case "relationship":
+		switch spec.Op {
+		case planner.OpEQ:
+			if v, ok := spec.Value.(string); ok {
+				return leasespace.RelationshipEQ(leasespace.Relationship(v)), true
+			}
+		case planner.OpNEQ:
+			if v, ok := spec.Value.(string); ok {
+				return leasespace.RelationshipNEQ(leasespace.Relationship(v)), true
+			}
+		case planner.OpIn:
+			vs := make([]leasespace.Relationship, 0, len(spec.Values))
+			for _, v := range spec.Values {
+				s, ok := v.(string)
+				if !ok {
+					return nil, false
+				}
+				vs = append(vs, leasespace.Relationship(s))
+			}
+			return leasespace.RelationshipIn(vs...), true
+		}
+	}
+	return nil, false
+}
+
 func (h *leasespaceQueryHandle) WithEdge(name string) QueryHandle {
 	switch name {
 	case "lease":
@@ -912,12 +2337,57 @@ func (h *leasespaceQueryHandle) WithEdge(name string) QueryHandle {
 	return h
 }
 
+// leasespaceEdges maps each LeaseSpace edge to a traversal returning the
+// related entities' query handle.
+var leasespaceEdges = map[string]EdgeTraversal{
+	"lease": {
+		Target: "lease",
+		Unique: true,
+		Query: func(client *ent.Client, id uuid.UUID) QueryHandle {
+			return &leaseQueryHandle{q: client.LeaseSpace.Query().Where(leasespace.ID(id)).QueryLease().Limit(1)}
+		},
+	},
+	"space": {
+		Target: "space",
+		Unique: true,
+		Query: func(client *ent.Client, id uuid.UUID) QueryHandle {
+			return &spaceQueryHandle{q: client.LeaseSpace.Query().Where(leasespace.ID(id)).QuerySpace().Limit(1)}
+		},
+	},
+}
+
+// Edges returns the LeaseSpace edge traversals.
+func (d *leasespaceDispatcher) Edges() map[string]EdgeTraversal {
+	return leasespaceEdges
+}
+
+// leasespaceOrderColumns maps each orderable LeaseSpace field to its Ent
+// column. JSON fields have no meaningful order and edge FKs no column of
+// their own, so both are left out.
+var leasespaceOrderColumns = map[string]string{
+	"id":                    leasespace.FieldID,
+	"is_primary":            leasespace.FieldIsPrimary,
+	"relationship":          leasespace.FieldRelationship,
+	"square_footage_leased": leasespace.FieldSquareFootageLeased,
+}
+
+// OrderBy sorts by an orderable column. The planner has already validated the
+// field; anything else is passed through so Ent rejects it when the query runs.
 func (h *leasespaceQueryHandle) OrderBy(field string, desc bool) QueryHandle {
-	var opts []sql.OrderTermOption
+	column, ok := leasespaceOrderColumns[field]
+	if !ok {
+		column = field
+	}
+	order := ent.Asc
 	if desc {
-		opts = append(opts, sql.OrderDesc())
+		order = ent.Desc
 	}
-	h.q = h.q.Order(leasespace.OrderOption(sql.OrderByField(field, opts...).ToFunc()))
+	h.q = h.q.Order(order(column))
+	return h
+}
+
+func (h *leasespaceQueryHandle) ShowSensitive() QueryHandle {
+	h.showSensitive = true
 	return h
 }
 
@@ -938,7 +2408,13 @@ func (h *leasespaceQueryHandle) All(ctx context.Context) ([]any, error) {
 	}
 	out := make([]any, len(results))
 	for i, r := range results {
-		out[i] = r
+		if h.showSensitive {
+			out[i] = r
+			continue
+		}
+		if out[i], err = redactSensitive("lease_space", r); err != nil {
+			return nil, err
+		}
 	}
 	return out, nil
 }
@@ -947,6 +2423,27 @@ func (h *leasespaceQueryHandle) Count(ctx context.Context) (int, error) {
 	return h.q.Count(ctx)
 }
 
+// leasespaceAggregatable lists the LeaseSpace columns valid for sum and avg.
+var leasespaceAggregatable = map[string]bool{
+	"square_footage_leased": true,
+}
+
+func (d *leasespaceDispatcher) Aggregate(ctx context.Context, client *ent.Client, fn AggregateFunc, column string, specs ...planner.PredicateSpec) (float64, error) {
+	h := d.Query(client).Where(specs...).(*leasespaceQueryHandle)
+	if fn == AggregateCount {
+		n, err := h.q.Count(ctx)
+		return float64(n), err
+	}
+	if !leasespaceAggregatable[column] {
+		return 0, fmt.Errorf("cannot %s '%s': not a numeric field of lease_space", fn, column)
+	}
+	agg, err := aggregateColumn(fn, column)
+	if err != nil {
+		return 0, err
+	}
+	return h.q.Aggregate(agg).Float64(ctx)
+}
+
 func (d *leasespaceDispatcher) Create(ctx context.Context, client *ent.Client, fields map[string]any) (any, error) {
 	builder := client.LeaseSpace.Create()
 	m := builder.Mutation()
@@ -986,17 +2483,52 @@ func (d *ledgerentryDispatcher) Get(ctx context.Context, client *ent.Client, id
 }
 
 type ledgerentryQueryHandle struct {
-	q *ent.LedgerEntryQuery
+	q             *ent.LedgerEntryQuery
+	showSensitive bool
 }
 
 func (h *ledgerentryQueryHandle) Where(specs ...planner.PredicateSpec) QueryHandle {
 	for _, spec := range specs {
+		if p, ok := ledgerentryEnumPredicate(spec); ok {
+			h.q = h.q.Where(p)
+			continue
+		}
 		p := buildSQLPredicate(spec)
 		h.q = h.q.Where(predicate.LedgerEntry(p))
 	}
 	return h
 }
 
+// ledgerentryEnumPredicate builds typed Ent predicates for enum fields. It
+// reports false for other fields, other operators and non-string values,
+// which fall back to buildSQLPredicate.
+func ledgerentryEnumPredicate(spec planner.PredicateSpec) (predicate.LedgerEntry, bool) {
+	switch spec.Field {
+	case "entry_type":
+		switch spec.Op {
+		case planner.OpEQ:
+			if v, ok := spec.Value.(string); ok {
+				return ledgerentry.EntryTypeEQ(ledgerentry.EntryType(v)), true
+			}
+		case planner.OpNEQ:
+			if v, ok := spec.Value.(string); ok {
+				return ledgerentry.EntryTypeNEQ(ledgerentry.EntryType(v)), true
+			}
+		case planner.OpIn:
+			vs := make([]ledgerentry.EntryType, 0, len(spec.Values))
+			for _, v := range spec.Values {
+				s, ok := v.(string)
+				if !ok {
+					return nil, false
+				}
+				vs = append(vs, ledgerentry.EntryType(s))
+			}
+			return ledgerentry.EntryTypeIn(vs...), true
+		}
+	}
+	return nil, false
+}
+
 func (h *ledgerentryQueryHandle) WithEdge(name string) QueryHandle {
 	switch name {
 	case "lease":
@@ -1015,12 +2547,96 @@ func (h *ledgerentryQueryHandle) WithEdge(name string) QueryHandle {
 	return h
 }
 
+// ledgerentryEdges maps each LedgerEntry edge to a traversal returning the
+// related entities' query handle.
+var ledgerentryEdges = map[string]EdgeTraversal{
+	"lease": {
+		Target: "lease",
+		Unique: true,
+		Query: func(client *ent.Client, id uuid.UUID) QueryHandle {
+			return &leaseQueryHandle{q: client.LedgerEntry.Query().Where(ledgerentry.ID(id)).QueryLease().Limit(1)}
+		},
+	},
+	"journal_entry": {
+		Target: "journal_entry",
+		Unique: true,
+		Query: func(client *ent.Client, id uuid.UUID) QueryHandle {
+			return &journalentryQueryHandle{q: client.LedgerEntry.Query().Where(ledgerentry.ID(id)).QueryJournalEntry().Limit(1)}
+		},
+	},
+	"account": {
+		Target: "account",
+		Unique: true,
+		Query: func(client *ent.Client, id uuid.UUID) QueryHandle {
+			return &accountQueryHandle{q: client.LedgerEntry.Query().Where(ledgerentry.ID(id)).QueryAccount().Limit(1)}
+		},
+	},
+	"property": {
+		Target: "property",
+		Unique: true,
+		Query: func(client *ent.Client, id uuid.UUID) QueryHandle {
+			return &propertyQueryHandle{q: client.LedgerEntry.Query().Where(ledgerentry.ID(id)).QueryProperty().Limit(1)}
+		},
+	},
+	"space": {
+		Target: "space",
+		Unique: true,
+		Query: func(client *ent.Client, id uuid.UUID) QueryHandle {
+			return &spaceQueryHandle{q: client.LedgerEntry.Query().Where(ledgerentry.ID(id)).QuerySpace().Limit(1)}
+		},
+	},
+	"person": {
+		Target: "person",
+		Unique: true,
+		Query: func(client *ent.Client, id uuid.UUID) QueryHandle {
+			return &personQueryHandle{q: client.LedgerEntry.Query().Where(ledgerentry.ID(id)).QueryPerson().Limit(1)}
+		},
+	},
+}
+
+// Edges returns the LedgerEntry edge traversals.
+func (d *ledgerentryDispatcher) Edges() map[string]EdgeTraversal {
+	return ledgerentryEdges
+}
+
+// ledgerentryOrderColumns maps each orderable LedgerEntry field to its Ent
+// column. JSON fields have no meaningful order and edge FKs no column of
+// their own, so both are left out.
+var ledgerentryOrderColumns = map[string]string{
+	"id":                  ledgerentry.FieldID,
+	"entry_type":          ledgerentry.FieldEntryType,
+	"amount_amount_cents": ledgerentry.FieldAmountAmountCents,
+	"amount_currency":     ledgerentry.FieldAmountCurrency,
+	"effective_date":      ledgerentry.FieldEffectiveDate,
+	"posted_date":         ledgerentry.FieldPostedDate,
+	"description":         ledgerentry.FieldDescription,
+	"charge_code":         ledgerentry.FieldChargeCode,
+	"memo":                ledgerentry.FieldMemo,
+	"bank_account_id":     ledgerentry.FieldBankAccountID,
+	"bank_transaction_id": ledgerentry.FieldBankTransactionID,
+	"reconciled":          ledgerentry.FieldReconciled,
+	"reconciliation_id":   ledgerentry.FieldReconciliationID,
+	"reconciled_at":       ledgerentry.FieldReconciledAt,
+	"adjusts_entry_id":    ledgerentry.FieldAdjustsEntryID,
+}
+
+// OrderBy sorts by an orderable column. The planner has already validated the
+// field; anything else is passed through so Ent rejects it when the query runs.
 func (h *ledgerentryQueryHandle) OrderBy(field string, desc bool) QueryHandle {
-	var opts []sql.OrderTermOption
+	column, ok := ledgerentryOrderColumns[field]
+	if !ok {
+		column = field
+	}
+	order := ent.Asc
 	if desc {
-		opts = append(opts, sql.OrderDesc())
+		order = ent.Desc
 	}
-	h.q = h.q.Order(ledgerentry.OrderOption(sql.OrderByField(field, opts...).ToFunc()))
+	h.q = h.q.Order(order(column))
+	return h
+}
+
+func (h *ledgerentryQueryHandle) ShowSensitive() QueryHandle {
+	h.showSensitive = true
 	return h
 }
 
@@ -1041,7 +2657,13 @@ func (h *ledgerentryQueryHandle) All(ctx context.Context) ([]any, error) {
 	}
 	out := make([]any, len(results))
 	for i, r := range results {
-		out[i] = r
+		if h.showSensitive {
+			out[i] = r
+			continue
+		}
+		if out[i], err = redactSensitive("ledger_entry", r); err != nil {
+			return nil, err
+		}
 	}
 	return out, nil
 }
@@ -1050,6 +2672,27 @@ func (h *ledgerentryQueryHandle) Count(ctx context.Context) (int, error) {
 	return h.q.Count(ctx)
 }
 
+// ledgerentryAggregatable lists the LedgerEntry columns valid for sum and avg.
+var ledgerentryAggregatable = map[string]bool{
+	"amount_amount_cents": true,
+}
+
+func (d *ledgerentryDispatcher) Aggregate(ctx context.Context, client *ent.Client, fn AggregateFunc, column string, specs ...planner.PredicateSpec) (float64, error) {
+	h := d.Query(client).Where(specs...).(*ledgerentryQueryHandle)
+	if fn == AggregateCount {
+		n, err := h.q.Count(ctx)
+		return float64(n), err
+	}
+	if !ledgerentryAggregatable[column] {
+		return 0, fmt.Errorf("cannot %s '%s': not a numeric field of ledger_entry", fn, column)
+	}
+	agg, err := aggregateColumn(fn, column)
+	if err != nil {
+		return 0, err
+	}
+	return h.q.Aggregate(agg).Float64(ctx)
+}
+
 func (d *ledgerentryDispatcher) Create(ctx context.Context, client *ent.Client, fields map[string]any) (any, error) {
 	builder := client.LedgerEntry.Create()
 	m := builder.Mutation()
@@ -1089,17 +2732,94 @@ func (d *organizationDispatcher) Get(ctx context.Context, client *ent.Client, id
 }
 
 type organizationQueryHandle struct {
-	q *ent.OrganizationQuery
+	q             *ent.OrganizationQuery
+	showSensitive bool
 }
 
 func (h *organizationQueryHandle) Where(specs ...planner.PredicateSpec) QueryHandle {
 	for _, spec := range specs {
+		if p, ok := organizationEnumPredicate(spec); ok {
+			h.q = h.q.Where(p)
+			continue
+		}
 		p := buildSQLPredicate(spec)
 		h.q = h.q.Where(predicate.Organization(p))
 	}
 	return h
 }
 
+// organizationEnumPredicate builds typed Ent predicates for enum fields. It
+// reports false for other fields, other operators and non-string values,
+// which fall back to buildSQLPredicate.
+func organizationEnumPredicate(spec planner.PredicateSpec) (predicate.Organization, bool) {
+	switch spec.Field {
+	case "org_type":
+		switch spec.Op {
+		case planner.OpEQ:
+			if v, ok := spec.Value.(string); ok {
+				return organization.OrgTypeEQ(organization.OrgType(v)), true
+			}
+		case planner.OpNEQ:
+			if v, ok := spec.Value.(string); ok {
+				return organization.OrgTypeNEQ(organization.OrgType(v)), true
+			}
+		case planner.OpIn:
+			vs := make([]organization.OrgType, 0, len(spec.Values))
+			for _, v := range spec.Values {
+				s, ok := v.(string)
+				if !ok {
+					return nil, false
+				}
+				vs = append(vs, organization.OrgType(s))
+			}
+			return organization.OrgTypeIn(vs...), true
+		}
+	case "tax_id_type":
+		switch spec.Op {
+		case planner.OpEQ:
+			if v, ok := spec.Value.(string); ok {
+				return organization.TaxIDTypeEQ(organization.TaxIDType(v)), true
+			}
+		case planner.OpNEQ:
+			if v, ok := spec.Value.(string); ok {
+				return organization.TaxIDTypeNEQ(organization.TaxIDType(v)), true
+			}
+		case planner.OpIn:
+			vs := make([]organization.TaxIDType, 0, len(spec.Values))
+			for _, v := range spec.Values {
+				s, ok := v.(string)
+				if !ok {
+					return nil, false
+				}
+				vs = append(vs, organization.TaxIDType(s))
+			}
+			return organization.TaxIDTypeIn(vs...), true
+		}
+	case "status":
+		switch spec.Op {
+		case planner.OpEQ:
+			if v, ok := spec.Value.(string); ok {
+				return organization.StatusEQ(organization.Status(v)), true
+			}
+		case planner.OpNEQ:
+			if v, ok := spec.Value.(string); ok {
+				return organization.StatusNEQ(organization.Status(v)), true
+			}
+		case planner.OpIn:
+			vs := make([]organization.Status, 0, len(spec.Values))
+			for _, v := range spec.Values {
+				s, ok := v.(string)
+				if !ok {
+					return nil, false
+				}
+				vs = append(vs, organization.Status(s))
+			}
+			return organization.StatusIn(vs...), true
+		}
+	}
+	return nil, false
+}
+
 func (h *organizationQueryHandle) WithEdge(name string) QueryHandle {
 	switch name {
 	case "owned_portfolios":
@@ -1114,12 +2834,79 @@ func (h *organizationQueryHandle) WithEdge(name string) QueryHandle {
 	return h
 }
 
+// organizationEdges maps each Organization edge to a traversal returning the
+// related entities' query handle.
+var organizationEdges = map[string]EdgeTraversal{
+	"owned_portfolios": {
+		Target: "portfolio",
+		Unique: false,
+		Query: func(client *ent.Client, id uuid.UUID) QueryHandle {
+			return &portfolioQueryHandle{q: client.Organization.Query().Where(organization.ID(id)).QueryOwnedPortfolios()}
+		},
+	},
+	"people": {
+		Target: "person",
+		Unique: false,
+		Query: func(client *ent.Client, id uuid.UUID) QueryHandle {
+			return &personQueryHandle{q: client.Organization.Query().Where(organization.ID(id)).QueryPeople()}
+		},
+	},
+	"subsidiaries": {
+		Target: "organization",
+		Unique: false,
+		Query: func(client *ent.Client, id uuid.UUID) QueryHandle {
+			return &organizationQueryHandle{q: client.Organization.Query().Where(organization.ID(id)).QuerySubsidiaries()}
+		},
+	},
+	"parent_org": {
+		Target: "organization",
+		Unique: true,
+		Query: func(client *ent.Client, id uuid.UUID) QueryHandle {
+			return &organizationQueryHandle{q: client.Organization.Query().Where(organization.ID(id)).QueryParentOrg().Limit(1)}
+		},
+	},
+}
+
+// Edges returns the Organization edge traversals.
+func (d *organizationDispatcher) Edges() map[string]EdgeTraversal {
+	return organizationEdges
+}
+
+// organizationOrderColumns maps each orderable Organization field to its Ent
+// column. JSON fields have no meaningful order and edge FKs no column of
+// their own, so both are left out.
+var organizationOrderColumns = map[string]string{
+	"id":                     organization.FieldID,
+	"legal_name":             organization.FieldLegalName,
+	"dba_name":               organization.FieldDbaName,
+	"org_type":               organization.FieldOrgType,
+	"tax_id":                 organization.FieldTaxID,
+	"tax_id_type":            organization.FieldTaxIDType,
+	"status":                 organization.FieldStatus,
+	"state_of_incorporation": organization.FieldStateOfIncorporation,
+	"formation_date":         organization.FieldFormationDate,
+	"management_license":     organization.FieldManagementLicense,
+	"license_state":          organization.FieldLicenseState,
+	"license_expiry":         organization.FieldLicenseExpiry,
+}
+
+// OrderBy sorts by an orderable column. The planner has already validated the
+// field; anything else is passed through so Ent rejects it when the query runs.
 func (h *organizationQueryHandle) OrderBy(field string, desc bool) QueryHandle {
-	var opts []sql.OrderTermOption
+	column, ok := organizationOrderColumns[field]
+	if !ok {
+		column = field
+	}
+	order := ent.Asc
 	if desc {
-		opts = append(opts, sql.OrderDesc())
+		order = ent.Desc
 	}
-	h.q = h.q.Order(organization.OrderOption(sql.OrderByField(field, opts...).ToFunc()))
+	h.q = h.q.Order(order(column))
+	return h
+}
+
+func (h *organizationQueryHandle) ShowSensitive() QueryHandle {
+	h.showSensitive = true
 	return h
 }
 
@@ -1140,7 +2927,13 @@ func (h *organizationQueryHandle) All(ctx context.Context) ([]any, error) {
 	}
 	out := make([]any, len(results))
 	for i, r := range results {
-		out[i] = r
+		if h.showSensitive {
+			out[i] = r
+			continue
+		}
+		if out[i], err = redactSensitive("organization", r); err != nil {
+			return nil, err
+		}
 	}
 	return out, nil
 }
@@ -1149,6 +2942,25 @@ func (h *organizationQueryHandle) Count(ctx context.Context) (int, error) {
 	return h.q.Count(ctx)
 }
 
+// organizationAggregatable lists the Organization columns valid for sum and avg.
+var organizationAggregatable = map[string]bool{}
+
+func (d *organizationDispatcher) Aggregate(ctx context.Context, client *ent.Client, fn AggregateFunc, column string, specs ...planner.PredicateSpec) (float64, error) {
+	h := d.Query(client).Where(specs...).(*organizationQueryHandle)
+	if fn == AggregateCount {
+		n, err := h.q.Count(ctx)
+		return float64(n), err
+	}
+	if !organizationAggregatable[column] {
+		return 0, fmt.Errorf("cannot %s '%s': not a numeric field of organization", fn, column)
+	}
+	agg, err := aggregateColumn(fn, column)
+	if err != nil {
+		return 0, err
+	}
+	return h.q.Aggregate(agg).Float64(ctx)
+}
+
 func (d *organizationDispatcher) Create(ctx context.Context, client *ent.Client, fields map[string]any) (any, error) {
 	builder := client.Organization.Create()
 	m := builder.Mutation()
@@ -1188,17 +3000,94 @@ func (d *personDispatcher) Get(ctx context.Context, client *ent.Client, id uuid.
 }
 
 type personQueryHandle struct {
-	q *ent.PersonQuery
+	q             *ent.PersonQuery
+	showSensitive bool
 }
 
 func (h *personQueryHandle) Where(specs ...planner.PredicateSpec) QueryHandle {
 	for _, spec := range specs {
+		if p, ok := personEnumPredicate(spec); ok {
+			h.q = h.q.Where(p)
+			continue
+		}
 		p := buildSQLPredicate(spec)
 		h.q = h.q.Where(predicate.Person(p))
 	}
 	return h
 }
 
+// personEnumPredicate builds typed Ent predicates for enum fields. It
+// reports false for other fields, other operators and non-string values,
+// which fall back to buildSQLPredicate.
+func personEnumPredicate(spec planner.PredicateSpec) (predicate.Person, bool) {
+	switch spec.Field {
+	case "record_source":
+		switch spec.Op {
+		case planner.OpEQ:
+			if v, ok := spec.Value.(string); ok {
+				return person.RecordSourceEQ(person.RecordSource(v)), true
+			}
+		case planner.OpNEQ:
+			if v, ok := spec.Value.(string); ok {
+				return person.RecordSourceNEQ(person.RecordSource(v)), true
+			}
+		case planner.OpIn:
+			vs := make([]person.RecordSource, 0, len(spec.Values))
+			for _, v := range spec.Values {
+				s, ok := v.(string)
+				if !ok {
+					return nil, false
+				}
+				vs = append(vs, person.RecordSource(s))
+			}
+			return person.RecordSourceIn(vs...), true
+		}
+	case "preferred_contact":
+		switch spec.Op {
+		case planner.OpEQ:
+			if v, ok := spec.Value.(string); ok {
+				return person.PreferredContactEQ(person.PreferredContact(v)), true
+			}
+		case planner.OpNEQ:
+			if v, ok := spec.Value.(string); ok {
+				return person.PreferredContactNEQ(person.PreferredContact(v)), true
+			}
+		case planner.OpIn:
+			vs := make([]person.PreferredContact, 0, len(spec.Values))
+			for _, v := range spec.Values {
+				s, ok := v.(string)
+				if !ok {
+					return nil, false
+				}
+				vs = append(vs, person.PreferredContact(s))
+			}
+			return person.PreferredContactIn(vs...), true
+		}
+	case "verification_method":
+		switch spec.Op {
+		case planner.OpEQ:
+			if v, ok := spec.Value.(string); ok {
+				return person.VerificationMethodEQ(person.VerificationMethod(v)), true
+			}
+		case planner.OpNEQ:
+			if v, ok := spec.Value.(string); ok {
+				return person.VerificationMethodNEQ(person.VerificationMethod(v)), true
+			}
+		case planner.OpIn:
+			vs := make([]person.VerificationMethod, 0, len(spec.Values))
+			for _, v := range spec.Values {
+				s, ok := v.(string)
+				if !ok {
+					return nil, false
+				}
+				vs = append(vs, person.VerificationMethod(s))
+			}
+			return person.VerificationMethodIn(vs...), true
+		}
+	}
+	return nil, false
+}
+
 func (h *personQueryHandle) WithEdge(name string) QueryHandle {
 	switch name {
 	case "roles":
@@ -1213,12 +3102,82 @@ func (h *personQueryHandle) WithEdge(name string) QueryHandle {
 	return h
 }
 
+// personEdges maps each Person edge to a traversal returning the
+// related entities' query handle.
+var personEdges = map[string]EdgeTraversal{
+	"roles": {
+		Target: "person_role",
+		Unique: false,
+		Query: func(client *ent.Client, id uuid.UUID) QueryHandle {
+			return &personroleQueryHandle{q: client.Person.Query().Where(person.ID(id)).QueryRoles()}
+		},
+	},
+	"organizations": {
+		Target: "organization",
+		Unique: false,
+		Query: func(client *ent.Client, id uuid.UUID) QueryHandle {
+			return &organizationQueryHandle{q: client.Person.Query().Where(person.ID(id)).QueryOrganizations()}
+		},
+	},
+	"ledger_entries": {
+		Target: "ledger_entry",
+		Unique: false,
+		Query: func(client *ent.Client, id uuid.UUID) QueryHandle {
+			return &ledgerentryQueryHandle{q: client.Person.Query().Where(person.ID(id)).QueryLedgerEntries()}
+		},
+	},
+	"applications": {
+		Target: "application",
+		Unique: false,
+		Query: func(client *ent.Client, id uuid.UUID) QueryHandle {
+			return &applicationQueryHandle{q: client.Person.Query().Where(person.ID(id)).QueryApplications()}
+		},
+	},
+}
+
+// Edges returns the Person edge traversals.
+func (d *personDispatcher) Edges() map[string]EdgeTraversal {
+	return personEdges
+}
+
+// personOrderColumns maps each orderable Person field to its Ent
+// column. JSON fields have no meaningful order and edge FKs no column of
+// their own, so both are left out.
+var personOrderColumns = map[string]string{
+	"id":                  person.FieldID,
+	"first_name":          person.FieldFirstName,
+	"middle_name":         person.FieldMiddleName,
+	"last_name":           person.FieldLastName,
+	"display_name":        person.FieldDisplayName,
+	"record_source":       person.FieldRecordSource,
+	"date_of_birth":       person.FieldDateOfBirth,
+	"ssn_last_four":       person.FieldSsnLastFour,
+	"preferred_contact":   person.FieldPreferredContact,
+	"language_preference": person.FieldLanguagePreference,
+	"timezone":            person.FieldTimezone,
+	"do_not_contact":      person.FieldDoNotContact,
+	"identity_verified":   person.FieldIdentityVerified,
+	"verification_method": person.FieldVerificationMethod,
+	"verified_at":         person.FieldVerifiedAt,
+}
+
+// OrderBy sorts by an orderable column. The planner has already validated the
+// field; anything else is passed through so Ent rejects it when the query runs.
 func (h *personQueryHandle) OrderBy(field string, desc bool) QueryHandle {
-	var opts []sql.OrderTermOption
+	column, ok := personOrderColumns[field]
+	if !ok {
+		column = field
+	}
+	order := ent.Asc
 	if desc {
-		opts = append(opts, sql.OrderDesc())
+		order = ent.Desc
 	}
-	h.q = h.q.Order(person.OrderOption(sql.OrderByField(field, opts...).ToFunc()))
+	h.q = h.q.Order(order(column))
+	return h
+}
+
+func (h *personQueryHandle) ShowSensitive() QueryHandle {
+	h.showSensitive = true
 	return h
 }
 
@@ -1239,7 +3198,13 @@ func (h *personQueryHandle) All(ctx context.Context) ([]any, error) {
 	}
 	out := make([]any, len(results))
 	for i, r := range results {
-		out[i] = r
+		if h.showSensitive {
+			out[i] = r
+			continue
+		}
+		if out[i], err = redactSensitive("person", r); err != nil {
+			return nil, err
+		}
 	}
 	return out, nil
 }
@@ -1248,6 +3213,25 @@ func (h *personQueryHandle) Count(ctx context.Context) (int, error) {
 	return h.q.Count(ctx)
 }
 
+// personAggregatable lists the Person columns valid for sum and avg.
+var personAggregatable = map[string]bool{}
+
+func (d *personDispatcher) Aggregate(ctx context.Context, client *ent.Client, fn AggregateFunc, column string, specs ...planner.PredicateSpec) (float64, error) {
+	h := d.Query(client).Where(specs...).(*personQueryHandle)
+	if fn == AggregateCount {
+		n, err := h.q.Count(ctx)
+		return float64(n), err
+	}
+	if !personAggregatable[column] {
+		return 0, fmt.Errorf("cannot %s '%s': not a numeric field of person", fn, column)
+	}
+	agg, err := aggregateColumn(fn, column)
+	if err != nil {
+		return 0, err
+	}
+	return h.q.Aggregate(agg).Float64(ctx)
+}
+
 func (d *personDispatcher) Create(ctx context.Context, client *ent.Client, fields map[string]any) (any, error) {
 	builder := client.Person.Create()
 	m := builder.Mutation()
@@ -1287,17 +3271,94 @@ func (d *personroleDispatcher) Get(ctx context.Context, client *ent.Client, id u
 }
 
 type personroleQueryHandle struct {
-	q *ent.PersonRoleQuery
+	q             *ent.PersonRoleQuery
+	showSensitive bool
 }
 
 func (h *personroleQueryHandle) Where(specs ...planner.PredicateSpec) QueryHandle {
 	for _, spec := range specs {
+		if p, ok := personroleEnumPredicate(spec); ok {
+			h.q = h.q.Where(p)
+			continue
+		}
 		p := buildSQLPredicate(spec)
 		h.q = h.q.Where(predicate.PersonRole(p))
 	}
 	return h
 }
 
+// personroleEnumPredicate builds typed Ent predicates for enum fields. It
+// reports false for other fields, other operators and non-string values,
+// which fall back to buildSQLPredicate.
+func personroleEnumPredicate(spec planner.PredicateSpec) (predicate.PersonRole, bool) {
+	switch spec.Field {
+	case "role_type":
+		switch spec.Op {
+		case planner.OpEQ:
+			if v, ok := spec.Value.(string); ok {
+				return personrole.RoleTypeEQ(personrole.RoleType(v)), true
+			}
+		case planner.OpNEQ:
+			if v, ok := spec.Value.(string); ok {
+				return personrole.RoleTypeNEQ(personrole.RoleType(v)), true
+			}
+		case planner.OpIn:
+			vs := make([]personrole.RoleType, 0, len(spec.Values))
+			for _, v := range spec.Values {
+				s, ok := v.(string)
+				if !ok {
+					return nil, false
+				}
+				vs = append(vs, personrole.RoleType(s))
+			}
+			return personrole.RoleTypeIn(vs...), true
+		}
+	case "scope_type":
+		switch spec.Op {
+		case planner.OpEQ:
+			if v, ok := spec.Value.(string); ok {
+				return personrole.ScopeTypeEQ(personrole.ScopeType(v)), true
+			}
+		case planner.OpNEQ:
+			if v, ok := spec.Value.(string); ok {
+				return personrole.ScopeTypeNEQ(personrole.ScopeType(v)), true
+			}
+		case planner.OpIn:
+			vs := make([]personrole.ScopeType, 0, len(spec.Values))
+			for _, v := range spec.Values {
+				s, ok := v.(string)
+				if !ok {
+					return nil, false
+				}
+				vs = append(vs, personrole.ScopeType(s))
+			}
+			return personrole.ScopeTypeIn(vs...), true
+		}
+	case "status":
+		switch spec.Op {
+		case planner.OpEQ:
+			if v, ok := spec.Value.(string); ok {
+				return personrole.StatusEQ(personrole.Status(v)), true
+			}
+		case planner.OpNEQ:
+			if v, ok := spec.Value.(string); ok {
+				return personrole.StatusNEQ(personrole.Status(v)), true
+			}
+		case planner.OpIn:
+			vs := make([]personrole.Status, 0, len(spec.Values))
+			for _, v := range spec.Values {
+				s, ok := v.(string)
+				if !ok {
+					return nil, false
+				}
+				vs = append(vs, personrole.Status(s))
+			}
+			return personrole.StatusIn(vs...), true
+		}
+	}
+	return nil, false
+}
+
 func (h *personroleQueryHandle) WithEdge(name string) QueryHandle {
 	switch name {
 	case "leases":
@@ -1310,12 +3371,65 @@ func (h *personroleQueryHandle) WithEdge(name string) QueryHandle {
 	return h
 }
 
+// personroleEdges maps each PersonRole edge to a traversal returning the
+// related entities' query handle.
+var personroleEdges = map[string]EdgeTraversal{
+	"leases": {
+		Target: "lease",
+		Unique: false,
+		Query: func(client *ent.Client, id uuid.UUID) QueryHandle {
+			return &leaseQueryHandle{q: client.PersonRole.Query().Where(personrole.ID(id)).QueryLeases()}
+		},
+	},
+	"guaranteed_leases": {
+		Target: "lease",
+		Unique: false,
+		Query: func(client *ent.Client, id uuid.UUID) QueryHandle {
+			return &leaseQueryHandle{q: client.PersonRole.Query().Where(personrole.ID(id)).QueryGuaranteedLeases()}
+		},
+	},
+	"person": {
+		Target: "person",
+		Unique: true,
+		Query: func(client *ent.Client, id uuid.UUID) QueryHandle {
+			return &personQueryHandle{q: client.PersonRole.Query().Where(personrole.ID(id)).QueryPerson().Limit(1)}
+		},
+	},
+}
+
+// Edges returns the PersonRole edge traversals.
+func (d *personroleDispatcher) Edges() map[string]EdgeTraversal {
+	return personroleEdges
+}
+
+// personroleOrderColumns maps each orderable PersonRole field to its Ent
+// column. JSON fields have no meaningful order and edge FKs no column of
+// their own, so both are left out.
+var personroleOrderColumns = map[string]string{
+	"id":         personrole.FieldID,
+	"role_type":  personrole.FieldRoleType,
+	"scope_type": personrole.FieldScopeType,
+	"scope_id":   personrole.FieldScopeID,
+	"status":     personrole.FieldStatus,
+}
+
+// OrderBy sorts by an orderable column. The planner has already validated the
+// field; anything else is passed through so Ent rejects it when the query runs.
 func (h *personroleQueryHandle) OrderBy(field string, desc bool) QueryHandle {
-	var opts []sql.OrderTermOption
+	column, ok := personroleOrderColumns[field]
+	if !ok {
+		column = field
+	}
+	order := ent.Asc
 	if desc {
-		opts = append(opts, sql.OrderDesc())
+		order = ent.Desc
 	}
-	h.q = h.q.Order(personrole.OrderOption(sql.OrderByField(field, opts...).ToFunc()))
+	h.q = h.q.Order(order(column))
+	return h
+}
+
+func (h *personroleQueryHandle) ShowSensitive() QueryHandle {
+	h.showSensitive = true
 	return h
 }
 
@@ -1336,7 +3450,13 @@ func (h *personroleQueryHandle) All(ctx context.Context) ([]any, error) {
 	}
 	out := make([]any, len(results))
 	for i, r := range results {
-		out[i] = r
+		if h.showSensitive {
+			out[i] = r
+			continue
+		}
+		if out[i], err = redactSensitive("person_role", r); err != nil {
+			return nil, err
+		}
 	}
 	return out, nil
 }
@@ -1345,6 +3465,25 @@ func (h *personroleQueryHandle) Count(ctx context.Context) (int, error) {
 	return h.q.Count(ctx)
 }
 
+// personroleAggregatable lists the PersonRole columns valid for sum and avg.
+var personroleAggregatable = map[string]bool{}
+
+func (d *personroleDispatcher) Aggregate(ctx context.Context, client *ent.Client, fn AggregateFunc, column string, specs ...planner.PredicateSpec) (float64, error) {
+	h := d.Query(client).Where(specs...).(*personroleQueryHandle)
+	if fn == AggregateCount {
+		n, err := h.q.Count(ctx)
+		return float64(n), err
+	}
+	if !personroleAggregatable[column] {
+		return 0, fmt.Errorf("cannot %s '%s': not a numeric field of person_role", fn, column)
+	}
+	agg, err := aggregateColumn(fn, column)
+	if err != nil {
+		return 0, err
+	}
+	return h.q.Aggregate(agg).Float64(ctx)
+}
+
 func (d *personroleDispatcher) Create(ctx context.Context, client *ent.Client, fields map[string]any) (any, error) {
 	builder := client.PersonRole.Create()
 	m := builder.Mutation()
@@ -1384,17 +3523,73 @@ func (d *portfolioDispatcher) Get(ctx context.Context, client *ent.Client, id uu
 }
 
 type portfolioQueryHandle struct {
-	q *ent.PortfolioQuery
+	q             *ent.PortfolioQuery
+	showSensitive bool
 }
 
 func (h *portfolioQueryHandle) Where(specs ...planner.PredicateSpec) QueryHandle {
 	for _, spec := range specs {
+		if p, ok := portfolioEnumPredicate(spec); ok {
+			h.q = h.q.Where(p)
+			continue
+		}
 		p := buildSQLPredicate(spec)
 		h.q = h.q.Where(predicate.Portfolio(p))
 	}
 	return h
 }
 
+// portfolioEnumPredicate builds typed Ent predicates for enum fields. It
+// reports false for other fields, other operators and non-string values,
+// which fall back to buildSQLPredicate.
+func portfolioEnumPredicate(spec planner.PredicateSpec) (predicate.Portfolio, bool) {
+	switch spec.Field {
+	case "management_type":
+		switch spec.Op {
+		case planner.OpEQ:
+			if v, ok := spec.Value.(string); ok {
+				return portfolio.ManagementTypeEQ(portfolio.ManagementType(v)), true
+			}
+		case planner.OpNEQ:
+			if v, ok := spec.Value.(string); ok {
+				return portfolio.ManagementTypeNEQ(portfolio.ManagementType(v)), true
+			}
+		case planner.OpIn:
+			vs := make([]portfolio.ManagementType, 0, len(spec.Values))
+			for _, v := range spec.Values {
+				s, ok := v.(string)
+				if !ok {
+					return nil, false
+				}
+				vs = append(vs, portfolio.ManagementType(s))
+			}
+			return portfolio.ManagementTypeIn(vs...), true
+		}
+	case "status":
+		switch spec.Op {
+		case planner.OpEQ:
+			if v, ok := spec.Value.(string); ok {
+				return portfolio.StatusEQ(portfolio.Status(v)), true
+			}
+		case planner.OpNEQ:
+			if v, ok := spec.Value.(string); ok {
+				return portfolio.StatusNEQ(portfolio.Status(v)), true
+			}
+		case planner.OpIn:
+			vs := make([]portfolio.Status, 0, len(spec.Values))
+			for _, v := range spec.Values {
+				s, ok := v.(string)
+				if !ok {
+					return nil, false
+				}
+				vs = append(vs, portfolio.Status(s))
+			}
+			return portfolio.StatusIn(vs...), true
+		}
+	}
+	return nil, false
+}
+
 func (h *portfolioQueryHandle) WithEdge(name string) QueryHandle {
 	switch name {
 	case "properties":
@@ -1407,12 +3602,67 @@ func (h *portfolioQueryHandle) WithEdge(name string) QueryHandle {
 	return h
 }
 
+// portfolioEdges maps each Portfolio edge to a traversal returning the
+// related entities' query handle.
+var portfolioEdges = map[string]EdgeTraversal{
+	"properties": {
+		Target: "property",
+		Unique: false,
+		Query: func(client *ent.Client, id uuid.UUID) QueryHandle {
+			return &propertyQueryHandle{q: client.Portfolio.Query().Where(portfolio.ID(id)).QueryProperties()}
+		},
+	},
+	"owner": {
+		Target: "organization",
+		Unique: true,
+		Query: func(client *ent.Client, id uuid.UUID) QueryHandle {
+			return &organizationQueryHandle{q: client.Portfolio.Query().Where(portfolio.ID(id)).QueryOwner().Limit(1)}
+		},
+	},
+	"trust_account": {
+		Target: "bank_account",
+		Unique: true,
+		Query: func(client *ent.Client, id uuid.UUID) QueryHandle {
+			return &bankaccountQueryHandle{q: client.Portfolio.Query().Where(portfolio.ID(id)).QueryTrustAccount().Limit(1)}
+		},
+	},
+}
+
+// Edges returns the Portfolio edge traversals.
+func (d *portfolioDispatcher) Edges() map[string]EdgeTraversal {
+	return portfolioEdges
+}
+
+// portfolioOrderColumns maps each orderable Portfolio field to its Ent
+// column. JSON fields have no meaningful order and edge FKs no column of
+// their own, so both are left out.
+var portfolioOrderColumns = map[string]string{
+	"id":                           portfolio.FieldID,
+	"name":                         portfolio.FieldName,
+	"management_type":              portfolio.FieldManagementType,
+	"description":                  portfolio.FieldDescription,
+	"status":                       portfolio.FieldStatus,
+	"default_chart_of_accounts_id": portfolio.FieldDefaultChartOfAccountsID,
+	"default_bank_account_id":      portfolio.FieldDefaultBankAccountID,
+}
+
+// OrderBy sorts by an orderable column. The planner has already validated the
+// field; anything else is passed through so Ent rejects it when the query runs.
 func (h *portfolioQueryHandle) OrderBy(field string, desc bool) QueryHandle {
-	var opts []sql.OrderTermOption
+	column, ok := portfolioOrderColumns[field]
+	if !ok {
+		column = field
+	}
+	order := ent.Asc
 	if desc {
-		opts = append(opts, sql.OrderDesc())
+		order = ent.Desc
 	}
-	h.q = h.q.Order(portfolio.OrderOption(sql.OrderByField(field, opts...).ToFunc()))
+	h.q = h.q.Order(order(column))
+	return h
+}
+
+func (h *portfolioQueryHandle) ShowSensitive() QueryHandle {
+	h.showSensitive = true
 	return h
 }
 
@@ -1433,7 +3683,13 @@ func (h *portfolioQueryHandle) All(ctx context.Context) ([]any, error) {
 	}
 	out := make([]any, len(results))
 	for i, r := range results {
-		out[i] = r
+		if h.showSensitive {
+			out[i] = r
+			continue
+		}
+		if out[i], err = redactSensitive("portfolio", r); err != nil {
+			return nil, err
+		}
 	}
 	return out, nil
 }
@@ -1442,6 +3698,25 @@ func (h *portfolioQueryHandle) Count(ctx context.Context) (int, error) {
 	return h.q.Count(ctx)
 }
 
+// portfolioAggregatable lists the Portfolio columns valid for sum and avg.
+var portfolioAggregatable = map[string]bool{}
+
+func (d *portfolioDispatcher) Aggregate(ctx context.Context, client *ent.Client, fn AggregateFunc, column string, specs ...planner.PredicateSpec) (float64, error) {
+	h := d.Query(client).Where(specs...).(*portfolioQueryHandle)
+	if fn == AggregateCount {
+		n, err := h.q.Count(ctx)
+		return float64(n), err
+	}
+	if !portfolioAggregatable[column] {
+		return 0, fmt.Errorf("cannot %s '%s': not a numeric field of portfolio", fn, column)
+	}
+	agg, err := aggregateColumn(fn, column)
+	if err != nil {
+		return 0, err
+	}
+	return h.q.Aggregate(agg).Float64(ctx)
+}
+
 func (d *portfolioDispatcher) Create(ctx context.Context, client *ent.Client, fields map[string]any) (any, error) {
 	builder := client.Portfolio.Create()
 	m := builder.Mutation()
@@ -1481,17 +3756,73 @@ func (d *propertyDispatcher) Get(ctx context.Context, client *ent.Client, id uui
 }
 
 type propertyQueryHandle struct {
-	q *ent.PropertyQuery
+	q             *ent.PropertyQuery
+	showSensitive bool
 }
 
 func (h *propertyQueryHandle) Where(specs ...planner.PredicateSpec) QueryHandle {
 	for _, spec := range specs {
+		if p, ok := propertyEnumPredicate(spec); ok {
+			h.q = h.q.Where(p)
+			continue
+		}
 		p := buildSQLPredicate(spec)
 		h.q = h.q.Where(predicate.Property(p))
 	}
 	return h
 }
 
+// propertyEnumPredicate builds typed Ent predicates for enum fields. It
+// reports false for other fields, other operators and non-string values,
+// which fall back to buildSQLPredicate.
+func propertyEnumPredicate(spec planner.PredicateSpec) (predicate.Property, bool) {
+	switch spec.Field {
+	case "property_type":
+		switch spec.Op {
+		case planner.OpEQ:
+			if v, ok := spec.Value.(string); ok {
+				return property.PropertyTypeEQ(property.PropertyType(v)), true
+			}
+		case planner.OpNEQ:
+			if v, ok := spec.Value.(string); ok {
+				return property.PropertyTypeNEQ(property.PropertyType(v)), true
+			}
+		case planner.OpIn:
+			vs := make([]property.PropertyType, 0, len(spec.Values))
+			for _, v := range spec.Values {
+				s, ok := v.(string)
+				if !ok {
+					return nil, false
+				}
+				vs = append(vs, property.PropertyType(s))
+			}
+			return property.PropertyTypeIn(vs...), true
+		}
+	case "status":
+		switch spec.Op {
+		case planner.OpEQ:
+			if v, ok := spec.Value.(string); ok {
+				return property.StatusEQ(property.Status(v)), true
+			}
+		case planner.OpNEQ:
+			if v, ok := spec.Value.(string); ok {
+				return property.StatusNEQ(property.Status(v)), true
+			}
+		case planner.OpIn:
+			vs := make([]property.Status, 0, len(spec.Values))
+			for _, v := range spec.Values {
+				s, ok := v.(string)
+				if !ok {
+					return nil, false
+				}
+				vs = append(vs, property.Status(s))
+			}
+			return property.StatusIn(vs...), true
+		}
+	}
+	return nil, false
+}
+
 func (h *propertyQueryHandle) WithEdge(name string) QueryHandle {
 	switch name {
 	case "portfolio":
@@ -1512,12 +3843,104 @@ func (h *propertyQueryHandle) WithEdge(name string) QueryHandle {
 	return h
 }
 
+// propertyEdges maps each Property edge to a traversal returning the
+// related entities' query handle.
+var propertyEdges = map[string]EdgeTraversal{
+	"portfolio": {
+		Target: "portfolio",
+		Unique: true,
+		Query: func(client *ent.Client, id uuid.UUID) QueryHandle {
+			return &portfolioQueryHandle{q: client.Property.Query().Where(property.ID(id)).QueryPortfolio().Limit(1)}
+		},
+	},
+	"buildings": {
+		Target: "building",
+		Unique: false,
+		Query: func(client *ent.Client, id uuid.UUID) QueryHandle {
+			return &buildingQueryHandle{q: client.Property.Query().Where(property.ID(id)).QueryBuildings()}
+		},
+	},
+	"spaces": {
+		Target: "space",
+		Unique: false,
+		Query: func(client *ent.Client, id uuid.UUID) QueryHandle {
+			return &spaceQueryHandle{q: client.Property.Query().Where(property.ID(id)).QuerySpaces()}
+		},
+	},
+	"bank_account": {
+		Target: "bank_account",
+		Unique: true,
+		Query: func(client *ent.Client, id uuid.UUID) QueryHandle {
+			return &bankaccountQueryHandle{q: client.Property.Query().Where(property.ID(id)).QueryBankAccount().Limit(1)}
+		},
+	},
+	"applications": {
+		Target: "application",
+		Unique: false,
+		Query: func(client *ent.Client, id uuid.UUID) QueryHandle {
+			return &applicationQueryHandle{q: client.Property.Query().Where(property.ID(id)).QueryApplications()}
+		},
+	},
+	"ledger_entries": {
+		Target: "ledger_entry",
+		Unique: false,
+		Query: func(client *ent.Client, id uuid.UUID) QueryHandle {
+			return &ledgerentryQueryHandle{q: client.Property.Query().Where(property.ID(id)).QueryLedgerEntries()}
+		},
+	},
+	"property_jurisdictions": {
+		Target: "property_jurisdiction",
+		Unique: false,
+		Query: func(client *ent.Client, id uuid.UUID) QueryHandle {
+			return &propertyjurisdictionQueryHandle{q: client.Property.Query().Where(property.ID(id)).QueryPropertyJurisdictions()}
+		},
+	},
+}
+
+// Edges returns the Property edge traversals.
+func (d *propertyDispatcher) Edges() map[string]EdgeTraversal {
+	return propertyEdges
+}
+
+// propertyOrderColumns maps each orderable Property field to its Ent
+// column. JSON fields have no meaningful order and edge FKs no column of
+// their own, so both are left out.
+var propertyOrderColumns = map[string]string{
+	"id":                       property.FieldID,
+	"name":                     property.FieldName,
+	"property_type":            property.FieldPropertyType,
+	"status":                   property.FieldStatus,
+	"year_built":               property.FieldYearBuilt,
+	"total_square_footage":     property.FieldTotalSquareFootage,
+	"total_spaces":             property.FieldTotalSpaces,
+	"lot_size_sqft":            property.FieldLotSizeSqft,
+	"stories":                  property.FieldStories,
+	"parking_spaces":           property.FieldParkingSpaces,
+	"jurisdiction_id":          property.FieldJurisdictionID,
+	"rent_controlled":          property.FieldRentControlled,
+	"requires_lead_disclosure": property.FieldRequiresLeadDisclosure,
+	"chart_of_accounts_id":     property.FieldChartOfAccountsID,
+	"insurance_policy_number":  property.FieldInsurancePolicyNumber,
+	"insurance_expiry":         property.FieldInsuranceExpiry,
+}
+
+// OrderBy sorts by an orderable column. The planner has already validated the
+// field; anything else is passed through so Ent rejects it when the query runs.
 func (h *propertyQueryHandle) OrderBy(field string, desc bool) QueryHandle {
-	var opts []sql.OrderTermOption
+	column, ok := propertyOrderColumns[field]
+	if !ok {
+		column = field
+	}
+	order := ent.Asc
 	if desc {
-		opts = append(opts, sql.OrderDesc())
+		order = ent.Desc
 	}
-	h.q = h.q.Order(property.OrderOption(sql.OrderByField(field, opts...).ToFunc()))
+	h.q = h.q.Order(order(column))
+	return h
+}
+
+func (h *propertyQueryHandle) ShowSensitive() QueryHandle {
+	h.showSensitive = true
 	return h
 }
 
@@ -1538,7 +3961,13 @@ func (h *propertyQueryHandle) All(ctx context.Context) ([]any, error) {
 	}
 	out := make([]any, len(results))
 	for i, r := range results {
-		out[i] = r
+		if h.showSensitive {
+			out[i] = r
+			continue
+		}
+		if out[i], err = redactSensitive("property", r); err != nil {
+			return nil, err
+		}
 	}
 	return out, nil
 }
@@ -1547,6 +3976,32 @@ func (h *propertyQueryHandle) Count(ctx context.Context) (int, error) {
 	return h.q.Count(ctx)
 }
 
+// propertyAggregatable lists the Property columns valid for sum and avg.
+var propertyAggregatable = map[string]bool{
+	"year_built":           true,
+	"total_square_footage": true,
+	"total_spaces":         true,
+	"lot_size_sqft":        true,
+	"stories":              true,
+	"parking_spaces":       true,
+}
+
+func (d *propertyDispatcher) Aggregate(ctx context.Context, client *ent.Client, fn AggregateFunc, column string, specs ...planner.PredicateSpec) (float64, error) {
+	h := d.Query(client).Where(specs...).(*propertyQueryHandle)
+	if fn == AggregateCount {
+		n, err := h.q.Count(ctx)
+		return float64(n), err
+	}
+	if !propertyAggregatable[column] {
+		return 0, fmt.Errorf("cannot %s '%s': not a numeric field of property", fn, column)
+	}
+	agg, err := aggregateColumn(fn, column)
+	if err != nil {
+		return 0, err
+	}
+	return h.q.Aggregate(agg).Float64(ctx)
+}
+
 func (d *propertyDispatcher) Create(ctx context.Context, client *ent.Client, fields map[string]any) (any, error) {
 	builder := client.Property.Create()
 	m := builder.Mutation()
@@ -1586,17 +4041,52 @@ func (d *propertyjurisdictionDispatcher) Get(ctx context.Context, client *ent.Cl
 }
 
 type propertyjurisdictionQueryHandle struct {
-	q *ent.PropertyJurisdictionQuery
+	q             *ent.PropertyJurisdictionQuery
+	showSensitive bool
 }
 
 func (h *propertyjurisdictionQueryHandle) Where(specs ...planner.PredicateSpec) QueryHandle {
 	for _, spec := range specs {
+		if p, ok := propertyjurisdictionEnumPredicate(spec); ok {
+			h.q = h.q.Where(p)
+			continue
+		}
 		p := buildSQLPredicate(spec)
 		h.q = h.q.Where(predicate.PropertyJurisdiction(p))
 	}
 	return h
 }
 
+// propertyjurisdictionEnumPredicate builds typed Ent predicates for enum fields. It
+// reports false for other fields, other operators and non-string values,
+// which fall back to buildSQLPredicate.
+func propertyjurisdictionEnumPredicate(spec planner.PredicateSpec) (predicate.PropertyJurisdiction, bool) {
+	switch spec.Field {
+	case "lookup_source":
+		switch spec.Op {
+		case planner.OpEQ:
+			if v, ok := spec.Value.(string); ok {
+				return propertyjurisdiction.LookupSourceEQ(propertyjurisdiction.LookupSource(v)), true
+			}
+		case planner.OpNEQ:
+			if v, ok := spec.Value.(string); ok {
+				return propertyjurisdiction.LookupSourceNEQ(propertyjurisdiction.LookupSource(v)), true
+			}
+		case planner.OpIn:
+			vs := make([]propertyjurisdiction.LookupSource, 0, len(spec.Values))
+			for _, v := range spec.Values {
+				s, ok := v.(string)
+				if !ok {
+					return nil, false
+				}
+				vs = append(vs, propertyjurisdiction.LookupSource(s))
+			}
+			return propertyjurisdiction.LookupSourceIn(vs...), true
+		}
+	}
+	return nil, false
+}
+
 func (h *propertyjurisdictionQueryHandle) WithEdge(name string) QueryHandle {
 	switch name {
 	case "property":
@@ -1607,12 +4097,60 @@ func (h *propertyjurisdictionQueryHandle) WithEdge(name string) QueryHandle {
 	return h
 }
 
+// propertyjurisdictionEdges maps each PropertyJurisdiction edge to a traversal returning the
+// related entities' query handle.
+var propertyjurisdictionEdges = map[string]EdgeTraversal{
+	"property": {
+		Target: "property",
+		Unique: true,
+		Query: func(client *ent.Client, id uuid.UUID) QueryHandle {
+			return &propertyQueryHandle{q: client.PropertyJurisdiction.Query().Where(propertyjurisdiction.ID(id)).QueryProperty().Limit(1)}
+		},
+	},
+	"jurisdiction": {
+		Target: "jurisdiction",
+		Unique: true,
+		Query: func(client *ent.Client, id uuid.UUID) QueryHandle {
+			return &jurisdictionQueryHandle{q: client.PropertyJurisdiction.Query().Where(propertyjurisdiction.ID(id)).QueryJurisdiction().Limit(1)}
+		},
+	},
+}
+
+// Edges returns the PropertyJurisdiction edge traversals.
+func (d *propertyjurisdictionDispatcher) Edges() map[string]EdgeTraversal {
+	return propertyjurisdictionEdges
+}
+
+// propertyjurisdictionOrderColumns maps each orderable PropertyJurisdiction field to its Ent
+// column. JSON fields have no meaningful order and edge FKs no column of
+// their own, so both are left out.
+var propertyjurisdictionOrderColumns = map[string]string{
+	"id":             propertyjurisdiction.FieldID,
+	"effective_date": propertyjurisdiction.FieldEffectiveDate,
+	"end_date":       propertyjurisdiction.FieldEndDate,
+	"lookup_source":  propertyjurisdiction.FieldLookupSource,
+	"verified":       propertyjurisdiction.FieldVerified,
+	"verified_at":    propertyjurisdiction.FieldVerifiedAt,
+	"verified_by":    propertyjurisdiction.FieldVerifiedBy,
+}
+
+// OrderBy sorts by an orderable column. The planner has already validated the
+// field; anything else is passed through so Ent rejects it when the query runs.
 func (h *propertyjurisdictionQueryHandle) OrderBy(field string, desc bool) QueryHandle {
-	var opts []sql.OrderTermOption
+	column, ok := propertyjurisdictionOrderColumns[field]
+	if !ok {
+		column = field
+	}
+	order := ent.Asc
 	if desc {
-		opts = append(opts, sql.OrderDesc())
+		order = ent.Desc
 	}
-	h.q = h.q.Order(propertyjurisdiction.OrderOption(sql.OrderByField(field, opts...).ToFunc()))
+	h.q = h.q.Order(order(column))
+	return h
+}
+
+func (h *propertyjurisdictionQueryHandle) ShowSensitive() QueryHandle {
+	h.showSensitive = true
 	return h
 }
 
@@ -1633,7 +4171,13 @@ func (h *propertyjurisdictionQueryHandle) All(ctx context.Context) ([]any, error
 	}
 	out := make([]any, len(results))
 	for i, r := range results {
-		out[i] = r
+		if h.showSensitive {
+			out[i] = r
+			continue
+		}
+		if out[i], err = redactSensitive("property_jurisdiction", r); err != nil {
+			return nil, err
+		}
 	}
 	return out, nil
 }
@@ -1642,6 +4186,25 @@ func (h *propertyjurisdictionQueryHandle) Count(ctx context.Context) (int, error
 	return h.q.Count(ctx)
 }
 
+// propertyjurisdictionAggregatable lists the PropertyJurisdiction columns valid for sum and avg.
+var propertyjurisdictionAggregatable = map[string]bool{}
+
+func (d *propertyjurisdictionDispatcher) Aggregate(ctx context.Context, client *ent.Client, fn AggregateFunc, column string, specs ...planner.PredicateSpec) (float64, error) {
+	h := d.Query(client).Where(specs...).(*propertyjurisdictionQueryHandle)
+	if fn == AggregateCount {
+		n, err := h.q.Count(ctx)
+		return float64(n), err
+	}
+	if !propertyjurisdictionAggregatable[column] {
+		return 0, fmt.Errorf("cannot %s '%s': not a numeric field of property_jurisdiction", fn, column)
+	}
+	agg, err := aggregateColumn(fn, column)
+	if err != nil {
+		return 0, err
+	}
+	return h.q.Aggregate(agg).Float64(ctx)
+}
+
 func (d *propertyjurisdictionDispatcher) Create(ctx context.Context, client *ent.Client, fields map[string]any) (any, error) {
 	builder := client.PropertyJurisdiction.Create()
 	m := builder.Mutation()
@@ -1681,17 +4244,52 @@ func (d *reconciliationDispatcher) Get(ctx context.Context, client *ent.Client,
 }
 
 type reconciliationQueryHandle struct {
-	q *ent.ReconciliationQuery
+	q             *ent.ReconciliationQuery
+	showSensitive bool
 }
 
 func (h *reconciliationQueryHandle) Where(specs ...planner.PredicateSpec) QueryHandle {
 	for _, spec := range specs {
+		if p, ok := reconciliationEnumPredicate(spec); ok {
+			h.q = h.q.Where(p)
+			continue
+		}
 		p := buildSQLPredicate(spec)
 		h.q = h.q.Where(predicate.Reconciliation(p))
 	}
 	return h
 }
 
+// reconciliationEnumPredicate builds typed Ent predicates for enum fields. It
+// reports false for other fields, other operators and non-string values,
+// which fall back to buildSQLPredicate.
+func reconciliationEnumPredicate(spec planner.PredicateSpec) (predicate.Reconciliation, bool) {
+	switch spec.Field {
+	case "status":
+		switch spec.Op {
+		case planner.OpEQ:
+			if v, ok := spec.Value.(string); ok {
+				return reconciliation.StatusEQ(reconciliation.Status(v)), true
+			}
+		case planner.OpNEQ:
+			if v, ok := spec.Value.(string); ok {
+				return reconciliation.StatusNEQ(reconciliation.Status(v)), true
+			}
+		case planner.OpIn:
+			vs := make([]reconciliation.Status, 0, len(spec.Values))
+			for _, v := range spec.Values {
+				s, ok := v.(string)
+				if !ok {
+					return nil, false
+				}
+				vs = append(vs, reconciliation.Status(s))
+			}
+			return reconciliation.StatusIn(vs...), true
+		}
+	}
+	return nil, false
+}
+
 func (h *reconciliationQueryHandle) WithEdge(name string) QueryHandle {
 	switch name {
 	case "bank_account":
@@ -1700,12 +4298,62 @@ func (h *reconciliationQueryHandle) WithEdge(name string) QueryHandle {
 	return h
 }
 
+// reconciliationEdges maps each Reconciliation edge to a traversal returning the
+// related entities' query handle.
+var reconciliationEdges = map[string]EdgeTraversal{
+	"bank_account": {
+		Target: "bank_account",
+		Unique: true,
+		Query: func(client *ent.Client, id uuid.UUID) QueryHandle {
+			return &bankaccountQueryHandle{q: client.Reconciliation.Query().Where(reconciliation.ID(id)).QueryBankAccount().Limit(1)}
+		},
+	},
+}
+
+// Edges returns the Reconciliation edge traversals.
+func (d *reconciliationDispatcher) Edges() map[string]EdgeTraversal {
+	return reconciliationEdges
+}
+
+// reconciliationOrderColumns maps each orderable Reconciliation field to its Ent
+// column. JSON fields have no meaningful order and edge FKs no column of
+// their own, so both are left out.
+var reconciliationOrderColumns = map[string]string{
+	"id":                             reconciliation.FieldID,
+	"period_start":                   reconciliation.FieldPeriodStart,
+	"period_end":                     reconciliation.FieldPeriodEnd,
+	"statement_date":                 reconciliation.FieldStatementDate,
+	"statement_balance_amount_cents": reconciliation.FieldStatementBalanceAmountCents,
+	"statement_balance_currency":     reconciliation.FieldStatementBalanceCurrency,
+	"gl_balance_amount_cents":        reconciliation.FieldGlBalanceAmountCents,
+	"gl_balance_currency":            reconciliation.FieldGlBalanceCurrency,
+	"difference_amount_cents":        reconciliation.FieldDifferenceAmountCents,
+	"difference_currency":            reconciliation.FieldDifferenceCurrency,
+	"status":                         reconciliation.FieldStatus,
+	"unreconciled_items":             reconciliation.FieldUnreconciledItems,
+	"reconciled_by":                  reconciliation.FieldReconciledBy,
+	"reconciled_at":                  reconciliation.FieldReconciledAt,
+	"approved_by":                    reconciliation.FieldApprovedBy,
+	"approved_at":                    reconciliation.FieldApprovedAt,
+}
+
+// OrderBy sorts by an orderable column. The planner has already validated the
+// field; anything else is passed through so Ent rejects it when the query runs.
 func (h *reconciliationQueryHandle) OrderBy(field string, desc bool) QueryHandle {
-	var opts []sql.OrderTermOption
+	column, ok := reconciliationOrderColumns[field]
+	if !ok {
+		column = field
+	}
+	order := ent.Asc
 	if desc {
-		opts = append(opts, sql.OrderDesc())
+		order = ent.Desc
 	}
-	h.q = h.q.Order(reconciliation.OrderOption(sql.OrderByField(field, opts...).ToFunc()))
+	h.q = h.q.Order(order(column))
+	return h
+}
+
+func (h *reconciliationQueryHandle) ShowSensitive() QueryHandle {
+	h.showSensitive = true
 	return h
 }
 
@@ -1726,7 +4374,13 @@ func (h *reconciliationQueryHandle) All(ctx context.Context) ([]any, error) {
 	}
 	out := make([]any, len(results))
 	for i, r := range results {
-		out[i] = r
+		if h.showSensitive {
+			out[i] = r
+			continue
+		}
+		if out[i], err = redactSensitive("reconciliation", r); err != nil {
+			return nil, err
+		}
 	}
 	return out, nil
 }
@@ -1735,6 +4389,30 @@ func (h *reconciliationQueryHandle) Count(ctx context.Context) (int, error) {
 	return h.q.Count(ctx)
 }
 
+// reconciliationAggregatable lists the Reconciliation columns valid for sum and avg.
+var reconciliationAggregatable = map[string]bool{
+	"statement_balance_amount_cents": true,
+	"gl_balance_amount_cents":        true,
+	"difference_amount_cents":        true,
+	"unreconciled_items":             true,
+}
+
+func (d *reconciliationDispatcher) Aggregate(ctx context.Context, client *ent.Client, fn AggregateFunc, column string, specs ...planner.PredicateSpec) (float64, error) {
+	h := d.Query(client).Where(specs...).(*reconciliationQueryHandle)
+	if fn == AggregateCount {
+		n, err := h.q.Count(ctx)
+		return float64(n), err
+	}
+	if !reconciliationAggregatable[column] {
+		return 0, fmt.Errorf("cannot %s '%s': not a numeric field of reconciliation", fn, column)
+	}
+	agg, err := aggregateColumn(fn, column)
+	if err != nil {
+		return 0, err
+	}
+	return h.q.Aggregate(agg).Float64(ctx)
+}
+
 func (d *reconciliationDispatcher) Create(ctx context.Context, client *ent.Client, fields map[string]any) (any, error) {
 	builder := client.Reconciliation.Create()
 	m := builder.Mutation()
@@ -1774,17 +4452,73 @@ func (d *spaceDispatcher) Get(ctx context.Context, client *ent.Client, id uuid.U
 }
 
 type spaceQueryHandle struct {
-	q *ent.SpaceQuery
+	q             *ent.SpaceQuery
+	showSensitive bool
 }
 
 func (h *spaceQueryHandle) Where(specs ...planner.PredicateSpec) QueryHandle {
 	for _, spec := range specs {
+		if p, ok := spaceEnumPredicate(spec); ok {
+			h.q = h.q.Where(p)
+			continue
+		}
 		p := buildSQLPredicate(spec)
 		h.q = h.q.Where(predicate.Space(p))
 	}
 	return h
 }
 
+// spaceEnumPredicate builds typed Ent predicates for enum fields. It
+// reports false for other fields, other operators and non-string values,
+// which fall back to buildSQLPredicate.
+func spaceEnumPredicate(spec planner.PredicateSpec) (predicate.Space, bool) {
+	switch spec.Field {
+	case "space_type":
+		switch spec.Op {
+		case planner.OpEQ:
+			if v, ok := spec.Value.(string); ok {
+				return space.SpaceTypeEQ(space.SpaceType(v)), true
+			}
+		case planner.OpNEQ:
+			if v, ok := spec.Value.(string); ok {
+				return space.SpaceTypeNEQ(space.SpaceType(v)), true
+			}
+		case planner.OpIn:
+			vs := make([]space.SpaceType, 0, len(spec.Values))
+			for _, v := range spec.Values {
+				s, ok := v.(string)
+				if !ok {
+					return nil, false
+				}
+				vs = append(vs, space.SpaceType(s))
+			}
+			return space.SpaceTypeIn(vs...), true
+		}
+	case "status":
+		switch spec.Op {
+		case planner.OpEQ:
+			if v, ok := spec.Value.(string); ok {
+				return space.StatusEQ(space.Status(v)), true
+			}
+		case planner.OpNEQ:
+			if v, ok := spec.Value.(string); ok {
+				return space.StatusNEQ(space.Status(v)), true
+			}
+		case planner.OpIn:
+			vs := make([]space.Status, 0, len(spec.Values))
+			for _, v := range spec.Values {
+				s, ok := v.(string)
+				if !ok {
+					return nil, false
+				}
+				vs = append(vs, space.Status(s))
+			}
+			return space.StatusIn(vs...), true
+		}
+	}
+	return nil, false
+}
+
 func (h *spaceQueryHandle) WithEdge(name string) QueryHandle {
 	switch name {
 	case "property":
@@ -1805,12 +4539,106 @@ func (h *spaceQueryHandle) WithEdge(name string) QueryHandle {
 	return h
 }
 
+// spaceEdges maps each Space edge to a traversal returning the
+// related entities' query handle.
+var spaceEdges = map[string]EdgeTraversal{
+	"property": {
+		Target: "property",
+		Unique: true,
+		Query: func(client *ent.Client, id uuid.UUID) QueryHandle {
+			return &propertyQueryHandle{q: client.Space.Query().Where(space.ID(id)).QueryProperty().Limit(1)}
+		},
+	},
+	"building": {
+		Target: "building",
+		Unique: true,
+		Query: func(client *ent.Client, id uuid.UUID) QueryHandle {
+			return &buildingQueryHandle{q: client.Space.Query().Where(space.ID(id)).QueryBuilding().Limit(1)}
+		},
+	},
+	"children": {
+		Target: "space",
+		Unique: false,
+		Query: func(client *ent.Client, id uuid.UUID) QueryHandle {
+			return &spaceQueryHandle{q: client.Space.Query().Where(space.ID(id)).QueryChildren()}
+		},
+	},
+	"parent_space": {
+		Target: "space",
+		Unique: true,
+		Query: func(client *ent.Client, id uuid.UUID) QueryHandle {
+			return &spaceQueryHandle{q: client.Space.Query().Where(space.ID(id)).QueryParentSpace().Limit(1)}
+		},
+	},
+	"applications": {
+		Target: "application",
+		Unique: false,
+		Query: func(client *ent.Client, id uuid.UUID) QueryHandle {
+			return &applicationQueryHandle{q: client.Space.Query().Where(space.ID(id)).QueryApplications()}
+		},
+	},
+	"lease_spaces": {
+		Target: "lease_space",
+		Unique: false,
+		Query: func(client *ent.Client, id uuid.UUID) QueryHandle {
+			return &leasespaceQueryHandle{q: client.Space.Query().Where(space.ID(id)).QueryLeaseSpaces()}
+		},
+	},
+	"ledger_entries": {
+		Target: "ledger_entry",
+		Unique: false,
+		Query: func(client *ent.Client, id uuid.UUID) QueryHandle {
+			return &ledgerentryQueryHandle{q: client.Space.Query().Where(space.ID(id)).QueryLedgerEntries()}
+		},
+	},
+}
+
+// Edges returns the Space edge traversals.
+func (d *spaceDispatcher) Edges() map[string]EdgeTraversal {
+	return spaceEdges
+}
+
+// spaceOrderColumns maps each orderable Space field to its Ent
+// column. JSON fields have no meaningful order and edge FKs no column of
+// their own, so both are left out.
+var spaceOrderColumns = map[string]string{
+	"id":                       space.FieldID,
+	"space_number":             space.FieldSpaceNumber,
+	"space_type":               space.FieldSpaceType,
+	"status":                   space.FieldStatus,
+	"leasable":                 space.FieldLeasable,
+	"shared_with_parent":       space.FieldSharedWithParent,
+	"square_footage":           space.FieldSquareFootage,
+	"bedrooms":                 space.FieldBedrooms,
+	"bathrooms":                space.FieldBathrooms,
+	"floor":                    space.FieldFloor,
+	"floor_plan":               space.FieldFloorPlan,
+	"ada_accessible":           space.FieldAdaAccessible,
+	"pet_friendly":             space.FieldPetFriendly,
+	"furnished":                space.FieldFurnished,
+	"market_rent_amount_cents": space.FieldMarketRentAmountCents,
+	"market_rent_currency":     space.FieldMarketRentCurrency,
+	"ami_restriction":          space.FieldAmiRestriction,
+	"active_lease_id":          space.FieldActiveLeaseID,
+}
+
+// OrderBy sorts by an orderable column. The planner has already validated the
+// field; anything else is passed through so Ent rejects it when the query runs.
 func (h *spaceQueryHandle) OrderBy(field string, desc bool) QueryHandle {
-	var opts []sql.OrderTermOption
+	column, ok := spaceOrderColumns[field]
+	if !ok {
+		column = field
+	}
+	order := ent.Asc
 	if desc {
-		opts = append(opts, sql.OrderDesc())
+		order = ent.Desc
 	}
-	h.q = h.q.Order(space.OrderOption(sql.OrderByField(field, opts...).ToFunc()))
+	h.q = h.q.Order(order(column))
+	return h
+}
+
+func (h *spaceQueryHandle) ShowSensitive() QueryHandle {
+	h.showSensitive = true
 	return h
 }
 
@@ -1831,7 +4659,13 @@ func (h *spaceQueryHandle) All(ctx context.Context) ([]any, error) {
 	}
 	out := make([]any, len(results))
 	for i, r := range results {
-		out[i] = r
+		if h.showSensitive {
+			out[i] = r
+			continue
+		}
+		if out[i], err = redactSensitive("space", r); err != nil {
+			return nil, err
+		}
 	}
 	return out, nil
 }
@@ -1840,6 +4674,32 @@ func (h *spaceQueryHandle) Count(ctx context.Context) (int, error) {
 	return h.q.Count(ctx)
 }
 
+// spaceAggregatable lists the Space columns valid for sum and avg.
+var spaceAggregatable = map[string]bool{
+	"square_footage":           true,
+	"bedrooms":                 true,
+	"bathrooms":                true,
+	"floor":                    true,
+	"market_rent_amount_cents": true,
+	"ami_restriction":          true,
+}
+
+func (d *spaceDispatcher) Aggregate(ctx context.Context, client *ent.Client, fn AggregateFunc, column string, specs ...planner.PredicateSpec) (float64, error) {
+	h := d.Query(client).Where(specs...).(*spaceQueryHandle)
+	if fn == AggregateCount {
+		n, err := h.q.Count(ctx)
+		return float64(n), err
+	}
+	if !spaceAggregatable[column] {
+		return 0, fmt.Errorf("cannot %s '%s': not a numeric field of space", fn, column)
+	}
+	agg, err := aggregateColumn(fn, column)
+	if err != nil {
+		return 0, err
+	}
+	return h.q.Aggregate(agg).Float64(ctx)
+}
+
 func (d *spaceDispatcher) Create(ctx context.Context, client *ent.Client, fields map[string]any) (any, error) {
 	builder := client.Space.Create()
 	m := builder.Mutation()
@@ -1,4 +1,5 @@
-// Package meta handles REPL meta-commands (:help, :clear, :env, :history).
+// Package meta handles REPL meta-commands (:help, :clear, :env, :history,
+// :schema, :describe).
 package meta
 
 import (
@@ -39,6 +40,11 @@ func (h *Handler) Execute(sess *session.Session, command string, args []string)
 		return h.history(sess)
 	case "schema":
 		return h.schemaCmd(args)
+	case "describe":
+		if len(args) == 0 {
+			return nil, fmt.Errorf("usage: describe <entity>")
+		}
+		return h.describe(args[0])
 	default:
 		return nil, fmt.Errorf("unknown meta-command ':%s'. Type :help for available commands", command)
 	}
@@ -54,7 +60,11 @@ func (h *Handler) help(args []string) (*Result, error) {
 Queries:
   find <entity> [clauses]  Search for entities
   get <entity> "<id>"      Fetch a single entity by ID
+  get <entity> "<id>".<edge> [include]
+                           Fetch the entities related through an edge
   count <entity> [where]   Count matching entities
+  aggregate <entity> <fn>(<field>) [where]
+                           count(*), sum or avg over matching entities
 
 Mutations:
   create <entity> set <field> = <value> [, ...]
@@ -72,6 +82,9 @@ Clauses (any order):
 Operators: =, !=, >, <, >=, <=, like, in
 Logic: and, or, not
 
+Schema:
+  describe <entity>        Show fields, edges and transitions
+
 Meta-commands:
   :help [topic]    Show help
   :clear           Clear the screen
@@ -83,7 +96,9 @@ Examples:
   find lease where status = "active" limit 10
   find person where first_name like "J%"
   get person "550e8400-e29b-41d4-a716-446655440000"
+  get lease "550e...".lease_spaces include space
   count space where status in ["vacant", "available"]
+  aggregate lease sum(base_rent_amount_cents) where status = "active"
   create portfolio set name = "Main Portfolio"
   update property "550e..." set name = "Updated Name"
   delete building "550e..."`
@@ -96,15 +111,19 @@ func (h *Handler) helpTopic(topic string) (*Result, error) {
 	case "find":
 		return &Result{Output: "find <entity> [where ...] [select ...] [include ...] [order by ...] [limit N] [offset N]"}, nil
 	case "get":
-		return &Result{Output: "get <entity> \"<uuid>\"\n\nFetches a single entity by its UUID."}, nil
+		return &Result{Output: "get <entity> \"<uuid>\"[.<edge>] [include ...]\n\nFetches a single entity by its UUID. With .<edge>, returns the entities\nrelated to it through that edge instead."}, nil
 	case "count":
 		return &Result{Output: "count <entity> [where ...]\n\nReturns the number of matching entities."}, nil
+	case "aggregate":
+		return &Result{Output: "aggregate <entity> count(*) [where ...]\naggregate <entity> sum(<field>) [where ...]\naggregate <entity> avg(<field>) [where ...]\n\nAggregates the matching entities. sum and avg take a numeric field; avg of no rows is 0."}, nil
 	case "create":
 		return &Result{Output: "create <entity> set <field> = <value> [, <field> = <value> ...]\n\nCreates a new entity with the specified field values."}, nil
 	case "update":
 		return &Result{Output: "update <entity> \"<uuid>\" set <field> = <value> [, <field> = <value> ...]\n\nUpdates an existing entity's fields."}, nil
 	case "delete":
 		return &Result{Output: "delete <entity> \"<uuid>\"\n\nDeletes an entity by its UUID."}, nil
+	case "describe":
+		return &Result{Output: "describe <entity>\n\nLists the entity's fields (type, optional, sensitive), edges (target and cardinality)\nand state-machine transitions."}, nil
 	case "where":
 		return &Result{Output: "where <field> <op> <value> [and|or <field> <op> <value> ...]\n\nOperators: =, !=, >, <, >=, <=, like, in\n\nLIKE uses SQL wildcards: % = any characters, _ = single character\n  Example: find person where first_name like \"J%\""}, nil
 	default:
@@ -140,48 +159,58 @@ func (h *Handler) schemaCmd(args []string) (*Result, error) {
 		return &Result{Output: fmt.Sprintf("Entities (%d):\n  %s", len(names), strings.Join(names, "\n  "))}, nil
 	}
 
-	entityName := strings.ToLower(args[0])
+	return h.describe(args[0])
+}
+
+// describe renders an entity's generated schema description.
+func (h *Handler) describe(entity string) (*Result, error) {
+	entityName := strings.ToLower(entity)
 	es := h.registry.Entity(entityName)
 	if es == nil {
 		return nil, fmt.Errorf("unknown entity '%s'", entityName)
 	}
+	d := es.Describe()
 
 	var b strings.Builder
-	fmt.Fprintf(&b, "Entity: %s (%s)\n", es.Name, es.EntName)
-	if es.HasStateMachine {
+	fmt.Fprintf(&b, "Entity: %s (%s)\n", d.Entity, d.EntName)
+	if len(d.Transitions) > 0 {
 		fmt.Fprintf(&b, "State Machine: yes\n")
 	}
-	if es.Immutable {
+	if d.Immutable {
 		fmt.Fprintf(&b, "Immutable: yes\n")
 	}
 
 	fmt.Fprintf(&b, "\nFields:\n")
-	for _, fname := range es.FieldOrder {
-		fm := es.Fields[fname]
-		opt := ""
-		if fm.Optional {
-			opt = " (optional)"
+	for _, f := range d.Fields {
+		flags := ""
+		if f.Optional {
+			flags += " (optional)"
+		}
+		if f.Sensitive {
+			flags += " (sensitive)"
 		}
-		extra := ""
-		if fm.Type == schema.FieldEnum && len(fm.EnumValues) > 0 {
-			data, _ := json.Marshal(fm.EnumValues)
-			extra = " values=" + string(data)
+		if len(f.EnumValues) > 0 {
+			data, _ := json.Marshal(f.EnumValues)
+			flags += " values=" + string(data)
 		}
-		fmt.Fprintf(&b, "  %-30s %s%s%s\n", fname, fm.Type, opt, extra)
+		fmt.Fprintf(&b, "  %-30s %s%s\n", f.Name, f.Type, flags)
 	}
 
-	if len(es.EdgeOrder) > 0 {
+	if len(d.Edges) > 0 {
 		fmt.Fprintf(&b, "\nEdges:\n")
-		for _, ename := range es.EdgeOrder {
-			em := es.Edges[ename]
-			fmt.Fprintf(&b, "  %-30s -> %s (%s)\n", ename, em.Target, em.Cardinality)
+		for _, e := range d.Edges {
+			fmt.Fprintf(&b, "  %-30s -> %s (%s)\n", e.Name, e.Target, e.Cardinality)
 		}
 	}
 
-	if es.HasStateMachine && es.StateMachine != nil {
+	if len(d.Transitions) > 0 {
 		fmt.Fprintf(&b, "\nState Machine:\n")
-		for from, targets := range es.StateMachine {
-			fmt.Fprintf(&b, "  %s -> %s\n", from, strings.Join(targets, ", "))
+		for _, t := range d.Transitions {
+			to := "(terminal)"
+			if len(t.To) > 0 {
+				to = strings.Join(t.To, ", ")
+			}
+			fmt.Fprintf(&b, "  %s -> %s\n", t.From, to)
 		}
 	}
 
@@ -13,6 +13,8 @@ const (
 	PlanCreate
 	PlanUpdate
 	PlanDelete
+	PlanTraverse
+	PlanAggregate
 )
 
 // QueryPlan is the validated, resolved plan ready for the executor.
@@ -28,9 +30,21 @@ type QueryPlan struct {
 	Limit      int // 0 = use default
 	Offset     int
 
-	// For PlanGet
+	// ShowSensitive returns @sensitive()/@pii() values unmasked (--show-sensitive).
+	ShowSensitive bool
+
+	// For PlanGet / PlanTraverse
 	ID string // UUID string
 
+	// For PlanTraverse: the source entity's edge to follow. Entity is the
+	// source; Edges and Limit apply to the target's rows.
+	Edge string
+
+	// For PlanAggregate: count, sum or avg, and the Ent column it reads
+	// ("" for count). Predicates filter the aggregated rows.
+	AggregateFunc   string
+	AggregateColumn string
+
 	// For PlanMeta
 	MetaCommand string
 	MetaArgs    []string
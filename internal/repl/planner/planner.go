@@ -32,6 +32,8 @@ func (p *Planner) Plan(stmt pql.Statement) (*QueryPlan, error) {
 		return p.planGet(s)
 	case *pql.CountStmt:
 		return p.planCount(s)
+	case *pql.AggregateStmt:
+		return p.planAggregate(s)
 	case *pql.CreateStmt:
 		return p.planCreate(s)
 	case *pql.UpdateStmt:
@@ -97,6 +99,12 @@ func (p *Planner) planFind(stmt *pql.FindStmt) (*QueryPlan, error) {
 			if err != nil {
 				return nil, err
 			}
+			if fm := es.Fields[item.Field.Parts[0]]; fm != nil && !fm.Orderable() {
+				if fm.EdgeFK {
+					return nil, fmt.Errorf("cannot order %s by '%s': it is a relationship, not a column", es.Name, fm.Name)
+				}
+				return nil, fmt.Errorf("cannot order %s by %s field '%s'", es.Name, fm.Type, fm.Name)
+			}
 			plan.OrderBy = append(plan.OrderBy, OrderSpec{Field: colName, Desc: item.Desc})
 		}
 	}
@@ -122,6 +130,10 @@ func (p *Planner) planGet(stmt *pql.GetStmt) (*QueryPlan, error) {
 		return nil, err
 	}
 
+	if stmt.Edge != "" {
+		return p.planTraverse(es, stmt)
+	}
+
 	plan := &QueryPlan{
 		Type:   PlanGet,
 		Entity: es.Name,
@@ -141,6 +153,42 @@ func (p *Planner) planGet(stmt *pql.GetStmt) (*QueryPlan, error) {
 	return plan, nil
 }
 
+// planTraverse plans get <entity> "<id>".<edge>: the rows related to one
+// entity through an edge. Includes resolve against the edge's target.
+func (p *Planner) planTraverse(es *schema.EntitySchema, stmt *pql.GetStmt) (*QueryPlan, error) {
+	edgeName, err := p.resolveEdge(es, pql.EdgePath{Parts: []string{stmt.Edge}})
+	if err != nil {
+		return nil, err
+	}
+	em := es.Edges[edgeName]
+
+	plan := &QueryPlan{
+		Type:   PlanTraverse,
+		Entity: es.Name,
+		ID:     stmt.ID,
+		Edge:   edgeName,
+	}
+	if !em.Unique {
+		plan.Limit = DefaultLimit
+	}
+
+	if stmt.Include != nil {
+		target, err := p.resolveEntity(em.Target)
+		if err != nil {
+			return nil, err
+		}
+		for _, ep := range stmt.Include.Edges {
+			name, err := p.resolveEdge(target, ep)
+			if err != nil {
+				return nil, err
+			}
+			plan.Edges = append(plan.Edges, name)
+		}
+	}
+
+	return plan, nil
+}
+
 // ── count ────────────────────────────────────────────────────────────────────
 
 func (p *Planner) planCount(stmt *pql.CountStmt) (*QueryPlan, error) {
@@ -165,6 +213,38 @@ func (p *Planner) planCount(stmt *pql.CountStmt) (*QueryPlan, error) {
 	return plan, nil
 }
 
+// ── aggregate ────────────────────────────────────────────────────────────────
+
+func (p *Planner) planAggregate(stmt *pql.AggregateStmt) (*QueryPlan, error) {
+	es, err := p.resolveEntity(stmt.Entity)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := p.registry.ValidateAggregate(es.Name, stmt.Func, stmt.Field); err != nil {
+		return nil, err
+	}
+
+	plan := &QueryPlan{
+		Type:          PlanAggregate,
+		Entity:        es.Name,
+		AggregateFunc: stmt.Func,
+	}
+	if stmt.Field != "" {
+		plan.AggregateColumn = es.Fields[stmt.Field].EntColumn
+	}
+
+	if stmt.Where != nil {
+		preds, err := p.resolvePredicates(es, stmt.Where.Expr)
+		if err != nil {
+			return nil, err
+		}
+		plan.Predicates = preds
+	}
+
+	return plan, nil
+}
+
 // ── create ────────────────────────────────────────────────────────────────────
 
 func (p *Planner) planCreate(stmt *pql.CreateStmt) (*QueryPlan, error) {
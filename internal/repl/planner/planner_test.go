@@ -157,6 +157,25 @@ func TestPlanner_FindWithOrderBy(t *testing.T) {
 	assert.True(t, plan.OrderBy[0].Desc)
 }
 
+func TestPlanner_OrderByUnorderableField(t *testing.T) {
+	reg := testRegistry()
+	lease := reg.Entity("lease")
+	lease.Fields["property_id"] = &schema.FieldMeta{Name: "property_id", EntColumn: "property_id", Type: schema.FieldString, EdgeFK: true}
+	lease.Fields["cam_terms"] = &schema.FieldMeta{Name: "cam_terms", EntColumn: "cam_terms", Type: schema.FieldJSON}
+
+	for input, want := range map[string]string{
+		"find lease order by property_id": "it is a relationship",
+		"find lease order by cam_terms":   "cannot order lease by json field 'cam_terms'",
+		"find lease order by stauts":      "unknown field 'stauts'",
+	} {
+		tokens, _ := pql.NewLexer(input).Tokenize()
+		stmts, _ := pql.NewParser(tokens).Parse()
+		_, err := New(reg).Plan(stmts[0])
+		require.Error(t, err, input)
+		assert.Contains(t, err.Error(), want, input)
+	}
+}
+
 func TestPlanner_FindWithLimit(t *testing.T) {
 	reg := testRegistry()
 	plan := planPQL(t, reg, "find lease limit 25")
@@ -180,6 +199,31 @@ func TestPlanner_GetBasic(t *testing.T) {
 	assert.Equal(t, "550e8400-e29b-41d4-a716-446655440000", plan.ID)
 }
 
+func TestPlanner_GetEdgeTraversal(t *testing.T) {
+	reg := testRegistry()
+	plan := planPQL(t, reg, `get lease "550e8400-e29b-41d4-a716-446655440000".lease_spaces`)
+
+	assert.Equal(t, PlanTraverse, plan.Type)
+	assert.Equal(t, "lease", plan.Entity)
+	assert.Equal(t, "550e8400-e29b-41d4-a716-446655440000", plan.ID)
+	assert.Equal(t, "lease_spaces", plan.Edge)
+	assert.Equal(t, DefaultLimit, plan.Limit)
+}
+
+func TestPlanner_GetUnknownEdgeTraversal(t *testing.T) {
+	reg := testRegistry()
+	lexer := pql.NewLexer(`get lease "550e8400-e29b-41d4-a716-446655440000".lease_spacez`)
+	tokens, _ := lexer.Tokenize()
+	parser := pql.NewParser(tokens)
+	stmts, _ := parser.Parse()
+
+	planner := New(reg)
+	_, err := planner.Plan(stmts[0])
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "unknown edge 'lease_spacez'")
+	assert.Contains(t, err.Error(), "did you mean")
+}
+
 func TestPlanner_CountBasic(t *testing.T) {
 	reg := testRegistry()
 	plan := planPQL(t, reg, "count lease")
@@ -188,6 +232,40 @@ func TestPlanner_CountBasic(t *testing.T) {
 	assert.Equal(t, "lease", plan.Entity)
 }
 
+func TestPlanner_AggregateSum(t *testing.T) {
+	reg := testRegistry()
+	plan := planPQL(t, reg, `aggregate lease sum(base_rent_amount_cents) where status = "active"`)
+
+	assert.Equal(t, PlanAggregate, plan.Type)
+	assert.Equal(t, "lease", plan.Entity)
+	assert.Equal(t, "sum", plan.AggregateFunc)
+	assert.Equal(t, "base_rent_amount_cents", plan.AggregateColumn)
+	require.Len(t, plan.Predicates, 1)
+	assert.Equal(t, "status", plan.Predicates[0].Field)
+}
+
+func TestPlanner_AggregateCount(t *testing.T) {
+	reg := testRegistry()
+	plan := planPQL(t, reg, "aggregate lease count(*)")
+
+	assert.Equal(t, PlanAggregate, plan.Type)
+	assert.Equal(t, "count", plan.AggregateFunc)
+	assert.Empty(t, plan.AggregateColumn)
+}
+
+func TestPlanner_AggregateNonNumericField(t *testing.T) {
+	reg := testRegistry()
+	lexer := pql.NewLexer("aggregate lease avg(status)")
+	tokens, _ := lexer.Tokenize()
+	parser := pql.NewParser(tokens)
+	stmts, _ := parser.Parse()
+
+	planner := New(reg)
+	_, err := planner.Plan(stmts[0])
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "cannot avg enum field 'status'")
+}
+
 func TestPlanner_Meta(t *testing.T) {
 	reg := testRegistry()
 	plan := planPQL(t, reg, ":help")
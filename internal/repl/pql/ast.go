@@ -32,11 +32,12 @@ func (s *FindStmt) nodeType() string { return "FindStmt" }
 func (s *FindStmt) Pos() int         { return s.TokenPos }
 func (s *FindStmt) stmtNode()        {}
 
-// GetStmt represents: get <entity> <id>
+// GetStmt represents: get <entity> <id>[.<edge>] [include ...]
 type GetStmt struct {
 	TokenPos int
 	Entity   string
 	ID       string
+	Edge     string // edge to traverse from the entity ("" = the entity itself)
 	Include  *IncludeClause
 }
 
@@ -55,6 +56,19 @@ func (s *CountStmt) nodeType() string { return "CountStmt" }
 func (s *CountStmt) Pos() int         { return s.TokenPos }
 func (s *CountStmt) stmtNode()        {}
 
+// AggregateStmt represents: aggregate <entity> <fn>(<field>|*) [where ...]
+type AggregateStmt struct {
+	TokenPos int
+	Entity   string
+	Func     string // count, sum or avg
+	Field    string // "" for count() and count(*)
+	Where    *WhereClause
+}
+
+func (s *AggregateStmt) nodeType() string { return "AggregateStmt" }
+func (s *AggregateStmt) Pos() int         { return s.TokenPos }
+func (s *AggregateStmt) stmtNode()        {}
+
 // MetaCmdStmt represents: :<command> [args...]
 type MetaCmdStmt struct {
 	TokenPos int
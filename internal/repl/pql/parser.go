@@ -115,6 +115,8 @@ func (p *Parser) parseStatement() Statement {
 		return p.parseGet()
 	case TokenCount:
 		return p.parseCount()
+	case TokenAggregate:
+		return p.parseAggregate()
 	case TokenCreate:
 		return p.parseCreate()
 	case TokenUpdate:
@@ -123,17 +125,19 @@ func (p *Parser) parseStatement() Statement {
 		return p.parseDelete()
 	case TokenMetaCmd:
 		return p.parseMetaCmd()
+	case TokenDescribe:
+		return p.parseDescribe()
 
 	// Future verbs — produce clear errors
-	case TokenRun, TokenDescribe, TokenExplain,
-		TokenHistory, TokenDiff, TokenAggregate, TokenWatch:
+	case TokenRun, TokenExplain,
+		TokenHistory, TokenDiff, TokenWatch:
 		p.addError(tok, fmt.Sprintf("'%s' is not yet implemented", tok.Literal))
 		p.advance()
 		p.synchronize()
 		return nil
 
 	default:
-		p.addError(tok, fmt.Sprintf("expected a PQL verb (find, get, count, create, update, delete) or meta-command, got %s", tok.Type))
+		p.addError(tok, fmt.Sprintf("expected a PQL verb (find, get, count, aggregate, create, update, delete) or meta-command, got %s", tok.Type))
 		p.advance()
 		p.synchronize()
 		return nil
@@ -238,6 +242,17 @@ func (p *Parser) parseGet() *GetStmt {
 	}
 	stmt.ID = idTok.Literal
 
+	// Optional edge traversal: "<id>".<edge>
+	if p.check(TokenDot) {
+		p.advance() // consume '.'
+		edgeTok, ok := p.expect(TokenIdent)
+		if !ok {
+			p.synchronize()
+			return nil
+		}
+		stmt.Edge = strings.ToLower(edgeTok.Literal)
+	}
+
 	// Optional include
 	if p.check(TokenInclude) {
 		stmt.Include = p.parseInclude()
@@ -268,6 +283,51 @@ func (p *Parser) parseCount() *CountStmt {
 	return stmt
 }
 
+// ── aggregate ────────────────────────────────────────────────────────────────
+
+func (p *Parser) parseAggregate() *AggregateStmt {
+	tok := p.advance() // consume 'aggregate'
+	stmt := &AggregateStmt{TokenPos: tok.Pos}
+
+	// Entity name
+	entTok, ok := p.expect(TokenIdent)
+	if !ok {
+		p.synchronize()
+		return nil
+	}
+	stmt.Entity = strings.ToLower(entTok.Literal)
+
+	// Function name: count lexes as its verb keyword, sum/avg as identifiers
+	fnTok, ok := p.match(TokenCount, TokenIdent)
+	if !ok {
+		p.addError(p.peek(), fmt.Sprintf("expected an aggregate function (count, sum, avg), got %s", p.peek().Type))
+		p.synchronize()
+		return nil
+	}
+	stmt.Func = strings.ToLower(fnTok.Literal)
+
+	if _, ok := p.expect(TokenLParen); !ok {
+		p.synchronize()
+		return nil
+	}
+	if fieldTok, ok := p.match(TokenIdent); ok {
+		stmt.Field = strings.ToLower(fieldTok.Literal)
+	} else {
+		p.match(TokenStar)
+	}
+	if _, ok := p.expect(TokenRParen); !ok {
+		p.synchronize()
+		return nil
+	}
+
+	// Optional where
+	if p.check(TokenWhere) {
+		stmt.Where = p.parseWhere()
+	}
+
+	return stmt
+}
+
 // ── meta-command ─────────────────────────────────────────────────────────────
 
 func (p *Parser) parseMetaCmd() *MetaCmdStmt {
@@ -286,6 +346,24 @@ func (p *Parser) parseMetaCmd() *MetaCmdStmt {
 	return stmt
 }
 
+// ── describe ─────────────────────────────────────────────────────────────────
+
+// parseDescribe parses `describe <entity>`. Describing is schema introspection
+// rather than a query, so it becomes the equivalent of `:describe <entity>`.
+func (p *Parser) parseDescribe() *MetaCmdStmt {
+	tok := p.advance() // consume 'describe'
+	entTok, ok := p.expect(TokenIdent)
+	if !ok {
+		p.synchronize()
+		return nil
+	}
+	return &MetaCmdStmt{
+		TokenPos: tok.Pos,
+		Command:  "describe",
+		Args:     []string{strings.ToLower(entTok.Literal)},
+	}
+}
+
 // ── create ────────────────────────────────────────────────────────────────────
 
 func (p *Parser) parseCreate() *CreateStmt {
@@ -186,6 +186,16 @@ func TestParser_GetWithInclude(t *testing.T) {
 	assert.Len(t, getStmt.Include.Edges, 1)
 }
 
+func TestParser_GetEdgeTraversal(t *testing.T) {
+	stmts := parse(t, `get lease "some-id".Lease_Spaces include space`)
+	getStmt := stmts[0].(*GetStmt)
+
+	assert.Equal(t, "some-id", getStmt.ID)
+	assert.Equal(t, "lease_spaces", getStmt.Edge)
+	require.NotNil(t, getStmt.Include)
+	assert.Equal(t, "space", getStmt.Include.Edges[0].String())
+}
+
 func TestParser_CountBasic(t *testing.T) {
 	stmts := parse(t, "count lease")
 	require.Len(t, stmts, 1)
@@ -203,6 +213,35 @@ func TestParser_CountWithWhere(t *testing.T) {
 	require.NotNil(t, countStmt.Where)
 }
 
+func TestParser_AggregateSum(t *testing.T) {
+	stmts := parse(t, `aggregate lease sum(Base_Rent_Amount_Cents) where status = "active"`)
+	require.Len(t, stmts, 1)
+
+	agg, ok := stmts[0].(*AggregateStmt)
+	require.True(t, ok)
+	assert.Equal(t, "lease", agg.Entity)
+	assert.Equal(t, "sum", agg.Func)
+	assert.Equal(t, "base_rent_amount_cents", agg.Field)
+	require.NotNil(t, agg.Where)
+}
+
+func TestParser_AggregateCount(t *testing.T) {
+	for _, input := range []string{"aggregate lease count()", "aggregate lease count(*)"} {
+		agg := parse(t, input)[0].(*AggregateStmt)
+		assert.Equal(t, "count", agg.Func, input)
+		assert.Empty(t, agg.Field, input)
+	}
+}
+
+func TestParser_AggregateMissingParen(t *testing.T) {
+	lexer := NewLexer("aggregate lease sum base_rent_amount_cents")
+	tokens, _ := lexer.Tokenize()
+	parser := NewParser(tokens)
+	_, errs := parser.Parse()
+	require.NotEmpty(t, errs)
+	assert.Contains(t, errs[0].Message, "expected (")
+}
+
 func TestParser_MetaCommand(t *testing.T) {
 	stmts := parse(t, ":help find")
 	require.Len(t, stmts, 1)
@@ -243,6 +282,16 @@ func TestParser_ParenthesizedExpression(t *testing.T) {
 	assert.Equal(t, LogicOr, leftLogic.Op)
 }
 
+func TestParser_DescribeIsMetaCommand(t *testing.T) {
+	stmts := parse(t, "describe Lease")
+	require.Len(t, stmts, 1)
+
+	meta, ok := stmts[0].(*MetaCmdStmt)
+	require.True(t, ok)
+	assert.Equal(t, "describe", meta.Command)
+	assert.Equal(t, []string{"lease"}, meta.Args)
+}
+
 func TestParser_DuplicateClauseError(t *testing.T) {
 	lexer := NewLexer(`find lease where status = "active" where status = "draft"`)
 	tokens, _ := lexer.Tokenize()
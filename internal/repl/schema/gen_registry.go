@@ -15,6 +15,7 @@ func InitRegistry() *Registry {
 				Type:      FieldString,
 				Optional:  false,
 				Sensitive: false,
+				Formatter: FormatPlain,
 			},
 			"name": {
 				Name:      "name",
@@ -22,6 +23,7 @@ func InitRegistry() *Registry {
 				Type:      FieldString,
 				Optional:  false,
 				Sensitive: false,
+				Formatter: FormatPlain,
 			},
 			"description": {
 				Name:      "description",
@@ -29,6 +31,7 @@ func InitRegistry() *Registry {
 				Type:      FieldString,
 				Optional:  true,
 				Sensitive: false,
+				Formatter: FormatPlain,
 			},
 			"account_type": {
 				Name:       "account_type",
@@ -37,6 +40,7 @@ func InitRegistry() *Registry {
 				Optional:   false,
 				Sensitive:  false,
 				EnumValues: []string{"asset", "liability", "equity", "revenue", "expense"},
+				Formatter:  FormatEnum,
 			},
 			"account_subtype": {
 				Name:       "account_subtype",
@@ -45,6 +49,7 @@ func InitRegistry() *Registry {
 				Optional:   false,
 				Sensitive:  false,
 				EnumValues: []string{"cash", "accounts_receivable", "prepaid", "fixed_asset", "accumulated_depreciation", "other_asset", "accounts_payable", "accrued_liability", "unearned_revenue", "security_deposits_held", "other_liability", "owners_equity", "retained_earnings", "distributions", "rental_income", "other_income", "cam_recovery", "percentage_rent_income", "operating_expense", "maintenance_expense", "utility_expense", "management_fee_expense", "depreciation_expense", "other_expense"},
+				Formatter:  FormatEnum,
 			},
 			"parent_account_id": {
 				Name:      "parent_account_id",
@@ -52,6 +57,7 @@ func InitRegistry() *Registry {
 				Type:      FieldString,
 				Optional:  true,
 				Sensitive: false,
+				Formatter: FormatPlain,
 			},
 			"depth": {
 				Name:      "depth",
@@ -59,6 +65,7 @@ func InitRegistry() *Registry {
 				Type:      FieldInt,
 				Optional:  false,
 				Sensitive: false,
+				Formatter: FormatPlain,
 			},
 			"dimensions": {
 				Name:      "dimensions",
@@ -66,6 +73,7 @@ func InitRegistry() *Registry {
 				Type:      FieldJSON,
 				Optional:  true,
 				Sensitive: false,
+				Formatter: FormatJSON,
 			},
 			"normal_balance": {
 				Name:       "normal_balance",
@@ -74,6 +82,7 @@ func InitRegistry() *Registry {
 				Optional:   false,
 				Sensitive:  false,
 				EnumValues: []string{"debit", "credit"},
+				Formatter:  FormatEnum,
 			},
 			"is_header": {
 				Name:      "is_header",
@@ -81,6 +90,7 @@ func InitRegistry() *Registry {
 				Type:      FieldBool,
 				Optional:  false,
 				Sensitive: false,
+				Formatter: FormatPlain,
 			},
 			"is_system": {
 				Name:      "is_system",
@@ -88,6 +98,7 @@ func InitRegistry() *Registry {
 				Type:      FieldBool,
 				Optional:  false,
 				Sensitive: false,
+				Formatter: FormatPlain,
 			},
 			"allows_direct_posting": {
 				Name:      "allows_direct_posting",
@@ -95,6 +106,7 @@ func InitRegistry() *Registry {
 				Type:      FieldBool,
 				Optional:  false,
 				Sensitive: false,
+				Formatter: FormatPlain,
 			},
 			"status": {
 				Name:       "status",
@@ -103,6 +115,7 @@ func InitRegistry() *Registry {
 				Optional:   false,
 				Sensitive:  false,
 				EnumValues: []string{"active", "inactive", "archived"},
+				Formatter:  FormatEnum,
 			},
 			"is_trust_account": {
 				Name:      "is_trust_account",
@@ -110,6 +123,7 @@ func InitRegistry() *Registry {
 				Type:      FieldBool,
 				Optional:  false,
 				Sensitive: false,
+				Formatter: FormatPlain,
 			},
 			"trust_type": {
 				Name:       "trust_type",
@@ -118,20 +132,25 @@ func InitRegistry() *Registry {
 				Optional:   true,
 				Sensitive:  false,
 				EnumValues: []string{"operating", "security_deposit", "escrow"},
+				Formatter:  FormatEnum,
 			},
 			"budget_amount_amount_cents": {
-				Name:      "budget_amount_amount_cents",
-				EntColumn: "budget_amount_amount_cents",
-				Type:      FieldInt64,
-				Optional:  true,
-				Sensitive: false,
+				Name:       "budget_amount_amount_cents",
+				EntColumn:  "budget_amount_amount_cents",
+				Type:       FieldInt64,
+				Optional:   true,
+				Sensitive:  false,
+				Formatter:  FormatMoneyPair,
+				MoneyGroup: "budget_amount",
 			},
 			"budget_amount_currency": {
-				Name:      "budget_amount_currency",
-				EntColumn: "budget_amount_currency",
-				Type:      FieldString,
-				Optional:  true,
-				Sensitive: false,
+				Name:       "budget_amount_currency",
+				EntColumn:  "budget_amount_currency",
+				Type:       FieldString,
+				Optional:   true,
+				Sensitive:  false,
+				Formatter:  FormatMoneyPair,
+				MoneyGroup: "budget_amount",
 			},
 			"tax_line": {
 				Name:      "tax_line",
@@ -139,6 +158,7 @@ func InitRegistry() *Registry {
 				Type:      FieldString,
 				Optional:  true,
 				Sensitive: false,
+				Formatter: FormatPlain,
 			},
 		},
 		FieldOrder: []string{
@@ -193,8 +213,43 @@ func InitRegistry() *Registry {
 			"entries",
 			"bank_accounts",
 		},
+		Aggregatable: []string{
+			"depth",
+			"budget_amount_amount_cents",
+		},
 		HasStateMachine: false,
 		Immutable:       false,
+		description: Description{
+			Entity:    "account",
+			EntName:   "Account",
+			Immutable: false,
+			Fields: []FieldDescription{
+				{Name: "account_number", Type: FieldString, Optional: false, Sensitive: false},
+				{Name: "name", Type: FieldString, Optional: false, Sensitive: false},
+				{Name: "description", Type: FieldString, Optional: true, Sensitive: false},
+				{Name: "account_type", Type: FieldEnum, Optional: false, Sensitive: false, EnumValues: []string{"asset", "liability", "equity", "revenue", "expense"}},
+				{Name: "account_subtype", Type: FieldEnum, Optional: false, Sensitive: false, EnumValues: []string{"cash", "accounts_receivable", "prepaid", "fixed_asset", "accumulated_depreciation", "other_asset", "accounts_payable", "accrued_liability", "unearned_revenue", "security_deposits_held", "other_liability", "owners_equity", "retained_earnings", "distributions", "rental_income", "other_income", "cam_recovery", "percentage_rent_income", "operating_expense", "maintenance_expense", "utility_expense", "management_fee_expense", "depreciation_expense", "other_expense"}},
+				{Name: "parent_account_id", Type: FieldString, Optional: true, Sensitive: false},
+				{Name: "depth", Type: FieldInt, Optional: false, Sensitive: false},
+				{Name: "dimensions", Type: FieldJSON, Optional: true, Sensitive: false},
+				{Name: "normal_balance", Type: FieldEnum, Optional: false, Sensitive: false, EnumValues: []string{"debit", "credit"}},
+				{Name: "is_header", Type: FieldBool, Optional: false, Sensitive: false},
+				{Name: "is_system", Type: FieldBool, Optional: false, Sensitive: false},
+				{Name: "allows_direct_posting", Type: FieldBool, Optional: false, Sensitive: false},
+				{Name: "status", Type: FieldEnum, Optional: false, Sensitive: false, EnumValues: []string{"active", "inactive", "archived"}},
+				{Name: "is_trust_account", Type: FieldBool, Optional: false, Sensitive: false},
+				{Name: "trust_type", Type: FieldEnum, Optional: true, Sensitive: false, EnumValues: []string{"operating", "security_deposit", "escrow"}},
+				{Name: "budget_amount_amount_cents", Type: FieldInt64, Optional: true, Sensitive: false},
+				{Name: "budget_amount_currency", Type: FieldString, Optional: true, Sensitive: false},
+				{Name: "tax_line", Type: FieldString, Optional: true, Sensitive: false},
+			},
+			Edges: []EdgeDescription{
+				{Name: "children", Target: "account", Cardinality: "O2M"},
+				{Name: "parent", Target: "account", Cardinality: "M2O"},
+				{Name: "entries", Target: "ledger_entry", Cardinality: "O2M"},
+				{Name: "bank_accounts", Target: "bank_account", Cardinality: "O2M"},
+			},
+		},
 	})
 
 	r.Register(&EntitySchema{
@@ -207,6 +262,8 @@ func InitRegistry() *Registry {
 				Type:      FieldString,
 				Optional:  false,
 				Sensitive: false,
+				Formatter: FormatPlain,
+				EdgeFK:    true,
 			},
 			"space_id": {
 				Name:      "space_id",
@@ -214,6 +271,8 @@ func InitRegistry() *Registry {
 				Type:      FieldString,
 				Optional:  true,
 				Sensitive: false,
+				Formatter: FormatPlain,
+				EdgeFK:    true,
 			},
 			"applicant_person_id": {
 				Name:      "applicant_person_id",
@@ -221,6 +280,7 @@ func InitRegistry() *Registry {
 				Type:      FieldString,
 				Optional:  false,
 				Sensitive: false,
+				Formatter: FormatPlain,
 			},
 			"status": {
 				Name:       "status",
@@ -229,6 +289,7 @@ func InitRegistry() *Registry {
 				Optional:   false,
 				Sensitive:  false,
 				EnumValues: []string{"submitted", "screening", "under_review", "approved", "conditionally_approved", "denied", "withdrawn", "expired"},
+				Formatter:  FormatEnum,
 			},
 			"desired_move_in": {
 				Name:      "desired_move_in",
@@ -236,6 +297,7 @@ func InitRegistry() *Registry {
 				Type:      FieldTime,
 				Optional:  false,
 				Sensitive: false,
+				Formatter: FormatTimestamp,
 			},
 			"desired_lease_term_months": {
 				Name:      "desired_lease_term_months",
@@ -243,6 +305,7 @@ func InitRegistry() *Registry {
 				Type:      FieldInt,
 				Optional:  false,
 				Sensitive: false,
+				Formatter: FormatPlain,
 			},
 			"screening_request_id": {
 				Name:      "screening_request_id",
@@ -250,6 +313,7 @@ func InitRegistry() *Registry {
 				Type:      FieldString,
 				Optional:  true,
 				Sensitive: false,
+				Formatter: FormatPlain,
 			},
 			"screening_completed": {
 				Name:      "screening_completed",
@@ -257,6 +321,7 @@ func InitRegistry() *Registry {
 				Type:      FieldTime,
 				Optional:  true,
 				Sensitive: false,
+				Formatter: FormatTimestamp,
 			},
 			"credit_score": {
 				Name:      "credit_score",
@@ -264,6 +329,7 @@ func InitRegistry() *Registry {
 				Type:      FieldInt,
 				Optional:  true,
 				Sensitive: false,
+				Formatter: FormatPlain,
 			},
 			"background_clear": {
 				Name:      "background_clear",
@@ -271,6 +337,7 @@ func InitRegistry() *Registry {
 				Type:      FieldBool,
 				Optional:  false,
 				Sensitive: false,
+				Formatter: FormatPlain,
 			},
 			"income_verified": {
 				Name:      "income_verified",
@@ -278,6 +345,7 @@ func InitRegistry() *Registry {
 				Type:      FieldBool,
 				Optional:  false,
 				Sensitive: false,
+				Formatter: FormatPlain,
 			},
 			"income_to_rent_ratio": {
 				Name:      "income_to_rent_ratio",
@@ -285,6 +353,7 @@ func InitRegistry() *Registry {
 				Type:      FieldFloat,
 				Optional:  true,
 				Sensitive: false,
+				Formatter: FormatPlain,
 			},
 			"decision_by": {
 				Name:      "decision_by",
@@ -292,6 +361,7 @@ func InitRegistry() *Registry {
 				Type:      FieldString,
 				Optional:  true,
 				Sensitive: false,
+				Formatter: FormatPlain,
 			},
 			"decision_at": {
 				Name:      "decision_at",
@@ -299,6 +369,7 @@ func InitRegistry() *Registry {
 				Type:      FieldTime,
 				Optional:  true,
 				Sensitive: false,
+				Formatter: FormatTimestamp,
 			},
 			"decision_reason": {
 				Name:      "decision_reason",
@@ -306,6 +377,7 @@ func InitRegistry() *Registry {
 				Type:      FieldString,
 				Optional:  true,
 				Sensitive: false,
+				Formatter: FormatPlain,
 			},
 			"conditions": {
 				Name:      "conditions",
@@ -313,20 +385,25 @@ func InitRegistry() *Registry {
 				Type:      FieldJSON,
 				Optional:  true,
 				Sensitive: false,
+				Formatter: FormatJSON,
 			},
 			"application_fee_amount_cents": {
-				Name:      "application_fee_amount_cents",
-				EntColumn: "application_fee_amount_cents",
-				Type:      FieldInt64,
-				Optional:  false,
-				Sensitive: false,
+				Name:       "application_fee_amount_cents",
+				EntColumn:  "application_fee_amount_cents",
+				Type:       FieldInt64,
+				Optional:   false,
+				Sensitive:  false,
+				Formatter:  FormatMoneyPair,
+				MoneyGroup: "application_fee",
 			},
 			"application_fee_currency": {
-				Name:      "application_fee_currency",
-				EntColumn: "application_fee_currency",
-				Type:      FieldString,
-				Optional:  false,
-				Sensitive: false,
+				Name:       "application_fee_currency",
+				EntColumn:  "application_fee_currency",
+				Type:       FieldString,
+				Optional:   false,
+				Sensitive:  false,
+				Formatter:  FormatMoneyPair,
+				MoneyGroup: "application_fee",
 			},
 			"fee_paid": {
 				Name:      "fee_paid",
@@ -334,6 +411,7 @@ func InitRegistry() *Registry {
 				Type:      FieldBool,
 				Optional:  false,
 				Sensitive: false,
+				Formatter: FormatPlain,
 			},
 		},
 		FieldOrder: []string{
@@ -389,6 +467,12 @@ func InitRegistry() *Registry {
 			"resulting_lease",
 			"applicant",
 		},
+		Aggregatable: []string{
+			"desired_lease_term_months",
+			"credit_score",
+			"income_to_rent_ratio",
+			"application_fee_amount_cents",
+		},
 		HasStateMachine: true,
 		Immutable:       false,
 		StateMachine: map[string][]string{
@@ -401,6 +485,48 @@ func InitRegistry() *Registry {
 			"under_review":           {"approved", "conditionally_approved", "denied", "withdrawn"},
 			"withdrawn":              {},
 		},
+		description: Description{
+			Entity:    "application",
+			EntName:   "Application",
+			Immutable: false,
+			Fields: []FieldDescription{
+				{Name: "property_id", Type: FieldString, Optional: false, Sensitive: false},
+				{Name: "space_id", Type: FieldString, Optional: true, Sensitive: false},
+				{Name: "applicant_person_id", Type: FieldString, Optional: false, Sensitive: false},
+				{Name: "status", Type: FieldEnum, Optional: false, Sensitive: false, EnumValues: []string{"submitted", "screening", "under_review", "approved", "conditionally_approved", "denied", "withdrawn", "expired"}},
+				{Name: "desired_move_in", Type: FieldTime, Optional: false, Sensitive: false},
+				{Name: "desired_lease_term_months", Type: FieldInt, Optional: false, Sensitive: false},
+				{Name: "screening_request_id", Type: FieldString, Optional: true, Sensitive: false},
+				{Name: "screening_completed", Type: FieldTime, Optional: true, Sensitive: false},
+				{Name: "credit_score", Type: FieldInt, Optional: true, Sensitive: false},
+				{Name: "background_clear", Type: FieldBool, Optional: false, Sensitive: false},
+				{Name: "income_verified", Type: FieldBool, Optional: false, Sensitive: false},
+				{Name: "income_to_rent_ratio", Type: FieldFloat, Optional: true, Sensitive: false},
+				{Name: "decision_by", Type: FieldString, Optional: true, Sensitive: false},
+				{Name: "decision_at", Type: FieldTime, Optional: true, Sensitive: false},
+				{Name: "decision_reason", Type: FieldString, Optional: true, Sensitive: false},
+				{Name: "conditions", Type: FieldJSON, Optional: true, Sensitive: false},
+				{Name: "application_fee_amount_cents", Type: FieldInt64, Optional: false, Sensitive: false},
+				{Name: "application_fee_currency", Type: FieldString, Optional: false, Sensitive: false},
+				{Name: "fee_paid", Type: FieldBool, Optional: false, Sensitive: false},
+			},
+			Edges: []EdgeDescription{
+				{Name: "property", Target: "property", Cardinality: "M2O"},
+				{Name: "space", Target: "space", Cardinality: "M2O"},
+				{Name: "resulting_lease", Target: "lease", Cardinality: "O2O"},
+				{Name: "applicant", Target: "person", Cardinality: "M2O"},
+			},
+			Transitions: []TransitionDescription{
+				{From: "approved", To: []string{"expired"}},
+				{From: "conditionally_approved", To: []string{"approved", "denied", "withdrawn", "expired"}},
+				{From: "denied"},
+				{From: "expired"},
+				{From: "screening", To: []string{"under_review", "withdrawn"}},
+				{From: "submitted", To: []string{"screening", "withdrawn"}},
+				{From: "under_review", To: []string{"approved", "conditionally_approved", "denied", "withdrawn"}},
+				{From: "withdrawn"},
+			},
+		},
 	})
 
 	r.Register(&EntitySchema{
@@ -413,6 +539,7 @@ func InitRegistry() *Registry {
 				Type:      FieldString,
 				Optional:  false,
 				Sensitive: false,
+				Formatter: FormatPlain,
 			},
 			"account_type": {
 				Name:       "account_type",
@@ -421,6 +548,7 @@ func InitRegistry() *Registry {
 				Optional:   false,
 				Sensitive:  false,
 				EnumValues: []string{"operating", "trust", "security_deposit", "escrow", "reserve"},
+				Formatter:  FormatEnum,
 			},
 			"gl_account_id": {
 				Name:      "gl_account_id",
@@ -428,6 +556,8 @@ func InitRegistry() *Registry {
 				Type:      FieldString,
 				Optional:  false,
 				Sensitive: false,
+				Formatter: FormatPlain,
+				EdgeFK:    true,
 			},
 			"institution_name": {
 				Name:      "institution_name",
@@ -435,6 +565,7 @@ func InitRegistry() *Registry {
 				Type:      FieldString,
 				Optional:  false,
 				Sensitive: false,
+				Formatter: FormatPlain,
 			},
 			"routing_number": {
 				Name:      "routing_number",
@@ -442,6 +573,7 @@ func InitRegistry() *Registry {
 				Type:      FieldString,
 				Optional:  false,
 				Sensitive: true,
+				Formatter: FormatPlain,
 			},
 			"account_mask": {
 				Name:      "account_mask",
@@ -449,6 +581,7 @@ func InitRegistry() *Registry {
 				Type:      FieldString,
 				Optional:  false,
 				Sensitive: true,
+				Formatter: FormatPlain,
 			},
 			"account_number_encrypted": {
 				Name:      "account_number_encrypted",
@@ -456,6 +589,7 @@ func InitRegistry() *Registry {
 				Type:      FieldString,
 				Optional:  true,
 				Sensitive: true,
+				Formatter: FormatPlain,
 			},
 			"plaid_account_id": {
 				Name:      "plaid_account_id",
@@ -463,6 +597,7 @@ func InitRegistry() *Registry {
 				Type:      FieldString,
 				Optional:  true,
 				Sensitive: false,
+				Formatter: FormatPlain,
 			},
 			"plaid_access_token": {
 				Name:      "plaid_access_token",
@@ -470,6 +605,7 @@ func InitRegistry() *Registry {
 				Type:      FieldString,
 				Optional:  true,
 				Sensitive: true,
+				Formatter: FormatPlain,
 			},
 			"portfolio_id": {
 				Name:      "portfolio_id",
@@ -477,6 +613,8 @@ func InitRegistry() *Registry {
 				Type:      FieldString,
 				Optional:  true,
 				Sensitive: false,
+				Formatter: FormatPlain,
+				EdgeFK:    true,
 			},
 			"property_id": {
 				Name:      "property_id",
@@ -484,6 +622,7 @@ func InitRegistry() *Registry {
 				Type:      FieldString,
 				Optional:  true,
 				Sensitive: false,
+				Formatter: FormatPlain,
 			},
 			"entity_id": {
 				Name:      "entity_id",
@@ -491,6 +630,7 @@ func InitRegistry() *Registry {
 				Type:      FieldString,
 				Optional:  true,
 				Sensitive: false,
+				Formatter: FormatPlain,
 			},
 			"status": {
 				Name:       "status",
@@ -499,6 +639,7 @@ func InitRegistry() *Registry {
 				Optional:   false,
 				Sensitive:  false,
 				EnumValues: []string{"active", "inactive", "frozen", "closed"},
+				Formatter:  FormatEnum,
 			},
 			"is_default": {
 				Name:      "is_default",
@@ -506,6 +647,7 @@ func InitRegistry() *Registry {
 				Type:      FieldBool,
 				Optional:  false,
 				Sensitive: false,
+				Formatter: FormatPlain,
 			},
 			"accepts_deposits": {
 				Name:      "accepts_deposits",
@@ -513,6 +655,7 @@ func InitRegistry() *Registry {
 				Type:      FieldBool,
 				Optional:  false,
 				Sensitive: false,
+				Formatter: FormatPlain,
 			},
 			"accepts_payments": {
 				Name:      "accepts_payments",
@@ -520,20 +663,25 @@ func InitRegistry() *Registry {
 				Type:      FieldBool,
 				Optional:  false,
 				Sensitive: false,
+				Formatter: FormatPlain,
 			},
 			"current_balance_amount_cents": {
-				Name:      "current_balance_amount_cents",
-				EntColumn: "current_balance_amount_cents",
-				Type:      FieldInt64,
-				Optional:  true,
-				Sensitive: false,
+				Name:       "current_balance_amount_cents",
+				EntColumn:  "current_balance_amount_cents",
+				Type:       FieldInt64,
+				Optional:   true,
+				Sensitive:  false,
+				Formatter:  FormatMoneyPair,
+				MoneyGroup: "current_balance",
 			},
 			"current_balance_currency": {
-				Name:      "current_balance_currency",
-				EntColumn: "current_balance_currency",
-				Type:      FieldString,
-				Optional:  true,
-				Sensitive: false,
+				Name:       "current_balance_currency",
+				EntColumn:  "current_balance_currency",
+				Type:       FieldString,
+				Optional:   true,
+				Sensitive:  false,
+				Formatter:  FormatMoneyPair,
+				MoneyGroup: "current_balance",
 			},
 			"last_statement_date": {
 				Name:      "last_statement_date",
@@ -541,6 +689,7 @@ func InitRegistry() *Registry {
 				Type:      FieldTime,
 				Optional:  true,
 				Sensitive: false,
+				Formatter: FormatTimestamp,
 			},
 		},
 		FieldOrder: []string{
@@ -596,6 +745,9 @@ func InitRegistry() *Registry {
 			"gl_account",
 			"reconciliations",
 		},
+		Aggregatable: []string{
+			"current_balance_amount_cents",
+		},
 		HasStateMachine: true,
 		Immutable:       false,
 		StateMachine: map[string][]string{
@@ -604,6 +756,44 @@ func InitRegistry() *Registry {
 			"frozen":   {"active", "closed"},
 			"inactive": {"active", "closed"},
 		},
+		description: Description{
+			Entity:    "bank_account",
+			EntName:   "BankAccount",
+			Immutable: false,
+			Fields: []FieldDescription{
+				{Name: "name", Type: FieldString, Optional: false, Sensitive: false},
+				{Name: "account_type", Type: FieldEnum, Optional: false, Sensitive: false, EnumValues: []string{"operating", "trust", "security_deposit", "escrow", "reserve"}},
+				{Name: "gl_account_id", Type: FieldString, Optional: false, Sensitive: false},
+				{Name: "institution_name", Type: FieldString, Optional: false, Sensitive: false},
+				{Name: "routing_number", Type: FieldString, Optional: false, Sensitive: true},
+				{Name: "account_mask", Type: FieldString, Optional: false, Sensitive: true},
+				{Name: "account_number_encrypted", Type: FieldString, Optional: true, Sensitive: true},
+				{Name: "plaid_account_id", Type: FieldString, Optional: true, Sensitive: false},
+				{Name: "plaid_access_token", Type: FieldString, Optional: true, Sensitive: true},
+				{Name: "portfolio_id", Type: FieldString, Optional: true, Sensitive: false},
+				{Name: "property_id", Type: FieldString, Optional: true, Sensitive: false},
+				{Name: "entity_id", Type: FieldString, Optional: true, Sensitive: false},
+				{Name: "status", Type: FieldEnum, Optional: false, Sensitive: false, EnumValues: []string{"active", "inactive", "frozen", "closed"}},
+				{Name: "is_default", Type: FieldBool, Optional: false, Sensitive: false},
+				{Name: "accepts_deposits", Type: FieldBool, Optional: false, Sensitive: false},
+				{Name: "accepts_payments", Type: FieldBool, Optional: false, Sensitive: false},
+				{Name: "current_balance_amount_cents", Type: FieldInt64, Optional: true, Sensitive: false},
+				{Name: "current_balance_currency", Type: FieldString, Optional: true, Sensitive: false},
+				{Name: "last_statement_date", Type: FieldTime, Optional: true, Sensitive: false},
+			},
+			Edges: []EdgeDescription{
+				{Name: "trust_portfolio", Target: "portfolio", Cardinality: "O2O"},
+				{Name: "properties", Target: "property", Cardinality: "O2M"},
+				{Name: "gl_account", Target: "account", Cardinality: "M2O"},
+				{Name: "reconciliations", Target: "reconciliation", Cardinality: "O2M"},
+			},
+			Transitions: []TransitionDescription{
+				{From: "active", To: []string{"inactive", "frozen", "closed"}},
+				{From: "closed"},
+				{From: "frozen", To: []string{"active", "closed"}},
+				{From: "inactive", To: []string{"active", "closed"}},
+			},
+		},
 	})
 
 	r.Register(&EntitySchema{
@@ -616,6 +806,8 @@ func InitRegistry() *Registry {
 				Type:      FieldString,
 				Optional:  false,
 				Sensitive: false,
+				Formatter: FormatPlain,
+				EdgeFK:    true,
 			},
 			"name": {
 				Name:      "name",
@@ -623,6 +815,7 @@ func InitRegistry() *Registry {
 				Type:      FieldString,
 				Optional:  false,
 				Sensitive: false,
+				Formatter: FormatPlain,
 			},
 			"building_type": {
 				Name:       "building_type",
@@ -631,6 +824,7 @@ func InitRegistry() *Registry {
 				Optional:   false,
 				Sensitive:  false,
 				EnumValues: []string{"residential", "commercial", "mixed_use", "parking_structure", "industrial", "storage", "auxiliary"},
+				Formatter:  FormatEnum,
 			},
 			"address": {
 				Name:      "address",
@@ -638,6 +832,7 @@ func InitRegistry() *Registry {
 				Type:      FieldJSON,
 				Optional:  true,
 				Sensitive: false,
+				Formatter: FormatJSON,
 			},
 			"description": {
 				Name:      "description",
@@ -645,6 +840,7 @@ func InitRegistry() *Registry {
 				Type:      FieldString,
 				Optional:  true,
 				Sensitive: false,
+				Formatter: FormatPlain,
 			},
 			"status": {
 				Name:       "status",
@@ -653,6 +849,7 @@ func InitRegistry() *Registry {
 				Optional:   false,
 				Sensitive:  false,
 				EnumValues: []string{"active", "inactive", "under_renovation"},
+				Formatter:  FormatEnum,
 			},
 			"floors": {
 				Name:      "floors",
@@ -660,6 +857,7 @@ func InitRegistry() *Registry {
 				Type:      FieldInt,
 				Optional:  true,
 				Sensitive: false,
+				Formatter: FormatPlain,
 			},
 			"year_built": {
 				Name:      "year_built",
@@ -667,6 +865,7 @@ func InitRegistry() *Registry {
 				Type:      FieldInt,
 				Optional:  true,
 				Sensitive: false,
+				Formatter: FormatPlain,
 			},
 			"total_square_footage": {
 				Name:      "total_square_footage",
@@ -674,6 +873,7 @@ func InitRegistry() *Registry {
 				Type:      FieldFloat,
 				Optional:  true,
 				Sensitive: false,
+				Formatter: FormatPlain,
 			},
 			"total_rentable_square_footage": {
 				Name:      "total_rentable_square_footage",
@@ -681,6 +881,7 @@ func InitRegistry() *Registry {
 				Type:      FieldFloat,
 				Optional:  true,
 				Sensitive: false,
+				Formatter: FormatPlain,
 			},
 		},
 		FieldOrder: []string{
@@ -713,6 +914,12 @@ func InitRegistry() *Registry {
 			"property",
 			"spaces",
 		},
+		Aggregatable: []string{
+			"floors",
+			"year_built",
+			"total_square_footage",
+			"total_rentable_square_footage",
+		},
 		HasStateMachine: true,
 		Immutable:       false,
 		StateMachine: map[string][]string{
@@ -720,6 +927,32 @@ func InitRegistry() *Registry {
 			"inactive":         {"active"},
 			"under_renovation": {"active"},
 		},
+		description: Description{
+			Entity:    "building",
+			EntName:   "Building",
+			Immutable: false,
+			Fields: []FieldDescription{
+				{Name: "property_id", Type: FieldString, Optional: false, Sensitive: false},
+				{Name: "name", Type: FieldString, Optional: false, Sensitive: false},
+				{Name: "building_type", Type: FieldEnum, Optional: false, Sensitive: false, EnumValues: []string{"residential", "commercial", "mixed_use", "parking_structure", "industrial", "storage", "auxiliary"}},
+				{Name: "address", Type: FieldJSON, Optional: true, Sensitive: false},
+				{Name: "description", Type: FieldString, Optional: true, Sensitive: false},
+				{Name: "status", Type: FieldEnum, Optional: false, Sensitive: false, EnumValues: []string{"active", "inactive", "under_renovation"}},
+				{Name: "floors", Type: FieldInt, Optional: true, Sensitive: false},
+				{Name: "year_built", Type: FieldInt, Optional: true, Sensitive: false},
+				{Name: "total_square_footage", Type: FieldFloat, Optional: true, Sensitive: false},
+				{Name: "total_rentable_square_footage", Type: FieldFloat, Optional: true, Sensitive: false},
+			},
+			Edges: []EdgeDescription{
+				{Name: "property", Target: "property", Cardinality: "M2O"},
+				{Name: "spaces", Target: "space", Cardinality: "O2M"},
+			},
+			Transitions: []TransitionDescription{
+				{From: "active", To: []string{"inactive", "under_renovation"}},
+				{From: "inactive", To: []string{"active"}},
+				{From: "under_renovation", To: []string{"active"}},
+			},
+		},
 	})
 
 	r.Register(&EntitySchema{
@@ -732,6 +965,7 @@ func InitRegistry() *Registry {
 				Type:      FieldTime,
 				Optional:  false,
 				Sensitive: false,
+				Formatter: FormatTimestamp,
 			},
 			"posted_date": {
 				Name:      "posted_date",
@@ -739,6 +973,7 @@ func InitRegistry() *Registry {
 				Type:      FieldTime,
 				Optional:  false,
 				Sensitive: false,
+				Formatter: FormatTimestamp,
 			},
 			"description": {
 				Name:      "description",
@@ -746,6 +981,7 @@ func InitRegistry() *Registry {
 				Type:      FieldString,
 				Optional:  false,
 				Sensitive: false,
+				Formatter: FormatPlain,
 			},
 			"source_type": {
 				Name:       "source_type",
@@ -754,6 +990,7 @@ func InitRegistry() *Registry {
 				Optional:   false,
 				Sensitive:  false,
 				EnumValues: []string{"manual", "auto_charge", "payment", "bank_import", "cam_reconciliation", "depreciation", "accrual", "intercompany", "management_fee", "system"},
+				Formatter:  FormatEnum,
 			},
 			"source_id": {
 				Name:      "source_id",
@@ -761,6 +998,7 @@ func InitRegistry() *Registry {
 				Type:      FieldString,
 				Optional:  true,
 				Sensitive: false,
+				Formatter: FormatPlain,
 			},
 			"status": {
 				Name:       "status",
@@ -769,6 +1007,7 @@ func InitRegistry() *Registry {
 				Optional:   false,
 				Sensitive:  false,
 				EnumValues: []string{"draft", "pending_approval", "posted", "voided"},
+				Formatter:  FormatEnum,
 			},
 			"approved_by": {
 				Name:      "approved_by",
@@ -776,6 +1015,7 @@ func InitRegistry() *Registry {
 				Type:      FieldString,
 				Optional:  true,
 				Sensitive: false,
+				Formatter: FormatPlain,
 			},
 			"approved_at": {
 				Name:      "approved_at",
@@ -783,6 +1023,7 @@ func InitRegistry() *Registry {
 				Type:      FieldTime,
 				Optional:  true,
 				Sensitive: false,
+				Formatter: FormatTimestamp,
 			},
 			"batch_id": {
 				Name:      "batch_id",
@@ -790,6 +1031,7 @@ func InitRegistry() *Registry {
 				Type:      FieldString,
 				Optional:  true,
 				Sensitive: false,
+				Formatter: FormatPlain,
 			},
 			"entity_id": {
 				Name:      "entity_id",
@@ -797,6 +1039,7 @@ func InitRegistry() *Registry {
 				Type:      FieldString,
 				Optional:  true,
 				Sensitive: false,
+				Formatter: FormatPlain,
 			},
 			"property_id": {
 				Name:      "property_id",
@@ -804,6 +1047,7 @@ func InitRegistry() *Registry {
 				Type:      FieldString,
 				Optional:  true,
 				Sensitive: false,
+				Formatter: FormatPlain,
 			},
 			"reverses_journal_id": {
 				Name:      "reverses_journal_id",
@@ -811,6 +1055,7 @@ func InitRegistry() *Registry {
 				Type:      FieldString,
 				Optional:  true,
 				Sensitive: false,
+				Formatter: FormatPlain,
 			},
 			"reversed_by_journal_id": {
 				Name:      "reversed_by_journal_id",
@@ -818,6 +1063,7 @@ func InitRegistry() *Registry {
 				Type:      FieldString,
 				Optional:  true,
 				Sensitive: false,
+				Formatter: FormatPlain,
 			},
 			"lines": {
 				Name:      "lines",
@@ -825,6 +1071,7 @@ func InitRegistry() *Registry {
 				Type:      FieldJSON,
 				Optional:  false,
 				Sensitive: false,
+				Formatter: FormatJSON,
 			},
 		},
 		FieldOrder: []string{
@@ -854,6 +1101,7 @@ func InitRegistry() *Registry {
 		EdgeOrder: []string{
 			"ledger_entries",
 		},
+		Aggregatable:    []string{},
 		HasStateMachine: true,
 		Immutable:       false,
 		StateMachine: map[string][]string{
@@ -862,6 +1110,36 @@ func InitRegistry() *Registry {
 			"posted":           {"voided"},
 			"voided":           {},
 		},
+		description: Description{
+			Entity:    "journal_entry",
+			EntName:   "JournalEntry",
+			Immutable: false,
+			Fields: []FieldDescription{
+				{Name: "entry_date", Type: FieldTime, Optional: false, Sensitive: false},
+				{Name: "posted_date", Type: FieldTime, Optional: false, Sensitive: false},
+				{Name: "description", Type: FieldString, Optional: false, Sensitive: false},
+				{Name: "source_type", Type: FieldEnum, Optional: false, Sensitive: false, EnumValues: []string{"manual", "auto_charge", "payment", "bank_import", "cam_reconciliation", "depreciation", "accrual", "intercompany", "management_fee", "system"}},
+				{Name: "source_id", Type: FieldString, Optional: true, Sensitive: false},
+				{Name: "status", Type: FieldEnum, Optional: false, Sensitive: false, EnumValues: []string{"draft", "pending_approval", "posted", "voided"}},
+				{Name: "approved_by", Type: FieldString, Optional: true, Sensitive: false},
+				{Name: "approved_at", Type: FieldTime, Optional: true, Sensitive: false},
+				{Name: "batch_id", Type: FieldString, Optional: true, Sensitive: false},
+				{Name: "entity_id", Type: FieldString, Optional: true, Sensitive: false},
+				{Name: "property_id", Type: FieldString, Optional: true, Sensitive: false},
+				{Name: "reverses_journal_id", Type: FieldString, Optional: true, Sensitive: false},
+				{Name: "reversed_by_journal_id", Type: FieldString, Optional: true, Sensitive: false},
+				{Name: "lines", Type: FieldJSON, Optional: false, Sensitive: false},
+			},
+			Edges: []EdgeDescription{
+				{Name: "ledger_entries", Target: "ledger_entry", Cardinality: "O2M"},
+			},
+			Transitions: []TransitionDescription{
+				{From: "draft", To: []string{"pending_approval", "posted"}},
+				{From: "pending_approval", To: []string{"posted", "draft"}},
+				{From: "posted", To: []string{"voided"}},
+				{From: "voided"},
+			},
+		},
 	})
 
 	r.Register(&EntitySchema{
@@ -874,6 +1152,7 @@ func InitRegistry() *Registry {
 				Type:      FieldString,
 				Optional:  false,
 				Sensitive: false,
+				Formatter: FormatPlain,
 			},
 			"jurisdiction_type": {
 				Name:       "jurisdiction_type",
@@ -882,6 +1161,7 @@ func InitRegistry() *Registry {
 				Optional:   false,
 				Sensitive:  false,
 				EnumValues: []string{"federal", "state", "county", "city", "special_district", "unincorporated_area"},
+				Formatter:  FormatEnum,
 			},
 			"parent_jurisdiction_id": {
 				Name:      "parent_jurisdiction_id",
@@ -889,6 +1169,8 @@ func InitRegistry() *Registry {
 				Type:      FieldString,
 				Optional:  true,
 				Sensitive: false,
+				Formatter: FormatPlain,
+				EdgeFK:    true,
 			},
 			"fips_code": {
 				Name:      "fips_code",
@@ -896,6 +1178,7 @@ func InitRegistry() *Registry {
 				Type:      FieldString,
 				Optional:  true,
 				Sensitive: false,
+				Formatter: FormatPlain,
 			},
 			"state_code": {
 				Name:      "state_code",
@@ -903,6 +1186,7 @@ func InitRegistry() *Registry {
 				Type:      FieldString,
 				Optional:  true,
 				Sensitive: false,
+				Formatter: FormatPlain,
 			},
 			"country_code": {
 				Name:      "country_code",
@@ -910,6 +1194,7 @@ func InitRegistry() *Registry {
 				Type:      FieldString,
 				Optional:  false,
 				Sensitive: false,
+				Formatter: FormatPlain,
 			},
 			"status": {
 				Name:       "status",
@@ -918,6 +1203,7 @@ func InitRegistry() *Registry {
 				Optional:   false,
 				Sensitive:  false,
 				EnumValues: []string{"active", "dissolved", "merged", "pending"},
+				Formatter:  FormatEnum,
 			},
 			"successor_jurisdiction_id": {
 				Name:      "successor_jurisdiction_id",
@@ -925,6 +1211,7 @@ func InitRegistry() *Registry {
 				Type:      FieldString,
 				Optional:  true,
 				Sensitive: false,
+				Formatter: FormatPlain,
 			},
 			"effective_date": {
 				Name:      "effective_date",
@@ -932,6 +1219,7 @@ func InitRegistry() *Registry {
 				Type:      FieldTime,
 				Optional:  true,
 				Sensitive: false,
+				Formatter: FormatTimestamp,
 			},
 			"dissolution_date": {
 				Name:      "dissolution_date",
@@ -939,6 +1227,7 @@ func InitRegistry() *Registry {
 				Type:      FieldTime,
 				Optional:  true,
 				Sensitive: false,
+				Formatter: FormatTimestamp,
 			},
 			"governing_body": {
 				Name:      "governing_body",
@@ -946,6 +1235,7 @@ func InitRegistry() *Registry {
 				Type:      FieldString,
 				Optional:  true,
 				Sensitive: false,
+				Formatter: FormatPlain,
 			},
 			"regulatory_url": {
 				Name:      "regulatory_url",
@@ -953,6 +1243,7 @@ func InitRegistry() *Registry {
 				Type:      FieldString,
 				Optional:  true,
 				Sensitive: false,
+				Formatter: FormatPlain,
 			},
 		},
 		FieldOrder: []string{
@@ -1001,6 +1292,7 @@ func InitRegistry() *Registry {
 			"rules",
 			"property_jurisdictions",
 		},
+		Aggregatable:    []string{},
 		HasStateMachine: true,
 		Immutable:       false,
 		StateMachine: map[string][]string{
@@ -1009,6 +1301,37 @@ func InitRegistry() *Registry {
 			"merged":    {},
 			"pending":   {"active"},
 		},
+		description: Description{
+			Entity:    "jurisdiction",
+			EntName:   "Jurisdiction",
+			Immutable: false,
+			Fields: []FieldDescription{
+				{Name: "name", Type: FieldString, Optional: false, Sensitive: false},
+				{Name: "jurisdiction_type", Type: FieldEnum, Optional: false, Sensitive: false, EnumValues: []string{"federal", "state", "county", "city", "special_district", "unincorporated_area"}},
+				{Name: "parent_jurisdiction_id", Type: FieldString, Optional: true, Sensitive: false},
+				{Name: "fips_code", Type: FieldString, Optional: true, Sensitive: false},
+				{Name: "state_code", Type: FieldString, Optional: true, Sensitive: false},
+				{Name: "country_code", Type: FieldString, Optional: false, Sensitive: false},
+				{Name: "status", Type: FieldEnum, Optional: false, Sensitive: false, EnumValues: []string{"active", "dissolved", "merged", "pending"}},
+				{Name: "successor_jurisdiction_id", Type: FieldString, Optional: true, Sensitive: false},
+				{Name: "effective_date", Type: FieldTime, Optional: true, Sensitive: false},
+				{Name: "dissolution_date", Type: FieldTime, Optional: true, Sensitive: false},
+				{Name: "governing_body", Type: FieldString, Optional: true, Sensitive: false},
+				{Name: "regulatory_url", Type: FieldString, Optional: true, Sensitive: false},
+			},
+			Edges: []EdgeDescription{
+				{Name: "children", Target: "jurisdiction", Cardinality: "O2M"},
+				{Name: "parent_jurisdiction", Target: "jurisdiction", Cardinality: "M2O"},
+				{Name: "rules", Target: "jurisdiction_rule", Cardinality: "O2M"},
+				{Name: "property_jurisdictions", Target: "property_jurisdiction", Cardinality: "O2M"},
+			},
+			Transitions: []TransitionDescription{
+				{From: "active", To: []string{"dissolved", "merged"}},
+				{From: "dissolved"},
+				{From: "merged"},
+				{From: "pending", To: []string{"active"}},
+			},
+		},
 	})
 
 	r.Register(&EntitySchema{
@@ -1021,6 +1344,8 @@ func InitRegistry() *Registry {
 				Type:      FieldString,
 				Optional:  false,
 				Sensitive: false,
+				Formatter: FormatPlain,
+				EdgeFK:    true,
 			},
 			"rule_type": {
 				Name:       "rule_type",
@@ -1029,6 +1354,7 @@ func InitRegistry() *Registry {
 				Optional:   false,
 				Sensitive:  false,
 				EnumValues: []string{"security_deposit_limit", "notice_period", "rent_increase_cap", "required_disclosure", "eviction_procedure", "late_fee_cap", "rent_control", "habitability_standard", "tenant_screening_restriction", "lease_term_restriction", "fee_restriction", "relocation_assistance", "right_to_counsel", "just_cause_eviction", "source_of_income_protection", "lead_paint_disclosure", "mold_disclosure", "bed_bug_disclosure", "flood_zone_disclosure", "utility_billing_restriction", "short_term_rental_restriction"},
+				Formatter:  FormatEnum,
 			},
 			"status": {
 				Name:       "status",
@@ -1037,6 +1363,7 @@ func InitRegistry() *Registry {
 				Optional:   false,
 				Sensitive:  false,
 				EnumValues: []string{"draft", "active", "superseded", "expired", "repealed"},
+				Formatter:  FormatEnum,
 			},
 			"applies_to_lease_types": {
 				Name:      "applies_to_lease_types",
@@ -1044,6 +1371,7 @@ func InitRegistry() *Registry {
 				Type:      FieldJSON,
 				Optional:  true,
 				Sensitive: false,
+				Formatter: FormatJSON,
 			},
 			"applies_to_property_types": {
 				Name:      "applies_to_property_types",
@@ -1051,6 +1379,7 @@ func InitRegistry() *Registry {
 				Type:      FieldJSON,
 				Optional:  true,
 				Sensitive: false,
+				Formatter: FormatJSON,
 			},
 			"applies_to_space_types": {
 				Name:      "applies_to_space_types",
@@ -1058,6 +1387,7 @@ func InitRegistry() *Registry {
 				Type:      FieldJSON,
 				Optional:  true,
 				Sensitive: false,
+				Formatter: FormatJSON,
 			},
 			"exemptions": {
 				Name:      "exemptions",
@@ -1065,6 +1395,7 @@ func InitRegistry() *Registry {
 				Type:      FieldJSON,
 				Optional:  true,
 				Sensitive: false,
+				Formatter: FormatJSON,
 			},
 			"rule_definition": {
 				Name:      "rule_definition",
@@ -1072,6 +1403,7 @@ func InitRegistry() *Registry {
 				Type:      FieldJSON,
 				Optional:  false,
 				Sensitive: false,
+				Formatter: FormatJSON,
 			},
 			"statute_reference": {
 				Name:      "statute_reference",
@@ -1079,6 +1411,7 @@ func InitRegistry() *Registry {
 				Type:      FieldString,
 				Optional:  true,
 				Sensitive: false,
+				Formatter: FormatPlain,
 			},
 			"ordinance_number": {
 				Name:      "ordinance_number",
@@ -1086,6 +1419,7 @@ func InitRegistry() *Registry {
 				Type:      FieldString,
 				Optional:  true,
 				Sensitive: false,
+				Formatter: FormatPlain,
 			},
 			"statute_url": {
 				Name:      "statute_url",
@@ -1093,6 +1427,7 @@ func InitRegistry() *Registry {
 				Type:      FieldString,
 				Optional:  true,
 				Sensitive: false,
+				Formatter: FormatPlain,
 			},
 			"effective_date": {
 				Name:      "effective_date",
@@ -1100,6 +1435,7 @@ func InitRegistry() *Registry {
 				Type:      FieldTime,
 				Optional:  false,
 				Sensitive: false,
+				Formatter: FormatTimestamp,
 			},
 			"expiration_date": {
 				Name:      "expiration_date",
@@ -1107,6 +1443,7 @@ func InitRegistry() *Registry {
 				Type:      FieldTime,
 				Optional:  true,
 				Sensitive: false,
+				Formatter: FormatTimestamp,
 			},
 			"superseded_by_id": {
 				Name:      "superseded_by_id",
@@ -1114,6 +1451,8 @@ func InitRegistry() *Registry {
 				Type:      FieldString,
 				Optional:  true,
 				Sensitive: false,
+				Formatter: FormatPlain,
+				EdgeFK:    true,
 			},
 			"last_verified": {
 				Name:      "last_verified",
@@ -1121,6 +1460,7 @@ func InitRegistry() *Registry {
 				Type:      FieldTime,
 				Optional:  true,
 				Sensitive: false,
+				Formatter: FormatTimestamp,
 			},
 			"verified_by": {
 				Name:      "verified_by",
@@ -1128,6 +1468,7 @@ func InitRegistry() *Registry {
 				Type:      FieldString,
 				Optional:  true,
 				Sensitive: false,
+				Formatter: FormatPlain,
 			},
 			"verification_source": {
 				Name:      "verification_source",
@@ -1135,6 +1476,7 @@ func InitRegistry() *Registry {
 				Type:      FieldString,
 				Optional:  true,
 				Sensitive: false,
+				Formatter: FormatPlain,
 			},
 		},
 		FieldOrder: []string{
@@ -1181,6 +1523,7 @@ func InitRegistry() *Registry {
 			"superseded_by",
 			"supersedes",
 		},
+		Aggregatable:    []string{},
 		HasStateMachine: true,
 		Immutable:       false,
 		StateMachine: map[string][]string{
@@ -1190,6 +1533,42 @@ func InitRegistry() *Registry {
 			"repealed":   {},
 			"superseded": {},
 		},
+		description: Description{
+			Entity:    "jurisdiction_rule",
+			EntName:   "JurisdictionRule",
+			Immutable: false,
+			Fields: []FieldDescription{
+				{Name: "jurisdiction_id", Type: FieldString, Optional: false, Sensitive: false},
+				{Name: "rule_type", Type: FieldEnum, Optional: false, Sensitive: false, EnumValues: []string{"security_deposit_limit", "notice_period", "rent_increase_cap", "required_disclosure", "eviction_procedure", "late_fee_cap", "rent_control", "habitability_standard", "tenant_screening_restriction", "lease_term_restriction", "fee_restriction", "relocation_assistance", "right_to_counsel", "just_cause_eviction", "source_of_income_protection", "lead_paint_disclosure", "mold_disclosure", "bed_bug_disclosure", "flood_zone_disclosure", "utility_billing_restriction", "short_term_rental_restriction"}},
+				{Name: "status", Type: FieldEnum, Optional: false, Sensitive: false, EnumValues: []string{"draft", "active", "superseded", "expired", "repealed"}},
+				{Name: "applies_to_lease_types", Type: FieldJSON, Optional: true, Sensitive: false},
+				{Name: "applies_to_property_types", Type: FieldJSON, Optional: true, Sensitive: false},
+				{Name: "applies_to_space_types", Type: FieldJSON, Optional: true, Sensitive: false},
+				{Name: "exemptions", Type: FieldJSON, Optional: true, Sensitive: false},
+				{Name: "rule_definition", Type: FieldJSON, Optional: false, Sensitive: false},
+				{Name: "statute_reference", Type: FieldString, Optional: true, Sensitive: false},
+				{Name: "ordinance_number", Type: FieldString, Optional: true, Sensitive: false},
+				{Name: "statute_url", Type: FieldString, Optional: true, Sensitive: false},
+				{Name: "effective_date", Type: FieldTime, Optional: false, Sensitive: false},
+				{Name: "expiration_date", Type: FieldTime, Optional: true, Sensitive: false},
+				{Name: "superseded_by_id", Type: FieldString, Optional: true, Sensitive: false},
+				{Name: "last_verified", Type: FieldTime, Optional: true, Sensitive: false},
+				{Name: "verified_by", Type: FieldString, Optional: true, Sensitive: false},
+				{Name: "verification_source", Type: FieldString, Optional: true, Sensitive: false},
+			},
+			Edges: []EdgeDescription{
+				{Name: "jurisdiction", Target: "jurisdiction", Cardinality: "M2O"},
+				{Name: "superseded_by", Target: "jurisdiction_rule", Cardinality: "O2O"},
+				{Name: "supersedes", Target: "jurisdiction_rule", Cardinality: "O2O"},
+			},
+			Transitions: []TransitionDescription{
+				{From: "active", To: []string{"superseded", "expired", "repealed"}},
+				{From: "draft", To: []string{"active"}},
+				{From: "expired"},
+				{From: "repealed"},
+				{From: "superseded"},
+			},
+		},
 	})
 
 	r.Register(&EntitySchema{
@@ -1202,6 +1581,7 @@ func InitRegistry() *Registry {
 				Type:      FieldString,
 				Optional:  false,
 				Sensitive: false,
+				Formatter: FormatPlain,
 			},
 			"tenant_role_ids": {
 				Name:      "tenant_role_ids",
@@ -1209,6 +1589,7 @@ func InitRegistry() *Registry {
 				Type:      FieldJSON,
 				Optional:  false,
 				Sensitive: false,
+				Formatter: FormatJSON,
 			},
 			"guarantor_role_ids": {
 				Name:      "guarantor_role_ids",
@@ -1216,6 +1597,7 @@ func InitRegistry() *Registry {
 				Type:      FieldJSON,
 				Optional:  true,
 				Sensitive: false,
+				Formatter: FormatJSON,
 			},
 			"lease_type": {
 				Name:       "lease_type",
@@ -1224,6 +1606,7 @@ func InitRegistry() *Registry {
 				Optional:   false,
 				Sensitive:  false,
 				EnumValues: []string{"fixed_term", "month_to_month", "commercial_nnn", "commercial_nn", "commercial_n", "commercial_gross", "commercial_modified_gross", "affordable", "section_8", "student", "ground_lease", "short_term", "membership"},
+				Formatter:  FormatEnum,
 			},
 			"status": {
 				Name:       "status",
@@ -1232,6 +1615,7 @@ func InitRegistry() *Registry {
 				Optional:   false,
 				Sensitive:  false,
 				EnumValues: []string{"draft", "pending_approval", "pending_signature", "active", "expired", "month_to_month_holdover", "renewed", "terminated", "eviction"},
+				Formatter:  FormatEnum,
 			},
 			"description": {
 				Name:      "description",
@@ -1239,6 +1623,7 @@ func InitRegistry() *Registry {
 				Type:      FieldString,
 				Optional:  true,
 				Sensitive: false,
+				Formatter: FormatPlain,
 			},
 			"liability_type": {
 				Name:       "liability_type",
@@ -1247,6 +1632,7 @@ func InitRegistry() *Registry {
 				Optional:   false,
 				Sensitive:  false,
 				EnumValues: []string{"joint_and_several", "individual", "by_the_bed", "proportional"},
+				Formatter:  FormatEnum,
 			},
 			"term": {
 				Name:      "term",
@@ -1254,6 +1640,7 @@ func InitRegistry() *Registry {
 				Type:      FieldJSON,
 				Optional:  false,
 				Sensitive: false,
+				Formatter: FormatJSON,
 			},
 			"lease_commencement_date": {
 				Name:      "lease_commencement_date",
@@ -1261,6 +1648,7 @@ func InitRegistry() *Registry {
 				Type:      FieldTime,
 				Optional:  true,
 				Sensitive: false,
+				Formatter: FormatTimestamp,
 			},
 			"rent_commencement_date": {
 				Name:      "rent_commencement_date",
@@ -1268,34 +1656,43 @@ func InitRegistry() *Registry {
 				Type:      FieldTime,
 				Optional:  true,
 				Sensitive: false,
+				Formatter: FormatTimestamp,
 			},
 			"base_rent_amount_cents": {
-				Name:      "base_rent_amount_cents",
-				EntColumn: "base_rent_amount_cents",
-				Type:      FieldInt64,
-				Optional:  false,
-				Sensitive: false,
+				Name:       "base_rent_amount_cents",
+				EntColumn:  "base_rent_amount_cents",
+				Type:       FieldInt64,
+				Optional:   false,
+				Sensitive:  false,
+				Formatter:  FormatMoneyPair,
+				MoneyGroup: "base_rent",
 			},
 			"base_rent_currency": {
-				Name:      "base_rent_currency",
-				EntColumn: "base_rent_currency",
-				Type:      FieldString,
-				Optional:  false,
-				Sensitive: false,
+				Name:       "base_rent_currency",
+				EntColumn:  "base_rent_currency",
+				Type:       FieldString,
+				Optional:   false,
+				Sensitive:  false,
+				Formatter:  FormatMoneyPair,
+				MoneyGroup: "base_rent",
 			},
 			"security_deposit_amount_cents": {
-				Name:      "security_deposit_amount_cents",
-				EntColumn: "security_deposit_amount_cents",
-				Type:      FieldInt64,
-				Optional:  false,
-				Sensitive: true,
+				Name:       "security_deposit_amount_cents",
+				EntColumn:  "security_deposit_amount_cents",
+				Type:       FieldInt64,
+				Optional:   false,
+				Sensitive:  true,
+				Formatter:  FormatMoneyPair,
+				MoneyGroup: "security_deposit",
 			},
 			"security_deposit_currency": {
-				Name:      "security_deposit_currency",
-				EntColumn: "security_deposit_currency",
-				Type:      FieldString,
-				Optional:  false,
-				Sensitive: true,
+				Name:       "security_deposit_currency",
+				EntColumn:  "security_deposit_currency",
+				Type:       FieldString,
+				Optional:   false,
+				Sensitive:  true,
+				Formatter:  FormatMoneyPair,
+				MoneyGroup: "security_deposit",
 			},
 			"rent_schedule": {
 				Name:      "rent_schedule",
@@ -1303,6 +1700,7 @@ func InitRegistry() *Registry {
 				Type:      FieldJSON,
 				Optional:  true,
 				Sensitive: false,
+				Formatter: FormatJSON,
 			},
 			"recurring_charges": {
 				Name:      "recurring_charges",
@@ -1310,6 +1708,7 @@ func InitRegistry() *Registry {
 				Type:      FieldJSON,
 				Optional:  true,
 				Sensitive: false,
+				Formatter: FormatJSON,
 			},
 			"late_fee_policy": {
 				Name:      "late_fee_policy",
@@ -1317,6 +1716,7 @@ func InitRegistry() *Registry {
 				Type:      FieldJSON,
 				Optional:  true,
 				Sensitive: false,
+				Formatter: FormatJSON,
 			},
 			"cam_terms": {
 				Name:      "cam_terms",
@@ -1324,6 +1724,7 @@ func InitRegistry() *Registry {
 				Type:      FieldJSON,
 				Optional:  true,
 				Sensitive: false,
+				Formatter: FormatJSON,
 			},
 			"tenant_improvement": {
 				Name:      "tenant_improvement",
@@ -1331,6 +1732,7 @@ func InitRegistry() *Registry {
 				Type:      FieldJSON,
 				Optional:  true,
 				Sensitive: false,
+				Formatter: FormatJSON,
 			},
 			"renewal_options": {
 				Name:      "renewal_options",
@@ -1338,6 +1740,7 @@ func InitRegistry() *Registry {
 				Type:      FieldJSON,
 				Optional:  true,
 				Sensitive: false,
+				Formatter: FormatJSON,
 			},
 			"usage_charges": {
 				Name:      "usage_charges",
@@ -1345,6 +1748,7 @@ func InitRegistry() *Registry {
 				Type:      FieldJSON,
 				Optional:  true,
 				Sensitive: false,
+				Formatter: FormatJSON,
 			},
 			"percentage_rent": {
 				Name:      "percentage_rent",
@@ -1352,6 +1756,7 @@ func InitRegistry() *Registry {
 				Type:      FieldJSON,
 				Optional:  true,
 				Sensitive: false,
+				Formatter: FormatJSON,
 			},
 			"expansion_rights": {
 				Name:      "expansion_rights",
@@ -1359,6 +1764,7 @@ func InitRegistry() *Registry {
 				Type:      FieldJSON,
 				Optional:  true,
 				Sensitive: false,
+				Formatter: FormatJSON,
 			},
 			"contraction_rights": {
 				Name:      "contraction_rights",
@@ -1366,6 +1772,7 @@ func InitRegistry() *Registry {
 				Type:      FieldJSON,
 				Optional:  true,
 				Sensitive: false,
+				Formatter: FormatJSON,
 			},
 			"subsidy": {
 				Name:      "subsidy",
@@ -1373,6 +1780,7 @@ func InitRegistry() *Registry {
 				Type:      FieldJSON,
 				Optional:  true,
 				Sensitive: false,
+				Formatter: FormatJSON,
 			},
 			"move_in_date": {
 				Name:      "move_in_date",
@@ -1380,6 +1788,7 @@ func InitRegistry() *Registry {
 				Type:      FieldTime,
 				Optional:  true,
 				Sensitive: false,
+				Formatter: FormatTimestamp,
 			},
 			"move_out_date": {
 				Name:      "move_out_date",
@@ -1387,6 +1796,7 @@ func InitRegistry() *Registry {
 				Type:      FieldTime,
 				Optional:  true,
 				Sensitive: false,
+				Formatter: FormatTimestamp,
 			},
 			"notice_date": {
 				Name:      "notice_date",
@@ -1394,6 +1804,7 @@ func InitRegistry() *Registry {
 				Type:      FieldTime,
 				Optional:  true,
 				Sensitive: false,
+				Formatter: FormatTimestamp,
 			},
 			"notice_required_days": {
 				Name:      "notice_required_days",
@@ -1401,6 +1812,7 @@ func InitRegistry() *Registry {
 				Type:      FieldInt,
 				Optional:  false,
 				Sensitive: false,
+				Formatter: FormatPlain,
 			},
 			"check_in_time": {
 				Name:      "check_in_time",
@@ -1408,6 +1820,7 @@ func InitRegistry() *Registry {
 				Type:      FieldString,
 				Optional:  true,
 				Sensitive: false,
+				Formatter: FormatPlain,
 			},
 			"check_out_time": {
 				Name:      "check_out_time",
@@ -1415,20 +1828,25 @@ func InitRegistry() *Registry {
 				Type:      FieldString,
 				Optional:  true,
 				Sensitive: false,
+				Formatter: FormatPlain,
 			},
 			"cleaning_fee_amount_cents": {
-				Name:      "cleaning_fee_amount_cents",
-				EntColumn: "cleaning_fee_amount_cents",
-				Type:      FieldInt64,
-				Optional:  true,
-				Sensitive: false,
+				Name:       "cleaning_fee_amount_cents",
+				EntColumn:  "cleaning_fee_amount_cents",
+				Type:       FieldInt64,
+				Optional:   true,
+				Sensitive:  false,
+				Formatter:  FormatMoneyPair,
+				MoneyGroup: "cleaning_fee",
 			},
 			"cleaning_fee_currency": {
-				Name:      "cleaning_fee_currency",
-				EntColumn: "cleaning_fee_currency",
-				Type:      FieldString,
-				Optional:  true,
-				Sensitive: false,
+				Name:       "cleaning_fee_currency",
+				EntColumn:  "cleaning_fee_currency",
+				Type:       FieldString,
+				Optional:   true,
+				Sensitive:  false,
+				Formatter:  FormatMoneyPair,
+				MoneyGroup: "cleaning_fee",
 			},
 			"platform_booking_id": {
 				Name:      "platform_booking_id",
@@ -1436,6 +1854,7 @@ func InitRegistry() *Registry {
 				Type:      FieldString,
 				Optional:  true,
 				Sensitive: false,
+				Formatter: FormatPlain,
 			},
 			"membership_tier": {
 				Name:       "membership_tier",
@@ -1444,6 +1863,7 @@ func InitRegistry() *Registry {
 				Optional:   true,
 				Sensitive:  false,
 				EnumValues: []string{"hot_desk", "dedicated_desk", "office", "suite", "virtual"},
+				Formatter:  FormatEnum,
 			},
 			"parent_lease_id": {
 				Name:      "parent_lease_id",
@@ -1451,6 +1871,8 @@ func InitRegistry() *Registry {
 				Type:      FieldString,
 				Optional:  true,
 				Sensitive: false,
+				Formatter: FormatPlain,
+				EdgeFK:    true,
 			},
 			"is_sublease": {
 				Name:      "is_sublease",
@@ -1458,6 +1880,7 @@ func InitRegistry() *Registry {
 				Type:      FieldBool,
 				Optional:  false,
 				Sensitive: false,
+				Formatter: FormatPlain,
 			},
 			"sublease_billing": {
 				Name:       "sublease_billing",
@@ -1466,6 +1889,7 @@ func InitRegistry() *Registry {
 				Optional:   false,
 				Sensitive:  false,
 				EnumValues: []string{"through_master_tenant", "direct_to_landlord"},
+				Formatter:  FormatEnum,
 			},
 			"signing_method": {
 				Name:       "signing_method",
@@ -1474,6 +1898,7 @@ func InitRegistry() *Registry {
 				Optional:   true,
 				Sensitive:  false,
 				EnumValues: []string{"electronic", "wet_ink", "both"},
+				Formatter:  FormatEnum,
 			},
 			"signed_at": {
 				Name:      "signed_at",
@@ -1481,6 +1906,7 @@ func InitRegistry() *Registry {
 				Type:      FieldTime,
 				Optional:  true,
 				Sensitive: false,
+				Formatter: FormatTimestamp,
 			},
 			"document_id": {
 				Name:      "document_id",
@@ -1488,6 +1914,7 @@ func InitRegistry() *Registry {
 				Type:      FieldString,
 				Optional:  true,
 				Sensitive: false,
+				Formatter: FormatPlain,
 			},
 		},
 		FieldOrder: []string{
@@ -1586,6 +2013,12 @@ func InitRegistry() *Registry {
 			"subleases",
 			"parent_lease",
 		},
+		Aggregatable: []string{
+			"base_rent_amount_cents",
+			"security_deposit_amount_cents",
+			"notice_required_days",
+			"cleaning_fee_amount_cents",
+		},
 		HasStateMachine: true,
 		Immutable:       false,
 		StateMachine: map[string][]string{
@@ -1599,6 +2032,74 @@ func InitRegistry() *Registry {
 			"renewed":                 {},
 			"terminated":              {},
 		},
+		description: Description{
+			Entity:    "lease",
+			EntName:   "Lease",
+			Immutable: false,
+			Fields: []FieldDescription{
+				{Name: "property_id", Type: FieldString, Optional: false, Sensitive: false},
+				{Name: "tenant_role_ids", Type: FieldJSON, Optional: false, Sensitive: false},
+				{Name: "guarantor_role_ids", Type: FieldJSON, Optional: true, Sensitive: false},
+				{Name: "lease_type", Type: FieldEnum, Optional: false, Sensitive: false, EnumValues: []string{"fixed_term", "month_to_month", "commercial_nnn", "commercial_nn", "commercial_n", "commercial_gross", "commercial_modified_gross", "affordable", "section_8", "student", "ground_lease", "short_term", "membership"}},
+				{Name: "status", Type: FieldEnum, Optional: false, Sensitive: false, EnumValues: []string{"draft", "pending_approval", "pending_signature", "active", "expired", "month_to_month_holdover", "renewed", "terminated", "eviction"}},
+				{Name: "description", Type: FieldString, Optional: true, Sensitive: false},
+				{Name: "liability_type", Type: FieldEnum, Optional: false, Sensitive: false, EnumValues: []string{"joint_and_several", "individual", "by_the_bed", "proportional"}},
+				{Name: "term", Type: FieldJSON, Optional: false, Sensitive: false},
+				{Name: "lease_commencement_date", Type: FieldTime, Optional: true, Sensitive: false},
+				{Name: "rent_commencement_date", Type: FieldTime, Optional: true, Sensitive: false},
+				{Name: "base_rent_amount_cents", Type: FieldInt64, Optional: false, Sensitive: false},
+				{Name: "base_rent_currency", Type: FieldString, Optional: false, Sensitive: false},
+				{Name: "security_deposit_amount_cents", Type: FieldInt64, Optional: false, Sensitive: true},
+				{Name: "security_deposit_currency", Type: FieldString, Optional: false, Sensitive: true},
+				{Name: "rent_schedule", Type: FieldJSON, Optional: true, Sensitive: false},
+				{Name: "recurring_charges", Type: FieldJSON, Optional: true, Sensitive: false},
+				{Name: "late_fee_policy", Type: FieldJSON, Optional: true, Sensitive: false},
+				{Name: "cam_terms", Type: FieldJSON, Optional: true, Sensitive: false},
+				{Name: "tenant_improvement", Type: FieldJSON, Optional: true, Sensitive: false},
+				{Name: "renewal_options", Type: FieldJSON, Optional: true, Sensitive: false},
+				{Name: "usage_charges", Type: FieldJSON, Optional: true, Sensitive: false},
+				{Name: "percentage_rent", Type: FieldJSON, Optional: true, Sensitive: false},
+				{Name: "expansion_rights", Type: FieldJSON, Optional: true, Sensitive: false},
+				{Name: "contraction_rights", Type: FieldJSON, Optional: true, Sensitive: false},
+				{Name: "subsidy", Type: FieldJSON, Optional: true, Sensitive: false},
+				{Name: "move_in_date", Type: FieldTime, Optional: true, Sensitive: false},
+				{Name: "move_out_date", Type: FieldTime, Optional: true, Sensitive: false},
+				{Name: "notice_date", Type: FieldTime, Optional: true, Sensitive: false},
+				{Name: "notice_required_days", Type: FieldInt, Optional: false, Sensitive: false},
+				{Name: "check_in_time", Type: FieldString, Optional: true, Sensitive: false},
+				{Name: "check_out_time", Type: FieldString, Optional: true, Sensitive: false},
+				{Name: "cleaning_fee_amount_cents", Type: FieldInt64, Optional: true, Sensitive: false},
+				{Name: "cleaning_fee_currency", Type: FieldString, Optional: true, Sensitive: false},
+				{Name: "platform_booking_id", Type: FieldString, Optional: true, Sensitive: false},
+				{Name: "membership_tier", Type: FieldEnum, Optional: true, Sensitive: false, EnumValues: []string{"hot_desk", "dedicated_desk", "office", "suite", "virtual"}},
+				{Name: "parent_lease_id", Type: FieldString, Optional: true, Sensitive: false},
+				{Name: "is_sublease", Type: FieldBool, Optional: false, Sensitive: false},
+				{Name: "sublease_billing", Type: FieldEnum, Optional: false, Sensitive: false, EnumValues: []string{"through_master_tenant", "direct_to_landlord"}},
+				{Name: "signing_method", Type: FieldEnum, Optional: true, Sensitive: false, EnumValues: []string{"electronic", "wet_ink", "both"}},
+				{Name: "signed_at", Type: FieldTime, Optional: true, Sensitive: false},
+				{Name: "document_id", Type: FieldString, Optional: true, Sensitive: false},
+			},
+			Edges: []EdgeDescription{
+				{Name: "lease_spaces", Target: "lease_space", Cardinality: "O2M"},
+				{Name: "tenant_roles", Target: "person_role", Cardinality: "M2M"},
+				{Name: "guarantor_roles", Target: "person_role", Cardinality: "M2M"},
+				{Name: "ledger_entries", Target: "ledger_entry", Cardinality: "O2M"},
+				{Name: "application", Target: "application", Cardinality: "O2O"},
+				{Name: "subleases", Target: "lease", Cardinality: "O2M"},
+				{Name: "parent_lease", Target: "lease", Cardinality: "M2O"},
+			},
+			Transitions: []TransitionDescription{
+				{From: "active", To: []string{"expired", "month_to_month_holdover", "terminated", "eviction"}},
+				{From: "draft", To: []string{"pending_approval", "pending_signature", "terminated"}},
+				{From: "eviction", To: []string{"terminated"}},
+				{From: "expired", To: []string{"active", "month_to_month_holdover", "renewed", "terminated"}},
+				{From: "month_to_month_holdover", To: []string{"active", "renewed", "terminated", "eviction"}},
+				{From: "pending_approval", To: []string{"draft", "pending_signature", "terminated"}},
+				{From: "pending_signature", To: []string{"active", "draft", "terminated"}},
+				{From: "renewed"},
+				{From: "terminated"},
+			},
+		},
 	})
 
 	r.Register(&EntitySchema{
@@ -1611,6 +2112,8 @@ func InitRegistry() *Registry {
 				Type:      FieldString,
 				Optional:  false,
 				Sensitive: false,
+				Formatter: FormatPlain,
+				EdgeFK:    true,
 			},
 			"space_id": {
 				Name:      "space_id",
@@ -1618,6 +2121,8 @@ func InitRegistry() *Registry {
 				Type:      FieldString,
 				Optional:  false,
 				Sensitive: false,
+				Formatter: FormatPlain,
+				EdgeFK:    true,
 			},
 			"is_primary": {
 				Name:      "is_primary",
@@ -1625,6 +2130,7 @@ func InitRegistry() *Registry {
 				Type:      FieldBool,
 				Optional:  false,
 				Sensitive: false,
+				Formatter: FormatPlain,
 			},
 			"relationship": {
 				Name:       "relationship",
@@ -1633,6 +2139,7 @@ func InitRegistry() *Registry {
 				Optional:   false,
 				Sensitive:  false,
 				EnumValues: []string{"primary", "expansion", "sublease", "shared_access", "parking", "storage", "loading_dock", "rooftop", "patio", "signage", "included", "membership"},
+				Formatter:  FormatEnum,
 			},
 			"effective": {
 				Name:      "effective",
@@ -1640,6 +2147,7 @@ func InitRegistry() *Registry {
 				Type:      FieldJSON,
 				Optional:  false,
 				Sensitive: false,
+				Formatter: FormatJSON,
 			},
 			"square_footage_leased": {
 				Name:      "square_footage_leased",
@@ -1647,6 +2155,7 @@ func InitRegistry() *Registry {
 				Type:      FieldFloat,
 				Optional:  true,
 				Sensitive: false,
+				Formatter: FormatPlain,
 			},
 		},
 		FieldOrder: []string{
@@ -1675,8 +2184,28 @@ func InitRegistry() *Registry {
 			"lease",
 			"space",
 		},
+		Aggregatable: []string{
+			"square_footage_leased",
+		},
 		HasStateMachine: false,
 		Immutable:       false,
+		description: Description{
+			Entity:    "lease_space",
+			EntName:   "LeaseSpace",
+			Immutable: false,
+			Fields: []FieldDescription{
+				{Name: "lease_id", Type: FieldString, Optional: false, Sensitive: false},
+				{Name: "space_id", Type: FieldString, Optional: false, Sensitive: false},
+				{Name: "is_primary", Type: FieldBool, Optional: false, Sensitive: false},
+				{Name: "relationship", Type: FieldEnum, Optional: false, Sensitive: false, EnumValues: []string{"primary", "expansion", "sublease", "shared_access", "parking", "storage", "loading_dock", "rooftop", "patio", "signage", "included", "membership"}},
+				{Name: "effective", Type: FieldJSON, Optional: false, Sensitive: false},
+				{Name: "square_footage_leased", Type: FieldFloat, Optional: true, Sensitive: false},
+			},
+			Edges: []EdgeDescription{
+				{Name: "lease", Target: "lease", Cardinality: "M2O"},
+				{Name: "space", Target: "space", Cardinality: "M2O"},
+			},
+		},
 	})
 
 	r.Register(&EntitySchema{
@@ -1689,6 +2218,8 @@ func InitRegistry() *Registry {
 				Type:      FieldString,
 				Optional:  false,
 				Sensitive: false,
+				Formatter: FormatPlain,
+				EdgeFK:    true,
 			},
 			"entry_type": {
 				Name:       "entry_type",
@@ -1697,20 +2228,25 @@ func InitRegistry() *Registry {
 				Optional:   false,
 				Sensitive:  false,
 				EnumValues: []string{"charge", "payment", "credit", "adjustment", "refund", "deposit", "nsf", "write_off", "late_fee", "management_fee", "owner_draw"},
+				Formatter:  FormatEnum,
 			},
 			"amount_amount_cents": {
-				Name:      "amount_amount_cents",
-				EntColumn: "amount_amount_cents",
-				Type:      FieldInt64,
-				Optional:  false,
-				Sensitive: false,
+				Name:       "amount_amount_cents",
+				EntColumn:  "amount_amount_cents",
+				Type:       FieldInt64,
+				Optional:   false,
+				Sensitive:  false,
+				Formatter:  FormatMoneyPair,
+				MoneyGroup: "amount",
 			},
 			"amount_currency": {
-				Name:      "amount_currency",
-				EntColumn: "amount_currency",
-				Type:      FieldString,
-				Optional:  false,
-				Sensitive: false,
+				Name:       "amount_currency",
+				EntColumn:  "amount_currency",
+				Type:       FieldString,
+				Optional:   false,
+				Sensitive:  false,
+				Formatter:  FormatMoneyPair,
+				MoneyGroup: "amount",
 			},
 			"journal_entry_id": {
 				Name:      "journal_entry_id",
@@ -1718,6 +2254,8 @@ func InitRegistry() *Registry {
 				Type:      FieldString,
 				Optional:  false,
 				Sensitive: false,
+				Formatter: FormatPlain,
+				EdgeFK:    true,
 			},
 			"effective_date": {
 				Name:      "effective_date",
@@ -1725,6 +2263,7 @@ func InitRegistry() *Registry {
 				Type:      FieldTime,
 				Optional:  false,
 				Sensitive: false,
+				Formatter: FormatTimestamp,
 			},
 			"posted_date": {
 				Name:      "posted_date",
@@ -1732,6 +2271,7 @@ func InitRegistry() *Registry {
 				Type:      FieldTime,
 				Optional:  false,
 				Sensitive: false,
+				Formatter: FormatTimestamp,
 			},
 			"description": {
 				Name:      "description",
@@ -1739,6 +2279,7 @@ func InitRegistry() *Registry {
 				Type:      FieldString,
 				Optional:  false,
 				Sensitive: false,
+				Formatter: FormatPlain,
 			},
 			"charge_code": {
 				Name:      "charge_code",
@@ -1746,6 +2287,7 @@ func InitRegistry() *Registry {
 				Type:      FieldString,
 				Optional:  false,
 				Sensitive: false,
+				Formatter: FormatPlain,
 			},
 			"memo": {
 				Name:      "memo",
@@ -1753,6 +2295,7 @@ func InitRegistry() *Registry {
 				Type:      FieldString,
 				Optional:  true,
 				Sensitive: false,
+				Formatter: FormatPlain,
 			},
 			"property_id": {
 				Name:      "property_id",
@@ -1760,6 +2303,8 @@ func InitRegistry() *Registry {
 				Type:      FieldString,
 				Optional:  false,
 				Sensitive: false,
+				Formatter: FormatPlain,
+				EdgeFK:    true,
 			},
 			"space_id": {
 				Name:      "space_id",
@@ -1767,6 +2312,8 @@ func InitRegistry() *Registry {
 				Type:      FieldString,
 				Optional:  true,
 				Sensitive: false,
+				Formatter: FormatPlain,
+				EdgeFK:    true,
 			},
 			"lease_id": {
 				Name:      "lease_id",
@@ -1774,6 +2321,8 @@ func InitRegistry() *Registry {
 				Type:      FieldString,
 				Optional:  true,
 				Sensitive: false,
+				Formatter: FormatPlain,
+				EdgeFK:    true,
 			},
 			"person_id": {
 				Name:      "person_id",
@@ -1781,6 +2330,8 @@ func InitRegistry() *Registry {
 				Type:      FieldString,
 				Optional:  true,
 				Sensitive: false,
+				Formatter: FormatPlain,
+				EdgeFK:    true,
 			},
 			"bank_account_id": {
 				Name:      "bank_account_id",
@@ -1788,6 +2339,7 @@ func InitRegistry() *Registry {
 				Type:      FieldString,
 				Optional:  true,
 				Sensitive: false,
+				Formatter: FormatPlain,
 			},
 			"bank_transaction_id": {
 				Name:      "bank_transaction_id",
@@ -1795,6 +2347,7 @@ func InitRegistry() *Registry {
 				Type:      FieldString,
 				Optional:  true,
 				Sensitive: false,
+				Formatter: FormatPlain,
 			},
 			"reconciled": {
 				Name:      "reconciled",
@@ -1802,6 +2355,7 @@ func InitRegistry() *Registry {
 				Type:      FieldBool,
 				Optional:  false,
 				Sensitive: false,
+				Formatter: FormatPlain,
 			},
 			"reconciliation_id": {
 				Name:      "reconciliation_id",
@@ -1809,6 +2363,7 @@ func InitRegistry() *Registry {
 				Type:      FieldString,
 				Optional:  true,
 				Sensitive: false,
+				Formatter: FormatPlain,
 			},
 			"reconciled_at": {
 				Name:      "reconciled_at",
@@ -1816,6 +2371,7 @@ func InitRegistry() *Registry {
 				Type:      FieldTime,
 				Optional:  true,
 				Sensitive: false,
+				Formatter: FormatTimestamp,
 			},
 			"adjusts_entry_id": {
 				Name:      "adjusts_entry_id",
@@ -1823,6 +2379,7 @@ func InitRegistry() *Registry {
 				Type:      FieldString,
 				Optional:  true,
 				Sensitive: false,
+				Formatter: FormatPlain,
 			},
 		},
 		FieldOrder: []string{
@@ -1893,8 +2450,46 @@ func InitRegistry() *Registry {
 			"space",
 			"person",
 		},
+		Aggregatable: []string{
+			"amount_amount_cents",
+		},
 		HasStateMachine: false,
 		Immutable:       false,
+		description: Description{
+			Entity:    "ledger_entry",
+			EntName:   "LedgerEntry",
+			Immutable: false,
+			Fields: []FieldDescription{
+				{Name: "account_id", Type: FieldString, Optional: false, Sensitive: false},
+				{Name: "entry_type", Type: FieldEnum, Optional: false, Sensitive: false, EnumValues: []string{"charge", "payment", "credit", "adjustment", "refund", "deposit", "nsf", "write_off", "late_fee", "management_fee", "owner_draw"}},
+				{Name: "amount_amount_cents", Type: FieldInt64, Optional: false, Sensitive: false},
+				{Name: "amount_currency", Type: FieldString, Optional: false, Sensitive: false},
+				{Name: "journal_entry_id", Type: FieldString, Optional: false, Sensitive: false},
+				{Name: "effective_date", Type: FieldTime, Optional: false, Sensitive: false},
+				{Name: "posted_date", Type: FieldTime, Optional: false, Sensitive: false},
+				{Name: "description", Type: FieldString, Optional: false, Sensitive: false},
+				{Name: "charge_code", Type: FieldString, Optional: false, Sensitive: false},
+				{Name: "memo", Type: FieldString, Optional: true, Sensitive: false},
+				{Name: "property_id", Type: FieldString, Optional: false, Sensitive: false},
+				{Name: "space_id", Type: FieldString, Optional: true, Sensitive: false},
+				{Name: "lease_id", Type: FieldString, Optional: true, Sensitive: false},
+				{Name: "person_id", Type: FieldString, Optional: true, Sensitive: false},
+				{Name: "bank_account_id", Type: FieldString, Optional: true, Sensitive: false},
+				{Name: "bank_transaction_id", Type: FieldString, Optional: true, Sensitive: false},
+				{Name: "reconciled", Type: FieldBool, Optional: false, Sensitive: false},
+				{Name: "reconciliation_id", Type: FieldString, Optional: true, Sensitive: false},
+				{Name: "reconciled_at", Type: FieldTime, Optional: true, Sensitive: false},
+				{Name: "adjusts_entry_id", Type: FieldString, Optional: true, Sensitive: false},
+			},
+			Edges: []EdgeDescription{
+				{Name: "lease", Target: "lease", Cardinality: "M2O"},
+				{Name: "journal_entry", Target: "journal_entry", Cardinality: "M2O"},
+				{Name: "account", Target: "account", Cardinality: "M2O"},
+				{Name: "property", Target: "property", Cardinality: "M2O"},
+				{Name: "space", Target: "space", Cardinality: "M2O"},
+				{Name: "person", Target: "person", Cardinality: "M2O"},
+			},
+		},
 	})
 
 	r.Register(&EntitySchema{
@@ -1907,6 +2502,7 @@ func InitRegistry() *Registry {
 				Type:      FieldString,
 				Optional:  false,
 				Sensitive: false,
+				Formatter: FormatPlain,
 			},
 			"dba_name": {
 				Name:      "dba_name",
@@ -1914,6 +2510,7 @@ func InitRegistry() *Registry {
 				Type:      FieldString,
 				Optional:  true,
 				Sensitive: false,
+				Formatter: FormatPlain,
 			},
 			"org_type": {
 				Name:       "org_type",
@@ -1922,6 +2519,7 @@ func InitRegistry() *Registry {
 				Optional:   false,
 				Sensitive:  false,
 				EnumValues: []string{"management_company", "ownership_entity", "vendor", "corporate_tenant", "government_agency", "hoa", "investment_fund", "other"},
+				Formatter:  FormatEnum,
 			},
 			"tax_id": {
 				Name:      "tax_id",
@@ -1929,6 +2527,7 @@ func InitRegistry() *Registry {
 				Type:      FieldString,
 				Optional:  true,
 				Sensitive: true,
+				Formatter: FormatPlain,
 			},
 			"tax_id_type": {
 				Name:       "tax_id_type",
@@ -1937,6 +2536,7 @@ func InitRegistry() *Registry {
 				Optional:   true,
 				Sensitive:  false,
 				EnumValues: []string{"ein", "ssn", "itin", "foreign"},
+				Formatter:  FormatEnum,
 			},
 			"status": {
 				Name:       "status",
@@ -1945,6 +2545,7 @@ func InitRegistry() *Registry {
 				Optional:   false,
 				Sensitive:  false,
 				EnumValues: []string{"active", "inactive", "suspended", "dissolved"},
+				Formatter:  FormatEnum,
 			},
 			"address": {
 				Name:      "address",
@@ -1952,6 +2553,7 @@ func InitRegistry() *Registry {
 				Type:      FieldJSON,
 				Optional:  true,
 				Sensitive: false,
+				Formatter: FormatJSON,
 			},
 			"contact_methods": {
 				Name:      "contact_methods",
@@ -1959,6 +2561,7 @@ func InitRegistry() *Registry {
 				Type:      FieldJSON,
 				Optional:  true,
 				Sensitive: false,
+				Formatter: FormatJSON,
 			},
 			"state_of_incorporation": {
 				Name:      "state_of_incorporation",
@@ -1966,6 +2569,7 @@ func InitRegistry() *Registry {
 				Type:      FieldString,
 				Optional:  true,
 				Sensitive: false,
+				Formatter: FormatPlain,
 			},
 			"formation_date": {
 				Name:      "formation_date",
@@ -1973,6 +2577,7 @@ func InitRegistry() *Registry {
 				Type:      FieldTime,
 				Optional:  true,
 				Sensitive: false,
+				Formatter: FormatTimestamp,
 			},
 			"management_license": {
 				Name:      "management_license",
@@ -1980,6 +2585,7 @@ func InitRegistry() *Registry {
 				Type:      FieldString,
 				Optional:  true,
 				Sensitive: false,
+				Formatter: FormatPlain,
 			},
 			"license_state": {
 				Name:      "license_state",
@@ -1987,6 +2593,7 @@ func InitRegistry() *Registry {
 				Type:      FieldString,
 				Optional:  true,
 				Sensitive: false,
+				Formatter: FormatPlain,
 			},
 			"license_expiry": {
 				Name:      "license_expiry",
@@ -1994,6 +2601,7 @@ func InitRegistry() *Registry {
 				Type:      FieldTime,
 				Optional:  true,
 				Sensitive: false,
+				Formatter: FormatTimestamp,
 			},
 		},
 		FieldOrder: []string{
@@ -2043,6 +2651,7 @@ func InitRegistry() *Registry {
 			"subsidiaries",
 			"parent_org",
 		},
+		Aggregatable:    []string{},
 		HasStateMachine: true,
 		Immutable:       false,
 		StateMachine: map[string][]string{
@@ -2051,6 +2660,38 @@ func InitRegistry() *Registry {
 			"inactive":  {"active", "dissolved"},
 			"suspended": {"active", "dissolved"},
 		},
+		description: Description{
+			Entity:    "organization",
+			EntName:   "Organization",
+			Immutable: false,
+			Fields: []FieldDescription{
+				{Name: "legal_name", Type: FieldString, Optional: false, Sensitive: false},
+				{Name: "dba_name", Type: FieldString, Optional: true, Sensitive: false},
+				{Name: "org_type", Type: FieldEnum, Optional: false, Sensitive: false, EnumValues: []string{"management_company", "ownership_entity", "vendor", "corporate_tenant", "government_agency", "hoa", "investment_fund", "other"}},
+				{Name: "tax_id", Type: FieldString, Optional: true, Sensitive: true},
+				{Name: "tax_id_type", Type: FieldEnum, Optional: true, Sensitive: false, EnumValues: []string{"ein", "ssn", "itin", "foreign"}},
+				{Name: "status", Type: FieldEnum, Optional: false, Sensitive: false, EnumValues: []string{"active", "inactive", "suspended", "dissolved"}},
+				{Name: "address", Type: FieldJSON, Optional: true, Sensitive: false},
+				{Name: "contact_methods", Type: FieldJSON, Optional: true, Sensitive: false},
+				{Name: "state_of_incorporation", Type: FieldString, Optional: true, Sensitive: false},
+				{Name: "formation_date", Type: FieldTime, Optional: true, Sensitive: false},
+				{Name: "management_license", Type: FieldString, Optional: true, Sensitive: false},
+				{Name: "license_state", Type: FieldString, Optional: true, Sensitive: false},
+				{Name: "license_expiry", Type: FieldTime, Optional: true, Sensitive: false},
+			},
+			Edges: []EdgeDescription{
+				{Name: "owned_portfolios", Target: "portfolio", Cardinality: "O2M"},
+				{Name: "people", Target: "person", Cardinality: "M2M"},
+				{Name: "subsidiaries", Target: "organization", Cardinality: "O2M"},
+				{Name: "parent_org", Target: "organization", Cardinality: "M2O"},
+			},
+			Transitions: []TransitionDescription{
+				{From: "active", To: []string{"inactive", "suspended", "dissolved"}},
+				{From: "dissolved"},
+				{From: "inactive", To: []string{"active", "dissolved"}},
+				{From: "suspended", To: []string{"active", "dissolved"}},
+			},
+		},
 	})
 
 	r.Register(&EntitySchema{
@@ -2063,6 +2704,7 @@ func InitRegistry() *Registry {
 				Type:      FieldString,
 				Optional:  false,
 				Sensitive: false,
+				Formatter: FormatPlain,
 			},
 			"middle_name": {
 				Name:      "middle_name",
@@ -2070,6 +2712,7 @@ func InitRegistry() *Registry {
 				Type:      FieldString,
 				Optional:  true,
 				Sensitive: false,
+				Formatter: FormatPlain,
 			},
 			"last_name": {
 				Name:      "last_name",
@@ -2077,6 +2720,7 @@ func InitRegistry() *Registry {
 				Type:      FieldString,
 				Optional:  false,
 				Sensitive: false,
+				Formatter: FormatPlain,
 			},
 			"display_name": {
 				Name:      "display_name",
@@ -2084,6 +2728,7 @@ func InitRegistry() *Registry {
 				Type:      FieldString,
 				Optional:  false,
 				Sensitive: false,
+				Formatter: FormatPlain,
 			},
 			"record_source": {
 				Name:       "record_source",
@@ -2092,6 +2737,7 @@ func InitRegistry() *Registry {
 				Optional:   false,
 				Sensitive:  false,
 				EnumValues: []string{"user", "applicant", "import", "system"},
+				Formatter:  FormatEnum,
 			},
 			"date_of_birth": {
 				Name:      "date_of_birth",
@@ -2099,6 +2745,7 @@ func InitRegistry() *Registry {
 				Type:      FieldTime,
 				Optional:  true,
 				Sensitive: true,
+				Formatter: FormatTimestamp,
 			},
 			"ssn_last_four": {
 				Name:      "ssn_last_four",
@@ -2106,6 +2753,7 @@ func InitRegistry() *Registry {
 				Type:      FieldString,
 				Optional:  true,
 				Sensitive: true,
+				Formatter: FormatPlain,
 			},
 			"contact_methods": {
 				Name:      "contact_methods",
@@ -2113,6 +2761,7 @@ func InitRegistry() *Registry {
 				Type:      FieldJSON,
 				Optional:  false,
 				Sensitive: false,
+				Formatter: FormatJSON,
 			},
 			"preferred_contact": {
 				Name:       "preferred_contact",
@@ -2121,6 +2770,7 @@ func InitRegistry() *Registry {
 				Optional:   false,
 				Sensitive:  false,
 				EnumValues: []string{"email", "sms", "phone", "mail", "portal"},
+				Formatter:  FormatEnum,
 			},
 			"language_preference": {
 				Name:      "language_preference",
@@ -2128,6 +2778,7 @@ func InitRegistry() *Registry {
 				Type:      FieldString,
 				Optional:  false,
 				Sensitive: false,
+				Formatter: FormatPlain,
 			},
 			"timezone": {
 				Name:      "timezone",
@@ -2135,6 +2786,7 @@ func InitRegistry() *Registry {
 				Type:      FieldString,
 				Optional:  true,
 				Sensitive: false,
+				Formatter: FormatPlain,
 			},
 			"do_not_contact": {
 				Name:      "do_not_contact",
@@ -2142,6 +2794,7 @@ func InitRegistry() *Registry {
 				Type:      FieldBool,
 				Optional:  false,
 				Sensitive: false,
+				Formatter: FormatPlain,
 			},
 			"identity_verified": {
 				Name:      "identity_verified",
@@ -2149,6 +2802,7 @@ func InitRegistry() *Registry {
 				Type:      FieldBool,
 				Optional:  false,
 				Sensitive: false,
+				Formatter: FormatPlain,
 			},
 			"verification_method": {
 				Name:       "verification_method",
@@ -2157,6 +2811,7 @@ func InitRegistry() *Registry {
 				Optional:   true,
 				Sensitive:  false,
 				EnumValues: []string{"manual", "id_check", "credit_check", "ssn_verify"},
+				Formatter:  FormatEnum,
 			},
 			"verified_at": {
 				Name:      "verified_at",
@@ -2164,6 +2819,7 @@ func InitRegistry() *Registry {
 				Type:      FieldTime,
 				Optional:  true,
 				Sensitive: false,
+				Formatter: FormatTimestamp,
 			},
 			"tags": {
 				Name:      "tags",
@@ -2171,6 +2827,7 @@ func InitRegistry() *Registry {
 				Type:      FieldJSON,
 				Optional:  true,
 				Sensitive: false,
+				Formatter: FormatJSON,
 			},
 		},
 		FieldOrder: []string{
@@ -2223,8 +2880,38 @@ func InitRegistry() *Registry {
 			"ledger_entries",
 			"applications",
 		},
+		Aggregatable:    []string{},
 		HasStateMachine: false,
 		Immutable:       false,
+		description: Description{
+			Entity:    "person",
+			EntName:   "Person",
+			Immutable: false,
+			Fields: []FieldDescription{
+				{Name: "first_name", Type: FieldString, Optional: false, Sensitive: false},
+				{Name: "middle_name", Type: FieldString, Optional: true, Sensitive: false},
+				{Name: "last_name", Type: FieldString, Optional: false, Sensitive: false},
+				{Name: "display_name", Type: FieldString, Optional: false, Sensitive: false},
+				{Name: "record_source", Type: FieldEnum, Optional: false, Sensitive: false, EnumValues: []string{"user", "applicant", "import", "system"}},
+				{Name: "date_of_birth", Type: FieldTime, Optional: true, Sensitive: true},
+				{Name: "ssn_last_four", Type: FieldString, Optional: true, Sensitive: true},
+				{Name: "contact_methods", Type: FieldJSON, Optional: false, Sensitive: false},
+				{Name: "preferred_contact", Type: FieldEnum, Optional: false, Sensitive: false, EnumValues: []string{"email", "sms", "phone", "mail", "portal"}},
+				{Name: "language_preference", Type: FieldString, Optional: false, Sensitive: false},
+				{Name: "timezone", Type: FieldString, Optional: true, Sensitive: false},
+				{Name: "do_not_contact", Type: FieldBool, Optional: false, Sensitive: false},
+				{Name: "identity_verified", Type: FieldBool, Optional: false, Sensitive: false},
+				{Name: "verification_method", Type: FieldEnum, Optional: true, Sensitive: false, EnumValues: []string{"manual", "id_check", "credit_check", "ssn_verify"}},
+				{Name: "verified_at", Type: FieldTime, Optional: true, Sensitive: false},
+				{Name: "tags", Type: FieldJSON, Optional: true, Sensitive: false},
+			},
+			Edges: []EdgeDescription{
+				{Name: "roles", Target: "person_role", Cardinality: "O2M"},
+				{Name: "organizations", Target: "organization", Cardinality: "M2M"},
+				{Name: "ledger_entries", Target: "ledger_entry", Cardinality: "O2M"},
+				{Name: "applications", Target: "application", Cardinality: "O2M"},
+			},
+		},
 	})
 
 	r.Register(&EntitySchema{
@@ -2237,6 +2924,8 @@ func InitRegistry() *Registry {
 				Type:      FieldString,
 				Optional:  false,
 				Sensitive: false,
+				Formatter: FormatPlain,
+				EdgeFK:    true,
 			},
 			"role_type": {
 				Name:       "role_type",
@@ -2245,6 +2934,7 @@ func InitRegistry() *Registry {
 				Optional:   false,
 				Sensitive:  false,
 				EnumValues: []string{"tenant", "owner", "property_manager", "maintenance_tech", "leasing_agent", "accountant", "vendor_contact", "guarantor", "emergency_contact", "authorized_occupant", "co_signer"},
+				Formatter:  FormatEnum,
 			},
 			"scope_type": {
 				Name:       "scope_type",
@@ -2253,6 +2943,7 @@ func InitRegistry() *Registry {
 				Optional:   false,
 				Sensitive:  false,
 				EnumValues: []string{"organization", "portfolio", "property", "building", "space", "lease"},
+				Formatter:  FormatEnum,
 			},
 			"scope_id": {
 				Name:      "scope_id",
@@ -2260,6 +2951,7 @@ func InitRegistry() *Registry {
 				Type:      FieldString,
 				Optional:  false,
 				Sensitive: false,
+				Formatter: FormatPlain,
 			},
 			"status": {
 				Name:       "status",
@@ -2268,6 +2960,7 @@ func InitRegistry() *Registry {
 				Optional:   false,
 				Sensitive:  false,
 				EnumValues: []string{"active", "inactive", "pending", "terminated"},
+				Formatter:  FormatEnum,
 			},
 			"effective": {
 				Name:      "effective",
@@ -2275,6 +2968,7 @@ func InitRegistry() *Registry {
 				Type:      FieldJSON,
 				Optional:  false,
 				Sensitive: false,
+				Formatter: FormatJSON,
 			},
 			"attributes": {
 				Name:      "attributes",
@@ -2282,6 +2976,7 @@ func InitRegistry() *Registry {
 				Type:      FieldJSON,
 				Optional:  true,
 				Sensitive: false,
+				Formatter: FormatJSON,
 			},
 		},
 		FieldOrder: []string{
@@ -2318,6 +3013,7 @@ func InitRegistry() *Registry {
 			"guaranteed_leases",
 			"person",
 		},
+		Aggregatable:    []string{},
 		HasStateMachine: true,
 		Immutable:       false,
 		StateMachine: map[string][]string{
@@ -2326,6 +3022,31 @@ func InitRegistry() *Registry {
 			"pending":    {"active", "terminated"},
 			"terminated": {},
 		},
+		description: Description{
+			Entity:    "person_role",
+			EntName:   "PersonRole",
+			Immutable: false,
+			Fields: []FieldDescription{
+				{Name: "person_id", Type: FieldString, Optional: false, Sensitive: false},
+				{Name: "role_type", Type: FieldEnum, Optional: false, Sensitive: false, EnumValues: []string{"tenant", "owner", "property_manager", "maintenance_tech", "leasing_agent", "accountant", "vendor_contact", "guarantor", "emergency_contact", "authorized_occupant", "co_signer"}},
+				{Name: "scope_type", Type: FieldEnum, Optional: false, Sensitive: false, EnumValues: []string{"organization", "portfolio", "property", "building", "space", "lease"}},
+				{Name: "scope_id", Type: FieldString, Optional: false, Sensitive: false},
+				{Name: "status", Type: FieldEnum, Optional: false, Sensitive: false, EnumValues: []string{"active", "inactive", "pending", "terminated"}},
+				{Name: "effective", Type: FieldJSON, Optional: false, Sensitive: false},
+				{Name: "attributes", Type: FieldJSON, Optional: true, Sensitive: false},
+			},
+			Edges: []EdgeDescription{
+				{Name: "leases", Target: "lease", Cardinality: "M2M"},
+				{Name: "guaranteed_leases", Target: "lease", Cardinality: "M2M"},
+				{Name: "person", Target: "person", Cardinality: "M2O"},
+			},
+			Transitions: []TransitionDescription{
+				{From: "active", To: []string{"inactive", "terminated"}},
+				{From: "inactive", To: []string{"active", "terminated"}},
+				{From: "pending", To: []string{"active", "terminated"}},
+				{From: "terminated"},
+			},
+		},
 	})
 
 	r.Register(&EntitySchema{
@@ -2338,6 +3059,7 @@ func InitRegistry() *Registry {
 				Type:      FieldString,
 				Optional:  false,
 				Sensitive: false,
+				Formatter: FormatPlain,
 			},
 			"owner_id": {
 				Name:      "owner_id",
@@ -2345,6 +3067,8 @@ func InitRegistry() *Registry {
 				Type:      FieldString,
 				Optional:  false,
 				Sensitive: false,
+				Formatter: FormatPlain,
+				EdgeFK:    true,
 			},
 			"management_type": {
 				Name:       "management_type",
@@ -2353,6 +3077,7 @@ func InitRegistry() *Registry {
 				Optional:   false,
 				Sensitive:  false,
 				EnumValues: []string{"self_managed", "third_party", "hybrid"},
+				Formatter:  FormatEnum,
 			},
 			"description": {
 				Name:      "description",
@@ -2360,6 +3085,7 @@ func InitRegistry() *Registry {
 				Type:      FieldString,
 				Optional:  true,
 				Sensitive: false,
+				Formatter: FormatPlain,
 			},
 			"status": {
 				Name:       "status",
@@ -2368,6 +3094,7 @@ func InitRegistry() *Registry {
 				Optional:   false,
 				Sensitive:  false,
 				EnumValues: []string{"active", "inactive", "onboarding", "offboarding"},
+				Formatter:  FormatEnum,
 			},
 			"default_chart_of_accounts_id": {
 				Name:      "default_chart_of_accounts_id",
@@ -2375,6 +3102,7 @@ func InitRegistry() *Registry {
 				Type:      FieldString,
 				Optional:  true,
 				Sensitive: false,
+				Formatter: FormatPlain,
 			},
 			"default_bank_account_id": {
 				Name:      "default_bank_account_id",
@@ -2382,6 +3110,7 @@ func InitRegistry() *Registry {
 				Type:      FieldString,
 				Optional:  true,
 				Sensitive: false,
+				Formatter: FormatPlain,
 			},
 		},
 		FieldOrder: []string{
@@ -2418,6 +3147,7 @@ func InitRegistry() *Registry {
 			"owner",
 			"trust_account",
 		},
+		Aggregatable:    []string{},
 		HasStateMachine: true,
 		Immutable:       false,
 		StateMachine: map[string][]string{
@@ -2426,6 +3156,31 @@ func InitRegistry() *Registry {
 			"offboarding": {"inactive"},
 			"onboarding":  {"active"},
 		},
+		description: Description{
+			Entity:    "portfolio",
+			EntName:   "Portfolio",
+			Immutable: false,
+			Fields: []FieldDescription{
+				{Name: "name", Type: FieldString, Optional: false, Sensitive: false},
+				{Name: "owner_id", Type: FieldString, Optional: false, Sensitive: false},
+				{Name: "management_type", Type: FieldEnum, Optional: false, Sensitive: false, EnumValues: []string{"self_managed", "third_party", "hybrid"}},
+				{Name: "description", Type: FieldString, Optional: true, Sensitive: false},
+				{Name: "status", Type: FieldEnum, Optional: false, Sensitive: false, EnumValues: []string{"active", "inactive", "onboarding", "offboarding"}},
+				{Name: "default_chart_of_accounts_id", Type: FieldString, Optional: true, Sensitive: false},
+				{Name: "default_bank_account_id", Type: FieldString, Optional: true, Sensitive: false},
+			},
+			Edges: []EdgeDescription{
+				{Name: "properties", Target: "property", Cardinality: "O2M"},
+				{Name: "owner", Target: "organization", Cardinality: "M2O"},
+				{Name: "trust_account", Target: "bank_account", Cardinality: "O2O"},
+			},
+			Transitions: []TransitionDescription{
+				{From: "active", To: []string{"inactive", "offboarding"}},
+				{From: "inactive", To: []string{"active", "offboarding"}},
+				{From: "offboarding", To: []string{"inactive"}},
+				{From: "onboarding", To: []string{"active"}},
+			},
+		},
 	})
 
 	r.Register(&EntitySchema{
@@ -2438,6 +3193,8 @@ func InitRegistry() *Registry {
 				Type:      FieldString,
 				Optional:  false,
 				Sensitive: false,
+				Formatter: FormatPlain,
+				EdgeFK:    true,
 			},
 			"name": {
 				Name:      "name",
@@ -2445,6 +3202,7 @@ func InitRegistry() *Registry {
 				Type:      FieldString,
 				Optional:  false,
 				Sensitive: false,
+				Formatter: FormatPlain,
 			},
 			"address": {
 				Name:      "address",
@@ -2452,6 +3210,7 @@ func InitRegistry() *Registry {
 				Type:      FieldJSON,
 				Optional:  false,
 				Sensitive: false,
+				Formatter: FormatJSON,
 			},
 			"property_type": {
 				Name:       "property_type",
@@ -2460,6 +3219,7 @@ func InitRegistry() *Registry {
 				Optional:   false,
 				Sensitive:  false,
 				EnumValues: []string{"single_family", "multi_family", "commercial_office", "commercial_retail", "mixed_use", "industrial", "affordable_housing", "student_housing", "senior_living", "vacation_rental", "mobile_home_park", "self_storage", "coworking", "data_center", "medical_office"},
+				Formatter:  FormatEnum,
 			},
 			"status": {
 				Name:       "status",
@@ -2468,6 +3228,7 @@ func InitRegistry() *Registry {
 				Optional:   false,
 				Sensitive:  false,
 				EnumValues: []string{"active", "inactive", "under_renovation", "for_sale", "onboarding"},
+				Formatter:  FormatEnum,
 			},
 			"year_built": {
 				Name:      "year_built",
@@ -2475,6 +3236,7 @@ func InitRegistry() *Registry {
 				Type:      FieldInt,
 				Optional:  false,
 				Sensitive: false,
+				Formatter: FormatPlain,
 			},
 			"total_square_footage": {
 				Name:      "total_square_footage",
@@ -2482,6 +3244,7 @@ func InitRegistry() *Registry {
 				Type:      FieldFloat,
 				Optional:  false,
 				Sensitive: false,
+				Formatter: FormatPlain,
 			},
 			"total_spaces": {
 				Name:      "total_spaces",
@@ -2489,6 +3252,7 @@ func InitRegistry() *Registry {
 				Type:      FieldInt,
 				Optional:  false,
 				Sensitive: false,
+				Formatter: FormatPlain,
 			},
 			"lot_size_sqft": {
 				Name:      "lot_size_sqft",
@@ -2496,6 +3260,7 @@ func InitRegistry() *Registry {
 				Type:      FieldFloat,
 				Optional:  true,
 				Sensitive: false,
+				Formatter: FormatPlain,
 			},
 			"stories": {
 				Name:      "stories",
@@ -2503,6 +3268,7 @@ func InitRegistry() *Registry {
 				Type:      FieldInt,
 				Optional:  true,
 				Sensitive: false,
+				Formatter: FormatPlain,
 			},
 			"parking_spaces": {
 				Name:      "parking_spaces",
@@ -2510,6 +3276,7 @@ func InitRegistry() *Registry {
 				Type:      FieldInt,
 				Optional:  true,
 				Sensitive: false,
+				Formatter: FormatPlain,
 			},
 			"jurisdiction_id": {
 				Name:      "jurisdiction_id",
@@ -2517,6 +3284,7 @@ func InitRegistry() *Registry {
 				Type:      FieldString,
 				Optional:  true,
 				Sensitive: false,
+				Formatter: FormatPlain,
 			},
 			"rent_controlled": {
 				Name:      "rent_controlled",
@@ -2524,6 +3292,7 @@ func InitRegistry() *Registry {
 				Type:      FieldBool,
 				Optional:  false,
 				Sensitive: false,
+				Formatter: FormatPlain,
 			},
 			"compliance_programs": {
 				Name:      "compliance_programs",
@@ -2531,6 +3300,7 @@ func InitRegistry() *Registry {
 				Type:      FieldJSON,
 				Optional:  true,
 				Sensitive: false,
+				Formatter: FormatJSON,
 			},
 			"requires_lead_disclosure": {
 				Name:      "requires_lead_disclosure",
@@ -2538,6 +3308,7 @@ func InitRegistry() *Registry {
 				Type:      FieldBool,
 				Optional:  false,
 				Sensitive: false,
+				Formatter: FormatPlain,
 			},
 			"chart_of_accounts_id": {
 				Name:      "chart_of_accounts_id",
@@ -2545,6 +3316,7 @@ func InitRegistry() *Registry {
 				Type:      FieldString,
 				Optional:  true,
 				Sensitive: false,
+				Formatter: FormatPlain,
 			},
 			"bank_account_id": {
 				Name:      "bank_account_id",
@@ -2552,6 +3324,8 @@ func InitRegistry() *Registry {
 				Type:      FieldString,
 				Optional:  true,
 				Sensitive: false,
+				Formatter: FormatPlain,
+				EdgeFK:    true,
 			},
 			"insurance_policy_number": {
 				Name:      "insurance_policy_number",
@@ -2559,6 +3333,7 @@ func InitRegistry() *Registry {
 				Type:      FieldString,
 				Optional:  true,
 				Sensitive: false,
+				Formatter: FormatPlain,
 			},
 			"insurance_expiry": {
 				Name:      "insurance_expiry",
@@ -2566,6 +3341,7 @@ func InitRegistry() *Registry {
 				Type:      FieldTime,
 				Optional:  true,
 				Sensitive: false,
+				Formatter: FormatTimestamp,
 			},
 		},
 		FieldOrder: []string{
@@ -2642,6 +3418,14 @@ func InitRegistry() *Registry {
 			"ledger_entries",
 			"property_jurisdictions",
 		},
+		Aggregatable: []string{
+			"year_built",
+			"total_square_footage",
+			"total_spaces",
+			"lot_size_sqft",
+			"stories",
+			"parking_spaces",
+		},
 		HasStateMachine: true,
 		Immutable:       false,
 		StateMachine: map[string][]string{
@@ -2651,6 +3435,48 @@ func InitRegistry() *Registry {
 			"onboarding":       {"active"},
 			"under_renovation": {"active", "for_sale"},
 		},
+		description: Description{
+			Entity:    "property",
+			EntName:   "Property",
+			Immutable: false,
+			Fields: []FieldDescription{
+				{Name: "portfolio_id", Type: FieldString, Optional: false, Sensitive: false},
+				{Name: "name", Type: FieldString, Optional: false, Sensitive: false},
+				{Name: "address", Type: FieldJSON, Optional: false, Sensitive: false},
+				{Name: "property_type", Type: FieldEnum, Optional: false, Sensitive: false, EnumValues: []string{"single_family", "multi_family", "commercial_office", "commercial_retail", "mixed_use", "industrial", "affordable_housing", "student_housing", "senior_living", "vacation_rental", "mobile_home_park", "self_storage", "coworking", "data_center", "medical_office"}},
+				{Name: "status", Type: FieldEnum, Optional: false, Sensitive: false, EnumValues: []string{"active", "inactive", "under_renovation", "for_sale", "onboarding"}},
+				{Name: "year_built", Type: FieldInt, Optional: false, Sensitive: false},
+				{Name: "total_square_footage", Type: FieldFloat, Optional: false, Sensitive: false},
+				{Name: "total_spaces", Type: FieldInt, Optional: false, Sensitive: false},
+				{Name: "lot_size_sqft", Type: FieldFloat, Optional: true, Sensitive: false},
+				{Name: "stories", Type: FieldInt, Optional: true, Sensitive: false},
+				{Name: "parking_spaces", Type: FieldInt, Optional: true, Sensitive: false},
+				{Name: "jurisdiction_id", Type: FieldString, Optional: true, Sensitive: false},
+				{Name: "rent_controlled", Type: FieldBool, Optional: false, Sensitive: false},
+				{Name: "compliance_programs", Type: FieldJSON, Optional: true, Sensitive: false},
+				{Name: "requires_lead_disclosure", Type: FieldBool, Optional: false, Sensitive: false},
+				{Name: "chart_of_accounts_id", Type: FieldString, Optional: true, Sensitive: false},
+				{Name: "bank_account_id", Type: FieldString, Optional: true, Sensitive: false},
+				{Name: "insurance_policy_number", Type: FieldString, Optional: true, Sensitive: false},
+				{Name: "insurance_expiry", Type: FieldTime, Optional: true, Sensitive: false},
+			},
+			Edges: []EdgeDescription{
+				{Name: "portfolio", Target: "portfolio", Cardinality: "M2O"},
+				{Name: "buildings", Target: "building", Cardinality: "O2M"},
+				{Name: "spaces", Target: "space", Cardinality: "O2M"},
+				{Name: "bank_account", Target: "bank_account", Cardinality: "M2O"},
+				{Name: "applications", Target: "application", Cardinality: "O2M"},
+				{Name: "ledger_entries", Target: "ledger_entry", Cardinality: "O2M"},
+				{Name: "property_jurisdictions", Target: "property_jurisdiction", Cardinality: "O2M"},
+			},
+			Transitions: []TransitionDescription{
+				{From: "active", To: []string{"inactive", "under_renovation", "for_sale"}},
+				{From: "for_sale", To: []string{"active", "inactive"}},
+				{From: "inactive", To: []string{"active"}},
+				{From: "onboarding", To: []string{"active"}},
+				{From: "under_renovation", To: []string{"active", "for_sale"}},
+			},
+		},
 	})
 
 	r.Register(&EntitySchema{
@@ -2663,6 +3489,8 @@ func InitRegistry() *Registry {
 				Type:      FieldString,
 				Optional:  false,
 				Sensitive: false,
+				Formatter: FormatPlain,
+				EdgeFK:    true,
 			},
 			"jurisdiction_id": {
 				Name:      "jurisdiction_id",
@@ -2670,6 +3498,8 @@ func InitRegistry() *Registry {
 				Type:      FieldString,
 				Optional:  false,
 				Sensitive: false,
+				Formatter: FormatPlain,
+				EdgeFK:    true,
 			},
 			"effective_date": {
 				Name:      "effective_date",
@@ -2677,6 +3507,7 @@ func InitRegistry() *Registry {
 				Type:      FieldTime,
 				Optional:  false,
 				Sensitive: false,
+				Formatter: FormatTimestamp,
 			},
 			"end_date": {
 				Name:      "end_date",
@@ -2684,6 +3515,7 @@ func InitRegistry() *Registry {
 				Type:      FieldTime,
 				Optional:  true,
 				Sensitive: false,
+				Formatter: FormatTimestamp,
 			},
 			"lookup_source": {
 				Name:       "lookup_source",
@@ -2692,6 +3524,7 @@ func InitRegistry() *Registry {
 				Optional:   false,
 				Sensitive:  false,
 				EnumValues: []string{"address_geocode", "manual", "api_lookup", "imported"},
+				Formatter:  FormatEnum,
 			},
 			"verified": {
 				Name:      "verified",
@@ -2699,6 +3532,7 @@ func InitRegistry() *Registry {
 				Type:      FieldBool,
 				Optional:  false,
 				Sensitive: false,
+				Formatter: FormatPlain,
 			},
 			"verified_at": {
 				Name:      "verified_at",
@@ -2706,6 +3540,7 @@ func InitRegistry() *Registry {
 				Type:      FieldTime,
 				Optional:  true,
 				Sensitive: false,
+				Formatter: FormatTimestamp,
 			},
 			"verified_by": {
 				Name:      "verified_by",
@@ -2713,6 +3548,7 @@ func InitRegistry() *Registry {
 				Type:      FieldString,
 				Optional:  true,
 				Sensitive: false,
+				Formatter: FormatPlain,
 			},
 		},
 		FieldOrder: []string{
@@ -2743,8 +3579,28 @@ func InitRegistry() *Registry {
 			"property",
 			"jurisdiction",
 		},
+		Aggregatable:    []string{},
 		HasStateMachine: false,
 		Immutable:       false,
+		description: Description{
+			Entity:    "property_jurisdiction",
+			EntName:   "PropertyJurisdiction",
+			Immutable: false,
+			Fields: []FieldDescription{
+				{Name: "property_id", Type: FieldString, Optional: false, Sensitive: false},
+				{Name: "jurisdiction_id", Type: FieldString, Optional: false, Sensitive: false},
+				{Name: "effective_date", Type: FieldTime, Optional: false, Sensitive: false},
+				{Name: "end_date", Type: FieldTime, Optional: true, Sensitive: false},
+				{Name: "lookup_source", Type: FieldEnum, Optional: false, Sensitive: false, EnumValues: []string{"address_geocode", "manual", "api_lookup", "imported"}},
+				{Name: "verified", Type: FieldBool, Optional: false, Sensitive: false},
+				{Name: "verified_at", Type: FieldTime, Optional: true, Sensitive: false},
+				{Name: "verified_by", Type: FieldString, Optional: true, Sensitive: false},
+			},
+			Edges: []EdgeDescription{
+				{Name: "property", Target: "property", Cardinality: "M2O"},
+				{Name: "jurisdiction", Target: "jurisdiction", Cardinality: "M2O"},
+			},
+		},
 	})
 
 	r.Register(&EntitySchema{
@@ -2757,6 +3613,8 @@ func InitRegistry() *Registry {
 				Type:      FieldString,
 				Optional:  false,
 				Sensitive: false,
+				Formatter: FormatPlain,
+				EdgeFK:    true,
 			},
 			"period_start": {
 				Name:      "period_start",
@@ -2764,6 +3622,7 @@ func InitRegistry() *Registry {
 				Type:      FieldTime,
 				Optional:  false,
 				Sensitive: false,
+				Formatter: FormatTimestamp,
 			},
 			"period_end": {
 				Name:      "period_end",
@@ -2771,6 +3630,7 @@ func InitRegistry() *Registry {
 				Type:      FieldTime,
 				Optional:  false,
 				Sensitive: false,
+				Formatter: FormatTimestamp,
 			},
 			"statement_date": {
 				Name:      "statement_date",
@@ -2778,48 +3638,61 @@ func InitRegistry() *Registry {
 				Type:      FieldTime,
 				Optional:  false,
 				Sensitive: false,
+				Formatter: FormatTimestamp,
 			},
 			"statement_balance_amount_cents": {
-				Name:      "statement_balance_amount_cents",
-				EntColumn: "statement_balance_amount_cents",
-				Type:      FieldInt64,
-				Optional:  false,
-				Sensitive: false,
+				Name:       "statement_balance_amount_cents",
+				EntColumn:  "statement_balance_amount_cents",
+				Type:       FieldInt64,
+				Optional:   false,
+				Sensitive:  false,
+				Formatter:  FormatMoneyPair,
+				MoneyGroup: "statement_balance",
 			},
 			"statement_balance_currency": {
-				Name:      "statement_balance_currency",
-				EntColumn: "statement_balance_currency",
-				Type:      FieldString,
-				Optional:  false,
-				Sensitive: false,
+				Name:       "statement_balance_currency",
+				EntColumn:  "statement_balance_currency",
+				Type:       FieldString,
+				Optional:   false,
+				Sensitive:  false,
+				Formatter:  FormatMoneyPair,
+				MoneyGroup: "statement_balance",
 			},
 			"gl_balance_amount_cents": {
-				Name:      "gl_balance_amount_cents",
-				EntColumn: "gl_balance_amount_cents",
-				Type:      FieldInt64,
-				Optional:  false,
-				Sensitive: false,
+				Name:       "gl_balance_amount_cents",
+				EntColumn:  "gl_balance_amount_cents",
+				Type:       FieldInt64,
+				Optional:   false,
+				Sensitive:  false,
+				Formatter:  FormatMoneyPair,
+				MoneyGroup: "gl_balance",
 			},
 			"gl_balance_currency": {
-				Name:      "gl_balance_currency",
-				EntColumn: "gl_balance_currency",
-				Type:      FieldString,
-				Optional:  false,
-				Sensitive: false,
+				Name:       "gl_balance_currency",
+				EntColumn:  "gl_balance_currency",
+				Type:       FieldString,
+				Optional:   false,
+				Sensitive:  false,
+				Formatter:  FormatMoneyPair,
+				MoneyGroup: "gl_balance",
 			},
 			"difference_amount_cents": {
-				Name:      "difference_amount_cents",
-				EntColumn: "difference_amount_cents",
-				Type:      FieldInt64,
-				Optional:  true,
-				Sensitive: false,
+				Name:       "difference_amount_cents",
+				EntColumn:  "difference_amount_cents",
+				Type:       FieldInt64,
+				Optional:   true,
+				Sensitive:  false,
+				Formatter:  FormatMoneyPair,
+				MoneyGroup: "difference",
 			},
 			"difference_currency": {
-				Name:      "difference_currency",
-				EntColumn: "difference_currency",
-				Type:      FieldString,
-				Optional:  true,
-				Sensitive: false,
+				Name:       "difference_currency",
+				EntColumn:  "difference_currency",
+				Type:       FieldString,
+				Optional:   true,
+				Sensitive:  false,
+				Formatter:  FormatMoneyPair,
+				MoneyGroup: "difference",
 			},
 			"status": {
 				Name:       "status",
@@ -2828,6 +3701,7 @@ func InitRegistry() *Registry {
 				Optional:   false,
 				Sensitive:  false,
 				EnumValues: []string{"in_progress", "balanced", "unbalanced", "approved"},
+				Formatter:  FormatEnum,
 			},
 			"unreconciled_items": {
 				Name:      "unreconciled_items",
@@ -2835,6 +3709,7 @@ func InitRegistry() *Registry {
 				Type:      FieldInt,
 				Optional:  true,
 				Sensitive: false,
+				Formatter: FormatPlain,
 			},
 			"reconciled_by": {
 				Name:      "reconciled_by",
@@ -2842,6 +3717,7 @@ func InitRegistry() *Registry {
 				Type:      FieldString,
 				Optional:  true,
 				Sensitive: false,
+				Formatter: FormatPlain,
 			},
 			"reconciled_at": {
 				Name:      "reconciled_at",
@@ -2849,6 +3725,7 @@ func InitRegistry() *Registry {
 				Type:      FieldTime,
 				Optional:  true,
 				Sensitive: false,
+				Formatter: FormatTimestamp,
 			},
 			"approved_by": {
 				Name:      "approved_by",
@@ -2856,6 +3733,7 @@ func InitRegistry() *Registry {
 				Type:      FieldString,
 				Optional:  true,
 				Sensitive: false,
+				Formatter: FormatPlain,
 			},
 			"approved_at": {
 				Name:      "approved_at",
@@ -2863,6 +3741,7 @@ func InitRegistry() *Registry {
 				Type:      FieldTime,
 				Optional:  true,
 				Sensitive: false,
+				Formatter: FormatTimestamp,
 			},
 		},
 		FieldOrder: []string{
@@ -2894,6 +3773,12 @@ func InitRegistry() *Registry {
 		EdgeOrder: []string{
 			"bank_account",
 		},
+		Aggregatable: []string{
+			"statement_balance_amount_cents",
+			"gl_balance_amount_cents",
+			"difference_amount_cents",
+			"unreconciled_items",
+		},
 		HasStateMachine: true,
 		Immutable:       false,
 		StateMachine: map[string][]string{
@@ -2902,6 +3787,38 @@ func InitRegistry() *Registry {
 			"in_progress": {"balanced", "unbalanced"},
 			"unbalanced":  {"in_progress"},
 		},
+		description: Description{
+			Entity:    "reconciliation",
+			EntName:   "Reconciliation",
+			Immutable: false,
+			Fields: []FieldDescription{
+				{Name: "bank_account_id", Type: FieldString, Optional: false, Sensitive: false},
+				{Name: "period_start", Type: FieldTime, Optional: false, Sensitive: false},
+				{Name: "period_end", Type: FieldTime, Optional: false, Sensitive: false},
+				{Name: "statement_date", Type: FieldTime, Optional: false, Sensitive: false},
+				{Name: "statement_balance_amount_cents", Type: FieldInt64, Optional: false, Sensitive: false},
+				{Name: "statement_balance_currency", Type: FieldString, Optional: false, Sensitive: false},
+				{Name: "gl_balance_amount_cents", Type: FieldInt64, Optional: false, Sensitive: false},
+				{Name: "gl_balance_currency", Type: FieldString, Optional: false, Sensitive: false},
+				{Name: "difference_amount_cents", Type: FieldInt64, Optional: true, Sensitive: false},
+				{Name: "difference_currency", Type: FieldString, Optional: true, Sensitive: false},
+				{Name: "status", Type: FieldEnum, Optional: false, Sensitive: false, EnumValues: []string{"in_progress", "balanced", "unbalanced", "approved"}},
+				{Name: "unreconciled_items", Type: FieldInt, Optional: true, Sensitive: false},
+				{Name: "reconciled_by", Type: FieldString, Optional: true, Sensitive: false},
+				{Name: "reconciled_at", Type: FieldTime, Optional: true, Sensitive: false},
+				{Name: "approved_by", Type: FieldString, Optional: true, Sensitive: false},
+				{Name: "approved_at", Type: FieldTime, Optional: true, Sensitive: false},
+			},
+			Edges: []EdgeDescription{
+				{Name: "bank_account", Target: "bank_account", Cardinality: "M2O"},
+			},
+			Transitions: []TransitionDescription{
+				{From: "approved"},
+				{From: "balanced", To: []string{"approved", "in_progress"}},
+				{From: "in_progress", To: []string{"balanced", "unbalanced"}},
+				{From: "unbalanced", To: []string{"in_progress"}},
+			},
+		},
 	})
 
 	r.Register(&EntitySchema{
@@ -2914,6 +3831,8 @@ func InitRegistry() *Registry {
 				Type:      FieldString,
 				Optional:  false,
 				Sensitive: false,
+				Formatter: FormatPlain,
+				EdgeFK:    true,
 			},
 			"space_number": {
 				Name:      "space_number",
@@ -2921,6 +3840,7 @@ func InitRegistry() *Registry {
 				Type:      FieldString,
 				Optional:  false,
 				Sensitive: false,
+				Formatter: FormatPlain,
 			},
 			"space_type": {
 				Name:       "space_type",
@@ -2929,6 +3849,7 @@ func InitRegistry() *Registry {
 				Optional:   false,
 				Sensitive:  false,
 				EnumValues: []string{"residential_unit", "commercial_office", "commercial_retail", "storage", "parking", "common_area", "industrial", "lot_pad", "bed_space", "desk_space", "parking_garage", "private_office", "warehouse", "amenity", "rack", "cage", "server_room", "other"},
+				Formatter:  FormatEnum,
 			},
 			"status": {
 				Name:       "status",
@@ -2937,6 +3858,7 @@ func InitRegistry() *Registry {
 				Optional:   false,
 				Sensitive:  false,
 				EnumValues: []string{"vacant", "occupied", "notice_given", "make_ready", "down", "model", "reserved", "owner_occupied"},
+				Formatter:  FormatEnum,
 			},
 			"building_id": {
 				Name:      "building_id",
@@ -2944,6 +3866,8 @@ func InitRegistry() *Registry {
 				Type:      FieldString,
 				Optional:  true,
 				Sensitive: false,
+				Formatter: FormatPlain,
+				EdgeFK:    true,
 			},
 			"parent_space_id": {
 				Name:      "parent_space_id",
@@ -2951,6 +3875,8 @@ func InitRegistry() *Registry {
 				Type:      FieldString,
 				Optional:  true,
 				Sensitive: false,
+				Formatter: FormatPlain,
+				EdgeFK:    true,
 			},
 			"leasable": {
 				Name:      "leasable",
@@ -2958,6 +3884,7 @@ func InitRegistry() *Registry {
 				Type:      FieldBool,
 				Optional:  false,
 				Sensitive: false,
+				Formatter: FormatPlain,
 			},
 			"shared_with_parent": {
 				Name:      "shared_with_parent",
@@ -2965,6 +3892,7 @@ func InitRegistry() *Registry {
 				Type:      FieldBool,
 				Optional:  false,
 				Sensitive: false,
+				Formatter: FormatPlain,
 			},
 			"square_footage": {
 				Name:      "square_footage",
@@ -2972,6 +3900,7 @@ func InitRegistry() *Registry {
 				Type:      FieldFloat,
 				Optional:  false,
 				Sensitive: false,
+				Formatter: FormatPlain,
 			},
 			"bedrooms": {
 				Name:      "bedrooms",
@@ -2979,6 +3908,7 @@ func InitRegistry() *Registry {
 				Type:      FieldInt,
 				Optional:  true,
 				Sensitive: false,
+				Formatter: FormatPlain,
 			},
 			"bathrooms": {
 				Name:      "bathrooms",
@@ -2986,6 +3916,7 @@ func InitRegistry() *Registry {
 				Type:      FieldFloat,
 				Optional:  true,
 				Sensitive: false,
+				Formatter: FormatPlain,
 			},
 			"floor": {
 				Name:      "floor",
@@ -2993,6 +3924,7 @@ func InitRegistry() *Registry {
 				Type:      FieldInt,
 				Optional:  true,
 				Sensitive: false,
+				Formatter: FormatPlain,
 			},
 			"amenities": {
 				Name:      "amenities",
@@ -3000,6 +3932,7 @@ func InitRegistry() *Registry {
 				Type:      FieldJSON,
 				Optional:  true,
 				Sensitive: false,
+				Formatter: FormatJSON,
 			},
 			"floor_plan": {
 				Name:      "floor_plan",
@@ -3007,6 +3940,7 @@ func InitRegistry() *Registry {
 				Type:      FieldString,
 				Optional:  true,
 				Sensitive: false,
+				Formatter: FormatPlain,
 			},
 			"ada_accessible": {
 				Name:      "ada_accessible",
@@ -3014,6 +3948,7 @@ func InitRegistry() *Registry {
 				Type:      FieldBool,
 				Optional:  false,
 				Sensitive: false,
+				Formatter: FormatPlain,
 			},
 			"pet_friendly": {
 				Name:      "pet_friendly",
@@ -3021,6 +3956,7 @@ func InitRegistry() *Registry {
 				Type:      FieldBool,
 				Optional:  false,
 				Sensitive: false,
+				Formatter: FormatPlain,
 			},
 			"furnished": {
 				Name:      "furnished",
@@ -3028,6 +3964,7 @@ func InitRegistry() *Registry {
 				Type:      FieldBool,
 				Optional:  false,
 				Sensitive: false,
+				Formatter: FormatPlain,
 			},
 			"specialized_infrastructure": {
 				Name:      "specialized_infrastructure",
@@ -3035,20 +3972,25 @@ func InitRegistry() *Registry {
 				Type:      FieldJSON,
 				Optional:  true,
 				Sensitive: false,
+				Formatter: FormatJSON,
 			},
 			"market_rent_amount_cents": {
-				Name:      "market_rent_amount_cents",
-				EntColumn: "market_rent_amount_cents",
-				Type:      FieldInt64,
-				Optional:  true,
-				Sensitive: false,
+				Name:       "market_rent_amount_cents",
+				EntColumn:  "market_rent_amount_cents",
+				Type:       FieldInt64,
+				Optional:   true,
+				Sensitive:  false,
+				Formatter:  FormatMoneyPair,
+				MoneyGroup: "market_rent",
 			},
 			"market_rent_currency": {
-				Name:      "market_rent_currency",
-				EntColumn: "market_rent_currency",
-				Type:      FieldString,
-				Optional:  true,
-				Sensitive: false,
+				Name:       "market_rent_currency",
+				EntColumn:  "market_rent_currency",
+				Type:       FieldString,
+				Optional:   true,
+				Sensitive:  false,
+				Formatter:  FormatMoneyPair,
+				MoneyGroup: "market_rent",
 			},
 			"ami_restriction": {
 				Name:      "ami_restriction",
@@ -3056,6 +3998,7 @@ func InitRegistry() *Registry {
 				Type:      FieldInt,
 				Optional:  true,
 				Sensitive: false,
+				Formatter: FormatPlain,
 			},
 			"active_lease_id": {
 				Name:      "active_lease_id",
@@ -3063,6 +4006,7 @@ func InitRegistry() *Registry {
 				Type:      FieldString,
 				Optional:  true,
 				Sensitive: false,
+				Formatter: FormatPlain,
 			},
 		},
 		FieldOrder: []string{
@@ -3142,6 +4086,14 @@ func InitRegistry() *Registry {
 			"lease_spaces",
 			"ledger_entries",
 		},
+		Aggregatable: []string{
+			"square_footage",
+			"bedrooms",
+			"bathrooms",
+			"floor",
+			"market_rent_amount_cents",
+			"ami_restriction",
+		},
 		HasStateMachine: true,
 		Immutable:       false,
 		StateMachine: map[string][]string{
@@ -3154,6 +4106,54 @@ func InitRegistry() *Registry {
 			"reserved":       {"vacant", "occupied"},
 			"vacant":         {"occupied", "make_ready", "down", "model", "reserved"},
 		},
+		description: Description{
+			Entity:    "space",
+			EntName:   "Space",
+			Immutable: false,
+			Fields: []FieldDescription{
+				{Name: "property_id", Type: FieldString, Optional: false, Sensitive: false},
+				{Name: "space_number", Type: FieldString, Optional: false, Sensitive: false},
+				{Name: "space_type", Type: FieldEnum, Optional: false, Sensitive: false, EnumValues: []string{"residential_unit", "commercial_office", "commercial_retail", "storage", "parking", "common_area", "industrial", "lot_pad", "bed_space", "desk_space", "parking_garage", "private_office", "warehouse", "amenity", "rack", "cage", "server_room", "other"}},
+				{Name: "status", Type: FieldEnum, Optional: false, Sensitive: false, EnumValues: []string{"vacant", "occupied", "notice_given", "make_ready", "down", "model", "reserved", "owner_occupied"}},
+				{Name: "building_id", Type: FieldString, Optional: true, Sensitive: false},
+				{Name: "parent_space_id", Type: FieldString, Optional: true, Sensitive: false},
+				{Name: "leasable", Type: FieldBool, Optional: false, Sensitive: false},
+				{Name: "shared_with_parent", Type: FieldBool, Optional: false, Sensitive: false},
+				{Name: "square_footage", Type: FieldFloat, Optional: false, Sensitive: false},
+				{Name: "bedrooms", Type: FieldInt, Optional: true, Sensitive: false},
+				{Name: "bathrooms", Type: FieldFloat, Optional: true, Sensitive: false},
+				{Name: "floor", Type: FieldInt, Optional: true, Sensitive: false},
+				{Name: "amenities", Type: FieldJSON, Optional: true, Sensitive: false},
+				{Name: "floor_plan", Type: FieldString, Optional: true, Sensitive: false},
+				{Name: "ada_accessible", Type: FieldBool, Optional: false, Sensitive: false},
+				{Name: "pet_friendly", Type: FieldBool, Optional: false, Sensitive: false},
+				{Name: "furnished", Type: FieldBool, Optional: false, Sensitive: false},
+				{Name: "specialized_infrastructure", Type: FieldJSON, Optional: true, Sensitive: false},
+				{Name: "market_rent_amount_cents", Type: FieldInt64, Optional: true, Sensitive: false},
+				{Name: "market_rent_currency", Type: FieldString, Optional: true, Sensitive: false},
+				{Name: "ami_restriction", Type: FieldInt, Optional: true, Sensitive: false},
+				{Name: "active_lease_id", Type: FieldString, Optional: true, Sensitive: false},
+			},
+			Edges: []EdgeDescription{
+				{Name: "property", Target: "property", Cardinality: "M2O"},
+				{Name: "building", Target: "building", Cardinality: "M2O"},
+				{Name: "children", Target: "space", Cardinality: "O2M"},
+				{Name: "parent_space", Target: "space", Cardinality: "M2O"},
+				{Name: "applications", Target: "application", Cardinality: "O2M"},
+				{Name: "lease_spaces", Target: "lease_space", Cardinality: "O2M"},
+				{Name: "ledger_entries", Target: "ledger_entry", Cardinality: "O2M"},
+			},
+			Transitions: []TransitionDescription{
+				{From: "down", To: []string{"make_ready", "vacant"}},
+				{From: "make_ready", To: []string{"vacant", "down"}},
+				{From: "model", To: []string{"vacant", "occupied"}},
+				{From: "notice_given", To: []string{"make_ready", "occupied"}},
+				{From: "occupied", To: []string{"notice_given"}},
+				{From: "owner_occupied", To: []string{"vacant"}},
+				{From: "reserved", To: []string{"vacant", "occupied"}},
+				{From: "vacant", To: []string{"occupied", "make_ready", "down", "model", "reserved"}},
+			},
+		},
 	})
 
 	return r
@@ -5,6 +5,8 @@
 // and executor (dispatch).
 package schema
 
+import "fmt"
+
 // FieldType classifies how PQL treats a field for comparison operators
 // and value coercion.
 type FieldType int
@@ -57,6 +59,46 @@ func (ft FieldType) Comparable() bool {
 	}
 }
 
+// Aggregatable returns true if the field type supports sum and avg.
+func (ft FieldType) Aggregatable() bool {
+	switch ft {
+	case FieldInt, FieldInt64, FieldFloat:
+		return true
+	default:
+		return false
+	}
+}
+
+// Formatter selects how the REPL display layer renders a field's values.
+type Formatter int
+
+const (
+	FormatPlain     Formatter = iota
+	FormatMoneyPair           // one half of a flattened money field; recombine by MoneyGroup
+	FormatTimestamp           // RFC 3339
+	FormatEnum
+	FormatUUID
+	FormatJSON
+)
+
+// String returns the formatter name.
+func (f Formatter) String() string {
+	switch f {
+	case FormatMoneyPair:
+		return "money_pair"
+	case FormatTimestamp:
+		return "timestamp"
+	case FormatEnum:
+		return "enum"
+	case FormatUUID:
+		return "uuid"
+	case FormatJSON:
+		return "json"
+	default:
+		return "plain"
+	}
+}
+
 // FieldMeta describes a single field on an entity.
 type FieldMeta struct {
 	Name      string    // PQL name (snake_case, e.g. "lease_type")
@@ -65,6 +107,15 @@ type FieldMeta struct {
 	Optional  bool      // Whether the field is nullable
 	Sensitive bool      // Whether the field is PII/@sensitive
 	EnumValues []string // Non-nil for enum fields
+	Formatter  Formatter // How the display layer renders values
+	MoneyGroup string    // For FormatMoneyPair: the money field both halves belong to (e.g. "base_rent")
+	EdgeFK     bool      // FK stored by an Ent edge rather than as a column of its own
+}
+
+// Orderable reports whether results can be sorted by the field. JSON values
+// have no ordering and edge FKs have no column of their own.
+func (fm *FieldMeta) Orderable() bool {
+	return fm.Type != FieldJSON && !fm.EdgeFK
 }
 
 // EdgeMeta describes a relationship edge on an entity.
@@ -83,9 +134,53 @@ type EntitySchema struct {
 	Edges           map[string]*EdgeMeta  // edge name -> metadata
 	FieldOrder      []string              // fields in ontology order
 	EdgeOrder       []string              // edges in ontology order
+	Aggregatable    []string              // numeric fields valid for sum/avg, in ontology order
 	HasStateMachine bool
 	Immutable       bool
 	StateMachine    map[string][]string   // from_status -> valid targets (nil if !HasStateMachine)
+
+	description Description // prebuilt by gen_registry.go
+}
+
+// Describe returns the entity's schema description, as shown by
+// `describe <entity>`.
+func (es *EntitySchema) Describe() Description {
+	return es.description
+}
+
+// Description summarizes an entity for schema introspection. It is generated
+// as a literal alongside the rest of the registry, so describing an entity
+// neither walks the field maps nor reflects over Ent types.
+type Description struct {
+	Entity      string // PQL name
+	EntName     string // Go type name
+	Immutable   bool
+	Fields      []FieldDescription      // in ontology order
+	Edges       []EdgeDescription       // in ontology order
+	Transitions []TransitionDescription // by source state; nil without a state machine
+}
+
+// FieldDescription is one field line of a Description.
+type FieldDescription struct {
+	Name       string
+	Type       FieldType
+	Optional   bool
+	Sensitive  bool
+	EnumValues []string
+}
+
+// EdgeDescription is one edge line of a Description.
+type EdgeDescription struct {
+	Name        string
+	Target      string
+	Cardinality string
+}
+
+// TransitionDescription lists the states reachable from one source state.
+// To is empty for terminal states.
+type TransitionDescription struct {
+	From string
+	To   []string
 }
 
 // Registry holds schema metadata for all entities. It is populated at init
@@ -133,3 +228,31 @@ func (r *Registry) StateMachine(entity string) map[string][]string {
 func (r *Registry) AllEntities() map[string]*EntitySchema {
 	return r.entities
 }
+
+// ValidateAggregate checks an aggregate call such as sum(base_rent_amount_cents)
+// before it is executed. count takes no field (or "*"); sum and avg require
+// one of the entity's numeric fields.
+func (r *Registry) ValidateAggregate(entity, fn, field string) error {
+	es := r.entities[entity]
+	if es == nil {
+		return fmt.Errorf("unknown entity '%s'", entity)
+	}
+	switch fn {
+	case "count":
+		if field != "" && field != "*" {
+			return fmt.Errorf("count takes no field, got '%s'", field)
+		}
+		return nil
+	case "sum", "avg":
+	default:
+		return fmt.Errorf("unknown aggregate '%s': expected count, sum or avg", fn)
+	}
+	fm := es.Fields[field]
+	if fm == nil {
+		return fmt.Errorf("entity '%s' has no field '%s'", entity, field)
+	}
+	if !fm.Type.Aggregatable() {
+		return fmt.Errorf("cannot %s %s field '%s'", fn, fm.Type, field)
+	}
+	return nil
+}
@@ -205,6 +205,17 @@ func (h *Handler) handleExecute(ctx context.Context, conn *websocket.Conn, sess
 			})
 		}
 
+		// Send aggregate
+		if result.Aggregate != nil {
+			h.send(ctx, conn, ServerMessage{
+				Type:      "rows",
+				RequestID: msg.ID,
+				Data: map[string]float64{
+					result.Aggregate.Label(): result.Aggregate.Value,
+				},
+			})
+		}
+
 		// Send done
 		elapsed := time.Since(start)
 		h.send(ctx, conn, ServerMessage{
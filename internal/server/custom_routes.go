@@ -12,11 +12,12 @@ import (
 )
 
 // RegisterCustomRoutes registers hand-written route mappings for custom
-// operations that don't follow the standard CRUD path pattern.
-func RegisterCustomRoutes(r chi.Router, client *ent.Client) {
-	lh := handler.NewLeaseHandler(client)
-	proph := handler.NewPropertyHandler(client)
-	ah := handler.NewAccountingHandler(client)
+// operations that don't follow the standard CRUD path pattern. Generated
+// mutations reused here append to audit like their generated routes.
+func RegisterCustomRoutes(r chi.Router, client *ent.Client, audit handler.AuditRecorder) {
+	lh := handler.NewLeaseHandler(client, audit)
+	proph := handler.NewPropertyHandler(client, audit)
+	ah := handler.NewAccountingHandler(client, audit)
 
 	// Lease search and ledger queries.
 	r.Post("/v1/leases/search", lh.SearchLeases)
@@ -10,52 +10,81 @@ import (
 )
 
 // RegisterRoutes registers all generated HTTP routes on the given router.
-func RegisterRoutes(r chi.Router, client *ent.Client) {
+// Mutations append to audit when it is non-nil.
+func RegisterRoutes(r chi.Router, client *ent.Client, audit handler.AuditRecorder) {
 	// Health check
 	r.Get("/healthz", func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Set("Content-Type", "application/json")
 		w.Write([]byte(`{"status":"ok"}`))
 	})
 
-	ph := handler.NewPersonHandler(client)
-	proph := handler.NewPropertyHandler(client)
-	lh := handler.NewLeaseHandler(client)
-	ah := handler.NewAccountingHandler(client)
-	jh := handler.NewJurisdictionHandler(client)
+	ph := handler.NewPersonHandler(client, audit)
+	proph := handler.NewPropertyHandler(client, audit)
+	lh := handler.NewLeaseHandler(client, audit)
+	ah := handler.NewAccountingHandler(client, audit)
+	jh := handler.NewJurisdictionHandler(client, audit)
 
 	r.Post("/v1/persons", ph.CreatePerson)
 	r.Get("/v1/persons/{id}", ph.GetPerson)
+	r.Get("/v1/persons/{id}/roles", ph.ListRolesOfPerson)
+	r.Get("/v1/persons/{id}/organizations", ph.ListOrganizationsOfPerson)
+	r.Get("/v1/persons/{id}/ledger-entries", ph.ListLedgerEntriesOfPerson)
+	r.Get("/v1/persons/{id}/applications", ph.ListApplicationsOfPerson)
+	r.Head("/v1/persons/{id}", ph.CheckPersonExists)
 	r.Get("/v1/persons", ph.ListPersons)
 	r.Patch("/v1/persons/{id}", ph.UpdatePerson)
 	r.Post("/v1/organizations", ph.CreateOrganization)
 	r.Get("/v1/organizations/{id}", ph.GetOrganization)
+	r.Get("/v1/organizations/{id}/owned-portfolios", ph.ListOwnedPortfoliosOfOrganization)
+	r.Get("/v1/organizations/{id}/people", ph.ListPeopleOfOrganization)
+	r.Get("/v1/organizations/{id}/subsidiaries", ph.ListSubsidiariesOfOrganization)
+	r.Head("/v1/organizations/{id}", ph.CheckOrganizationExists)
 	r.Get("/v1/organizations", ph.ListOrganizations)
 	r.Patch("/v1/organizations/{id}", ph.UpdateOrganization)
 	r.Post("/v1/person-roles", ph.CreatePersonRole)
 	r.Get("/v1/person-roles/{id}", ph.GetPersonRole)
+	r.Get("/v1/person-roles/{id}/leases", ph.ListLeasesOfPersonRole)
+	r.Get("/v1/person-roles/{id}/guaranteed-leases", ph.ListGuaranteedLeasesOfPersonRole)
+	r.Head("/v1/person-roles/{id}", ph.CheckPersonRoleExists)
 	r.Get("/v1/person-roles", ph.ListPersonRoles)
 	r.Post("/v1/person-roles/{id}/activate", ph.ActivateRole)
 	r.Post("/v1/person-roles/{id}/deactivate", ph.DeactivateRole)
 	r.Post("/v1/person-roles/{id}/terminate", ph.TerminateRole)
 	r.Post("/v1/portfolios", proph.CreatePortfolio)
 	r.Get("/v1/portfolios/{id}", proph.GetPortfolio)
+	r.Get("/v1/portfolios/{id}/properties", proph.ListPropertiesOfPortfolio)
+	r.Head("/v1/portfolios/{id}", proph.CheckPortfolioExists)
 	r.Get("/v1/portfolios", proph.ListPortfolios)
 	r.Patch("/v1/portfolios/{id}", proph.UpdatePortfolio)
 	r.Post("/v1/portfolios/{id}/activate", proph.ActivatePortfolio)
 	r.Post("/v1/properties", proph.CreateProperty)
 	r.Get("/v1/properties/{id}", proph.GetProperty)
+	r.Get("/v1/properties/{id}/buildings", proph.ListBuildingsOfProperty)
+	r.Get("/v1/properties/{id}/spaces", proph.ListSpacesOfProperty)
+	r.Get("/v1/properties/{id}/applications", proph.ListApplicationsOfProperty)
+	r.Get("/v1/properties/{id}/ledger-entries", proph.ListLedgerEntriesOfProperty)
+	r.Get("/v1/properties/{id}/property-jurisdictions", proph.ListPropertyJurisdictionsOfProperty)
+	r.Head("/v1/properties/{id}", proph.CheckPropertyExists)
 	r.Get("/v1/properties", proph.ListProperties)
 	r.Patch("/v1/properties/{id}", proph.UpdateProperty)
 	r.Post("/v1/properties/{id}/activate", proph.ActivateProperty)
 	r.Post("/v1/buildings", proph.CreateBuilding)
 	r.Get("/v1/buildings/{id}", proph.GetBuilding)
+	r.Get("/v1/buildings/{id}/spaces", proph.ListSpacesOfBuilding)
+	r.Head("/v1/buildings/{id}", proph.CheckBuildingExists)
 	r.Get("/v1/buildings", proph.ListBuildings)
 	r.Patch("/v1/buildings/{id}", proph.UpdateBuilding)
+	r.Delete("/v1/buildings/{id}", proph.DeleteBuilding)
 	r.Post("/v1/buildings/{id}/deactivate", proph.DeactivateBuilding)
 	r.Post("/v1/buildings/{id}/renovate", proph.StartBuildingRenovation)
 	r.Post("/v1/buildings/{id}/activate", proph.ActivateBuilding)
 	r.Post("/v1/spaces", proph.CreateSpace)
 	r.Get("/v1/spaces/{id}", proph.GetSpace)
+	r.Get("/v1/spaces/{id}/children", proph.ListChildrenOfSpace)
+	r.Get("/v1/spaces/{id}/applications", proph.ListApplicationsOfSpace)
+	r.Get("/v1/spaces/{id}/lease-spaces", proph.ListLeaseSpacesOfSpace)
+	r.Get("/v1/spaces/{id}/ledger-entries", proph.ListLedgerEntriesOfSpace)
+	r.Head("/v1/spaces/{id}", proph.CheckSpaceExists)
 	r.Get("/v1/spaces", proph.ListSpaces)
 	r.Patch("/v1/spaces/{id}", proph.UpdateSpace)
 	r.Post("/v1/spaces/{id}/occupy", proph.OccupySpace)
@@ -68,6 +97,12 @@ func RegisterRoutes(r chi.Router, client *ent.Client) {
 	r.Post("/v1/spaces/{id}/reserve", proph.ReserveSpace)
 	r.Post("/v1/leases", lh.CreateLease)
 	r.Get("/v1/leases/{id}", lh.GetLease)
+	r.Get("/v1/leases/{id}/lease-spaces", lh.ListLeaseSpacesOfLease)
+	r.Get("/v1/leases/{id}/tenant-roles", lh.ListTenantRolesOfLease)
+	r.Get("/v1/leases/{id}/guarantor-roles", lh.ListGuarantorRolesOfLease)
+	r.Get("/v1/leases/{id}/ledger-entries", lh.ListLedgerEntriesOfLease)
+	r.Get("/v1/leases/{id}/subleases", lh.ListSubleasesOfLease)
+	r.Head("/v1/leases/{id}", lh.CheckLeaseExists)
 	r.Get("/v1/leases", lh.ListLeases)
 	r.Patch("/v1/leases/{id}", lh.UpdateLease)
 	r.Post("/v1/leases/{id}/submit", lh.SubmitForApproval)
@@ -80,34 +115,52 @@ func RegisterRoutes(r chi.Router, client *ent.Client) {
 	r.Post("/v1/leases/{id}/notice", lh.RecordNotice)
 	r.Post("/v1/lease-spaces", lh.CreateLeaseSpace)
 	r.Get("/v1/lease-spaces/{id}", lh.GetLeaseSpace)
+	r.Head("/v1/lease-spaces/{id}", lh.CheckLeaseSpaceExists)
 	r.Get("/v1/lease-spaces", lh.ListLeaseSpaces)
 	r.Patch("/v1/lease-spaces/{id}", lh.UpdateLeaseSpace)
 	r.Post("/v1/applications", lh.CreateApplication)
 	r.Get("/v1/applications/{id}", lh.GetApplication)
+	r.Head("/v1/applications/{id}", lh.CheckApplicationExists)
 	r.Get("/v1/applications", lh.ListApplications)
 	r.Post("/v1/applications/{id}/approve", lh.ApproveApplication)
 	r.Post("/v1/applications/{id}/deny", lh.DenyApplication)
 	r.Post("/v1/accounts", ah.CreateAccount)
 	r.Get("/v1/accounts/{id}", ah.GetAccount)
+	r.Get("/v1/accounts/{id}/children", ah.ListChildrenOfAccount)
+	r.Get("/v1/accounts/{id}/entries", ah.ListEntriesOfAccount)
+	r.Get("/v1/accounts/{id}/bank-accounts", ah.ListBankAccountsOfAccount)
+	r.Head("/v1/accounts/{id}", ah.CheckAccountExists)
 	r.Get("/v1/accounts", ah.ListAccounts)
 	r.Patch("/v1/accounts/{id}", ah.UpdateAccount)
 	r.Get("/v1/ledger-entries/{id}", ah.GetLedgerEntry)
+	r.Head("/v1/ledger-entries/{id}", ah.CheckLedgerEntryExists)
 	r.Get("/v1/ledger-entries", ah.ListLedgerEntries)
+	r.Post("/v1/ledger-entries/bulk", ah.BulkCreateLedgerEntries)
 	r.Post("/v1/journal-entries", ah.CreateJournalEntry)
 	r.Get("/v1/journal-entries/{id}", ah.GetJournalEntry)
+	r.Get("/v1/journal-entries/{id}/ledger-entries", ah.ListLedgerEntriesOfJournalEntry)
+	r.Head("/v1/journal-entries/{id}", ah.CheckJournalEntryExists)
 	r.Get("/v1/journal-entries", ah.ListJournalEntries)
 	r.Post("/v1/journal-entries/{id}/post", ah.PostJournalEntry)
 	r.Post("/v1/journal-entries/{id}/void", ah.VoidJournalEntry)
 	r.Post("/v1/bank-accounts", ah.CreateBankAccount)
 	r.Get("/v1/bank-accounts/{id}", ah.GetBankAccount)
+	r.Get("/v1/bank-accounts/{id}/properties", ah.ListPropertiesOfBankAccount)
+	r.Get("/v1/bank-accounts/{id}/reconciliations", ah.ListReconciliationsOfBankAccount)
+	r.Head("/v1/bank-accounts/{id}", ah.CheckBankAccountExists)
 	r.Get("/v1/bank-accounts", ah.ListBankAccounts)
 	r.Patch("/v1/bank-accounts/{id}", ah.UpdateBankAccount)
 	r.Post("/v1/reconciliations", ah.CreateReconciliation)
 	r.Get("/v1/reconciliations/{id}", ah.GetReconciliation)
+	r.Head("/v1/reconciliations/{id}", ah.CheckReconciliationExists)
 	r.Get("/v1/reconciliations", ah.ListReconciliations)
 	r.Post("/v1/reconciliations/{id}/approve", ah.ApproveReconciliation)
 	r.Post("/v1/jurisdictions", jh.CreateJurisdiction)
 	r.Get("/v1/jurisdictions/{id}", jh.GetJurisdiction)
+	r.Get("/v1/jurisdictions/{id}/children", jh.ListChildrenOfJurisdiction)
+	r.Get("/v1/jurisdictions/{id}/rules", jh.ListRulesOfJurisdiction)
+	r.Get("/v1/jurisdictions/{id}/property-jurisdictions", jh.ListPropertyJurisdictionsOfJurisdiction)
+	r.Head("/v1/jurisdictions/{id}", jh.CheckJurisdictionExists)
 	r.Get("/v1/jurisdictions", jh.ListJurisdictions)
 	r.Patch("/v1/jurisdictions/{id}", jh.UpdateJurisdiction)
 	r.Post("/v1/jurisdictions/{id}/activate", jh.ActivateJurisdiction)
@@ -115,10 +168,12 @@ func RegisterRoutes(r chi.Router, client *ent.Client) {
 	r.Post("/v1/jurisdictions/{id}/merge", jh.MergeJurisdiction)
 	r.Post("/v1/property-jurisdictions", jh.CreatePropertyJurisdiction)
 	r.Get("/v1/property-jurisdictions/{id}", jh.GetPropertyJurisdiction)
+	r.Head("/v1/property-jurisdictions/{id}", jh.CheckPropertyJurisdictionExists)
 	r.Get("/v1/property-jurisdictions", jh.ListPropertyJurisdictions)
 	r.Patch("/v1/property-jurisdictions/{id}", jh.UpdatePropertyJurisdiction)
 	r.Post("/v1/jurisdiction-rules", jh.CreateJurisdictionRule)
 	r.Get("/v1/jurisdiction-rules/{id}", jh.GetJurisdictionRule)
+	r.Head("/v1/jurisdiction-rules/{id}", jh.CheckJurisdictionRuleExists)
 	r.Get("/v1/jurisdiction-rules", jh.ListJurisdictionRules)
 	r.Patch("/v1/jurisdiction-rules/{id}", jh.UpdateJurisdictionRule)
 	r.Post("/v1/jurisdiction-rules/{id}/activate", jh.ActivateRule)
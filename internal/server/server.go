@@ -23,7 +23,8 @@ import (
 type Config struct {
 	Port          int
 	DBClient      *ent.Client
-	ActivityStore activity.Store // optional; if set, activity routes are registered
+	ActivityStore activity.Store        // optional; if set, activity routes are registered
+	AuditRecorder handler.AuditRecorder // optional; if set, generated mutations append audit events
 }
 
 // Run starts the HTTP server with all routes registered.
@@ -49,10 +50,10 @@ func Run(ctx context.Context, cfg Config) error {
 	cfg.DBClient.Lease.Use(jurisdiction.LeaseHook(cfg.DBClient))
 
 	// Generated routes for standard CRUD and transitions.
-	RegisterRoutes(r, cfg.DBClient)
+	RegisterRoutes(r, cfg.DBClient, cfg.AuditRecorder)
 	// Custom routes with non-standard path patterns.
 	// Registered after generated routes so custom handlers override generated ones.
-	RegisterCustomRoutes(r, cfg.DBClient)
+	RegisterCustomRoutes(r, cfg.DBClient, cfg.AuditRecorder)
 
 	// Activity/signal routes (optional — no Ent dependency).
 	if cfg.ActivityStore != nil {
@@ -35,11 +35,11 @@ import "time"
 
 #Account: close({
 	#BaseEntity
-	account_number: string & !=""
+	account_number: string & !="" @unique()
 	name:           string & !="" @display()
 	description?:   string @text()
 
-	account_type: "asset" | "liability" | "equity" | "revenue" | "expense"
+	account_type: "asset" | "liability" | "equity" | "revenue" | "expense" @immutable_after_create()
 
 	account_subtype: "cash" | "accounts_receivable" | "prepaid" | "fixed_asset" |
 		"accumulated_depreciation" | "other_asset" |
@@ -101,7 +101,7 @@ import "time"
 
 	// Hidden: generator metadata
 	_display_template: "{account_number} — {name}"
-})
+}) @list_group_by(account_type)
 
 #AccountDimensions: close({
 	entity_id?:   string
@@ -115,7 +115,7 @@ import "time"
 
 #LedgerEntry: close({
 	#ImmutableEntity
-	account_id: string & !="" @immutable()
+	account_id: string & !="" @immutable() @filterable()
 
 	entry_type: ("charge" | "payment" | "credit" | "adjustment" |
 		"refund" | "deposit" | "nsf" | "write_off" |
@@ -135,10 +135,10 @@ import "time"
 	memo?:       string @immutable() @text()
 
 	// Dimensional references
-	property_id: string & !="" @immutable()
-	space_id?:   string @immutable()
-	lease_id?:   string @immutable()
-	person_id?:  string @immutable()
+	property_id: string & !="" @immutable() @filterable()
+	space_id?:   string @immutable() @ref_filter(property_id)
+	lease_id?:   string @immutable() @filterable()
+	person_id?:  string @immutable() @filterable()
 
 	// Bank / trust accounting
 	bank_account_id?:     string @immutable()
@@ -43,7 +43,7 @@ _net_lease_types: ["commercial_nnn", "commercial_nn", "commercial_n"]
 
 #Lease: close({
 	#StatefulEntity
-	property_id: string & !="" // Denormalized for query efficiency
+	property_id: string & !="" @filterable() @immutable_after_create() // Denormalized for query efficiency
 
 	// Tenant references — via PersonRole, not directly to Person
 	tenant_role_ids:     [...string]
@@ -52,11 +52,11 @@ _net_lease_types: ["commercial_nnn", "commercial_nn", "commercial_n"]
 	lease_type: "fixed_term" | "month_to_month" |
 		"commercial_nnn" | "commercial_nn" | "commercial_n" | "commercial_gross" | "commercial_modified_gross" |
 		"affordable" | "section_8" | "student" |
-		"ground_lease" | "short_term" | "membership"
+		"ground_lease" | "short_term" | "membership" @filterable()
 
 	status: "draft" | "pending_approval" | "pending_signature" | "active" |
 		"expired" | "month_to_month_holdover" | "renewed" |
-		"terminated" | "eviction"
+		"terminated" | "eviction" @filterable()
 
 	description?:       string @text()
 	// Liability
@@ -68,7 +68,7 @@ _net_lease_types: ["commercial_nnn", "commercial_nn", "commercial_n"]
 	rent_commencement_date?:  time.Time
 
 	// Financial — base rent
-	base_rent:        #NonNegativeMoney
+	base_rent:        #NonNegativeMoney @filter_range(0, 50000)
 	security_deposit: #NonNegativeMoney @sensitive()
 
 	// Rent schedule
@@ -93,10 +93,10 @@ _net_lease_types: ["commercial_nnn", "commercial_nn", "commercial_n"]
 	subsidy?: #SubsidyTerms
 
 	// Move-in / move-out
-	move_in_date?:       time.Time
+	move_in_date?:       time.Time @relative_time()
 	move_out_date?:      time.Time
 	notice_date?:        time.Time
-	notice_required_days: *30 | int & >=0
+	notice_required_days: *30 | int & >=0 @duration(days)
 
 	// Short-term specific
 	check_in_time?:       string
@@ -240,7 +240,7 @@ _net_lease_types: ["commercial_nnn", "commercial_nn", "commercial_n"]
 	allowance:                #NonNegativeMoney
 	amortized:                bool | *false
 	amortization_term_months?: int & >0
-	interest_rate_percent?:   float & >=0
+	interest_rate_percent?:   float & >=0 @percent()
 	completion_deadline?:     time.Time
 })
 
@@ -283,7 +283,7 @@ _net_lease_types: ["commercial_nnn", "commercial_nn", "commercial_n"]
 })
 
 #PercentageRent: close({
-	rate:                   float & >0 & <=100
+	rate:                   float & >0 & <=100 @percent()
 	breakpoint_type:        "natural" | "artificial"
 	natural_breakpoint?:    #NonNegativeMoney
 	artificial_breakpoint?: #NonNegativeMoney
@@ -358,15 +358,15 @@ _net_lease_types: ["commercial_nnn", "commercial_nn", "commercial_n"]
 
 #Application: close({
 	#StatefulEntity
-	property_id:         string & !=""
-	space_id?:           string
+	property_id:         string & !="" @filterable()
+	space_id?:           string @ref_filter(property_id)
 	applicant_person_id: string & !=""
 
 	status: "submitted" | "screening" | "under_review" | "approved" |
-		"conditionally_approved" | "denied" | "withdrawn" | "expired"
+		"conditionally_approved" | "denied" | "withdrawn" | "expired" @filterable()
 
 	desired_move_in:           time.Time
-	desired_lease_term_months: int & >0
+	desired_lease_term_months: int & >0 @duration(months)
 
 	// Screening
 	screening_request_id?: string
@@ -374,7 +374,7 @@ _net_lease_types: ["commercial_nnn", "commercial_nn", "commercial_n"]
 	credit_score?:         int & >=300 & <=850
 	background_clear:      bool | *false
 	income_verified:       bool | *false
-	income_to_rent_ratio?: float & >=0
+	income_to_rent_ratio?: float & >=0 @percent()
 
 	// Decision
 	decision_by?:     string
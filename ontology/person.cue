@@ -80,7 +80,7 @@ import (
 	// For management companies
 	management_license?: string
 	license_state?:      =~"^[A-Z]{2}$"
-	license_expiry?:     time.Time
+	license_expiry?:     time.Time @relative_time(expiry)
 
 	// Hidden: generator metadata
 	_display_template: "{legal_name}"
@@ -91,7 +91,7 @@ import (
 
 	// Insurance
 	insurance_policy_number?: string
-	insurance_expiry?:        time.Time
+	insurance_expiry?:        time.Time @relative_time(expiry)
 
 	// CONSTRAINTS:
 
@@ -126,7 +126,7 @@ import (
 
 #Building: close({
 	#StatefulEntity
-	property_id: string & !=""
+	property_id: string & !="" @filterable()
 	name:        string & strings.MinRunes(1) @display()
 
 	building_type: "residential" | "commercial" | "mixed_use" | "parking_structure" |
@@ -144,7 +144,7 @@ import (
 
 	// Hidden: generator metadata
 	_display_template: "{name}"
-})
+}) @soft_delete() @versioned()
 
 // ─── Space ──────────────────────────────────────────────────────────────────
 // A leasable (or non-leasable) area within a property or building.
@@ -152,20 +152,20 @@ import (
 
 #Space: close({
 	#StatefulEntity
-	property_id: string & !=""
-	space_number: string & strings.MinRunes(1) @display() // "101", "A", "Suite 200", etc.
+	property_id: string & !="" @filterable()
+	space_number: string & strings.MinRunes(1) @display() @unique(property_id) // "101", "A", "Suite 200", etc.
 
 	space_type: "residential_unit" | "commercial_office" | "commercial_retail" |
 		"storage" | "parking" | "common_area" |
 		"industrial" | "lot_pad" | "bed_space" | "desk_space" |
 		"parking_garage" | "private_office" | "warehouse" | "amenity" |
-		"rack" | "cage" | "server_room" | "other"
+		"rack" | "cage" | "server_room" | "other" @filterable()
 
 	status: "vacant" | "occupied" | "notice_given" | "make_ready" |
-		"down" | "model" | "reserved" | "owner_occupied"
+		"down" | "model" | "reserved" | "owner_occupied" @filterable()
 
 	// Hierarchy
-	building_id?:      string
+	building_id?:      string @ref_filter(property_id)
 	parent_space_id?:  string
 
 	// Leasability
@@ -189,7 +189,7 @@ import (
 	specialized_infrastructure?: [...("medical_plumbing" | "clean_room" | "high_voltage" | "loading_dock" | "commercial_kitchen" | "server_room" | "cold_storage" | "hazmat_ventilation" | "grease_trap" | "exhaust_hood")]
 
 	// Financial
-	market_rent?: #NonNegativeMoney
+	market_rent?: #NonNegativeMoney @filter_range(0, 50000)
 
 	// For affordable housing — space-level income restrictions
 	ami_restriction?: int & >=0 & <=150 // % of Area Median Income
@@ -226,4 +226,4 @@ import (
 
 	// Hidden: generator metadata
 	_display_template: "Space {space_number}"
-})
+}) @list_group_by(space_type)
@@ -17,6 +17,7 @@ package propeller
 	semantic:     string // Human-readable relationship meaning
 	inverse_name: string // Edge name on the target side
 	via?:         string // Join entity for M2M relationships
+	on_delete?:   "cascade" // Delete targets with the source; omit to keep the default FK behavior
 })
 
 relationships: [...#OntologyRelationship]
@@ -30,9 +31,9 @@ relationships: [
 		semantic: "Portfolio uses BankAccount for trust funds", inverse_name: "trust_portfolio"},
 
 	// Property relationships
-	{from: "Property", to: "Building", edge_name: "buildings", cardinality: "O2M",
+	{from: "Property", to: "Building", edge_name: "buildings", cardinality: "O2M", on_delete: "cascade",
 		semantic: "Property contains Buildings", inverse_name: "property"},
-	{from: "Property", to: "Space", edge_name: "spaces", cardinality: "O2M",
+	{from: "Property", to: "Space", edge_name: "spaces", cardinality: "O2M", on_delete: "cascade",
 		semantic: "Property contains Spaces", inverse_name: "property"},
 	{from: "Property", to: "BankAccount", edge_name: "bank_account", cardinality: "M2O",
 		semantic: "Property uses BankAccount", inverse_name: "properties"},
@@ -6,8 +6,23 @@ package propeller
 // persistence layer. No code path can violate these transitions.
 
 // #StateMachine defines a state machine as a map of source state → valid target states.
+// A target is either a bare state name or a #GuardedTransition.
 #StateMachine: {
-	[string]: [...string]
+	[string]: [...(string | #GuardedTransition)]
+}
+
+// #GuardedTransition is a target state that is only reachable while its guard holds.
+#GuardedTransition: {
+	to:    string
+	guard: #TransitionGuard
+}
+
+// #TransitionGuard compares one of the entity's fields, as it will be after
+// the transition, with a constant. Ordering operators need a numeric value.
+#TransitionGuard: {
+	field: string
+	op:    *"==" | "!=" | "<" | "<=" | ">" | ">="
+	value: string | number | bool
 }
 
 // #StateMachines is the unified map of all entity state machines.